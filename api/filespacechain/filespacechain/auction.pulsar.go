@@ -0,0 +1,1721 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package filespacechain
+
+import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_Auction               protoreflect.MessageDescriptor
+	fd_Auction_inquiryId     protoreflect.FieldDescriptor
+	fd_Auction_commitEnd     protoreflect.FieldDescriptor
+	fd_Auction_revealEnd     protoreflect.FieldDescriptor
+	fd_Auction_settled       protoreflect.FieldDescriptor
+	fd_Auction_clearingPrice protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_filespacechain_filespacechain_auction_proto_init()
+	md_Auction = File_filespacechain_filespacechain_auction_proto.Messages().ByName("Auction")
+	fd_Auction_inquiryId = md_Auction.Fields().ByName("inquiryId")
+	fd_Auction_commitEnd = md_Auction.Fields().ByName("commitEnd")
+	fd_Auction_revealEnd = md_Auction.Fields().ByName("revealEnd")
+	fd_Auction_settled = md_Auction.Fields().ByName("settled")
+	fd_Auction_clearingPrice = md_Auction.Fields().ByName("clearingPrice")
+}
+
+var _ protoreflect.Message = (*fastReflection_Auction)(nil)
+
+type fastReflection_Auction Auction
+
+func (x *Auction) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Auction)(x)
+}
+
+func (x *Auction) slowProtoReflect() protoreflect.Message {
+	mi := &file_filespacechain_filespacechain_auction_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Auction_messageType fastReflection_Auction_messageType
+var _ protoreflect.MessageType = fastReflection_Auction_messageType{}
+
+type fastReflection_Auction_messageType struct{}
+
+func (x fastReflection_Auction_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Auction)(nil)
+}
+func (x fastReflection_Auction_messageType) New() protoreflect.Message {
+	return new(fastReflection_Auction)
+}
+func (x fastReflection_Auction_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Auction
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Auction) Descriptor() protoreflect.MessageDescriptor {
+	return md_Auction
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Auction) Type() protoreflect.MessageType {
+	return _fastReflection_Auction_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Auction) New() protoreflect.Message {
+	return new(fastReflection_Auction)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Auction) Interface() protoreflect.ProtoMessage {
+	return (*Auction)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Auction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InquiryId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InquiryId)
+		if !f(fd_Auction_inquiryId, value) {
+			return
+		}
+	}
+	if x.CommitEnd != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CommitEnd)
+		if !f(fd_Auction_commitEnd, value) {
+			return
+		}
+	}
+	if x.RevealEnd != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RevealEnd)
+		if !f(fd_Auction_revealEnd, value) {
+			return
+		}
+	}
+	if x.Settled != false {
+		value := protoreflect.ValueOfBool(x.Settled)
+		if !f(fd_Auction_settled, value) {
+			return
+		}
+	}
+	if x.ClearingPrice != nil {
+		value := protoreflect.ValueOfMessage(x.ClearingPrice.ProtoReflect())
+		if !f(fd_Auction_clearingPrice, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Auction) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.Auction.inquiryId":
+		return x.InquiryId != uint64(0)
+	case "filespacechain.filespacechain.Auction.commitEnd":
+		return x.CommitEnd != uint64(0)
+	case "filespacechain.filespacechain.Auction.revealEnd":
+		return x.RevealEnd != uint64(0)
+	case "filespacechain.filespacechain.Auction.settled":
+		return x.Settled != false
+	case "filespacechain.filespacechain.Auction.clearingPrice":
+		return x.ClearingPrice != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.Auction"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.Auction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Auction) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.Auction.inquiryId":
+		x.InquiryId = uint64(0)
+	case "filespacechain.filespacechain.Auction.commitEnd":
+		x.CommitEnd = uint64(0)
+	case "filespacechain.filespacechain.Auction.revealEnd":
+		x.RevealEnd = uint64(0)
+	case "filespacechain.filespacechain.Auction.settled":
+		x.Settled = false
+	case "filespacechain.filespacechain.Auction.clearingPrice":
+		x.ClearingPrice = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.Auction"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.Auction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Auction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "filespacechain.filespacechain.Auction.inquiryId":
+		value := x.InquiryId
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.Auction.commitEnd":
+		value := x.CommitEnd
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.Auction.revealEnd":
+		value := x.RevealEnd
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.Auction.settled":
+		value := x.Settled
+		return protoreflect.ValueOfBool(value)
+	case "filespacechain.filespacechain.Auction.clearingPrice":
+		value := x.ClearingPrice
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.Auction"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.Auction does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Auction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.Auction.inquiryId":
+		x.InquiryId = value.Uint()
+	case "filespacechain.filespacechain.Auction.commitEnd":
+		x.CommitEnd = value.Uint()
+	case "filespacechain.filespacechain.Auction.revealEnd":
+		x.RevealEnd = value.Uint()
+	case "filespacechain.filespacechain.Auction.settled":
+		x.Settled = value.Bool()
+	case "filespacechain.filespacechain.Auction.clearingPrice":
+		x.ClearingPrice = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.Auction"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.Auction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Auction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.Auction.clearingPrice":
+		if x.ClearingPrice == nil {
+			x.ClearingPrice = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.ClearingPrice.ProtoReflect())
+	case "filespacechain.filespacechain.Auction.inquiryId":
+		panic(fmt.Errorf("field inquiryId of message filespacechain.filespacechain.Auction is not mutable"))
+	case "filespacechain.filespacechain.Auction.commitEnd":
+		panic(fmt.Errorf("field commitEnd of message filespacechain.filespacechain.Auction is not mutable"))
+	case "filespacechain.filespacechain.Auction.revealEnd":
+		panic(fmt.Errorf("field revealEnd of message filespacechain.filespacechain.Auction is not mutable"))
+	case "filespacechain.filespacechain.Auction.settled":
+		panic(fmt.Errorf("field settled of message filespacechain.filespacechain.Auction is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.Auction"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.Auction does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Auction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.Auction.inquiryId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.Auction.commitEnd":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.Auction.revealEnd":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.Auction.settled":
+		return protoreflect.ValueOfBool(false)
+	case "filespacechain.filespacechain.Auction.clearingPrice":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.Auction"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.Auction does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Auction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in filespacechain.filespacechain.Auction", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Auction) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Auction) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Auction) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Auction) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Auction)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.InquiryId != 0 {
+			n += 1 + runtime.Sov(uint64(x.InquiryId))
+		}
+		if x.CommitEnd != 0 {
+			n += 1 + runtime.Sov(uint64(x.CommitEnd))
+		}
+		if x.RevealEnd != 0 {
+			n += 1 + runtime.Sov(uint64(x.RevealEnd))
+		}
+		if x.Settled {
+			n += 2
+		}
+		if x.ClearingPrice != nil {
+			l = options.Size(x.ClearingPrice)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Auction)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ClearingPrice != nil {
+			encoded, err := options.Marshal(x.ClearingPrice)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Settled {
+			i--
+			if x.Settled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.RevealEnd != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RevealEnd))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.CommitEnd != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CommitEnd))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.InquiryId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InquiryId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Auction)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Auction: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Auction: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+				}
+				x.InquiryId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InquiryId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitEnd", wireType)
+				}
+				x.CommitEnd = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CommitEnd |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevealEnd", wireType)
+				}
+				x.RevealEnd = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RevealEnd |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Settled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Settled = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClearingPrice", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ClearingPrice == nil {
+					x.ClearingPrice = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ClearingPrice); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AuctionBid              protoreflect.MessageDescriptor
+	fd_AuctionBid_inquiryId    protoreflect.FieldDescriptor
+	fd_AuctionBid_provider     protoreflect.FieldDescriptor
+	fd_AuctionBid_offerId      protoreflect.FieldDescriptor
+	fd_AuctionBid_commitment   protoreflect.FieldDescriptor
+	fd_AuctionBid_commitHeight protoreflect.FieldDescriptor
+	fd_AuctionBid_revealed     protoreflect.FieldDescriptor
+	fd_AuctionBid_price        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_filespacechain_filespacechain_auction_proto_init()
+	md_AuctionBid = File_filespacechain_filespacechain_auction_proto.Messages().ByName("AuctionBid")
+	fd_AuctionBid_inquiryId = md_AuctionBid.Fields().ByName("inquiryId")
+	fd_AuctionBid_provider = md_AuctionBid.Fields().ByName("provider")
+	fd_AuctionBid_offerId = md_AuctionBid.Fields().ByName("offerId")
+	fd_AuctionBid_commitment = md_AuctionBid.Fields().ByName("commitment")
+	fd_AuctionBid_commitHeight = md_AuctionBid.Fields().ByName("commitHeight")
+	fd_AuctionBid_revealed = md_AuctionBid.Fields().ByName("revealed")
+	fd_AuctionBid_price = md_AuctionBid.Fields().ByName("price")
+}
+
+var _ protoreflect.Message = (*fastReflection_AuctionBid)(nil)
+
+type fastReflection_AuctionBid AuctionBid
+
+func (x *AuctionBid) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AuctionBid)(x)
+}
+
+func (x *AuctionBid) slowProtoReflect() protoreflect.Message {
+	mi := &file_filespacechain_filespacechain_auction_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AuctionBid_messageType fastReflection_AuctionBid_messageType
+var _ protoreflect.MessageType = fastReflection_AuctionBid_messageType{}
+
+type fastReflection_AuctionBid_messageType struct{}
+
+func (x fastReflection_AuctionBid_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AuctionBid)(nil)
+}
+func (x fastReflection_AuctionBid_messageType) New() protoreflect.Message {
+	return new(fastReflection_AuctionBid)
+}
+func (x fastReflection_AuctionBid_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AuctionBid
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AuctionBid) Descriptor() protoreflect.MessageDescriptor {
+	return md_AuctionBid
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AuctionBid) Type() protoreflect.MessageType {
+	return _fastReflection_AuctionBid_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AuctionBid) New() protoreflect.Message {
+	return new(fastReflection_AuctionBid)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AuctionBid) Interface() protoreflect.ProtoMessage {
+	return (*AuctionBid)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AuctionBid) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InquiryId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InquiryId)
+		if !f(fd_AuctionBid_inquiryId, value) {
+			return
+		}
+	}
+	if x.Provider != "" {
+		value := protoreflect.ValueOfString(x.Provider)
+		if !f(fd_AuctionBid_provider, value) {
+			return
+		}
+	}
+	if x.OfferId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OfferId)
+		if !f(fd_AuctionBid_offerId, value) {
+			return
+		}
+	}
+	if len(x.Commitment) != 0 {
+		value := protoreflect.ValueOfBytes(x.Commitment)
+		if !f(fd_AuctionBid_commitment, value) {
+			return
+		}
+	}
+	if x.CommitHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CommitHeight)
+		if !f(fd_AuctionBid_commitHeight, value) {
+			return
+		}
+	}
+	if x.Revealed != false {
+		value := protoreflect.ValueOfBool(x.Revealed)
+		if !f(fd_AuctionBid_revealed, value) {
+			return
+		}
+	}
+	if x.Price != nil {
+		value := protoreflect.ValueOfMessage(x.Price.ProtoReflect())
+		if !f(fd_AuctionBid_price, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AuctionBid) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.AuctionBid.inquiryId":
+		return x.InquiryId != uint64(0)
+	case "filespacechain.filespacechain.AuctionBid.provider":
+		return x.Provider != ""
+	case "filespacechain.filespacechain.AuctionBid.offerId":
+		return x.OfferId != uint64(0)
+	case "filespacechain.filespacechain.AuctionBid.commitment":
+		return len(x.Commitment) != 0
+	case "filespacechain.filespacechain.AuctionBid.commitHeight":
+		return x.CommitHeight != uint64(0)
+	case "filespacechain.filespacechain.AuctionBid.revealed":
+		return x.Revealed != false
+	case "filespacechain.filespacechain.AuctionBid.price":
+		return x.Price != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.AuctionBid"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.AuctionBid does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AuctionBid) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.AuctionBid.inquiryId":
+		x.InquiryId = uint64(0)
+	case "filespacechain.filespacechain.AuctionBid.provider":
+		x.Provider = ""
+	case "filespacechain.filespacechain.AuctionBid.offerId":
+		x.OfferId = uint64(0)
+	case "filespacechain.filespacechain.AuctionBid.commitment":
+		x.Commitment = nil
+	case "filespacechain.filespacechain.AuctionBid.commitHeight":
+		x.CommitHeight = uint64(0)
+	case "filespacechain.filespacechain.AuctionBid.revealed":
+		x.Revealed = false
+	case "filespacechain.filespacechain.AuctionBid.price":
+		x.Price = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.AuctionBid"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.AuctionBid does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AuctionBid) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "filespacechain.filespacechain.AuctionBid.inquiryId":
+		value := x.InquiryId
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.AuctionBid.provider":
+		value := x.Provider
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.AuctionBid.offerId":
+		value := x.OfferId
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.AuctionBid.commitment":
+		value := x.Commitment
+		return protoreflect.ValueOfBytes(value)
+	case "filespacechain.filespacechain.AuctionBid.commitHeight":
+		value := x.CommitHeight
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.AuctionBid.revealed":
+		value := x.Revealed
+		return protoreflect.ValueOfBool(value)
+	case "filespacechain.filespacechain.AuctionBid.price":
+		value := x.Price
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.AuctionBid"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.AuctionBid does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AuctionBid) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.AuctionBid.inquiryId":
+		x.InquiryId = value.Uint()
+	case "filespacechain.filespacechain.AuctionBid.provider":
+		x.Provider = value.Interface().(string)
+	case "filespacechain.filespacechain.AuctionBid.offerId":
+		x.OfferId = value.Uint()
+	case "filespacechain.filespacechain.AuctionBid.commitment":
+		x.Commitment = value.Bytes()
+	case "filespacechain.filespacechain.AuctionBid.commitHeight":
+		x.CommitHeight = value.Uint()
+	case "filespacechain.filespacechain.AuctionBid.revealed":
+		x.Revealed = value.Bool()
+	case "filespacechain.filespacechain.AuctionBid.price":
+		x.Price = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.AuctionBid"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.AuctionBid does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AuctionBid) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.AuctionBid.price":
+		if x.Price == nil {
+			x.Price = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Price.ProtoReflect())
+	case "filespacechain.filespacechain.AuctionBid.inquiryId":
+		panic(fmt.Errorf("field inquiryId of message filespacechain.filespacechain.AuctionBid is not mutable"))
+	case "filespacechain.filespacechain.AuctionBid.provider":
+		panic(fmt.Errorf("field provider of message filespacechain.filespacechain.AuctionBid is not mutable"))
+	case "filespacechain.filespacechain.AuctionBid.offerId":
+		panic(fmt.Errorf("field offerId of message filespacechain.filespacechain.AuctionBid is not mutable"))
+	case "filespacechain.filespacechain.AuctionBid.commitment":
+		panic(fmt.Errorf("field commitment of message filespacechain.filespacechain.AuctionBid is not mutable"))
+	case "filespacechain.filespacechain.AuctionBid.commitHeight":
+		panic(fmt.Errorf("field commitHeight of message filespacechain.filespacechain.AuctionBid is not mutable"))
+	case "filespacechain.filespacechain.AuctionBid.revealed":
+		panic(fmt.Errorf("field revealed of message filespacechain.filespacechain.AuctionBid is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.AuctionBid"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.AuctionBid does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AuctionBid) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.AuctionBid.inquiryId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.AuctionBid.provider":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.AuctionBid.offerId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.AuctionBid.commitment":
+		return protoreflect.ValueOfBytes(nil)
+	case "filespacechain.filespacechain.AuctionBid.commitHeight":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.AuctionBid.revealed":
+		return protoreflect.ValueOfBool(false)
+	case "filespacechain.filespacechain.AuctionBid.price":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.AuctionBid"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.AuctionBid does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AuctionBid) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in filespacechain.filespacechain.AuctionBid", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AuctionBid) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AuctionBid) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AuctionBid) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AuctionBid) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AuctionBid)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.InquiryId != 0 {
+			n += 1 + runtime.Sov(uint64(x.InquiryId))
+		}
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OfferId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OfferId))
+		}
+		l = len(x.Commitment)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CommitHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.CommitHeight))
+		}
+		if x.Revealed {
+			n += 2
+		}
+		if x.Price != nil {
+			l = options.Size(x.Price)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AuctionBid)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Price != nil {
+			encoded, err := options.Marshal(x.Price)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Revealed {
+			i--
+			if x.Revealed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.CommitHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CommitHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Commitment) > 0 {
+			i -= len(x.Commitment)
+			copy(dAtA[i:], x.Commitment)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Commitment)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.OfferId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OfferId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.InquiryId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InquiryId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AuctionBid)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AuctionBid: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AuctionBid: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+				}
+				x.InquiryId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InquiryId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+				}
+				x.OfferId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OfferId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Commitment = append(x.Commitment[:0], dAtA[iNdEx:postIndex]...)
+				if x.Commitment == nil {
+					x.Commitment = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitHeight", wireType)
+				}
+				x.CommitHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CommitHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Revealed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Revealed = bool(v != 0)
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Price == nil {
+					x.Price = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Price); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: filespacechain/filespacechain/auction.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Auction is the sealed-bid reverse auction of an inquiry. Providers commit
+// to a hashed price until commitEnd and reveal it until revealEnd; the
+// auction then settles at a uniform clearing price.
+type Auction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InquiryId uint64 `protobuf:"varint,1,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	// Last block at which bids can be committed
+	CommitEnd uint64 `protobuf:"varint,2,opt,name=commitEnd,proto3" json:"commitEnd,omitempty"`
+	// Last block at which committed bids can be revealed
+	RevealEnd uint64 `protobuf:"varint,3,opt,name=revealEnd,proto3" json:"revealEnd,omitempty"`
+	Settled   bool   `protobuf:"varint,4,opt,name=settled,proto3" json:"settled,omitempty"`
+	// Price per block every winning provider is paid, set on settlement
+	ClearingPrice *v1beta1.Coin `protobuf:"bytes,5,opt,name=clearingPrice,proto3" json:"clearingPrice,omitempty"`
+}
+
+func (x *Auction) Reset() {
+	*x = Auction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_filespacechain_filespacechain_auction_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Auction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auction) ProtoMessage() {}
+
+// Deprecated: Use Auction.ProtoReflect.Descriptor instead.
+func (*Auction) Descriptor() ([]byte, []int) {
+	return file_filespacechain_filespacechain_auction_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Auction) GetInquiryId() uint64 {
+	if x != nil {
+		return x.InquiryId
+	}
+	return 0
+}
+
+func (x *Auction) GetCommitEnd() uint64 {
+	if x != nil {
+		return x.CommitEnd
+	}
+	return 0
+}
+
+func (x *Auction) GetRevealEnd() uint64 {
+	if x != nil {
+		return x.RevealEnd
+	}
+	return 0
+}
+
+func (x *Auction) GetSettled() bool {
+	if x != nil {
+		return x.Settled
+	}
+	return false
+}
+
+func (x *Auction) GetClearingPrice() *v1beta1.Coin {
+	if x != nil {
+		return x.ClearingPrice
+	}
+	return nil
+}
+
+// AuctionBid is the bid of a provider in the auction of an inquiry, made
+// with one of its hosting offers
+type AuctionBid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InquiryId uint64 `protobuf:"varint,1,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	Provider  string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	OfferId   uint64 `protobuf:"varint,3,opt,name=offerId,proto3" json:"offerId,omitempty"`
+	// SHA-256 commitment to the price, see types.BidCommitment
+	Commitment   []byte `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	CommitHeight uint64 `protobuf:"varint,5,opt,name=commitHeight,proto3" json:"commitHeight,omitempty"`
+	Revealed     bool   `protobuf:"varint,6,opt,name=revealed,proto3" json:"revealed,omitempty"`
+	// Price per block the provider asks, set when the bid is revealed
+	Price *v1beta1.Coin `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *AuctionBid) Reset() {
+	*x = AuctionBid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_filespacechain_filespacechain_auction_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuctionBid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionBid) ProtoMessage() {}
+
+// Deprecated: Use AuctionBid.ProtoReflect.Descriptor instead.
+func (*AuctionBid) Descriptor() ([]byte, []int) {
+	return file_filespacechain_filespacechain_auction_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuctionBid) GetInquiryId() uint64 {
+	if x != nil {
+		return x.InquiryId
+	}
+	return 0
+}
+
+func (x *AuctionBid) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *AuctionBid) GetOfferId() uint64 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+func (x *AuctionBid) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *AuctionBid) GetCommitHeight() uint64 {
+	if x != nil {
+		return x.CommitHeight
+	}
+	return 0
+}
+
+func (x *AuctionBid) GetRevealed() bool {
+	if x != nil {
+		return x.Revealed
+	}
+	return false
+}
+
+func (x *AuctionBid) GetPrice() *v1beta1.Coin {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+var File_filespacechain_filespacechain_auction_proto protoreflect.FileDescriptor
+
+var file_filespacechain_filespacechain_auction_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x1a, 0x14, 0x67, 0x6f,
+	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xc4, 0x01, 0x0a, 0x07, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x45, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x45, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x76, 0x65, 0x61, 0x6c, 0x45, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72,
+	0x65, 0x76, 0x65, 0x61, 0x6c, 0x45, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x64, 0x12, 0x45, 0x0a, 0x0d, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x63, 0x6c, 0x65, 0x61,
+	0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xf7, 0x01, 0x0a, 0x0a, 0x41, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x71, 0x75,
+	0x69, 0x72, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x69, 0x6e, 0x71,
+	0x75, 0x69, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x42, 0xf6, 0x01, 0x0a, 0x21, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x0c, 0x41, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xa2, 0x02, 0x03, 0x46, 0x46, 0x58, 0xaa,
+	0x02, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xca,
+	0x02, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xe2,
+	0x02, 0x29, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1e, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_filespacechain_filespacechain_auction_proto_rawDescOnce sync.Once
+	file_filespacechain_filespacechain_auction_proto_rawDescData = file_filespacechain_filespacechain_auction_proto_rawDesc
+)
+
+func file_filespacechain_filespacechain_auction_proto_rawDescGZIP() []byte {
+	file_filespacechain_filespacechain_auction_proto_rawDescOnce.Do(func() {
+		file_filespacechain_filespacechain_auction_proto_rawDescData = protoimpl.X.CompressGZIP(file_filespacechain_filespacechain_auction_proto_rawDescData)
+	})
+	return file_filespacechain_filespacechain_auction_proto_rawDescData
+}
+
+var file_filespacechain_filespacechain_auction_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_filespacechain_filespacechain_auction_proto_goTypes = []interface{}{
+	(*Auction)(nil),      // 0: filespacechain.filespacechain.Auction
+	(*AuctionBid)(nil),   // 1: filespacechain.filespacechain.AuctionBid
+	(*v1beta1.Coin)(nil), // 2: cosmos.base.v1beta1.Coin
+}
+var file_filespacechain_filespacechain_auction_proto_depIdxs = []int32{
+	2, // 0: filespacechain.filespacechain.Auction.clearingPrice:type_name -> cosmos.base.v1beta1.Coin
+	2, // 1: filespacechain.filespacechain.AuctionBid.price:type_name -> cosmos.base.v1beta1.Coin
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_filespacechain_filespacechain_auction_proto_init() }
+func file_filespacechain_filespacechain_auction_proto_init() {
+	if File_filespacechain_filespacechain_auction_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_filespacechain_filespacechain_auction_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Auction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_filespacechain_filespacechain_auction_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuctionBid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_filespacechain_filespacechain_auction_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_filespacechain_filespacechain_auction_proto_goTypes,
+		DependencyIndexes: file_filespacechain_filespacechain_auction_proto_depIdxs,
+		MessageInfos:      file_filespacechain_filespacechain_auction_proto_msgTypes,
+	}.Build()
+	File_filespacechain_filespacechain_auction_proto = out.File
+	file_filespacechain_filespacechain_auction_proto_rawDesc = nil
+	file_filespacechain_filespacechain_auction_proto_goTypes = nil
+	file_filespacechain_filespacechain_auction_proto_depIdxs = nil
+}
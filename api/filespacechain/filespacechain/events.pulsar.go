@@ -0,0 +1,7677 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package filespacechain
+
+import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_EventHostingContractStatusChanged            protoreflect.MessageDescriptor
+	fd_EventHostingContractStatusChanged_contractId protoreflect.FieldDescriptor
+	fd_EventHostingContractStatusChanged_inquiryId  protoreflect.FieldDescriptor
+	fd_EventHostingContractStatusChanged_provider   protoreflect.FieldDescriptor
+	fd_EventHostingContractStatusChanged_fromStatus protoreflect.FieldDescriptor
+	fd_EventHostingContractStatusChanged_toStatus   protoreflect.FieldDescriptor
+	fd_EventHostingContractStatusChanged_height     protoreflect.FieldDescriptor
+	fd_EventHostingContractStatusChanged_reason     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_filespacechain_filespacechain_events_proto_init()
+	md_EventHostingContractStatusChanged = File_filespacechain_filespacechain_events_proto.Messages().ByName("EventHostingContractStatusChanged")
+	fd_EventHostingContractStatusChanged_contractId = md_EventHostingContractStatusChanged.Fields().ByName("contractId")
+	fd_EventHostingContractStatusChanged_inquiryId = md_EventHostingContractStatusChanged.Fields().ByName("inquiryId")
+	fd_EventHostingContractStatusChanged_provider = md_EventHostingContractStatusChanged.Fields().ByName("provider")
+	fd_EventHostingContractStatusChanged_fromStatus = md_EventHostingContractStatusChanged.Fields().ByName("fromStatus")
+	fd_EventHostingContractStatusChanged_toStatus = md_EventHostingContractStatusChanged.Fields().ByName("toStatus")
+	fd_EventHostingContractStatusChanged_height = md_EventHostingContractStatusChanged.Fields().ByName("height")
+	fd_EventHostingContractStatusChanged_reason = md_EventHostingContractStatusChanged.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventHostingContractStatusChanged)(nil)
+
+type fastReflection_EventHostingContractStatusChanged EventHostingContractStatusChanged
+
+func (x *EventHostingContractStatusChanged) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventHostingContractStatusChanged)(x)
+}
+
+func (x *EventHostingContractStatusChanged) slowProtoReflect() protoreflect.Message {
+	mi := &file_filespacechain_filespacechain_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventHostingContractStatusChanged_messageType fastReflection_EventHostingContractStatusChanged_messageType
+var _ protoreflect.MessageType = fastReflection_EventHostingContractStatusChanged_messageType{}
+
+type fastReflection_EventHostingContractStatusChanged_messageType struct{}
+
+func (x fastReflection_EventHostingContractStatusChanged_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventHostingContractStatusChanged)(nil)
+}
+func (x fastReflection_EventHostingContractStatusChanged_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventHostingContractStatusChanged)
+}
+func (x fastReflection_EventHostingContractStatusChanged_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventHostingContractStatusChanged
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventHostingContractStatusChanged) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventHostingContractStatusChanged
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventHostingContractStatusChanged) Type() protoreflect.MessageType {
+	return _fastReflection_EventHostingContractStatusChanged_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventHostingContractStatusChanged) New() protoreflect.Message {
+	return new(fastReflection_EventHostingContractStatusChanged)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventHostingContractStatusChanged) Interface() protoreflect.ProtoMessage {
+	return (*EventHostingContractStatusChanged)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventHostingContractStatusChanged) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_EventHostingContractStatusChanged_contractId, value) {
+			return
+		}
+	}
+	if x.InquiryId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InquiryId)
+		if !f(fd_EventHostingContractStatusChanged_inquiryId, value) {
+			return
+		}
+	}
+	if x.Provider != "" {
+		value := protoreflect.ValueOfString(x.Provider)
+		if !f(fd_EventHostingContractStatusChanged_provider, value) {
+			return
+		}
+	}
+	if x.FromStatus != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.FromStatus))
+		if !f(fd_EventHostingContractStatusChanged_fromStatus, value) {
+			return
+		}
+	}
+	if x.ToStatus != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ToStatus))
+		if !f(fd_EventHostingContractStatusChanged_toStatus, value) {
+			return
+		}
+	}
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_EventHostingContractStatusChanged_height, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventHostingContractStatusChanged_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventHostingContractStatusChanged) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.contractId":
+		return x.ContractId != uint64(0)
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.inquiryId":
+		return x.InquiryId != uint64(0)
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.provider":
+		return x.Provider != ""
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.fromStatus":
+		return x.FromStatus != 0
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.toStatus":
+		return x.ToStatus != 0
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.height":
+		return x.Height != uint64(0)
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.reason":
+		return x.Reason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingContractStatusChanged"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingContractStatusChanged does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHostingContractStatusChanged) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.contractId":
+		x.ContractId = uint64(0)
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.inquiryId":
+		x.InquiryId = uint64(0)
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.provider":
+		x.Provider = ""
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.fromStatus":
+		x.FromStatus = 0
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.toStatus":
+		x.ToStatus = 0
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.height":
+		x.Height = uint64(0)
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.reason":
+		x.Reason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingContractStatusChanged"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingContractStatusChanged does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventHostingContractStatusChanged) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.contractId":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.inquiryId":
+		value := x.InquiryId
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.provider":
+		value := x.Provider
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.fromStatus":
+		value := x.FromStatus
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.toStatus":
+		value := x.ToStatus
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingContractStatusChanged"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingContractStatusChanged does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHostingContractStatusChanged) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.contractId":
+		x.ContractId = value.Uint()
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.inquiryId":
+		x.InquiryId = value.Uint()
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.provider":
+		x.Provider = value.Interface().(string)
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.fromStatus":
+		x.FromStatus = (ContractStatus)(value.Enum())
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.toStatus":
+		x.ToStatus = (ContractStatus)(value.Enum())
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.height":
+		x.Height = value.Uint()
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.reason":
+		x.Reason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingContractStatusChanged"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingContractStatusChanged does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHostingContractStatusChanged) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.contractId":
+		panic(fmt.Errorf("field contractId of message filespacechain.filespacechain.EventHostingContractStatusChanged is not mutable"))
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.inquiryId":
+		panic(fmt.Errorf("field inquiryId of message filespacechain.filespacechain.EventHostingContractStatusChanged is not mutable"))
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.provider":
+		panic(fmt.Errorf("field provider of message filespacechain.filespacechain.EventHostingContractStatusChanged is not mutable"))
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.fromStatus":
+		panic(fmt.Errorf("field fromStatus of message filespacechain.filespacechain.EventHostingContractStatusChanged is not mutable"))
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.toStatus":
+		panic(fmt.Errorf("field toStatus of message filespacechain.filespacechain.EventHostingContractStatusChanged is not mutable"))
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.height":
+		panic(fmt.Errorf("field height of message filespacechain.filespacechain.EventHostingContractStatusChanged is not mutable"))
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.reason":
+		panic(fmt.Errorf("field reason of message filespacechain.filespacechain.EventHostingContractStatusChanged is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingContractStatusChanged"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingContractStatusChanged does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventHostingContractStatusChanged) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.contractId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.inquiryId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.provider":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.fromStatus":
+		return protoreflect.ValueOfEnum(0)
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.toStatus":
+		return protoreflect.ValueOfEnum(0)
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.EventHostingContractStatusChanged.reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingContractStatusChanged"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingContractStatusChanged does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventHostingContractStatusChanged) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in filespacechain.filespacechain.EventHostingContractStatusChanged", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventHostingContractStatusChanged) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHostingContractStatusChanged) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventHostingContractStatusChanged) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventHostingContractStatusChanged) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventHostingContractStatusChanged)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		if x.InquiryId != 0 {
+			n += 1 + runtime.Sov(uint64(x.InquiryId))
+		}
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FromStatus != 0 {
+			n += 1 + runtime.Sov(uint64(x.FromStatus))
+		}
+		if x.ToStatus != 0 {
+			n += 1 + runtime.Sov(uint64(x.ToStatus))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventHostingContractStatusChanged)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ToStatus != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ToStatus))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.FromStatus != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FromStatus))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.InquiryId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InquiryId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventHostingContractStatusChanged)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventHostingContractStatusChanged: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventHostingContractStatusChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				}
+				x.ContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+				}
+				x.InquiryId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InquiryId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromStatus", wireType)
+				}
+				x.FromStatus = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FromStatus |= ContractStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToStatus", wireType)
+				}
+				x.ToStatus = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ToStatus |= ContractStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventHostingOffersMatched_3_list)(nil)
+
+type _EventHostingOffersMatched_3_list struct {
+	list *[]uint64
+}
+
+func (x *_EventHostingOffersMatched_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventHostingOffersMatched_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_EventHostingOffersMatched_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventHostingOffersMatched_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventHostingOffersMatched_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventHostingOffersMatched at list field MatchedOfferIds as it is not of Message kind"))
+}
+
+func (x *_EventHostingOffersMatched_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventHostingOffersMatched_3_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_EventHostingOffersMatched_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventHostingOffersMatched_4_list)(nil)
+
+type _EventHostingOffersMatched_4_list struct {
+	list *[]*OfferRejection
+}
+
+func (x *_EventHostingOffersMatched_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventHostingOffersMatched_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventHostingOffersMatched_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OfferRejection)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventHostingOffersMatched_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OfferRejection)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventHostingOffersMatched_4_list) AppendMutable() protoreflect.Value {
+	v := new(OfferRejection)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventHostingOffersMatched_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventHostingOffersMatched_4_list) NewElement() protoreflect.Value {
+	v := new(OfferRejection)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventHostingOffersMatched_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventHostingOffersMatched                 protoreflect.MessageDescriptor
+	fd_EventHostingOffersMatched_inquiryId       protoreflect.FieldDescriptor
+	fd_EventHostingOffersMatched_slots           protoreflect.FieldDescriptor
+	fd_EventHostingOffersMatched_matchedOfferIds protoreflect.FieldDescriptor
+	fd_EventHostingOffersMatched_rejections      protoreflect.FieldDescriptor
+	fd_EventHostingOffersMatched_height          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_filespacechain_filespacechain_events_proto_init()
+	md_EventHostingOffersMatched = File_filespacechain_filespacechain_events_proto.Messages().ByName("EventHostingOffersMatched")
+	fd_EventHostingOffersMatched_inquiryId = md_EventHostingOffersMatched.Fields().ByName("inquiryId")
+	fd_EventHostingOffersMatched_slots = md_EventHostingOffersMatched.Fields().ByName("slots")
+	fd_EventHostingOffersMatched_matchedOfferIds = md_EventHostingOffersMatched.Fields().ByName("matchedOfferIds")
+	fd_EventHostingOffersMatched_rejections = md_EventHostingOffersMatched.Fields().ByName("rejections")
+	fd_EventHostingOffersMatched_height = md_EventHostingOffersMatched.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventHostingOffersMatched)(nil)
+
+type fastReflection_EventHostingOffersMatched EventHostingOffersMatched
+
+func (x *EventHostingOffersMatched) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventHostingOffersMatched)(x)
+}
+
+func (x *EventHostingOffersMatched) slowProtoReflect() protoreflect.Message {
+	mi := &file_filespacechain_filespacechain_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventHostingOffersMatched_messageType fastReflection_EventHostingOffersMatched_messageType
+var _ protoreflect.MessageType = fastReflection_EventHostingOffersMatched_messageType{}
+
+type fastReflection_EventHostingOffersMatched_messageType struct{}
+
+func (x fastReflection_EventHostingOffersMatched_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventHostingOffersMatched)(nil)
+}
+func (x fastReflection_EventHostingOffersMatched_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventHostingOffersMatched)
+}
+func (x fastReflection_EventHostingOffersMatched_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventHostingOffersMatched
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventHostingOffersMatched) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventHostingOffersMatched
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventHostingOffersMatched) Type() protoreflect.MessageType {
+	return _fastReflection_EventHostingOffersMatched_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventHostingOffersMatched) New() protoreflect.Message {
+	return new(fastReflection_EventHostingOffersMatched)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventHostingOffersMatched) Interface() protoreflect.ProtoMessage {
+	return (*EventHostingOffersMatched)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventHostingOffersMatched) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InquiryId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InquiryId)
+		if !f(fd_EventHostingOffersMatched_inquiryId, value) {
+			return
+		}
+	}
+	if x.Slots != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Slots)
+		if !f(fd_EventHostingOffersMatched_slots, value) {
+			return
+		}
+	}
+	if len(x.MatchedOfferIds) != 0 {
+		value := protoreflect.ValueOfList(&_EventHostingOffersMatched_3_list{list: &x.MatchedOfferIds})
+		if !f(fd_EventHostingOffersMatched_matchedOfferIds, value) {
+			return
+		}
+	}
+	if len(x.Rejections) != 0 {
+		value := protoreflect.ValueOfList(&_EventHostingOffersMatched_4_list{list: &x.Rejections})
+		if !f(fd_EventHostingOffersMatched_rejections, value) {
+			return
+		}
+	}
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_EventHostingOffersMatched_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventHostingOffersMatched) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingOffersMatched.inquiryId":
+		return x.InquiryId != uint64(0)
+	case "filespacechain.filespacechain.EventHostingOffersMatched.slots":
+		return x.Slots != uint64(0)
+	case "filespacechain.filespacechain.EventHostingOffersMatched.matchedOfferIds":
+		return len(x.MatchedOfferIds) != 0
+	case "filespacechain.filespacechain.EventHostingOffersMatched.rejections":
+		return len(x.Rejections) != 0
+	case "filespacechain.filespacechain.EventHostingOffersMatched.height":
+		return x.Height != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingOffersMatched"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingOffersMatched does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHostingOffersMatched) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingOffersMatched.inquiryId":
+		x.InquiryId = uint64(0)
+	case "filespacechain.filespacechain.EventHostingOffersMatched.slots":
+		x.Slots = uint64(0)
+	case "filespacechain.filespacechain.EventHostingOffersMatched.matchedOfferIds":
+		x.MatchedOfferIds = nil
+	case "filespacechain.filespacechain.EventHostingOffersMatched.rejections":
+		x.Rejections = nil
+	case "filespacechain.filespacechain.EventHostingOffersMatched.height":
+		x.Height = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingOffersMatched"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingOffersMatched does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventHostingOffersMatched) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "filespacechain.filespacechain.EventHostingOffersMatched.inquiryId":
+		value := x.InquiryId
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.EventHostingOffersMatched.slots":
+		value := x.Slots
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.EventHostingOffersMatched.matchedOfferIds":
+		if len(x.MatchedOfferIds) == 0 {
+			return protoreflect.ValueOfList(&_EventHostingOffersMatched_3_list{})
+		}
+		listValue := &_EventHostingOffersMatched_3_list{list: &x.MatchedOfferIds}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.EventHostingOffersMatched.rejections":
+		if len(x.Rejections) == 0 {
+			return protoreflect.ValueOfList(&_EventHostingOffersMatched_4_list{})
+		}
+		listValue := &_EventHostingOffersMatched_4_list{list: &x.Rejections}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.EventHostingOffersMatched.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingOffersMatched"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingOffersMatched does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHostingOffersMatched) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingOffersMatched.inquiryId":
+		x.InquiryId = value.Uint()
+	case "filespacechain.filespacechain.EventHostingOffersMatched.slots":
+		x.Slots = value.Uint()
+	case "filespacechain.filespacechain.EventHostingOffersMatched.matchedOfferIds":
+		lv := value.List()
+		clv := lv.(*_EventHostingOffersMatched_3_list)
+		x.MatchedOfferIds = *clv.list
+	case "filespacechain.filespacechain.EventHostingOffersMatched.rejections":
+		lv := value.List()
+		clv := lv.(*_EventHostingOffersMatched_4_list)
+		x.Rejections = *clv.list
+	case "filespacechain.filespacechain.EventHostingOffersMatched.height":
+		x.Height = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingOffersMatched"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingOffersMatched does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHostingOffersMatched) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingOffersMatched.matchedOfferIds":
+		if x.MatchedOfferIds == nil {
+			x.MatchedOfferIds = []uint64{}
+		}
+		value := &_EventHostingOffersMatched_3_list{list: &x.MatchedOfferIds}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.EventHostingOffersMatched.rejections":
+		if x.Rejections == nil {
+			x.Rejections = []*OfferRejection{}
+		}
+		value := &_EventHostingOffersMatched_4_list{list: &x.Rejections}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.EventHostingOffersMatched.inquiryId":
+		panic(fmt.Errorf("field inquiryId of message filespacechain.filespacechain.EventHostingOffersMatched is not mutable"))
+	case "filespacechain.filespacechain.EventHostingOffersMatched.slots":
+		panic(fmt.Errorf("field slots of message filespacechain.filespacechain.EventHostingOffersMatched is not mutable"))
+	case "filespacechain.filespacechain.EventHostingOffersMatched.height":
+		panic(fmt.Errorf("field height of message filespacechain.filespacechain.EventHostingOffersMatched is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingOffersMatched"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingOffersMatched does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventHostingOffersMatched) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingOffersMatched.inquiryId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.EventHostingOffersMatched.slots":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.EventHostingOffersMatched.matchedOfferIds":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_EventHostingOffersMatched_3_list{list: &list})
+	case "filespacechain.filespacechain.EventHostingOffersMatched.rejections":
+		list := []*OfferRejection{}
+		return protoreflect.ValueOfList(&_EventHostingOffersMatched_4_list{list: &list})
+	case "filespacechain.filespacechain.EventHostingOffersMatched.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingOffersMatched"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingOffersMatched does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventHostingOffersMatched) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in filespacechain.filespacechain.EventHostingOffersMatched", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventHostingOffersMatched) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHostingOffersMatched) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventHostingOffersMatched) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventHostingOffersMatched) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventHostingOffersMatched)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.InquiryId != 0 {
+			n += 1 + runtime.Sov(uint64(x.InquiryId))
+		}
+		if x.Slots != 0 {
+			n += 1 + runtime.Sov(uint64(x.Slots))
+		}
+		if len(x.MatchedOfferIds) > 0 {
+			l = 0
+			for _, e := range x.MatchedOfferIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.Rejections) > 0 {
+			for _, e := range x.Rejections {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventHostingOffersMatched)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Rejections) > 0 {
+			for iNdEx := len(x.Rejections) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Rejections[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.MatchedOfferIds) > 0 {
+			var pksize2 int
+			for _, num := range x.MatchedOfferIds {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.MatchedOfferIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Slots != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Slots))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.InquiryId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InquiryId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventHostingOffersMatched)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventHostingOffersMatched: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventHostingOffersMatched: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+				}
+				x.InquiryId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InquiryId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Slots", wireType)
+				}
+				x.Slots = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Slots |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.MatchedOfferIds = append(x.MatchedOfferIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.MatchedOfferIds) == 0 {
+						x.MatchedOfferIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.MatchedOfferIds = append(x.MatchedOfferIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MatchedOfferIds", wireType)
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rejections", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Rejections = append(x.Rejections, &OfferRejection{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rejections[len(x.Rejections)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventAuctionSettled_3_list)(nil)
+
+type _EventAuctionSettled_3_list struct {
+	list *[]uint64
+}
+
+func (x *_EventAuctionSettled_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventAuctionSettled_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_EventAuctionSettled_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventAuctionSettled_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventAuctionSettled_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventAuctionSettled at list field WinningOfferIds as it is not of Message kind"))
+}
+
+func (x *_EventAuctionSettled_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventAuctionSettled_3_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_EventAuctionSettled_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventAuctionSettled                 protoreflect.MessageDescriptor
+	fd_EventAuctionSettled_inquiryId       protoreflect.FieldDescriptor
+	fd_EventAuctionSettled_clearingPrice   protoreflect.FieldDescriptor
+	fd_EventAuctionSettled_winningOfferIds protoreflect.FieldDescriptor
+	fd_EventAuctionSettled_height          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_filespacechain_filespacechain_events_proto_init()
+	md_EventAuctionSettled = File_filespacechain_filespacechain_events_proto.Messages().ByName("EventAuctionSettled")
+	fd_EventAuctionSettled_inquiryId = md_EventAuctionSettled.Fields().ByName("inquiryId")
+	fd_EventAuctionSettled_clearingPrice = md_EventAuctionSettled.Fields().ByName("clearingPrice")
+	fd_EventAuctionSettled_winningOfferIds = md_EventAuctionSettled.Fields().ByName("winningOfferIds")
+	fd_EventAuctionSettled_height = md_EventAuctionSettled.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventAuctionSettled)(nil)
+
+type fastReflection_EventAuctionSettled EventAuctionSettled
+
+func (x *EventAuctionSettled) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventAuctionSettled)(x)
+}
+
+func (x *EventAuctionSettled) slowProtoReflect() protoreflect.Message {
+	mi := &file_filespacechain_filespacechain_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventAuctionSettled_messageType fastReflection_EventAuctionSettled_messageType
+var _ protoreflect.MessageType = fastReflection_EventAuctionSettled_messageType{}
+
+type fastReflection_EventAuctionSettled_messageType struct{}
+
+func (x fastReflection_EventAuctionSettled_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventAuctionSettled)(nil)
+}
+func (x fastReflection_EventAuctionSettled_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventAuctionSettled)
+}
+func (x fastReflection_EventAuctionSettled_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventAuctionSettled
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventAuctionSettled) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventAuctionSettled
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventAuctionSettled) Type() protoreflect.MessageType {
+	return _fastReflection_EventAuctionSettled_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventAuctionSettled) New() protoreflect.Message {
+	return new(fastReflection_EventAuctionSettled)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventAuctionSettled) Interface() protoreflect.ProtoMessage {
+	return (*EventAuctionSettled)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventAuctionSettled) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InquiryId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InquiryId)
+		if !f(fd_EventAuctionSettled_inquiryId, value) {
+			return
+		}
+	}
+	if x.ClearingPrice != nil {
+		value := protoreflect.ValueOfMessage(x.ClearingPrice.ProtoReflect())
+		if !f(fd_EventAuctionSettled_clearingPrice, value) {
+			return
+		}
+	}
+	if len(x.WinningOfferIds) != 0 {
+		value := protoreflect.ValueOfList(&_EventAuctionSettled_3_list{list: &x.WinningOfferIds})
+		if !f(fd_EventAuctionSettled_winningOfferIds, value) {
+			return
+		}
+	}
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_EventAuctionSettled_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventAuctionSettled) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventAuctionSettled.inquiryId":
+		return x.InquiryId != uint64(0)
+	case "filespacechain.filespacechain.EventAuctionSettled.clearingPrice":
+		return x.ClearingPrice != nil
+	case "filespacechain.filespacechain.EventAuctionSettled.winningOfferIds":
+		return len(x.WinningOfferIds) != 0
+	case "filespacechain.filespacechain.EventAuctionSettled.height":
+		return x.Height != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventAuctionSettled"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventAuctionSettled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAuctionSettled) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventAuctionSettled.inquiryId":
+		x.InquiryId = uint64(0)
+	case "filespacechain.filespacechain.EventAuctionSettled.clearingPrice":
+		x.ClearingPrice = nil
+	case "filespacechain.filespacechain.EventAuctionSettled.winningOfferIds":
+		x.WinningOfferIds = nil
+	case "filespacechain.filespacechain.EventAuctionSettled.height":
+		x.Height = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventAuctionSettled"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventAuctionSettled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventAuctionSettled) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "filespacechain.filespacechain.EventAuctionSettled.inquiryId":
+		value := x.InquiryId
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.EventAuctionSettled.clearingPrice":
+		value := x.ClearingPrice
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "filespacechain.filespacechain.EventAuctionSettled.winningOfferIds":
+		if len(x.WinningOfferIds) == 0 {
+			return protoreflect.ValueOfList(&_EventAuctionSettled_3_list{})
+		}
+		listValue := &_EventAuctionSettled_3_list{list: &x.WinningOfferIds}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.EventAuctionSettled.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventAuctionSettled"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventAuctionSettled does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAuctionSettled) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventAuctionSettled.inquiryId":
+		x.InquiryId = value.Uint()
+	case "filespacechain.filespacechain.EventAuctionSettled.clearingPrice":
+		x.ClearingPrice = value.Message().Interface().(*v1beta1.Coin)
+	case "filespacechain.filespacechain.EventAuctionSettled.winningOfferIds":
+		lv := value.List()
+		clv := lv.(*_EventAuctionSettled_3_list)
+		x.WinningOfferIds = *clv.list
+	case "filespacechain.filespacechain.EventAuctionSettled.height":
+		x.Height = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventAuctionSettled"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventAuctionSettled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAuctionSettled) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventAuctionSettled.clearingPrice":
+		if x.ClearingPrice == nil {
+			x.ClearingPrice = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.ClearingPrice.ProtoReflect())
+	case "filespacechain.filespacechain.EventAuctionSettled.winningOfferIds":
+		if x.WinningOfferIds == nil {
+			x.WinningOfferIds = []uint64{}
+		}
+		value := &_EventAuctionSettled_3_list{list: &x.WinningOfferIds}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.EventAuctionSettled.inquiryId":
+		panic(fmt.Errorf("field inquiryId of message filespacechain.filespacechain.EventAuctionSettled is not mutable"))
+	case "filespacechain.filespacechain.EventAuctionSettled.height":
+		panic(fmt.Errorf("field height of message filespacechain.filespacechain.EventAuctionSettled is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventAuctionSettled"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventAuctionSettled does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventAuctionSettled) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventAuctionSettled.inquiryId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.EventAuctionSettled.clearingPrice":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "filespacechain.filespacechain.EventAuctionSettled.winningOfferIds":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_EventAuctionSettled_3_list{list: &list})
+	case "filespacechain.filespacechain.EventAuctionSettled.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventAuctionSettled"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventAuctionSettled does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventAuctionSettled) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in filespacechain.filespacechain.EventAuctionSettled", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventAuctionSettled) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAuctionSettled) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventAuctionSettled) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventAuctionSettled) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventAuctionSettled)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.InquiryId != 0 {
+			n += 1 + runtime.Sov(uint64(x.InquiryId))
+		}
+		if x.ClearingPrice != nil {
+			l = options.Size(x.ClearingPrice)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.WinningOfferIds) > 0 {
+			l = 0
+			for _, e := range x.WinningOfferIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventAuctionSettled)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.WinningOfferIds) > 0 {
+			var pksize2 int
+			for _, num := range x.WinningOfferIds {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.WinningOfferIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ClearingPrice != nil {
+			encoded, err := options.Marshal(x.ClearingPrice)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.InquiryId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InquiryId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventAuctionSettled)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventAuctionSettled: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventAuctionSettled: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+				}
+				x.InquiryId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InquiryId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClearingPrice", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ClearingPrice == nil {
+					x.ClearingPrice = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ClearingPrice); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.WinningOfferIds = append(x.WinningOfferIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.WinningOfferIds) == 0 {
+						x.WinningOfferIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.WinningOfferIds = append(x.WinningOfferIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WinningOfferIds", wireType)
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventProviderReputationChanged          protoreflect.MessageDescriptor
+	fd_EventProviderReputationChanged_provider protoreflect.FieldDescriptor
+	fd_EventProviderReputationChanged_event    protoreflect.FieldDescriptor
+	fd_EventProviderReputationChanged_score    protoreflect.FieldDescriptor
+	fd_EventProviderReputationChanged_height   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_filespacechain_filespacechain_events_proto_init()
+	md_EventProviderReputationChanged = File_filespacechain_filespacechain_events_proto.Messages().ByName("EventProviderReputationChanged")
+	fd_EventProviderReputationChanged_provider = md_EventProviderReputationChanged.Fields().ByName("provider")
+	fd_EventProviderReputationChanged_event = md_EventProviderReputationChanged.Fields().ByName("event")
+	fd_EventProviderReputationChanged_score = md_EventProviderReputationChanged.Fields().ByName("score")
+	fd_EventProviderReputationChanged_height = md_EventProviderReputationChanged.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventProviderReputationChanged)(nil)
+
+type fastReflection_EventProviderReputationChanged EventProviderReputationChanged
+
+func (x *EventProviderReputationChanged) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventProviderReputationChanged)(x)
+}
+
+func (x *EventProviderReputationChanged) slowProtoReflect() protoreflect.Message {
+	mi := &file_filespacechain_filespacechain_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventProviderReputationChanged_messageType fastReflection_EventProviderReputationChanged_messageType
+var _ protoreflect.MessageType = fastReflection_EventProviderReputationChanged_messageType{}
+
+type fastReflection_EventProviderReputationChanged_messageType struct{}
+
+func (x fastReflection_EventProviderReputationChanged_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventProviderReputationChanged)(nil)
+}
+func (x fastReflection_EventProviderReputationChanged_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventProviderReputationChanged)
+}
+func (x fastReflection_EventProviderReputationChanged_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventProviderReputationChanged
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventProviderReputationChanged) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventProviderReputationChanged
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventProviderReputationChanged) Type() protoreflect.MessageType {
+	return _fastReflection_EventProviderReputationChanged_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventProviderReputationChanged) New() protoreflect.Message {
+	return new(fastReflection_EventProviderReputationChanged)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventProviderReputationChanged) Interface() protoreflect.ProtoMessage {
+	return (*EventProviderReputationChanged)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventProviderReputationChanged) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Provider != "" {
+		value := protoreflect.ValueOfString(x.Provider)
+		if !f(fd_EventProviderReputationChanged_provider, value) {
+			return
+		}
+	}
+	if x.Event != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Event))
+		if !f(fd_EventProviderReputationChanged_event, value) {
+			return
+		}
+	}
+	if x.Score != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Score)
+		if !f(fd_EventProviderReputationChanged_score, value) {
+			return
+		}
+	}
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_EventProviderReputationChanged_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventProviderReputationChanged) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventProviderReputationChanged.provider":
+		return x.Provider != ""
+	case "filespacechain.filespacechain.EventProviderReputationChanged.event":
+		return x.Event != 0
+	case "filespacechain.filespacechain.EventProviderReputationChanged.score":
+		return x.Score != uint64(0)
+	case "filespacechain.filespacechain.EventProviderReputationChanged.height":
+		return x.Height != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventProviderReputationChanged"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventProviderReputationChanged does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventProviderReputationChanged) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventProviderReputationChanged.provider":
+		x.Provider = ""
+	case "filespacechain.filespacechain.EventProviderReputationChanged.event":
+		x.Event = 0
+	case "filespacechain.filespacechain.EventProviderReputationChanged.score":
+		x.Score = uint64(0)
+	case "filespacechain.filespacechain.EventProviderReputationChanged.height":
+		x.Height = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventProviderReputationChanged"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventProviderReputationChanged does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventProviderReputationChanged) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "filespacechain.filespacechain.EventProviderReputationChanged.provider":
+		value := x.Provider
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.EventProviderReputationChanged.event":
+		value := x.Event
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "filespacechain.filespacechain.EventProviderReputationChanged.score":
+		value := x.Score
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.EventProviderReputationChanged.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventProviderReputationChanged"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventProviderReputationChanged does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventProviderReputationChanged) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventProviderReputationChanged.provider":
+		x.Provider = value.Interface().(string)
+	case "filespacechain.filespacechain.EventProviderReputationChanged.event":
+		x.Event = (ReputationEvent)(value.Enum())
+	case "filespacechain.filespacechain.EventProviderReputationChanged.score":
+		x.Score = value.Uint()
+	case "filespacechain.filespacechain.EventProviderReputationChanged.height":
+		x.Height = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventProviderReputationChanged"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventProviderReputationChanged does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventProviderReputationChanged) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventProviderReputationChanged.provider":
+		panic(fmt.Errorf("field provider of message filespacechain.filespacechain.EventProviderReputationChanged is not mutable"))
+	case "filespacechain.filespacechain.EventProviderReputationChanged.event":
+		panic(fmt.Errorf("field event of message filespacechain.filespacechain.EventProviderReputationChanged is not mutable"))
+	case "filespacechain.filespacechain.EventProviderReputationChanged.score":
+		panic(fmt.Errorf("field score of message filespacechain.filespacechain.EventProviderReputationChanged is not mutable"))
+	case "filespacechain.filespacechain.EventProviderReputationChanged.height":
+		panic(fmt.Errorf("field height of message filespacechain.filespacechain.EventProviderReputationChanged is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventProviderReputationChanged"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventProviderReputationChanged does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventProviderReputationChanged) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventProviderReputationChanged.provider":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.EventProviderReputationChanged.event":
+		return protoreflect.ValueOfEnum(0)
+	case "filespacechain.filespacechain.EventProviderReputationChanged.score":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.EventProviderReputationChanged.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventProviderReputationChanged"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventProviderReputationChanged does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventProviderReputationChanged) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in filespacechain.filespacechain.EventProviderReputationChanged", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventProviderReputationChanged) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventProviderReputationChanged) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventProviderReputationChanged) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventProviderReputationChanged) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventProviderReputationChanged)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Event != 0 {
+			n += 1 + runtime.Sov(uint64(x.Event))
+		}
+		if x.Score != 0 {
+			n += 1 + runtime.Sov(uint64(x.Score))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventProviderReputationChanged)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Score != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Score))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Event != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Event))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventProviderReputationChanged)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventProviderReputationChanged: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventProviderReputationChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				}
+				x.Event = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Event |= ReputationEvent(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+				}
+				x.Score = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Score |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventPaymentDustSwept             protoreflect.MessageDescriptor
+	fd_EventPaymentDustSwept_inquiryId   protoreflect.FieldDescriptor
+	fd_EventPaymentDustSwept_destination protoreflect.FieldDescriptor
+	fd_EventPaymentDustSwept_recipient   protoreflect.FieldDescriptor
+	fd_EventPaymentDustSwept_amount      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_filespacechain_filespacechain_events_proto_init()
+	md_EventPaymentDustSwept = File_filespacechain_filespacechain_events_proto.Messages().ByName("EventPaymentDustSwept")
+	fd_EventPaymentDustSwept_inquiryId = md_EventPaymentDustSwept.Fields().ByName("inquiryId")
+	fd_EventPaymentDustSwept_destination = md_EventPaymentDustSwept.Fields().ByName("destination")
+	fd_EventPaymentDustSwept_recipient = md_EventPaymentDustSwept.Fields().ByName("recipient")
+	fd_EventPaymentDustSwept_amount = md_EventPaymentDustSwept.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventPaymentDustSwept)(nil)
+
+type fastReflection_EventPaymentDustSwept EventPaymentDustSwept
+
+func (x *EventPaymentDustSwept) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventPaymentDustSwept)(x)
+}
+
+func (x *EventPaymentDustSwept) slowProtoReflect() protoreflect.Message {
+	mi := &file_filespacechain_filespacechain_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventPaymentDustSwept_messageType fastReflection_EventPaymentDustSwept_messageType
+var _ protoreflect.MessageType = fastReflection_EventPaymentDustSwept_messageType{}
+
+type fastReflection_EventPaymentDustSwept_messageType struct{}
+
+func (x fastReflection_EventPaymentDustSwept_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventPaymentDustSwept)(nil)
+}
+func (x fastReflection_EventPaymentDustSwept_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventPaymentDustSwept)
+}
+func (x fastReflection_EventPaymentDustSwept_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventPaymentDustSwept
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventPaymentDustSwept) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventPaymentDustSwept
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventPaymentDustSwept) Type() protoreflect.MessageType {
+	return _fastReflection_EventPaymentDustSwept_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventPaymentDustSwept) New() protoreflect.Message {
+	return new(fastReflection_EventPaymentDustSwept)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventPaymentDustSwept) Interface() protoreflect.ProtoMessage {
+	return (*EventPaymentDustSwept)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventPaymentDustSwept) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InquiryId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InquiryId)
+		if !f(fd_EventPaymentDustSwept_inquiryId, value) {
+			return
+		}
+	}
+	if x.Destination != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Destination))
+		if !f(fd_EventPaymentDustSwept_destination, value) {
+			return
+		}
+	}
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_EventPaymentDustSwept_recipient, value) {
+			return
+		}
+	}
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_EventPaymentDustSwept_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventPaymentDustSwept) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventPaymentDustSwept.inquiryId":
+		return x.InquiryId != uint64(0)
+	case "filespacechain.filespacechain.EventPaymentDustSwept.destination":
+		return x.Destination != 0
+	case "filespacechain.filespacechain.EventPaymentDustSwept.recipient":
+		return x.Recipient != ""
+	case "filespacechain.filespacechain.EventPaymentDustSwept.amount":
+		return x.Amount != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventPaymentDustSwept"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventPaymentDustSwept does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventPaymentDustSwept) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventPaymentDustSwept.inquiryId":
+		x.InquiryId = uint64(0)
+	case "filespacechain.filespacechain.EventPaymentDustSwept.destination":
+		x.Destination = 0
+	case "filespacechain.filespacechain.EventPaymentDustSwept.recipient":
+		x.Recipient = ""
+	case "filespacechain.filespacechain.EventPaymentDustSwept.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventPaymentDustSwept"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventPaymentDustSwept does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventPaymentDustSwept) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "filespacechain.filespacechain.EventPaymentDustSwept.inquiryId":
+		value := x.InquiryId
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.EventPaymentDustSwept.destination":
+		value := x.Destination
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "filespacechain.filespacechain.EventPaymentDustSwept.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.EventPaymentDustSwept.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventPaymentDustSwept"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventPaymentDustSwept does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventPaymentDustSwept) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventPaymentDustSwept.inquiryId":
+		x.InquiryId = value.Uint()
+	case "filespacechain.filespacechain.EventPaymentDustSwept.destination":
+		x.Destination = (DustDestination)(value.Enum())
+	case "filespacechain.filespacechain.EventPaymentDustSwept.recipient":
+		x.Recipient = value.Interface().(string)
+	case "filespacechain.filespacechain.EventPaymentDustSwept.amount":
+		x.Amount = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventPaymentDustSwept"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventPaymentDustSwept does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventPaymentDustSwept) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventPaymentDustSwept.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "filespacechain.filespacechain.EventPaymentDustSwept.inquiryId":
+		panic(fmt.Errorf("field inquiryId of message filespacechain.filespacechain.EventPaymentDustSwept is not mutable"))
+	case "filespacechain.filespacechain.EventPaymentDustSwept.destination":
+		panic(fmt.Errorf("field destination of message filespacechain.filespacechain.EventPaymentDustSwept is not mutable"))
+	case "filespacechain.filespacechain.EventPaymentDustSwept.recipient":
+		panic(fmt.Errorf("field recipient of message filespacechain.filespacechain.EventPaymentDustSwept is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventPaymentDustSwept"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventPaymentDustSwept does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventPaymentDustSwept) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventPaymentDustSwept.inquiryId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.EventPaymentDustSwept.destination":
+		return protoreflect.ValueOfEnum(0)
+	case "filespacechain.filespacechain.EventPaymentDustSwept.recipient":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.EventPaymentDustSwept.amount":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventPaymentDustSwept"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventPaymentDustSwept does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventPaymentDustSwept) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in filespacechain.filespacechain.EventPaymentDustSwept", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventPaymentDustSwept) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventPaymentDustSwept) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventPaymentDustSwept) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventPaymentDustSwept) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventPaymentDustSwept)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.InquiryId != 0 {
+			n += 1 + runtime.Sov(uint64(x.InquiryId))
+		}
+		if x.Destination != 0 {
+			n += 1 + runtime.Sov(uint64(x.Destination))
+		}
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventPaymentDustSwept)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Destination != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Destination))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.InquiryId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InquiryId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventPaymentDustSwept)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventPaymentDustSwept: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventPaymentDustSwept: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+				}
+				x.InquiryId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InquiryId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				}
+				x.Destination = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Destination |= DustDestination(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventContractSurplusRefunded            protoreflect.MessageDescriptor
+	fd_EventContractSurplusRefunded_contractId protoreflect.FieldDescriptor
+	fd_EventContractSurplusRefunded_inquiryId  protoreflect.FieldDescriptor
+	fd_EventContractSurplusRefunded_creator    protoreflect.FieldDescriptor
+	fd_EventContractSurplusRefunded_amount     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_filespacechain_filespacechain_events_proto_init()
+	md_EventContractSurplusRefunded = File_filespacechain_filespacechain_events_proto.Messages().ByName("EventContractSurplusRefunded")
+	fd_EventContractSurplusRefunded_contractId = md_EventContractSurplusRefunded.Fields().ByName("contractId")
+	fd_EventContractSurplusRefunded_inquiryId = md_EventContractSurplusRefunded.Fields().ByName("inquiryId")
+	fd_EventContractSurplusRefunded_creator = md_EventContractSurplusRefunded.Fields().ByName("creator")
+	fd_EventContractSurplusRefunded_amount = md_EventContractSurplusRefunded.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventContractSurplusRefunded)(nil)
+
+type fastReflection_EventContractSurplusRefunded EventContractSurplusRefunded
+
+func (x *EventContractSurplusRefunded) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventContractSurplusRefunded)(x)
+}
+
+func (x *EventContractSurplusRefunded) slowProtoReflect() protoreflect.Message {
+	mi := &file_filespacechain_filespacechain_events_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventContractSurplusRefunded_messageType fastReflection_EventContractSurplusRefunded_messageType
+var _ protoreflect.MessageType = fastReflection_EventContractSurplusRefunded_messageType{}
+
+type fastReflection_EventContractSurplusRefunded_messageType struct{}
+
+func (x fastReflection_EventContractSurplusRefunded_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventContractSurplusRefunded)(nil)
+}
+func (x fastReflection_EventContractSurplusRefunded_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventContractSurplusRefunded)
+}
+func (x fastReflection_EventContractSurplusRefunded_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventContractSurplusRefunded
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventContractSurplusRefunded) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventContractSurplusRefunded
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventContractSurplusRefunded) Type() protoreflect.MessageType {
+	return _fastReflection_EventContractSurplusRefunded_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventContractSurplusRefunded) New() protoreflect.Message {
+	return new(fastReflection_EventContractSurplusRefunded)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventContractSurplusRefunded) Interface() protoreflect.ProtoMessage {
+	return (*EventContractSurplusRefunded)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventContractSurplusRefunded) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_EventContractSurplusRefunded_contractId, value) {
+			return
+		}
+	}
+	if x.InquiryId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InquiryId)
+		if !f(fd_EventContractSurplusRefunded_inquiryId, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_EventContractSurplusRefunded_creator, value) {
+			return
+		}
+	}
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_EventContractSurplusRefunded_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventContractSurplusRefunded) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.contractId":
+		return x.ContractId != uint64(0)
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.inquiryId":
+		return x.InquiryId != uint64(0)
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.creator":
+		return x.Creator != ""
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.amount":
+		return x.Amount != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventContractSurplusRefunded"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventContractSurplusRefunded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractSurplusRefunded) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.contractId":
+		x.ContractId = uint64(0)
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.inquiryId":
+		x.InquiryId = uint64(0)
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.creator":
+		x.Creator = ""
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventContractSurplusRefunded"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventContractSurplusRefunded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventContractSurplusRefunded) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.contractId":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.inquiryId":
+		value := x.InquiryId
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventContractSurplusRefunded"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventContractSurplusRefunded does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractSurplusRefunded) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.contractId":
+		x.ContractId = value.Uint()
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.inquiryId":
+		x.InquiryId = value.Uint()
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.creator":
+		x.Creator = value.Interface().(string)
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.amount":
+		x.Amount = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventContractSurplusRefunded"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventContractSurplusRefunded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractSurplusRefunded) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.contractId":
+		panic(fmt.Errorf("field contractId of message filespacechain.filespacechain.EventContractSurplusRefunded is not mutable"))
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.inquiryId":
+		panic(fmt.Errorf("field inquiryId of message filespacechain.filespacechain.EventContractSurplusRefunded is not mutable"))
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.creator":
+		panic(fmt.Errorf("field creator of message filespacechain.filespacechain.EventContractSurplusRefunded is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventContractSurplusRefunded"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventContractSurplusRefunded does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventContractSurplusRefunded) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.contractId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.inquiryId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.creator":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.EventContractSurplusRefunded.amount":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventContractSurplusRefunded"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventContractSurplusRefunded does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventContractSurplusRefunded) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in filespacechain.filespacechain.EventContractSurplusRefunded", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventContractSurplusRefunded) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractSurplusRefunded) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventContractSurplusRefunded) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventContractSurplusRefunded) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventContractSurplusRefunded)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		if x.InquiryId != 0 {
+			n += 1 + runtime.Sov(uint64(x.InquiryId))
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventContractSurplusRefunded)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.InquiryId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InquiryId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventContractSurplusRefunded)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventContractSurplusRefunded: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventContractSurplusRefunded: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				}
+				x.ContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+				}
+				x.InquiryId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InquiryId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventHostingInquiryExtended                  protoreflect.MessageDescriptor
+	fd_EventHostingInquiryExtended_inquiryId        protoreflect.FieldDescriptor
+	fd_EventHostingInquiryExtended_creator          protoreflect.FieldDescriptor
+	fd_EventHostingInquiryExtended_previousEndTime  protoreflect.FieldDescriptor
+	fd_EventHostingInquiryExtended_endTime          protoreflect.FieldDescriptor
+	fd_EventHostingInquiryExtended_additionalEscrow protoreflect.FieldDescriptor
+	fd_EventHostingInquiryExtended_escrowAmount     protoreflect.FieldDescriptor
+	fd_EventHostingInquiryExtended_height           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_filespacechain_filespacechain_events_proto_init()
+	md_EventHostingInquiryExtended = File_filespacechain_filespacechain_events_proto.Messages().ByName("EventHostingInquiryExtended")
+	fd_EventHostingInquiryExtended_inquiryId = md_EventHostingInquiryExtended.Fields().ByName("inquiryId")
+	fd_EventHostingInquiryExtended_creator = md_EventHostingInquiryExtended.Fields().ByName("creator")
+	fd_EventHostingInquiryExtended_previousEndTime = md_EventHostingInquiryExtended.Fields().ByName("previousEndTime")
+	fd_EventHostingInquiryExtended_endTime = md_EventHostingInquiryExtended.Fields().ByName("endTime")
+	fd_EventHostingInquiryExtended_additionalEscrow = md_EventHostingInquiryExtended.Fields().ByName("additionalEscrow")
+	fd_EventHostingInquiryExtended_escrowAmount = md_EventHostingInquiryExtended.Fields().ByName("escrowAmount")
+	fd_EventHostingInquiryExtended_height = md_EventHostingInquiryExtended.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventHostingInquiryExtended)(nil)
+
+type fastReflection_EventHostingInquiryExtended EventHostingInquiryExtended
+
+func (x *EventHostingInquiryExtended) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventHostingInquiryExtended)(x)
+}
+
+func (x *EventHostingInquiryExtended) slowProtoReflect() protoreflect.Message {
+	mi := &file_filespacechain_filespacechain_events_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventHostingInquiryExtended_messageType fastReflection_EventHostingInquiryExtended_messageType
+var _ protoreflect.MessageType = fastReflection_EventHostingInquiryExtended_messageType{}
+
+type fastReflection_EventHostingInquiryExtended_messageType struct{}
+
+func (x fastReflection_EventHostingInquiryExtended_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventHostingInquiryExtended)(nil)
+}
+func (x fastReflection_EventHostingInquiryExtended_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventHostingInquiryExtended)
+}
+func (x fastReflection_EventHostingInquiryExtended_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventHostingInquiryExtended
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventHostingInquiryExtended) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventHostingInquiryExtended
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventHostingInquiryExtended) Type() protoreflect.MessageType {
+	return _fastReflection_EventHostingInquiryExtended_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventHostingInquiryExtended) New() protoreflect.Message {
+	return new(fastReflection_EventHostingInquiryExtended)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventHostingInquiryExtended) Interface() protoreflect.ProtoMessage {
+	return (*EventHostingInquiryExtended)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventHostingInquiryExtended) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InquiryId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InquiryId)
+		if !f(fd_EventHostingInquiryExtended_inquiryId, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_EventHostingInquiryExtended_creator, value) {
+			return
+		}
+	}
+	if x.PreviousEndTime != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PreviousEndTime)
+		if !f(fd_EventHostingInquiryExtended_previousEndTime, value) {
+			return
+		}
+	}
+	if x.EndTime != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EndTime)
+		if !f(fd_EventHostingInquiryExtended_endTime, value) {
+			return
+		}
+	}
+	if x.AdditionalEscrow != nil {
+		value := protoreflect.ValueOfMessage(x.AdditionalEscrow.ProtoReflect())
+		if !f(fd_EventHostingInquiryExtended_additionalEscrow, value) {
+			return
+		}
+	}
+	if x.EscrowAmount != nil {
+		value := protoreflect.ValueOfMessage(x.EscrowAmount.ProtoReflect())
+		if !f(fd_EventHostingInquiryExtended_escrowAmount, value) {
+			return
+		}
+	}
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_EventHostingInquiryExtended_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventHostingInquiryExtended) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.inquiryId":
+		return x.InquiryId != uint64(0)
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.creator":
+		return x.Creator != ""
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.previousEndTime":
+		return x.PreviousEndTime != uint64(0)
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.endTime":
+		return x.EndTime != uint64(0)
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.additionalEscrow":
+		return x.AdditionalEscrow != nil
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.escrowAmount":
+		return x.EscrowAmount != nil
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.height":
+		return x.Height != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingInquiryExtended"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingInquiryExtended does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHostingInquiryExtended) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.inquiryId":
+		x.InquiryId = uint64(0)
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.creator":
+		x.Creator = ""
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.previousEndTime":
+		x.PreviousEndTime = uint64(0)
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.endTime":
+		x.EndTime = uint64(0)
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.additionalEscrow":
+		x.AdditionalEscrow = nil
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.escrowAmount":
+		x.EscrowAmount = nil
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.height":
+		x.Height = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingInquiryExtended"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingInquiryExtended does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventHostingInquiryExtended) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.inquiryId":
+		value := x.InquiryId
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.previousEndTime":
+		value := x.PreviousEndTime
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.endTime":
+		value := x.EndTime
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.additionalEscrow":
+		value := x.AdditionalEscrow
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.escrowAmount":
+		value := x.EscrowAmount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingInquiryExtended"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingInquiryExtended does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHostingInquiryExtended) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.inquiryId":
+		x.InquiryId = value.Uint()
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.creator":
+		x.Creator = value.Interface().(string)
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.previousEndTime":
+		x.PreviousEndTime = value.Uint()
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.endTime":
+		x.EndTime = value.Uint()
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.additionalEscrow":
+		x.AdditionalEscrow = value.Message().Interface().(*v1beta1.Coin)
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.escrowAmount":
+		x.EscrowAmount = value.Message().Interface().(*v1beta1.Coin)
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.height":
+		x.Height = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingInquiryExtended"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingInquiryExtended does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHostingInquiryExtended) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.additionalEscrow":
+		if x.AdditionalEscrow == nil {
+			x.AdditionalEscrow = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.AdditionalEscrow.ProtoReflect())
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.escrowAmount":
+		if x.EscrowAmount == nil {
+			x.EscrowAmount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.EscrowAmount.ProtoReflect())
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.inquiryId":
+		panic(fmt.Errorf("field inquiryId of message filespacechain.filespacechain.EventHostingInquiryExtended is not mutable"))
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.creator":
+		panic(fmt.Errorf("field creator of message filespacechain.filespacechain.EventHostingInquiryExtended is not mutable"))
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.previousEndTime":
+		panic(fmt.Errorf("field previousEndTime of message filespacechain.filespacechain.EventHostingInquiryExtended is not mutable"))
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.endTime":
+		panic(fmt.Errorf("field endTime of message filespacechain.filespacechain.EventHostingInquiryExtended is not mutable"))
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.height":
+		panic(fmt.Errorf("field height of message filespacechain.filespacechain.EventHostingInquiryExtended is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingInquiryExtended"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingInquiryExtended does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventHostingInquiryExtended) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.inquiryId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.creator":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.previousEndTime":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.endTime":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.additionalEscrow":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.escrowAmount":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "filespacechain.filespacechain.EventHostingInquiryExtended.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingInquiryExtended"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingInquiryExtended does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventHostingInquiryExtended) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in filespacechain.filespacechain.EventHostingInquiryExtended", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventHostingInquiryExtended) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHostingInquiryExtended) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventHostingInquiryExtended) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventHostingInquiryExtended) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventHostingInquiryExtended)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.InquiryId != 0 {
+			n += 1 + runtime.Sov(uint64(x.InquiryId))
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PreviousEndTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.PreviousEndTime))
+		}
+		if x.EndTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.EndTime))
+		}
+		if x.AdditionalEscrow != nil {
+			l = options.Size(x.AdditionalEscrow)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EscrowAmount != nil {
+			l = options.Size(x.EscrowAmount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventHostingInquiryExtended)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.EscrowAmount != nil {
+			encoded, err := options.Marshal(x.EscrowAmount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.AdditionalEscrow != nil {
+			encoded, err := options.Marshal(x.AdditionalEscrow)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.EndTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndTime))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.PreviousEndTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PreviousEndTime))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.InquiryId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InquiryId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventHostingInquiryExtended)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventHostingInquiryExtended: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventHostingInquiryExtended: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+				}
+				x.InquiryId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InquiryId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousEndTime", wireType)
+				}
+				x.PreviousEndTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PreviousEndTime |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				}
+				x.EndTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EndTime |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AdditionalEscrow", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AdditionalEscrow == nil {
+					x.AdditionalEscrow = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AdditionalEscrow); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowAmount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EscrowAmount == nil {
+					x.EscrowAmount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EscrowAmount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventHostingContractReassigned              protoreflect.MessageDescriptor
+	fd_EventHostingContractReassigned_contractId   protoreflect.FieldDescriptor
+	fd_EventHostingContractReassigned_inquiryId    protoreflect.FieldDescriptor
+	fd_EventHostingContractReassigned_fromProvider protoreflect.FieldDescriptor
+	fd_EventHostingContractReassigned_toProvider   protoreflect.FieldDescriptor
+	fd_EventHostingContractReassigned_offerId      protoreflect.FieldDescriptor
+	fd_EventHostingContractReassigned_reason       protoreflect.FieldDescriptor
+	fd_EventHostingContractReassigned_height       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_filespacechain_filespacechain_events_proto_init()
+	md_EventHostingContractReassigned = File_filespacechain_filespacechain_events_proto.Messages().ByName("EventHostingContractReassigned")
+	fd_EventHostingContractReassigned_contractId = md_EventHostingContractReassigned.Fields().ByName("contractId")
+	fd_EventHostingContractReassigned_inquiryId = md_EventHostingContractReassigned.Fields().ByName("inquiryId")
+	fd_EventHostingContractReassigned_fromProvider = md_EventHostingContractReassigned.Fields().ByName("fromProvider")
+	fd_EventHostingContractReassigned_toProvider = md_EventHostingContractReassigned.Fields().ByName("toProvider")
+	fd_EventHostingContractReassigned_offerId = md_EventHostingContractReassigned.Fields().ByName("offerId")
+	fd_EventHostingContractReassigned_reason = md_EventHostingContractReassigned.Fields().ByName("reason")
+	fd_EventHostingContractReassigned_height = md_EventHostingContractReassigned.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventHostingContractReassigned)(nil)
+
+type fastReflection_EventHostingContractReassigned EventHostingContractReassigned
+
+func (x *EventHostingContractReassigned) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventHostingContractReassigned)(x)
+}
+
+func (x *EventHostingContractReassigned) slowProtoReflect() protoreflect.Message {
+	mi := &file_filespacechain_filespacechain_events_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventHostingContractReassigned_messageType fastReflection_EventHostingContractReassigned_messageType
+var _ protoreflect.MessageType = fastReflection_EventHostingContractReassigned_messageType{}
+
+type fastReflection_EventHostingContractReassigned_messageType struct{}
+
+func (x fastReflection_EventHostingContractReassigned_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventHostingContractReassigned)(nil)
+}
+func (x fastReflection_EventHostingContractReassigned_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventHostingContractReassigned)
+}
+func (x fastReflection_EventHostingContractReassigned_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventHostingContractReassigned
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventHostingContractReassigned) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventHostingContractReassigned
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventHostingContractReassigned) Type() protoreflect.MessageType {
+	return _fastReflection_EventHostingContractReassigned_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventHostingContractReassigned) New() protoreflect.Message {
+	return new(fastReflection_EventHostingContractReassigned)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventHostingContractReassigned) Interface() protoreflect.ProtoMessage {
+	return (*EventHostingContractReassigned)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventHostingContractReassigned) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_EventHostingContractReassigned_contractId, value) {
+			return
+		}
+	}
+	if x.InquiryId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InquiryId)
+		if !f(fd_EventHostingContractReassigned_inquiryId, value) {
+			return
+		}
+	}
+	if x.FromProvider != "" {
+		value := protoreflect.ValueOfString(x.FromProvider)
+		if !f(fd_EventHostingContractReassigned_fromProvider, value) {
+			return
+		}
+	}
+	if x.ToProvider != "" {
+		value := protoreflect.ValueOfString(x.ToProvider)
+		if !f(fd_EventHostingContractReassigned_toProvider, value) {
+			return
+		}
+	}
+	if x.OfferId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OfferId)
+		if !f(fd_EventHostingContractReassigned_offerId, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventHostingContractReassigned_reason, value) {
+			return
+		}
+	}
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_EventHostingContractReassigned_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventHostingContractReassigned) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingContractReassigned.contractId":
+		return x.ContractId != uint64(0)
+	case "filespacechain.filespacechain.EventHostingContractReassigned.inquiryId":
+		return x.InquiryId != uint64(0)
+	case "filespacechain.filespacechain.EventHostingContractReassigned.fromProvider":
+		return x.FromProvider != ""
+	case "filespacechain.filespacechain.EventHostingContractReassigned.toProvider":
+		return x.ToProvider != ""
+	case "filespacechain.filespacechain.EventHostingContractReassigned.offerId":
+		return x.OfferId != uint64(0)
+	case "filespacechain.filespacechain.EventHostingContractReassigned.reason":
+		return x.Reason != ""
+	case "filespacechain.filespacechain.EventHostingContractReassigned.height":
+		return x.Height != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingContractReassigned"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingContractReassigned does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHostingContractReassigned) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingContractReassigned.contractId":
+		x.ContractId = uint64(0)
+	case "filespacechain.filespacechain.EventHostingContractReassigned.inquiryId":
+		x.InquiryId = uint64(0)
+	case "filespacechain.filespacechain.EventHostingContractReassigned.fromProvider":
+		x.FromProvider = ""
+	case "filespacechain.filespacechain.EventHostingContractReassigned.toProvider":
+		x.ToProvider = ""
+	case "filespacechain.filespacechain.EventHostingContractReassigned.offerId":
+		x.OfferId = uint64(0)
+	case "filespacechain.filespacechain.EventHostingContractReassigned.reason":
+		x.Reason = ""
+	case "filespacechain.filespacechain.EventHostingContractReassigned.height":
+		x.Height = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingContractReassigned"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingContractReassigned does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventHostingContractReassigned) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "filespacechain.filespacechain.EventHostingContractReassigned.contractId":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.EventHostingContractReassigned.inquiryId":
+		value := x.InquiryId
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.EventHostingContractReassigned.fromProvider":
+		value := x.FromProvider
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.EventHostingContractReassigned.toProvider":
+		value := x.ToProvider
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.EventHostingContractReassigned.offerId":
+		value := x.OfferId
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.EventHostingContractReassigned.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.EventHostingContractReassigned.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingContractReassigned"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingContractReassigned does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHostingContractReassigned) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingContractReassigned.contractId":
+		x.ContractId = value.Uint()
+	case "filespacechain.filespacechain.EventHostingContractReassigned.inquiryId":
+		x.InquiryId = value.Uint()
+	case "filespacechain.filespacechain.EventHostingContractReassigned.fromProvider":
+		x.FromProvider = value.Interface().(string)
+	case "filespacechain.filespacechain.EventHostingContractReassigned.toProvider":
+		x.ToProvider = value.Interface().(string)
+	case "filespacechain.filespacechain.EventHostingContractReassigned.offerId":
+		x.OfferId = value.Uint()
+	case "filespacechain.filespacechain.EventHostingContractReassigned.reason":
+		x.Reason = value.Interface().(string)
+	case "filespacechain.filespacechain.EventHostingContractReassigned.height":
+		x.Height = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingContractReassigned"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingContractReassigned does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHostingContractReassigned) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingContractReassigned.contractId":
+		panic(fmt.Errorf("field contractId of message filespacechain.filespacechain.EventHostingContractReassigned is not mutable"))
+	case "filespacechain.filespacechain.EventHostingContractReassigned.inquiryId":
+		panic(fmt.Errorf("field inquiryId of message filespacechain.filespacechain.EventHostingContractReassigned is not mutable"))
+	case "filespacechain.filespacechain.EventHostingContractReassigned.fromProvider":
+		panic(fmt.Errorf("field fromProvider of message filespacechain.filespacechain.EventHostingContractReassigned is not mutable"))
+	case "filespacechain.filespacechain.EventHostingContractReassigned.toProvider":
+		panic(fmt.Errorf("field toProvider of message filespacechain.filespacechain.EventHostingContractReassigned is not mutable"))
+	case "filespacechain.filespacechain.EventHostingContractReassigned.offerId":
+		panic(fmt.Errorf("field offerId of message filespacechain.filespacechain.EventHostingContractReassigned is not mutable"))
+	case "filespacechain.filespacechain.EventHostingContractReassigned.reason":
+		panic(fmt.Errorf("field reason of message filespacechain.filespacechain.EventHostingContractReassigned is not mutable"))
+	case "filespacechain.filespacechain.EventHostingContractReassigned.height":
+		panic(fmt.Errorf("field height of message filespacechain.filespacechain.EventHostingContractReassigned is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingContractReassigned"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingContractReassigned does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventHostingContractReassigned) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingContractReassigned.contractId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.EventHostingContractReassigned.inquiryId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.EventHostingContractReassigned.fromProvider":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.EventHostingContractReassigned.toProvider":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.EventHostingContractReassigned.offerId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.EventHostingContractReassigned.reason":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.EventHostingContractReassigned.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingContractReassigned"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingContractReassigned does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventHostingContractReassigned) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in filespacechain.filespacechain.EventHostingContractReassigned", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventHostingContractReassigned) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHostingContractReassigned) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventHostingContractReassigned) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventHostingContractReassigned) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventHostingContractReassigned)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		if x.InquiryId != 0 {
+			n += 1 + runtime.Sov(uint64(x.InquiryId))
+		}
+		l = len(x.FromProvider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ToProvider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OfferId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OfferId))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventHostingContractReassigned)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.OfferId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OfferId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.ToProvider) > 0 {
+			i -= len(x.ToProvider)
+			copy(dAtA[i:], x.ToProvider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToProvider)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.FromProvider) > 0 {
+			i -= len(x.FromProvider)
+			copy(dAtA[i:], x.FromProvider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FromProvider)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.InquiryId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InquiryId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventHostingContractReassigned)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventHostingContractReassigned: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventHostingContractReassigned: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				}
+				x.ContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+				}
+				x.InquiryId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InquiryId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromProvider", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FromProvider = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToProvider", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ToProvider = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+				}
+				x.OfferId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OfferId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventHostingContractTerminated_7_list)(nil)
+
+type _EventHostingContractTerminated_7_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_EventHostingContractTerminated_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventHostingContractTerminated_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventHostingContractTerminated_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventHostingContractTerminated_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventHostingContractTerminated_7_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventHostingContractTerminated_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventHostingContractTerminated_7_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventHostingContractTerminated_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventHostingContractTerminated              protoreflect.MessageDescriptor
+	fd_EventHostingContractTerminated_contractId   protoreflect.FieldDescriptor
+	fd_EventHostingContractTerminated_inquiryId    protoreflect.FieldDescriptor
+	fd_EventHostingContractTerminated_provider     protoreflect.FieldDescriptor
+	fd_EventHostingContractTerminated_terminatedBy protoreflect.FieldDescriptor
+	fd_EventHostingContractTerminated_settled      protoreflect.FieldDescriptor
+	fd_EventHostingContractTerminated_penalty      protoreflect.FieldDescriptor
+	fd_EventHostingContractTerminated_slashed      protoreflect.FieldDescriptor
+	fd_EventHostingContractTerminated_refunded     protoreflect.FieldDescriptor
+	fd_EventHostingContractTerminated_reason       protoreflect.FieldDescriptor
+	fd_EventHostingContractTerminated_height       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_filespacechain_filespacechain_events_proto_init()
+	md_EventHostingContractTerminated = File_filespacechain_filespacechain_events_proto.Messages().ByName("EventHostingContractTerminated")
+	fd_EventHostingContractTerminated_contractId = md_EventHostingContractTerminated.Fields().ByName("contractId")
+	fd_EventHostingContractTerminated_inquiryId = md_EventHostingContractTerminated.Fields().ByName("inquiryId")
+	fd_EventHostingContractTerminated_provider = md_EventHostingContractTerminated.Fields().ByName("provider")
+	fd_EventHostingContractTerminated_terminatedBy = md_EventHostingContractTerminated.Fields().ByName("terminatedBy")
+	fd_EventHostingContractTerminated_settled = md_EventHostingContractTerminated.Fields().ByName("settled")
+	fd_EventHostingContractTerminated_penalty = md_EventHostingContractTerminated.Fields().ByName("penalty")
+	fd_EventHostingContractTerminated_slashed = md_EventHostingContractTerminated.Fields().ByName("slashed")
+	fd_EventHostingContractTerminated_refunded = md_EventHostingContractTerminated.Fields().ByName("refunded")
+	fd_EventHostingContractTerminated_reason = md_EventHostingContractTerminated.Fields().ByName("reason")
+	fd_EventHostingContractTerminated_height = md_EventHostingContractTerminated.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventHostingContractTerminated)(nil)
+
+type fastReflection_EventHostingContractTerminated EventHostingContractTerminated
+
+func (x *EventHostingContractTerminated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventHostingContractTerminated)(x)
+}
+
+func (x *EventHostingContractTerminated) slowProtoReflect() protoreflect.Message {
+	mi := &file_filespacechain_filespacechain_events_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventHostingContractTerminated_messageType fastReflection_EventHostingContractTerminated_messageType
+var _ protoreflect.MessageType = fastReflection_EventHostingContractTerminated_messageType{}
+
+type fastReflection_EventHostingContractTerminated_messageType struct{}
+
+func (x fastReflection_EventHostingContractTerminated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventHostingContractTerminated)(nil)
+}
+func (x fastReflection_EventHostingContractTerminated_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventHostingContractTerminated)
+}
+func (x fastReflection_EventHostingContractTerminated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventHostingContractTerminated
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventHostingContractTerminated) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventHostingContractTerminated
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventHostingContractTerminated) Type() protoreflect.MessageType {
+	return _fastReflection_EventHostingContractTerminated_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventHostingContractTerminated) New() protoreflect.Message {
+	return new(fastReflection_EventHostingContractTerminated)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventHostingContractTerminated) Interface() protoreflect.ProtoMessage {
+	return (*EventHostingContractTerminated)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventHostingContractTerminated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_EventHostingContractTerminated_contractId, value) {
+			return
+		}
+	}
+	if x.InquiryId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InquiryId)
+		if !f(fd_EventHostingContractTerminated_inquiryId, value) {
+			return
+		}
+	}
+	if x.Provider != "" {
+		value := protoreflect.ValueOfString(x.Provider)
+		if !f(fd_EventHostingContractTerminated_provider, value) {
+			return
+		}
+	}
+	if x.TerminatedBy != "" {
+		value := protoreflect.ValueOfString(x.TerminatedBy)
+		if !f(fd_EventHostingContractTerminated_terminatedBy, value) {
+			return
+		}
+	}
+	if x.Settled != nil {
+		value := protoreflect.ValueOfMessage(x.Settled.ProtoReflect())
+		if !f(fd_EventHostingContractTerminated_settled, value) {
+			return
+		}
+	}
+	if x.Penalty != nil {
+		value := protoreflect.ValueOfMessage(x.Penalty.ProtoReflect())
+		if !f(fd_EventHostingContractTerminated_penalty, value) {
+			return
+		}
+	}
+	if len(x.Slashed) != 0 {
+		value := protoreflect.ValueOfList(&_EventHostingContractTerminated_7_list{list: &x.Slashed})
+		if !f(fd_EventHostingContractTerminated_slashed, value) {
+			return
+		}
+	}
+	if x.Refunded != nil {
+		value := protoreflect.ValueOfMessage(x.Refunded.ProtoReflect())
+		if !f(fd_EventHostingContractTerminated_refunded, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventHostingContractTerminated_reason, value) {
+			return
+		}
+	}
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_EventHostingContractTerminated_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventHostingContractTerminated) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingContractTerminated.contractId":
+		return x.ContractId != uint64(0)
+	case "filespacechain.filespacechain.EventHostingContractTerminated.inquiryId":
+		return x.InquiryId != uint64(0)
+	case "filespacechain.filespacechain.EventHostingContractTerminated.provider":
+		return x.Provider != ""
+	case "filespacechain.filespacechain.EventHostingContractTerminated.terminatedBy":
+		return x.TerminatedBy != ""
+	case "filespacechain.filespacechain.EventHostingContractTerminated.settled":
+		return x.Settled != nil
+	case "filespacechain.filespacechain.EventHostingContractTerminated.penalty":
+		return x.Penalty != nil
+	case "filespacechain.filespacechain.EventHostingContractTerminated.slashed":
+		return len(x.Slashed) != 0
+	case "filespacechain.filespacechain.EventHostingContractTerminated.refunded":
+		return x.Refunded != nil
+	case "filespacechain.filespacechain.EventHostingContractTerminated.reason":
+		return x.Reason != ""
+	case "filespacechain.filespacechain.EventHostingContractTerminated.height":
+		return x.Height != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingContractTerminated"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingContractTerminated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHostingContractTerminated) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingContractTerminated.contractId":
+		x.ContractId = uint64(0)
+	case "filespacechain.filespacechain.EventHostingContractTerminated.inquiryId":
+		x.InquiryId = uint64(0)
+	case "filespacechain.filespacechain.EventHostingContractTerminated.provider":
+		x.Provider = ""
+	case "filespacechain.filespacechain.EventHostingContractTerminated.terminatedBy":
+		x.TerminatedBy = ""
+	case "filespacechain.filespacechain.EventHostingContractTerminated.settled":
+		x.Settled = nil
+	case "filespacechain.filespacechain.EventHostingContractTerminated.penalty":
+		x.Penalty = nil
+	case "filespacechain.filespacechain.EventHostingContractTerminated.slashed":
+		x.Slashed = nil
+	case "filespacechain.filespacechain.EventHostingContractTerminated.refunded":
+		x.Refunded = nil
+	case "filespacechain.filespacechain.EventHostingContractTerminated.reason":
+		x.Reason = ""
+	case "filespacechain.filespacechain.EventHostingContractTerminated.height":
+		x.Height = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingContractTerminated"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingContractTerminated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventHostingContractTerminated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "filespacechain.filespacechain.EventHostingContractTerminated.contractId":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.EventHostingContractTerminated.inquiryId":
+		value := x.InquiryId
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.EventHostingContractTerminated.provider":
+		value := x.Provider
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.EventHostingContractTerminated.terminatedBy":
+		value := x.TerminatedBy
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.EventHostingContractTerminated.settled":
+		value := x.Settled
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "filespacechain.filespacechain.EventHostingContractTerminated.penalty":
+		value := x.Penalty
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "filespacechain.filespacechain.EventHostingContractTerminated.slashed":
+		if len(x.Slashed) == 0 {
+			return protoreflect.ValueOfList(&_EventHostingContractTerminated_7_list{})
+		}
+		listValue := &_EventHostingContractTerminated_7_list{list: &x.Slashed}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.EventHostingContractTerminated.refunded":
+		value := x.Refunded
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "filespacechain.filespacechain.EventHostingContractTerminated.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.EventHostingContractTerminated.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingContractTerminated"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingContractTerminated does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHostingContractTerminated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingContractTerminated.contractId":
+		x.ContractId = value.Uint()
+	case "filespacechain.filespacechain.EventHostingContractTerminated.inquiryId":
+		x.InquiryId = value.Uint()
+	case "filespacechain.filespacechain.EventHostingContractTerminated.provider":
+		x.Provider = value.Interface().(string)
+	case "filespacechain.filespacechain.EventHostingContractTerminated.terminatedBy":
+		x.TerminatedBy = value.Interface().(string)
+	case "filespacechain.filespacechain.EventHostingContractTerminated.settled":
+		x.Settled = value.Message().Interface().(*v1beta1.Coin)
+	case "filespacechain.filespacechain.EventHostingContractTerminated.penalty":
+		x.Penalty = value.Message().Interface().(*v1beta1.Coin)
+	case "filespacechain.filespacechain.EventHostingContractTerminated.slashed":
+		lv := value.List()
+		clv := lv.(*_EventHostingContractTerminated_7_list)
+		x.Slashed = *clv.list
+	case "filespacechain.filespacechain.EventHostingContractTerminated.refunded":
+		x.Refunded = value.Message().Interface().(*v1beta1.Coin)
+	case "filespacechain.filespacechain.EventHostingContractTerminated.reason":
+		x.Reason = value.Interface().(string)
+	case "filespacechain.filespacechain.EventHostingContractTerminated.height":
+		x.Height = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingContractTerminated"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingContractTerminated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHostingContractTerminated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingContractTerminated.settled":
+		if x.Settled == nil {
+			x.Settled = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Settled.ProtoReflect())
+	case "filespacechain.filespacechain.EventHostingContractTerminated.penalty":
+		if x.Penalty == nil {
+			x.Penalty = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Penalty.ProtoReflect())
+	case "filespacechain.filespacechain.EventHostingContractTerminated.slashed":
+		if x.Slashed == nil {
+			x.Slashed = []*v1beta1.Coin{}
+		}
+		value := &_EventHostingContractTerminated_7_list{list: &x.Slashed}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.EventHostingContractTerminated.refunded":
+		if x.Refunded == nil {
+			x.Refunded = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Refunded.ProtoReflect())
+	case "filespacechain.filespacechain.EventHostingContractTerminated.contractId":
+		panic(fmt.Errorf("field contractId of message filespacechain.filespacechain.EventHostingContractTerminated is not mutable"))
+	case "filespacechain.filespacechain.EventHostingContractTerminated.inquiryId":
+		panic(fmt.Errorf("field inquiryId of message filespacechain.filespacechain.EventHostingContractTerminated is not mutable"))
+	case "filespacechain.filespacechain.EventHostingContractTerminated.provider":
+		panic(fmt.Errorf("field provider of message filespacechain.filespacechain.EventHostingContractTerminated is not mutable"))
+	case "filespacechain.filespacechain.EventHostingContractTerminated.terminatedBy":
+		panic(fmt.Errorf("field terminatedBy of message filespacechain.filespacechain.EventHostingContractTerminated is not mutable"))
+	case "filespacechain.filespacechain.EventHostingContractTerminated.reason":
+		panic(fmt.Errorf("field reason of message filespacechain.filespacechain.EventHostingContractTerminated is not mutable"))
+	case "filespacechain.filespacechain.EventHostingContractTerminated.height":
+		panic(fmt.Errorf("field height of message filespacechain.filespacechain.EventHostingContractTerminated is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingContractTerminated"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingContractTerminated does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventHostingContractTerminated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.EventHostingContractTerminated.contractId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.EventHostingContractTerminated.inquiryId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.EventHostingContractTerminated.provider":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.EventHostingContractTerminated.terminatedBy":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.EventHostingContractTerminated.settled":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "filespacechain.filespacechain.EventHostingContractTerminated.penalty":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "filespacechain.filespacechain.EventHostingContractTerminated.slashed":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_EventHostingContractTerminated_7_list{list: &list})
+	case "filespacechain.filespacechain.EventHostingContractTerminated.refunded":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "filespacechain.filespacechain.EventHostingContractTerminated.reason":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.EventHostingContractTerminated.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.EventHostingContractTerminated"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.EventHostingContractTerminated does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventHostingContractTerminated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in filespacechain.filespacechain.EventHostingContractTerminated", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventHostingContractTerminated) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHostingContractTerminated) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventHostingContractTerminated) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventHostingContractTerminated) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventHostingContractTerminated)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		if x.InquiryId != 0 {
+			n += 1 + runtime.Sov(uint64(x.InquiryId))
+		}
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TerminatedBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Settled != nil {
+			l = options.Size(x.Settled)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Penalty != nil {
+			l = options.Size(x.Penalty)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Slashed) > 0 {
+			for _, e := range x.Slashed {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Refunded != nil {
+			l = options.Size(x.Refunded)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventHostingContractTerminated)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.Refunded != nil {
+			encoded, err := options.Marshal(x.Refunded)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Slashed) > 0 {
+			for iNdEx := len(x.Slashed) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Slashed[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.Penalty != nil {
+			encoded, err := options.Marshal(x.Penalty)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Settled != nil {
+			encoded, err := options.Marshal(x.Settled)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.TerminatedBy) > 0 {
+			i -= len(x.TerminatedBy)
+			copy(dAtA[i:], x.TerminatedBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TerminatedBy)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.InquiryId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InquiryId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventHostingContractTerminated)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventHostingContractTerminated: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventHostingContractTerminated: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				}
+				x.ContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+				}
+				x.InquiryId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InquiryId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TerminatedBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TerminatedBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Settled", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Settled == nil {
+					x.Settled = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Settled); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Penalty == nil {
+					x.Penalty = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Penalty); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Slashed = append(x.Slashed, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Slashed[len(x.Slashed)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Refunded == nil {
+					x.Refunded = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Refunded); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: filespacechain/filespacechain/events.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventHostingContractStatusChanged is emitted on every contract status
+// transition.
+type EventHostingContractStatusChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractId uint64         `protobuf:"varint,1,opt,name=contractId,proto3" json:"contractId,omitempty"`
+	InquiryId  uint64         `protobuf:"varint,2,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	Provider   string         `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	FromStatus ContractStatus `protobuf:"varint,4,opt,name=fromStatus,proto3,enum=filespacechain.filespacechain.ContractStatus" json:"fromStatus,omitempty"`
+	ToStatus   ContractStatus `protobuf:"varint,5,opt,name=toStatus,proto3,enum=filespacechain.filespacechain.ContractStatus" json:"toStatus,omitempty"`
+	Height     uint64         `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Reason     string         `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *EventHostingContractStatusChanged) Reset() {
+	*x = EventHostingContractStatusChanged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_filespacechain_filespacechain_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventHostingContractStatusChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventHostingContractStatusChanged) ProtoMessage() {}
+
+// Deprecated: Use EventHostingContractStatusChanged.ProtoReflect.Descriptor instead.
+func (*EventHostingContractStatusChanged) Descriptor() ([]byte, []int) {
+	return file_filespacechain_filespacechain_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventHostingContractStatusChanged) GetContractId() uint64 {
+	if x != nil {
+		return x.ContractId
+	}
+	return 0
+}
+
+func (x *EventHostingContractStatusChanged) GetInquiryId() uint64 {
+	if x != nil {
+		return x.InquiryId
+	}
+	return 0
+}
+
+func (x *EventHostingContractStatusChanged) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *EventHostingContractStatusChanged) GetFromStatus() ContractStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ContractStatus_CONTRACT_STATUS_PENDING
+}
+
+func (x *EventHostingContractStatusChanged) GetToStatus() ContractStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ContractStatus_CONTRACT_STATUS_PENDING
+}
+
+func (x *EventHostingContractStatusChanged) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *EventHostingContractStatusChanged) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// EventHostingOffersMatched is emitted each time offers are matched to an
+// inquiry, listing the matched offers and why every other offer was passed
+// over.
+type EventHostingOffersMatched struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InquiryId       uint64            `protobuf:"varint,1,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	Slots           uint64            `protobuf:"varint,2,opt,name=slots,proto3" json:"slots,omitempty"`
+	MatchedOfferIds []uint64          `protobuf:"varint,3,rep,packed,name=matchedOfferIds,proto3" json:"matchedOfferIds,omitempty"`
+	Rejections      []*OfferRejection `protobuf:"bytes,4,rep,name=rejections,proto3" json:"rejections,omitempty"`
+	Height          uint64            `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *EventHostingOffersMatched) Reset() {
+	*x = EventHostingOffersMatched{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_filespacechain_filespacechain_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventHostingOffersMatched) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventHostingOffersMatched) ProtoMessage() {}
+
+// Deprecated: Use EventHostingOffersMatched.ProtoReflect.Descriptor instead.
+func (*EventHostingOffersMatched) Descriptor() ([]byte, []int) {
+	return file_filespacechain_filespacechain_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventHostingOffersMatched) GetInquiryId() uint64 {
+	if x != nil {
+		return x.InquiryId
+	}
+	return 0
+}
+
+func (x *EventHostingOffersMatched) GetSlots() uint64 {
+	if x != nil {
+		return x.Slots
+	}
+	return 0
+}
+
+func (x *EventHostingOffersMatched) GetMatchedOfferIds() []uint64 {
+	if x != nil {
+		return x.MatchedOfferIds
+	}
+	return nil
+}
+
+func (x *EventHostingOffersMatched) GetRejections() []*OfferRejection {
+	if x != nil {
+		return x.Rejections
+	}
+	return nil
+}
+
+func (x *EventHostingOffersMatched) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// EventAuctionSettled is emitted when the auction of an inquiry settles,
+// listing the offers that won a replica at the clearing price.
+type EventAuctionSettled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InquiryId       uint64        `protobuf:"varint,1,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	ClearingPrice   *v1beta1.Coin `protobuf:"bytes,2,opt,name=clearingPrice,proto3" json:"clearingPrice,omitempty"`
+	WinningOfferIds []uint64      `protobuf:"varint,3,rep,packed,name=winningOfferIds,proto3" json:"winningOfferIds,omitempty"`
+	Height          uint64        `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *EventAuctionSettled) Reset() {
+	*x = EventAuctionSettled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_filespacechain_filespacechain_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventAuctionSettled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventAuctionSettled) ProtoMessage() {}
+
+// Deprecated: Use EventAuctionSettled.ProtoReflect.Descriptor instead.
+func (*EventAuctionSettled) Descriptor() ([]byte, []int) {
+	return file_filespacechain_filespacechain_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventAuctionSettled) GetInquiryId() uint64 {
+	if x != nil {
+		return x.InquiryId
+	}
+	return 0
+}
+
+func (x *EventAuctionSettled) GetClearingPrice() *v1beta1.Coin {
+	if x != nil {
+		return x.ClearingPrice
+	}
+	return nil
+}
+
+func (x *EventAuctionSettled) GetWinningOfferIds() []uint64 {
+	if x != nil {
+		return x.WinningOfferIds
+	}
+	return nil
+}
+
+func (x *EventAuctionSettled) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// EventProviderReputationChanged is emitted each time a contract outcome moves
+// the reputation of a provider.
+type EventProviderReputationChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider string          `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Event    ReputationEvent `protobuf:"varint,2,opt,name=event,proto3,enum=filespacechain.filespacechain.ReputationEvent" json:"event,omitempty"`
+	Score    uint64          `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	Height   uint64          `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *EventProviderReputationChanged) Reset() {
+	*x = EventProviderReputationChanged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_filespacechain_filespacechain_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventProviderReputationChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventProviderReputationChanged) ProtoMessage() {}
+
+// Deprecated: Use EventProviderReputationChanged.ProtoReflect.Descriptor instead.
+func (*EventProviderReputationChanged) Descriptor() ([]byte, []int) {
+	return file_filespacechain_filespacechain_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventProviderReputationChanged) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *EventProviderReputationChanged) GetEvent() ReputationEvent {
+	if x != nil {
+		return x.Event
+	}
+	return ReputationEvent_REPUTATION_EVENT_UNSPECIFIED
+}
+
+func (x *EventProviderReputationChanged) GetScore() uint64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *EventProviderReputationChanged) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// EventPaymentDustSwept is emitted when the part of an expired inquiry's
+// escrow that cannot be split evenly over its replicas is swept to the dust
+// destination.
+type EventPaymentDustSwept struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InquiryId   uint64          `protobuf:"varint,2,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	Destination DustDestination `protobuf:"varint,3,opt,name=destination,proto3,enum=filespacechain.filespacechain.DustDestination" json:"destination,omitempty"`
+	Recipient   string          `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount      *v1beta1.Coin   `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *EventPaymentDustSwept) Reset() {
+	*x = EventPaymentDustSwept{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_filespacechain_filespacechain_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventPaymentDustSwept) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventPaymentDustSwept) ProtoMessage() {}
+
+// Deprecated: Use EventPaymentDustSwept.ProtoReflect.Descriptor instead.
+func (*EventPaymentDustSwept) Descriptor() ([]byte, []int) {
+	return file_filespacechain_filespacechain_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventPaymentDustSwept) GetInquiryId() uint64 {
+	if x != nil {
+		return x.InquiryId
+	}
+	return 0
+}
+
+func (x *EventPaymentDustSwept) GetDestination() DustDestination {
+	if x != nil {
+		return x.Destination
+	}
+	return DustDestination_DUST_DESTINATION_INQUIRY_CREATOR
+}
+
+func (x *EventPaymentDustSwept) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *EventPaymentDustSwept) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// EventContractSurplusRefunded is emitted when a contract settles for less
+// than its share of the escrow and the surplus is refunded to the inquiry
+// creator.
+type EventContractSurplusRefunded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractId uint64        `protobuf:"varint,1,opt,name=contractId,proto3" json:"contractId,omitempty"`
+	InquiryId  uint64        `protobuf:"varint,2,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	Creator    string        `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	Amount     *v1beta1.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *EventContractSurplusRefunded) Reset() {
+	*x = EventContractSurplusRefunded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_filespacechain_filespacechain_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventContractSurplusRefunded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventContractSurplusRefunded) ProtoMessage() {}
+
+// Deprecated: Use EventContractSurplusRefunded.ProtoReflect.Descriptor instead.
+func (*EventContractSurplusRefunded) Descriptor() ([]byte, []int) {
+	return file_filespacechain_filespacechain_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventContractSurplusRefunded) GetContractId() uint64 {
+	if x != nil {
+		return x.ContractId
+	}
+	return 0
+}
+
+func (x *EventContractSurplusRefunded) GetInquiryId() uint64 {
+	if x != nil {
+		return x.InquiryId
+	}
+	return 0
+}
+
+func (x *EventContractSurplusRefunded) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *EventContractSurplusRefunded) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// EventHostingInquiryExtended is emitted when the creator of a running
+// inquiry tops up its escrow and pushes out its end time.
+type EventHostingInquiryExtended struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InquiryId        uint64        `protobuf:"varint,1,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	Creator          string        `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	PreviousEndTime  uint64        `protobuf:"varint,3,opt,name=previousEndTime,proto3" json:"previousEndTime,omitempty"`
+	EndTime          uint64        `protobuf:"varint,4,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	AdditionalEscrow *v1beta1.Coin `protobuf:"bytes,5,opt,name=additionalEscrow,proto3" json:"additionalEscrow,omitempty"`
+	EscrowAmount     *v1beta1.Coin `protobuf:"bytes,6,opt,name=escrowAmount,proto3" json:"escrowAmount,omitempty"`
+	Height           uint64        `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *EventHostingInquiryExtended) Reset() {
+	*x = EventHostingInquiryExtended{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_filespacechain_filespacechain_events_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventHostingInquiryExtended) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventHostingInquiryExtended) ProtoMessage() {}
+
+// Deprecated: Use EventHostingInquiryExtended.ProtoReflect.Descriptor instead.
+func (*EventHostingInquiryExtended) Descriptor() ([]byte, []int) {
+	return file_filespacechain_filespacechain_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EventHostingInquiryExtended) GetInquiryId() uint64 {
+	if x != nil {
+		return x.InquiryId
+	}
+	return 0
+}
+
+func (x *EventHostingInquiryExtended) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *EventHostingInquiryExtended) GetPreviousEndTime() uint64 {
+	if x != nil {
+		return x.PreviousEndTime
+	}
+	return 0
+}
+
+func (x *EventHostingInquiryExtended) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *EventHostingInquiryExtended) GetAdditionalEscrow() *v1beta1.Coin {
+	if x != nil {
+		return x.AdditionalEscrow
+	}
+	return nil
+}
+
+func (x *EventHostingInquiryExtended) GetEscrowAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.EscrowAmount
+	}
+	return nil
+}
+
+func (x *EventHostingInquiryExtended) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// EventHostingContractReassigned is emitted when a contract is handed over to
+// the provider of another offer, by mutual consent or by governance.
+type EventHostingContractReassigned struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractId   uint64 `protobuf:"varint,1,opt,name=contractId,proto3" json:"contractId,omitempty"`
+	InquiryId    uint64 `protobuf:"varint,2,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	FromProvider string `protobuf:"bytes,3,opt,name=fromProvider,proto3" json:"fromProvider,omitempty"`
+	ToProvider   string `protobuf:"bytes,4,opt,name=toProvider,proto3" json:"toProvider,omitempty"`
+	OfferId      uint64 `protobuf:"varint,5,opt,name=offerId,proto3" json:"offerId,omitempty"`
+	Reason       string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Height       uint64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *EventHostingContractReassigned) Reset() {
+	*x = EventHostingContractReassigned{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_filespacechain_filespacechain_events_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventHostingContractReassigned) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventHostingContractReassigned) ProtoMessage() {}
+
+// Deprecated: Use EventHostingContractReassigned.ProtoReflect.Descriptor instead.
+func (*EventHostingContractReassigned) Descriptor() ([]byte, []int) {
+	return file_filespacechain_filespacechain_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EventHostingContractReassigned) GetContractId() uint64 {
+	if x != nil {
+		return x.ContractId
+	}
+	return 0
+}
+
+func (x *EventHostingContractReassigned) GetInquiryId() uint64 {
+	if x != nil {
+		return x.InquiryId
+	}
+	return 0
+}
+
+func (x *EventHostingContractReassigned) GetFromProvider() string {
+	if x != nil {
+		return x.FromProvider
+	}
+	return ""
+}
+
+func (x *EventHostingContractReassigned) GetToProvider() string {
+	if x != nil {
+		return x.ToProvider
+	}
+	return ""
+}
+
+func (x *EventHostingContractReassigned) GetOfferId() uint64 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+func (x *EventHostingContractReassigned) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EventHostingContractReassigned) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// EventHostingContractTerminated is emitted when an active contract is ended
+// early by its provider or by the creator of its inquiry and settled: the
+// provider is paid what it earned, the party walking away pays its penalty
+// and the rest of the contract's escrow share is refunded.
+type EventHostingContractTerminated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractId   uint64        `protobuf:"varint,1,opt,name=contractId,proto3" json:"contractId,omitempty"`
+	InquiryId    uint64        `protobuf:"varint,2,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	Provider     string        `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	TerminatedBy string        `protobuf:"bytes,4,opt,name=terminatedBy,proto3" json:"terminatedBy,omitempty"`
+	Settled      *v1beta1.Coin `protobuf:"bytes,5,opt,name=settled,proto3" json:"settled,omitempty"`
+	// paid to the provider when the inquiry creator walks away
+	Penalty *v1beta1.Coin `protobuf:"bytes,6,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	// slashed from the provider's stake when it walks away
+	Slashed  []*v1beta1.Coin `protobuf:"bytes,7,rep,name=slashed,proto3" json:"slashed,omitempty"`
+	Refunded *v1beta1.Coin   `protobuf:"bytes,8,opt,name=refunded,proto3" json:"refunded,omitempty"`
+	Reason   string          `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	Height   uint64          `protobuf:"varint,10,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *EventHostingContractTerminated) Reset() {
+	*x = EventHostingContractTerminated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_filespacechain_filespacechain_events_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventHostingContractTerminated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventHostingContractTerminated) ProtoMessage() {}
+
+// Deprecated: Use EventHostingContractTerminated.ProtoReflect.Descriptor instead.
+func (*EventHostingContractTerminated) Descriptor() ([]byte, []int) {
+	return file_filespacechain_filespacechain_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EventHostingContractTerminated) GetContractId() uint64 {
+	if x != nil {
+		return x.ContractId
+	}
+	return 0
+}
+
+func (x *EventHostingContractTerminated) GetInquiryId() uint64 {
+	if x != nil {
+		return x.InquiryId
+	}
+	return 0
+}
+
+func (x *EventHostingContractTerminated) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *EventHostingContractTerminated) GetTerminatedBy() string {
+	if x != nil {
+		return x.TerminatedBy
+	}
+	return ""
+}
+
+func (x *EventHostingContractTerminated) GetSettled() *v1beta1.Coin {
+	if x != nil {
+		return x.Settled
+	}
+	return nil
+}
+
+func (x *EventHostingContractTerminated) GetPenalty() *v1beta1.Coin {
+	if x != nil {
+		return x.Penalty
+	}
+	return nil
+}
+
+func (x *EventHostingContractTerminated) GetSlashed() []*v1beta1.Coin {
+	if x != nil {
+		return x.Slashed
+	}
+	return nil
+}
+
+func (x *EventHostingContractTerminated) GetRefunded() *v1beta1.Coin {
+	if x != nil {
+		return x.Refunded
+	}
+	return nil
+}
+
+func (x *EventHostingContractTerminated) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EventHostingContractTerminated) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+var File_filespacechain_filespacechain_events_proto protoreflect.FileDescriptor
+
+var file_filespacechain_filespacechain_events_proto_rawDesc = []byte{
+	0x0a, 0x2a, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x1a, 0x14, 0x67, 0x6f, 0x67,
+	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x34, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x68, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2b, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xc7, 0x02, 0x0a, 0x21, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x71, 0x75,
+	0x69, 0x72, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x69, 0x6e, 0x71,
+	0x75, 0x69, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x4d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x49, 0x0a, 0x08, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x08, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xe6, 0x01, 0x0a,
+	0x19, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e,
+	0x71, 0x75, 0x69, 0x72, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x69,
+	0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x28,
+	0x0a, 0x0f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x53, 0x0a, 0x0a, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0a, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xbc, 0x01, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x0d, 0x63,
+	0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0d, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x77, 0x69, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0xb0, 0x01, 0x0a, 0x1e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xe4, 0x01, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x75, 0x73, 0x74, 0x53, 0x77, 0x65, 0x70,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x49, 0x64, 0x12,
+	0x50, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x75, 0x73, 0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x37, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0xaf,
+	0x01, 0x0a, 0x1c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x53, 0x75, 0x72, 0x70, 0x6c, 0x75, 0x73, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xc3, 0x02, 0x0a, 0x1b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x45, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x10,
+	0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x12, 0x43, 0x0a, 0x0c, 0x65, 0x73, 0x63,
+	0x72, 0x6f, 0x77, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0c, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xec, 0x01, 0x0a, 0x1e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x48, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x71,
+	0x75, 0x69, 0x72, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x69, 0x6e,
+	0x71, 0x75, 0x69, 0x72, 0x79, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66,
+	0x72, 0x6f, 0x6d, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x74,
+	0x6f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x74, 0x6f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x66, 0x66, 0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x66,
+	0x66, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xe8, 0x03, 0x0a, 0x1e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48,
+	0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x71, 0x75,
+	0x69, 0x72, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x69, 0x6e, 0x71,
+	0x75, 0x69, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x64, 0x12, 0x39, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x65, 0x0a, 0x07,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x07, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x42, 0xf5, 0x01, 0x0a, 0x21, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0xa2, 0x02, 0x03, 0x46, 0x46, 0x58, 0xaa, 0x02, 0x1d, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xca, 0x02, 0x1d, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xe2, 0x02, 0x29, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_filespacechain_filespacechain_events_proto_rawDescOnce sync.Once
+	file_filespacechain_filespacechain_events_proto_rawDescData = file_filespacechain_filespacechain_events_proto_rawDesc
+)
+
+func file_filespacechain_filespacechain_events_proto_rawDescGZIP() []byte {
+	file_filespacechain_filespacechain_events_proto_rawDescOnce.Do(func() {
+		file_filespacechain_filespacechain_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_filespacechain_filespacechain_events_proto_rawDescData)
+	})
+	return file_filespacechain_filespacechain_events_proto_rawDescData
+}
+
+var file_filespacechain_filespacechain_events_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_filespacechain_filespacechain_events_proto_goTypes = []interface{}{
+	(*EventHostingContractStatusChanged)(nil), // 0: filespacechain.filespacechain.EventHostingContractStatusChanged
+	(*EventHostingOffersMatched)(nil),         // 1: filespacechain.filespacechain.EventHostingOffersMatched
+	(*EventAuctionSettled)(nil),               // 2: filespacechain.filespacechain.EventAuctionSettled
+	(*EventProviderReputationChanged)(nil),    // 3: filespacechain.filespacechain.EventProviderReputationChanged
+	(*EventPaymentDustSwept)(nil),             // 4: filespacechain.filespacechain.EventPaymentDustSwept
+	(*EventContractSurplusRefunded)(nil),      // 5: filespacechain.filespacechain.EventContractSurplusRefunded
+	(*EventHostingInquiryExtended)(nil),       // 6: filespacechain.filespacechain.EventHostingInquiryExtended
+	(*EventHostingContractReassigned)(nil),    // 7: filespacechain.filespacechain.EventHostingContractReassigned
+	(*EventHostingContractTerminated)(nil),    // 8: filespacechain.filespacechain.EventHostingContractTerminated
+	(ContractStatus)(0),                       // 9: filespacechain.filespacechain.ContractStatus
+	(*OfferRejection)(nil),                    // 10: filespacechain.filespacechain.OfferRejection
+	(*v1beta1.Coin)(nil),                      // 11: cosmos.base.v1beta1.Coin
+	(ReputationEvent)(0),                      // 12: filespacechain.filespacechain.ReputationEvent
+	(DustDestination)(0),                      // 13: filespacechain.filespacechain.DustDestination
+}
+var file_filespacechain_filespacechain_events_proto_depIdxs = []int32{
+	9,  // 0: filespacechain.filespacechain.EventHostingContractStatusChanged.fromStatus:type_name -> filespacechain.filespacechain.ContractStatus
+	9,  // 1: filespacechain.filespacechain.EventHostingContractStatusChanged.toStatus:type_name -> filespacechain.filespacechain.ContractStatus
+	10, // 2: filespacechain.filespacechain.EventHostingOffersMatched.rejections:type_name -> filespacechain.filespacechain.OfferRejection
+	11, // 3: filespacechain.filespacechain.EventAuctionSettled.clearingPrice:type_name -> cosmos.base.v1beta1.Coin
+	12, // 4: filespacechain.filespacechain.EventProviderReputationChanged.event:type_name -> filespacechain.filespacechain.ReputationEvent
+	13, // 5: filespacechain.filespacechain.EventPaymentDustSwept.destination:type_name -> filespacechain.filespacechain.DustDestination
+	11, // 6: filespacechain.filespacechain.EventPaymentDustSwept.amount:type_name -> cosmos.base.v1beta1.Coin
+	11, // 7: filespacechain.filespacechain.EventContractSurplusRefunded.amount:type_name -> cosmos.base.v1beta1.Coin
+	11, // 8: filespacechain.filespacechain.EventHostingInquiryExtended.additionalEscrow:type_name -> cosmos.base.v1beta1.Coin
+	11, // 9: filespacechain.filespacechain.EventHostingInquiryExtended.escrowAmount:type_name -> cosmos.base.v1beta1.Coin
+	11, // 10: filespacechain.filespacechain.EventHostingContractTerminated.settled:type_name -> cosmos.base.v1beta1.Coin
+	11, // 11: filespacechain.filespacechain.EventHostingContractTerminated.penalty:type_name -> cosmos.base.v1beta1.Coin
+	11, // 12: filespacechain.filespacechain.EventHostingContractTerminated.slashed:type_name -> cosmos.base.v1beta1.Coin
+	11, // 13: filespacechain.filespacechain.EventHostingContractTerminated.refunded:type_name -> cosmos.base.v1beta1.Coin
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_filespacechain_filespacechain_events_proto_init() }
+func file_filespacechain_filespacechain_events_proto_init() {
+	if File_filespacechain_filespacechain_events_proto != nil {
+		return
+	}
+	file_filespacechain_filespacechain_hosting_contract_proto_init()
+	file_filespacechain_filespacechain_matching_proto_init()
+	file_filespacechain_filespacechain_reputation_proto_init()
+	file_filespacechain_filespacechain_payment_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_filespacechain_filespacechain_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventHostingContractStatusChanged); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_filespacechain_filespacechain_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventHostingOffersMatched); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_filespacechain_filespacechain_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventAuctionSettled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_filespacechain_filespacechain_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventProviderReputationChanged); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_filespacechain_filespacechain_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventPaymentDustSwept); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_filespacechain_filespacechain_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventContractSurplusRefunded); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_filespacechain_filespacechain_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventHostingInquiryExtended); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_filespacechain_filespacechain_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventHostingContractReassigned); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_filespacechain_filespacechain_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventHostingContractTerminated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_filespacechain_filespacechain_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_filespacechain_filespacechain_events_proto_goTypes,
+		DependencyIndexes: file_filespacechain_filespacechain_events_proto_depIdxs,
+		MessageInfos:      file_filespacechain_filespacechain_events_proto_msgTypes,
+	}.Build()
+	File_filespacechain_filespacechain_events_proto = out.File
+	file_filespacechain_filespacechain_events_proto_rawDesc = nil
+	file_filespacechain_filespacechain_events_proto_goTypes = nil
+	file_filespacechain_filespacechain_events_proto_depIdxs = nil
+}
@@ -13,14 +13,17 @@ import (
 )
 
 var (
-	md_FileEntry           protoreflect.MessageDescriptor
-	fd_FileEntry_id        protoreflect.FieldDescriptor
-	fd_FileEntry_cid       protoreflect.FieldDescriptor
-	fd_FileEntry_rootCid   protoreflect.FieldDescriptor
-	fd_FileEntry_parentCid protoreflect.FieldDescriptor
-	fd_FileEntry_metaData  protoreflect.FieldDescriptor
-	fd_FileEntry_fileSize  protoreflect.FieldDescriptor
-	fd_FileEntry_creator   protoreflect.FieldDescriptor
+	md_FileEntry            protoreflect.MessageDescriptor
+	fd_FileEntry_id         protoreflect.FieldDescriptor
+	fd_FileEntry_cid        protoreflect.FieldDescriptor
+	fd_FileEntry_rootCid    protoreflect.FieldDescriptor
+	fd_FileEntry_parentCid  protoreflect.FieldDescriptor
+	fd_FileEntry_metaData   protoreflect.FieldDescriptor
+	fd_FileEntry_fileSize   protoreflect.FieldDescriptor
+	fd_FileEntry_creator    protoreflect.FieldDescriptor
+	fd_FileEntry_merkleRoot protoreflect.FieldDescriptor
+	fd_FileEntry_chunkCount protoreflect.FieldDescriptor
+	fd_FileEntry_chunkSize  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -33,6 +36,9 @@ func init() {
 	fd_FileEntry_metaData = md_FileEntry.Fields().ByName("metaData")
 	fd_FileEntry_fileSize = md_FileEntry.Fields().ByName("fileSize")
 	fd_FileEntry_creator = md_FileEntry.Fields().ByName("creator")
+	fd_FileEntry_merkleRoot = md_FileEntry.Fields().ByName("merkleRoot")
+	fd_FileEntry_chunkCount = md_FileEntry.Fields().ByName("chunkCount")
+	fd_FileEntry_chunkSize = md_FileEntry.Fields().ByName("chunkSize")
 }
 
 var _ protoreflect.Message = (*fastReflection_FileEntry)(nil)
@@ -142,6 +148,24 @@ func (x *fastReflection_FileEntry) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
+	if len(x.MerkleRoot) != 0 {
+		value := protoreflect.ValueOfBytes(x.MerkleRoot)
+		if !f(fd_FileEntry_merkleRoot, value) {
+			return
+		}
+	}
+	if x.ChunkCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ChunkCount)
+		if !f(fd_FileEntry_chunkCount, value) {
+			return
+		}
+	}
+	if x.ChunkSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ChunkSize)
+		if !f(fd_FileEntry_chunkSize, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -171,6 +195,12 @@ func (x *fastReflection_FileEntry) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.FileSize != uint64(0)
 	case "filespacechain.filespacechain.FileEntry.creator":
 		return x.Creator != ""
+	case "filespacechain.filespacechain.FileEntry.merkleRoot":
+		return len(x.MerkleRoot) != 0
+	case "filespacechain.filespacechain.FileEntry.chunkCount":
+		return x.ChunkCount != uint64(0)
+	case "filespacechain.filespacechain.FileEntry.chunkSize":
+		return x.ChunkSize != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.FileEntry"))
@@ -201,6 +231,12 @@ func (x *fastReflection_FileEntry) Clear(fd protoreflect.FieldDescriptor) {
 		x.FileSize = uint64(0)
 	case "filespacechain.filespacechain.FileEntry.creator":
 		x.Creator = ""
+	case "filespacechain.filespacechain.FileEntry.merkleRoot":
+		x.MerkleRoot = nil
+	case "filespacechain.filespacechain.FileEntry.chunkCount":
+		x.ChunkCount = uint64(0)
+	case "filespacechain.filespacechain.FileEntry.chunkSize":
+		x.ChunkSize = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.FileEntry"))
@@ -238,6 +274,15 @@ func (x *fastReflection_FileEntry) Get(descriptor protoreflect.FieldDescriptor)
 	case "filespacechain.filespacechain.FileEntry.creator":
 		value := x.Creator
 		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.FileEntry.merkleRoot":
+		value := x.MerkleRoot
+		return protoreflect.ValueOfBytes(value)
+	case "filespacechain.filespacechain.FileEntry.chunkCount":
+		value := x.ChunkCount
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.FileEntry.chunkSize":
+		value := x.ChunkSize
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.FileEntry"))
@@ -272,6 +317,12 @@ func (x *fastReflection_FileEntry) Set(fd protoreflect.FieldDescriptor, value pr
 		x.FileSize = value.Uint()
 	case "filespacechain.filespacechain.FileEntry.creator":
 		x.Creator = value.Interface().(string)
+	case "filespacechain.filespacechain.FileEntry.merkleRoot":
+		x.MerkleRoot = value.Bytes()
+	case "filespacechain.filespacechain.FileEntry.chunkCount":
+		x.ChunkCount = value.Uint()
+	case "filespacechain.filespacechain.FileEntry.chunkSize":
+		x.ChunkSize = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.FileEntry"))
@@ -306,6 +357,12 @@ func (x *fastReflection_FileEntry) Mutable(fd protoreflect.FieldDescriptor) prot
 		panic(fmt.Errorf("field fileSize of message filespacechain.filespacechain.FileEntry is not mutable"))
 	case "filespacechain.filespacechain.FileEntry.creator":
 		panic(fmt.Errorf("field creator of message filespacechain.filespacechain.FileEntry is not mutable"))
+	case "filespacechain.filespacechain.FileEntry.merkleRoot":
+		panic(fmt.Errorf("field merkleRoot of message filespacechain.filespacechain.FileEntry is not mutable"))
+	case "filespacechain.filespacechain.FileEntry.chunkCount":
+		panic(fmt.Errorf("field chunkCount of message filespacechain.filespacechain.FileEntry is not mutable"))
+	case "filespacechain.filespacechain.FileEntry.chunkSize":
+		panic(fmt.Errorf("field chunkSize of message filespacechain.filespacechain.FileEntry is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.FileEntry"))
@@ -333,6 +390,12 @@ func (x *fastReflection_FileEntry) NewField(fd protoreflect.FieldDescriptor) pro
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "filespacechain.filespacechain.FileEntry.creator":
 		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.FileEntry.merkleRoot":
+		return protoreflect.ValueOfBytes(nil)
+	case "filespacechain.filespacechain.FileEntry.chunkCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.FileEntry.chunkSize":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.FileEntry"))
@@ -428,6 +491,16 @@ func (x *fastReflection_FileEntry) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.MerkleRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChunkCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChunkCount))
+		}
+		if x.ChunkSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChunkSize))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -457,6 +530,23 @@ func (x *fastReflection_FileEntry) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ChunkSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChunkSize))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.ChunkCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChunkCount))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.MerkleRoot) > 0 {
+			i -= len(x.MerkleRoot)
+			copy(dAtA[i:], x.MerkleRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MerkleRoot)))
+			i--
+			dAtA[i] = 0x42
+		}
 		if len(x.Creator) > 0 {
 			i -= len(x.Creator)
 			copy(dAtA[i:], x.Creator)
@@ -749,6 +839,78 @@ func (x *fastReflection_FileEntry) ProtoMethods() *protoiface.Methods {
 				}
 				x.Creator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MerkleRoot = append(x.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+				if x.MerkleRoot == nil {
+					x.MerkleRoot = []byte{}
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChunkCount", wireType)
+				}
+				x.ChunkCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChunkCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChunkSize", wireType)
+				}
+				x.ChunkSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChunkSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -802,13 +964,16 @@ type FileEntry struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Cid       string `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
-	RootCid   string `protobuf:"bytes,3,opt,name=rootCid,proto3" json:"rootCid,omitempty"`
-	ParentCid string `protobuf:"bytes,4,opt,name=parentCid,proto3" json:"parentCid,omitempty"`
-	MetaData  string `protobuf:"bytes,5,opt,name=metaData,proto3" json:"metaData,omitempty"`
-	FileSize  uint64 `protobuf:"varint,6,opt,name=fileSize,proto3" json:"fileSize,omitempty"`
-	Creator   string `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Cid        string `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	RootCid    string `protobuf:"bytes,3,opt,name=rootCid,proto3" json:"rootCid,omitempty"`
+	ParentCid  string `protobuf:"bytes,4,opt,name=parentCid,proto3" json:"parentCid,omitempty"`
+	MetaData   string `protobuf:"bytes,5,opt,name=metaData,proto3" json:"metaData,omitempty"`
+	FileSize   uint64 `protobuf:"varint,6,opt,name=fileSize,proto3" json:"fileSize,omitempty"`
+	Creator    string `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
+	MerkleRoot []byte `protobuf:"bytes,8,opt,name=merkleRoot,proto3" json:"merkleRoot,omitempty"`
+	ChunkCount uint64 `protobuf:"varint,9,opt,name=chunkCount,proto3" json:"chunkCount,omitempty"`
+	ChunkSize  uint64 `protobuf:"varint,10,opt,name=chunkSize,proto3" json:"chunkSize,omitempty"`
 }
 
 func (x *FileEntry) Reset() {
@@ -880,6 +1045,27 @@ func (x *FileEntry) GetCreator() string {
 	return ""
 }
 
+func (x *FileEntry) GetMerkleRoot() []byte {
+	if x != nil {
+		return x.MerkleRoot
+	}
+	return nil
+}
+
+func (x *FileEntry) GetChunkCount() uint64 {
+	if x != nil {
+		return x.ChunkCount
+	}
+	return 0
+}
+
+func (x *FileEntry) GetChunkSize() uint64 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
 var File_filespacechain_filespacechain_file_entry_proto protoreflect.FileDescriptor
 
 var file_filespacechain_filespacechain_file_entry_proto_rawDesc = []byte{
@@ -888,7 +1074,7 @@ var file_filespacechain_filespacechain_file_entry_proto_rawDesc = []byte{
 	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x1d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
 	0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x22,
-	0xb7, 0x01, 0x0a, 0x09, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a,
+	0x95, 0x02, 0x0a, 0x09, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a,
 	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a,
 	0x03, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12,
 	0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
@@ -899,23 +1085,29 @@ var file_filespacechain_filespacechain_file_entry_proto_rawDesc = []byte{
 	0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
 	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
 	0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x42, 0xf8, 0x01, 0x0a, 0x21, 0x63, 0x6f,
-	0x6d, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x42,
-	0x0e, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0xa2, 0x02, 0x03, 0x46, 0x46, 0x58, 0xaa, 0x02, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xca, 0x02, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xe2, 0x02, 0x29, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x1e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x65, 0x72,
+	0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6d,
+	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x42, 0xf8, 0x01, 0x0a, 0x21, 0x63, 0x6f, 0x6d, 0x2e,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x0e, 0x46,
+	0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xa2,
+	0x02, 0x03, 0x46, 0x46, 0x58, 0xaa, 0x02, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0xca, 0x02, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0xe2, 0x02, 0x29, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x1e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x3a, 0x3a, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
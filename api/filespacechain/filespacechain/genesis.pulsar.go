@@ -218,701 +218,2367 @@ func (x *_GenesisState_8_list) IsValid() bool {
 	return x.list != nil
 }
 
-var (
-	md_GenesisState                      protoreflect.MessageDescriptor
-	fd_GenesisState_params               protoreflect.FieldDescriptor
-	fd_GenesisState_fileEntryList        protoreflect.FieldDescriptor
-	fd_GenesisState_fileEntryCount       protoreflect.FieldDescriptor
-	fd_GenesisState_hostingInquiryList   protoreflect.FieldDescriptor
-	fd_GenesisState_hostingInquiryCount  protoreflect.FieldDescriptor
-	fd_GenesisState_hostingContractList  protoreflect.FieldDescriptor
-	fd_GenesisState_hostingContractCount protoreflect.FieldDescriptor
-	fd_GenesisState_hostingOfferList     protoreflect.FieldDescriptor
-	fd_GenesisState_hostingOfferCount    protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
 
-func init() {
-	file_filespacechain_filespacechain_genesis_proto_init()
-	md_GenesisState = File_filespacechain_filespacechain_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
-	fd_GenesisState_fileEntryList = md_GenesisState.Fields().ByName("fileEntryList")
-	fd_GenesisState_fileEntryCount = md_GenesisState.Fields().ByName("fileEntryCount")
-	fd_GenesisState_hostingInquiryList = md_GenesisState.Fields().ByName("hostingInquiryList")
-	fd_GenesisState_hostingInquiryCount = md_GenesisState.Fields().ByName("hostingInquiryCount")
-	fd_GenesisState_hostingContractList = md_GenesisState.Fields().ByName("hostingContractList")
-	fd_GenesisState_hostingContractCount = md_GenesisState.Fields().ByName("hostingContractCount")
-	fd_GenesisState_hostingOfferList = md_GenesisState.Fields().ByName("hostingOfferList")
-	fd_GenesisState_hostingOfferCount = md_GenesisState.Fields().ByName("hostingOfferCount")
+type _GenesisState_10_list struct {
+	list *[]*StorageChallenge
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_GenesisState GenesisState
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StorageChallenge)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_filespacechain_filespacechain_genesis_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StorageChallenge)
+	*x.list = append(*x.list, concreteValue)
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(StorageChallenge)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(StorageChallenge)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*SlashEvent
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SlashEvent)
+	(*x.list)[i] = concreteValue
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SlashEvent)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_GenesisState_params, value) {
-			return
-		}
-	}
-	if len(x.FileEntryList) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.FileEntryList})
-		if !f(fd_GenesisState_fileEntryList, value) {
-			return
-		}
-	}
-	if x.FileEntryCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.FileEntryCount)
-		if !f(fd_GenesisState_fileEntryCount, value) {
-			return
-		}
-	}
-	if len(x.HostingInquiryList) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.HostingInquiryList})
-		if !f(fd_GenesisState_hostingInquiryList, value) {
-			return
-		}
-	}
-	if x.HostingInquiryCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.HostingInquiryCount)
-		if !f(fd_GenesisState_hostingInquiryCount, value) {
-			return
-		}
-	}
-	if len(x.HostingContractList) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.HostingContractList})
-		if !f(fd_GenesisState_hostingContractList, value) {
-			return
-		}
-	}
-	if x.HostingContractCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.HostingContractCount)
-		if !f(fd_GenesisState_hostingContractCount, value) {
-			return
-		}
-	}
-	if len(x.HostingOfferList) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.HostingOfferList})
-		if !f(fd_GenesisState_hostingOfferList, value) {
-			return
-		}
-	}
-	if x.HostingOfferCount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.HostingOfferCount)
-		if !f(fd_GenesisState_hostingOfferCount, value) {
-			return
-		}
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(SlashEvent)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "filespacechain.filespacechain.GenesisState.params":
-		return x.Params != nil
-	case "filespacechain.filespacechain.GenesisState.fileEntryList":
-		return len(x.FileEntryList) != 0
-	case "filespacechain.filespacechain.GenesisState.fileEntryCount":
-		return x.FileEntryCount != uint64(0)
-	case "filespacechain.filespacechain.GenesisState.hostingInquiryList":
-		return len(x.HostingInquiryList) != 0
-	case "filespacechain.filespacechain.GenesisState.hostingInquiryCount":
-		return x.HostingInquiryCount != uint64(0)
-	case "filespacechain.filespacechain.GenesisState.hostingContractList":
-		return len(x.HostingContractList) != 0
-	case "filespacechain.filespacechain.GenesisState.hostingContractCount":
-		return x.HostingContractCount != uint64(0)
-	case "filespacechain.filespacechain.GenesisState.hostingOfferList":
-		return len(x.HostingOfferList) != 0
-	case "filespacechain.filespacechain.GenesisState.hostingOfferCount":
-		return x.HostingOfferCount != uint64(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.GenesisState"))
-		}
-		panic(fmt.Errorf("message filespacechain.filespacechain.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(SlashEvent)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*EscrowRecord
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "filespacechain.filespacechain.GenesisState.params":
-		x.Params = nil
-	case "filespacechain.filespacechain.GenesisState.fileEntryList":
-		x.FileEntryList = nil
-	case "filespacechain.filespacechain.GenesisState.fileEntryCount":
-		x.FileEntryCount = uint64(0)
-	case "filespacechain.filespacechain.GenesisState.hostingInquiryList":
-		x.HostingInquiryList = nil
-	case "filespacechain.filespacechain.GenesisState.hostingInquiryCount":
-		x.HostingInquiryCount = uint64(0)
-	case "filespacechain.filespacechain.GenesisState.hostingContractList":
-		x.HostingContractList = nil
-	case "filespacechain.filespacechain.GenesisState.hostingContractCount":
-		x.HostingContractCount = uint64(0)
-	case "filespacechain.filespacechain.GenesisState.hostingOfferList":
-		x.HostingOfferList = nil
-	case "filespacechain.filespacechain.GenesisState.hostingOfferCount":
-		x.HostingOfferCount = uint64(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.GenesisState"))
-		}
-		panic(fmt.Errorf("message filespacechain.filespacechain.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EscrowRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EscrowRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(EscrowRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "filespacechain.filespacechain.GenesisState.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "filespacechain.filespacechain.GenesisState.fileEntryList":
-		if len(x.FileEntryList) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_2_list{})
-		}
-		listValue := &_GenesisState_2_list{list: &x.FileEntryList}
-		return protoreflect.ValueOfList(listValue)
-	case "filespacechain.filespacechain.GenesisState.fileEntryCount":
-		value := x.FileEntryCount
-		return protoreflect.ValueOfUint64(value)
-	case "filespacechain.filespacechain.GenesisState.hostingInquiryList":
-		if len(x.HostingInquiryList) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_4_list{})
-		}
-		listValue := &_GenesisState_4_list{list: &x.HostingInquiryList}
-		return protoreflect.ValueOfList(listValue)
-	case "filespacechain.filespacechain.GenesisState.hostingInquiryCount":
-		value := x.HostingInquiryCount
-		return protoreflect.ValueOfUint64(value)
-	case "filespacechain.filespacechain.GenesisState.hostingContractList":
-		if len(x.HostingContractList) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_6_list{})
-		}
-		listValue := &_GenesisState_6_list{list: &x.HostingContractList}
-		return protoreflect.ValueOfList(listValue)
-	case "filespacechain.filespacechain.GenesisState.hostingContractCount":
-		value := x.HostingContractCount
-		return protoreflect.ValueOfUint64(value)
-	case "filespacechain.filespacechain.GenesisState.hostingOfferList":
-		if len(x.HostingOfferList) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_8_list{})
-		}
-		listValue := &_GenesisState_8_list{list: &x.HostingOfferList}
-		return protoreflect.ValueOfList(listValue)
-	case "filespacechain.filespacechain.GenesisState.hostingOfferCount":
-		value := x.HostingOfferCount
-		return protoreflect.ValueOfUint64(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.GenesisState"))
-		}
-		panic(fmt.Errorf("message filespacechain.filespacechain.GenesisState does not contain field %s", descriptor.FullName()))
-	}
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(EscrowRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "filespacechain.filespacechain.GenesisState.params":
-		x.Params = value.Message().Interface().(*Params)
-	case "filespacechain.filespacechain.GenesisState.fileEntryList":
-		lv := value.List()
-		clv := lv.(*_GenesisState_2_list)
-		x.FileEntryList = *clv.list
-	case "filespacechain.filespacechain.GenesisState.fileEntryCount":
-		x.FileEntryCount = value.Uint()
-	case "filespacechain.filespacechain.GenesisState.hostingInquiryList":
-		lv := value.List()
-		clv := lv.(*_GenesisState_4_list)
-		x.HostingInquiryList = *clv.list
-	case "filespacechain.filespacechain.GenesisState.hostingInquiryCount":
-		x.HostingInquiryCount = value.Uint()
-	case "filespacechain.filespacechain.GenesisState.hostingContractList":
-		lv := value.List()
-		clv := lv.(*_GenesisState_6_list)
-		x.HostingContractList = *clv.list
-	case "filespacechain.filespacechain.GenesisState.hostingContractCount":
-		x.HostingContractCount = value.Uint()
-	case "filespacechain.filespacechain.GenesisState.hostingOfferList":
-		lv := value.List()
-		clv := lv.(*_GenesisState_8_list)
-		x.HostingOfferList = *clv.list
-	case "filespacechain.filespacechain.GenesisState.hostingOfferCount":
-		x.HostingOfferCount = value.Uint()
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.GenesisState"))
-		}
-		panic(fmt.Errorf("message filespacechain.filespacechain.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "filespacechain.filespacechain.GenesisState.params":
-		if x.Params == nil {
-			x.Params = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	case "filespacechain.filespacechain.GenesisState.fileEntryList":
-		if x.FileEntryList == nil {
-			x.FileEntryList = []*FileEntry{}
-		}
-		value := &_GenesisState_2_list{list: &x.FileEntryList}
-		return protoreflect.ValueOfList(value)
-	case "filespacechain.filespacechain.GenesisState.hostingInquiryList":
-		if x.HostingInquiryList == nil {
-			x.HostingInquiryList = []*HostingInquiry{}
-		}
-		value := &_GenesisState_4_list{list: &x.HostingInquiryList}
-		return protoreflect.ValueOfList(value)
-	case "filespacechain.filespacechain.GenesisState.hostingContractList":
-		if x.HostingContractList == nil {
-			x.HostingContractList = []*HostingContract{}
-		}
-		value := &_GenesisState_6_list{list: &x.HostingContractList}
-		return protoreflect.ValueOfList(value)
-	case "filespacechain.filespacechain.GenesisState.hostingOfferList":
-		if x.HostingOfferList == nil {
-			x.HostingOfferList = []*HostingOffer{}
-		}
-		value := &_GenesisState_8_list{list: &x.HostingOfferList}
-		return protoreflect.ValueOfList(value)
-	case "filespacechain.filespacechain.GenesisState.fileEntryCount":
-		panic(fmt.Errorf("field fileEntryCount of message filespacechain.filespacechain.GenesisState is not mutable"))
-	case "filespacechain.filespacechain.GenesisState.hostingInquiryCount":
-		panic(fmt.Errorf("field hostingInquiryCount of message filespacechain.filespacechain.GenesisState is not mutable"))
-	case "filespacechain.filespacechain.GenesisState.hostingContractCount":
-		panic(fmt.Errorf("field hostingContractCount of message filespacechain.filespacechain.GenesisState is not mutable"))
-	case "filespacechain.filespacechain.GenesisState.hostingOfferCount":
-		panic(fmt.Errorf("field hostingOfferCount of message filespacechain.filespacechain.GenesisState is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.GenesisState"))
-		}
-		panic(fmt.Errorf("message filespacechain.filespacechain.GenesisState does not contain field %s", fd.FullName()))
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]*ProviderStake
+}
+
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "filespacechain.filespacechain.GenesisState.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "filespacechain.filespacechain.GenesisState.fileEntryList":
-		list := []*FileEntry{}
-		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
-	case "filespacechain.filespacechain.GenesisState.fileEntryCount":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "filespacechain.filespacechain.GenesisState.hostingInquiryList":
-		list := []*HostingInquiry{}
-		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
-	case "filespacechain.filespacechain.GenesisState.hostingInquiryCount":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "filespacechain.filespacechain.GenesisState.hostingContractList":
-		list := []*HostingContract{}
-		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
-	case "filespacechain.filespacechain.GenesisState.hostingContractCount":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "filespacechain.filespacechain.GenesisState.hostingOfferList":
-		list := []*HostingOffer{}
-		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
-	case "filespacechain.filespacechain.GenesisState.hostingOfferCount":
-		return protoreflect.ValueOfUint64(uint64(0))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.GenesisState"))
-		}
-		panic(fmt.Errorf("message filespacechain.filespacechain.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProviderStake)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProviderStake)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	v := new(ProviderStake)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in filespacechain.filespacechain.GenesisState", d.FullName()))
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := new(ProviderStake)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_16_list)(nil)
+
+type _GenesisState_16_list struct {
+	list *[]*PaymentHistory
+}
+
+func (x *_GenesisState_16_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	panic("unreachable")
+	return len(*x.list)
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *_GenesisState_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *_GenesisState_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PaymentHistory)
+	(*x.list)[i] = concreteValue
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
-	return x != nil
+func (x *_GenesisState_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PaymentHistory)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
+func (x *_GenesisState_16_list) AppendMutable() protoreflect.Value {
+	v := new(PaymentHistory)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_16_list) NewElement() protoreflect.Value {
+	v := new(PaymentHistory)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_17_list)(nil)
+
+type _GenesisState_17_list struct {
+	list *[]*UnbondingEntry
+}
+
+func (x *_GenesisState_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnbondingEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnbondingEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_17_list) AppendMutable() protoreflect.Value {
+	v := new(UnbondingEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_17_list) NewElement() protoreflect.Value {
+	v := new(UnbondingEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_19_list)(nil)
+
+type _GenesisState_19_list struct {
+	list *[]*Auction
+}
+
+func (x *_GenesisState_19_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_19_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Auction)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_19_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Auction)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_19_list) AppendMutable() protoreflect.Value {
+	v := new(Auction)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_19_list) NewElement() protoreflect.Value {
+	v := new(Auction)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_20_list)(nil)
+
+type _GenesisState_20_list struct {
+	list *[]*AuctionBid
+}
+
+func (x *_GenesisState_20_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_20_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_20_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AuctionBid)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_20_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AuctionBid)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_20_list) AppendMutable() protoreflect.Value {
+	v := new(AuctionBid)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_20_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_20_list) NewElement() protoreflect.Value {
+	v := new(AuctionBid)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_20_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_21_list)(nil)
+
+type _GenesisState_21_list struct {
+	list *[]*ProviderProfile
+}
+
+func (x *_GenesisState_21_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_21_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProviderProfile)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_21_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProviderProfile)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_21_list) AppendMutable() protoreflect.Value {
+	v := new(ProviderProfile)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_21_list) NewElement() protoreflect.Value {
+	v := new(ProviderProfile)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_22_list)(nil)
+
+type _GenesisState_22_list struct {
+	list *[]*ProviderReputation
+}
+
+func (x *_GenesisState_22_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_22_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_22_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProviderReputation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_22_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProviderReputation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_22_list) AppendMutable() protoreflect.Value {
+	v := new(ProviderReputation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_22_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_22_list) NewElement() protoreflect.Value {
+	v := new(ProviderReputation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_22_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_23_list)(nil)
+
+type _GenesisState_23_list struct {
+	list *[]*ProviderPayment
+}
+
+func (x *_GenesisState_23_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_23_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_23_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProviderPayment)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_23_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProviderPayment)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_23_list) AppendMutable() protoreflect.Value {
+	v := new(ProviderPayment)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_23_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_23_list) NewElement() protoreflect.Value {
+	v := new(ProviderPayment)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_23_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                        protoreflect.MessageDescriptor
+	fd_GenesisState_params                 protoreflect.FieldDescriptor
+	fd_GenesisState_fileEntryList          protoreflect.FieldDescriptor
+	fd_GenesisState_fileEntryCount         protoreflect.FieldDescriptor
+	fd_GenesisState_hostingInquiryList     protoreflect.FieldDescriptor
+	fd_GenesisState_hostingInquiryCount    protoreflect.FieldDescriptor
+	fd_GenesisState_hostingContractList    protoreflect.FieldDescriptor
+	fd_GenesisState_hostingContractCount   protoreflect.FieldDescriptor
+	fd_GenesisState_hostingOfferList       protoreflect.FieldDescriptor
+	fd_GenesisState_hostingOfferCount      protoreflect.FieldDescriptor
+	fd_GenesisState_storageChallengeList   protoreflect.FieldDescriptor
+	fd_GenesisState_storageChallengeCount  protoreflect.FieldDescriptor
+	fd_GenesisState_slashEventList         protoreflect.FieldDescriptor
+	fd_GenesisState_slashEventCount        protoreflect.FieldDescriptor
+	fd_GenesisState_escrowRecordList       protoreflect.FieldDescriptor
+	fd_GenesisState_providerStakeList      protoreflect.FieldDescriptor
+	fd_GenesisState_paymentHistoryList     protoreflect.FieldDescriptor
+	fd_GenesisState_unbondingEntryList     protoreflect.FieldDescriptor
+	fd_GenesisState_unbondingEntryCount    protoreflect.FieldDescriptor
+	fd_GenesisState_auctionList            protoreflect.FieldDescriptor
+	fd_GenesisState_auctionBidList         protoreflect.FieldDescriptor
+	fd_GenesisState_providerProfileList    protoreflect.FieldDescriptor
+	fd_GenesisState_providerReputationList protoreflect.FieldDescriptor
+	fd_GenesisState_providerPaymentList    protoreflect.FieldDescriptor
+	fd_GenesisState_providerPaymentCount   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_filespacechain_filespacechain_genesis_proto_init()
+	md_GenesisState = File_filespacechain_filespacechain_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_fileEntryList = md_GenesisState.Fields().ByName("fileEntryList")
+	fd_GenesisState_fileEntryCount = md_GenesisState.Fields().ByName("fileEntryCount")
+	fd_GenesisState_hostingInquiryList = md_GenesisState.Fields().ByName("hostingInquiryList")
+	fd_GenesisState_hostingInquiryCount = md_GenesisState.Fields().ByName("hostingInquiryCount")
+	fd_GenesisState_hostingContractList = md_GenesisState.Fields().ByName("hostingContractList")
+	fd_GenesisState_hostingContractCount = md_GenesisState.Fields().ByName("hostingContractCount")
+	fd_GenesisState_hostingOfferList = md_GenesisState.Fields().ByName("hostingOfferList")
+	fd_GenesisState_hostingOfferCount = md_GenesisState.Fields().ByName("hostingOfferCount")
+	fd_GenesisState_storageChallengeList = md_GenesisState.Fields().ByName("storageChallengeList")
+	fd_GenesisState_storageChallengeCount = md_GenesisState.Fields().ByName("storageChallengeCount")
+	fd_GenesisState_slashEventList = md_GenesisState.Fields().ByName("slashEventList")
+	fd_GenesisState_slashEventCount = md_GenesisState.Fields().ByName("slashEventCount")
+	fd_GenesisState_escrowRecordList = md_GenesisState.Fields().ByName("escrowRecordList")
+	fd_GenesisState_providerStakeList = md_GenesisState.Fields().ByName("providerStakeList")
+	fd_GenesisState_paymentHistoryList = md_GenesisState.Fields().ByName("paymentHistoryList")
+	fd_GenesisState_unbondingEntryList = md_GenesisState.Fields().ByName("unbondingEntryList")
+	fd_GenesisState_unbondingEntryCount = md_GenesisState.Fields().ByName("unbondingEntryCount")
+	fd_GenesisState_auctionList = md_GenesisState.Fields().ByName("auctionList")
+	fd_GenesisState_auctionBidList = md_GenesisState.Fields().ByName("auctionBidList")
+	fd_GenesisState_providerProfileList = md_GenesisState.Fields().ByName("providerProfileList")
+	fd_GenesisState_providerReputationList = md_GenesisState.Fields().ByName("providerReputationList")
+	fd_GenesisState_providerPaymentList = md_GenesisState.Fields().ByName("providerPaymentList")
+	fd_GenesisState_providerPaymentCount = md_GenesisState.Fields().ByName("providerPaymentCount")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_filespacechain_filespacechain_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if len(x.FileEntryList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.FileEntryList})
+		if !f(fd_GenesisState_fileEntryList, value) {
+			return
+		}
+	}
+	if x.FileEntryCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FileEntryCount)
+		if !f(fd_GenesisState_fileEntryCount, value) {
+			return
+		}
+	}
+	if len(x.HostingInquiryList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.HostingInquiryList})
+		if !f(fd_GenesisState_hostingInquiryList, value) {
+			return
+		}
+	}
+	if x.HostingInquiryCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HostingInquiryCount)
+		if !f(fd_GenesisState_hostingInquiryCount, value) {
+			return
+		}
+	}
+	if len(x.HostingContractList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.HostingContractList})
+		if !f(fd_GenesisState_hostingContractList, value) {
+			return
+		}
+	}
+	if x.HostingContractCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HostingContractCount)
+		if !f(fd_GenesisState_hostingContractCount, value) {
+			return
+		}
+	}
+	if len(x.HostingOfferList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.HostingOfferList})
+		if !f(fd_GenesisState_hostingOfferList, value) {
+			return
+		}
+	}
+	if x.HostingOfferCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HostingOfferCount)
+		if !f(fd_GenesisState_hostingOfferCount, value) {
+			return
+		}
+	}
+	if len(x.StorageChallengeList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.StorageChallengeList})
+		if !f(fd_GenesisState_storageChallengeList, value) {
+			return
+		}
+	}
+	if x.StorageChallengeCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StorageChallengeCount)
+		if !f(fd_GenesisState_storageChallengeCount, value) {
+			return
+		}
+	}
+	if len(x.SlashEventList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.SlashEventList})
+		if !f(fd_GenesisState_slashEventList, value) {
+			return
+		}
+	}
+	if x.SlashEventCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SlashEventCount)
+		if !f(fd_GenesisState_slashEventCount, value) {
+			return
+		}
+	}
+	if len(x.EscrowRecordList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.EscrowRecordList})
+		if !f(fd_GenesisState_escrowRecordList, value) {
+			return
+		}
+	}
+	if len(x.ProviderStakeList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.ProviderStakeList})
+		if !f(fd_GenesisState_providerStakeList, value) {
+			return
+		}
+	}
+	if len(x.PaymentHistoryList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.PaymentHistoryList})
+		if !f(fd_GenesisState_paymentHistoryList, value) {
+			return
+		}
+	}
+	if len(x.UnbondingEntryList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_17_list{list: &x.UnbondingEntryList})
+		if !f(fd_GenesisState_unbondingEntryList, value) {
+			return
+		}
+	}
+	if x.UnbondingEntryCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.UnbondingEntryCount)
+		if !f(fd_GenesisState_unbondingEntryCount, value) {
+			return
+		}
+	}
+	if len(x.AuctionList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_19_list{list: &x.AuctionList})
+		if !f(fd_GenesisState_auctionList, value) {
+			return
+		}
+	}
+	if len(x.AuctionBidList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_20_list{list: &x.AuctionBidList})
+		if !f(fd_GenesisState_auctionBidList, value) {
+			return
+		}
+	}
+	if len(x.ProviderProfileList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_21_list{list: &x.ProviderProfileList})
+		if !f(fd_GenesisState_providerProfileList, value) {
+			return
+		}
+	}
+	if len(x.ProviderReputationList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_22_list{list: &x.ProviderReputationList})
+		if !f(fd_GenesisState_providerReputationList, value) {
+			return
+		}
+	}
+	if len(x.ProviderPaymentList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_23_list{list: &x.ProviderPaymentList})
+		if !f(fd_GenesisState_providerPaymentList, value) {
+			return
+		}
+	}
+	if x.ProviderPaymentCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProviderPaymentCount)
+		if !f(fd_GenesisState_providerPaymentCount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.GenesisState.params":
+		return x.Params != nil
+	case "filespacechain.filespacechain.GenesisState.fileEntryList":
+		return len(x.FileEntryList) != 0
+	case "filespacechain.filespacechain.GenesisState.fileEntryCount":
+		return x.FileEntryCount != uint64(0)
+	case "filespacechain.filespacechain.GenesisState.hostingInquiryList":
+		return len(x.HostingInquiryList) != 0
+	case "filespacechain.filespacechain.GenesisState.hostingInquiryCount":
+		return x.HostingInquiryCount != uint64(0)
+	case "filespacechain.filespacechain.GenesisState.hostingContractList":
+		return len(x.HostingContractList) != 0
+	case "filespacechain.filespacechain.GenesisState.hostingContractCount":
+		return x.HostingContractCount != uint64(0)
+	case "filespacechain.filespacechain.GenesisState.hostingOfferList":
+		return len(x.HostingOfferList) != 0
+	case "filespacechain.filespacechain.GenesisState.hostingOfferCount":
+		return x.HostingOfferCount != uint64(0)
+	case "filespacechain.filespacechain.GenesisState.storageChallengeList":
+		return len(x.StorageChallengeList) != 0
+	case "filespacechain.filespacechain.GenesisState.storageChallengeCount":
+		return x.StorageChallengeCount != uint64(0)
+	case "filespacechain.filespacechain.GenesisState.slashEventList":
+		return len(x.SlashEventList) != 0
+	case "filespacechain.filespacechain.GenesisState.slashEventCount":
+		return x.SlashEventCount != uint64(0)
+	case "filespacechain.filespacechain.GenesisState.escrowRecordList":
+		return len(x.EscrowRecordList) != 0
+	case "filespacechain.filespacechain.GenesisState.providerStakeList":
+		return len(x.ProviderStakeList) != 0
+	case "filespacechain.filespacechain.GenesisState.paymentHistoryList":
+		return len(x.PaymentHistoryList) != 0
+	case "filespacechain.filespacechain.GenesisState.unbondingEntryList":
+		return len(x.UnbondingEntryList) != 0
+	case "filespacechain.filespacechain.GenesisState.unbondingEntryCount":
+		return x.UnbondingEntryCount != uint64(0)
+	case "filespacechain.filespacechain.GenesisState.auctionList":
+		return len(x.AuctionList) != 0
+	case "filespacechain.filespacechain.GenesisState.auctionBidList":
+		return len(x.AuctionBidList) != 0
+	case "filespacechain.filespacechain.GenesisState.providerProfileList":
+		return len(x.ProviderProfileList) != 0
+	case "filespacechain.filespacechain.GenesisState.providerReputationList":
+		return len(x.ProviderReputationList) != 0
+	case "filespacechain.filespacechain.GenesisState.providerPaymentList":
+		return len(x.ProviderPaymentList) != 0
+	case "filespacechain.filespacechain.GenesisState.providerPaymentCount":
+		return x.ProviderPaymentCount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.GenesisState"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.GenesisState.params":
+		x.Params = nil
+	case "filespacechain.filespacechain.GenesisState.fileEntryList":
+		x.FileEntryList = nil
+	case "filespacechain.filespacechain.GenesisState.fileEntryCount":
+		x.FileEntryCount = uint64(0)
+	case "filespacechain.filespacechain.GenesisState.hostingInquiryList":
+		x.HostingInquiryList = nil
+	case "filespacechain.filespacechain.GenesisState.hostingInquiryCount":
+		x.HostingInquiryCount = uint64(0)
+	case "filespacechain.filespacechain.GenesisState.hostingContractList":
+		x.HostingContractList = nil
+	case "filespacechain.filespacechain.GenesisState.hostingContractCount":
+		x.HostingContractCount = uint64(0)
+	case "filespacechain.filespacechain.GenesisState.hostingOfferList":
+		x.HostingOfferList = nil
+	case "filespacechain.filespacechain.GenesisState.hostingOfferCount":
+		x.HostingOfferCount = uint64(0)
+	case "filespacechain.filespacechain.GenesisState.storageChallengeList":
+		x.StorageChallengeList = nil
+	case "filespacechain.filespacechain.GenesisState.storageChallengeCount":
+		x.StorageChallengeCount = uint64(0)
+	case "filespacechain.filespacechain.GenesisState.slashEventList":
+		x.SlashEventList = nil
+	case "filespacechain.filespacechain.GenesisState.slashEventCount":
+		x.SlashEventCount = uint64(0)
+	case "filespacechain.filespacechain.GenesisState.escrowRecordList":
+		x.EscrowRecordList = nil
+	case "filespacechain.filespacechain.GenesisState.providerStakeList":
+		x.ProviderStakeList = nil
+	case "filespacechain.filespacechain.GenesisState.paymentHistoryList":
+		x.PaymentHistoryList = nil
+	case "filespacechain.filespacechain.GenesisState.unbondingEntryList":
+		x.UnbondingEntryList = nil
+	case "filespacechain.filespacechain.GenesisState.unbondingEntryCount":
+		x.UnbondingEntryCount = uint64(0)
+	case "filespacechain.filespacechain.GenesisState.auctionList":
+		x.AuctionList = nil
+	case "filespacechain.filespacechain.GenesisState.auctionBidList":
+		x.AuctionBidList = nil
+	case "filespacechain.filespacechain.GenesisState.providerProfileList":
+		x.ProviderProfileList = nil
+	case "filespacechain.filespacechain.GenesisState.providerReputationList":
+		x.ProviderReputationList = nil
+	case "filespacechain.filespacechain.GenesisState.providerPaymentList":
+		x.ProviderPaymentList = nil
+	case "filespacechain.filespacechain.GenesisState.providerPaymentCount":
+		x.ProviderPaymentCount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.GenesisState"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "filespacechain.filespacechain.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "filespacechain.filespacechain.GenesisState.fileEntryList":
+		if len(x.FileEntryList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.FileEntryList}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.GenesisState.fileEntryCount":
+		value := x.FileEntryCount
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.GenesisState.hostingInquiryList":
+		if len(x.HostingInquiryList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.HostingInquiryList}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.GenesisState.hostingInquiryCount":
+		value := x.HostingInquiryCount
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.GenesisState.hostingContractList":
+		if len(x.HostingContractList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.HostingContractList}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.GenesisState.hostingContractCount":
+		value := x.HostingContractCount
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.GenesisState.hostingOfferList":
+		if len(x.HostingOfferList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.HostingOfferList}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.GenesisState.hostingOfferCount":
+		value := x.HostingOfferCount
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.GenesisState.storageChallengeList":
+		if len(x.StorageChallengeList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.StorageChallengeList}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.GenesisState.storageChallengeCount":
+		value := x.StorageChallengeCount
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.GenesisState.slashEventList":
+		if len(x.SlashEventList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.SlashEventList}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.GenesisState.slashEventCount":
+		value := x.SlashEventCount
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.GenesisState.escrowRecordList":
+		if len(x.EscrowRecordList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.EscrowRecordList}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.GenesisState.providerStakeList":
+		if len(x.ProviderStakeList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.ProviderStakeList}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.GenesisState.paymentHistoryList":
+		if len(x.PaymentHistoryList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.PaymentHistoryList}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.GenesisState.unbondingEntryList":
+		if len(x.UnbondingEntryList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_17_list{})
+		}
+		listValue := &_GenesisState_17_list{list: &x.UnbondingEntryList}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.GenesisState.unbondingEntryCount":
+		value := x.UnbondingEntryCount
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.GenesisState.auctionList":
+		if len(x.AuctionList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_19_list{})
+		}
+		listValue := &_GenesisState_19_list{list: &x.AuctionList}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.GenesisState.auctionBidList":
+		if len(x.AuctionBidList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_20_list{})
+		}
+		listValue := &_GenesisState_20_list{list: &x.AuctionBidList}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.GenesisState.providerProfileList":
+		if len(x.ProviderProfileList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_21_list{})
+		}
+		listValue := &_GenesisState_21_list{list: &x.ProviderProfileList}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.GenesisState.providerReputationList":
+		if len(x.ProviderReputationList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_22_list{})
+		}
+		listValue := &_GenesisState_22_list{list: &x.ProviderReputationList}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.GenesisState.providerPaymentList":
+		if len(x.ProviderPaymentList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_23_list{})
+		}
+		listValue := &_GenesisState_23_list{list: &x.ProviderPaymentList}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.GenesisState.providerPaymentCount":
+		value := x.ProviderPaymentCount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.GenesisState"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "filespacechain.filespacechain.GenesisState.fileEntryList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.FileEntryList = *clv.list
+	case "filespacechain.filespacechain.GenesisState.fileEntryCount":
+		x.FileEntryCount = value.Uint()
+	case "filespacechain.filespacechain.GenesisState.hostingInquiryList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.HostingInquiryList = *clv.list
+	case "filespacechain.filespacechain.GenesisState.hostingInquiryCount":
+		x.HostingInquiryCount = value.Uint()
+	case "filespacechain.filespacechain.GenesisState.hostingContractList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.HostingContractList = *clv.list
+	case "filespacechain.filespacechain.GenesisState.hostingContractCount":
+		x.HostingContractCount = value.Uint()
+	case "filespacechain.filespacechain.GenesisState.hostingOfferList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.HostingOfferList = *clv.list
+	case "filespacechain.filespacechain.GenesisState.hostingOfferCount":
+		x.HostingOfferCount = value.Uint()
+	case "filespacechain.filespacechain.GenesisState.storageChallengeList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.StorageChallengeList = *clv.list
+	case "filespacechain.filespacechain.GenesisState.storageChallengeCount":
+		x.StorageChallengeCount = value.Uint()
+	case "filespacechain.filespacechain.GenesisState.slashEventList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.SlashEventList = *clv.list
+	case "filespacechain.filespacechain.GenesisState.slashEventCount":
+		x.SlashEventCount = value.Uint()
+	case "filespacechain.filespacechain.GenesisState.escrowRecordList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.EscrowRecordList = *clv.list
+	case "filespacechain.filespacechain.GenesisState.providerStakeList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.ProviderStakeList = *clv.list
+	case "filespacechain.filespacechain.GenesisState.paymentHistoryList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.PaymentHistoryList = *clv.list
+	case "filespacechain.filespacechain.GenesisState.unbondingEntryList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_17_list)
+		x.UnbondingEntryList = *clv.list
+	case "filespacechain.filespacechain.GenesisState.unbondingEntryCount":
+		x.UnbondingEntryCount = value.Uint()
+	case "filespacechain.filespacechain.GenesisState.auctionList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_19_list)
+		x.AuctionList = *clv.list
+	case "filespacechain.filespacechain.GenesisState.auctionBidList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_20_list)
+		x.AuctionBidList = *clv.list
+	case "filespacechain.filespacechain.GenesisState.providerProfileList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_21_list)
+		x.ProviderProfileList = *clv.list
+	case "filespacechain.filespacechain.GenesisState.providerReputationList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_22_list)
+		x.ProviderReputationList = *clv.list
+	case "filespacechain.filespacechain.GenesisState.providerPaymentList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_23_list)
+		x.ProviderPaymentList = *clv.list
+	case "filespacechain.filespacechain.GenesisState.providerPaymentCount":
+		x.ProviderPaymentCount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.GenesisState"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "filespacechain.filespacechain.GenesisState.fileEntryList":
+		if x.FileEntryList == nil {
+			x.FileEntryList = []*FileEntry{}
+		}
+		value := &_GenesisState_2_list{list: &x.FileEntryList}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.GenesisState.hostingInquiryList":
+		if x.HostingInquiryList == nil {
+			x.HostingInquiryList = []*HostingInquiry{}
+		}
+		value := &_GenesisState_4_list{list: &x.HostingInquiryList}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.GenesisState.hostingContractList":
+		if x.HostingContractList == nil {
+			x.HostingContractList = []*HostingContract{}
+		}
+		value := &_GenesisState_6_list{list: &x.HostingContractList}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.GenesisState.hostingOfferList":
+		if x.HostingOfferList == nil {
+			x.HostingOfferList = []*HostingOffer{}
+		}
+		value := &_GenesisState_8_list{list: &x.HostingOfferList}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.GenesisState.storageChallengeList":
+		if x.StorageChallengeList == nil {
+			x.StorageChallengeList = []*StorageChallenge{}
+		}
+		value := &_GenesisState_10_list{list: &x.StorageChallengeList}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.GenesisState.slashEventList":
+		if x.SlashEventList == nil {
+			x.SlashEventList = []*SlashEvent{}
+		}
+		value := &_GenesisState_12_list{list: &x.SlashEventList}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.GenesisState.escrowRecordList":
+		if x.EscrowRecordList == nil {
+			x.EscrowRecordList = []*EscrowRecord{}
+		}
+		value := &_GenesisState_14_list{list: &x.EscrowRecordList}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.GenesisState.providerStakeList":
+		if x.ProviderStakeList == nil {
+			x.ProviderStakeList = []*ProviderStake{}
+		}
+		value := &_GenesisState_15_list{list: &x.ProviderStakeList}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.GenesisState.paymentHistoryList":
+		if x.PaymentHistoryList == nil {
+			x.PaymentHistoryList = []*PaymentHistory{}
+		}
+		value := &_GenesisState_16_list{list: &x.PaymentHistoryList}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.GenesisState.unbondingEntryList":
+		if x.UnbondingEntryList == nil {
+			x.UnbondingEntryList = []*UnbondingEntry{}
+		}
+		value := &_GenesisState_17_list{list: &x.UnbondingEntryList}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.GenesisState.auctionList":
+		if x.AuctionList == nil {
+			x.AuctionList = []*Auction{}
+		}
+		value := &_GenesisState_19_list{list: &x.AuctionList}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.GenesisState.auctionBidList":
+		if x.AuctionBidList == nil {
+			x.AuctionBidList = []*AuctionBid{}
+		}
+		value := &_GenesisState_20_list{list: &x.AuctionBidList}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.GenesisState.providerProfileList":
+		if x.ProviderProfileList == nil {
+			x.ProviderProfileList = []*ProviderProfile{}
+		}
+		value := &_GenesisState_21_list{list: &x.ProviderProfileList}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.GenesisState.providerReputationList":
+		if x.ProviderReputationList == nil {
+			x.ProviderReputationList = []*ProviderReputation{}
+		}
+		value := &_GenesisState_22_list{list: &x.ProviderReputationList}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.GenesisState.providerPaymentList":
+		if x.ProviderPaymentList == nil {
+			x.ProviderPaymentList = []*ProviderPayment{}
+		}
+		value := &_GenesisState_23_list{list: &x.ProviderPaymentList}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.GenesisState.fileEntryCount":
+		panic(fmt.Errorf("field fileEntryCount of message filespacechain.filespacechain.GenesisState is not mutable"))
+	case "filespacechain.filespacechain.GenesisState.hostingInquiryCount":
+		panic(fmt.Errorf("field hostingInquiryCount of message filespacechain.filespacechain.GenesisState is not mutable"))
+	case "filespacechain.filespacechain.GenesisState.hostingContractCount":
+		panic(fmt.Errorf("field hostingContractCount of message filespacechain.filespacechain.GenesisState is not mutable"))
+	case "filespacechain.filespacechain.GenesisState.hostingOfferCount":
+		panic(fmt.Errorf("field hostingOfferCount of message filespacechain.filespacechain.GenesisState is not mutable"))
+	case "filespacechain.filespacechain.GenesisState.storageChallengeCount":
+		panic(fmt.Errorf("field storageChallengeCount of message filespacechain.filespacechain.GenesisState is not mutable"))
+	case "filespacechain.filespacechain.GenesisState.slashEventCount":
+		panic(fmt.Errorf("field slashEventCount of message filespacechain.filespacechain.GenesisState is not mutable"))
+	case "filespacechain.filespacechain.GenesisState.unbondingEntryCount":
+		panic(fmt.Errorf("field unbondingEntryCount of message filespacechain.filespacechain.GenesisState is not mutable"))
+	case "filespacechain.filespacechain.GenesisState.providerPaymentCount":
+		panic(fmt.Errorf("field providerPaymentCount of message filespacechain.filespacechain.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.GenesisState"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "filespacechain.filespacechain.GenesisState.fileEntryList":
+		list := []*FileEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "filespacechain.filespacechain.GenesisState.fileEntryCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.GenesisState.hostingInquiryList":
+		list := []*HostingInquiry{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "filespacechain.filespacechain.GenesisState.hostingInquiryCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.GenesisState.hostingContractList":
+		list := []*HostingContract{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "filespacechain.filespacechain.GenesisState.hostingContractCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.GenesisState.hostingOfferList":
+		list := []*HostingOffer{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "filespacechain.filespacechain.GenesisState.hostingOfferCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.GenesisState.storageChallengeList":
+		list := []*StorageChallenge{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "filespacechain.filespacechain.GenesisState.storageChallengeCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.GenesisState.slashEventList":
+		list := []*SlashEvent{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "filespacechain.filespacechain.GenesisState.slashEventCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.GenesisState.escrowRecordList":
+		list := []*EscrowRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	case "filespacechain.filespacechain.GenesisState.providerStakeList":
+		list := []*ProviderStake{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
+	case "filespacechain.filespacechain.GenesisState.paymentHistoryList":
+		list := []*PaymentHistory{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
+	case "filespacechain.filespacechain.GenesisState.unbondingEntryList":
+		list := []*UnbondingEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_17_list{list: &list})
+	case "filespacechain.filespacechain.GenesisState.unbondingEntryCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.GenesisState.auctionList":
+		list := []*Auction{}
+		return protoreflect.ValueOfList(&_GenesisState_19_list{list: &list})
+	case "filespacechain.filespacechain.GenesisState.auctionBidList":
+		list := []*AuctionBid{}
+		return protoreflect.ValueOfList(&_GenesisState_20_list{list: &list})
+	case "filespacechain.filespacechain.GenesisState.providerProfileList":
+		list := []*ProviderProfile{}
+		return protoreflect.ValueOfList(&_GenesisState_21_list{list: &list})
+	case "filespacechain.filespacechain.GenesisState.providerReputationList":
+		list := []*ProviderReputation{}
+		return protoreflect.ValueOfList(&_GenesisState_22_list{list: &list})
+	case "filespacechain.filespacechain.GenesisState.providerPaymentList":
+		list := []*ProviderPayment{}
+		return protoreflect.ValueOfList(&_GenesisState_23_list{list: &list})
+	case "filespacechain.filespacechain.GenesisState.providerPaymentCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.GenesisState"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in filespacechain.filespacechain.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
 func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
 		x := input.Message.Interface().(*GenesisState)
 		if x == nil {
-			return protoiface.SizeOutput{
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FileEntryList) > 0 {
+			for _, e := range x.FileEntryList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.FileEntryCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.FileEntryCount))
+		}
+		if len(x.HostingInquiryList) > 0 {
+			for _, e := range x.HostingInquiryList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.HostingInquiryCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.HostingInquiryCount))
+		}
+		if len(x.HostingContractList) > 0 {
+			for _, e := range x.HostingContractList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.HostingContractCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.HostingContractCount))
+		}
+		if len(x.HostingOfferList) > 0 {
+			for _, e := range x.HostingOfferList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.HostingOfferCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.HostingOfferCount))
+		}
+		if len(x.StorageChallengeList) > 0 {
+			for _, e := range x.StorageChallengeList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.StorageChallengeCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.StorageChallengeCount))
+		}
+		if len(x.SlashEventList) > 0 {
+			for _, e := range x.SlashEventList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.SlashEventCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashEventCount))
+		}
+		if len(x.EscrowRecordList) > 0 {
+			for _, e := range x.EscrowRecordList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ProviderStakeList) > 0 {
+			for _, e := range x.ProviderStakeList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PaymentHistoryList) > 0 {
+			for _, e := range x.PaymentHistoryList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.UnbondingEntryList) > 0 {
+			for _, e := range x.UnbondingEntryList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.UnbondingEntryCount != 0 {
+			n += 2 + runtime.Sov(uint64(x.UnbondingEntryCount))
+		}
+		if len(x.AuctionList) > 0 {
+			for _, e := range x.AuctionList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AuctionBidList) > 0 {
+			for _, e := range x.AuctionBidList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ProviderProfileList) > 0 {
+			for _, e := range x.ProviderProfileList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ProviderReputationList) > 0 {
+			for _, e := range x.ProviderReputationList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ProviderPaymentList) > 0 {
+			for _, e := range x.ProviderPaymentList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ProviderPaymentCount != 0 {
+			n += 2 + runtime.Sov(uint64(x.ProviderPaymentCount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
+				Buf:               input.Buf,
+			}, nil
 		}
-		options := runtime.SizeInputToOptions(input)
+		options := runtime.MarshalInputToOptions(input)
 		_ = options
-		var n int
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
 		var l int
 		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.FileEntryList) > 0 {
-			for _, e := range x.FileEntryList {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
+		if x.ProviderPaymentCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProviderPaymentCount))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc0
+		}
+		if len(x.ProviderPaymentList) > 0 {
+			for iNdEx := len(x.ProviderPaymentList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ProviderPaymentList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xba
 			}
 		}
-		if x.FileEntryCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.FileEntryCount))
+		if len(x.ProviderReputationList) > 0 {
+			for iNdEx := len(x.ProviderReputationList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ProviderReputationList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xb2
+			}
 		}
-		if len(x.HostingInquiryList) > 0 {
-			for _, e := range x.HostingInquiryList {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.ProviderProfileList) > 0 {
+			for iNdEx := len(x.ProviderProfileList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ProviderProfileList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xaa
 			}
 		}
-		if x.HostingInquiryCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.HostingInquiryCount))
+		if len(x.AuctionBidList) > 0 {
+			for iNdEx := len(x.AuctionBidList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AuctionBidList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xa2
+			}
+		}
+		if len(x.AuctionList) > 0 {
+			for iNdEx := len(x.AuctionList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AuctionList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x9a
+			}
+		}
+		if x.UnbondingEntryCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnbondingEntryCount))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if len(x.UnbondingEntryList) > 0 {
+			for iNdEx := len(x.UnbondingEntryList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.UnbondingEntryList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if len(x.PaymentHistoryList) > 0 {
+			for iNdEx := len(x.PaymentHistoryList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PaymentHistoryList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.ProviderStakeList) > 0 {
+			for iNdEx := len(x.ProviderStakeList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ProviderStakeList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.EscrowRecordList) > 0 {
+			for iNdEx := len(x.EscrowRecordList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EscrowRecordList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if x.SlashEventCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashEventCount))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.SlashEventList) > 0 {
+			for iNdEx := len(x.SlashEventList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SlashEventList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.StorageChallengeCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StorageChallengeCount))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.StorageChallengeList) > 0 {
+			for iNdEx := len(x.StorageChallengeList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StorageChallengeList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.HostingOfferCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HostingOfferCount))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.HostingOfferList) > 0 {
+			for iNdEx := len(x.HostingOfferList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.HostingOfferList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.HostingContractCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HostingContractCount))
+			i--
+			dAtA[i] = 0x38
 		}
 		if len(x.HostingContractList) > 0 {
-			for _, e := range x.HostingContractList {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
+			for iNdEx := len(x.HostingContractList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.HostingContractList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.HostingInquiryCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HostingInquiryCount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.HostingInquiryList) > 0 {
+			for iNdEx := len(x.HostingInquiryList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.HostingInquiryList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.FileEntryCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FileEntryCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.FileEntryList) > 0 {
+			for iNdEx := len(x.FileEntryList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FileEntryList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
 			}
 		}
-		if x.HostingContractCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.HostingContractCount))
-		}
-		if len(x.HostingOfferList) > 0 {
-			for _, e := range x.HostingOfferList {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
-		if x.HostingOfferCount != 0 {
-			n += 1 + runtime.Sov(uint64(x.HostingOfferCount))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
 		}
-		return protoiface.SizeOutput{
+		return protoiface.MarshalOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+			Buf:               input.Buf,
+		}, nil
 	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
 		x := input.Message.Interface().(*GenesisState)
 		if x == nil {
-			return protoiface.MarshalOutput{
+			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
+				Flags:             input.Flags,
 			}, nil
 		}
-		options := runtime.MarshalInputToOptions(input)
+		options := runtime.UnmarshalInputToOptions(input)
 		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.HostingOfferCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.HostingOfferCount))
-			i--
-			dAtA[i] = 0x48
-		}
-		if len(x.HostingOfferList) > 0 {
-			for iNdEx := len(x.HostingOfferList) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.HostingOfferList[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FileEntryList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FileEntryList = append(x.FileEntryList, &FileEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FileEntryList[len(x.FileEntryList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FileEntryCount", wireType)
+				}
+				x.FileEntryCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FileEntryCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostingInquiryList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HostingInquiryList = append(x.HostingInquiryList, &HostingInquiry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HostingInquiryList[len(x.HostingInquiryList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostingInquiryCount", wireType)
+				}
+				x.HostingInquiryCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HostingInquiryCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostingContractList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x42
-			}
-		}
-		if x.HostingContractCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.HostingContractCount))
-			i--
-			dAtA[i] = 0x38
-		}
-		if len(x.HostingContractList) > 0 {
-			for iNdEx := len(x.HostingContractList) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.HostingContractList[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x32
-			}
-		}
-		if x.HostingInquiryCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.HostingInquiryCount))
-			i--
-			dAtA[i] = 0x28
-		}
-		if len(x.HostingInquiryList) > 0 {
-			for iNdEx := len(x.HostingInquiryList) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.HostingInquiryList[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x22
-			}
-		}
-		if x.FileEntryCount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.FileEntryCount))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.FileEntryList) > 0 {
-			for iNdEx := len(x.FileEntryList) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.FileEntryList[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HostingContractList = append(x.HostingContractList, &HostingContract{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HostingContractList[len(x.HostingContractList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostingContractCount", wireType)
+				}
+				x.HostingContractCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HostingContractCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostingOfferList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HostingOfferList = append(x.HostingOfferList, &HostingOffer{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HostingOfferList[len(x.HostingOfferList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostingOfferCount", wireType)
+				}
+				x.HostingOfferCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HostingOfferCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StorageChallengeList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StorageChallengeList = append(x.StorageChallengeList, &StorageChallenge{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StorageChallengeList[len(x.StorageChallengeList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StorageChallengeCount", wireType)
+				}
+				x.StorageChallengeCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StorageChallengeCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashEventList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashEventList = append(x.SlashEventList, &SlashEvent{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SlashEventList[len(x.SlashEventList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashEventCount", wireType)
+				}
+				x.SlashEventCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashEventCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowRecordList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.EscrowRecordList = append(x.EscrowRecordList, &EscrowRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EscrowRecordList[len(x.EscrowRecordList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 15:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderStakeList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -939,16 +2605,48 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				x.ProviderStakeList = append(x.ProviderStakeList, &ProviderStake{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProviderStakeList[len(x.ProviderStakeList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PaymentHistoryList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PaymentHistoryList = append(x.PaymentHistoryList, &PaymentHistory{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PaymentHistoryList[len(x.PaymentHistoryList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 17:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FileEntryList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingEntryList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -975,16 +2673,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.FileEntryList = append(x.FileEntryList, &FileEntry{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FileEntryList[len(x.FileEntryList)-1]); err != nil {
+				x.UnbondingEntryList = append(x.UnbondingEntryList, &UnbondingEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnbondingEntryList[len(x.UnbondingEntryList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
+			case 18:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FileEntryCount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingEntryCount", wireType)
 				}
-				x.FileEntryCount = 0
+				x.UnbondingEntryCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -994,14 +2692,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.FileEntryCount |= uint64(b&0x7F) << shift
+					x.UnbondingEntryCount |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 4:
+			case 19:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostingInquiryList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1028,16 +2726,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.HostingInquiryList = append(x.HostingInquiryList, &HostingInquiry{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HostingInquiryList[len(x.HostingInquiryList)-1]); err != nil {
+				x.AuctionList = append(x.AuctionList, &Auction{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AuctionList[len(x.AuctionList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostingInquiryCount", wireType)
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionBidList", wireType)
 				}
-				x.HostingInquiryCount = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1047,14 +2745,29 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.HostingInquiryCount |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 6:
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AuctionBidList = append(x.AuctionBidList, &AuctionBid{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AuctionBidList[len(x.AuctionBidList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 21:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostingContractList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderProfileList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1081,16 +2794,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.HostingContractList = append(x.HostingContractList, &HostingContract{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HostingContractList[len(x.HostingContractList)-1]); err != nil {
+				x.ProviderProfileList = append(x.ProviderProfileList, &ProviderProfile{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProviderProfileList[len(x.ProviderProfileList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 7:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostingContractCount", wireType)
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderReputationList", wireType)
 				}
-				x.HostingContractCount = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1100,14 +2813,29 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.HostingContractCount |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 8:
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProviderReputationList = append(x.ProviderReputationList, &ProviderReputation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProviderReputationList[len(x.ProviderReputationList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 23:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostingOfferList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderPaymentList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1134,16 +2862,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.HostingOfferList = append(x.HostingOfferList, &HostingOffer{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HostingOfferList[len(x.HostingOfferList)-1]); err != nil {
+				x.ProviderPaymentList = append(x.ProviderPaymentList, &ProviderPayment{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProviderPaymentList[len(x.ProviderPaymentList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 9:
+			case 24:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HostingOfferCount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderPaymentCount", wireType)
 				}
-				x.HostingOfferCount = 0
+				x.ProviderPaymentCount = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1153,7 +2881,7 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.HostingOfferCount |= uint64(b&0x7F) << shift
+					x.ProviderPaymentCount |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -1213,15 +2941,30 @@ type GenesisState struct {
 	unknownFields protoimpl.UnknownFields
 
 	// params defines all the parameters of the module.
-	Params               *Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	FileEntryList        []*FileEntry       `protobuf:"bytes,2,rep,name=fileEntryList,proto3" json:"fileEntryList,omitempty"`
-	FileEntryCount       uint64             `protobuf:"varint,3,opt,name=fileEntryCount,proto3" json:"fileEntryCount,omitempty"`
-	HostingInquiryList   []*HostingInquiry  `protobuf:"bytes,4,rep,name=hostingInquiryList,proto3" json:"hostingInquiryList,omitempty"`
-	HostingInquiryCount  uint64             `protobuf:"varint,5,opt,name=hostingInquiryCount,proto3" json:"hostingInquiryCount,omitempty"`
-	HostingContractList  []*HostingContract `protobuf:"bytes,6,rep,name=hostingContractList,proto3" json:"hostingContractList,omitempty"`
-	HostingContractCount uint64             `protobuf:"varint,7,opt,name=hostingContractCount,proto3" json:"hostingContractCount,omitempty"`
-	HostingOfferList     []*HostingOffer    `protobuf:"bytes,8,rep,name=hostingOfferList,proto3" json:"hostingOfferList,omitempty"`
-	HostingOfferCount    uint64             `protobuf:"varint,9,opt,name=hostingOfferCount,proto3" json:"hostingOfferCount,omitempty"`
+	Params                 *Params               `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	FileEntryList          []*FileEntry          `protobuf:"bytes,2,rep,name=fileEntryList,proto3" json:"fileEntryList,omitempty"`
+	FileEntryCount         uint64                `protobuf:"varint,3,opt,name=fileEntryCount,proto3" json:"fileEntryCount,omitempty"`
+	HostingInquiryList     []*HostingInquiry     `protobuf:"bytes,4,rep,name=hostingInquiryList,proto3" json:"hostingInquiryList,omitempty"`
+	HostingInquiryCount    uint64                `protobuf:"varint,5,opt,name=hostingInquiryCount,proto3" json:"hostingInquiryCount,omitempty"`
+	HostingContractList    []*HostingContract    `protobuf:"bytes,6,rep,name=hostingContractList,proto3" json:"hostingContractList,omitempty"`
+	HostingContractCount   uint64                `protobuf:"varint,7,opt,name=hostingContractCount,proto3" json:"hostingContractCount,omitempty"`
+	HostingOfferList       []*HostingOffer       `protobuf:"bytes,8,rep,name=hostingOfferList,proto3" json:"hostingOfferList,omitempty"`
+	HostingOfferCount      uint64                `protobuf:"varint,9,opt,name=hostingOfferCount,proto3" json:"hostingOfferCount,omitempty"`
+	StorageChallengeList   []*StorageChallenge   `protobuf:"bytes,10,rep,name=storageChallengeList,proto3" json:"storageChallengeList,omitempty"`
+	StorageChallengeCount  uint64                `protobuf:"varint,11,opt,name=storageChallengeCount,proto3" json:"storageChallengeCount,omitempty"`
+	SlashEventList         []*SlashEvent         `protobuf:"bytes,12,rep,name=slashEventList,proto3" json:"slashEventList,omitempty"`
+	SlashEventCount        uint64                `protobuf:"varint,13,opt,name=slashEventCount,proto3" json:"slashEventCount,omitempty"`
+	EscrowRecordList       []*EscrowRecord       `protobuf:"bytes,14,rep,name=escrowRecordList,proto3" json:"escrowRecordList,omitempty"`
+	ProviderStakeList      []*ProviderStake      `protobuf:"bytes,15,rep,name=providerStakeList,proto3" json:"providerStakeList,omitempty"`
+	PaymentHistoryList     []*PaymentHistory     `protobuf:"bytes,16,rep,name=paymentHistoryList,proto3" json:"paymentHistoryList,omitempty"`
+	UnbondingEntryList     []*UnbondingEntry     `protobuf:"bytes,17,rep,name=unbondingEntryList,proto3" json:"unbondingEntryList,omitempty"`
+	UnbondingEntryCount    uint64                `protobuf:"varint,18,opt,name=unbondingEntryCount,proto3" json:"unbondingEntryCount,omitempty"`
+	AuctionList            []*Auction            `protobuf:"bytes,19,rep,name=auctionList,proto3" json:"auctionList,omitempty"`
+	AuctionBidList         []*AuctionBid         `protobuf:"bytes,20,rep,name=auctionBidList,proto3" json:"auctionBidList,omitempty"`
+	ProviderProfileList    []*ProviderProfile    `protobuf:"bytes,21,rep,name=providerProfileList,proto3" json:"providerProfileList,omitempty"`
+	ProviderReputationList []*ProviderReputation `protobuf:"bytes,22,rep,name=providerReputationList,proto3" json:"providerReputationList,omitempty"`
+	ProviderPaymentList    []*ProviderPayment    `protobuf:"bytes,23,rep,name=providerPaymentList,proto3" json:"providerPaymentList,omitempty"`
+	ProviderPaymentCount   uint64                `protobuf:"varint,24,opt,name=providerPaymentCount,proto3" json:"providerPaymentCount,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1307,6 +3050,111 @@ func (x *GenesisState) GetHostingOfferCount() uint64 {
 	return 0
 }
 
+func (x *GenesisState) GetStorageChallengeList() []*StorageChallenge {
+	if x != nil {
+		return x.StorageChallengeList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetStorageChallengeCount() uint64 {
+	if x != nil {
+		return x.StorageChallengeCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetSlashEventList() []*SlashEvent {
+	if x != nil {
+		return x.SlashEventList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSlashEventCount() uint64 {
+	if x != nil {
+		return x.SlashEventCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetEscrowRecordList() []*EscrowRecord {
+	if x != nil {
+		return x.EscrowRecordList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetProviderStakeList() []*ProviderStake {
+	if x != nil {
+		return x.ProviderStakeList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPaymentHistoryList() []*PaymentHistory {
+	if x != nil {
+		return x.PaymentHistoryList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetUnbondingEntryList() []*UnbondingEntry {
+	if x != nil {
+		return x.UnbondingEntryList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetUnbondingEntryCount() uint64 {
+	if x != nil {
+		return x.UnbondingEntryCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetAuctionList() []*Auction {
+	if x != nil {
+		return x.AuctionList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAuctionBidList() []*AuctionBid {
+	if x != nil {
+		return x.AuctionBidList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetProviderProfileList() []*ProviderProfile {
+	if x != nil {
+		return x.ProviderProfileList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetProviderReputationList() []*ProviderReputation {
+	if x != nil {
+		return x.ProviderReputationList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetProviderPaymentList() []*ProviderPayment {
+	if x != nil {
+		return x.ProviderPaymentList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetProviderPaymentCount() uint64 {
+	if x != nil {
+		return x.ProviderPaymentCount
+	}
+	return 0
+}
+
 var File_filespacechain_filespacechain_genesis_proto protoreflect.FileDescriptor
 
 var file_filespacechain_filespacechain_genesis_proto_rawDesc = []byte{
@@ -1332,49 +3180,147 @@ var file_filespacechain_filespacechain_genesis_proto_rawDesc = []byte{
 	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x31, 0x66, 0x69,
 	0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x69, 0x6c,
 	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x68, 0x6f, 0x73, 0x74,
-	0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x96, 0x05, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x48, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x54, 0x0a, 0x0d, 0x66, 0x69,
-	0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x28, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x31, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x2c, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x2b, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2b, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x34, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x95, 0x0f, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x48, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61,
 	0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f,
-	0x00, 0x52, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x26, 0x0a, 0x0e, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x63, 0x0a, 0x12, 0x68, 0x6f, 0x73, 0x74,
-	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x71, 0x75,
-	0x69, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x68, 0x6f, 0x73, 0x74, 0x69,
-	0x6e, 0x67, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a,
-	0x13, 0x68, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x68, 0x6f, 0x73, 0x74,
-	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x66, 0x0a, 0x13, 0x68, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x66,
-	0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69,
-	0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x48, 0x6f, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x13, 0x68, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x14, 0x68, 0x6f, 0x73, 0x74, 0x69,
-	0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x68, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5d, 0x0a, 0x10, 0x68,
-	0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x18,
-	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x6e, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x54, 0x0a, 0x0d, 0x66,
+	0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x63, 0x0a, 0x12, 0x68, 0x6f, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
 	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x66,
-	0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x68, 0x6f, 0x73, 0x74, 0x69, 0x6e,
-	0x67, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x68, 0x6f,
-	0x73, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x68, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x66,
-	0x66, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0xf6, 0x01, 0x0a, 0x21, 0x63, 0x6f, 0x6d,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x71,
+	0x75, 0x69, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x68, 0x6f, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30,
+	0x0a, 0x13, 0x68, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x68, 0x6f, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x66, 0x0a, 0x13, 0x68, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x48, 0x6f,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x13, 0x68, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x14, 0x68, 0x6f, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x68, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5d, 0x0a, 0x10,
+	0x68, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x68, 0x6f, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x68,
+	0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x68, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x69, 0x0a, 0x14, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x15, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x57, 0x0a, 0x0e, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5d, 0x0a,
+	0x10, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x65, 0x73, 0x63, 0x72,
+	0x6f, 0x77, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x60, 0x0a, 0x11,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x63,
+	0x0a, 0x12, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x4c, 0x69, 0x73, 0x74, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x12, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x63, 0x0a, 0x12, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x75, 0x6e, 0x62, 0x6f,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4e, 0x0a, 0x0b, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0e, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x14, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x64, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x66, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x6f, 0x0a, 0x16, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x16, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x66, 0x0a, 0x13,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x13, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x18, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x14, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0xf6, 0x01, 0x0a, 0x21, 0x63, 0x6f, 0x6d,
 	0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
 	0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x0c,
 	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e,
@@ -1407,24 +3353,46 @@ func file_filespacechain_filespacechain_genesis_proto_rawDescGZIP() []byte {
 
 var file_filespacechain_filespacechain_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_filespacechain_filespacechain_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),    // 0: filespacechain.filespacechain.GenesisState
-	(*Params)(nil),          // 1: filespacechain.filespacechain.Params
-	(*FileEntry)(nil),       // 2: filespacechain.filespacechain.FileEntry
-	(*HostingInquiry)(nil),  // 3: filespacechain.filespacechain.HostingInquiry
-	(*HostingContract)(nil), // 4: filespacechain.filespacechain.HostingContract
-	(*HostingOffer)(nil),    // 5: filespacechain.filespacechain.HostingOffer
+	(*GenesisState)(nil),       // 0: filespacechain.filespacechain.GenesisState
+	(*Params)(nil),             // 1: filespacechain.filespacechain.Params
+	(*FileEntry)(nil),          // 2: filespacechain.filespacechain.FileEntry
+	(*HostingInquiry)(nil),     // 3: filespacechain.filespacechain.HostingInquiry
+	(*HostingContract)(nil),    // 4: filespacechain.filespacechain.HostingContract
+	(*HostingOffer)(nil),       // 5: filespacechain.filespacechain.HostingOffer
+	(*StorageChallenge)(nil),   // 6: filespacechain.filespacechain.StorageChallenge
+	(*SlashEvent)(nil),         // 7: filespacechain.filespacechain.SlashEvent
+	(*EscrowRecord)(nil),       // 8: filespacechain.filespacechain.EscrowRecord
+	(*ProviderStake)(nil),      // 9: filespacechain.filespacechain.ProviderStake
+	(*PaymentHistory)(nil),     // 10: filespacechain.filespacechain.PaymentHistory
+	(*UnbondingEntry)(nil),     // 11: filespacechain.filespacechain.UnbondingEntry
+	(*Auction)(nil),            // 12: filespacechain.filespacechain.Auction
+	(*AuctionBid)(nil),         // 13: filespacechain.filespacechain.AuctionBid
+	(*ProviderProfile)(nil),    // 14: filespacechain.filespacechain.ProviderProfile
+	(*ProviderReputation)(nil), // 15: filespacechain.filespacechain.ProviderReputation
+	(*ProviderPayment)(nil),    // 16: filespacechain.filespacechain.ProviderPayment
 }
 var file_filespacechain_filespacechain_genesis_proto_depIdxs = []int32{
-	1, // 0: filespacechain.filespacechain.GenesisState.params:type_name -> filespacechain.filespacechain.Params
-	2, // 1: filespacechain.filespacechain.GenesisState.fileEntryList:type_name -> filespacechain.filespacechain.FileEntry
-	3, // 2: filespacechain.filespacechain.GenesisState.hostingInquiryList:type_name -> filespacechain.filespacechain.HostingInquiry
-	4, // 3: filespacechain.filespacechain.GenesisState.hostingContractList:type_name -> filespacechain.filespacechain.HostingContract
-	5, // 4: filespacechain.filespacechain.GenesisState.hostingOfferList:type_name -> filespacechain.filespacechain.HostingOffer
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	1,  // 0: filespacechain.filespacechain.GenesisState.params:type_name -> filespacechain.filespacechain.Params
+	2,  // 1: filespacechain.filespacechain.GenesisState.fileEntryList:type_name -> filespacechain.filespacechain.FileEntry
+	3,  // 2: filespacechain.filespacechain.GenesisState.hostingInquiryList:type_name -> filespacechain.filespacechain.HostingInquiry
+	4,  // 3: filespacechain.filespacechain.GenesisState.hostingContractList:type_name -> filespacechain.filespacechain.HostingContract
+	5,  // 4: filespacechain.filespacechain.GenesisState.hostingOfferList:type_name -> filespacechain.filespacechain.HostingOffer
+	6,  // 5: filespacechain.filespacechain.GenesisState.storageChallengeList:type_name -> filespacechain.filespacechain.StorageChallenge
+	7,  // 6: filespacechain.filespacechain.GenesisState.slashEventList:type_name -> filespacechain.filespacechain.SlashEvent
+	8,  // 7: filespacechain.filespacechain.GenesisState.escrowRecordList:type_name -> filespacechain.filespacechain.EscrowRecord
+	9,  // 8: filespacechain.filespacechain.GenesisState.providerStakeList:type_name -> filespacechain.filespacechain.ProviderStake
+	10, // 9: filespacechain.filespacechain.GenesisState.paymentHistoryList:type_name -> filespacechain.filespacechain.PaymentHistory
+	11, // 10: filespacechain.filespacechain.GenesisState.unbondingEntryList:type_name -> filespacechain.filespacechain.UnbondingEntry
+	12, // 11: filespacechain.filespacechain.GenesisState.auctionList:type_name -> filespacechain.filespacechain.Auction
+	13, // 12: filespacechain.filespacechain.GenesisState.auctionBidList:type_name -> filespacechain.filespacechain.AuctionBid
+	14, // 13: filespacechain.filespacechain.GenesisState.providerProfileList:type_name -> filespacechain.filespacechain.ProviderProfile
+	15, // 14: filespacechain.filespacechain.GenesisState.providerReputationList:type_name -> filespacechain.filespacechain.ProviderReputation
+	16, // 15: filespacechain.filespacechain.GenesisState.providerPaymentList:type_name -> filespacechain.filespacechain.ProviderPayment
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_filespacechain_filespacechain_genesis_proto_init() }
@@ -1437,6 +3405,12 @@ func file_filespacechain_filespacechain_genesis_proto_init() {
 	file_filespacechain_filespacechain_hosting_inquiry_proto_init()
 	file_filespacechain_filespacechain_hosting_contract_proto_init()
 	file_filespacechain_filespacechain_hosting_offer_proto_init()
+	file_filespacechain_filespacechain_storage_proof_proto_init()
+	file_filespacechain_filespacechain_slashing_proto_init()
+	file_filespacechain_filespacechain_payment_proto_init()
+	file_filespacechain_filespacechain_auction_proto_init()
+	file_filespacechain_filespacechain_provider_profile_proto_init()
+	file_filespacechain_filespacechain_reputation_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_filespacechain_filespacechain_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -2,8 +2,10 @@
 package filespacechain
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -13,11 +15,21 @@ import (
 )
 
 var (
-	md_HostingContract           protoreflect.MessageDescriptor
-	fd_HostingContract_id        protoreflect.FieldDescriptor
-	fd_HostingContract_inquiryId protoreflect.FieldDescriptor
-	fd_HostingContract_offerId   protoreflect.FieldDescriptor
-	fd_HostingContract_creator   protoreflect.FieldDescriptor
+	md_HostingContract                    protoreflect.MessageDescriptor
+	fd_HostingContract_id                 protoreflect.FieldDescriptor
+	fd_HostingContract_inquiryId          protoreflect.FieldDescriptor
+	fd_HostingContract_offerId            protoreflect.FieldDescriptor
+	fd_HostingContract_creator            protoreflect.FieldDescriptor
+	fd_HostingContract_startBlock         protoreflect.FieldDescriptor
+	fd_HostingContract_endBlock           protoreflect.FieldDescriptor
+	fd_HostingContract_slashedBlock       protoreflect.FieldDescriptor
+	fd_HostingContract_status             protoreflect.FieldDescriptor
+	fd_HostingContract_acceptanceDeadline protoreflect.FieldDescriptor
+	fd_HostingContract_attestation        protoreflect.FieldDescriptor
+	fd_HostingContract_acceptanceChunk    protoreflect.FieldDescriptor
+	fd_HostingContract_pricePerBlock      protoreflect.FieldDescriptor
+	fd_HostingContract_reservedCapacity   protoreflect.FieldDescriptor
+	fd_HostingContract_transferOfferId    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -27,6 +39,16 @@ func init() {
 	fd_HostingContract_inquiryId = md_HostingContract.Fields().ByName("inquiryId")
 	fd_HostingContract_offerId = md_HostingContract.Fields().ByName("offerId")
 	fd_HostingContract_creator = md_HostingContract.Fields().ByName("creator")
+	fd_HostingContract_startBlock = md_HostingContract.Fields().ByName("startBlock")
+	fd_HostingContract_endBlock = md_HostingContract.Fields().ByName("endBlock")
+	fd_HostingContract_slashedBlock = md_HostingContract.Fields().ByName("slashedBlock")
+	fd_HostingContract_status = md_HostingContract.Fields().ByName("status")
+	fd_HostingContract_acceptanceDeadline = md_HostingContract.Fields().ByName("acceptanceDeadline")
+	fd_HostingContract_attestation = md_HostingContract.Fields().ByName("attestation")
+	fd_HostingContract_acceptanceChunk = md_HostingContract.Fields().ByName("acceptanceChunk")
+	fd_HostingContract_pricePerBlock = md_HostingContract.Fields().ByName("pricePerBlock")
+	fd_HostingContract_reservedCapacity = md_HostingContract.Fields().ByName("reservedCapacity")
+	fd_HostingContract_transferOfferId = md_HostingContract.Fields().ByName("transferOfferId")
 }
 
 var _ protoreflect.Message = (*fastReflection_HostingContract)(nil)
@@ -118,6 +140,66 @@ func (x *fastReflection_HostingContract) Range(f func(protoreflect.FieldDescript
 			return
 		}
 	}
+	if x.StartBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartBlock)
+		if !f(fd_HostingContract_startBlock, value) {
+			return
+		}
+	}
+	if x.EndBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EndBlock)
+		if !f(fd_HostingContract_endBlock, value) {
+			return
+		}
+	}
+	if x.SlashedBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SlashedBlock)
+		if !f(fd_HostingContract_slashedBlock, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_HostingContract_status, value) {
+			return
+		}
+	}
+	if x.AcceptanceDeadline != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AcceptanceDeadline)
+		if !f(fd_HostingContract_acceptanceDeadline, value) {
+			return
+		}
+	}
+	if len(x.Attestation) != 0 {
+		value := protoreflect.ValueOfBytes(x.Attestation)
+		if !f(fd_HostingContract_attestation, value) {
+			return
+		}
+	}
+	if x.AcceptanceChunk != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AcceptanceChunk)
+		if !f(fd_HostingContract_acceptanceChunk, value) {
+			return
+		}
+	}
+	if x.PricePerBlock != nil {
+		value := protoreflect.ValueOfMessage(x.PricePerBlock.ProtoReflect())
+		if !f(fd_HostingContract_pricePerBlock, value) {
+			return
+		}
+	}
+	if x.ReservedCapacity != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReservedCapacity)
+		if !f(fd_HostingContract_reservedCapacity, value) {
+			return
+		}
+	}
+	if x.TransferOfferId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TransferOfferId)
+		if !f(fd_HostingContract_transferOfferId, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -141,6 +223,26 @@ func (x *fastReflection_HostingContract) Has(fd protoreflect.FieldDescriptor) bo
 		return x.OfferId != uint64(0)
 	case "filespacechain.filespacechain.HostingContract.creator":
 		return x.Creator != ""
+	case "filespacechain.filespacechain.HostingContract.startBlock":
+		return x.StartBlock != uint64(0)
+	case "filespacechain.filespacechain.HostingContract.endBlock":
+		return x.EndBlock != uint64(0)
+	case "filespacechain.filespacechain.HostingContract.slashedBlock":
+		return x.SlashedBlock != uint64(0)
+	case "filespacechain.filespacechain.HostingContract.status":
+		return x.Status != 0
+	case "filespacechain.filespacechain.HostingContract.acceptanceDeadline":
+		return x.AcceptanceDeadline != uint64(0)
+	case "filespacechain.filespacechain.HostingContract.attestation":
+		return len(x.Attestation) != 0
+	case "filespacechain.filespacechain.HostingContract.acceptanceChunk":
+		return x.AcceptanceChunk != uint64(0)
+	case "filespacechain.filespacechain.HostingContract.pricePerBlock":
+		return x.PricePerBlock != nil
+	case "filespacechain.filespacechain.HostingContract.reservedCapacity":
+		return x.ReservedCapacity != uint64(0)
+	case "filespacechain.filespacechain.HostingContract.transferOfferId":
+		return x.TransferOfferId != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.HostingContract"))
@@ -165,6 +267,26 @@ func (x *fastReflection_HostingContract) Clear(fd protoreflect.FieldDescriptor)
 		x.OfferId = uint64(0)
 	case "filespacechain.filespacechain.HostingContract.creator":
 		x.Creator = ""
+	case "filespacechain.filespacechain.HostingContract.startBlock":
+		x.StartBlock = uint64(0)
+	case "filespacechain.filespacechain.HostingContract.endBlock":
+		x.EndBlock = uint64(0)
+	case "filespacechain.filespacechain.HostingContract.slashedBlock":
+		x.SlashedBlock = uint64(0)
+	case "filespacechain.filespacechain.HostingContract.status":
+		x.Status = 0
+	case "filespacechain.filespacechain.HostingContract.acceptanceDeadline":
+		x.AcceptanceDeadline = uint64(0)
+	case "filespacechain.filespacechain.HostingContract.attestation":
+		x.Attestation = nil
+	case "filespacechain.filespacechain.HostingContract.acceptanceChunk":
+		x.AcceptanceChunk = uint64(0)
+	case "filespacechain.filespacechain.HostingContract.pricePerBlock":
+		x.PricePerBlock = nil
+	case "filespacechain.filespacechain.HostingContract.reservedCapacity":
+		x.ReservedCapacity = uint64(0)
+	case "filespacechain.filespacechain.HostingContract.transferOfferId":
+		x.TransferOfferId = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.HostingContract"))
@@ -193,6 +315,36 @@ func (x *fastReflection_HostingContract) Get(descriptor protoreflect.FieldDescri
 	case "filespacechain.filespacechain.HostingContract.creator":
 		value := x.Creator
 		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.HostingContract.startBlock":
+		value := x.StartBlock
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.HostingContract.endBlock":
+		value := x.EndBlock
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.HostingContract.slashedBlock":
+		value := x.SlashedBlock
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.HostingContract.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "filespacechain.filespacechain.HostingContract.acceptanceDeadline":
+		value := x.AcceptanceDeadline
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.HostingContract.attestation":
+		value := x.Attestation
+		return protoreflect.ValueOfBytes(value)
+	case "filespacechain.filespacechain.HostingContract.acceptanceChunk":
+		value := x.AcceptanceChunk
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.HostingContract.pricePerBlock":
+		value := x.PricePerBlock
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "filespacechain.filespacechain.HostingContract.reservedCapacity":
+		value := x.ReservedCapacity
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.HostingContract.transferOfferId":
+		value := x.TransferOfferId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.HostingContract"))
@@ -221,6 +373,26 @@ func (x *fastReflection_HostingContract) Set(fd protoreflect.FieldDescriptor, va
 		x.OfferId = value.Uint()
 	case "filespacechain.filespacechain.HostingContract.creator":
 		x.Creator = value.Interface().(string)
+	case "filespacechain.filespacechain.HostingContract.startBlock":
+		x.StartBlock = value.Uint()
+	case "filespacechain.filespacechain.HostingContract.endBlock":
+		x.EndBlock = value.Uint()
+	case "filespacechain.filespacechain.HostingContract.slashedBlock":
+		x.SlashedBlock = value.Uint()
+	case "filespacechain.filespacechain.HostingContract.status":
+		x.Status = (ContractStatus)(value.Enum())
+	case "filespacechain.filespacechain.HostingContract.acceptanceDeadline":
+		x.AcceptanceDeadline = value.Uint()
+	case "filespacechain.filespacechain.HostingContract.attestation":
+		x.Attestation = value.Bytes()
+	case "filespacechain.filespacechain.HostingContract.acceptanceChunk":
+		x.AcceptanceChunk = value.Uint()
+	case "filespacechain.filespacechain.HostingContract.pricePerBlock":
+		x.PricePerBlock = value.Message().Interface().(*v1beta1.Coin)
+	case "filespacechain.filespacechain.HostingContract.reservedCapacity":
+		x.ReservedCapacity = value.Uint()
+	case "filespacechain.filespacechain.HostingContract.transferOfferId":
+		x.TransferOfferId = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.HostingContract"))
@@ -241,6 +413,11 @@ func (x *fastReflection_HostingContract) Set(fd protoreflect.FieldDescriptor, va
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_HostingContract) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "filespacechain.filespacechain.HostingContract.pricePerBlock":
+		if x.PricePerBlock == nil {
+			x.PricePerBlock = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.PricePerBlock.ProtoReflect())
 	case "filespacechain.filespacechain.HostingContract.id":
 		panic(fmt.Errorf("field id of message filespacechain.filespacechain.HostingContract is not mutable"))
 	case "filespacechain.filespacechain.HostingContract.inquiryId":
@@ -249,6 +426,24 @@ func (x *fastReflection_HostingContract) Mutable(fd protoreflect.FieldDescriptor
 		panic(fmt.Errorf("field offerId of message filespacechain.filespacechain.HostingContract is not mutable"))
 	case "filespacechain.filespacechain.HostingContract.creator":
 		panic(fmt.Errorf("field creator of message filespacechain.filespacechain.HostingContract is not mutable"))
+	case "filespacechain.filespacechain.HostingContract.startBlock":
+		panic(fmt.Errorf("field startBlock of message filespacechain.filespacechain.HostingContract is not mutable"))
+	case "filespacechain.filespacechain.HostingContract.endBlock":
+		panic(fmt.Errorf("field endBlock of message filespacechain.filespacechain.HostingContract is not mutable"))
+	case "filespacechain.filespacechain.HostingContract.slashedBlock":
+		panic(fmt.Errorf("field slashedBlock of message filespacechain.filespacechain.HostingContract is not mutable"))
+	case "filespacechain.filespacechain.HostingContract.status":
+		panic(fmt.Errorf("field status of message filespacechain.filespacechain.HostingContract is not mutable"))
+	case "filespacechain.filespacechain.HostingContract.acceptanceDeadline":
+		panic(fmt.Errorf("field acceptanceDeadline of message filespacechain.filespacechain.HostingContract is not mutable"))
+	case "filespacechain.filespacechain.HostingContract.attestation":
+		panic(fmt.Errorf("field attestation of message filespacechain.filespacechain.HostingContract is not mutable"))
+	case "filespacechain.filespacechain.HostingContract.acceptanceChunk":
+		panic(fmt.Errorf("field acceptanceChunk of message filespacechain.filespacechain.HostingContract is not mutable"))
+	case "filespacechain.filespacechain.HostingContract.reservedCapacity":
+		panic(fmt.Errorf("field reservedCapacity of message filespacechain.filespacechain.HostingContract is not mutable"))
+	case "filespacechain.filespacechain.HostingContract.transferOfferId":
+		panic(fmt.Errorf("field transferOfferId of message filespacechain.filespacechain.HostingContract is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.HostingContract"))
@@ -270,6 +465,27 @@ func (x *fastReflection_HostingContract) NewField(fd protoreflect.FieldDescripto
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "filespacechain.filespacechain.HostingContract.creator":
 		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.HostingContract.startBlock":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.HostingContract.endBlock":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.HostingContract.slashedBlock":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.HostingContract.status":
+		return protoreflect.ValueOfEnum(0)
+	case "filespacechain.filespacechain.HostingContract.acceptanceDeadline":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.HostingContract.attestation":
+		return protoreflect.ValueOfBytes(nil)
+	case "filespacechain.filespacechain.HostingContract.acceptanceChunk":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.HostingContract.pricePerBlock":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "filespacechain.filespacechain.HostingContract.reservedCapacity":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.HostingContract.transferOfferId":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.HostingContract"))
@@ -352,6 +568,38 @@ func (x *fastReflection_HostingContract) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.StartBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartBlock))
+		}
+		if x.EndBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.EndBlock))
+		}
+		if x.SlashedBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashedBlock))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.AcceptanceDeadline != 0 {
+			n += 1 + runtime.Sov(uint64(x.AcceptanceDeadline))
+		}
+		l = len(x.Attestation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AcceptanceChunk != 0 {
+			n += 1 + runtime.Sov(uint64(x.AcceptanceChunk))
+		}
+		if x.PricePerBlock != nil {
+			l = options.Size(x.PricePerBlock)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ReservedCapacity != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReservedCapacity))
+		}
+		if x.TransferOfferId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TransferOfferId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -381,6 +629,67 @@ func (x *fastReflection_HostingContract) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.TransferOfferId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TransferOfferId))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.ReservedCapacity != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReservedCapacity))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.PricePerBlock != nil {
+			encoded, err := options.Marshal(x.PricePerBlock)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.AcceptanceChunk != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AcceptanceChunk))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.Attestation) > 0 {
+			i -= len(x.Attestation)
+			copy(dAtA[i:], x.Attestation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Attestation)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.AcceptanceDeadline != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AcceptanceDeadline))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.SlashedBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashedBlock))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.EndBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndBlock))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.StartBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartBlock))
+			i--
+			dAtA[i] = 0x28
+		}
 		if len(x.Creator) > 0 {
 			i -= len(x.Creator)
 			copy(dAtA[i:], x.Creator)
@@ -541,6 +850,228 @@ func (x *fastReflection_HostingContract) ProtoMethods() *protoiface.Methods {
 				}
 				x.Creator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+				}
+				x.StartBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+				}
+				x.EndBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EndBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashedBlock", wireType)
+				}
+				x.SlashedBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashedBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= ContractStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AcceptanceDeadline", wireType)
+				}
+				x.AcceptanceDeadline = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AcceptanceDeadline |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Attestation = append(x.Attestation[:0], dAtA[iNdEx:postIndex]...)
+				if x.Attestation == nil {
+					x.Attestation = []byte{}
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AcceptanceChunk", wireType)
+				}
+				x.AcceptanceChunk = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AcceptanceChunk |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PricePerBlock", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PricePerBlock == nil {
+					x.PricePerBlock = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PricePerBlock); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReservedCapacity", wireType)
+				}
+				x.ReservedCapacity = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReservedCapacity |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransferOfferId", wireType)
+				}
+				x.TransferOfferId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TransferOfferId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -589,15 +1120,93 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ContractStatus is the lifecycle state of a hosting contract. PENDING
+// contracts may become ACTIVE or TERMINATED; ACTIVE contracts end as
+// COMPLETED, TERMINATED or SLASHED. The last three are final.
+type ContractStatus int32
+
+const (
+	ContractStatus_CONTRACT_STATUS_PENDING    ContractStatus = 0
+	ContractStatus_CONTRACT_STATUS_ACTIVE     ContractStatus = 1
+	ContractStatus_CONTRACT_STATUS_COMPLETED  ContractStatus = 2
+	ContractStatus_CONTRACT_STATUS_TERMINATED ContractStatus = 3
+	ContractStatus_CONTRACT_STATUS_SLASHED    ContractStatus = 4
+)
+
+// Enum value maps for ContractStatus.
+var (
+	ContractStatus_name = map[int32]string{
+		0: "CONTRACT_STATUS_PENDING",
+		1: "CONTRACT_STATUS_ACTIVE",
+		2: "CONTRACT_STATUS_COMPLETED",
+		3: "CONTRACT_STATUS_TERMINATED",
+		4: "CONTRACT_STATUS_SLASHED",
+	}
+	ContractStatus_value = map[string]int32{
+		"CONTRACT_STATUS_PENDING":    0,
+		"CONTRACT_STATUS_ACTIVE":     1,
+		"CONTRACT_STATUS_COMPLETED":  2,
+		"CONTRACT_STATUS_TERMINATED": 3,
+		"CONTRACT_STATUS_SLASHED":    4,
+	}
+)
+
+func (x ContractStatus) Enum() *ContractStatus {
+	p := new(ContractStatus)
+	*p = x
+	return p
+}
+
+func (x ContractStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContractStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_filespacechain_filespacechain_hosting_contract_proto_enumTypes[0].Descriptor()
+}
+
+func (ContractStatus) Type() protoreflect.EnumType {
+	return &file_filespacechain_filespacechain_hosting_contract_proto_enumTypes[0]
+}
+
+func (x ContractStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContractStatus.Descriptor instead.
+func (ContractStatus) EnumDescriptor() ([]byte, []int) {
+	return file_filespacechain_filespacechain_hosting_contract_proto_rawDescGZIP(), []int{0}
+}
+
 type HostingContract struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	InquiryId uint64 `protobuf:"varint,2,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
-	OfferId   uint64 `protobuf:"varint,3,opt,name=offerId,proto3" json:"offerId,omitempty"`
-	Creator   string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id           uint64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	InquiryId    uint64         `protobuf:"varint,2,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	OfferId      uint64         `protobuf:"varint,3,opt,name=offerId,proto3" json:"offerId,omitempty"`
+	Creator      string         `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	StartBlock   uint64         `protobuf:"varint,5,opt,name=startBlock,proto3" json:"startBlock,omitempty"`
+	EndBlock     uint64         `protobuf:"varint,6,opt,name=endBlock,proto3" json:"endBlock,omitempty"`
+	SlashedBlock uint64         `protobuf:"varint,7,opt,name=slashedBlock,proto3" json:"slashedBlock,omitempty"`
+	Status       ContractStatus `protobuf:"varint,8,opt,name=status,proto3,enum=filespacechain.filespacechain.ContractStatus" json:"status,omitempty"`
+	// Last block at which the provider may accept a PENDING contract
+	AcceptanceDeadline uint64 `protobuf:"varint,9,opt,name=acceptanceDeadline,proto3" json:"acceptanceDeadline,omitempty"`
+	// Merkle root the provider computed over its copy of the file on acceptance
+	Attestation []byte `protobuf:"bytes,10,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	// Chunk of the file, drawn when the contract opens, whose Merkle proof the
+	// provider submits to accept it
+	AcceptanceChunk uint64 `protobuf:"varint,11,opt,name=acceptanceChunk,proto3" json:"acceptanceChunk,omitempty"`
+	// Price per block the provider hosts for: the offer price of a matched
+	// contract, the clearing price of an auctioned one
+	PricePerBlock *v1beta1.Coin `protobuf:"bytes,12,opt,name=pricePerBlock,proto3" json:"pricePerBlock,omitempty"`
+	// Bytes of the provider's capacity the contract holds until it is final:
+	// the size of the file when the contract opened
+	ReservedCapacity uint64 `protobuf:"varint,13,opt,name=reservedCapacity,proto3" json:"reservedCapacity,omitempty"`
+	// Offer of the provider the contract's provider proposed to hand it over
+	// to; zero while no hand-over is proposed
+	TransferOfferId uint64 `protobuf:"varint,14,opt,name=transferOfferId,proto3" json:"transferOfferId,omitempty"`
 }
 
 func (x *HostingContract) Reset() {
@@ -648,6 +1257,76 @@ func (x *HostingContract) GetCreator() string {
 	return ""
 }
 
+func (x *HostingContract) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+func (x *HostingContract) GetEndBlock() uint64 {
+	if x != nil {
+		return x.EndBlock
+	}
+	return 0
+}
+
+func (x *HostingContract) GetSlashedBlock() uint64 {
+	if x != nil {
+		return x.SlashedBlock
+	}
+	return 0
+}
+
+func (x *HostingContract) GetStatus() ContractStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ContractStatus_CONTRACT_STATUS_PENDING
+}
+
+func (x *HostingContract) GetAcceptanceDeadline() uint64 {
+	if x != nil {
+		return x.AcceptanceDeadline
+	}
+	return 0
+}
+
+func (x *HostingContract) GetAttestation() []byte {
+	if x != nil {
+		return x.Attestation
+	}
+	return nil
+}
+
+func (x *HostingContract) GetAcceptanceChunk() uint64 {
+	if x != nil {
+		return x.AcceptanceChunk
+	}
+	return 0
+}
+
+func (x *HostingContract) GetPricePerBlock() *v1beta1.Coin {
+	if x != nil {
+		return x.PricePerBlock
+	}
+	return nil
+}
+
+func (x *HostingContract) GetReservedCapacity() uint64 {
+	if x != nil {
+		return x.ReservedCapacity
+	}
+	return 0
+}
+
+func (x *HostingContract) GetTransferOfferId() uint64 {
+	if x != nil {
+		return x.TransferOfferId
+	}
+	return 0
+}
+
 var File_filespacechain_filespacechain_hosting_contract_proto protoreflect.FileDescriptor
 
 var file_filespacechain_filespacechain_hosting_contract_proto_rawDesc = []byte{
@@ -656,31 +1335,73 @@ var file_filespacechain_filespacechain_hosting_contract_proto_rawDesc = []byte{
 	0x68, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
 	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x22, 0x73, 0x0a, 0x0f, 0x48, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x71, 0x75,
-	0x69, 0x72, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x69, 0x6e, 0x71,
-	0x75, 0x69, 0x72, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x49,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x42, 0xfe, 0x01, 0x0a, 0x21, 0x63,
-	0x6f, 0x6d, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x42, 0x14, 0x48, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xa2, 0x02, 0x03, 0x46, 0x46, 0x58, 0xaa, 0x02,
-	0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
-	0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xca, 0x02,
-	0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c,
-	0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xe2, 0x02,
-	0x29, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c,
-	0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1e, 0x46, 0x69, 0x6c,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x46, 0x69, 0x6c,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb3, 0x04, 0x0a, 0x0f,
+	0x48, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x22, 0x0a,
+	0x0c, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2d, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x61,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x12, 0x45, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2a, 0x0a, 0x10, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x43,
+	0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x49,
+	0x64, 0x2a, 0xab, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x00, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x1d, 0x0a,
+	0x19, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a,
+	0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17,
+	0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x53, 0x4c, 0x41, 0x53, 0x48, 0x45, 0x44, 0x10, 0x04, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42,
+	0xfe, 0x01, 0x0a, 0x21, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x14, 0x48, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xa2, 0x02, 0x03,
+	0x46, 0x46, 0x58, 0xaa, 0x02, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0xca, 0x02, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0xe2, 0x02, 0x29, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x1e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x3a, 0x3a, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -695,16 +1416,21 @@ func file_filespacechain_filespacechain_hosting_contract_proto_rawDescGZIP() []b
 	return file_filespacechain_filespacechain_hosting_contract_proto_rawDescData
 }
 
+var file_filespacechain_filespacechain_hosting_contract_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_filespacechain_filespacechain_hosting_contract_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_filespacechain_filespacechain_hosting_contract_proto_goTypes = []interface{}{
-	(*HostingContract)(nil), // 0: filespacechain.filespacechain.HostingContract
+	(ContractStatus)(0),     // 0: filespacechain.filespacechain.ContractStatus
+	(*HostingContract)(nil), // 1: filespacechain.filespacechain.HostingContract
+	(*v1beta1.Coin)(nil),    // 2: cosmos.base.v1beta1.Coin
 }
 var file_filespacechain_filespacechain_hosting_contract_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: filespacechain.filespacechain.HostingContract.status:type_name -> filespacechain.filespacechain.ContractStatus
+	2, // 1: filespacechain.filespacechain.HostingContract.pricePerBlock:type_name -> cosmos.base.v1beta1.Coin
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_filespacechain_filespacechain_hosting_contract_proto_init() }
@@ -731,13 +1457,14 @@ func file_filespacechain_filespacechain_hosting_contract_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_filespacechain_filespacechain_hosting_contract_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_filespacechain_filespacechain_hosting_contract_proto_goTypes,
 		DependencyIndexes: file_filespacechain_filespacechain_hosting_contract_proto_depIdxs,
+		EnumInfos:         file_filespacechain_filespacechain_hosting_contract_proto_enumTypes,
 		MessageInfos:      file_filespacechain_filespacechain_hosting_contract_proto_msgTypes,
 	}.Build()
 	File_filespacechain_filespacechain_hosting_contract_proto = out.File
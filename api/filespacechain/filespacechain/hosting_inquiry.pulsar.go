@@ -14,6 +14,98 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_HostingInquiry_9_list)(nil)
+
+type _HostingInquiry_9_list struct {
+	list *[]string
+}
+
+func (x *_HostingInquiry_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_HostingInquiry_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_HostingInquiry_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_HostingInquiry_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_HostingInquiry_9_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message HostingInquiry at list field AllowedRegions as it is not of Message kind"))
+}
+
+func (x *_HostingInquiry_9_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_HostingInquiry_9_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_HostingInquiry_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_HostingInquiry_10_list)(nil)
+
+type _HostingInquiry_10_list struct {
+	list *[]string
+}
+
+func (x *_HostingInquiry_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_HostingInquiry_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_HostingInquiry_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_HostingInquiry_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_HostingInquiry_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message HostingInquiry at list field DeniedRegions as it is not of Message kind"))
+}
+
+func (x *_HostingInquiry_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_HostingInquiry_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_HostingInquiry_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_HostingInquiry                  protoreflect.MessageDescriptor
 	fd_HostingInquiry_id               protoreflect.FieldDescriptor
@@ -23,6 +115,10 @@ var (
 	fd_HostingInquiry_endTime          protoreflect.FieldDescriptor
 	fd_HostingInquiry_creator          protoreflect.FieldDescriptor
 	fd_HostingInquiry_maxPricePerBlock protoreflect.FieldDescriptor
+	fd_HostingInquiry_fileEntryId      protoreflect.FieldDescriptor
+	fd_HostingInquiry_allowedRegions   protoreflect.FieldDescriptor
+	fd_HostingInquiry_deniedRegions    protoreflect.FieldDescriptor
+	fd_HostingInquiry_filledReplicas   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -35,6 +131,10 @@ func init() {
 	fd_HostingInquiry_endTime = md_HostingInquiry.Fields().ByName("endTime")
 	fd_HostingInquiry_creator = md_HostingInquiry.Fields().ByName("creator")
 	fd_HostingInquiry_maxPricePerBlock = md_HostingInquiry.Fields().ByName("maxPricePerBlock")
+	fd_HostingInquiry_fileEntryId = md_HostingInquiry.Fields().ByName("fileEntryId")
+	fd_HostingInquiry_allowedRegions = md_HostingInquiry.Fields().ByName("allowedRegions")
+	fd_HostingInquiry_deniedRegions = md_HostingInquiry.Fields().ByName("deniedRegions")
+	fd_HostingInquiry_filledReplicas = md_HostingInquiry.Fields().ByName("filledReplicas")
 }
 
 var _ protoreflect.Message = (*fastReflection_HostingInquiry)(nil)
@@ -144,6 +244,30 @@ func (x *fastReflection_HostingInquiry) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if x.FileEntryId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FileEntryId)
+		if !f(fd_HostingInquiry_fileEntryId, value) {
+			return
+		}
+	}
+	if len(x.AllowedRegions) != 0 {
+		value := protoreflect.ValueOfList(&_HostingInquiry_9_list{list: &x.AllowedRegions})
+		if !f(fd_HostingInquiry_allowedRegions, value) {
+			return
+		}
+	}
+	if len(x.DeniedRegions) != 0 {
+		value := protoreflect.ValueOfList(&_HostingInquiry_10_list{list: &x.DeniedRegions})
+		if !f(fd_HostingInquiry_deniedRegions, value) {
+			return
+		}
+	}
+	if x.FilledReplicas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FilledReplicas)
+		if !f(fd_HostingInquiry_filledReplicas, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -173,6 +297,14 @@ func (x *fastReflection_HostingInquiry) Has(fd protoreflect.FieldDescriptor) boo
 		return x.Creator != ""
 	case "filespacechain.filespacechain.HostingInquiry.maxPricePerBlock":
 		return x.MaxPricePerBlock != uint64(0)
+	case "filespacechain.filespacechain.HostingInquiry.fileEntryId":
+		return x.FileEntryId != uint64(0)
+	case "filespacechain.filespacechain.HostingInquiry.allowedRegions":
+		return len(x.AllowedRegions) != 0
+	case "filespacechain.filespacechain.HostingInquiry.deniedRegions":
+		return len(x.DeniedRegions) != 0
+	case "filespacechain.filespacechain.HostingInquiry.filledReplicas":
+		return x.FilledReplicas != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.HostingInquiry"))
@@ -203,6 +335,14 @@ func (x *fastReflection_HostingInquiry) Clear(fd protoreflect.FieldDescriptor) {
 		x.Creator = ""
 	case "filespacechain.filespacechain.HostingInquiry.maxPricePerBlock":
 		x.MaxPricePerBlock = uint64(0)
+	case "filespacechain.filespacechain.HostingInquiry.fileEntryId":
+		x.FileEntryId = uint64(0)
+	case "filespacechain.filespacechain.HostingInquiry.allowedRegions":
+		x.AllowedRegions = nil
+	case "filespacechain.filespacechain.HostingInquiry.deniedRegions":
+		x.DeniedRegions = nil
+	case "filespacechain.filespacechain.HostingInquiry.filledReplicas":
+		x.FilledReplicas = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.HostingInquiry"))
@@ -240,6 +380,24 @@ func (x *fastReflection_HostingInquiry) Get(descriptor protoreflect.FieldDescrip
 	case "filespacechain.filespacechain.HostingInquiry.maxPricePerBlock":
 		value := x.MaxPricePerBlock
 		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.HostingInquiry.fileEntryId":
+		value := x.FileEntryId
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.HostingInquiry.allowedRegions":
+		if len(x.AllowedRegions) == 0 {
+			return protoreflect.ValueOfList(&_HostingInquiry_9_list{})
+		}
+		listValue := &_HostingInquiry_9_list{list: &x.AllowedRegions}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.HostingInquiry.deniedRegions":
+		if len(x.DeniedRegions) == 0 {
+			return protoreflect.ValueOfList(&_HostingInquiry_10_list{})
+		}
+		listValue := &_HostingInquiry_10_list{list: &x.DeniedRegions}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.HostingInquiry.filledReplicas":
+		value := x.FilledReplicas
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.HostingInquiry"))
@@ -274,6 +432,18 @@ func (x *fastReflection_HostingInquiry) Set(fd protoreflect.FieldDescriptor, val
 		x.Creator = value.Interface().(string)
 	case "filespacechain.filespacechain.HostingInquiry.maxPricePerBlock":
 		x.MaxPricePerBlock = value.Uint()
+	case "filespacechain.filespacechain.HostingInquiry.fileEntryId":
+		x.FileEntryId = value.Uint()
+	case "filespacechain.filespacechain.HostingInquiry.allowedRegions":
+		lv := value.List()
+		clv := lv.(*_HostingInquiry_9_list)
+		x.AllowedRegions = *clv.list
+	case "filespacechain.filespacechain.HostingInquiry.deniedRegions":
+		lv := value.List()
+		clv := lv.(*_HostingInquiry_10_list)
+		x.DeniedRegions = *clv.list
+	case "filespacechain.filespacechain.HostingInquiry.filledReplicas":
+		x.FilledReplicas = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.HostingInquiry"))
@@ -299,6 +469,18 @@ func (x *fastReflection_HostingInquiry) Mutable(fd protoreflect.FieldDescriptor)
 			x.EscrowAmount = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.EscrowAmount.ProtoReflect())
+	case "filespacechain.filespacechain.HostingInquiry.allowedRegions":
+		if x.AllowedRegions == nil {
+			x.AllowedRegions = []string{}
+		}
+		value := &_HostingInquiry_9_list{list: &x.AllowedRegions}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.HostingInquiry.deniedRegions":
+		if x.DeniedRegions == nil {
+			x.DeniedRegions = []string{}
+		}
+		value := &_HostingInquiry_10_list{list: &x.DeniedRegions}
+		return protoreflect.ValueOfList(value)
 	case "filespacechain.filespacechain.HostingInquiry.id":
 		panic(fmt.Errorf("field id of message filespacechain.filespacechain.HostingInquiry is not mutable"))
 	case "filespacechain.filespacechain.HostingInquiry.fileEntryCid":
@@ -311,6 +493,10 @@ func (x *fastReflection_HostingInquiry) Mutable(fd protoreflect.FieldDescriptor)
 		panic(fmt.Errorf("field creator of message filespacechain.filespacechain.HostingInquiry is not mutable"))
 	case "filespacechain.filespacechain.HostingInquiry.maxPricePerBlock":
 		panic(fmt.Errorf("field maxPricePerBlock of message filespacechain.filespacechain.HostingInquiry is not mutable"))
+	case "filespacechain.filespacechain.HostingInquiry.fileEntryId":
+		panic(fmt.Errorf("field fileEntryId of message filespacechain.filespacechain.HostingInquiry is not mutable"))
+	case "filespacechain.filespacechain.HostingInquiry.filledReplicas":
+		panic(fmt.Errorf("field filledReplicas of message filespacechain.filespacechain.HostingInquiry is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.HostingInquiry"))
@@ -339,6 +525,16 @@ func (x *fastReflection_HostingInquiry) NewField(fd protoreflect.FieldDescriptor
 		return protoreflect.ValueOfString("")
 	case "filespacechain.filespacechain.HostingInquiry.maxPricePerBlock":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.HostingInquiry.fileEntryId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.HostingInquiry.allowedRegions":
+		list := []string{}
+		return protoreflect.ValueOfList(&_HostingInquiry_9_list{list: &list})
+	case "filespacechain.filespacechain.HostingInquiry.deniedRegions":
+		list := []string{}
+		return protoreflect.ValueOfList(&_HostingInquiry_10_list{list: &list})
+	case "filespacechain.filespacechain.HostingInquiry.filledReplicas":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.HostingInquiry"))
@@ -432,6 +628,24 @@ func (x *fastReflection_HostingInquiry) ProtoMethods() *protoiface.Methods {
 		if x.MaxPricePerBlock != 0 {
 			n += 1 + runtime.Sov(uint64(x.MaxPricePerBlock))
 		}
+		if x.FileEntryId != 0 {
+			n += 1 + runtime.Sov(uint64(x.FileEntryId))
+		}
+		if len(x.AllowedRegions) > 0 {
+			for _, s := range x.AllowedRegions {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DeniedRegions) > 0 {
+			for _, s := range x.DeniedRegions {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.FilledReplicas != 0 {
+			n += 1 + runtime.Sov(uint64(x.FilledReplicas))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -461,6 +675,34 @@ func (x *fastReflection_HostingInquiry) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.FilledReplicas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FilledReplicas))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.DeniedRegions) > 0 {
+			for iNdEx := len(x.DeniedRegions) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.DeniedRegions[iNdEx])
+				copy(dAtA[i:], x.DeniedRegions[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DeniedRegions[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.AllowedRegions) > 0 {
+			for iNdEx := len(x.AllowedRegions) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedRegions[iNdEx])
+				copy(dAtA[i:], x.AllowedRegions[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedRegions[iNdEx])))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.FileEntryId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FileEntryId))
+			i--
+			dAtA[i] = 0x40
+		}
 		if x.MaxPricePerBlock != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPricePerBlock))
 			i--
@@ -734,6 +976,108 @@ func (x *fastReflection_HostingInquiry) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FileEntryId", wireType)
+				}
+				x.FileEntryId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FileEntryId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedRegions", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedRegions = append(x.AllowedRegions, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeniedRegions", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DeniedRegions = append(x.DeniedRegions, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FilledReplicas", wireType)
+				}
+				x.FilledReplicas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FilledReplicas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -794,6 +1138,16 @@ type HostingInquiry struct {
 	EndTime          uint64        `protobuf:"varint,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
 	Creator          string        `protobuf:"bytes,6,opt,name=creator,proto3" json:"creator,omitempty"`
 	MaxPricePerBlock uint64        `protobuf:"varint,7,opt,name=maxPricePerBlock,proto3" json:"maxPricePerBlock,omitempty"`
+	// File entry the inquiry hosts; CIDs are not unique, so contracts and
+	// challenges resolve the file through this id rather than fileEntryCid
+	FileEntryId uint64 `protobuf:"varint,8,opt,name=fileEntryId,proto3" json:"fileEntryId,omitempty"`
+	// Regions offers must be in to be matched; empty allows every region
+	AllowedRegions []string `protobuf:"bytes,9,rep,name=allowedRegions,proto3" json:"allowedRegions,omitempty"`
+	// Regions offers must not be in to be matched
+	DeniedRegions []string `protobuf:"bytes,10,rep,name=deniedRegions,proto3" json:"deniedRegions,omitempty"`
+	// Replicas held by contracts that have not reached a final status; the
+	// inquiry stays open to new offers while this is below replicationRate
+	FilledReplicas uint64 `protobuf:"varint,11,opt,name=filledReplicas,proto3" json:"filledReplicas,omitempty"`
 }
 
 func (x *HostingInquiry) Reset() {
@@ -865,6 +1219,34 @@ func (x *HostingInquiry) GetMaxPricePerBlock() uint64 {
 	return 0
 }
 
+func (x *HostingInquiry) GetFileEntryId() uint64 {
+	if x != nil {
+		return x.FileEntryId
+	}
+	return 0
+}
+
+func (x *HostingInquiry) GetAllowedRegions() []string {
+	if x != nil {
+		return x.AllowedRegions
+	}
+	return nil
+}
+
+func (x *HostingInquiry) GetDeniedRegions() []string {
+	if x != nil {
+		return x.DeniedRegions
+	}
+	return nil
+}
+
+func (x *HostingInquiry) GetFilledReplicas() uint64 {
+	if x != nil {
+		return x.FilledReplicas
+	}
+	return 0
+}
+
 var File_filespacechain_filespacechain_hosting_inquiry_proto protoreflect.FileDescriptor
 
 var file_filespacechain_filespacechain_hosting_inquiry_proto_rawDesc = []byte{
@@ -876,7 +1258,7 @@ var file_filespacechain_filespacechain_hosting_inquiry_proto_rawDesc = []byte{
 	0x68, 0x61, 0x69, 0x6e, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
 	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
-	0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x93, 0x02, 0x0a, 0x0e, 0x48,
+	0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xab, 0x03, 0x0a, 0x0e, 0x48,
 	0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x12, 0x0e, 0x0a,
 	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a,
 	0x0c, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x69, 0x64, 0x18, 0x02, 0x20,
@@ -894,23 +1276,32 @@ var file_filespacechain_filespacechain_hosting_inquiry_proto_rawDesc = []byte{
 	0x61, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65,
 	0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
 	0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x42, 0xfd, 0x01, 0x0a, 0x21, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x13, 0x48, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x49,
-	0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66,
-	0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xa2, 0x02, 0x03,
-	0x46, 0x46, 0x58, 0xaa, 0x02, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0xca, 0x02, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0xe2, 0x02, 0x29, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x1e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x3a, 0x3a, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x52, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65,
+	0x6e, 0x69, 0x65, 0x64, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0d, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x26, 0x0a, 0x0e, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x42, 0xfd, 0x01, 0x0a, 0x21, 0x63, 0x6f, 0x6d,
+	0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x13,
+	0x48, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0xa2, 0x02, 0x03, 0x46, 0x46, 0x58, 0xaa, 0x02, 0x1d, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xca, 0x02, 0x1d, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xe2, 0x02, 0x29, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -0,0 +1,810 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package filespacechain
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_OfferRejection          protoreflect.MessageDescriptor
+	fd_OfferRejection_offerId  protoreflect.FieldDescriptor
+	fd_OfferRejection_provider protoreflect.FieldDescriptor
+	fd_OfferRejection_reason   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_filespacechain_filespacechain_matching_proto_init()
+	md_OfferRejection = File_filespacechain_filespacechain_matching_proto.Messages().ByName("OfferRejection")
+	fd_OfferRejection_offerId = md_OfferRejection.Fields().ByName("offerId")
+	fd_OfferRejection_provider = md_OfferRejection.Fields().ByName("provider")
+	fd_OfferRejection_reason = md_OfferRejection.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_OfferRejection)(nil)
+
+type fastReflection_OfferRejection OfferRejection
+
+func (x *OfferRejection) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OfferRejection)(x)
+}
+
+func (x *OfferRejection) slowProtoReflect() protoreflect.Message {
+	mi := &file_filespacechain_filespacechain_matching_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OfferRejection_messageType fastReflection_OfferRejection_messageType
+var _ protoreflect.MessageType = fastReflection_OfferRejection_messageType{}
+
+type fastReflection_OfferRejection_messageType struct{}
+
+func (x fastReflection_OfferRejection_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OfferRejection)(nil)
+}
+func (x fastReflection_OfferRejection_messageType) New() protoreflect.Message {
+	return new(fastReflection_OfferRejection)
+}
+func (x fastReflection_OfferRejection_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OfferRejection
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OfferRejection) Descriptor() protoreflect.MessageDescriptor {
+	return md_OfferRejection
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OfferRejection) Type() protoreflect.MessageType {
+	return _fastReflection_OfferRejection_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OfferRejection) New() protoreflect.Message {
+	return new(fastReflection_OfferRejection)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OfferRejection) Interface() protoreflect.ProtoMessage {
+	return (*OfferRejection)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OfferRejection) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OfferId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OfferId)
+		if !f(fd_OfferRejection_offerId, value) {
+			return
+		}
+	}
+	if x.Provider != "" {
+		value := protoreflect.ValueOfString(x.Provider)
+		if !f(fd_OfferRejection_provider, value) {
+			return
+		}
+	}
+	if x.Reason != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Reason))
+		if !f(fd_OfferRejection_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OfferRejection) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.OfferRejection.offerId":
+		return x.OfferId != uint64(0)
+	case "filespacechain.filespacechain.OfferRejection.provider":
+		return x.Provider != ""
+	case "filespacechain.filespacechain.OfferRejection.reason":
+		return x.Reason != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.OfferRejection"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.OfferRejection does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OfferRejection) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.OfferRejection.offerId":
+		x.OfferId = uint64(0)
+	case "filespacechain.filespacechain.OfferRejection.provider":
+		x.Provider = ""
+	case "filespacechain.filespacechain.OfferRejection.reason":
+		x.Reason = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.OfferRejection"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.OfferRejection does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OfferRejection) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "filespacechain.filespacechain.OfferRejection.offerId":
+		value := x.OfferId
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.OfferRejection.provider":
+		value := x.Provider
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.OfferRejection.reason":
+		value := x.Reason
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.OfferRejection"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.OfferRejection does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OfferRejection) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.OfferRejection.offerId":
+		x.OfferId = value.Uint()
+	case "filespacechain.filespacechain.OfferRejection.provider":
+		x.Provider = value.Interface().(string)
+	case "filespacechain.filespacechain.OfferRejection.reason":
+		x.Reason = (OfferRejectionReason)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.OfferRejection"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.OfferRejection does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OfferRejection) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.OfferRejection.offerId":
+		panic(fmt.Errorf("field offerId of message filespacechain.filespacechain.OfferRejection is not mutable"))
+	case "filespacechain.filespacechain.OfferRejection.provider":
+		panic(fmt.Errorf("field provider of message filespacechain.filespacechain.OfferRejection is not mutable"))
+	case "filespacechain.filespacechain.OfferRejection.reason":
+		panic(fmt.Errorf("field reason of message filespacechain.filespacechain.OfferRejection is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.OfferRejection"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.OfferRejection does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OfferRejection) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.OfferRejection.offerId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.OfferRejection.provider":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.OfferRejection.reason":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.OfferRejection"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.OfferRejection does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OfferRejection) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in filespacechain.filespacechain.OfferRejection", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OfferRejection) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OfferRejection) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OfferRejection) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OfferRejection) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OfferRejection)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OfferId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OfferId))
+		}
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Reason != 0 {
+			n += 1 + runtime.Sov(uint64(x.Reason))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OfferRejection)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Reason != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reason))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.OfferId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OfferId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OfferRejection)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OfferRejection: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OfferRejection: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+				}
+				x.OfferId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OfferId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				x.Reason = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Reason |= OfferRejectionReason(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: filespacechain/filespacechain/matching.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// OfferRejectionReason explains why the matching engine did not match a
+// hosting offer to an inquiry.
+type OfferRejectionReason int32
+
+const (
+	OfferRejectionReason_OFFER_REJECTION_REASON_UNSPECIFIED OfferRejectionReason = 0
+	// The offer is priced in another denom than the inquiry's escrow.
+	OfferRejectionReason_OFFER_REJECTION_REASON_DENOM_MISMATCH OfferRejectionReason = 1
+	// The offer's price per block is above the inquiry's maximum.
+	OfferRejectionReason_OFFER_REJECTION_REASON_PRICE_ABOVE_MAX OfferRejectionReason = 2
+	// The offer's region is not on the inquiry's allow list.
+	OfferRejectionReason_OFFER_REJECTION_REASON_REGION_NOT_ALLOWED OfferRejectionReason = 3
+	// The offer's region is on the inquiry's deny list.
+	OfferRejectionReason_OFFER_REJECTION_REASON_REGION_DENIED OfferRejectionReason = 4
+	// The offer's provider is below the minimum provider stake.
+	OfferRejectionReason_OFFER_REJECTION_REASON_INSUFFICIENT_STAKE OfferRejectionReason = 5
+	// The offer's provider holds or held a contract for the inquiry.
+	OfferRejectionReason_OFFER_REJECTION_REASON_PROVIDER_CONTRACTED OfferRejectionReason = 6
+	// A cheaper offer of the same provider was matched instead.
+	OfferRejectionReason_OFFER_REJECTION_REASON_DUPLICATE_PROVIDER OfferRejectionReason = 7
+	// Every open slot of the inquiry went to a cheaper offer.
+	OfferRejectionReason_OFFER_REJECTION_REASON_SLOTS_FILLED OfferRejectionReason = 8
+	// The file does not fit in the free capacity the offer's provider declared.
+	OfferRejectionReason_OFFER_REJECTION_REASON_INSUFFICIENT_CAPACITY OfferRejectionReason = 9
+)
+
+// Enum value maps for OfferRejectionReason.
+var (
+	OfferRejectionReason_name = map[int32]string{
+		0: "OFFER_REJECTION_REASON_UNSPECIFIED",
+		1: "OFFER_REJECTION_REASON_DENOM_MISMATCH",
+		2: "OFFER_REJECTION_REASON_PRICE_ABOVE_MAX",
+		3: "OFFER_REJECTION_REASON_REGION_NOT_ALLOWED",
+		4: "OFFER_REJECTION_REASON_REGION_DENIED",
+		5: "OFFER_REJECTION_REASON_INSUFFICIENT_STAKE",
+		6: "OFFER_REJECTION_REASON_PROVIDER_CONTRACTED",
+		7: "OFFER_REJECTION_REASON_DUPLICATE_PROVIDER",
+		8: "OFFER_REJECTION_REASON_SLOTS_FILLED",
+		9: "OFFER_REJECTION_REASON_INSUFFICIENT_CAPACITY",
+	}
+	OfferRejectionReason_value = map[string]int32{
+		"OFFER_REJECTION_REASON_UNSPECIFIED":           0,
+		"OFFER_REJECTION_REASON_DENOM_MISMATCH":        1,
+		"OFFER_REJECTION_REASON_PRICE_ABOVE_MAX":       2,
+		"OFFER_REJECTION_REASON_REGION_NOT_ALLOWED":    3,
+		"OFFER_REJECTION_REASON_REGION_DENIED":         4,
+		"OFFER_REJECTION_REASON_INSUFFICIENT_STAKE":    5,
+		"OFFER_REJECTION_REASON_PROVIDER_CONTRACTED":   6,
+		"OFFER_REJECTION_REASON_DUPLICATE_PROVIDER":    7,
+		"OFFER_REJECTION_REASON_SLOTS_FILLED":          8,
+		"OFFER_REJECTION_REASON_INSUFFICIENT_CAPACITY": 9,
+	}
+)
+
+func (x OfferRejectionReason) Enum() *OfferRejectionReason {
+	p := new(OfferRejectionReason)
+	*p = x
+	return p
+}
+
+func (x OfferRejectionReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OfferRejectionReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_filespacechain_filespacechain_matching_proto_enumTypes[0].Descriptor()
+}
+
+func (OfferRejectionReason) Type() protoreflect.EnumType {
+	return &file_filespacechain_filespacechain_matching_proto_enumTypes[0]
+}
+
+func (x OfferRejectionReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OfferRejectionReason.Descriptor instead.
+func (OfferRejectionReason) EnumDescriptor() ([]byte, []int) {
+	return file_filespacechain_filespacechain_matching_proto_rawDescGZIP(), []int{0}
+}
+
+// OfferRejection records an offer the matching engine passed over.
+type OfferRejection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OfferId  uint64               `protobuf:"varint,1,opt,name=offerId,proto3" json:"offerId,omitempty"`
+	Provider string               `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Reason   OfferRejectionReason `protobuf:"varint,3,opt,name=reason,proto3,enum=filespacechain.filespacechain.OfferRejectionReason" json:"reason,omitempty"`
+}
+
+func (x *OfferRejection) Reset() {
+	*x = OfferRejection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_filespacechain_filespacechain_matching_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfferRejection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferRejection) ProtoMessage() {}
+
+// Deprecated: Use OfferRejection.ProtoReflect.Descriptor instead.
+func (*OfferRejection) Descriptor() ([]byte, []int) {
+	return file_filespacechain_filespacechain_matching_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OfferRejection) GetOfferId() uint64 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+func (x *OfferRejection) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *OfferRejection) GetReason() OfferRejectionReason {
+	if x != nil {
+		return x.Reason
+	}
+	return OfferRejectionReason_OFFER_REJECTION_REASON_UNSPECIFIED
+}
+
+var File_filespacechain_filespacechain_matching_proto protoreflect.FileDescriptor
+
+var file_filespacechain_filespacechain_matching_proto_rawDesc = []byte{
+	0x0a, 0x2c, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x1a, 0x14, 0x67,
+	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x93, 0x01, 0x0a, 0x0e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2a, 0xdd, 0x03, 0x0a, 0x14, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x22, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x4a, 0x45,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x29, 0x0a, 0x25, 0x4f, 0x46,
+	0x46, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x4f, 0x4d, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41,
+	0x54, 0x43, 0x48, 0x10, 0x01, 0x12, 0x2a, 0x0a, 0x26, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x52,
+	0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x41, 0x42, 0x4f, 0x56, 0x45, 0x5f, 0x4d, 0x41, 0x58, 0x10,
+	0x02, 0x12, 0x2d, 0x0a, 0x29, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x47, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x28, 0x0a, 0x24, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x4f,
+	0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x04, 0x12, 0x2d, 0x0a, 0x29, 0x4f, 0x46,
+	0x46, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x10, 0x05, 0x12, 0x2e, 0x0a, 0x2a, 0x4f, 0x46, 0x46,
+	0x45, 0x52, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e,
+	0x54, 0x52, 0x41, 0x43, 0x54, 0x45, 0x44, 0x10, 0x06, 0x12, 0x2d, 0x0a, 0x29, 0x4f, 0x46, 0x46,
+	0x45, 0x52, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x52,
+	0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x10, 0x07, 0x12, 0x27, 0x0a, 0x23, 0x4f, 0x46, 0x46, 0x45,
+	0x52, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x53, 0x5f, 0x46, 0x49, 0x4c, 0x4c, 0x45, 0x44, 0x10,
+	0x08, 0x12, 0x30, 0x0a, 0x2c, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x53, 0x55,
+	0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x41, 0x50, 0x41, 0x43, 0x49, 0x54,
+	0x59, 0x10, 0x09, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xf7, 0x01, 0x0a, 0x21, 0x63, 0x6f,
+	0x6d, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x42,
+	0x0d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0xa2, 0x02, 0x03, 0x46, 0x46, 0x58, 0xaa, 0x02, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xca, 0x02, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xe2, 0x02, 0x29, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x1e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_filespacechain_filespacechain_matching_proto_rawDescOnce sync.Once
+	file_filespacechain_filespacechain_matching_proto_rawDescData = file_filespacechain_filespacechain_matching_proto_rawDesc
+)
+
+func file_filespacechain_filespacechain_matching_proto_rawDescGZIP() []byte {
+	file_filespacechain_filespacechain_matching_proto_rawDescOnce.Do(func() {
+		file_filespacechain_filespacechain_matching_proto_rawDescData = protoimpl.X.CompressGZIP(file_filespacechain_filespacechain_matching_proto_rawDescData)
+	})
+	return file_filespacechain_filespacechain_matching_proto_rawDescData
+}
+
+var file_filespacechain_filespacechain_matching_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_filespacechain_filespacechain_matching_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_filespacechain_filespacechain_matching_proto_goTypes = []interface{}{
+	(OfferRejectionReason)(0), // 0: filespacechain.filespacechain.OfferRejectionReason
+	(*OfferRejection)(nil),    // 1: filespacechain.filespacechain.OfferRejection
+}
+var file_filespacechain_filespacechain_matching_proto_depIdxs = []int32{
+	0, // 0: filespacechain.filespacechain.OfferRejection.reason:type_name -> filespacechain.filespacechain.OfferRejectionReason
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_filespacechain_filespacechain_matching_proto_init() }
+func file_filespacechain_filespacechain_matching_proto_init() {
+	if File_filespacechain_filespacechain_matching_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_filespacechain_filespacechain_matching_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OfferRejection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_filespacechain_filespacechain_matching_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_filespacechain_filespacechain_matching_proto_goTypes,
+		DependencyIndexes: file_filespacechain_filespacechain_matching_proto_depIdxs,
+		EnumInfos:         file_filespacechain_filespacechain_matching_proto_enumTypes,
+		MessageInfos:      file_filespacechain_filespacechain_matching_proto_msgTypes,
+	}.Build()
+	File_filespacechain_filespacechain_matching_proto = out.File
+	file_filespacechain_filespacechain_matching_proto_rawDesc = nil
+	file_filespacechain_filespacechain_matching_proto_goTypes = nil
+	file_filespacechain_filespacechain_matching_proto_depIdxs = nil
+}
@@ -14,25 +14,1525 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_15_list)(nil)
+
+type _Params_15_list struct {
+	list *[]*PaymentDenom
+}
+
+func (x *_Params_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PaymentDenom)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PaymentDenom)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_15_list) AppendMutable() protoreflect.Value {
+	v := new(PaymentDenom)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_15_list) NewElement() protoreflect.Value {
+	v := new(PaymentDenom)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Params                                     protoreflect.MessageDescriptor
+	fd_Params_min_provider_stake                  protoreflect.FieldDescriptor
+	fd_Params_slashing_fraction                   protoreflect.FieldDescriptor
+	fd_Params_challenges_per_block                protoreflect.FieldDescriptor
+	fd_Params_chunks_per_challenge                protoreflect.FieldDescriptor
+	fd_Params_challenge_window                    protoreflect.FieldDescriptor
+	fd_Params_acceptance_deadline                 protoreflect.FieldDescriptor
+	fd_Params_unbonding_period                    protoreflect.FieldDescriptor
+	fd_Params_collateral_ratio                    protoreflect.FieldDescriptor
+	fd_Params_slash_destination                   protoreflect.FieldDescriptor
+	fd_Params_max_expiries_per_block              protoreflect.FieldDescriptor
+	fd_Params_reputation_weight                   protoreflect.FieldDescriptor
+	fd_Params_reputation_half_life                protoreflect.FieldDescriptor
+	fd_Params_dust_destination                    protoreflect.FieldDescriptor
+	fd_Params_accepted_denoms                     protoreflect.FieldDescriptor
+	fd_Params_completion_bonus_fraction           protoreflect.FieldDescriptor
+	fd_Params_provider_termination_slash_fraction protoreflect.FieldDescriptor
+	fd_Params_client_termination_penalty_fraction protoreflect.FieldDescriptor
+	fd_Params_bond_denom                          protoreflect.FieldDescriptor
+	fd_Params_offer_match_scan_limit              protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_filespacechain_filespacechain_params_proto_init()
+	md_Params = File_filespacechain_filespacechain_params_proto.Messages().ByName("Params")
+	fd_Params_min_provider_stake = md_Params.Fields().ByName("min_provider_stake")
+	fd_Params_slashing_fraction = md_Params.Fields().ByName("slashing_fraction")
+	fd_Params_challenges_per_block = md_Params.Fields().ByName("challenges_per_block")
+	fd_Params_chunks_per_challenge = md_Params.Fields().ByName("chunks_per_challenge")
+	fd_Params_challenge_window = md_Params.Fields().ByName("challenge_window")
+	fd_Params_acceptance_deadline = md_Params.Fields().ByName("acceptance_deadline")
+	fd_Params_unbonding_period = md_Params.Fields().ByName("unbonding_period")
+	fd_Params_collateral_ratio = md_Params.Fields().ByName("collateral_ratio")
+	fd_Params_slash_destination = md_Params.Fields().ByName("slash_destination")
+	fd_Params_max_expiries_per_block = md_Params.Fields().ByName("max_expiries_per_block")
+	fd_Params_reputation_weight = md_Params.Fields().ByName("reputation_weight")
+	fd_Params_reputation_half_life = md_Params.Fields().ByName("reputation_half_life")
+	fd_Params_dust_destination = md_Params.Fields().ByName("dust_destination")
+	fd_Params_accepted_denoms = md_Params.Fields().ByName("accepted_denoms")
+	fd_Params_completion_bonus_fraction = md_Params.Fields().ByName("completion_bonus_fraction")
+	fd_Params_provider_termination_slash_fraction = md_Params.Fields().ByName("provider_termination_slash_fraction")
+	fd_Params_client_termination_penalty_fraction = md_Params.Fields().ByName("client_termination_penalty_fraction")
+	fd_Params_bond_denom = md_Params.Fields().ByName("bond_denom")
+	fd_Params_offer_match_scan_limit = md_Params.Fields().ByName("offer_match_scan_limit")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_filespacechain_filespacechain_params_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MinProviderStake != "" {
+		value := protoreflect.ValueOfString(x.MinProviderStake)
+		if !f(fd_Params_min_provider_stake, value) {
+			return
+		}
+	}
+	if x.SlashingFraction != "" {
+		value := protoreflect.ValueOfString(x.SlashingFraction)
+		if !f(fd_Params_slashing_fraction, value) {
+			return
+		}
+	}
+	if x.ChallengesPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ChallengesPerBlock)
+		if !f(fd_Params_challenges_per_block, value) {
+			return
+		}
+	}
+	if x.ChunksPerChallenge != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ChunksPerChallenge)
+		if !f(fd_Params_chunks_per_challenge, value) {
+			return
+		}
+	}
+	if x.ChallengeWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ChallengeWindow)
+		if !f(fd_Params_challenge_window, value) {
+			return
+		}
+	}
+	if x.AcceptanceDeadline != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AcceptanceDeadline)
+		if !f(fd_Params_acceptance_deadline, value) {
+			return
+		}
+	}
+	if x.UnbondingPeriod != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.UnbondingPeriod)
+		if !f(fd_Params_unbonding_period, value) {
+			return
+		}
+	}
+	if x.CollateralRatio != "" {
+		value := protoreflect.ValueOfString(x.CollateralRatio)
+		if !f(fd_Params_collateral_ratio, value) {
+			return
+		}
+	}
+	if x.SlashDestination != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.SlashDestination))
+		if !f(fd_Params_slash_destination, value) {
+			return
+		}
+	}
+	if x.MaxExpiriesPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxExpiriesPerBlock)
+		if !f(fd_Params_max_expiries_per_block, value) {
+			return
+		}
+	}
+	if x.ReputationWeight != "" {
+		value := protoreflect.ValueOfString(x.ReputationWeight)
+		if !f(fd_Params_reputation_weight, value) {
+			return
+		}
+	}
+	if x.ReputationHalfLife != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReputationHalfLife)
+		if !f(fd_Params_reputation_half_life, value) {
+			return
+		}
+	}
+	if x.DustDestination != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.DustDestination))
+		if !f(fd_Params_dust_destination, value) {
+			return
+		}
+	}
+	if len(x.AcceptedDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_Params_15_list{list: &x.AcceptedDenoms})
+		if !f(fd_Params_accepted_denoms, value) {
+			return
+		}
+	}
+	if x.CompletionBonusFraction != "" {
+		value := protoreflect.ValueOfString(x.CompletionBonusFraction)
+		if !f(fd_Params_completion_bonus_fraction, value) {
+			return
+		}
+	}
+	if x.ProviderTerminationSlashFraction != "" {
+		value := protoreflect.ValueOfString(x.ProviderTerminationSlashFraction)
+		if !f(fd_Params_provider_termination_slash_fraction, value) {
+			return
+		}
+	}
+	if x.ClientTerminationPenaltyFraction != "" {
+		value := protoreflect.ValueOfString(x.ClientTerminationPenaltyFraction)
+		if !f(fd_Params_client_termination_penalty_fraction, value) {
+			return
+		}
+	}
+	if x.BondDenom != "" {
+		value := protoreflect.ValueOfString(x.BondDenom)
+		if !f(fd_Params_bond_denom, value) {
+			return
+		}
+	}
+	if x.OfferMatchScanLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OfferMatchScanLimit)
+		if !f(fd_Params_offer_match_scan_limit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.Params.min_provider_stake":
+		return x.MinProviderStake != ""
+	case "filespacechain.filespacechain.Params.slashing_fraction":
+		return x.SlashingFraction != ""
+	case "filespacechain.filespacechain.Params.challenges_per_block":
+		return x.ChallengesPerBlock != uint64(0)
+	case "filespacechain.filespacechain.Params.chunks_per_challenge":
+		return x.ChunksPerChallenge != uint64(0)
+	case "filespacechain.filespacechain.Params.challenge_window":
+		return x.ChallengeWindow != uint64(0)
+	case "filespacechain.filespacechain.Params.acceptance_deadline":
+		return x.AcceptanceDeadline != uint64(0)
+	case "filespacechain.filespacechain.Params.unbonding_period":
+		return x.UnbondingPeriod != uint64(0)
+	case "filespacechain.filespacechain.Params.collateral_ratio":
+		return x.CollateralRatio != ""
+	case "filespacechain.filespacechain.Params.slash_destination":
+		return x.SlashDestination != 0
+	case "filespacechain.filespacechain.Params.max_expiries_per_block":
+		return x.MaxExpiriesPerBlock != uint64(0)
+	case "filespacechain.filespacechain.Params.reputation_weight":
+		return x.ReputationWeight != ""
+	case "filespacechain.filespacechain.Params.reputation_half_life":
+		return x.ReputationHalfLife != uint64(0)
+	case "filespacechain.filespacechain.Params.dust_destination":
+		return x.DustDestination != 0
+	case "filespacechain.filespacechain.Params.accepted_denoms":
+		return len(x.AcceptedDenoms) != 0
+	case "filespacechain.filespacechain.Params.completion_bonus_fraction":
+		return x.CompletionBonusFraction != ""
+	case "filespacechain.filespacechain.Params.provider_termination_slash_fraction":
+		return x.ProviderTerminationSlashFraction != ""
+	case "filespacechain.filespacechain.Params.client_termination_penalty_fraction":
+		return x.ClientTerminationPenaltyFraction != ""
+	case "filespacechain.filespacechain.Params.bond_denom":
+		return x.BondDenom != ""
+	case "filespacechain.filespacechain.Params.offer_match_scan_limit":
+		return x.OfferMatchScanLimit != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.Params"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.Params.min_provider_stake":
+		x.MinProviderStake = ""
+	case "filespacechain.filespacechain.Params.slashing_fraction":
+		x.SlashingFraction = ""
+	case "filespacechain.filespacechain.Params.challenges_per_block":
+		x.ChallengesPerBlock = uint64(0)
+	case "filespacechain.filespacechain.Params.chunks_per_challenge":
+		x.ChunksPerChallenge = uint64(0)
+	case "filespacechain.filespacechain.Params.challenge_window":
+		x.ChallengeWindow = uint64(0)
+	case "filespacechain.filespacechain.Params.acceptance_deadline":
+		x.AcceptanceDeadline = uint64(0)
+	case "filespacechain.filespacechain.Params.unbonding_period":
+		x.UnbondingPeriod = uint64(0)
+	case "filespacechain.filespacechain.Params.collateral_ratio":
+		x.CollateralRatio = ""
+	case "filespacechain.filespacechain.Params.slash_destination":
+		x.SlashDestination = 0
+	case "filespacechain.filespacechain.Params.max_expiries_per_block":
+		x.MaxExpiriesPerBlock = uint64(0)
+	case "filespacechain.filespacechain.Params.reputation_weight":
+		x.ReputationWeight = ""
+	case "filespacechain.filespacechain.Params.reputation_half_life":
+		x.ReputationHalfLife = uint64(0)
+	case "filespacechain.filespacechain.Params.dust_destination":
+		x.DustDestination = 0
+	case "filespacechain.filespacechain.Params.accepted_denoms":
+		x.AcceptedDenoms = nil
+	case "filespacechain.filespacechain.Params.completion_bonus_fraction":
+		x.CompletionBonusFraction = ""
+	case "filespacechain.filespacechain.Params.provider_termination_slash_fraction":
+		x.ProviderTerminationSlashFraction = ""
+	case "filespacechain.filespacechain.Params.client_termination_penalty_fraction":
+		x.ClientTerminationPenaltyFraction = ""
+	case "filespacechain.filespacechain.Params.bond_denom":
+		x.BondDenom = ""
+	case "filespacechain.filespacechain.Params.offer_match_scan_limit":
+		x.OfferMatchScanLimit = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.Params"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "filespacechain.filespacechain.Params.min_provider_stake":
+		value := x.MinProviderStake
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.Params.slashing_fraction":
+		value := x.SlashingFraction
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.Params.challenges_per_block":
+		value := x.ChallengesPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.Params.chunks_per_challenge":
+		value := x.ChunksPerChallenge
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.Params.challenge_window":
+		value := x.ChallengeWindow
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.Params.acceptance_deadline":
+		value := x.AcceptanceDeadline
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.Params.unbonding_period":
+		value := x.UnbondingPeriod
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.Params.collateral_ratio":
+		value := x.CollateralRatio
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.Params.slash_destination":
+		value := x.SlashDestination
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "filespacechain.filespacechain.Params.max_expiries_per_block":
+		value := x.MaxExpiriesPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.Params.reputation_weight":
+		value := x.ReputationWeight
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.Params.reputation_half_life":
+		value := x.ReputationHalfLife
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.Params.dust_destination":
+		value := x.DustDestination
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "filespacechain.filespacechain.Params.accepted_denoms":
+		if len(x.AcceptedDenoms) == 0 {
+			return protoreflect.ValueOfList(&_Params_15_list{})
+		}
+		listValue := &_Params_15_list{list: &x.AcceptedDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.Params.completion_bonus_fraction":
+		value := x.CompletionBonusFraction
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.Params.provider_termination_slash_fraction":
+		value := x.ProviderTerminationSlashFraction
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.Params.client_termination_penalty_fraction":
+		value := x.ClientTerminationPenaltyFraction
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.Params.bond_denom":
+		value := x.BondDenom
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.Params.offer_match_scan_limit":
+		value := x.OfferMatchScanLimit
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.Params"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.Params.min_provider_stake":
+		x.MinProviderStake = value.Interface().(string)
+	case "filespacechain.filespacechain.Params.slashing_fraction":
+		x.SlashingFraction = value.Interface().(string)
+	case "filespacechain.filespacechain.Params.challenges_per_block":
+		x.ChallengesPerBlock = value.Uint()
+	case "filespacechain.filespacechain.Params.chunks_per_challenge":
+		x.ChunksPerChallenge = value.Uint()
+	case "filespacechain.filespacechain.Params.challenge_window":
+		x.ChallengeWindow = value.Uint()
+	case "filespacechain.filespacechain.Params.acceptance_deadline":
+		x.AcceptanceDeadline = value.Uint()
+	case "filespacechain.filespacechain.Params.unbonding_period":
+		x.UnbondingPeriod = value.Uint()
+	case "filespacechain.filespacechain.Params.collateral_ratio":
+		x.CollateralRatio = value.Interface().(string)
+	case "filespacechain.filespacechain.Params.slash_destination":
+		x.SlashDestination = (SlashDestination)(value.Enum())
+	case "filespacechain.filespacechain.Params.max_expiries_per_block":
+		x.MaxExpiriesPerBlock = value.Uint()
+	case "filespacechain.filespacechain.Params.reputation_weight":
+		x.ReputationWeight = value.Interface().(string)
+	case "filespacechain.filespacechain.Params.reputation_half_life":
+		x.ReputationHalfLife = value.Uint()
+	case "filespacechain.filespacechain.Params.dust_destination":
+		x.DustDestination = (DustDestination)(value.Enum())
+	case "filespacechain.filespacechain.Params.accepted_denoms":
+		lv := value.List()
+		clv := lv.(*_Params_15_list)
+		x.AcceptedDenoms = *clv.list
+	case "filespacechain.filespacechain.Params.completion_bonus_fraction":
+		x.CompletionBonusFraction = value.Interface().(string)
+	case "filespacechain.filespacechain.Params.provider_termination_slash_fraction":
+		x.ProviderTerminationSlashFraction = value.Interface().(string)
+	case "filespacechain.filespacechain.Params.client_termination_penalty_fraction":
+		x.ClientTerminationPenaltyFraction = value.Interface().(string)
+	case "filespacechain.filespacechain.Params.bond_denom":
+		x.BondDenom = value.Interface().(string)
+	case "filespacechain.filespacechain.Params.offer_match_scan_limit":
+		x.OfferMatchScanLimit = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.Params"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.Params.accepted_denoms":
+		if x.AcceptedDenoms == nil {
+			x.AcceptedDenoms = []*PaymentDenom{}
+		}
+		value := &_Params_15_list{list: &x.AcceptedDenoms}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.Params.min_provider_stake":
+		panic(fmt.Errorf("field min_provider_stake of message filespacechain.filespacechain.Params is not mutable"))
+	case "filespacechain.filespacechain.Params.slashing_fraction":
+		panic(fmt.Errorf("field slashing_fraction of message filespacechain.filespacechain.Params is not mutable"))
+	case "filespacechain.filespacechain.Params.challenges_per_block":
+		panic(fmt.Errorf("field challenges_per_block of message filespacechain.filespacechain.Params is not mutable"))
+	case "filespacechain.filespacechain.Params.chunks_per_challenge":
+		panic(fmt.Errorf("field chunks_per_challenge of message filespacechain.filespacechain.Params is not mutable"))
+	case "filespacechain.filespacechain.Params.challenge_window":
+		panic(fmt.Errorf("field challenge_window of message filespacechain.filespacechain.Params is not mutable"))
+	case "filespacechain.filespacechain.Params.acceptance_deadline":
+		panic(fmt.Errorf("field acceptance_deadline of message filespacechain.filespacechain.Params is not mutable"))
+	case "filespacechain.filespacechain.Params.unbonding_period":
+		panic(fmt.Errorf("field unbonding_period of message filespacechain.filespacechain.Params is not mutable"))
+	case "filespacechain.filespacechain.Params.collateral_ratio":
+		panic(fmt.Errorf("field collateral_ratio of message filespacechain.filespacechain.Params is not mutable"))
+	case "filespacechain.filespacechain.Params.slash_destination":
+		panic(fmt.Errorf("field slash_destination of message filespacechain.filespacechain.Params is not mutable"))
+	case "filespacechain.filespacechain.Params.max_expiries_per_block":
+		panic(fmt.Errorf("field max_expiries_per_block of message filespacechain.filespacechain.Params is not mutable"))
+	case "filespacechain.filespacechain.Params.reputation_weight":
+		panic(fmt.Errorf("field reputation_weight of message filespacechain.filespacechain.Params is not mutable"))
+	case "filespacechain.filespacechain.Params.reputation_half_life":
+		panic(fmt.Errorf("field reputation_half_life of message filespacechain.filespacechain.Params is not mutable"))
+	case "filespacechain.filespacechain.Params.dust_destination":
+		panic(fmt.Errorf("field dust_destination of message filespacechain.filespacechain.Params is not mutable"))
+	case "filespacechain.filespacechain.Params.completion_bonus_fraction":
+		panic(fmt.Errorf("field completion_bonus_fraction of message filespacechain.filespacechain.Params is not mutable"))
+	case "filespacechain.filespacechain.Params.provider_termination_slash_fraction":
+		panic(fmt.Errorf("field provider_termination_slash_fraction of message filespacechain.filespacechain.Params is not mutable"))
+	case "filespacechain.filespacechain.Params.client_termination_penalty_fraction":
+		panic(fmt.Errorf("field client_termination_penalty_fraction of message filespacechain.filespacechain.Params is not mutable"))
+	case "filespacechain.filespacechain.Params.bond_denom":
+		panic(fmt.Errorf("field bond_denom of message filespacechain.filespacechain.Params is not mutable"))
+	case "filespacechain.filespacechain.Params.offer_match_scan_limit":
+		panic(fmt.Errorf("field offer_match_scan_limit of message filespacechain.filespacechain.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.Params"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.Params.min_provider_stake":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.Params.slashing_fraction":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.Params.challenges_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.Params.chunks_per_challenge":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.Params.challenge_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.Params.acceptance_deadline":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.Params.unbonding_period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.Params.collateral_ratio":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.Params.slash_destination":
+		return protoreflect.ValueOfEnum(0)
+	case "filespacechain.filespacechain.Params.max_expiries_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.Params.reputation_weight":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.Params.reputation_half_life":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.Params.dust_destination":
+		return protoreflect.ValueOfEnum(0)
+	case "filespacechain.filespacechain.Params.accepted_denoms":
+		list := []*PaymentDenom{}
+		return protoreflect.ValueOfList(&_Params_15_list{list: &list})
+	case "filespacechain.filespacechain.Params.completion_bonus_fraction":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.Params.provider_termination_slash_fraction":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.Params.client_termination_penalty_fraction":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.Params.bond_denom":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.Params.offer_match_scan_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.Params"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in filespacechain.filespacechain.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MinProviderStake)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SlashingFraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChallengesPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChallengesPerBlock))
+		}
+		if x.ChunksPerChallenge != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChunksPerChallenge))
+		}
+		if x.ChallengeWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChallengeWindow))
+		}
+		if x.AcceptanceDeadline != 0 {
+			n += 1 + runtime.Sov(uint64(x.AcceptanceDeadline))
+		}
+		if x.UnbondingPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.UnbondingPeriod))
+		}
+		l = len(x.CollateralRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SlashDestination != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashDestination))
+		}
+		if x.MaxExpiriesPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxExpiriesPerBlock))
+		}
+		l = len(x.ReputationWeight)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ReputationHalfLife != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReputationHalfLife))
+		}
+		if x.DustDestination != 0 {
+			n += 1 + runtime.Sov(uint64(x.DustDestination))
+		}
+		if len(x.AcceptedDenoms) > 0 {
+			for _, e := range x.AcceptedDenoms {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.CompletionBonusFraction)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProviderTerminationSlashFraction)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ClientTerminationPenaltyFraction)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BondDenom)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.OfferMatchScanLimit != 0 {
+			n += 2 + runtime.Sov(uint64(x.OfferMatchScanLimit))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.OfferMatchScanLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OfferMatchScanLimit))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if len(x.BondDenom) > 0 {
+			i -= len(x.BondDenom)
+			copy(dAtA[i:], x.BondDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondDenom)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if len(x.ClientTerminationPenaltyFraction) > 0 {
+			i -= len(x.ClientTerminationPenaltyFraction)
+			copy(dAtA[i:], x.ClientTerminationPenaltyFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClientTerminationPenaltyFraction)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.ProviderTerminationSlashFraction) > 0 {
+			i -= len(x.ProviderTerminationSlashFraction)
+			copy(dAtA[i:], x.ProviderTerminationSlashFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProviderTerminationSlashFraction)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.CompletionBonusFraction) > 0 {
+			i -= len(x.CompletionBonusFraction)
+			copy(dAtA[i:], x.CompletionBonusFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CompletionBonusFraction)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.AcceptedDenoms) > 0 {
+			for iNdEx := len(x.AcceptedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AcceptedDenoms[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if x.DustDestination != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DustDestination))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.ReputationHalfLife != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReputationHalfLife))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.ReputationWeight) > 0 {
+			i -= len(x.ReputationWeight)
+			copy(dAtA[i:], x.ReputationWeight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReputationWeight)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.MaxExpiriesPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxExpiriesPerBlock))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.SlashDestination != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashDestination))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.CollateralRatio) > 0 {
+			i -= len(x.CollateralRatio)
+			copy(dAtA[i:], x.CollateralRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CollateralRatio)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.UnbondingPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnbondingPeriod))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.AcceptanceDeadline != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AcceptanceDeadline))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.ChallengeWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChallengeWindow))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ChunksPerChallenge != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChunksPerChallenge))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ChallengesPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChallengesPerBlock))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.SlashingFraction) > 0 {
+			i -= len(x.SlashingFraction)
+			copy(dAtA[i:], x.SlashingFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashingFraction)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.MinProviderStake) > 0 {
+			i -= len(x.MinProviderStake)
+			copy(dAtA[i:], x.MinProviderStake)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinProviderStake)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinProviderStake", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinProviderStake = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashingFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashingFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChallengesPerBlock", wireType)
+				}
+				x.ChallengesPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChallengesPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChunksPerChallenge", wireType)
+				}
+				x.ChunksPerChallenge = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChunksPerChallenge |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChallengeWindow", wireType)
+				}
+				x.ChallengeWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChallengeWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AcceptanceDeadline", wireType)
+				}
+				x.AcceptanceDeadline = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AcceptanceDeadline |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+				}
+				x.UnbondingPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UnbondingPeriod |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CollateralRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CollateralRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashDestination", wireType)
+				}
+				x.SlashDestination = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashDestination |= SlashDestination(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxExpiriesPerBlock", wireType)
+				}
+				x.MaxExpiriesPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxExpiriesPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputationWeight", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReputationWeight = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputationHalfLife", wireType)
+				}
+				x.ReputationHalfLife = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReputationHalfLife |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DustDestination", wireType)
+				}
+				x.DustDestination = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DustDestination |= DustDestination(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AcceptedDenoms", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AcceptedDenoms = append(x.AcceptedDenoms, &PaymentDenom{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AcceptedDenoms[len(x.AcceptedDenoms)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompletionBonusFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CompletionBonusFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderTerminationSlashFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProviderTerminationSlashFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientTerminationPenaltyFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClientTerminationPenaltyFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BondDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OfferMatchScanLimit", wireType)
+				}
+				x.OfferMatchScanLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OfferMatchScanLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
-	md_Params protoreflect.MessageDescriptor
+	md_PaymentDenom                               protoreflect.MessageDescriptor
+	fd_PaymentDenom_denom                         protoreflect.FieldDescriptor
+	fd_PaymentDenom_base_price_per_byte_per_block protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_filespacechain_filespacechain_params_proto_init()
-	md_Params = File_filespacechain_filespacechain_params_proto.Messages().ByName("Params")
+	md_PaymentDenom = File_filespacechain_filespacechain_params_proto.Messages().ByName("PaymentDenom")
+	fd_PaymentDenom_denom = md_PaymentDenom.Fields().ByName("denom")
+	fd_PaymentDenom_base_price_per_byte_per_block = md_PaymentDenom.Fields().ByName("base_price_per_byte_per_block")
 }
 
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
+var _ protoreflect.Message = (*fastReflection_PaymentDenom)(nil)
 
-type fastReflection_Params Params
+type fastReflection_PaymentDenom PaymentDenom
 
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
+func (x *PaymentDenom) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PaymentDenom)(x)
 }
 
-func (x *Params) slowProtoReflect() protoreflect.Message {
-	mi := &file_filespacechain_filespacechain_params_proto_msgTypes[0]
+func (x *PaymentDenom) slowProtoReflect() protoreflect.Message {
+	mi := &file_filespacechain_filespacechain_params_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -43,43 +1543,43 @@ func (x *Params) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+var _fastReflection_PaymentDenom_messageType fastReflection_PaymentDenom_messageType
+var _ protoreflect.MessageType = fastReflection_PaymentDenom_messageType{}
 
-type fastReflection_Params_messageType struct{}
+type fastReflection_PaymentDenom_messageType struct{}
 
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
+func (x fastReflection_PaymentDenom_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PaymentDenom)(nil)
 }
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x fastReflection_PaymentDenom_messageType) New() protoreflect.Message {
+	return new(fastReflection_PaymentDenom)
 }
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x fastReflection_PaymentDenom_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PaymentDenom
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x *fastReflection_PaymentDenom) Descriptor() protoreflect.MessageDescriptor {
+	return md_PaymentDenom
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
+func (x *fastReflection_PaymentDenom) Type() protoreflect.MessageType {
+	return _fastReflection_PaymentDenom_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x *fastReflection_PaymentDenom) New() protoreflect.Message {
+	return new(fastReflection_PaymentDenom)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
+func (x *fastReflection_PaymentDenom) Interface() protoreflect.ProtoMessage {
+	return (*PaymentDenom)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -87,7 +1587,19 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_PaymentDenom) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_PaymentDenom_denom, value) {
+			return
+		}
+	}
+	if x.BasePricePerBytePerBlock != "" {
+		value := protoreflect.ValueOfString(x.BasePricePerBytePerBlock)
+		if !f(fd_PaymentDenom_base_price_per_byte_per_block, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -101,13 +1613,17 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PaymentDenom) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "filespacechain.filespacechain.PaymentDenom.denom":
+		return x.Denom != ""
+	case "filespacechain.filespacechain.PaymentDenom.base_price_per_byte_per_block":
+		return x.BasePricePerBytePerBlock != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.PaymentDenom"))
 		}
-		panic(fmt.Errorf("message filespacechain.filespacechain.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message filespacechain.filespacechain.PaymentDenom does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -117,13 +1633,17 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PaymentDenom) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "filespacechain.filespacechain.PaymentDenom.denom":
+		x.Denom = ""
+	case "filespacechain.filespacechain.PaymentDenom.base_price_per_byte_per_block":
+		x.BasePricePerBytePerBlock = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.PaymentDenom"))
 		}
-		panic(fmt.Errorf("message filespacechain.filespacechain.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message filespacechain.filespacechain.PaymentDenom does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -133,13 +1653,19 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PaymentDenom) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "filespacechain.filespacechain.PaymentDenom.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.PaymentDenom.base_price_per_byte_per_block":
+		value := x.BasePricePerBytePerBlock
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.PaymentDenom"))
 		}
-		panic(fmt.Errorf("message filespacechain.filespacechain.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message filespacechain.filespacechain.PaymentDenom does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -153,13 +1679,17 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PaymentDenom) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "filespacechain.filespacechain.PaymentDenom.denom":
+		x.Denom = value.Interface().(string)
+	case "filespacechain.filespacechain.PaymentDenom.base_price_per_byte_per_block":
+		x.BasePricePerBytePerBlock = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.PaymentDenom"))
 		}
-		panic(fmt.Errorf("message filespacechain.filespacechain.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message filespacechain.filespacechain.PaymentDenom does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -173,36 +1703,44 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PaymentDenom) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "filespacechain.filespacechain.PaymentDenom.denom":
+		panic(fmt.Errorf("field denom of message filespacechain.filespacechain.PaymentDenom is not mutable"))
+	case "filespacechain.filespacechain.PaymentDenom.base_price_per_byte_per_block":
+		panic(fmt.Errorf("field base_price_per_byte_per_block of message filespacechain.filespacechain.PaymentDenom is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.PaymentDenom"))
 		}
-		panic(fmt.Errorf("message filespacechain.filespacechain.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message filespacechain.filespacechain.PaymentDenom does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PaymentDenom) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "filespacechain.filespacechain.PaymentDenom.denom":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.PaymentDenom.base_price_per_byte_per_block":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.PaymentDenom"))
 		}
-		panic(fmt.Errorf("message filespacechain.filespacechain.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message filespacechain.filespacechain.PaymentDenom does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PaymentDenom) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in filespacechain.filespacechain.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in filespacechain.filespacechain.PaymentDenom", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -210,7 +1748,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PaymentDenom) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -221,7 +1759,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PaymentDenom) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -233,7 +1771,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_PaymentDenom) IsValid() bool {
 	return x != nil
 }
 
@@ -243,9 +1781,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PaymentDenom) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*PaymentDenom)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -257,6 +1795,14 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BasePricePerBytePerBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -267,7 +1813,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*PaymentDenom)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -286,6 +1832,20 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.BasePricePerBytePerBlock) > 0 {
+			i -= len(x.BasePricePerBytePerBlock)
+			copy(dAtA[i:], x.BasePricePerBytePerBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BasePricePerBytePerBlock)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -297,7 +1857,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*PaymentDenom)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -329,12 +1889,76 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PaymentDenom: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PaymentDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasePricePerBytePerBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BasePricePerBytePerBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -388,6 +2012,58 @@ type Params struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Minimum stake required for hosting providers
+	MinProviderStake string `protobuf:"bytes,2,opt,name=min_provider_stake,json=minProviderStake,proto3" json:"min_provider_stake,omitempty"`
+	// Fraction of stake to slash for provider failures (0.0 to 1.0)
+	SlashingFraction string `protobuf:"bytes,3,opt,name=slashing_fraction,json=slashingFraction,proto3" json:"slashing_fraction,omitempty"`
+	// Number of active hosting contracts challenged for a storage proof each block
+	ChallengesPerBlock uint64 `protobuf:"varint,4,opt,name=challenges_per_block,json=challengesPerBlock,proto3" json:"challenges_per_block,omitempty"`
+	// Number of chunk indices sampled in a single storage challenge
+	ChunksPerChallenge uint64 `protobuf:"varint,5,opt,name=chunks_per_challenge,json=chunksPerChallenge,proto3" json:"chunks_per_challenge,omitempty"`
+	// Number of blocks a provider has to answer a storage challenge
+	ChallengeWindow uint64 `protobuf:"varint,6,opt,name=challenge_window,json=challengeWindow,proto3" json:"challenge_window,omitempty"`
+	// Number of blocks a provider has to accept a hosting contract before its slot is offered to the next-cheapest offer
+	AcceptanceDeadline uint64 `protobuf:"varint,7,opt,name=acceptance_deadline,json=acceptanceDeadline,proto3" json:"acceptance_deadline,omitempty"`
+	// Number of blocks unstaked funds stay in the bonded pool before they are returned
+	UnbondingPeriod uint64 `protobuf:"varint,8,opt,name=unbonding_period,json=unbondingPeriod,proto3" json:"unbonding_period,omitempty"`
+	// Minimum stake, as a fraction of the remaining value of a provider's active
+	// contracts, that must stay bonded when unstaking
+	CollateralRatio string `protobuf:"bytes,9,opt,name=collateral_ratio,json=collateralRatio,proto3" json:"collateral_ratio,omitempty"`
+	// Where slashed provider stake goes: burned, the community pool or the
+	// affected inquiry creators
+	SlashDestination SlashDestination `protobuf:"varint,10,opt,name=slash_destination,json=slashDestination,proto3,enum=filespacechain.filespacechain.SlashDestination" json:"slash_destination,omitempty"`
+	// Maximum number of entries taken from each expiry queue in one block; the
+	// rest are processed in the following blocks
+	MaxExpiriesPerBlock uint64 `protobuf:"varint,11,opt,name=max_expiries_per_block,json=maxExpiriesPerBlock,proto3" json:"max_expiries_per_block,omitempty"`
+	// Weight of provider reputation next to price when matching offers (0.0 to
+	// 1.0); zero matches on price alone
+	ReputationWeight string `protobuf:"bytes,12,opt,name=reputation_weight,json=reputationWeight,proto3" json:"reputation_weight,omitempty"`
+	// Number of blocks in which a provider's reputation decays halfway back to
+	// neutral; zero disables decay
+	ReputationHalfLife uint64 `protobuf:"varint,13,opt,name=reputation_half_life,json=reputationHalfLife,proto3" json:"reputation_half_life,omitempty"`
+	// Where the rounding remainder of splitting an expired inquiry's escrow
+	// over its replicas goes: back to the inquiry creator or to the community
+	// pool
+	DustDestination DustDestination `protobuf:"varint,14,opt,name=dust_destination,json=dustDestination,proto3,enum=filespacechain.filespacechain.DustDestination" json:"dust_destination,omitempty"`
+	// Denoms inquiries can be escrowed and offers priced in, each with its own
+	// base price per byte per block for storage services
+	AcceptedDenoms []*PaymentDenom `protobuf:"bytes,15,rep,name=accepted_denoms,json=acceptedDenoms,proto3" json:"accepted_denoms,omitempty"`
+	// Fraction of a contract's charge held back as a completion bonus (0.0 to
+	// 1.0); the rest streams to the provider block by block
+	CompletionBonusFraction string `protobuf:"bytes,16,opt,name=completion_bonus_fraction,json=completionBonusFraction,proto3" json:"completion_bonus_fraction,omitempty"`
+	// Fraction of a provider's stake slashed when it terminates an active
+	// contract early (0.0 to 1.0)
+	ProviderTerminationSlashFraction string `protobuf:"bytes,17,opt,name=provider_termination_slash_fraction,json=providerTerminationSlashFraction,proto3" json:"provider_termination_slash_fraction,omitempty"`
+	// Fraction of the unearned charge of an active contract paid to its
+	// provider when the inquiry creator terminates it early (0.0 to 1.0)
+	ClientTerminationPenaltyFraction string `protobuf:"bytes,18,opt,name=client_termination_penalty_fraction,json=clientTerminationPenaltyFraction,proto3" json:"client_termination_penalty_fraction,omitempty"`
+	// Denom providers stake in; min_provider_stake is counted in it
+	BondDenom string `protobuf:"bytes,19,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	// Open inquiries a new or updated offer is matched against at most, in id
+	// order from where the previous offer stopped, and offers an inquiry is
+	// matched against at most, cheapest first
+	OfferMatchScanLimit uint64 `protobuf:"varint,20,opt,name=offer_match_scan_limit,json=offerMatchScanLimit,proto3" json:"offer_match_scan_limit,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -410,6 +2086,184 @@ func (*Params) Descriptor() ([]byte, []int) {
 	return file_filespacechain_filespacechain_params_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *Params) GetMinProviderStake() string {
+	if x != nil {
+		return x.MinProviderStake
+	}
+	return ""
+}
+
+func (x *Params) GetSlashingFraction() string {
+	if x != nil {
+		return x.SlashingFraction
+	}
+	return ""
+}
+
+func (x *Params) GetChallengesPerBlock() uint64 {
+	if x != nil {
+		return x.ChallengesPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetChunksPerChallenge() uint64 {
+	if x != nil {
+		return x.ChunksPerChallenge
+	}
+	return 0
+}
+
+func (x *Params) GetChallengeWindow() uint64 {
+	if x != nil {
+		return x.ChallengeWindow
+	}
+	return 0
+}
+
+func (x *Params) GetAcceptanceDeadline() uint64 {
+	if x != nil {
+		return x.AcceptanceDeadline
+	}
+	return 0
+}
+
+func (x *Params) GetUnbondingPeriod() uint64 {
+	if x != nil {
+		return x.UnbondingPeriod
+	}
+	return 0
+}
+
+func (x *Params) GetCollateralRatio() string {
+	if x != nil {
+		return x.CollateralRatio
+	}
+	return ""
+}
+
+func (x *Params) GetSlashDestination() SlashDestination {
+	if x != nil {
+		return x.SlashDestination
+	}
+	return SlashDestination_SLASH_DESTINATION_BURN
+}
+
+func (x *Params) GetMaxExpiriesPerBlock() uint64 {
+	if x != nil {
+		return x.MaxExpiriesPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetReputationWeight() string {
+	if x != nil {
+		return x.ReputationWeight
+	}
+	return ""
+}
+
+func (x *Params) GetReputationHalfLife() uint64 {
+	if x != nil {
+		return x.ReputationHalfLife
+	}
+	return 0
+}
+
+func (x *Params) GetDustDestination() DustDestination {
+	if x != nil {
+		return x.DustDestination
+	}
+	return DustDestination_DUST_DESTINATION_INQUIRY_CREATOR
+}
+
+func (x *Params) GetAcceptedDenoms() []*PaymentDenom {
+	if x != nil {
+		return x.AcceptedDenoms
+	}
+	return nil
+}
+
+func (x *Params) GetCompletionBonusFraction() string {
+	if x != nil {
+		return x.CompletionBonusFraction
+	}
+	return ""
+}
+
+func (x *Params) GetProviderTerminationSlashFraction() string {
+	if x != nil {
+		return x.ProviderTerminationSlashFraction
+	}
+	return ""
+}
+
+func (x *Params) GetClientTerminationPenaltyFraction() string {
+	if x != nil {
+		return x.ClientTerminationPenaltyFraction
+	}
+	return ""
+}
+
+func (x *Params) GetBondDenom() string {
+	if x != nil {
+		return x.BondDenom
+	}
+	return ""
+}
+
+func (x *Params) GetOfferMatchScanLimit() uint64 {
+	if x != nil {
+		return x.OfferMatchScanLimit
+	}
+	return 0
+}
+
+// PaymentDenom is a denom accepted for storage payments and its base price
+type PaymentDenom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Base price per byte per block for storage services paid in this denom
+	BasePricePerBytePerBlock string `protobuf:"bytes,2,opt,name=base_price_per_byte_per_block,json=basePricePerBytePerBlock,proto3" json:"base_price_per_byte_per_block,omitempty"`
+}
+
+func (x *PaymentDenom) Reset() {
+	*x = PaymentDenom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_filespacechain_filespacechain_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentDenom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentDenom) ProtoMessage() {}
+
+// Deprecated: Use PaymentDenom.ProtoReflect.Descriptor instead.
+func (*PaymentDenom) Descriptor() ([]byte, []int) {
+	return file_filespacechain_filespacechain_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PaymentDenom) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *PaymentDenom) GetBasePricePerBytePerBlock() string {
+	if x != nil {
+		return x.BasePricePerBytePerBlock
+	}
+	return ""
+}
+
 var File_filespacechain_filespacechain_params_proto protoreflect.FileDescriptor
 
 var file_filespacechain_filespacechain_params_proto_rawDesc = []byte{
@@ -420,26 +2274,128 @@ var file_filespacechain_filespacechain_params_proto_rawDesc = []byte{
 	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x1a, 0x11, 0x61, 0x6d, 0x69,
 	0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14,
 	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x39, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x2f,
-	0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x26, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
-	0xf5, 0x01, 0x0a, 0x21, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x2b, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xa8, 0x0b, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4b, 0x0a, 0x12, 0x6d, 0x69,
+	0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x10, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
+	0x67, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x30, 0x0a, 0x14, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x73, 0x50, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x29, 0x0a,
+	0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x2f, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x62,
+	0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x4e, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x52,
+	0x61, 0x74, 0x69, 0x6f, 0x12, 0x5c, 0x0a, 0x11, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2f, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x53, 0x6c, 0x61, 0x73, 0x68, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x10, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69,
+	0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x65, 0x73, 0x50,
+	0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x50, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x75, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x10, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x70,
+	0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61, 0x6c, 0x66, 0x5f, 0x6c, 0x69, 0x66,
+	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x61, 0x6c, 0x66, 0x4c, 0x69, 0x66, 0x65, 0x12, 0x59, 0x0a, 0x10, 0x64,
+	0x75, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
 	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0xa2, 0x02, 0x03, 0x46, 0x46, 0x58, 0xaa, 0x02, 0x1d, 0x46, 0x69, 0x6c,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6c, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xca, 0x02, 0x1d, 0x46, 0x69, 0x6c,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xe2, 0x02, 0x29, 0x46, 0x69, 0x6c,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x75, 0x73, 0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x64, 0x75, 0x73, 0x74, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5f, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x65, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
+	0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x5f, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x5f, 0x66, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52,
+	0x17, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x6e, 0x75, 0x73,
+	0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x72, 0x0a, 0x23, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x20, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x72, 0x0a, 0x23,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x20,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6e, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
+	0x33, 0x0a, 0x16, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73,
+	0x63, 0x61, 0x6e, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x13, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x63, 0x61, 0x6e, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x3a, 0x2f, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x26, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x52, 0x1d, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x90, 0x01, 0x0a, 0x0c, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x12, 0x64, 0x0a, 0x1d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x18, 0x62,
+	0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x50,
+	0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xf5, 0x01,
+	0x0a, 0x21, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0xa2, 0x02, 0x03, 0x46, 0x46, 0x58, 0xaa, 0x02, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xca, 0x02, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xe2, 0x02, 0x29, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -454,16 +2410,22 @@ func file_filespacechain_filespacechain_params_proto_rawDescGZIP() []byte {
 	return file_filespacechain_filespacechain_params_proto_rawDescData
 }
 
-var file_filespacechain_filespacechain_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_filespacechain_filespacechain_params_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_filespacechain_filespacechain_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: filespacechain.filespacechain.Params
+	(*Params)(nil),        // 0: filespacechain.filespacechain.Params
+	(*PaymentDenom)(nil),  // 1: filespacechain.filespacechain.PaymentDenom
+	(SlashDestination)(0), // 2: filespacechain.filespacechain.SlashDestination
+	(DustDestination)(0),  // 3: filespacechain.filespacechain.DustDestination
 }
 var file_filespacechain_filespacechain_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: filespacechain.filespacechain.Params.slash_destination:type_name -> filespacechain.filespacechain.SlashDestination
+	3, // 1: filespacechain.filespacechain.Params.dust_destination:type_name -> filespacechain.filespacechain.DustDestination
+	1, // 2: filespacechain.filespacechain.Params.accepted_denoms:type_name -> filespacechain.filespacechain.PaymentDenom
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_filespacechain_filespacechain_params_proto_init() }
@@ -471,6 +2433,8 @@ func file_filespacechain_filespacechain_params_proto_init() {
 	if File_filespacechain_filespacechain_params_proto != nil {
 		return
 	}
+	file_filespacechain_filespacechain_slashing_proto_init()
+	file_filespacechain_filespacechain_payment_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_filespacechain_filespacechain_params_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Params); i {
@@ -484,6 +2448,18 @@ func file_filespacechain_filespacechain_params_proto_init() {
 				return nil
 			}
 		}
+		file_filespacechain_filespacechain_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentDenom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -491,7 +2467,7 @@ func file_filespacechain_filespacechain_params_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_filespacechain_filespacechain_params_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
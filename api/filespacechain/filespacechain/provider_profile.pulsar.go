@@ -0,0 +1,1038 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package filespacechain
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_ProviderProfile_5_list)(nil)
+
+type _ProviderProfile_5_list struct {
+	list *[]string
+}
+
+func (x *_ProviderProfile_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ProviderProfile_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ProviderProfile_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ProviderProfile_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ProviderProfile_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ProviderProfile at list field Regions as it is not of Message kind"))
+}
+
+func (x *_ProviderProfile_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ProviderProfile_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ProviderProfile_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ProviderProfile_6_list)(nil)
+
+type _ProviderProfile_6_list struct {
+	list *[]string
+}
+
+func (x *_ProviderProfile_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ProviderProfile_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ProviderProfile_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ProviderProfile_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ProviderProfile_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ProviderProfile at list field Endpoints as it is not of Message kind"))
+}
+
+func (x *_ProviderProfile_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ProviderProfile_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ProviderProfile_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ProviderProfile               protoreflect.MessageDescriptor
+	fd_ProviderProfile_provider      protoreflect.FieldDescriptor
+	fd_ProviderProfile_moniker       protoreflect.FieldDescriptor
+	fd_ProviderProfile_totalCapacity protoreflect.FieldDescriptor
+	fd_ProviderProfile_usedCapacity  protoreflect.FieldDescriptor
+	fd_ProviderProfile_regions       protoreflect.FieldDescriptor
+	fd_ProviderProfile_endpoints     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_filespacechain_filespacechain_provider_profile_proto_init()
+	md_ProviderProfile = File_filespacechain_filespacechain_provider_profile_proto.Messages().ByName("ProviderProfile")
+	fd_ProviderProfile_provider = md_ProviderProfile.Fields().ByName("provider")
+	fd_ProviderProfile_moniker = md_ProviderProfile.Fields().ByName("moniker")
+	fd_ProviderProfile_totalCapacity = md_ProviderProfile.Fields().ByName("totalCapacity")
+	fd_ProviderProfile_usedCapacity = md_ProviderProfile.Fields().ByName("usedCapacity")
+	fd_ProviderProfile_regions = md_ProviderProfile.Fields().ByName("regions")
+	fd_ProviderProfile_endpoints = md_ProviderProfile.Fields().ByName("endpoints")
+}
+
+var _ protoreflect.Message = (*fastReflection_ProviderProfile)(nil)
+
+type fastReflection_ProviderProfile ProviderProfile
+
+func (x *ProviderProfile) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProviderProfile)(x)
+}
+
+func (x *ProviderProfile) slowProtoReflect() protoreflect.Message {
+	mi := &file_filespacechain_filespacechain_provider_profile_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ProviderProfile_messageType fastReflection_ProviderProfile_messageType
+var _ protoreflect.MessageType = fastReflection_ProviderProfile_messageType{}
+
+type fastReflection_ProviderProfile_messageType struct{}
+
+func (x fastReflection_ProviderProfile_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProviderProfile)(nil)
+}
+func (x fastReflection_ProviderProfile_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProviderProfile)
+}
+func (x fastReflection_ProviderProfile_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProviderProfile
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ProviderProfile) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProviderProfile
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ProviderProfile) Type() protoreflect.MessageType {
+	return _fastReflection_ProviderProfile_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ProviderProfile) New() protoreflect.Message {
+	return new(fastReflection_ProviderProfile)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ProviderProfile) Interface() protoreflect.ProtoMessage {
+	return (*ProviderProfile)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ProviderProfile) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Provider != "" {
+		value := protoreflect.ValueOfString(x.Provider)
+		if !f(fd_ProviderProfile_provider, value) {
+			return
+		}
+	}
+	if x.Moniker != "" {
+		value := protoreflect.ValueOfString(x.Moniker)
+		if !f(fd_ProviderProfile_moniker, value) {
+			return
+		}
+	}
+	if x.TotalCapacity != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalCapacity)
+		if !f(fd_ProviderProfile_totalCapacity, value) {
+			return
+		}
+	}
+	if x.UsedCapacity != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.UsedCapacity)
+		if !f(fd_ProviderProfile_usedCapacity, value) {
+			return
+		}
+	}
+	if len(x.Regions) != 0 {
+		value := protoreflect.ValueOfList(&_ProviderProfile_5_list{list: &x.Regions})
+		if !f(fd_ProviderProfile_regions, value) {
+			return
+		}
+	}
+	if len(x.Endpoints) != 0 {
+		value := protoreflect.ValueOfList(&_ProviderProfile_6_list{list: &x.Endpoints})
+		if !f(fd_ProviderProfile_endpoints, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ProviderProfile) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.ProviderProfile.provider":
+		return x.Provider != ""
+	case "filespacechain.filespacechain.ProviderProfile.moniker":
+		return x.Moniker != ""
+	case "filespacechain.filespacechain.ProviderProfile.totalCapacity":
+		return x.TotalCapacity != uint64(0)
+	case "filespacechain.filespacechain.ProviderProfile.usedCapacity":
+		return x.UsedCapacity != uint64(0)
+	case "filespacechain.filespacechain.ProviderProfile.regions":
+		return len(x.Regions) != 0
+	case "filespacechain.filespacechain.ProviderProfile.endpoints":
+		return len(x.Endpoints) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.ProviderProfile"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.ProviderProfile does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderProfile) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.ProviderProfile.provider":
+		x.Provider = ""
+	case "filespacechain.filespacechain.ProviderProfile.moniker":
+		x.Moniker = ""
+	case "filespacechain.filespacechain.ProviderProfile.totalCapacity":
+		x.TotalCapacity = uint64(0)
+	case "filespacechain.filespacechain.ProviderProfile.usedCapacity":
+		x.UsedCapacity = uint64(0)
+	case "filespacechain.filespacechain.ProviderProfile.regions":
+		x.Regions = nil
+	case "filespacechain.filespacechain.ProviderProfile.endpoints":
+		x.Endpoints = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.ProviderProfile"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.ProviderProfile does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ProviderProfile) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "filespacechain.filespacechain.ProviderProfile.provider":
+		value := x.Provider
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.ProviderProfile.moniker":
+		value := x.Moniker
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.ProviderProfile.totalCapacity":
+		value := x.TotalCapacity
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.ProviderProfile.usedCapacity":
+		value := x.UsedCapacity
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.ProviderProfile.regions":
+		if len(x.Regions) == 0 {
+			return protoreflect.ValueOfList(&_ProviderProfile_5_list{})
+		}
+		listValue := &_ProviderProfile_5_list{list: &x.Regions}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.ProviderProfile.endpoints":
+		if len(x.Endpoints) == 0 {
+			return protoreflect.ValueOfList(&_ProviderProfile_6_list{})
+		}
+		listValue := &_ProviderProfile_6_list{list: &x.Endpoints}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.ProviderProfile"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.ProviderProfile does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderProfile) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.ProviderProfile.provider":
+		x.Provider = value.Interface().(string)
+	case "filespacechain.filespacechain.ProviderProfile.moniker":
+		x.Moniker = value.Interface().(string)
+	case "filespacechain.filespacechain.ProviderProfile.totalCapacity":
+		x.TotalCapacity = value.Uint()
+	case "filespacechain.filespacechain.ProviderProfile.usedCapacity":
+		x.UsedCapacity = value.Uint()
+	case "filespacechain.filespacechain.ProviderProfile.regions":
+		lv := value.List()
+		clv := lv.(*_ProviderProfile_5_list)
+		x.Regions = *clv.list
+	case "filespacechain.filespacechain.ProviderProfile.endpoints":
+		lv := value.List()
+		clv := lv.(*_ProviderProfile_6_list)
+		x.Endpoints = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.ProviderProfile"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.ProviderProfile does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderProfile) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.ProviderProfile.regions":
+		if x.Regions == nil {
+			x.Regions = []string{}
+		}
+		value := &_ProviderProfile_5_list{list: &x.Regions}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.ProviderProfile.endpoints":
+		if x.Endpoints == nil {
+			x.Endpoints = []string{}
+		}
+		value := &_ProviderProfile_6_list{list: &x.Endpoints}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.ProviderProfile.provider":
+		panic(fmt.Errorf("field provider of message filespacechain.filespacechain.ProviderProfile is not mutable"))
+	case "filespacechain.filespacechain.ProviderProfile.moniker":
+		panic(fmt.Errorf("field moniker of message filespacechain.filespacechain.ProviderProfile is not mutable"))
+	case "filespacechain.filespacechain.ProviderProfile.totalCapacity":
+		panic(fmt.Errorf("field totalCapacity of message filespacechain.filespacechain.ProviderProfile is not mutable"))
+	case "filespacechain.filespacechain.ProviderProfile.usedCapacity":
+		panic(fmt.Errorf("field usedCapacity of message filespacechain.filespacechain.ProviderProfile is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.ProviderProfile"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.ProviderProfile does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ProviderProfile) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.ProviderProfile.provider":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.ProviderProfile.moniker":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.ProviderProfile.totalCapacity":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.ProviderProfile.usedCapacity":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.ProviderProfile.regions":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ProviderProfile_5_list{list: &list})
+	case "filespacechain.filespacechain.ProviderProfile.endpoints":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ProviderProfile_6_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.ProviderProfile"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.ProviderProfile does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ProviderProfile) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in filespacechain.filespacechain.ProviderProfile", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ProviderProfile) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderProfile) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ProviderProfile) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ProviderProfile) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ProviderProfile)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Moniker)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TotalCapacity != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalCapacity))
+		}
+		if x.UsedCapacity != 0 {
+			n += 1 + runtime.Sov(uint64(x.UsedCapacity))
+		}
+		if len(x.Regions) > 0 {
+			for _, s := range x.Regions {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Endpoints) > 0 {
+			for _, s := range x.Endpoints {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ProviderProfile)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Endpoints) > 0 {
+			for iNdEx := len(x.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Endpoints[iNdEx])
+				copy(dAtA[i:], x.Endpoints[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Endpoints[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Regions) > 0 {
+			for iNdEx := len(x.Regions) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Regions[iNdEx])
+				copy(dAtA[i:], x.Regions[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Regions[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.UsedCapacity != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UsedCapacity))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.TotalCapacity != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalCapacity))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Moniker) > 0 {
+			i -= len(x.Moniker)
+			copy(dAtA[i:], x.Moniker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Moniker)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ProviderProfile)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProviderProfile: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProviderProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Moniker = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalCapacity", wireType)
+				}
+				x.TotalCapacity = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalCapacity |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UsedCapacity", wireType)
+				}
+				x.UsedCapacity = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UsedCapacity |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Regions = append(x.Regions, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Endpoints = append(x.Endpoints, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: filespacechain/filespacechain/provider_profile.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ProviderProfile is what a provider declares about itself. Offers of a
+// provider with a profile are only matched to files that fit in its free
+// capacity.
+type ProviderProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Moniker  string `protobuf:"bytes,2,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	// Bytes the provider declares it can store
+	TotalCapacity uint64 `protobuf:"varint,3,opt,name=totalCapacity,proto3" json:"totalCapacity,omitempty"`
+	// Bytes held by the provider's PENDING and ACTIVE contracts
+	UsedCapacity uint64   `protobuf:"varint,4,opt,name=usedCapacity,proto3" json:"usedCapacity,omitempty"`
+	Regions      []string `protobuf:"bytes,5,rep,name=regions,proto3" json:"regions,omitempty"`
+	// Multiaddrs the provider serves hosted files at
+	Endpoints []string `protobuf:"bytes,6,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+}
+
+func (x *ProviderProfile) Reset() {
+	*x = ProviderProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_filespacechain_filespacechain_provider_profile_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderProfile) ProtoMessage() {}
+
+// Deprecated: Use ProviderProfile.ProtoReflect.Descriptor instead.
+func (*ProviderProfile) Descriptor() ([]byte, []int) {
+	return file_filespacechain_filespacechain_provider_profile_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProviderProfile) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ProviderProfile) GetMoniker() string {
+	if x != nil {
+		return x.Moniker
+	}
+	return ""
+}
+
+func (x *ProviderProfile) GetTotalCapacity() uint64 {
+	if x != nil {
+		return x.TotalCapacity
+	}
+	return 0
+}
+
+func (x *ProviderProfile) GetUsedCapacity() uint64 {
+	if x != nil {
+		return x.UsedCapacity
+	}
+	return 0
+}
+
+func (x *ProviderProfile) GetRegions() []string {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *ProviderProfile) GetEndpoints() []string {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+var File_filespacechain_filespacechain_provider_profile_proto protoreflect.FileDescriptor
+
+var file_filespacechain_filespacechain_provider_profile_proto_rawDesc = []byte{
+	0x0a, 0x34, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x22, 0xc9, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x69, 0x6b, 0x65, 0x72, 0x12,
+	0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x43, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x75, 0x73, 0x65,
+	0x64, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x42, 0xfe, 0x01, 0x0a, 0x21, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x14, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xa2,
+	0x02, 0x03, 0x46, 0x46, 0x58, 0xaa, 0x02, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0xca, 0x02, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0xe2, 0x02, 0x29, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x1e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x3a, 0x3a, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_filespacechain_filespacechain_provider_profile_proto_rawDescOnce sync.Once
+	file_filespacechain_filespacechain_provider_profile_proto_rawDescData = file_filespacechain_filespacechain_provider_profile_proto_rawDesc
+)
+
+func file_filespacechain_filespacechain_provider_profile_proto_rawDescGZIP() []byte {
+	file_filespacechain_filespacechain_provider_profile_proto_rawDescOnce.Do(func() {
+		file_filespacechain_filespacechain_provider_profile_proto_rawDescData = protoimpl.X.CompressGZIP(file_filespacechain_filespacechain_provider_profile_proto_rawDescData)
+	})
+	return file_filespacechain_filespacechain_provider_profile_proto_rawDescData
+}
+
+var file_filespacechain_filespacechain_provider_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_filespacechain_filespacechain_provider_profile_proto_goTypes = []interface{}{
+	(*ProviderProfile)(nil), // 0: filespacechain.filespacechain.ProviderProfile
+}
+var file_filespacechain_filespacechain_provider_profile_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_filespacechain_filespacechain_provider_profile_proto_init() }
+func file_filespacechain_filespacechain_provider_profile_proto_init() {
+	if File_filespacechain_filespacechain_provider_profile_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_filespacechain_filespacechain_provider_profile_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_filespacechain_filespacechain_provider_profile_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_filespacechain_filespacechain_provider_profile_proto_goTypes,
+		DependencyIndexes: file_filespacechain_filespacechain_provider_profile_proto_depIdxs,
+		MessageInfos:      file_filespacechain_filespacechain_provider_profile_proto_msgTypes,
+	}.Build()
+	File_filespacechain_filespacechain_provider_profile_proto = out.File
+	file_filespacechain_filespacechain_provider_profile_proto_rawDesc = nil
+	file_filespacechain_filespacechain_provider_profile_proto_goTypes = nil
+	file_filespacechain_filespacechain_provider_profile_proto_depIdxs = nil
+}
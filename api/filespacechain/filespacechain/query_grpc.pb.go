@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.3.0
+// - protoc-gen-go-grpc v1.5.1
 // - protoc             (unknown)
 // source: filespacechain/filespacechain/query.proto
 
@@ -15,25 +15,55 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.32.0 or later.
-const _ = grpc.SupportPackageIsVersion7
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName                  = "/filespacechain.filespacechain.Query/Params"
-	Query_FileEntry_FullMethodName               = "/filespacechain.filespacechain.Query/FileEntry"
-	Query_FileEntryAll_FullMethodName            = "/filespacechain.filespacechain.Query/FileEntryAll"
-	Query_HostingInquiry_FullMethodName          = "/filespacechain.filespacechain.Query/HostingInquiry"
-	Query_HostingInquiryAll_FullMethodName       = "/filespacechain.filespacechain.Query/HostingInquiryAll"
-	Query_HostingContract_FullMethodName         = "/filespacechain.filespacechain.Query/HostingContract"
-	Query_HostingContractAll_FullMethodName      = "/filespacechain.filespacechain.Query/HostingContractAll"
-	Query_HostingOffer_FullMethodName            = "/filespacechain.filespacechain.Query/HostingOffer"
-	Query_HostingOfferAll_FullMethodName         = "/filespacechain.filespacechain.Query/HostingOfferAll"
-	Query_ListHostingContractFrom_FullMethodName = "/filespacechain.filespacechain.Query/ListHostingContractFrom"
+	Query_Params_FullMethodName                   = "/filespacechain.filespacechain.Query/Params"
+	Query_FileEntry_FullMethodName                = "/filespacechain.filespacechain.Query/FileEntry"
+	Query_FileEntryAll_FullMethodName             = "/filespacechain.filespacechain.Query/FileEntryAll"
+	Query_HostingInquiry_FullMethodName           = "/filespacechain.filespacechain.Query/HostingInquiry"
+	Query_HostingInquiryAll_FullMethodName        = "/filespacechain.filespacechain.Query/HostingInquiryAll"
+	Query_HostingContract_FullMethodName          = "/filespacechain.filespacechain.Query/HostingContract"
+	Query_HostingContractAll_FullMethodName       = "/filespacechain.filespacechain.Query/HostingContractAll"
+	Query_HostingOffer_FullMethodName             = "/filespacechain.filespacechain.Query/HostingOffer"
+	Query_HostingOfferAll_FullMethodName          = "/filespacechain.filespacechain.Query/HostingOfferAll"
+	Query_ListHostingContractFrom_FullMethodName  = "/filespacechain.filespacechain.Query/ListHostingContractFrom"
+	Query_PaymentHistory_FullMethodName           = "/filespacechain.filespacechain.Query/PaymentHistory"
+	Query_PaymentHistoryAll_FullMethodName        = "/filespacechain.filespacechain.Query/PaymentHistoryAll"
+	Query_EscrowRecord_FullMethodName             = "/filespacechain.filespacechain.Query/EscrowRecord"
+	Query_EscrowRecordAll_FullMethodName          = "/filespacechain.filespacechain.Query/EscrowRecordAll"
+	Query_ProviderStake_FullMethodName            = "/filespacechain.filespacechain.Query/ProviderStake"
+	Query_ProviderStakeAll_FullMethodName         = "/filespacechain.filespacechain.Query/ProviderStakeAll"
+	Query_StorageChallenge_FullMethodName         = "/filespacechain.filespacechain.Query/StorageChallenge"
+	Query_StorageChallengeAll_FullMethodName      = "/filespacechain.filespacechain.Query/StorageChallengeAll"
+	Query_SlashEvent_FullMethodName               = "/filespacechain.filespacechain.Query/SlashEvent"
+	Query_SlashEventAll_FullMethodName            = "/filespacechain.filespacechain.Query/SlashEventAll"
+	Query_ProviderUnbondings_FullMethodName       = "/filespacechain.filespacechain.Query/ProviderUnbondings"
+	Query_OpenInquiries_FullMethodName            = "/filespacechain.filespacechain.Query/OpenInquiries"
+	Query_Auction_FullMethodName                  = "/filespacechain.filespacechain.Query/Auction"
+	Query_ProviderProfile_FullMethodName          = "/filespacechain.filespacechain.Query/ProviderProfile"
+	Query_ProviderProfileAll_FullMethodName       = "/filespacechain.filespacechain.Query/ProviderProfileAll"
+	Query_ProviderReputation_FullMethodName       = "/filespacechain.filespacechain.Query/ProviderReputation"
+	Query_ProviderReputationAll_FullMethodName    = "/filespacechain.filespacechain.Query/ProviderReputationAll"
+	Query_SystemStatistics_FullMethodName         = "/filespacechain.filespacechain.Query/SystemStatistics"
+	Query_ContractDetails_FullMethodName          = "/filespacechain.filespacechain.Query/ContractDetails"
+	Query_ProviderPerformance_FullMethodName      = "/filespacechain.filespacechain.Query/ProviderPerformance"
+	Query_PaymentAnalytics_FullMethodName         = "/filespacechain.filespacechain.Query/PaymentAnalytics"
+	Query_PaymentDistribution_FullMethodName      = "/filespacechain.filespacechain.Query/PaymentDistribution"
+	Query_ProviderPaymentSummary_FullMethodName   = "/filespacechain.filespacechain.Query/ProviderPaymentSummary"
+	Query_PaymentTrends_FullMethodName            = "/filespacechain.filespacechain.Query/PaymentTrends"
+	Query_UnpaidContracts_FullMethodName          = "/filespacechain.filespacechain.Query/UnpaidContracts"
+	Query_ProviderPayments_FullMethodName         = "/filespacechain.filespacechain.Query/ProviderPayments"
+	Query_ContractProviderPayments_FullMethodName = "/filespacechain.filespacechain.Query/ContractProviderPayments"
+	Query_ProviderPaymentsByHeight_FullMethodName = "/filespacechain.filespacechain.Query/ProviderPaymentsByHeight"
 )
 
 // QueryClient is the client API for Query service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Query defines the gRPC querier service.
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
@@ -51,6 +81,58 @@ type QueryClient interface {
 	HostingOfferAll(ctx context.Context, in *QueryAllHostingOfferRequest, opts ...grpc.CallOption) (*QueryAllHostingOfferResponse, error)
 	// Queries a list of ListHostingContractFrom items.
 	ListHostingContractFrom(ctx context.Context, in *QueryListHostingContractFromRequest, opts ...grpc.CallOption) (*QueryListHostingContractFromResponse, error)
+	// Queries payment history for a specific contract.
+	PaymentHistory(ctx context.Context, in *QueryPaymentHistoryRequest, opts ...grpc.CallOption) (*QueryPaymentHistoryResponse, error)
+	// Queries all payment history records.
+	PaymentHistoryAll(ctx context.Context, in *QueryAllPaymentHistoryRequest, opts ...grpc.CallOption) (*QueryAllPaymentHistoryResponse, error)
+	// Queries escrow record for a specific inquiry.
+	EscrowRecord(ctx context.Context, in *QueryEscrowRecordRequest, opts ...grpc.CallOption) (*QueryEscrowRecordResponse, error)
+	// Queries all escrow records.
+	EscrowRecordAll(ctx context.Context, in *QueryAllEscrowRecordRequest, opts ...grpc.CallOption) (*QueryAllEscrowRecordResponse, error)
+	// Queries provider stake for a specific address.
+	ProviderStake(ctx context.Context, in *QueryProviderStakeRequest, opts ...grpc.CallOption) (*QueryProviderStakeResponse, error)
+	// Queries all provider stakes.
+	ProviderStakeAll(ctx context.Context, in *QueryAllProviderStakeRequest, opts ...grpc.CallOption) (*QueryAllProviderStakeResponse, error)
+	// Queries a list of StorageChallenge items.
+	StorageChallenge(ctx context.Context, in *QueryGetStorageChallengeRequest, opts ...grpc.CallOption) (*QueryGetStorageChallengeResponse, error)
+	StorageChallengeAll(ctx context.Context, in *QueryAllStorageChallengeRequest, opts ...grpc.CallOption) (*QueryAllStorageChallengeResponse, error)
+	// Queries a list of SlashEvent items.
+	SlashEvent(ctx context.Context, in *QueryGetSlashEventRequest, opts ...grpc.CallOption) (*QueryGetSlashEventResponse, error)
+	SlashEventAll(ctx context.Context, in *QueryAllSlashEventRequest, opts ...grpc.CallOption) (*QueryAllSlashEventResponse, error)
+	// Queries the pending unbonding entries of a provider.
+	ProviderUnbondings(ctx context.Context, in *QueryProviderUnbondingsRequest, opts ...grpc.CallOption) (*QueryProviderUnbondingsResponse, error)
+	// Queries the inquiries that have not ended and still have unfilled replicas.
+	OpenInquiries(ctx context.Context, in *QueryOpenInquiriesRequest, opts ...grpc.CallOption) (*QueryOpenInquiriesResponse, error)
+	// Queries the auction of an inquiry and its bids.
+	Auction(ctx context.Context, in *QueryGetAuctionRequest, opts ...grpc.CallOption) (*QueryGetAuctionResponse, error)
+	// Queries a list of ProviderProfile items.
+	ProviderProfile(ctx context.Context, in *QueryGetProviderProfileRequest, opts ...grpc.CallOption) (*QueryGetProviderProfileResponse, error)
+	ProviderProfileAll(ctx context.Context, in *QueryAllProviderProfileRequest, opts ...grpc.CallOption) (*QueryAllProviderProfileResponse, error)
+	// Queries the reputation of a provider, decayed to the current block.
+	ProviderReputation(ctx context.Context, in *QueryGetProviderReputationRequest, opts ...grpc.CallOption) (*QueryGetProviderReputationResponse, error)
+	ProviderReputationAll(ctx context.Context, in *QueryAllProviderReputationRequest, opts ...grpc.CallOption) (*QueryAllProviderReputationResponse, error)
+	// Queries the number of records of each kind and of active and expired contracts.
+	SystemStatistics(ctx context.Context, in *QuerySystemStatisticsRequest, opts ...grpc.CallOption) (*QuerySystemStatisticsResponse, error)
+	// Queries a contract together with its inquiry, offer, payment history and escrow record.
+	ContractDetails(ctx context.Context, in *QueryContractDetailsRequest, opts ...grpc.CallOption) (*QueryContractDetailsResponse, error)
+	// Queries the contract, earnings and reputation figures of a provider.
+	ProviderPerformance(ctx context.Context, in *QueryProviderPerformanceRequest, opts ...grpc.CallOption) (*QueryProviderPerformanceResponse, error)
+	// Queries the amounts paid per denom and the number of settled and running payment histories.
+	PaymentAnalytics(ctx context.Context, in *QueryPaymentAnalyticsRequest, opts ...grpc.CallOption) (*QueryPaymentAnalyticsResponse, error)
+	// Queries the spread of the amounts paid per contract in a denom.
+	PaymentDistribution(ctx context.Context, in *QueryPaymentDistributionRequest, opts ...grpc.CallOption) (*QueryPaymentDistributionResponse, error)
+	// Queries the payments received by each staked provider.
+	ProviderPaymentSummary(ctx context.Context, in *QueryProviderPaymentSummaryRequest, opts ...grpc.CallOption) (*QueryProviderPaymentSummaryResponse, error)
+	// Queries the provider payments released per window of blocks.
+	PaymentTrends(ctx context.Context, in *QueryPaymentTrendsRequest, opts ...grpc.CallOption) (*QueryPaymentTrendsResponse, error)
+	// Queries the started contracts that have no payment history.
+	UnpaidContracts(ctx context.Context, in *QueryUnpaidContractsRequest, opts ...grpc.CallOption) (*QueryUnpaidContractsResponse, error)
+	// Queries the payments released to a provider.
+	ProviderPayments(ctx context.Context, in *QueryProviderPaymentsRequest, opts ...grpc.CallOption) (*QueryProviderPaymentsResponse, error)
+	// Queries the payments released to the providers of a contract.
+	ContractProviderPayments(ctx context.Context, in *QueryContractProviderPaymentsRequest, opts ...grpc.CallOption) (*QueryContractProviderPaymentsResponse, error)
+	// Queries the payments released to providers within a range of blocks.
+	ProviderPaymentsByHeight(ctx context.Context, in *QueryProviderPaymentsByHeightRequest, opts ...grpc.CallOption) (*QueryProviderPaymentsByHeightResponse, error)
 }
 
 type queryClient struct {
@@ -62,8 +144,9 @@ func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
 }
 
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -71,8 +154,9 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 }
 
 func (c *queryClient) FileEntry(ctx context.Context, in *QueryGetFileEntryRequest, opts ...grpc.CallOption) (*QueryGetFileEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryGetFileEntryResponse)
-	err := c.cc.Invoke(ctx, Query_FileEntry_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Query_FileEntry_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -80,8 +164,9 @@ func (c *queryClient) FileEntry(ctx context.Context, in *QueryGetFileEntryReques
 }
 
 func (c *queryClient) FileEntryAll(ctx context.Context, in *QueryAllFileEntryRequest, opts ...grpc.CallOption) (*QueryAllFileEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryAllFileEntryResponse)
-	err := c.cc.Invoke(ctx, Query_FileEntryAll_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Query_FileEntryAll_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -89,8 +174,9 @@ func (c *queryClient) FileEntryAll(ctx context.Context, in *QueryAllFileEntryReq
 }
 
 func (c *queryClient) HostingInquiry(ctx context.Context, in *QueryGetHostingInquiryRequest, opts ...grpc.CallOption) (*QueryGetHostingInquiryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryGetHostingInquiryResponse)
-	err := c.cc.Invoke(ctx, Query_HostingInquiry_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Query_HostingInquiry_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -98,8 +184,9 @@ func (c *queryClient) HostingInquiry(ctx context.Context, in *QueryGetHostingInq
 }
 
 func (c *queryClient) HostingInquiryAll(ctx context.Context, in *QueryAllHostingInquiryRequest, opts ...grpc.CallOption) (*QueryAllHostingInquiryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryAllHostingInquiryResponse)
-	err := c.cc.Invoke(ctx, Query_HostingInquiryAll_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Query_HostingInquiryAll_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -107,8 +194,9 @@ func (c *queryClient) HostingInquiryAll(ctx context.Context, in *QueryAllHosting
 }
 
 func (c *queryClient) HostingContract(ctx context.Context, in *QueryGetHostingContractRequest, opts ...grpc.CallOption) (*QueryGetHostingContractResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryGetHostingContractResponse)
-	err := c.cc.Invoke(ctx, Query_HostingContract_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Query_HostingContract_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -116,8 +204,9 @@ func (c *queryClient) HostingContract(ctx context.Context, in *QueryGetHostingCo
 }
 
 func (c *queryClient) HostingContractAll(ctx context.Context, in *QueryAllHostingContractRequest, opts ...grpc.CallOption) (*QueryAllHostingContractResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryAllHostingContractResponse)
-	err := c.cc.Invoke(ctx, Query_HostingContractAll_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Query_HostingContractAll_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -125,8 +214,9 @@ func (c *queryClient) HostingContractAll(ctx context.Context, in *QueryAllHostin
 }
 
 func (c *queryClient) HostingOffer(ctx context.Context, in *QueryGetHostingOfferRequest, opts ...grpc.CallOption) (*QueryGetHostingOfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryGetHostingOfferResponse)
-	err := c.cc.Invoke(ctx, Query_HostingOffer_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Query_HostingOffer_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -134,8 +224,9 @@ func (c *queryClient) HostingOffer(ctx context.Context, in *QueryGetHostingOffer
 }
 
 func (c *queryClient) HostingOfferAll(ctx context.Context, in *QueryAllHostingOfferRequest, opts ...grpc.CallOption) (*QueryAllHostingOfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryAllHostingOfferResponse)
-	err := c.cc.Invoke(ctx, Query_HostingOfferAll_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Query_HostingOfferAll_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -143,8 +234,289 @@ func (c *queryClient) HostingOfferAll(ctx context.Context, in *QueryAllHostingOf
 }
 
 func (c *queryClient) ListHostingContractFrom(ctx context.Context, in *QueryListHostingContractFromRequest, opts ...grpc.CallOption) (*QueryListHostingContractFromResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryListHostingContractFromResponse)
-	err := c.cc.Invoke(ctx, Query_ListHostingContractFrom_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Query_ListHostingContractFrom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymentHistory(ctx context.Context, in *QueryPaymentHistoryRequest, opts ...grpc.CallOption) (*QueryPaymentHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPaymentHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_PaymentHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymentHistoryAll(ctx context.Context, in *QueryAllPaymentHistoryRequest, opts ...grpc.CallOption) (*QueryAllPaymentHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAllPaymentHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_PaymentHistoryAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowRecord(ctx context.Context, in *QueryEscrowRecordRequest, opts ...grpc.CallOption) (*QueryEscrowRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEscrowRecordResponse)
+	err := c.cc.Invoke(ctx, Query_EscrowRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowRecordAll(ctx context.Context, in *QueryAllEscrowRecordRequest, opts ...grpc.CallOption) (*QueryAllEscrowRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAllEscrowRecordResponse)
+	err := c.cc.Invoke(ctx, Query_EscrowRecordAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderStake(ctx context.Context, in *QueryProviderStakeRequest, opts ...grpc.CallOption) (*QueryProviderStakeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryProviderStakeResponse)
+	err := c.cc.Invoke(ctx, Query_ProviderStake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderStakeAll(ctx context.Context, in *QueryAllProviderStakeRequest, opts ...grpc.CallOption) (*QueryAllProviderStakeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAllProviderStakeResponse)
+	err := c.cc.Invoke(ctx, Query_ProviderStakeAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageChallenge(ctx context.Context, in *QueryGetStorageChallengeRequest, opts ...grpc.CallOption) (*QueryGetStorageChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGetStorageChallengeResponse)
+	err := c.cc.Invoke(ctx, Query_StorageChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageChallengeAll(ctx context.Context, in *QueryAllStorageChallengeRequest, opts ...grpc.CallOption) (*QueryAllStorageChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAllStorageChallengeResponse)
+	err := c.cc.Invoke(ctx, Query_StorageChallengeAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashEvent(ctx context.Context, in *QueryGetSlashEventRequest, opts ...grpc.CallOption) (*QueryGetSlashEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGetSlashEventResponse)
+	err := c.cc.Invoke(ctx, Query_SlashEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashEventAll(ctx context.Context, in *QueryAllSlashEventRequest, opts ...grpc.CallOption) (*QueryAllSlashEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAllSlashEventResponse)
+	err := c.cc.Invoke(ctx, Query_SlashEventAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderUnbondings(ctx context.Context, in *QueryProviderUnbondingsRequest, opts ...grpc.CallOption) (*QueryProviderUnbondingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryProviderUnbondingsResponse)
+	err := c.cc.Invoke(ctx, Query_ProviderUnbondings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OpenInquiries(ctx context.Context, in *QueryOpenInquiriesRequest, opts ...grpc.CallOption) (*QueryOpenInquiriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryOpenInquiriesResponse)
+	err := c.cc.Invoke(ctx, Query_OpenInquiries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Auction(ctx context.Context, in *QueryGetAuctionRequest, opts ...grpc.CallOption) (*QueryGetAuctionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGetAuctionResponse)
+	err := c.cc.Invoke(ctx, Query_Auction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderProfile(ctx context.Context, in *QueryGetProviderProfileRequest, opts ...grpc.CallOption) (*QueryGetProviderProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGetProviderProfileResponse)
+	err := c.cc.Invoke(ctx, Query_ProviderProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderProfileAll(ctx context.Context, in *QueryAllProviderProfileRequest, opts ...grpc.CallOption) (*QueryAllProviderProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAllProviderProfileResponse)
+	err := c.cc.Invoke(ctx, Query_ProviderProfileAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderReputation(ctx context.Context, in *QueryGetProviderReputationRequest, opts ...grpc.CallOption) (*QueryGetProviderReputationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGetProviderReputationResponse)
+	err := c.cc.Invoke(ctx, Query_ProviderReputation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderReputationAll(ctx context.Context, in *QueryAllProviderReputationRequest, opts ...grpc.CallOption) (*QueryAllProviderReputationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAllProviderReputationResponse)
+	err := c.cc.Invoke(ctx, Query_ProviderReputationAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SystemStatistics(ctx context.Context, in *QuerySystemStatisticsRequest, opts ...grpc.CallOption) (*QuerySystemStatisticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySystemStatisticsResponse)
+	err := c.cc.Invoke(ctx, Query_SystemStatistics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractDetails(ctx context.Context, in *QueryContractDetailsRequest, opts ...grpc.CallOption) (*QueryContractDetailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryContractDetailsResponse)
+	err := c.cc.Invoke(ctx, Query_ContractDetails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderPerformance(ctx context.Context, in *QueryProviderPerformanceRequest, opts ...grpc.CallOption) (*QueryProviderPerformanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryProviderPerformanceResponse)
+	err := c.cc.Invoke(ctx, Query_ProviderPerformance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymentAnalytics(ctx context.Context, in *QueryPaymentAnalyticsRequest, opts ...grpc.CallOption) (*QueryPaymentAnalyticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPaymentAnalyticsResponse)
+	err := c.cc.Invoke(ctx, Query_PaymentAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymentDistribution(ctx context.Context, in *QueryPaymentDistributionRequest, opts ...grpc.CallOption) (*QueryPaymentDistributionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPaymentDistributionResponse)
+	err := c.cc.Invoke(ctx, Query_PaymentDistribution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderPaymentSummary(ctx context.Context, in *QueryProviderPaymentSummaryRequest, opts ...grpc.CallOption) (*QueryProviderPaymentSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryProviderPaymentSummaryResponse)
+	err := c.cc.Invoke(ctx, Query_ProviderPaymentSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymentTrends(ctx context.Context, in *QueryPaymentTrendsRequest, opts ...grpc.CallOption) (*QueryPaymentTrendsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPaymentTrendsResponse)
+	err := c.cc.Invoke(ctx, Query_PaymentTrends_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnpaidContracts(ctx context.Context, in *QueryUnpaidContractsRequest, opts ...grpc.CallOption) (*QueryUnpaidContractsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryUnpaidContractsResponse)
+	err := c.cc.Invoke(ctx, Query_UnpaidContracts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderPayments(ctx context.Context, in *QueryProviderPaymentsRequest, opts ...grpc.CallOption) (*QueryProviderPaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryProviderPaymentsResponse)
+	err := c.cc.Invoke(ctx, Query_ProviderPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractProviderPayments(ctx context.Context, in *QueryContractProviderPaymentsRequest, opts ...grpc.CallOption) (*QueryContractProviderPaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryContractProviderPaymentsResponse)
+	err := c.cc.Invoke(ctx, Query_ContractProviderPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderPaymentsByHeight(ctx context.Context, in *QueryProviderPaymentsByHeightRequest, opts ...grpc.CallOption) (*QueryProviderPaymentsByHeightResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryProviderPaymentsByHeightResponse)
+	err := c.cc.Invoke(ctx, Query_ProviderPaymentsByHeight_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -153,7 +525,9 @@ func (c *queryClient) ListHostingContractFrom(ctx context.Context, in *QueryList
 
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
-// for forward compatibility
+// for forward compatibility.
+//
+// Query defines the gRPC querier service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
@@ -171,12 +545,67 @@ type QueryServer interface {
 	HostingOfferAll(context.Context, *QueryAllHostingOfferRequest) (*QueryAllHostingOfferResponse, error)
 	// Queries a list of ListHostingContractFrom items.
 	ListHostingContractFrom(context.Context, *QueryListHostingContractFromRequest) (*QueryListHostingContractFromResponse, error)
+	// Queries payment history for a specific contract.
+	PaymentHistory(context.Context, *QueryPaymentHistoryRequest) (*QueryPaymentHistoryResponse, error)
+	// Queries all payment history records.
+	PaymentHistoryAll(context.Context, *QueryAllPaymentHistoryRequest) (*QueryAllPaymentHistoryResponse, error)
+	// Queries escrow record for a specific inquiry.
+	EscrowRecord(context.Context, *QueryEscrowRecordRequest) (*QueryEscrowRecordResponse, error)
+	// Queries all escrow records.
+	EscrowRecordAll(context.Context, *QueryAllEscrowRecordRequest) (*QueryAllEscrowRecordResponse, error)
+	// Queries provider stake for a specific address.
+	ProviderStake(context.Context, *QueryProviderStakeRequest) (*QueryProviderStakeResponse, error)
+	// Queries all provider stakes.
+	ProviderStakeAll(context.Context, *QueryAllProviderStakeRequest) (*QueryAllProviderStakeResponse, error)
+	// Queries a list of StorageChallenge items.
+	StorageChallenge(context.Context, *QueryGetStorageChallengeRequest) (*QueryGetStorageChallengeResponse, error)
+	StorageChallengeAll(context.Context, *QueryAllStorageChallengeRequest) (*QueryAllStorageChallengeResponse, error)
+	// Queries a list of SlashEvent items.
+	SlashEvent(context.Context, *QueryGetSlashEventRequest) (*QueryGetSlashEventResponse, error)
+	SlashEventAll(context.Context, *QueryAllSlashEventRequest) (*QueryAllSlashEventResponse, error)
+	// Queries the pending unbonding entries of a provider.
+	ProviderUnbondings(context.Context, *QueryProviderUnbondingsRequest) (*QueryProviderUnbondingsResponse, error)
+	// Queries the inquiries that have not ended and still have unfilled replicas.
+	OpenInquiries(context.Context, *QueryOpenInquiriesRequest) (*QueryOpenInquiriesResponse, error)
+	// Queries the auction of an inquiry and its bids.
+	Auction(context.Context, *QueryGetAuctionRequest) (*QueryGetAuctionResponse, error)
+	// Queries a list of ProviderProfile items.
+	ProviderProfile(context.Context, *QueryGetProviderProfileRequest) (*QueryGetProviderProfileResponse, error)
+	ProviderProfileAll(context.Context, *QueryAllProviderProfileRequest) (*QueryAllProviderProfileResponse, error)
+	// Queries the reputation of a provider, decayed to the current block.
+	ProviderReputation(context.Context, *QueryGetProviderReputationRequest) (*QueryGetProviderReputationResponse, error)
+	ProviderReputationAll(context.Context, *QueryAllProviderReputationRequest) (*QueryAllProviderReputationResponse, error)
+	// Queries the number of records of each kind and of active and expired contracts.
+	SystemStatistics(context.Context, *QuerySystemStatisticsRequest) (*QuerySystemStatisticsResponse, error)
+	// Queries a contract together with its inquiry, offer, payment history and escrow record.
+	ContractDetails(context.Context, *QueryContractDetailsRequest) (*QueryContractDetailsResponse, error)
+	// Queries the contract, earnings and reputation figures of a provider.
+	ProviderPerformance(context.Context, *QueryProviderPerformanceRequest) (*QueryProviderPerformanceResponse, error)
+	// Queries the amounts paid per denom and the number of settled and running payment histories.
+	PaymentAnalytics(context.Context, *QueryPaymentAnalyticsRequest) (*QueryPaymentAnalyticsResponse, error)
+	// Queries the spread of the amounts paid per contract in a denom.
+	PaymentDistribution(context.Context, *QueryPaymentDistributionRequest) (*QueryPaymentDistributionResponse, error)
+	// Queries the payments received by each staked provider.
+	ProviderPaymentSummary(context.Context, *QueryProviderPaymentSummaryRequest) (*QueryProviderPaymentSummaryResponse, error)
+	// Queries the provider payments released per window of blocks.
+	PaymentTrends(context.Context, *QueryPaymentTrendsRequest) (*QueryPaymentTrendsResponse, error)
+	// Queries the started contracts that have no payment history.
+	UnpaidContracts(context.Context, *QueryUnpaidContractsRequest) (*QueryUnpaidContractsResponse, error)
+	// Queries the payments released to a provider.
+	ProviderPayments(context.Context, *QueryProviderPaymentsRequest) (*QueryProviderPaymentsResponse, error)
+	// Queries the payments released to the providers of a contract.
+	ContractProviderPayments(context.Context, *QueryContractProviderPaymentsRequest) (*QueryContractProviderPaymentsResponse, error)
+	// Queries the payments released to providers within a range of blocks.
+	ProviderPaymentsByHeight(context.Context, *QueryProviderPaymentsByHeightRequest) (*QueryProviderPaymentsByHeightResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
-// UnimplementedQueryServer must be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
-}
+// UnimplementedQueryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQueryServer struct{}
 
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
@@ -208,7 +637,92 @@ func (UnimplementedQueryServer) HostingOfferAll(context.Context, *QueryAllHostin
 func (UnimplementedQueryServer) ListHostingContractFrom(context.Context, *QueryListHostingContractFromRequest) (*QueryListHostingContractFromResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListHostingContractFrom not implemented")
 }
+func (UnimplementedQueryServer) PaymentHistory(context.Context, *QueryPaymentHistoryRequest) (*QueryPaymentHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentHistory not implemented")
+}
+func (UnimplementedQueryServer) PaymentHistoryAll(context.Context, *QueryAllPaymentHistoryRequest) (*QueryAllPaymentHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentHistoryAll not implemented")
+}
+func (UnimplementedQueryServer) EscrowRecord(context.Context, *QueryEscrowRecordRequest) (*QueryEscrowRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowRecord not implemented")
+}
+func (UnimplementedQueryServer) EscrowRecordAll(context.Context, *QueryAllEscrowRecordRequest) (*QueryAllEscrowRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowRecordAll not implemented")
+}
+func (UnimplementedQueryServer) ProviderStake(context.Context, *QueryProviderStakeRequest) (*QueryProviderStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderStake not implemented")
+}
+func (UnimplementedQueryServer) ProviderStakeAll(context.Context, *QueryAllProviderStakeRequest) (*QueryAllProviderStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderStakeAll not implemented")
+}
+func (UnimplementedQueryServer) StorageChallenge(context.Context, *QueryGetStorageChallengeRequest) (*QueryGetStorageChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageChallenge not implemented")
+}
+func (UnimplementedQueryServer) StorageChallengeAll(context.Context, *QueryAllStorageChallengeRequest) (*QueryAllStorageChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageChallengeAll not implemented")
+}
+func (UnimplementedQueryServer) SlashEvent(context.Context, *QueryGetSlashEventRequest) (*QueryGetSlashEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashEvent not implemented")
+}
+func (UnimplementedQueryServer) SlashEventAll(context.Context, *QueryAllSlashEventRequest) (*QueryAllSlashEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashEventAll not implemented")
+}
+func (UnimplementedQueryServer) ProviderUnbondings(context.Context, *QueryProviderUnbondingsRequest) (*QueryProviderUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderUnbondings not implemented")
+}
+func (UnimplementedQueryServer) OpenInquiries(context.Context, *QueryOpenInquiriesRequest) (*QueryOpenInquiriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenInquiries not implemented")
+}
+func (UnimplementedQueryServer) Auction(context.Context, *QueryGetAuctionRequest) (*QueryGetAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Auction not implemented")
+}
+func (UnimplementedQueryServer) ProviderProfile(context.Context, *QueryGetProviderProfileRequest) (*QueryGetProviderProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderProfile not implemented")
+}
+func (UnimplementedQueryServer) ProviderProfileAll(context.Context, *QueryAllProviderProfileRequest) (*QueryAllProviderProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderProfileAll not implemented")
+}
+func (UnimplementedQueryServer) ProviderReputation(context.Context, *QueryGetProviderReputationRequest) (*QueryGetProviderReputationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderReputation not implemented")
+}
+func (UnimplementedQueryServer) ProviderReputationAll(context.Context, *QueryAllProviderReputationRequest) (*QueryAllProviderReputationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderReputationAll not implemented")
+}
+func (UnimplementedQueryServer) SystemStatistics(context.Context, *QuerySystemStatisticsRequest) (*QuerySystemStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemStatistics not implemented")
+}
+func (UnimplementedQueryServer) ContractDetails(context.Context, *QueryContractDetailsRequest) (*QueryContractDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractDetails not implemented")
+}
+func (UnimplementedQueryServer) ProviderPerformance(context.Context, *QueryProviderPerformanceRequest) (*QueryProviderPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderPerformance not implemented")
+}
+func (UnimplementedQueryServer) PaymentAnalytics(context.Context, *QueryPaymentAnalyticsRequest) (*QueryPaymentAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentAnalytics not implemented")
+}
+func (UnimplementedQueryServer) PaymentDistribution(context.Context, *QueryPaymentDistributionRequest) (*QueryPaymentDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentDistribution not implemented")
+}
+func (UnimplementedQueryServer) ProviderPaymentSummary(context.Context, *QueryProviderPaymentSummaryRequest) (*QueryProviderPaymentSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderPaymentSummary not implemented")
+}
+func (UnimplementedQueryServer) PaymentTrends(context.Context, *QueryPaymentTrendsRequest) (*QueryPaymentTrendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentTrends not implemented")
+}
+func (UnimplementedQueryServer) UnpaidContracts(context.Context, *QueryUnpaidContractsRequest) (*QueryUnpaidContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpaidContracts not implemented")
+}
+func (UnimplementedQueryServer) ProviderPayments(context.Context, *QueryProviderPaymentsRequest) (*QueryProviderPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderPayments not implemented")
+}
+func (UnimplementedQueryServer) ContractProviderPayments(context.Context, *QueryContractProviderPaymentsRequest) (*QueryContractProviderPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractProviderPayments not implemented")
+}
+func (UnimplementedQueryServer) ProviderPaymentsByHeight(context.Context, *QueryProviderPaymentsByHeightRequest) (*QueryProviderPaymentsByHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderPaymentsByHeight not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to QueryServer will
@@ -218,6 +732,13 @@ type UnsafeQueryServer interface {
 }
 
 func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	// If the following call pancis, it indicates UnimplementedQueryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
 	s.RegisterService(&Query_ServiceDesc, srv)
 }
 
@@ -401,52 +922,668 @@ func _Query_ListHostingContractFrom_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Query_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "filespacechain.filespacechain.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "FileEntry",
-			Handler:    _Query_FileEntry_Handler,
-		},
-		{
-			MethodName: "FileEntryAll",
-			Handler:    _Query_FileEntryAll_Handler,
-		},
-		{
-			MethodName: "HostingInquiry",
-			Handler:    _Query_HostingInquiry_Handler,
-		},
-		{
-			MethodName: "HostingInquiryAll",
-			Handler:    _Query_HostingInquiryAll_Handler,
-		},
-		{
-			MethodName: "HostingContract",
-			Handler:    _Query_HostingContract_Handler,
-		},
-		{
-			MethodName: "HostingContractAll",
-			Handler:    _Query_HostingContractAll_Handler,
-		},
-		{
-			MethodName: "HostingOffer",
-			Handler:    _Query_HostingOffer_Handler,
-		},
-		{
-			MethodName: "HostingOfferAll",
-			Handler:    _Query_HostingOfferAll_Handler,
-		},
-		{
-			MethodName: "ListHostingContractFrom",
-			Handler:    _Query_ListHostingContractFrom_Handler,
+func _Query_PaymentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PaymentHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentHistory(ctx, req.(*QueryPaymentHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentHistoryAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPaymentHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentHistoryAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PaymentHistoryAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentHistoryAll(ctx, req.(*QueryAllPaymentHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EscrowRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowRecord(ctx, req.(*QueryEscrowRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowRecordAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllEscrowRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowRecordAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EscrowRecordAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowRecordAll(ctx, req.(*QueryAllEscrowRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProviderStake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderStake(ctx, req.(*QueryProviderStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderStakeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllProviderStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderStakeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProviderStakeAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderStakeAll(ctx, req.(*QueryAllProviderStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetStorageChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StorageChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageChallenge(ctx, req.(*QueryGetStorageChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageChallengeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllStorageChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageChallengeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StorageChallengeAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageChallengeAll(ctx, req.(*QueryAllStorageChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSlashEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SlashEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashEvent(ctx, req.(*QueryGetSlashEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashEventAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSlashEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashEventAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SlashEventAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashEventAll(ctx, req.(*QueryAllSlashEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderUnbondingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProviderUnbondings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderUnbondings(ctx, req.(*QueryProviderUnbondingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OpenInquiries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOpenInquiriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OpenInquiries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OpenInquiries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OpenInquiries(ctx, req.(*QueryOpenInquiriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Auction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Auction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Auction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Auction(ctx, req.(*QueryGetAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProviderProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProviderProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderProfile(ctx, req.(*QueryGetProviderProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderProfileAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllProviderProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderProfileAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProviderProfileAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderProfileAll(ctx, req.(*QueryAllProviderProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderReputation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProviderReputationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderReputation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProviderReputation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderReputation(ctx, req.(*QueryGetProviderReputationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderReputationAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllProviderReputationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderReputationAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProviderReputationAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderReputationAll(ctx, req.(*QueryAllProviderReputationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SystemStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySystemStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SystemStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SystemStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SystemStatistics(ctx, req.(*QuerySystemStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContractDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractDetails(ctx, req.(*QueryContractDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProviderPerformance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderPerformance(ctx, req.(*QueryProviderPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PaymentAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentAnalytics(ctx, req.(*QueryPaymentAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PaymentDistribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentDistribution(ctx, req.(*QueryPaymentDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderPaymentSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderPaymentSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderPaymentSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProviderPaymentSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderPaymentSummary(ctx, req.(*QueryProviderPaymentSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentTrends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentTrendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentTrends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PaymentTrends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentTrends(ctx, req.(*QueryPaymentTrendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnpaidContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnpaidContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnpaidContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnpaidContracts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnpaidContracts(ctx, req.(*QueryUnpaidContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProviderPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderPayments(ctx, req.(*QueryProviderPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractProviderPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractProviderPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractProviderPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContractProviderPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractProviderPayments(ctx, req.(*QueryContractProviderPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderPaymentsByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderPaymentsByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderPaymentsByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProviderPaymentsByHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderPaymentsByHeight(ctx, req.(*QueryProviderPaymentsByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "filespacechain.filespacechain.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "FileEntry",
+			Handler:    _Query_FileEntry_Handler,
+		},
+		{
+			MethodName: "FileEntryAll",
+			Handler:    _Query_FileEntryAll_Handler,
+		},
+		{
+			MethodName: "HostingInquiry",
+			Handler:    _Query_HostingInquiry_Handler,
+		},
+		{
+			MethodName: "HostingInquiryAll",
+			Handler:    _Query_HostingInquiryAll_Handler,
+		},
+		{
+			MethodName: "HostingContract",
+			Handler:    _Query_HostingContract_Handler,
+		},
+		{
+			MethodName: "HostingContractAll",
+			Handler:    _Query_HostingContractAll_Handler,
+		},
+		{
+			MethodName: "HostingOffer",
+			Handler:    _Query_HostingOffer_Handler,
+		},
+		{
+			MethodName: "HostingOfferAll",
+			Handler:    _Query_HostingOfferAll_Handler,
+		},
+		{
+			MethodName: "ListHostingContractFrom",
+			Handler:    _Query_ListHostingContractFrom_Handler,
+		},
+		{
+			MethodName: "PaymentHistory",
+			Handler:    _Query_PaymentHistory_Handler,
+		},
+		{
+			MethodName: "PaymentHistoryAll",
+			Handler:    _Query_PaymentHistoryAll_Handler,
+		},
+		{
+			MethodName: "EscrowRecord",
+			Handler:    _Query_EscrowRecord_Handler,
+		},
+		{
+			MethodName: "EscrowRecordAll",
+			Handler:    _Query_EscrowRecordAll_Handler,
+		},
+		{
+			MethodName: "ProviderStake",
+			Handler:    _Query_ProviderStake_Handler,
+		},
+		{
+			MethodName: "ProviderStakeAll",
+			Handler:    _Query_ProviderStakeAll_Handler,
+		},
+		{
+			MethodName: "StorageChallenge",
+			Handler:    _Query_StorageChallenge_Handler,
+		},
+		{
+			MethodName: "StorageChallengeAll",
+			Handler:    _Query_StorageChallengeAll_Handler,
+		},
+		{
+			MethodName: "SlashEvent",
+			Handler:    _Query_SlashEvent_Handler,
+		},
+		{
+			MethodName: "SlashEventAll",
+			Handler:    _Query_SlashEventAll_Handler,
+		},
+		{
+			MethodName: "ProviderUnbondings",
+			Handler:    _Query_ProviderUnbondings_Handler,
+		},
+		{
+			MethodName: "OpenInquiries",
+			Handler:    _Query_OpenInquiries_Handler,
+		},
+		{
+			MethodName: "Auction",
+			Handler:    _Query_Auction_Handler,
+		},
+		{
+			MethodName: "ProviderProfile",
+			Handler:    _Query_ProviderProfile_Handler,
+		},
+		{
+			MethodName: "ProviderProfileAll",
+			Handler:    _Query_ProviderProfileAll_Handler,
+		},
+		{
+			MethodName: "ProviderReputation",
+			Handler:    _Query_ProviderReputation_Handler,
+		},
+		{
+			MethodName: "ProviderReputationAll",
+			Handler:    _Query_ProviderReputationAll_Handler,
+		},
+		{
+			MethodName: "SystemStatistics",
+			Handler:    _Query_SystemStatistics_Handler,
+		},
+		{
+			MethodName: "ContractDetails",
+			Handler:    _Query_ContractDetails_Handler,
+		},
+		{
+			MethodName: "ProviderPerformance",
+			Handler:    _Query_ProviderPerformance_Handler,
+		},
+		{
+			MethodName: "PaymentAnalytics",
+			Handler:    _Query_PaymentAnalytics_Handler,
+		},
+		{
+			MethodName: "PaymentDistribution",
+			Handler:    _Query_PaymentDistribution_Handler,
+		},
+		{
+			MethodName: "ProviderPaymentSummary",
+			Handler:    _Query_ProviderPaymentSummary_Handler,
+		},
+		{
+			MethodName: "PaymentTrends",
+			Handler:    _Query_PaymentTrends_Handler,
+		},
+		{
+			MethodName: "UnpaidContracts",
+			Handler:    _Query_UnpaidContracts_Handler,
+		},
+		{
+			MethodName: "ProviderPayments",
+			Handler:    _Query_ProviderPayments_Handler,
+		},
+		{
+			MethodName: "ContractProviderPayments",
+			Handler:    _Query_ContractProviderPayments_Handler,
+		},
+		{
+			MethodName: "ProviderPaymentsByHeight",
+			Handler:    _Query_ProviderPaymentsByHeight_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -0,0 +1,1005 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package filespacechain
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_ProviderReputation                    protoreflect.MessageDescriptor
+	fd_ProviderReputation_provider           protoreflect.FieldDescriptor
+	fd_ProviderReputation_score              protoreflect.FieldDescriptor
+	fd_ProviderReputation_lastUpdated        protoreflect.FieldDescriptor
+	fd_ProviderReputation_completedContracts protoreflect.FieldDescriptor
+	fd_ProviderReputation_failedProofs       protoreflect.FieldDescriptor
+	fd_ProviderReputation_slashes            protoreflect.FieldDescriptor
+	fd_ProviderReputation_earlyTerminations  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_filespacechain_filespacechain_reputation_proto_init()
+	md_ProviderReputation = File_filespacechain_filespacechain_reputation_proto.Messages().ByName("ProviderReputation")
+	fd_ProviderReputation_provider = md_ProviderReputation.Fields().ByName("provider")
+	fd_ProviderReputation_score = md_ProviderReputation.Fields().ByName("score")
+	fd_ProviderReputation_lastUpdated = md_ProviderReputation.Fields().ByName("lastUpdated")
+	fd_ProviderReputation_completedContracts = md_ProviderReputation.Fields().ByName("completedContracts")
+	fd_ProviderReputation_failedProofs = md_ProviderReputation.Fields().ByName("failedProofs")
+	fd_ProviderReputation_slashes = md_ProviderReputation.Fields().ByName("slashes")
+	fd_ProviderReputation_earlyTerminations = md_ProviderReputation.Fields().ByName("earlyTerminations")
+}
+
+var _ protoreflect.Message = (*fastReflection_ProviderReputation)(nil)
+
+type fastReflection_ProviderReputation ProviderReputation
+
+func (x *ProviderReputation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProviderReputation)(x)
+}
+
+func (x *ProviderReputation) slowProtoReflect() protoreflect.Message {
+	mi := &file_filespacechain_filespacechain_reputation_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ProviderReputation_messageType fastReflection_ProviderReputation_messageType
+var _ protoreflect.MessageType = fastReflection_ProviderReputation_messageType{}
+
+type fastReflection_ProviderReputation_messageType struct{}
+
+func (x fastReflection_ProviderReputation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProviderReputation)(nil)
+}
+func (x fastReflection_ProviderReputation_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProviderReputation)
+}
+func (x fastReflection_ProviderReputation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProviderReputation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ProviderReputation) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProviderReputation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ProviderReputation) Type() protoreflect.MessageType {
+	return _fastReflection_ProviderReputation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ProviderReputation) New() protoreflect.Message {
+	return new(fastReflection_ProviderReputation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ProviderReputation) Interface() protoreflect.ProtoMessage {
+	return (*ProviderReputation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ProviderReputation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Provider != "" {
+		value := protoreflect.ValueOfString(x.Provider)
+		if !f(fd_ProviderReputation_provider, value) {
+			return
+		}
+	}
+	if x.Score != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Score)
+		if !f(fd_ProviderReputation_score, value) {
+			return
+		}
+	}
+	if x.LastUpdated != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastUpdated)
+		if !f(fd_ProviderReputation_lastUpdated, value) {
+			return
+		}
+	}
+	if x.CompletedContracts != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CompletedContracts)
+		if !f(fd_ProviderReputation_completedContracts, value) {
+			return
+		}
+	}
+	if x.FailedProofs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FailedProofs)
+		if !f(fd_ProviderReputation_failedProofs, value) {
+			return
+		}
+	}
+	if x.Slashes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Slashes)
+		if !f(fd_ProviderReputation_slashes, value) {
+			return
+		}
+	}
+	if x.EarlyTerminations != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EarlyTerminations)
+		if !f(fd_ProviderReputation_earlyTerminations, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ProviderReputation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.ProviderReputation.provider":
+		return x.Provider != ""
+	case "filespacechain.filespacechain.ProviderReputation.score":
+		return x.Score != uint64(0)
+	case "filespacechain.filespacechain.ProviderReputation.lastUpdated":
+		return x.LastUpdated != uint64(0)
+	case "filespacechain.filespacechain.ProviderReputation.completedContracts":
+		return x.CompletedContracts != uint64(0)
+	case "filespacechain.filespacechain.ProviderReputation.failedProofs":
+		return x.FailedProofs != uint64(0)
+	case "filespacechain.filespacechain.ProviderReputation.slashes":
+		return x.Slashes != uint64(0)
+	case "filespacechain.filespacechain.ProviderReputation.earlyTerminations":
+		return x.EarlyTerminations != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.ProviderReputation"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.ProviderReputation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderReputation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.ProviderReputation.provider":
+		x.Provider = ""
+	case "filespacechain.filespacechain.ProviderReputation.score":
+		x.Score = uint64(0)
+	case "filespacechain.filespacechain.ProviderReputation.lastUpdated":
+		x.LastUpdated = uint64(0)
+	case "filespacechain.filespacechain.ProviderReputation.completedContracts":
+		x.CompletedContracts = uint64(0)
+	case "filespacechain.filespacechain.ProviderReputation.failedProofs":
+		x.FailedProofs = uint64(0)
+	case "filespacechain.filespacechain.ProviderReputation.slashes":
+		x.Slashes = uint64(0)
+	case "filespacechain.filespacechain.ProviderReputation.earlyTerminations":
+		x.EarlyTerminations = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.ProviderReputation"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.ProviderReputation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ProviderReputation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "filespacechain.filespacechain.ProviderReputation.provider":
+		value := x.Provider
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.ProviderReputation.score":
+		value := x.Score
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.ProviderReputation.lastUpdated":
+		value := x.LastUpdated
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.ProviderReputation.completedContracts":
+		value := x.CompletedContracts
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.ProviderReputation.failedProofs":
+		value := x.FailedProofs
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.ProviderReputation.slashes":
+		value := x.Slashes
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.ProviderReputation.earlyTerminations":
+		value := x.EarlyTerminations
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.ProviderReputation"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.ProviderReputation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderReputation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.ProviderReputation.provider":
+		x.Provider = value.Interface().(string)
+	case "filespacechain.filespacechain.ProviderReputation.score":
+		x.Score = value.Uint()
+	case "filespacechain.filespacechain.ProviderReputation.lastUpdated":
+		x.LastUpdated = value.Uint()
+	case "filespacechain.filespacechain.ProviderReputation.completedContracts":
+		x.CompletedContracts = value.Uint()
+	case "filespacechain.filespacechain.ProviderReputation.failedProofs":
+		x.FailedProofs = value.Uint()
+	case "filespacechain.filespacechain.ProviderReputation.slashes":
+		x.Slashes = value.Uint()
+	case "filespacechain.filespacechain.ProviderReputation.earlyTerminations":
+		x.EarlyTerminations = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.ProviderReputation"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.ProviderReputation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderReputation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.ProviderReputation.provider":
+		panic(fmt.Errorf("field provider of message filespacechain.filespacechain.ProviderReputation is not mutable"))
+	case "filespacechain.filespacechain.ProviderReputation.score":
+		panic(fmt.Errorf("field score of message filespacechain.filespacechain.ProviderReputation is not mutable"))
+	case "filespacechain.filespacechain.ProviderReputation.lastUpdated":
+		panic(fmt.Errorf("field lastUpdated of message filespacechain.filespacechain.ProviderReputation is not mutable"))
+	case "filespacechain.filespacechain.ProviderReputation.completedContracts":
+		panic(fmt.Errorf("field completedContracts of message filespacechain.filespacechain.ProviderReputation is not mutable"))
+	case "filespacechain.filespacechain.ProviderReputation.failedProofs":
+		panic(fmt.Errorf("field failedProofs of message filespacechain.filespacechain.ProviderReputation is not mutable"))
+	case "filespacechain.filespacechain.ProviderReputation.slashes":
+		panic(fmt.Errorf("field slashes of message filespacechain.filespacechain.ProviderReputation is not mutable"))
+	case "filespacechain.filespacechain.ProviderReputation.earlyTerminations":
+		panic(fmt.Errorf("field earlyTerminations of message filespacechain.filespacechain.ProviderReputation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.ProviderReputation"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.ProviderReputation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ProviderReputation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.ProviderReputation.provider":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.ProviderReputation.score":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.ProviderReputation.lastUpdated":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.ProviderReputation.completedContracts":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.ProviderReputation.failedProofs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.ProviderReputation.slashes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.ProviderReputation.earlyTerminations":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.ProviderReputation"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.ProviderReputation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ProviderReputation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in filespacechain.filespacechain.ProviderReputation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ProviderReputation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderReputation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ProviderReputation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ProviderReputation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ProviderReputation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Score != 0 {
+			n += 1 + runtime.Sov(uint64(x.Score))
+		}
+		if x.LastUpdated != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastUpdated))
+		}
+		if x.CompletedContracts != 0 {
+			n += 1 + runtime.Sov(uint64(x.CompletedContracts))
+		}
+		if x.FailedProofs != 0 {
+			n += 1 + runtime.Sov(uint64(x.FailedProofs))
+		}
+		if x.Slashes != 0 {
+			n += 1 + runtime.Sov(uint64(x.Slashes))
+		}
+		if x.EarlyTerminations != 0 {
+			n += 1 + runtime.Sov(uint64(x.EarlyTerminations))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ProviderReputation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EarlyTerminations != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EarlyTerminations))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Slashes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Slashes))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.FailedProofs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FailedProofs))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.CompletedContracts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CompletedContracts))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.LastUpdated != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdated))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Score != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Score))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ProviderReputation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProviderReputation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProviderReputation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+				}
+				x.Score = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Score |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+				}
+				x.LastUpdated = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastUpdated |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompletedContracts", wireType)
+				}
+				x.CompletedContracts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CompletedContracts |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailedProofs", wireType)
+				}
+				x.FailedProofs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FailedProofs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+				}
+				x.Slashes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Slashes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EarlyTerminations", wireType)
+				}
+				x.EarlyTerminations = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EarlyTerminations |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: filespacechain/filespacechain/reputation.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ReputationEvent is a contract outcome that moves a provider's reputation.
+type ReputationEvent int32
+
+const (
+	ReputationEvent_REPUTATION_EVENT_UNSPECIFIED ReputationEvent = 0
+	// An ACTIVE contract of the provider reached its end block.
+	ReputationEvent_REPUTATION_EVENT_CONTRACT_COMPLETED ReputationEvent = 1
+	// The provider missed a storage challenge or answered it with an invalid proof.
+	ReputationEvent_REPUTATION_EVENT_PROOF_FAILED ReputationEvent = 2
+	// The provider's stake was slashed.
+	ReputationEvent_REPUTATION_EVENT_SLASHED ReputationEvent = 3
+	// The provider rejected a contract or let its acceptance deadline pass.
+	ReputationEvent_REPUTATION_EVENT_EARLY_TERMINATION ReputationEvent = 4
+)
+
+// Enum value maps for ReputationEvent.
+var (
+	ReputationEvent_name = map[int32]string{
+		0: "REPUTATION_EVENT_UNSPECIFIED",
+		1: "REPUTATION_EVENT_CONTRACT_COMPLETED",
+		2: "REPUTATION_EVENT_PROOF_FAILED",
+		3: "REPUTATION_EVENT_SLASHED",
+		4: "REPUTATION_EVENT_EARLY_TERMINATION",
+	}
+	ReputationEvent_value = map[string]int32{
+		"REPUTATION_EVENT_UNSPECIFIED":        0,
+		"REPUTATION_EVENT_CONTRACT_COMPLETED": 1,
+		"REPUTATION_EVENT_PROOF_FAILED":       2,
+		"REPUTATION_EVENT_SLASHED":            3,
+		"REPUTATION_EVENT_EARLY_TERMINATION":  4,
+	}
+)
+
+func (x ReputationEvent) Enum() *ReputationEvent {
+	p := new(ReputationEvent)
+	*p = x
+	return p
+}
+
+func (x ReputationEvent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReputationEvent) Descriptor() protoreflect.EnumDescriptor {
+	return file_filespacechain_filespacechain_reputation_proto_enumTypes[0].Descriptor()
+}
+
+func (ReputationEvent) Type() protoreflect.EnumType {
+	return &file_filespacechain_filespacechain_reputation_proto_enumTypes[0]
+}
+
+func (x ReputationEvent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReputationEvent.Descriptor instead.
+func (ReputationEvent) EnumDescriptor() ([]byte, []int) {
+	return file_filespacechain_filespacechain_reputation_proto_rawDescGZIP(), []int{0}
+}
+
+// ProviderReputation is the reputation of a provider built from the outcomes
+// of its contracts. The score is in basis points, from 0 to 10000; it starts
+// at 5000 and decays back towards it while nothing happens.
+type ProviderReputation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Score as of lastUpdated
+	Score              uint64 `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	LastUpdated        uint64 `protobuf:"varint,3,opt,name=lastUpdated,proto3" json:"lastUpdated,omitempty"`
+	CompletedContracts uint64 `protobuf:"varint,4,opt,name=completedContracts,proto3" json:"completedContracts,omitempty"`
+	FailedProofs       uint64 `protobuf:"varint,5,opt,name=failedProofs,proto3" json:"failedProofs,omitempty"`
+	Slashes            uint64 `protobuf:"varint,6,opt,name=slashes,proto3" json:"slashes,omitempty"`
+	EarlyTerminations  uint64 `protobuf:"varint,7,opt,name=earlyTerminations,proto3" json:"earlyTerminations,omitempty"`
+}
+
+func (x *ProviderReputation) Reset() {
+	*x = ProviderReputation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_filespacechain_filespacechain_reputation_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderReputation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderReputation) ProtoMessage() {}
+
+// Deprecated: Use ProviderReputation.ProtoReflect.Descriptor instead.
+func (*ProviderReputation) Descriptor() ([]byte, []int) {
+	return file_filespacechain_filespacechain_reputation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProviderReputation) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ProviderReputation) GetScore() uint64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ProviderReputation) GetLastUpdated() uint64 {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return 0
+}
+
+func (x *ProviderReputation) GetCompletedContracts() uint64 {
+	if x != nil {
+		return x.CompletedContracts
+	}
+	return 0
+}
+
+func (x *ProviderReputation) GetFailedProofs() uint64 {
+	if x != nil {
+		return x.FailedProofs
+	}
+	return 0
+}
+
+func (x *ProviderReputation) GetSlashes() uint64 {
+	if x != nil {
+		return x.Slashes
+	}
+	return 0
+}
+
+func (x *ProviderReputation) GetEarlyTerminations() uint64 {
+	if x != nil {
+		return x.EarlyTerminations
+	}
+	return 0
+}
+
+var File_filespacechain_filespacechain_reputation_proto protoreflect.FileDescriptor
+
+var file_filespacechain_filespacechain_reputation_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x1d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x1a,
+	0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x84, 0x02, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x2e, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73,
+	0x12, 0x22, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x2c,
+	0x0a, 0x11, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x65, 0x61, 0x72, 0x6c, 0x79,
+	0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2a, 0xcb, 0x01, 0x0a,
+	0x0f, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x50, 0x55, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x52, 0x45, 0x50, 0x55, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f,
+	0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x52,
+	0x45, 0x50, 0x55, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1c,
+	0x0a, 0x18, 0x52, 0x45, 0x50, 0x55, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x53, 0x4c, 0x41, 0x53, 0x48, 0x45, 0x44, 0x10, 0x03, 0x12, 0x26, 0x0a, 0x22,
+	0x52, 0x45, 0x50, 0x55, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x45, 0x41, 0x52, 0x4c, 0x59, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x04, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xf9, 0x01, 0x0a, 0x21, 0x63,
+	0x6f, 0x6d, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x42, 0x0f, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0xa2, 0x02, 0x03, 0x46, 0x46, 0x58, 0xaa, 0x02, 0x1d, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xca, 0x02, 0x1d, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xe2, 0x02, 0x29, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_filespacechain_filespacechain_reputation_proto_rawDescOnce sync.Once
+	file_filespacechain_filespacechain_reputation_proto_rawDescData = file_filespacechain_filespacechain_reputation_proto_rawDesc
+)
+
+func file_filespacechain_filespacechain_reputation_proto_rawDescGZIP() []byte {
+	file_filespacechain_filespacechain_reputation_proto_rawDescOnce.Do(func() {
+		file_filespacechain_filespacechain_reputation_proto_rawDescData = protoimpl.X.CompressGZIP(file_filespacechain_filespacechain_reputation_proto_rawDescData)
+	})
+	return file_filespacechain_filespacechain_reputation_proto_rawDescData
+}
+
+var file_filespacechain_filespacechain_reputation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_filespacechain_filespacechain_reputation_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_filespacechain_filespacechain_reputation_proto_goTypes = []interface{}{
+	(ReputationEvent)(0),       // 0: filespacechain.filespacechain.ReputationEvent
+	(*ProviderReputation)(nil), // 1: filespacechain.filespacechain.ProviderReputation
+}
+var file_filespacechain_filespacechain_reputation_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_filespacechain_filespacechain_reputation_proto_init() }
+func file_filespacechain_filespacechain_reputation_proto_init() {
+	if File_filespacechain_filespacechain_reputation_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_filespacechain_filespacechain_reputation_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderReputation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_filespacechain_filespacechain_reputation_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_filespacechain_filespacechain_reputation_proto_goTypes,
+		DependencyIndexes: file_filespacechain_filespacechain_reputation_proto_depIdxs,
+		EnumInfos:         file_filespacechain_filespacechain_reputation_proto_enumTypes,
+		MessageInfos:      file_filespacechain_filespacechain_reputation_proto_msgTypes,
+	}.Build()
+	File_filespacechain_filespacechain_reputation_proto = out.File
+	file_filespacechain_filespacechain_reputation_proto_rawDesc = nil
+	file_filespacechain_filespacechain_reputation_proto_goTypes = nil
+	file_filespacechain_filespacechain_reputation_proto_depIdxs = nil
+}
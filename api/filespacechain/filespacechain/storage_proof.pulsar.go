@@ -0,0 +1,1925 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package filespacechain
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_StorageChallenge_5_list)(nil)
+
+type _StorageChallenge_5_list struct {
+	list *[]uint64
+}
+
+func (x *_StorageChallenge_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StorageChallenge_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_StorageChallenge_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StorageChallenge_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StorageChallenge_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message StorageChallenge at list field ChunkIndices as it is not of Message kind"))
+}
+
+func (x *_StorageChallenge_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StorageChallenge_5_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_StorageChallenge_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_StorageChallenge               protoreflect.MessageDescriptor
+	fd_StorageChallenge_id            protoreflect.FieldDescriptor
+	fd_StorageChallenge_contractId    protoreflect.FieldDescriptor
+	fd_StorageChallenge_provider      protoreflect.FieldDescriptor
+	fd_StorageChallenge_fileEntryCid  protoreflect.FieldDescriptor
+	fd_StorageChallenge_chunkIndices  protoreflect.FieldDescriptor
+	fd_StorageChallenge_issuedBlock   protoreflect.FieldDescriptor
+	fd_StorageChallenge_deadlineBlock protoreflect.FieldDescriptor
+	fd_StorageChallenge_status        protoreflect.FieldDescriptor
+	fd_StorageChallenge_fileEntryId   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_filespacechain_filespacechain_storage_proof_proto_init()
+	md_StorageChallenge = File_filespacechain_filespacechain_storage_proof_proto.Messages().ByName("StorageChallenge")
+	fd_StorageChallenge_id = md_StorageChallenge.Fields().ByName("id")
+	fd_StorageChallenge_contractId = md_StorageChallenge.Fields().ByName("contractId")
+	fd_StorageChallenge_provider = md_StorageChallenge.Fields().ByName("provider")
+	fd_StorageChallenge_fileEntryCid = md_StorageChallenge.Fields().ByName("fileEntryCid")
+	fd_StorageChallenge_chunkIndices = md_StorageChallenge.Fields().ByName("chunkIndices")
+	fd_StorageChallenge_issuedBlock = md_StorageChallenge.Fields().ByName("issuedBlock")
+	fd_StorageChallenge_deadlineBlock = md_StorageChallenge.Fields().ByName("deadlineBlock")
+	fd_StorageChallenge_status = md_StorageChallenge.Fields().ByName("status")
+	fd_StorageChallenge_fileEntryId = md_StorageChallenge.Fields().ByName("fileEntryId")
+}
+
+var _ protoreflect.Message = (*fastReflection_StorageChallenge)(nil)
+
+type fastReflection_StorageChallenge StorageChallenge
+
+func (x *StorageChallenge) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StorageChallenge)(x)
+}
+
+func (x *StorageChallenge) slowProtoReflect() protoreflect.Message {
+	mi := &file_filespacechain_filespacechain_storage_proof_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_StorageChallenge_messageType fastReflection_StorageChallenge_messageType
+var _ protoreflect.MessageType = fastReflection_StorageChallenge_messageType{}
+
+type fastReflection_StorageChallenge_messageType struct{}
+
+func (x fastReflection_StorageChallenge_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StorageChallenge)(nil)
+}
+func (x fastReflection_StorageChallenge_messageType) New() protoreflect.Message {
+	return new(fastReflection_StorageChallenge)
+}
+func (x fastReflection_StorageChallenge_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StorageChallenge
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_StorageChallenge) Descriptor() protoreflect.MessageDescriptor {
+	return md_StorageChallenge
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_StorageChallenge) Type() protoreflect.MessageType {
+	return _fastReflection_StorageChallenge_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_StorageChallenge) New() protoreflect.Message {
+	return new(fastReflection_StorageChallenge)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_StorageChallenge) Interface() protoreflect.ProtoMessage {
+	return (*StorageChallenge)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_StorageChallenge) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_StorageChallenge_id, value) {
+			return
+		}
+	}
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_StorageChallenge_contractId, value) {
+			return
+		}
+	}
+	if x.Provider != "" {
+		value := protoreflect.ValueOfString(x.Provider)
+		if !f(fd_StorageChallenge_provider, value) {
+			return
+		}
+	}
+	if x.FileEntryCid != "" {
+		value := protoreflect.ValueOfString(x.FileEntryCid)
+		if !f(fd_StorageChallenge_fileEntryCid, value) {
+			return
+		}
+	}
+	if len(x.ChunkIndices) != 0 {
+		value := protoreflect.ValueOfList(&_StorageChallenge_5_list{list: &x.ChunkIndices})
+		if !f(fd_StorageChallenge_chunkIndices, value) {
+			return
+		}
+	}
+	if x.IssuedBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IssuedBlock)
+		if !f(fd_StorageChallenge_issuedBlock, value) {
+			return
+		}
+	}
+	if x.DeadlineBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DeadlineBlock)
+		if !f(fd_StorageChallenge_deadlineBlock, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_StorageChallenge_status, value) {
+			return
+		}
+	}
+	if x.FileEntryId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FileEntryId)
+		if !f(fd_StorageChallenge_fileEntryId, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_StorageChallenge) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.StorageChallenge.id":
+		return x.Id != uint64(0)
+	case "filespacechain.filespacechain.StorageChallenge.contractId":
+		return x.ContractId != uint64(0)
+	case "filespacechain.filespacechain.StorageChallenge.provider":
+		return x.Provider != ""
+	case "filespacechain.filespacechain.StorageChallenge.fileEntryCid":
+		return x.FileEntryCid != ""
+	case "filespacechain.filespacechain.StorageChallenge.chunkIndices":
+		return len(x.ChunkIndices) != 0
+	case "filespacechain.filespacechain.StorageChallenge.issuedBlock":
+		return x.IssuedBlock != uint64(0)
+	case "filespacechain.filespacechain.StorageChallenge.deadlineBlock":
+		return x.DeadlineBlock != uint64(0)
+	case "filespacechain.filespacechain.StorageChallenge.status":
+		return x.Status != 0
+	case "filespacechain.filespacechain.StorageChallenge.fileEntryId":
+		return x.FileEntryId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.StorageChallenge"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.StorageChallenge does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StorageChallenge) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.StorageChallenge.id":
+		x.Id = uint64(0)
+	case "filespacechain.filespacechain.StorageChallenge.contractId":
+		x.ContractId = uint64(0)
+	case "filespacechain.filespacechain.StorageChallenge.provider":
+		x.Provider = ""
+	case "filespacechain.filespacechain.StorageChallenge.fileEntryCid":
+		x.FileEntryCid = ""
+	case "filespacechain.filespacechain.StorageChallenge.chunkIndices":
+		x.ChunkIndices = nil
+	case "filespacechain.filespacechain.StorageChallenge.issuedBlock":
+		x.IssuedBlock = uint64(0)
+	case "filespacechain.filespacechain.StorageChallenge.deadlineBlock":
+		x.DeadlineBlock = uint64(0)
+	case "filespacechain.filespacechain.StorageChallenge.status":
+		x.Status = 0
+	case "filespacechain.filespacechain.StorageChallenge.fileEntryId":
+		x.FileEntryId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.StorageChallenge"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.StorageChallenge does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_StorageChallenge) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "filespacechain.filespacechain.StorageChallenge.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.StorageChallenge.contractId":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.StorageChallenge.provider":
+		value := x.Provider
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.StorageChallenge.fileEntryCid":
+		value := x.FileEntryCid
+		return protoreflect.ValueOfString(value)
+	case "filespacechain.filespacechain.StorageChallenge.chunkIndices":
+		if len(x.ChunkIndices) == 0 {
+			return protoreflect.ValueOfList(&_StorageChallenge_5_list{})
+		}
+		listValue := &_StorageChallenge_5_list{list: &x.ChunkIndices}
+		return protoreflect.ValueOfList(listValue)
+	case "filespacechain.filespacechain.StorageChallenge.issuedBlock":
+		value := x.IssuedBlock
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.StorageChallenge.deadlineBlock":
+		value := x.DeadlineBlock
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.StorageChallenge.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "filespacechain.filespacechain.StorageChallenge.fileEntryId":
+		value := x.FileEntryId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.StorageChallenge"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.StorageChallenge does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StorageChallenge) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.StorageChallenge.id":
+		x.Id = value.Uint()
+	case "filespacechain.filespacechain.StorageChallenge.contractId":
+		x.ContractId = value.Uint()
+	case "filespacechain.filespacechain.StorageChallenge.provider":
+		x.Provider = value.Interface().(string)
+	case "filespacechain.filespacechain.StorageChallenge.fileEntryCid":
+		x.FileEntryCid = value.Interface().(string)
+	case "filespacechain.filespacechain.StorageChallenge.chunkIndices":
+		lv := value.List()
+		clv := lv.(*_StorageChallenge_5_list)
+		x.ChunkIndices = *clv.list
+	case "filespacechain.filespacechain.StorageChallenge.issuedBlock":
+		x.IssuedBlock = value.Uint()
+	case "filespacechain.filespacechain.StorageChallenge.deadlineBlock":
+		x.DeadlineBlock = value.Uint()
+	case "filespacechain.filespacechain.StorageChallenge.status":
+		x.Status = (ChallengeStatus)(value.Enum())
+	case "filespacechain.filespacechain.StorageChallenge.fileEntryId":
+		x.FileEntryId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.StorageChallenge"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.StorageChallenge does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StorageChallenge) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.StorageChallenge.chunkIndices":
+		if x.ChunkIndices == nil {
+			x.ChunkIndices = []uint64{}
+		}
+		value := &_StorageChallenge_5_list{list: &x.ChunkIndices}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.StorageChallenge.id":
+		panic(fmt.Errorf("field id of message filespacechain.filespacechain.StorageChallenge is not mutable"))
+	case "filespacechain.filespacechain.StorageChallenge.contractId":
+		panic(fmt.Errorf("field contractId of message filespacechain.filespacechain.StorageChallenge is not mutable"))
+	case "filespacechain.filespacechain.StorageChallenge.provider":
+		panic(fmt.Errorf("field provider of message filespacechain.filespacechain.StorageChallenge is not mutable"))
+	case "filespacechain.filespacechain.StorageChallenge.fileEntryCid":
+		panic(fmt.Errorf("field fileEntryCid of message filespacechain.filespacechain.StorageChallenge is not mutable"))
+	case "filespacechain.filespacechain.StorageChallenge.issuedBlock":
+		panic(fmt.Errorf("field issuedBlock of message filespacechain.filespacechain.StorageChallenge is not mutable"))
+	case "filespacechain.filespacechain.StorageChallenge.deadlineBlock":
+		panic(fmt.Errorf("field deadlineBlock of message filespacechain.filespacechain.StorageChallenge is not mutable"))
+	case "filespacechain.filespacechain.StorageChallenge.status":
+		panic(fmt.Errorf("field status of message filespacechain.filespacechain.StorageChallenge is not mutable"))
+	case "filespacechain.filespacechain.StorageChallenge.fileEntryId":
+		panic(fmt.Errorf("field fileEntryId of message filespacechain.filespacechain.StorageChallenge is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.StorageChallenge"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.StorageChallenge does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_StorageChallenge) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.StorageChallenge.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.StorageChallenge.contractId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.StorageChallenge.provider":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.StorageChallenge.fileEntryCid":
+		return protoreflect.ValueOfString("")
+	case "filespacechain.filespacechain.StorageChallenge.chunkIndices":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_StorageChallenge_5_list{list: &list})
+	case "filespacechain.filespacechain.StorageChallenge.issuedBlock":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.StorageChallenge.deadlineBlock":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.StorageChallenge.status":
+		return protoreflect.ValueOfEnum(0)
+	case "filespacechain.filespacechain.StorageChallenge.fileEntryId":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.StorageChallenge"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.StorageChallenge does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StorageChallenge) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in filespacechain.filespacechain.StorageChallenge", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StorageChallenge) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StorageChallenge) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StorageChallenge) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StorageChallenge) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StorageChallenge)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FileEntryCid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ChunkIndices) > 0 {
+			l = 0
+			for _, e := range x.ChunkIndices {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.IssuedBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.IssuedBlock))
+		}
+		if x.DeadlineBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.DeadlineBlock))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.FileEntryId != 0 {
+			n += 1 + runtime.Sov(uint64(x.FileEntryId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StorageChallenge)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.FileEntryId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FileEntryId))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.DeadlineBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DeadlineBlock))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.IssuedBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IssuedBlock))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.ChunkIndices) > 0 {
+			var pksize2 int
+			for _, num := range x.ChunkIndices {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.ChunkIndices {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.FileEntryCid) > 0 {
+			i -= len(x.FileEntryCid)
+			copy(dAtA[i:], x.FileEntryCid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FileEntryCid)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StorageChallenge)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StorageChallenge: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StorageChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				}
+				x.ContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FileEntryCid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FileEntryCid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.ChunkIndices = append(x.ChunkIndices, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.ChunkIndices) == 0 {
+						x.ChunkIndices = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.ChunkIndices = append(x.ChunkIndices, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChunkIndices", wireType)
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuedBlock", wireType)
+				}
+				x.IssuedBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IssuedBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeadlineBlock", wireType)
+				}
+				x.DeadlineBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DeadlineBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= ChallengeStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FileEntryId", wireType)
+				}
+				x.FileEntryId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FileEntryId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ChunkProof_3_list)(nil)
+
+type _ChunkProof_3_list struct {
+	list *[][]byte
+}
+
+func (x *_ChunkProof_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ChunkProof_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_ChunkProof_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ChunkProof_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ChunkProof_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ChunkProof at list field Siblings as it is not of Message kind"))
+}
+
+func (x *_ChunkProof_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ChunkProof_3_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_ChunkProof_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ChunkProof            protoreflect.MessageDescriptor
+	fd_ChunkProof_chunkIndex protoreflect.FieldDescriptor
+	fd_ChunkProof_chunk      protoreflect.FieldDescriptor
+	fd_ChunkProof_siblings   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_filespacechain_filespacechain_storage_proof_proto_init()
+	md_ChunkProof = File_filespacechain_filespacechain_storage_proof_proto.Messages().ByName("ChunkProof")
+	fd_ChunkProof_chunkIndex = md_ChunkProof.Fields().ByName("chunkIndex")
+	fd_ChunkProof_chunk = md_ChunkProof.Fields().ByName("chunk")
+	fd_ChunkProof_siblings = md_ChunkProof.Fields().ByName("siblings")
+}
+
+var _ protoreflect.Message = (*fastReflection_ChunkProof)(nil)
+
+type fastReflection_ChunkProof ChunkProof
+
+func (x *ChunkProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ChunkProof)(x)
+}
+
+func (x *ChunkProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_filespacechain_filespacechain_storage_proof_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ChunkProof_messageType fastReflection_ChunkProof_messageType
+var _ protoreflect.MessageType = fastReflection_ChunkProof_messageType{}
+
+type fastReflection_ChunkProof_messageType struct{}
+
+func (x fastReflection_ChunkProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ChunkProof)(nil)
+}
+func (x fastReflection_ChunkProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_ChunkProof)
+}
+func (x fastReflection_ChunkProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ChunkProof
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ChunkProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_ChunkProof
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ChunkProof) Type() protoreflect.MessageType {
+	return _fastReflection_ChunkProof_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ChunkProof) New() protoreflect.Message {
+	return new(fastReflection_ChunkProof)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ChunkProof) Interface() protoreflect.ProtoMessage {
+	return (*ChunkProof)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ChunkProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChunkIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ChunkIndex)
+		if !f(fd_ChunkProof_chunkIndex, value) {
+			return
+		}
+	}
+	if len(x.Chunk) != 0 {
+		value := protoreflect.ValueOfBytes(x.Chunk)
+		if !f(fd_ChunkProof_chunk, value) {
+			return
+		}
+	}
+	if len(x.Siblings) != 0 {
+		value := protoreflect.ValueOfList(&_ChunkProof_3_list{list: &x.Siblings})
+		if !f(fd_ChunkProof_siblings, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ChunkProof) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.ChunkProof.chunkIndex":
+		return x.ChunkIndex != uint64(0)
+	case "filespacechain.filespacechain.ChunkProof.chunk":
+		return len(x.Chunk) != 0
+	case "filespacechain.filespacechain.ChunkProof.siblings":
+		return len(x.Siblings) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.ChunkProof"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.ChunkProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChunkProof) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.ChunkProof.chunkIndex":
+		x.ChunkIndex = uint64(0)
+	case "filespacechain.filespacechain.ChunkProof.chunk":
+		x.Chunk = nil
+	case "filespacechain.filespacechain.ChunkProof.siblings":
+		x.Siblings = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.ChunkProof"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.ChunkProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ChunkProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "filespacechain.filespacechain.ChunkProof.chunkIndex":
+		value := x.ChunkIndex
+		return protoreflect.ValueOfUint64(value)
+	case "filespacechain.filespacechain.ChunkProof.chunk":
+		value := x.Chunk
+		return protoreflect.ValueOfBytes(value)
+	case "filespacechain.filespacechain.ChunkProof.siblings":
+		if len(x.Siblings) == 0 {
+			return protoreflect.ValueOfList(&_ChunkProof_3_list{})
+		}
+		listValue := &_ChunkProof_3_list{list: &x.Siblings}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.ChunkProof"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.ChunkProof does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChunkProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.ChunkProof.chunkIndex":
+		x.ChunkIndex = value.Uint()
+	case "filespacechain.filespacechain.ChunkProof.chunk":
+		x.Chunk = value.Bytes()
+	case "filespacechain.filespacechain.ChunkProof.siblings":
+		lv := value.List()
+		clv := lv.(*_ChunkProof_3_list)
+		x.Siblings = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.ChunkProof"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.ChunkProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChunkProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.ChunkProof.siblings":
+		if x.Siblings == nil {
+			x.Siblings = [][]byte{}
+		}
+		value := &_ChunkProof_3_list{list: &x.Siblings}
+		return protoreflect.ValueOfList(value)
+	case "filespacechain.filespacechain.ChunkProof.chunkIndex":
+		panic(fmt.Errorf("field chunkIndex of message filespacechain.filespacechain.ChunkProof is not mutable"))
+	case "filespacechain.filespacechain.ChunkProof.chunk":
+		panic(fmt.Errorf("field chunk of message filespacechain.filespacechain.ChunkProof is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.ChunkProof"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.ChunkProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ChunkProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "filespacechain.filespacechain.ChunkProof.chunkIndex":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "filespacechain.filespacechain.ChunkProof.chunk":
+		return protoreflect.ValueOfBytes(nil)
+	case "filespacechain.filespacechain.ChunkProof.siblings":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_ChunkProof_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: filespacechain.filespacechain.ChunkProof"))
+		}
+		panic(fmt.Errorf("message filespacechain.filespacechain.ChunkProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ChunkProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in filespacechain.filespacechain.ChunkProof", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ChunkProof) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChunkProof) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ChunkProof) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ChunkProof) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ChunkProof)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ChunkIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChunkIndex))
+		}
+		l = len(x.Chunk)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Siblings) > 0 {
+			for _, b := range x.Siblings {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ChunkProof)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Siblings) > 0 {
+			for iNdEx := len(x.Siblings) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Siblings[iNdEx])
+				copy(dAtA[i:], x.Siblings[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Siblings[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Chunk) > 0 {
+			i -= len(x.Chunk)
+			copy(dAtA[i:], x.Chunk)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Chunk)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ChunkIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChunkIndex))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ChunkProof)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChunkProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChunkProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChunkIndex", wireType)
+				}
+				x.ChunkIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChunkIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Chunk = append(x.Chunk[:0], dAtA[iNdEx:postIndex]...)
+				if x.Chunk == nil {
+					x.Chunk = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Siblings", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Siblings = append(x.Siblings, make([]byte, postIndex-iNdEx))
+				copy(x.Siblings[len(x.Siblings)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: filespacechain/filespacechain/storage_proof.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ChallengeStatus int32
+
+const (
+	ChallengeStatus_CHALLENGE_STATUS_PENDING ChallengeStatus = 0
+	ChallengeStatus_CHALLENGE_STATUS_PASSED  ChallengeStatus = 1
+	ChallengeStatus_CHALLENGE_STATUS_FAILED  ChallengeStatus = 2
+	ChallengeStatus_CHALLENGE_STATUS_MISSED  ChallengeStatus = 3
+)
+
+// Enum value maps for ChallengeStatus.
+var (
+	ChallengeStatus_name = map[int32]string{
+		0: "CHALLENGE_STATUS_PENDING",
+		1: "CHALLENGE_STATUS_PASSED",
+		2: "CHALLENGE_STATUS_FAILED",
+		3: "CHALLENGE_STATUS_MISSED",
+	}
+	ChallengeStatus_value = map[string]int32{
+		"CHALLENGE_STATUS_PENDING": 0,
+		"CHALLENGE_STATUS_PASSED":  1,
+		"CHALLENGE_STATUS_FAILED":  2,
+		"CHALLENGE_STATUS_MISSED":  3,
+	}
+)
+
+func (x ChallengeStatus) Enum() *ChallengeStatus {
+	p := new(ChallengeStatus)
+	*p = x
+	return p
+}
+
+func (x ChallengeStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChallengeStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_filespacechain_filespacechain_storage_proof_proto_enumTypes[0].Descriptor()
+}
+
+func (ChallengeStatus) Type() protoreflect.EnumType {
+	return &file_filespacechain_filespacechain_storage_proof_proto_enumTypes[0]
+}
+
+func (x ChallengeStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChallengeStatus.Descriptor instead.
+func (ChallengeStatus) EnumDescriptor() ([]byte, []int) {
+	return file_filespacechain_filespacechain_storage_proof_proto_rawDescGZIP(), []int{0}
+}
+
+// StorageChallenge asks the provider of a hosting contract to prove that it
+// still holds the sampled chunks of the contract's file.
+type StorageChallenge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContractId    uint64          `protobuf:"varint,2,opt,name=contractId,proto3" json:"contractId,omitempty"`
+	Provider      string          `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	FileEntryCid  string          `protobuf:"bytes,4,opt,name=fileEntryCid,proto3" json:"fileEntryCid,omitempty"`
+	ChunkIndices  []uint64        `protobuf:"varint,5,rep,packed,name=chunkIndices,proto3" json:"chunkIndices,omitempty"`
+	IssuedBlock   uint64          `protobuf:"varint,6,opt,name=issuedBlock,proto3" json:"issuedBlock,omitempty"`
+	DeadlineBlock uint64          `protobuf:"varint,7,opt,name=deadlineBlock,proto3" json:"deadlineBlock,omitempty"`
+	Status        ChallengeStatus `protobuf:"varint,8,opt,name=status,proto3,enum=filespacechain.filespacechain.ChallengeStatus" json:"status,omitempty"`
+	// File entry the proofs are checked against
+	FileEntryId uint64 `protobuf:"varint,9,opt,name=fileEntryId,proto3" json:"fileEntryId,omitempty"`
+}
+
+func (x *StorageChallenge) Reset() {
+	*x = StorageChallenge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_filespacechain_filespacechain_storage_proof_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageChallenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageChallenge) ProtoMessage() {}
+
+// Deprecated: Use StorageChallenge.ProtoReflect.Descriptor instead.
+func (*StorageChallenge) Descriptor() ([]byte, []int) {
+	return file_filespacechain_filespacechain_storage_proof_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StorageChallenge) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StorageChallenge) GetContractId() uint64 {
+	if x != nil {
+		return x.ContractId
+	}
+	return 0
+}
+
+func (x *StorageChallenge) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *StorageChallenge) GetFileEntryCid() string {
+	if x != nil {
+		return x.FileEntryCid
+	}
+	return ""
+}
+
+func (x *StorageChallenge) GetChunkIndices() []uint64 {
+	if x != nil {
+		return x.ChunkIndices
+	}
+	return nil
+}
+
+func (x *StorageChallenge) GetIssuedBlock() uint64 {
+	if x != nil {
+		return x.IssuedBlock
+	}
+	return 0
+}
+
+func (x *StorageChallenge) GetDeadlineBlock() uint64 {
+	if x != nil {
+		return x.DeadlineBlock
+	}
+	return 0
+}
+
+func (x *StorageChallenge) GetStatus() ChallengeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ChallengeStatus_CHALLENGE_STATUS_PENDING
+}
+
+func (x *StorageChallenge) GetFileEntryId() uint64 {
+	if x != nil {
+		return x.FileEntryId
+	}
+	return 0
+}
+
+// ChunkProof carries a challenged chunk together with the Merkle siblings
+// needed to recompute the file entry's merkleRoot, ordered from leaf to root.
+type ChunkProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChunkIndex uint64   `protobuf:"varint,1,opt,name=chunkIndex,proto3" json:"chunkIndex,omitempty"`
+	Chunk      []byte   `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Siblings   [][]byte `protobuf:"bytes,3,rep,name=siblings,proto3" json:"siblings,omitempty"`
+}
+
+func (x *ChunkProof) Reset() {
+	*x = ChunkProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_filespacechain_filespacechain_storage_proof_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChunkProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkProof) ProtoMessage() {}
+
+// Deprecated: Use ChunkProof.ProtoReflect.Descriptor instead.
+func (*ChunkProof) Descriptor() ([]byte, []int) {
+	return file_filespacechain_filespacechain_storage_proof_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChunkProof) GetChunkIndex() uint64 {
+	if x != nil {
+		return x.ChunkIndex
+	}
+	return 0
+}
+
+func (x *ChunkProof) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *ChunkProof) GetSiblings() [][]byte {
+	if x != nil {
+		return x.Siblings
+	}
+	return nil
+}
+
+var File_filespacechain_filespacechain_storage_proof_proto protoreflect.FileDescriptor
+
+var file_filespacechain_filespacechain_storage_proof_proto_rawDesc = []byte{
+	0x0a, 0x31, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd8, 0x02, 0x0a, 0x10, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x69, 0x6c,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x69, 0x64, 0x12, 0x22, 0x0a,
+	0x0c, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x0c, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x64, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x46, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x49, 0x64, 0x22, 0x5e, 0x0a, 0x0a, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x62, 0x6c, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x69, 0x62, 0x6c, 0x69,
+	0x6e, 0x67, 0x73, 0x2a, 0x8c, 0x01, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x48, 0x41, 0x4c, 0x4c,
+	0x45, 0x4e, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x48, 0x41, 0x4c, 0x4c, 0x45, 0x4e,
+	0x47, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x48, 0x41, 0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x1b, 0x0a, 0x17, 0x43, 0x48, 0x41, 0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3,
+	0x1e, 0x00, 0x42, 0xfb, 0x01, 0x0a, 0x21, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x11, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xa2, 0x02, 0x03,
+	0x46, 0x46, 0x58, 0xaa, 0x02, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0xca, 0x02, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0xe2, 0x02, 0x29, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x1e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x3a, 0x3a, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_filespacechain_filespacechain_storage_proof_proto_rawDescOnce sync.Once
+	file_filespacechain_filespacechain_storage_proof_proto_rawDescData = file_filespacechain_filespacechain_storage_proof_proto_rawDesc
+)
+
+func file_filespacechain_filespacechain_storage_proof_proto_rawDescGZIP() []byte {
+	file_filespacechain_filespacechain_storage_proof_proto_rawDescOnce.Do(func() {
+		file_filespacechain_filespacechain_storage_proof_proto_rawDescData = protoimpl.X.CompressGZIP(file_filespacechain_filespacechain_storage_proof_proto_rawDescData)
+	})
+	return file_filespacechain_filespacechain_storage_proof_proto_rawDescData
+}
+
+var file_filespacechain_filespacechain_storage_proof_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_filespacechain_filespacechain_storage_proof_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_filespacechain_filespacechain_storage_proof_proto_goTypes = []interface{}{
+	(ChallengeStatus)(0),     // 0: filespacechain.filespacechain.ChallengeStatus
+	(*StorageChallenge)(nil), // 1: filespacechain.filespacechain.StorageChallenge
+	(*ChunkProof)(nil),       // 2: filespacechain.filespacechain.ChunkProof
+}
+var file_filespacechain_filespacechain_storage_proof_proto_depIdxs = []int32{
+	0, // 0: filespacechain.filespacechain.StorageChallenge.status:type_name -> filespacechain.filespacechain.ChallengeStatus
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_filespacechain_filespacechain_storage_proof_proto_init() }
+func file_filespacechain_filespacechain_storage_proof_proto_init() {
+	if File_filespacechain_filespacechain_storage_proof_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_filespacechain_filespacechain_storage_proof_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageChallenge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_filespacechain_filespacechain_storage_proof_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChunkProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_filespacechain_filespacechain_storage_proof_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_filespacechain_filespacechain_storage_proof_proto_goTypes,
+		DependencyIndexes: file_filespacechain_filespacechain_storage_proof_proto_depIdxs,
+		EnumInfos:         file_filespacechain_filespacechain_storage_proof_proto_enumTypes,
+		MessageInfos:      file_filespacechain_filespacechain_storage_proof_proto_msgTypes,
+	}.Build()
+	File_filespacechain_filespacechain_storage_proof_proto = out.File
+	file_filespacechain_filespacechain_storage_proof_proto_rawDesc = nil
+	file_filespacechain_filespacechain_storage_proof_proto_goTypes = nil
+	file_filespacechain_filespacechain_storage_proof_proto_depIdxs = nil
+}
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.3.0
+// - protoc-gen-go-grpc v1.5.1
 // - protoc             (unknown)
 // source: filespacechain/filespacechain/tx.proto
 
@@ -15,28 +15,43 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.32.0 or later.
-const _ = grpc.SupportPackageIsVersion7
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_UpdateParams_FullMethodName          = "/filespacechain.filespacechain.Msg/UpdateParams"
-	Msg_CreateFileEntry_FullMethodName       = "/filespacechain.filespacechain.Msg/CreateFileEntry"
-	Msg_UpdateFileEntry_FullMethodName       = "/filespacechain.filespacechain.Msg/UpdateFileEntry"
-	Msg_DeleteFileEntry_FullMethodName       = "/filespacechain.filespacechain.Msg/DeleteFileEntry"
-	Msg_CreateHostingInquiry_FullMethodName  = "/filespacechain.filespacechain.Msg/CreateHostingInquiry"
-	Msg_UpdateHostingInquiry_FullMethodName  = "/filespacechain.filespacechain.Msg/UpdateHostingInquiry"
-	Msg_DeleteHostingInquiry_FullMethodName  = "/filespacechain.filespacechain.Msg/DeleteHostingInquiry"
-	Msg_CreateHostingContract_FullMethodName = "/filespacechain.filespacechain.Msg/CreateHostingContract"
-	Msg_UpdateHostingContract_FullMethodName = "/filespacechain.filespacechain.Msg/UpdateHostingContract"
-	Msg_DeleteHostingContract_FullMethodName = "/filespacechain.filespacechain.Msg/DeleteHostingContract"
-	Msg_CreateHostingOffer_FullMethodName    = "/filespacechain.filespacechain.Msg/CreateHostingOffer"
-	Msg_UpdateHostingOffer_FullMethodName    = "/filespacechain.filespacechain.Msg/UpdateHostingOffer"
-	Msg_DeleteHostingOffer_FullMethodName    = "/filespacechain.filespacechain.Msg/DeleteHostingOffer"
+	Msg_UpdateParams_FullMethodName                  = "/filespacechain.filespacechain.Msg/UpdateParams"
+	Msg_CreateFileEntry_FullMethodName               = "/filespacechain.filespacechain.Msg/CreateFileEntry"
+	Msg_UpdateFileEntry_FullMethodName               = "/filespacechain.filespacechain.Msg/UpdateFileEntry"
+	Msg_DeleteFileEntry_FullMethodName               = "/filespacechain.filespacechain.Msg/DeleteFileEntry"
+	Msg_CreateHostingInquiry_FullMethodName          = "/filespacechain.filespacechain.Msg/CreateHostingInquiry"
+	Msg_UpdateHostingInquiry_FullMethodName          = "/filespacechain.filespacechain.Msg/UpdateHostingInquiry"
+	Msg_DeleteHostingInquiry_FullMethodName          = "/filespacechain.filespacechain.Msg/DeleteHostingInquiry"
+	Msg_CreateHostingContract_FullMethodName         = "/filespacechain.filespacechain.Msg/CreateHostingContract"
+	Msg_UpdateHostingContract_FullMethodName         = "/filespacechain.filespacechain.Msg/UpdateHostingContract"
+	Msg_DeleteHostingContract_FullMethodName         = "/filespacechain.filespacechain.Msg/DeleteHostingContract"
+	Msg_CreateHostingOffer_FullMethodName            = "/filespacechain.filespacechain.Msg/CreateHostingOffer"
+	Msg_UpdateHostingOffer_FullMethodName            = "/filespacechain.filespacechain.Msg/UpdateHostingOffer"
+	Msg_DeleteHostingOffer_FullMethodName            = "/filespacechain.filespacechain.Msg/DeleteHostingOffer"
+	Msg_StakeForHosting_FullMethodName               = "/filespacechain.filespacechain.Msg/StakeForHosting"
+	Msg_UnstakeFromHosting_FullMethodName            = "/filespacechain.filespacechain.Msg/UnstakeFromHosting"
+	Msg_SubmitStorageProof_FullMethodName            = "/filespacechain.filespacechain.Msg/SubmitStorageProof"
+	Msg_AcceptHostingContract_FullMethodName         = "/filespacechain.filespacechain.Msg/AcceptHostingContract"
+	Msg_RejectHostingContract_FullMethodName         = "/filespacechain.filespacechain.Msg/RejectHostingContract"
+	Msg_CommitBid_FullMethodName                     = "/filespacechain.filespacechain.Msg/CommitBid"
+	Msg_RevealBid_FullMethodName                     = "/filespacechain.filespacechain.Msg/RevealBid"
+	Msg_RegisterProviderProfile_FullMethodName       = "/filespacechain.filespacechain.Msg/RegisterProviderProfile"
+	Msg_UpdateProviderProfile_FullMethodName         = "/filespacechain.filespacechain.Msg/UpdateProviderProfile"
+	Msg_ExtendHostingInquiry_FullMethodName          = "/filespacechain.filespacechain.Msg/ExtendHostingInquiry"
+	Msg_AcceptHostingContractTransfer_FullMethodName = "/filespacechain.filespacechain.Msg/AcceptHostingContractTransfer"
+	Msg_TerminateHostingContract_FullMethodName      = "/filespacechain.filespacechain.Msg/TerminateHostingContract"
+	Msg_ReassignHostingContract_FullMethodName       = "/filespacechain.filespacechain.Msg/ReassignHostingContract"
 )
 
 // MsgClient is the client API for Msg service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Msg defines the Msg service.
 type MsgClient interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
@@ -53,6 +68,21 @@ type MsgClient interface {
 	CreateHostingOffer(ctx context.Context, in *MsgCreateHostingOffer, opts ...grpc.CallOption) (*MsgCreateHostingOfferResponse, error)
 	UpdateHostingOffer(ctx context.Context, in *MsgUpdateHostingOffer, opts ...grpc.CallOption) (*MsgUpdateHostingOfferResponse, error)
 	DeleteHostingOffer(ctx context.Context, in *MsgDeleteHostingOffer, opts ...grpc.CallOption) (*MsgDeleteHostingOfferResponse, error)
+	StakeForHosting(ctx context.Context, in *MsgStakeForHosting, opts ...grpc.CallOption) (*MsgStakeForHostingResponse, error)
+	UnstakeFromHosting(ctx context.Context, in *MsgUnstakeFromHosting, opts ...grpc.CallOption) (*MsgUnstakeFromHostingResponse, error)
+	SubmitStorageProof(ctx context.Context, in *MsgSubmitStorageProof, opts ...grpc.CallOption) (*MsgSubmitStorageProofResponse, error)
+	AcceptHostingContract(ctx context.Context, in *MsgAcceptHostingContract, opts ...grpc.CallOption) (*MsgAcceptHostingContractResponse, error)
+	RejectHostingContract(ctx context.Context, in *MsgRejectHostingContract, opts ...grpc.CallOption) (*MsgRejectHostingContractResponse, error)
+	CommitBid(ctx context.Context, in *MsgCommitBid, opts ...grpc.CallOption) (*MsgCommitBidResponse, error)
+	RevealBid(ctx context.Context, in *MsgRevealBid, opts ...grpc.CallOption) (*MsgRevealBidResponse, error)
+	RegisterProviderProfile(ctx context.Context, in *MsgRegisterProviderProfile, opts ...grpc.CallOption) (*MsgRegisterProviderProfileResponse, error)
+	UpdateProviderProfile(ctx context.Context, in *MsgUpdateProviderProfile, opts ...grpc.CallOption) (*MsgUpdateProviderProfileResponse, error)
+	ExtendHostingInquiry(ctx context.Context, in *MsgExtendHostingInquiry, opts ...grpc.CallOption) (*MsgExtendHostingInquiryResponse, error)
+	AcceptHostingContractTransfer(ctx context.Context, in *MsgAcceptHostingContractTransfer, opts ...grpc.CallOption) (*MsgAcceptHostingContractTransferResponse, error)
+	TerminateHostingContract(ctx context.Context, in *MsgTerminateHostingContract, opts ...grpc.CallOption) (*MsgTerminateHostingContractResponse, error)
+	// ReassignHostingContract defines a (governance) operation for handing a
+	// hosting contract over to another provider without its consent.
+	ReassignHostingContract(ctx context.Context, in *MsgReassignHostingContract, opts ...grpc.CallOption) (*MsgReassignHostingContractResponse, error)
 }
 
 type msgClient struct {
@@ -64,8 +94,9 @@ func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
 }
 
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -73,8 +104,9 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 }
 
 func (c *msgClient) CreateFileEntry(ctx context.Context, in *MsgCreateFileEntry, opts ...grpc.CallOption) (*MsgCreateFileEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgCreateFileEntryResponse)
-	err := c.cc.Invoke(ctx, Msg_CreateFileEntry_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Msg_CreateFileEntry_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -82,8 +114,9 @@ func (c *msgClient) CreateFileEntry(ctx context.Context, in *MsgCreateFileEntry,
 }
 
 func (c *msgClient) UpdateFileEntry(ctx context.Context, in *MsgUpdateFileEntry, opts ...grpc.CallOption) (*MsgUpdateFileEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgUpdateFileEntryResponse)
-	err := c.cc.Invoke(ctx, Msg_UpdateFileEntry_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Msg_UpdateFileEntry_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -91,8 +124,9 @@ func (c *msgClient) UpdateFileEntry(ctx context.Context, in *MsgUpdateFileEntry,
 }
 
 func (c *msgClient) DeleteFileEntry(ctx context.Context, in *MsgDeleteFileEntry, opts ...grpc.CallOption) (*MsgDeleteFileEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgDeleteFileEntryResponse)
-	err := c.cc.Invoke(ctx, Msg_DeleteFileEntry_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Msg_DeleteFileEntry_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -100,8 +134,9 @@ func (c *msgClient) DeleteFileEntry(ctx context.Context, in *MsgDeleteFileEntry,
 }
 
 func (c *msgClient) CreateHostingInquiry(ctx context.Context, in *MsgCreateHostingInquiry, opts ...grpc.CallOption) (*MsgCreateHostingInquiryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgCreateHostingInquiryResponse)
-	err := c.cc.Invoke(ctx, Msg_CreateHostingInquiry_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Msg_CreateHostingInquiry_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -109,8 +144,9 @@ func (c *msgClient) CreateHostingInquiry(ctx context.Context, in *MsgCreateHosti
 }
 
 func (c *msgClient) UpdateHostingInquiry(ctx context.Context, in *MsgUpdateHostingInquiry, opts ...grpc.CallOption) (*MsgUpdateHostingInquiryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgUpdateHostingInquiryResponse)
-	err := c.cc.Invoke(ctx, Msg_UpdateHostingInquiry_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Msg_UpdateHostingInquiry_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -118,8 +154,9 @@ func (c *msgClient) UpdateHostingInquiry(ctx context.Context, in *MsgUpdateHosti
 }
 
 func (c *msgClient) DeleteHostingInquiry(ctx context.Context, in *MsgDeleteHostingInquiry, opts ...grpc.CallOption) (*MsgDeleteHostingInquiryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgDeleteHostingInquiryResponse)
-	err := c.cc.Invoke(ctx, Msg_DeleteHostingInquiry_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Msg_DeleteHostingInquiry_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -127,8 +164,9 @@ func (c *msgClient) DeleteHostingInquiry(ctx context.Context, in *MsgDeleteHosti
 }
 
 func (c *msgClient) CreateHostingContract(ctx context.Context, in *MsgCreateHostingContract, opts ...grpc.CallOption) (*MsgCreateHostingContractResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgCreateHostingContractResponse)
-	err := c.cc.Invoke(ctx, Msg_CreateHostingContract_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Msg_CreateHostingContract_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -136,8 +174,9 @@ func (c *msgClient) CreateHostingContract(ctx context.Context, in *MsgCreateHost
 }
 
 func (c *msgClient) UpdateHostingContract(ctx context.Context, in *MsgUpdateHostingContract, opts ...grpc.CallOption) (*MsgUpdateHostingContractResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgUpdateHostingContractResponse)
-	err := c.cc.Invoke(ctx, Msg_UpdateHostingContract_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Msg_UpdateHostingContract_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -145,8 +184,9 @@ func (c *msgClient) UpdateHostingContract(ctx context.Context, in *MsgUpdateHost
 }
 
 func (c *msgClient) DeleteHostingContract(ctx context.Context, in *MsgDeleteHostingContract, opts ...grpc.CallOption) (*MsgDeleteHostingContractResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgDeleteHostingContractResponse)
-	err := c.cc.Invoke(ctx, Msg_DeleteHostingContract_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Msg_DeleteHostingContract_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -154,8 +194,9 @@ func (c *msgClient) DeleteHostingContract(ctx context.Context, in *MsgDeleteHost
 }
 
 func (c *msgClient) CreateHostingOffer(ctx context.Context, in *MsgCreateHostingOffer, opts ...grpc.CallOption) (*MsgCreateHostingOfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgCreateHostingOfferResponse)
-	err := c.cc.Invoke(ctx, Msg_CreateHostingOffer_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Msg_CreateHostingOffer_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -163,8 +204,9 @@ func (c *msgClient) CreateHostingOffer(ctx context.Context, in *MsgCreateHosting
 }
 
 func (c *msgClient) UpdateHostingOffer(ctx context.Context, in *MsgUpdateHostingOffer, opts ...grpc.CallOption) (*MsgUpdateHostingOfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgUpdateHostingOfferResponse)
-	err := c.cc.Invoke(ctx, Msg_UpdateHostingOffer_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Msg_UpdateHostingOffer_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -172,8 +214,139 @@ func (c *msgClient) UpdateHostingOffer(ctx context.Context, in *MsgUpdateHosting
 }
 
 func (c *msgClient) DeleteHostingOffer(ctx context.Context, in *MsgDeleteHostingOffer, opts ...grpc.CallOption) (*MsgDeleteHostingOfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgDeleteHostingOfferResponse)
-	err := c.cc.Invoke(ctx, Msg_DeleteHostingOffer_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Msg_DeleteHostingOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StakeForHosting(ctx context.Context, in *MsgStakeForHosting, opts ...grpc.CallOption) (*MsgStakeForHostingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgStakeForHostingResponse)
+	err := c.cc.Invoke(ctx, Msg_StakeForHosting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnstakeFromHosting(ctx context.Context, in *MsgUnstakeFromHosting, opts ...grpc.CallOption) (*MsgUnstakeFromHostingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnstakeFromHostingResponse)
+	err := c.cc.Invoke(ctx, Msg_UnstakeFromHosting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitStorageProof(ctx context.Context, in *MsgSubmitStorageProof, opts ...grpc.CallOption) (*MsgSubmitStorageProofResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSubmitStorageProofResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitStorageProof_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptHostingContract(ctx context.Context, in *MsgAcceptHostingContract, opts ...grpc.CallOption) (*MsgAcceptHostingContractResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAcceptHostingContractResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptHostingContract_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RejectHostingContract(ctx context.Context, in *MsgRejectHostingContract, opts ...grpc.CallOption) (*MsgRejectHostingContractResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRejectHostingContractResponse)
+	err := c.cc.Invoke(ctx, Msg_RejectHostingContract_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommitBid(ctx context.Context, in *MsgCommitBid, opts ...grpc.CallOption) (*MsgCommitBidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCommitBidResponse)
+	err := c.cc.Invoke(ctx, Msg_CommitBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealBid(ctx context.Context, in *MsgRevealBid, opts ...grpc.CallOption) (*MsgRevealBidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRevealBidResponse)
+	err := c.cc.Invoke(ctx, Msg_RevealBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterProviderProfile(ctx context.Context, in *MsgRegisterProviderProfile, opts ...grpc.CallOption) (*MsgRegisterProviderProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRegisterProviderProfileResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterProviderProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateProviderProfile(ctx context.Context, in *MsgUpdateProviderProfile, opts ...grpc.CallOption) (*MsgUpdateProviderProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateProviderProfileResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateProviderProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExtendHostingInquiry(ctx context.Context, in *MsgExtendHostingInquiry, opts ...grpc.CallOption) (*MsgExtendHostingInquiryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgExtendHostingInquiryResponse)
+	err := c.cc.Invoke(ctx, Msg_ExtendHostingInquiry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptHostingContractTransfer(ctx context.Context, in *MsgAcceptHostingContractTransfer, opts ...grpc.CallOption) (*MsgAcceptHostingContractTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAcceptHostingContractTransferResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptHostingContractTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TerminateHostingContract(ctx context.Context, in *MsgTerminateHostingContract, opts ...grpc.CallOption) (*MsgTerminateHostingContractResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgTerminateHostingContractResponse)
+	err := c.cc.Invoke(ctx, Msg_TerminateHostingContract_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReassignHostingContract(ctx context.Context, in *MsgReassignHostingContract, opts ...grpc.CallOption) (*MsgReassignHostingContractResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgReassignHostingContractResponse)
+	err := c.cc.Invoke(ctx, Msg_ReassignHostingContract_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -182,7 +355,9 @@ func (c *msgClient) DeleteHostingOffer(ctx context.Context, in *MsgDeleteHosting
 
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
-// for forward compatibility
+// for forward compatibility.
+//
+// Msg defines the Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
@@ -199,12 +374,30 @@ type MsgServer interface {
 	CreateHostingOffer(context.Context, *MsgCreateHostingOffer) (*MsgCreateHostingOfferResponse, error)
 	UpdateHostingOffer(context.Context, *MsgUpdateHostingOffer) (*MsgUpdateHostingOfferResponse, error)
 	DeleteHostingOffer(context.Context, *MsgDeleteHostingOffer) (*MsgDeleteHostingOfferResponse, error)
+	StakeForHosting(context.Context, *MsgStakeForHosting) (*MsgStakeForHostingResponse, error)
+	UnstakeFromHosting(context.Context, *MsgUnstakeFromHosting) (*MsgUnstakeFromHostingResponse, error)
+	SubmitStorageProof(context.Context, *MsgSubmitStorageProof) (*MsgSubmitStorageProofResponse, error)
+	AcceptHostingContract(context.Context, *MsgAcceptHostingContract) (*MsgAcceptHostingContractResponse, error)
+	RejectHostingContract(context.Context, *MsgRejectHostingContract) (*MsgRejectHostingContractResponse, error)
+	CommitBid(context.Context, *MsgCommitBid) (*MsgCommitBidResponse, error)
+	RevealBid(context.Context, *MsgRevealBid) (*MsgRevealBidResponse, error)
+	RegisterProviderProfile(context.Context, *MsgRegisterProviderProfile) (*MsgRegisterProviderProfileResponse, error)
+	UpdateProviderProfile(context.Context, *MsgUpdateProviderProfile) (*MsgUpdateProviderProfileResponse, error)
+	ExtendHostingInquiry(context.Context, *MsgExtendHostingInquiry) (*MsgExtendHostingInquiryResponse, error)
+	AcceptHostingContractTransfer(context.Context, *MsgAcceptHostingContractTransfer) (*MsgAcceptHostingContractTransferResponse, error)
+	TerminateHostingContract(context.Context, *MsgTerminateHostingContract) (*MsgTerminateHostingContractResponse, error)
+	// ReassignHostingContract defines a (governance) operation for handing a
+	// hosting contract over to another provider without its consent.
+	ReassignHostingContract(context.Context, *MsgReassignHostingContract) (*MsgReassignHostingContractResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
-// UnimplementedMsgServer must be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
-}
+// UnimplementedMsgServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMsgServer struct{}
 
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
@@ -245,7 +438,47 @@ func (UnimplementedMsgServer) UpdateHostingOffer(context.Context, *MsgUpdateHost
 func (UnimplementedMsgServer) DeleteHostingOffer(context.Context, *MsgDeleteHostingOffer) (*MsgDeleteHostingOfferResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteHostingOffer not implemented")
 }
+func (UnimplementedMsgServer) StakeForHosting(context.Context, *MsgStakeForHosting) (*MsgStakeForHostingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakeForHosting not implemented")
+}
+func (UnimplementedMsgServer) UnstakeFromHosting(context.Context, *MsgUnstakeFromHosting) (*MsgUnstakeFromHostingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnstakeFromHosting not implemented")
+}
+func (UnimplementedMsgServer) SubmitStorageProof(context.Context, *MsgSubmitStorageProof) (*MsgSubmitStorageProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitStorageProof not implemented")
+}
+func (UnimplementedMsgServer) AcceptHostingContract(context.Context, *MsgAcceptHostingContract) (*MsgAcceptHostingContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptHostingContract not implemented")
+}
+func (UnimplementedMsgServer) RejectHostingContract(context.Context, *MsgRejectHostingContract) (*MsgRejectHostingContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectHostingContract not implemented")
+}
+func (UnimplementedMsgServer) CommitBid(context.Context, *MsgCommitBid) (*MsgCommitBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitBid not implemented")
+}
+func (UnimplementedMsgServer) RevealBid(context.Context, *MsgRevealBid) (*MsgRevealBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealBid not implemented")
+}
+func (UnimplementedMsgServer) RegisterProviderProfile(context.Context, *MsgRegisterProviderProfile) (*MsgRegisterProviderProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterProviderProfile not implemented")
+}
+func (UnimplementedMsgServer) UpdateProviderProfile(context.Context, *MsgUpdateProviderProfile) (*MsgUpdateProviderProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProviderProfile not implemented")
+}
+func (UnimplementedMsgServer) ExtendHostingInquiry(context.Context, *MsgExtendHostingInquiry) (*MsgExtendHostingInquiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendHostingInquiry not implemented")
+}
+func (UnimplementedMsgServer) AcceptHostingContractTransfer(context.Context, *MsgAcceptHostingContractTransfer) (*MsgAcceptHostingContractTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptHostingContractTransfer not implemented")
+}
+func (UnimplementedMsgServer) TerminateHostingContract(context.Context, *MsgTerminateHostingContract) (*MsgTerminateHostingContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateHostingContract not implemented")
+}
+func (UnimplementedMsgServer) ReassignHostingContract(context.Context, *MsgReassignHostingContract) (*MsgReassignHostingContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignHostingContract not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to MsgServer will
@@ -255,6 +488,13 @@ type UnsafeMsgServer interface {
 }
 
 func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	// If the following call pancis, it indicates UnimplementedMsgServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
 	s.RegisterService(&Msg_ServiceDesc, srv)
 }
 
@@ -492,6 +732,240 @@ func _Msg_DeleteHostingOffer_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_StakeForHosting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStakeForHosting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StakeForHosting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_StakeForHosting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StakeForHosting(ctx, req.(*MsgStakeForHosting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnstakeFromHosting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnstakeFromHosting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnstakeFromHosting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnstakeFromHosting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnstakeFromHosting(ctx, req.(*MsgUnstakeFromHosting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitStorageProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitStorageProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitStorageProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitStorageProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitStorageProof(ctx, req.(*MsgSubmitStorageProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptHostingContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptHostingContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptHostingContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptHostingContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptHostingContract(ctx, req.(*MsgAcceptHostingContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RejectHostingContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectHostingContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectHostingContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RejectHostingContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectHostingContract(ctx, req.(*MsgRejectHostingContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommitBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CommitBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitBid(ctx, req.(*MsgCommitBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevealBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealBid(ctx, req.(*MsgRevealBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterProviderProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterProviderProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterProviderProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterProviderProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterProviderProfile(ctx, req.(*MsgRegisterProviderProfile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateProviderProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProviderProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProviderProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateProviderProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProviderProfile(ctx, req.(*MsgUpdateProviderProfile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExtendHostingInquiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendHostingInquiry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendHostingInquiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ExtendHostingInquiry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendHostingInquiry(ctx, req.(*MsgExtendHostingInquiry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptHostingContractTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptHostingContractTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptHostingContractTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptHostingContractTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptHostingContractTransfer(ctx, req.(*MsgAcceptHostingContractTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TerminateHostingContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTerminateHostingContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TerminateHostingContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TerminateHostingContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TerminateHostingContract(ctx, req.(*MsgTerminateHostingContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReassignHostingContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReassignHostingContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReassignHostingContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReassignHostingContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReassignHostingContract(ctx, req.(*MsgReassignHostingContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -551,6 +1025,58 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteHostingOffer",
 			Handler:    _Msg_DeleteHostingOffer_Handler,
 		},
+		{
+			MethodName: "StakeForHosting",
+			Handler:    _Msg_StakeForHosting_Handler,
+		},
+		{
+			MethodName: "UnstakeFromHosting",
+			Handler:    _Msg_UnstakeFromHosting_Handler,
+		},
+		{
+			MethodName: "SubmitStorageProof",
+			Handler:    _Msg_SubmitStorageProof_Handler,
+		},
+		{
+			MethodName: "AcceptHostingContract",
+			Handler:    _Msg_AcceptHostingContract_Handler,
+		},
+		{
+			MethodName: "RejectHostingContract",
+			Handler:    _Msg_RejectHostingContract_Handler,
+		},
+		{
+			MethodName: "CommitBid",
+			Handler:    _Msg_CommitBid_Handler,
+		},
+		{
+			MethodName: "RevealBid",
+			Handler:    _Msg_RevealBid_Handler,
+		},
+		{
+			MethodName: "RegisterProviderProfile",
+			Handler:    _Msg_RegisterProviderProfile_Handler,
+		},
+		{
+			MethodName: "UpdateProviderProfile",
+			Handler:    _Msg_UpdateProviderProfile_Handler,
+		},
+		{
+			MethodName: "ExtendHostingInquiry",
+			Handler:    _Msg_ExtendHostingInquiry_Handler,
+		},
+		{
+			MethodName: "AcceptHostingContractTransfer",
+			Handler:    _Msg_AcceptHostingContractTransfer_Handler,
+		},
+		{
+			MethodName: "TerminateHostingContract",
+			Handler:    _Msg_TerminateHostingContract_Handler,
+		},
+		{
+			MethodName: "ReassignHostingContract",
+			Handler:    _Msg_ReassignHostingContract_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "filespacechain/filespacechain/tx.proto",
@@ -188,6 +188,8 @@ var (
 		minttypes.ModuleName,
 		stakingtypes.BondedPoolName,
 		stakingtypes.NotBondedPoolName,
+		filespacechainmoduletypes.ModuleName,
+		"hosting_bonded_pool",
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
 	}
@@ -0,0 +1,97 @@
+package app_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/hanshq/filespace-chain/testutil/keeper"
+	"github.com/hanshq/filespace-chain/testutil/sample"
+	filespacechain "github.com/hanshq/filespace-chain/x/filespacechain/module"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+const benchActiveContracts = 10
+
+// seedHostingHistory stores history settled contracts, whose escrow is gone,
+// and benchActiveContracts escrowed contracts that are paid every block, then
+// drains the expiry queues of the settled history
+func seedHostingHistory(b *testing.B, history int) (filespacechain.AppModule, sdk.Context) {
+	bank := keepertest.NewMockBankKeeper()
+	k, ctx := keepertest.FilespacechainKeeperWithBank(b, bank)
+	am := filespacechain.NewAppModule(nil, k, nil, bank)
+	ctx = ctx.WithBlockHeight(99)
+
+	appendContract := func(endBlock uint64, status types.ContractStatus) types.HostingContract {
+		provider := sample.AccAddress()
+		inquiryId := k.AppendHostingInquiry(ctx, types.HostingInquiry{
+			Creator:         sample.AccAddress(),
+			ReplicationRate: 1,
+			EndTime:         endBlock,
+		})
+		offerId := k.AppendHostingOffer(ctx, types.HostingOffer{
+			Creator:       provider,
+			PricePerBlock: sdk.NewCoin("token", math.NewInt(10)),
+		})
+		contract := types.HostingContract{
+			Creator:    provider,
+			InquiryId:  inquiryId,
+			OfferId:    offerId,
+			StartBlock: 1,
+			EndBlock:   endBlock,
+			Status:     status,
+		}
+		contract.Id = k.AppendHostingContract(ctx, contract)
+		return contract
+	}
+
+	for i := 0; i < history; i++ {
+		appendContract(50, types.CONTRACT_STATUS_COMPLETED)
+	}
+	for i := 0; i < benchActiveContracts; i++ {
+		contract := appendContract(1000, types.CONTRACT_STATUS_ACTIVE)
+		k.SetEscrowRecord(ctx, contract.InquiryId, sdk.NewCoin("token", math.NewInt(1000000)), sample.AccAddress())
+	}
+	bank.FundModule(types.ModuleName, sdk.NewCoins(sdk.NewCoin("token", math.NewInt(benchActiveContracts*1000000))))
+
+	params := k.GetParams(ctx)
+	defaultCap := params.MaxExpiriesPerBlock
+	params.MaxExpiriesPerBlock = uint64(history + benchActiveContracts)
+	require.NoError(b, k.SetParams(ctx, params))
+	require.NoError(b, am.BeginBlock(ctx))
+	params.MaxExpiriesPerBlock = defaultCap
+	require.NoError(b, k.SetParams(ctx, params))
+
+	// Commit so that iterators read the persisted tree, as they would in a
+	// running chain, instead of sorting every pending write
+	ctx.MultiStore().(storetypes.CommitMultiStore).Commit()
+
+	return am, ctx.WithBlockHeight(100)
+}
+
+// BenchmarkBeginBlockHistory runs BeginBlock with the same active workload over
+// a growing number of settled contracts. With the secondary indexes and expiry
+// queues the time per block stays flat as history grows.
+//
+// Run with:
+// `go test -run=^$ -bench ^BenchmarkBeginBlockHistory ./app`
+func BenchmarkBeginBlockHistory(b *testing.B) {
+	for _, history := range []int{100, 1000, 10000} {
+		b.Run(fmt.Sprintf("history=%d", history), func(b *testing.B) {
+			am, ctx := seedHostingHistory(b, history)
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				cacheCtx, _ := ctx.CacheContext()
+				if err := am.BeginBlock(cacheCtx); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	simulationtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
@@ -32,6 +33,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/hanshq/filespace-chain/app"
+	filespacechainkeeper "github.com/hanshq/filespace-chain/x/filespacechain/keeper"
 )
 
 const (
@@ -58,6 +60,15 @@ func interBlockCacheOpt() func(*baseapp.BaseApp) {
 	return baseapp.SetInterBlockCache(store.NewCommitKVStoreCacheManager())
 }
 
+// requireFilespacechainInvariants fails the test if any filespacechain
+// invariant is broken, so escrow and stake accounting bugs surface in every
+// simulation regardless of the crisis check period.
+func requireFilespacechainInvariants(tb testing.TB, ctx sdk.Context, bApp *app.App) {
+	tb.Helper()
+	res, broken := filespacechainkeeper.AllInvariants(bApp.FilespacechainKeeper)(ctx)
+	require.False(tb, broken, res)
+}
+
 // BenchmarkSimulation run the chain simulation
 // Running using starport command:
 // `ignite chain simulate -v --numBlocks 200 --blockSize 50`
@@ -108,6 +119,7 @@ func BenchmarkSimulation(b *testing.B) {
 	err = simtestutil.CheckExportSimulation(bApp, config, simParams)
 	require.NoError(b, err)
 	require.NoError(b, simErr)
+	requireFilespacechainInvariants(b, bApp.NewContextLegacy(true, cmtproto.Header{Height: bApp.LastBlockHeight()}), bApp)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -154,6 +166,7 @@ func TestAppImportExport(t *testing.T) {
 	err = simtestutil.CheckExportSimulation(bApp, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	requireFilespacechainInvariants(t, bApp.NewContextLegacy(true, cmtproto.Header{Height: bApp.LastBlockHeight()}), bApp)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -196,6 +209,7 @@ func TestAppImportExport(t *testing.T) {
 	require.NoError(t, err)
 	err = newApp.StoreConsensusParams(ctxB, exported.ConsensusParams)
 	require.NoError(t, err)
+	requireFilespacechainInvariants(t, ctxB, newApp)
 	fmt.Printf("comparing stores...\n")
 
 	// skip certain prefixes
@@ -274,6 +288,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 	err = simtestutil.CheckExportSimulation(bApp, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	requireFilespacechainInvariants(t, bApp.NewContextLegacy(true, cmtproto.Header{Height: bApp.LastBlockHeight()}), bApp)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -321,6 +336,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		bApp.AppCodec(),
 	)
 	require.NoError(t, err)
+	requireFilespacechainInvariants(t, newApp.NewContextLegacy(true, cmtproto.Header{Height: newApp.LastBlockHeight()}), newApp)
 }
 
 func TestAppStateDeterminism(t *testing.T) {
@@ -408,6 +424,7 @@ func TestAppStateDeterminism(t *testing.T) {
 				bApp.AppCodec(),
 			)
 			require.NoError(t, err)
+			requireFilespacechainInvariants(t, bApp.NewContextLegacy(true, cmtproto.Header{Height: bApp.LastBlockHeight()}), bApp)
 
 			if config.Commit {
 				simtestutil.PrintStats(db)
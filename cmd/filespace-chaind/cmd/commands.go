@@ -52,6 +52,7 @@ func initRootCmd(
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
+		ExportContractsCmd(),
 	)
 }
 
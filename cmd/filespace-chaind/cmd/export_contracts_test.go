@@ -0,0 +1,285 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func TestReadContractFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		filter contractFilter
+		err    string
+	}{
+		{
+			name: "no filters",
+		}, {
+			name: "addresses and block range",
+			args: []string{"--creator", "creator1", "--provider", "provider1", "--from-block", "100", "--to-block", "200"},
+			filter: contractFilter{
+				creator:   "creator1",
+				provider:  "provider1",
+				fromBlock: 100,
+				toBlock:   200,
+			},
+		}, {
+			name:   "open ended block range",
+			args:   []string{"--from-block", "100"},
+			filter: contractFilter{fromBlock: 100},
+		}, {
+			name: "statuses by short and full name",
+			args: []string{"--status", "active, Completed", "--status", "CONTRACT_STATUS_SLASHED"},
+			filter: contractFilter{statuses: map[types.ContractStatus]bool{
+				types.CONTRACT_STATUS_ACTIVE:    true,
+				types.CONTRACT_STATUS_COMPLETED: true,
+				types.CONTRACT_STATUS_SLASHED:   true,
+			}},
+		}, {
+			name: "unknown status",
+			args: []string{"--status", "expired"},
+			err:  `unknown contract status "CONTRACT_STATUS_EXPIRED"`,
+		}, {
+			name: "range ends before it starts",
+			args: []string{"--from-block", "200", "--to-block", "100"},
+			err:  "--to-block 100 is before --from-block 200",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := ExportContractsCmd()
+			require.NoError(t, cmd.ParseFlags(tt.args))
+
+			filter, err := readContractFilter(cmd)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.filter, filter)
+		})
+	}
+}
+
+func TestMatchesContract(t *testing.T) {
+	contract := types.HostingContract{
+		Creator:    "provider1",
+		StartBlock: 100,
+		EndBlock:   200,
+		Status:     types.CONTRACT_STATUS_ACTIVE,
+	}
+	tests := []struct {
+		name    string
+		filter  contractFilter
+		matches bool
+	}{
+		{
+			name:    "no filters",
+			matches: true,
+		}, {
+			name:    "provider",
+			filter:  contractFilter{provider: "provider1"},
+			matches: true,
+		}, {
+			name:   "other provider",
+			filter: contractFilter{provider: "provider2"},
+		}, {
+			name:    "status",
+			filter:  contractFilter{statuses: map[types.ContractStatus]bool{types.CONTRACT_STATUS_ACTIVE: true}},
+			matches: true,
+		}, {
+			name:   "other status",
+			filter: contractFilter{statuses: map[types.ContractStatus]bool{types.CONTRACT_STATUS_COMPLETED: true}},
+		}, {
+			name:    "range overlaps the end of the term",
+			filter:  contractFilter{fromBlock: 200, toBlock: 300},
+			matches: true,
+		}, {
+			name:    "range overlaps the start of the term",
+			filter:  contractFilter{fromBlock: 50, toBlock: 100},
+			matches: true,
+		}, {
+			name:   "range after the term",
+			filter: contractFilter{fromBlock: 201},
+		}, {
+			name:   "range before the term",
+			filter: contractFilter{toBlock: 99},
+		}, {
+			name:    "open ended range",
+			filter:  contractFilter{fromBlock: 150},
+			matches: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.matches, tt.filter.matchesContract(contract))
+		})
+	}
+}
+
+func TestContractRowWriterWithoutRows(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, newContractRowWriter(exportFormatCSV, &out).flush())
+	require.Equal(t, strings.Join(contractRowHeader, ",")+"\n", out.String())
+
+	out.Reset()
+	require.NoError(t, newContractRowWriter(exportFormatJSONL, &out).flush())
+	require.Empty(t, out.String())
+}
+
+func TestExportContractsFromGenesis(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		rows []string
+	}{
+		{
+			name: "all contracts",
+			rows: []string{
+				"1,COMPLETED,provider1,100,200,2token,2048,1,creator1,2,1000token,1,eu-west,2token,1,QmFile1,2048,150token,50token,200,true",
+				"2,ACTIVE,provider2,300,400,3token,0,1,creator1,2,1000token,2,,,1,QmFile1,2048,,,0,false",
+				"3,ACTIVE,provider1,500,600,1token,0,2,creator2,1,500token,3,,,2,QmFile2,0,,,0,false",
+			},
+		}, {
+			name: "by provider and status",
+			args: []string{"--provider", "provider1", "--status", "active"},
+			rows: []string{
+				"3,ACTIVE,provider1,500,600,1token,0,2,creator2,1,500token,3,,,2,QmFile2,0,,,0,false",
+			},
+		}, {
+			name: "by creator and block range",
+			args: []string{"--creator", "creator1", "--from-block", "250"},
+			rows: []string{
+				"2,ACTIVE,provider2,300,400,3token,0,1,creator1,2,1000token,2,,,1,QmFile1,2048,,,0,false",
+			},
+		}, {
+			name: "no matches",
+			args: []string{"--creator", "creator3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientCtx := client.Context{}.WithCodec(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()))
+			var out bytes.Buffer
+			cmd := ExportContractsCmd()
+			cmd.SetOut(&out)
+			cmd.SetArgs(append([]string{"--genesis", "testdata/exported_genesis.json"}, tt.args...))
+			require.NoError(t, cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)))
+
+			lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+			require.Equal(t, strings.Join(contractRowHeader, ","), lines[0])
+			require.Equal(t, tt.rows, append([]string(nil), lines[1:]...))
+		})
+	}
+}
+
+func TestQueryContractSourcePinsHeight(t *testing.T) {
+	tokens := sdk.NewCoin("token", math.NewInt(1))
+	pages := [][]types.HostingContract{
+		{{Id: 1, InquiryId: 1, OfferId: 1, PricePerBlock: tokens}, {Id: 2, InquiryId: 1, OfferId: 2, PricePerBlock: tokens}},
+		{{Id: 3, InquiryId: 2, OfferId: 3, PricePerBlock: tokens}},
+	}
+
+	for _, tt := range []struct {
+		name   string
+		height int64
+		pinned string
+	}{
+		{name: "latest height of the first page", pinned: "42"},
+		{name: "height flag", height: 7, pinned: "7"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			queryClient := &heightRecordingQueryClient{pages: pages, latest: 42}
+			source := newQueryContractSource(queryClient, tt.height)
+
+			var ids []uint64
+			err := source.forEachContract(context.Background(), func(contract types.HostingContract) error {
+				ids = append(ids, contract.Id)
+				_, err := joinContract(context.Background(), source, contract)
+				return err
+			})
+			require.NoError(t, err)
+			require.Equal(t, []uint64{1, 2, 3}, ids)
+
+			// Only the first page may be read at the latest height, every
+			// later page and lookup is pinned to it
+			heights := queryClient.heights
+			if tt.height == 0 {
+				require.Empty(t, heights[0])
+				heights = heights[1:]
+			}
+			require.NotEmpty(t, heights)
+			for _, height := range heights {
+				require.Equal(t, tt.pinned, height)
+			}
+		})
+	}
+}
+
+// heightRecordingQueryClient serves contract pages and records the height
+// each query asks for. The chain advances by a block on every query.
+type heightRecordingQueryClient struct {
+	types.QueryClient
+	pages   [][]types.HostingContract
+	latest  int64
+	heights []string
+}
+
+func (c *heightRecordingQueryClient) record(ctx context.Context) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	c.heights = append(c.heights, strings.Join(md.Get(grpctypes.GRPCBlockHeightHeader), ","))
+	c.latest++
+}
+
+func (c *heightRecordingQueryClient) HostingContractAll(ctx context.Context, req *types.QueryAllHostingContractRequest, opts ...grpc.CallOption) (*types.QueryAllHostingContractResponse, error) {
+	served := c.latest
+	c.record(ctx)
+	for _, opt := range opts {
+		if header, ok := opt.(grpc.HeaderCallOption); ok {
+			*header.HeaderAddr = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(served, 10))
+		}
+	}
+
+	page := 0
+	if len(req.Pagination.Key) > 0 {
+		page = int(req.Pagination.Key[0])
+	}
+	res := &types.QueryAllHostingContractResponse{HostingContract: c.pages[page], Pagination: &query.PageResponse{}}
+	if page+1 < len(c.pages) {
+		res.Pagination.NextKey = []byte{byte(page + 1)}
+	}
+	return res, nil
+}
+
+func (c *heightRecordingQueryClient) HostingInquiry(ctx context.Context, _ *types.QueryGetHostingInquiryRequest, _ ...grpc.CallOption) (*types.QueryGetHostingInquiryResponse, error) {
+	c.record(ctx)
+	return nil, status.Error(codes.NotFound, "not found")
+}
+
+func (c *heightRecordingQueryClient) HostingOffer(ctx context.Context, _ *types.QueryGetHostingOfferRequest, _ ...grpc.CallOption) (*types.QueryGetHostingOfferResponse, error) {
+	c.record(ctx)
+	return nil, status.Error(codes.NotFound, "not found")
+}
+
+func (c *heightRecordingQueryClient) PaymentHistory(ctx context.Context, _ *types.QueryPaymentHistoryRequest, _ ...grpc.CallOption) (*types.QueryPaymentHistoryResponse, error) {
+	c.record(ctx)
+	return nil, status.Error(codes.NotFound, "not found")
+}
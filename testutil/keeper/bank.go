@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+var (
+	_ types.BankKeeper         = (*MockBankKeeper)(nil)
+	_ types.DistributionKeeper = (*MockBankKeeper)(nil)
+)
+
+// MockBankKeeper is an in-memory bank keeper that tracks balances of accounts
+// and module accounts, so keeper tests can check where funds end up.
+type MockBankKeeper struct {
+	balances map[string]sdk.Coins
+	burned   sdk.Coins
+}
+
+func NewMockBankKeeper() *MockBankKeeper {
+	return &MockBankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+// ModuleAddress returns the address of a module account
+func ModuleAddress(moduleName string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(moduleName)
+}
+
+// Fund mints coins into an account
+func (b *MockBankKeeper) Fund(addr sdk.AccAddress, amt sdk.Coins) {
+	b.balances[addr.String()] = b.balances[addr.String()].Add(amt...)
+}
+
+// FundModule mints coins into a module account
+func (b *MockBankKeeper) FundModule(moduleName string, amt sdk.Coins) {
+	b.Fund(ModuleAddress(moduleName), amt)
+}
+
+// ModuleBalance returns all coins held by a module account
+func (b *MockBankKeeper) ModuleBalance(moduleName string) sdk.Coins {
+	return b.balances[ModuleAddress(moduleName).String()]
+}
+
+// Burned returns all coins burned so far
+func (b *MockBankKeeper) Burned() sdk.Coins {
+	return b.burned
+}
+
+// CommunityPool returns all coins sent to the community pool
+func (b *MockBankKeeper) CommunityPool() sdk.Coins {
+	return b.ModuleBalance(distrtypes.ModuleName)
+}
+
+func (b *MockBankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance := b.balances[from.String()]
+	remaining, hasNeg := balance.SafeSub(amt...)
+	if hasNeg {
+		return fmt.Errorf("insufficient funds: %s has %s, needs %s", from, balance, amt)
+	}
+	b.balances[from.String()] = remaining
+	b.balances[to.String()] = b.balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (b *MockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b *MockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.send(senderAddr, ModuleAddress(recipientModule), amt)
+}
+
+func (b *MockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(ModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (b *MockBankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b.balances[addr.String()].AmountOf(denom))
+}
+
+func (b *MockBankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b *MockBankKeeper) BurnCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	if err := b.send(ModuleAddress(moduleName), ModuleAddress("burned"), amt); err != nil {
+		return err
+	}
+	b.balances[ModuleAddress("burned").String()] = nil
+	b.burned = b.burned.Add(amt...)
+	return nil
+}
+
+func (b *MockBankKeeper) FundCommunityPool(_ context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	return b.send(sender, ModuleAddress(distrtypes.ModuleName), amount)
+}
@@ -22,8 +22,20 @@ import (
 )
 
 func FilespacechainKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	return FilespacechainKeeperWithBank(t, nil)
+}
+
+// FilespacechainKeeperWithBank returns a keeper backed by the given bank keeper,
+// such as a MockBankKeeper, for tests that move funds
+func FilespacechainKeeperWithBank(t testing.TB, bankKeeper types.BankKeeper) (keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
+	// The mock bank keeper also stands in for the community pool
+	var distrKeeper types.DistributionKeeper
+	if mock, ok := bankKeeper.(*MockBankKeeper); ok {
+		distrKeeper = mock
+	}
+
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
@@ -39,7 +51,8 @@ func FilespacechainKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		log.NewNopLogger(),
 		authority.String(),
 		nil, // Mock account keeper - tests will use nil
-		nil, // Mock bank keeper - tests will use nil
+		bankKeeper,
+		distrKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
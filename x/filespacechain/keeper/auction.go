@@ -0,0 +1,247 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+// Auctioned inquiries are not matched to the cheapest offers. Providers
+// commit to a hashed price with one of their offers, reveal it once bidding
+// closed, and when the reveal window closes the cheapest revealed bids win a
+// replica each. Every winner is paid the same clearing price: the price of
+// the cheapest losing bid, so undercutting by one unit gains nothing. Once
+// settled the inquiry is filled like any other.
+
+// SetAuction stores the auction of an inquiry and queues it for settlement
+// until it is settled
+func (k Keeper) SetAuction(ctx context.Context, auction types.Auction) {
+	if previous, found := k.GetAuction(ctx, auction.InquiryId); found {
+		k.removeIndexEntry(ctx, types.AuctionQueueKey, Uint64IndexPrefix(previous.RevealEnd), previous.InquiryId)
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionKey))
+	store.Set(Uint64IndexPrefix(auction.InquiryId), k.cdc.MustMarshal(&auction))
+
+	if !auction.Settled {
+		k.setIndexEntry(ctx, types.AuctionQueueKey, Uint64IndexPrefix(auction.RevealEnd), auction.InquiryId)
+	}
+}
+
+// GetAuction returns the auction of an inquiry
+func (k Keeper) GetAuction(ctx context.Context, inquiryId uint64) (val types.Auction, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionKey))
+	b := store.Get(Uint64IndexPrefix(inquiryId))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveAuction removes the auction of an inquiry, its queue entry and its bids
+func (k Keeper) RemoveAuction(ctx context.Context, inquiryId uint64) {
+	auction, found := k.GetAuction(ctx, inquiryId)
+	if !found {
+		return
+	}
+	k.removeIndexEntry(ctx, types.AuctionQueueKey, Uint64IndexPrefix(auction.RevealEnd), inquiryId)
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionKey)).Delete(Uint64IndexPrefix(inquiryId))
+
+	bidStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionBidKey))
+	for _, bid := range k.GetAuctionBids(ctx, inquiryId) {
+		bidStore.Delete(auctionBidKey(bid.InquiryId, bid.Provider))
+	}
+}
+
+// GetAllAuction returns all auctions
+func (k Keeper) GetAllAuction(ctx context.Context) (list []types.Auction) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Auction
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func auctionBidKey(inquiryId uint64, provider string) []byte {
+	return append(Uint64IndexPrefix(inquiryId), StringIndexPrefix(provider)...)
+}
+
+// SetAuctionBid stores the bid of a provider, replacing its previous bid
+func (k Keeper) SetAuctionBid(ctx context.Context, bid types.AuctionBid) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionBidKey))
+	store.Set(auctionBidKey(bid.InquiryId, bid.Provider), k.cdc.MustMarshal(&bid))
+}
+
+// GetAuctionBid returns the bid of a provider in the auction of an inquiry
+func (k Keeper) GetAuctionBid(ctx context.Context, inquiryId uint64, provider string) (val types.AuctionBid, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionBidKey))
+	b := store.Get(auctionBidKey(inquiryId, provider))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAuctionBids returns the bids in the auction of an inquiry in provider order
+func (k Keeper) GetAuctionBids(ctx context.Context, inquiryId uint64) []types.AuctionBid {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionBidKey))
+	return k.collectAuctionBids(storetypes.KVStorePrefixIterator(store, Uint64IndexPrefix(inquiryId)))
+}
+
+// GetAllAuctionBid returns all bids
+func (k Keeper) GetAllAuctionBid(ctx context.Context) []types.AuctionBid {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuctionBidKey))
+	return k.collectAuctionBids(storetypes.KVStorePrefixIterator(store, []byte{}))
+}
+
+func (k Keeper) collectAuctionBids(iterator storetypes.Iterator) (list []types.AuctionBid) {
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AuctionBid
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// isAuctionPending reports whether an inquiry is auctioned and its auction has
+// not settled yet; until then only the auction fills the inquiry
+func (k Keeper) isAuctionPending(ctx context.Context, inquiryId uint64) bool {
+	auction, found := k.GetAuction(ctx, inquiryId)
+	return found && !auction.Settled
+}
+
+// SettleAuction opens a PENDING contract at the clearing price for each of the
+// cheapest revealed bids, one per open replica of the inquiry. Bids are ranked
+// by price, then by commit height and provider. Bids whose offer is gone or no
+// longer meets the inquiry's criteria at the bid price are passed over.
+func (k Keeper) SettleAuction(ctx context.Context, auction types.Auction) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	inquiry, found := k.GetHostingInquiry(ctx, auction.InquiryId)
+	if !found {
+		k.RemoveAuction(ctx, auction.InquiryId)
+		return nil
+	}
+
+	filter := k.newOfferFilter(ctx, inquiry)
+	var bids []types.AuctionBid
+	offers := make(map[string]types.HostingOffer)
+	for _, bid := range k.GetAuctionBids(ctx, inquiry.Id) {
+		if !bid.Revealed {
+			continue
+		}
+		offer, found := k.GetHostingOffer(ctx, bid.OfferId)
+		if !found || offer.Creator != bid.Provider {
+			continue
+		}
+		// The bid price stands in for the offer's listed price
+		offer.PricePerBlock = bid.Price
+		if filter.check(offer) != types.OFFER_REJECTION_REASON_UNSPECIFIED {
+			continue
+		}
+		bids = append(bids, bid)
+		offers[bid.Provider] = offer
+	}
+
+	sort.SliceStable(bids, func(i, j int) bool {
+		if !bids[i].Price.Amount.Equal(bids[j].Price.Amount) {
+			return bids[i].Price.Amount.LT(bids[j].Price.Amount)
+		}
+		if bids[i].CommitHeight != bids[j].CommitHeight {
+			return bids[i].CommitHeight < bids[j].CommitHeight
+		}
+		return bids[i].Provider < bids[j].Provider
+	})
+
+	// An inquiry that ended while the auction waited for settlement takes no winners
+	var slots uint64
+	if inquiry.FilledReplicas < inquiry.ReplicationRate && uint64(sdkCtx.BlockHeight()) < inquiry.EndTime {
+		slots = inquiry.ReplicationRate - inquiry.FilledReplicas
+	}
+	winners := bids
+	if uint64(len(winners)) > slots {
+		winners = winners[:slots]
+	}
+
+	auction.Settled = true
+	auction.ClearingPrice = clearingPrice(inquiry, bids, slots)
+
+	winningOfferIds := make([]uint64, 0, len(winners))
+	for _, bid := range winners {
+		k.openHostingContract(ctx, inquiry, offers[bid.Provider], auction.ClearingPrice)
+		winningOfferIds = append(winningOfferIds, bid.OfferId)
+	}
+	k.SetAuction(ctx, auction)
+
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventAuctionSettled{
+		InquiryId:       inquiry.Id,
+		ClearingPrice:   auction.ClearingPrice,
+		WinningOfferIds: winningOfferIds,
+		Height:          uint64(sdkCtx.BlockHeight()),
+	})
+}
+
+// clearingPrice returns the price of the first losing bid. Without a losing
+// bid the inquiry's maximum price is the clearing price, and without a
+// maximum the highest winning bid is.
+func clearingPrice(inquiry types.HostingInquiry, bids []types.AuctionBid, slots uint64) sdk.Coin {
+	switch {
+	case uint64(len(bids)) > slots:
+		return bids[slots].Price
+	case inquiry.MaxPricePerBlock != 0:
+		return sdk.NewCoin(inquiry.EscrowAmount.Denom, math.NewIntFromUint64(inquiry.MaxPricePerBlock))
+	case len(bids) > 0:
+		return bids[len(bids)-1].Price
+	}
+	return sdk.NewCoin(inquiry.EscrowAmount.Denom, math.ZeroInt())
+}
+
+// ProcessAuctions settles the auctions whose reveal window has closed
+func (k Keeper) ProcessAuctions(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentHeight := uint64(sdkCtx.BlockHeight())
+	params := k.GetParams(ctx)
+
+	// Auctions whose reveal window ended before the current height are due
+	for _, entry := range k.getDueQueueEntries(ctx, types.AuctionQueueKey, currentHeight, params.MaxExpiriesPerBlock) {
+		auction, found := k.GetAuction(ctx, entry.id)
+		if !found {
+			k.removeIndexEntry(ctx, types.AuctionQueueKey, Uint64IndexPrefix(entry.height), entry.id)
+			continue
+		}
+
+		// Settling the auction takes it off the queue
+		if err := k.SettleAuction(ctx, auction); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
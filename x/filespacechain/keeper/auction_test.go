@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/hanshq/filespace-chain/testutil/keeper"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func TestAuctionSettlesAtClearingPrice(t *testing.T) {
+	k, srv, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(10)
+	tokens := func(amount int64) sdk.Coin { return sdk.NewCoin("token", math.NewInt(amount)) }
+
+	inquiry := types.HostingInquiry{
+		ReplicationRate:  2,
+		EscrowAmount:     tokens(1000),
+		EndTime:          1000,
+		MaxPricePerBlock: 50,
+	}
+	inquiry.Id = k.AppendHostingInquiry(ctx, inquiry)
+	k.SetAuction(ctx, types.Auction{InquiryId: inquiry.Id, CommitEnd: 20, RevealEnd: 30, ClearingPrice: tokens(0)})
+
+	offers := make(map[string]uint64)
+	for _, provider := range []string{"A", "B", "C", "D"} {
+		k.SetProviderStake(ctx, provider, sdk.NewCoin("token", k.GetParams(ctx).MinProviderStake), 1)
+		offers[provider] = k.AppendHostingOffer(ctx, types.HostingOffer{Creator: provider, PricePerBlock: tokens(40)})
+	}
+	prices := map[string]int64{"A": 30, "B": 10, "C": 20, "D": 5}
+	salt := []byte("0123456789abcdef")
+
+	// Replicas of an auctioned inquiry cannot be taken before it settles
+	_, err := srv.CreateHostingContract(ctx, &types.MsgCreateHostingContract{Creator: "A", InquiryId: inquiry.Id, OfferId: offers["A"]})
+	require.ErrorIs(t, err, types.ErrAuctionPhase)
+
+	for _, provider := range []string{"A", "B", "C", "D"} {
+		_, err := srv.CommitBid(ctx, &types.MsgCommitBid{
+			Creator:    provider,
+			InquiryId:  inquiry.Id,
+			OfferId:    offers[provider],
+			Commitment: types.BidCommitment(provider, inquiry.Id, tokens(prices[provider]), salt),
+		})
+		require.NoError(t, err)
+	}
+	_, err = srv.CommitBid(ctx, &types.MsgCommitBid{Creator: "A", InquiryId: inquiry.Id, OfferId: offers["B"], Commitment: make([]byte, 32)})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// Bids are revealed only once bidding closed
+	_, err = srv.RevealBid(ctx, &types.MsgRevealBid{Creator: "A", InquiryId: inquiry.Id, Price: tokens(30), Salt: salt})
+	require.ErrorIs(t, err, types.ErrAuctionPhase)
+
+	ctx = ctx.WithBlockHeight(25)
+	_, err = srv.CommitBid(ctx, &types.MsgCommitBid{Creator: "A", InquiryId: inquiry.Id, OfferId: offers["A"], Commitment: make([]byte, 32)})
+	require.ErrorIs(t, err, types.ErrAuctionPhase)
+
+	for _, provider := range []string{"A", "B", "C"} {
+		_, err := srv.RevealBid(ctx, &types.MsgRevealBid{Creator: provider, InquiryId: inquiry.Id, Price: tokens(prices[provider]), Salt: salt})
+		require.NoError(t, err)
+	}
+	// D revealed a price it did not commit to, so its bid stays sealed
+	_, err = srv.RevealBid(ctx, &types.MsgRevealBid{Creator: "D", InquiryId: inquiry.Id, Price: tokens(6), Salt: salt})
+	require.ErrorIs(t, err, types.ErrBidCommitmentMismatch)
+
+	// The auction settles in the first block after its reveal window
+	ctx = ctx.WithBlockHeight(30)
+	require.NoError(t, k.ProcessAuctions(ctx))
+	require.Empty(t, k.GetHostingContractsByInquiry(ctx, inquiry.Id))
+
+	ctx = ctx.WithBlockHeight(31)
+	require.NoError(t, k.ProcessAuctions(ctx))
+
+	// B and C win and are both paid A's losing price
+	contracts := k.GetHostingContractsByInquiry(ctx, inquiry.Id)
+	require.Len(t, contracts, 2)
+	winners := make(map[uint64]sdk.Coin)
+	for _, contract := range contracts {
+		require.Equal(t, types.CONTRACT_STATUS_PENDING, contract.Status)
+		winners[contract.OfferId] = contract.PricePerBlock
+	}
+	require.Equal(t, map[uint64]sdk.Coin{offers["B"]: tokens(30), offers["C"]: tokens(30)}, winners)
+
+	auction, found := k.GetAuction(ctx, inquiry.Id)
+	require.True(t, found)
+	require.True(t, auction.Settled)
+	require.Equal(t, tokens(30), auction.ClearingPrice)
+
+	got, found := k.GetHostingInquiry(ctx, inquiry.Id)
+	require.True(t, found)
+	require.Equal(t, uint64(2), got.FilledReplicas)
+}
+
+func TestAuctionWithoutLosingBidClearsAtMaxPrice(t *testing.T) {
+	k, ctx := keepertest.FilespacechainKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+	tokens := func(amount int64) sdk.Coin { return sdk.NewCoin("token", math.NewInt(amount)) }
+
+	inquiry := types.HostingInquiry{
+		ReplicationRate:  2,
+		EscrowAmount:     tokens(1000),
+		EndTime:          1000,
+		MaxPricePerBlock: 50,
+	}
+	inquiry.Id = k.AppendHostingInquiry(ctx, inquiry)
+	k.SetProviderStake(ctx, "A", sdk.NewCoin("token", k.GetParams(ctx).MinProviderStake), 1)
+	offerId := k.AppendHostingOffer(ctx, types.HostingOffer{Creator: "A", PricePerBlock: tokens(40)})
+
+	k.SetAuction(ctx, types.Auction{InquiryId: inquiry.Id, CommitEnd: 5, RevealEnd: 9, ClearingPrice: tokens(0)})
+	k.SetAuctionBid(ctx, types.AuctionBid{InquiryId: inquiry.Id, Provider: "A", OfferId: offerId, Revealed: true, Price: tokens(10)})
+	require.NoError(t, k.ProcessAuctions(ctx))
+
+	contracts := k.GetHostingContractsByInquiry(ctx, inquiry.Id)
+	require.Len(t, contracts, 1)
+	require.Equal(t, tokens(50), contracts[0].PricePerBlock)
+
+	// The settled auction leaves the remaining replica open to offers
+	got, found := k.GetHostingInquiry(ctx, inquiry.Id)
+	require.True(t, found)
+	require.Equal(t, uint64(1), got.FilledReplicas)
+	require.Len(t, k.GetOpenHostingInquiries(ctx), 1)
+}
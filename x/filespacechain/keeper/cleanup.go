@@ -5,37 +5,9 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
 )
 
-// CleanupExpiredRecords performs comprehensive cleanup of expired records
-func (k Keeper) CleanupExpiredRecords(ctx context.Context) error {
-	k.Logger().Info("starting cleanup of expired records")
-	
-	// Cleanup expired inquiries and refund escrow
-	err := k.ProcessExpiredInquiries(ctx)
-	if err != nil {
-		k.Logger().Error("failed to process expired inquiries", "error", err)
-		return fmt.Errorf("failed to process expired inquiries: %w", err)
-	}
-	
-	// Cleanup old payment history records
-	err = k.CleanupOldPaymentHistory(ctx)
-	if err != nil {
-		k.Logger().Error("failed to cleanup old payment history", "error", err)
-		return fmt.Errorf("failed to cleanup old payment history: %w", err)
-	}
-	
-	// Cleanup abandoned escrow records
-	err = k.CleanupAbandonedEscrow(ctx)
-	if err != nil {
-		k.Logger().Error("failed to cleanup abandoned escrow", "error", err)
-		return fmt.Errorf("failed to cleanup abandoned escrow: %w", err)
-	}
-	
-	k.Logger().Info("completed cleanup of expired records")
-	return nil
-}
-
 // CleanupOldPaymentHistory removes payment history for contracts completed more than specified blocks ago
 func (k Keeper) CleanupOldPaymentHistory(ctx context.Context) error {
 	// Clean up payment history older than 100,000 blocks (approximately 1 week)
@@ -43,62 +15,8 @@ func (k Keeper) CleanupOldPaymentHistory(ctx context.Context) error {
 	return k.CleanupCompletedPaymentHistory(ctx, olderThanBlocks)
 }
 
-// CleanupAbandonedEscrow identifies and refunds escrow for inquiries with no activity
-func (k Keeper) CleanupAbandonedEscrow(ctx context.Context) error {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	currentBlock := uint64(sdkCtx.BlockHeight())
-	
-	// Consider inquiries abandoned if they're past end time with no contracts
-	allEscrowRecords := k.GetAllEscrowRecords(ctx)
-	cleanedCount := 0
-	
-	for _, escrowRecord := range allEscrowRecords {
-		inquiry, found := k.GetHostingInquiry(ctx, escrowRecord.InquiryId)
-		if !found {
-			// Inquiry doesn't exist, clean up orphaned escrow
-			err := k.refundEscrowRecord(ctx, escrowRecord)
-			if err != nil {
-				k.Logger().Error("failed to refund orphaned escrow", 
-					"inquiry_id", escrowRecord.InquiryId,
-					"error", err)
-				continue
-			}
-			cleanedCount++
-			continue
-		}
-		
-		// Check if inquiry is expired and has no active contracts
-		if currentBlock > inquiry.EndTime {
-			contracts := k.GetAllHostingContract(ctx)
-			hasActiveContract := false
-			
-			for _, contract := range contracts {
-				if contract.InquiryId == inquiry.Id && currentBlock < contract.EndBlock {
-					hasActiveContract = true
-					break
-				}
-			}
-			
-			if !hasActiveContract {
-				// No active contracts, refund escrow
-				err := k.refundEscrowRecord(ctx, escrowRecord)
-				if err != nil {
-					k.Logger().Error("failed to refund abandoned escrow", 
-						"inquiry_id", escrowRecord.InquiryId,
-						"error", err)
-					continue
-				}
-				cleanedCount++
-			}
-		}
-	}
-	
-	k.Logger().Info("cleaned up abandoned escrow records", "count", cleanedCount)
-	return nil
-}
-
 // refundEscrowRecord handles the refund process for a single escrow record
-func (k Keeper) refundEscrowRecord(ctx context.Context, escrowRecord EscrowRecord) error {
+func (k Keeper) refundEscrowRecord(ctx context.Context, escrowRecord types.EscrowRecord) error {
 	// Convert creator address
 	creatorAddr, err := sdk.AccAddressFromBech32(escrowRecord.Creator)
 	if err != nil {
@@ -122,38 +40,9 @@ func (k Keeper) refundEscrowRecord(ctx context.Context, escrowRecord EscrowRecor
 	return nil
 }
 
-// CleanupExpiredContracts removes expired contracts and processes final payments
-func (k Keeper) CleanupExpiredContracts(ctx context.Context) error {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	currentBlock := uint64(sdkCtx.BlockHeight())
-	
-	allContracts := k.GetAllHostingContract(ctx)
-	cleanedCount := 0
-	
-	for _, contract := range allContracts {
-		if currentBlock >= contract.EndBlock {
-			// Process completion bonus if not already paid
-			paymentHistory, found := k.GetPaymentHistory(ctx, contract.Id)
-			if found && !paymentHistory.CompletionBonusPaid {
-				err := k.ProcessCompletionBonus(ctx, contract.Id)
-				if err != nil {
-					k.Logger().Error("failed to process completion bonus for expired contract",
-						"contract_id", contract.Id,
-						"error", err)
-				}
-			}
-			
-			// Note: We don't remove the contract itself as it serves as historical record
-			// The payment history cleanup will handle old payment records
-			cleanedCount++
-		}
-	}
-	
-	k.Logger().Info("processed expired contracts", "count", cleanedCount)
-	return nil
-}
-
 // CleanupOrphanedRecords removes records that reference non-existent entities
+// It walks every payment history and escrow record, so it is not scheduled
+// from BeginBlock
 func (k Keeper) CleanupOrphanedRecords(ctx context.Context) error {
 	k.Logger().Info("starting cleanup of orphaned records")
 	
@@ -196,22 +85,19 @@ func (k Keeper) CleanupOrphanedRecords(ctx context.Context) error {
 	return nil
 }
 
-// PerformMaintenanceCleanup runs all cleanup operations - designed to be called periodically
+// PerformMaintenanceCleanup works through the inquiry and payment history
+// expiry queues - designed to be called every block
 func (k Keeper) PerformMaintenanceCleanup(ctx context.Context) error {
-	k.Logger().Info("starting scheduled maintenance cleanup")
-	
-	// Run all cleanup operations
+	// Each operation takes at most MaxExpiriesPerBlock entries from its queue
 	cleanupOps := []struct {
 		name string
 		fn   func(context.Context) error
 	}{
-		{"expired_records", k.CleanupExpiredRecords},
-		{"expired_contracts", k.CleanupExpiredContracts},
-		{"orphaned_records", k.CleanupOrphanedRecords},
+		{"expired_inquiries", k.ProcessExpiredInquiries},
+		{"old_payment_history", k.CleanupOldPaymentHistory},
 	}
 	
 	for _, op := range cleanupOps {
-		k.Logger().Info("running cleanup operation", "operation", op.name)
 		err := op.fn(ctx)
 		if err != nil {
 			k.Logger().Error("cleanup operation failed", 
@@ -221,7 +107,6 @@ func (k Keeper) PerformMaintenanceCleanup(ctx context.Context) error {
 		}
 	}
 	
-	k.Logger().Info("completed scheduled maintenance cleanup")
 	return nil
 }
 
@@ -3,152 +3,188 @@ package keeper
 import (
 	"context"
 	"encoding/binary"
-	"encoding/json"
 	"fmt"
 
 	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
 )
 
-// EscrowRecord tracks escrowed funds for a specific inquiry
-type EscrowRecord struct {
-	InquiryId uint64   `json:"inquiry_id"`
-	Amount    sdk.Coin `json:"amount"`
-	Creator   string   `json:"creator"`
-}
-
-var (
-	EscrowKeyPrefix = []byte{0x01} // Prefix for escrow records
-)
-
-// EscrowKey returns the store key for escrow records
+// EscrowKey returns the store key of the escrow record of an inquiry
 func EscrowKey(inquiryId uint64) []byte {
-	key := make([]byte, len(EscrowKeyPrefix)+8)
-	copy(key, EscrowKeyPrefix)
-	binary.BigEndian.PutUint64(key[len(EscrowKeyPrefix):], inquiryId)
-	return key
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, inquiryId)
+	return bz
 }
 
 // SetEscrowRecord stores an escrow record for an inquiry
 func (k Keeper) SetEscrowRecord(ctx context.Context, inquiryId uint64, amount sdk.Coin, creator string) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	
-	record := EscrowRecord{
+	k.setEscrowRecord(ctx, types.EscrowRecord{
 		InquiryId: inquiryId,
 		Amount:    amount,
 		Creator:   creator,
-	}
-	
-	bz, err := json.Marshal(record)
-	if err != nil {
-		panic(err)
-	}
-	storeAdapter.Set(EscrowKey(inquiryId), bz)
+	})
+}
+
+func (k Keeper) setEscrowRecord(ctx context.Context, record types.EscrowRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EscrowRecordKey))
+	b := k.cdc.MustMarshal(&record)
+	store.Set(EscrowKey(record.InquiryId), b)
 }
 
 // GetEscrowRecord retrieves an escrow record for an inquiry
-func (k Keeper) GetEscrowRecord(ctx context.Context, inquiryId uint64) (EscrowRecord, bool) {
+func (k Keeper) GetEscrowRecord(ctx context.Context, inquiryId uint64) (types.EscrowRecord, bool) {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EscrowRecordKey))
 	
-	bz := storeAdapter.Get(EscrowKey(inquiryId))
+	bz := store.Get(EscrowKey(inquiryId))
 	if bz == nil {
-		return EscrowRecord{}, false
+		return types.EscrowRecord{}, false
 	}
 	
-	var record EscrowRecord
-	err := json.Unmarshal(bz, &record)
-	if err != nil {
-		return EscrowRecord{}, false
-	}
+	var record types.EscrowRecord
+	k.cdc.MustUnmarshal(bz, &record)
 	return record, true
 }
 
 // RemoveEscrowRecord removes an escrow record for an inquiry
 func (k Keeper) RemoveEscrowRecord(ctx context.Context, inquiryId uint64) {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	storeAdapter.Delete(EscrowKey(inquiryId))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EscrowRecordKey))
+	store.Delete(EscrowKey(inquiryId))
 }
 
 // GetAllEscrowRecords returns all escrow records
-func (k Keeper) GetAllEscrowRecords(ctx context.Context) []EscrowRecord {
+func (k Keeper) GetAllEscrowRecords(ctx context.Context) []types.EscrowRecord {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EscrowRecordKey))
 	
-	var records []EscrowRecord
-	iterator := storetypes.KVStorePrefixIterator(storeAdapter, EscrowKeyPrefix)
+	var records []types.EscrowRecord
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
 	defer iterator.Close()
 	
 	for ; iterator.Valid(); iterator.Next() {
-		var record EscrowRecord
-		err := json.Unmarshal(iterator.Value(), &record)
-		if err != nil {
-			continue // Skip invalid records
-		}
+		var record types.EscrowRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
 		records = append(records, record)
 	}
 	
 	return records
 }
 
-// ProcessExpiredInquiries checks for expired inquiries and refunds escrow automatically
+// ProcessExpiredInquiries takes the inquiries whose end time has passed off the
+// expiry queue and refunds the escrow their contracts did not pay out
+// automatically. An inquiry whose escrow cannot be settled keeps its escrow
+// record and is queued again for the next block.
 func (k Keeper) ProcessExpiredInquiries(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	currentBlock := uint64(sdkCtx.BlockHeight())
-	
-	// Get all hosting inquiries
-	allInquiries := k.GetAllHostingInquiry(ctx)
-	
-	for _, inquiry := range allInquiries {
-		// Check if inquiry has expired
-		if currentBlock > inquiry.EndTime {
-			// Get escrow record for this inquiry
-			escrowRecord, found := k.GetEscrowRecord(ctx, inquiry.Id)
-			if found {
-				// Convert creator address for refund
-				creatorAddr, err := sdk.AccAddressFromBech32(escrowRecord.Creator)
-				if err != nil {
-					k.Logger().Error("invalid creator address in escrow record", 
-						"inquiry_id", inquiry.Id, 
-						"creator", escrowRecord.Creator, 
-						"error", err)
-					continue
-				}
-
-				// Check if there are still active contracts for this inquiry
-				// TODO: Add logic to check active contracts before refunding
-				// For now, we'll refund expired inquiries
-
-				// Refund escrowed funds
-				err = k.RefundFunds(ctx, creatorAddr, escrowRecord.Amount)
-				if err != nil {
-					k.Logger().Error("failed to refund expired inquiry escrow", 
-						"inquiry_id", inquiry.Id, 
-						"amount", escrowRecord.Amount.String(), 
-						"error", err)
-					continue
-				}
-
-				// Remove escrow record
-				k.RemoveEscrowRecord(ctx, inquiry.Id)
-				
-				// Remove expired inquiry
-				k.RemoveHostingInquiry(sdkCtx, inquiry.Id)
-
-				k.Logger().Info("processed expired inquiry", 
-					"inquiry_id", inquiry.Id, 
-					"refunded_amount", escrowRecord.Amount.String())
-			}
+	params := k.GetParams(ctx)
+	
+	for _, entry := range k.getDueQueueEntries(ctx, types.HostingInquiryQueueKey, currentBlock, params.MaxExpiriesPerBlock) {
+		k.removeIndexEntry(ctx, types.HostingInquiryQueueKey, Uint64IndexPrefix(entry.height), entry.id)
+		
+		inquiry, found := k.GetHostingInquiry(ctx, entry.id)
+		if !found {
+			continue
+		}
+
+		// Ended inquiries take no more offers
+		k.removeIndexEntry(ctx, types.OpenHostingInquiryKey, nil, inquiry.Id)
+		
+		// An inquiry without escrow leaves nothing to refund
+		escrowRecord, found := k.GetEscrowRecord(ctx, inquiry.Id)
+		if !found {
+			continue
+		}
+		
+		// Contracts still running keep the escrow; look again after the last one ends
+		if lastEnd, running := k.getRunningContractsEnd(ctx, inquiry.Id); running {
+			k.setIndexEntry(ctx, types.HostingInquiryQueueKey, Uint64IndexPrefix(lastEnd), inquiry.Id)
+			continue
+		}
+
+		// Transfers are made on a cached context, so that an escrow that
+		// fails to settle is left untouched for the next attempt
+		cacheCtx, write := sdkCtx.CacheContext()
+		refund, dust, err := k.settleExpiredEscrow(cacheCtx, inquiry, escrowRecord)
+		if err != nil {
+			k.Logger().Error("failed to settle expired inquiry escrow, retrying next block",
+				"inquiry_id", inquiry.Id,
+				"error", err)
+			// Entries fall due in the block after their height
+			k.setIndexEntry(ctx, types.HostingInquiryQueueKey, Uint64IndexPrefix(currentBlock), inquiry.Id)
+			continue
 		}
+		write()
+
+		// Remove escrow record
+		k.RemoveEscrowRecord(ctx, inquiry.Id)
+		
+		// Remove expired inquiry
+		k.RemoveAuction(ctx, inquiry.Id)
+		k.RemoveHostingInquiry(sdkCtx, inquiry.Id)
+
+		k.Logger().Info("processed expired inquiry", 
+			"inquiry_id", inquiry.Id, 
+			"refunded_amount", refund.String(),
+			"dust", dust.String())
 	}
 
 	return nil
 }
 
+// settleExpiredEscrow refunds the escrow of an expired inquiry that its
+// contracts did not draw and sweeps the remainder of splitting it over the
+// replicas to the dust destination, or refunds that too if it cannot be swept
+func (k Keeper) settleExpiredEscrow(ctx context.Context, inquiry types.HostingInquiry, escrowRecord types.EscrowRecord) (refund, dust sdk.Coin, err error) {
+	creatorAddr, err := sdk.AccAddressFromBech32(escrowRecord.Creator)
+	if err != nil {
+		return refund, dust, fmt.Errorf("invalid creator address %s in escrow record: %w", escrowRecord.Creator, err)
+	}
+
+	// Refund what the contracts did not draw: the shares of contracts
+	// that ended early or never opened
+	outstanding := escrowRecord.Amount
+	for _, contract := range k.GetHostingContractsByInquiry(ctx, inquiry.Id) {
+		if history, found := k.GetPaymentHistory(ctx, contract.Id); found && history.TotalPaid.Denom == outstanding.Denom {
+			outstanding.Amount = outstanding.Amount.Sub(history.Drawn().Amount)
+		}
+	}
+
+	// The remainder of splitting the escrow over the replicas is swept to
+	// the dust destination once the rest has been refunded
+	dust = types.EscrowSplitRemainder(escrowRecord.Amount, inquiry.ReplicationRate)
+	if dust.Amount.GT(outstanding.Amount) {
+		dust.Amount = math.MaxInt(outstanding.Amount, math.ZeroInt())
+	}
+	refund = outstanding
+	refund.Amount = refund.Amount.Sub(dust.Amount)
+	if refund.IsPositive() {
+		if err := k.RefundFunds(ctx, creatorAddr, refund); err != nil {
+			return refund, dust, fmt.Errorf("failed to refund %s: %w", refund, err)
+		}
+	}
+	if err := k.sweepPaymentDust(ctx, escrowRecord, dust); err != nil {
+		k.Logger().Error("failed to sweep payment dust, refunding it",
+			"inquiry_id", inquiry.Id,
+			"amount", dust.String(),
+			"error", err)
+		if err := k.RefundFunds(ctx, creatorAddr, dust); err != nil {
+			return refund, dust, fmt.Errorf("failed to refund payment dust %s: %w", dust, err)
+		}
+	}
+	return refund, dust, nil
+}
+
 // GetEscrowRecordsByCreator returns all escrow records for a specific creator
-func (k Keeper) GetEscrowRecordsByCreator(ctx context.Context, creator string) []EscrowRecord {
+func (k Keeper) GetEscrowRecordsByCreator(ctx context.Context, creator string) []types.EscrowRecord {
 	allRecords := k.GetAllEscrowRecords(ctx)
-	var creatorRecords []EscrowRecord
+	var creatorRecords []types.EscrowRecord
 	
 	for _, record := range allRecords {
 		if record.Creator == creator {
@@ -160,12 +196,12 @@ func (k Keeper) GetEscrowRecordsByCreator(ctx context.Context, creator string) [
 }
 
 // GetActiveEscrowRecords returns all escrow records that are still active (not expired)
-func (k Keeper) GetActiveEscrowRecords(ctx context.Context) []EscrowRecord {
+func (k Keeper) GetActiveEscrowRecords(ctx context.Context) []types.EscrowRecord {
 	inquiries := k.GetAllHostingInquiry(ctx)
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	currentHeight := uint64(sdkCtx.BlockHeight())
 	
-	var activeRecords []EscrowRecord
+	var activeRecords []types.EscrowRecord
 	
 	for _, inquiry := range inquiries {
 		if currentHeight <= inquiry.EndTime {
@@ -187,13 +223,7 @@ func (k Keeper) UpdateEscrowAmount(ctx context.Context, inquiryId uint64, newAmo
 	}
 	
 	record.Amount = newAmount
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	
-	bz, err := json.Marshal(record)
-	if err != nil {
-		return fmt.Errorf("failed to marshal updated escrow record: %w", err)
-	}
-	storeAdapter.Set(EscrowKey(inquiryId), bz)
+	k.setEscrowRecord(ctx, record)
 	
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 
 	keepertest "github.com/hanshq/filespace-chain/testutil/keeper"
 	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
 )
 
 func TestSetGetEscrowRecord(t *testing.T) {
@@ -186,12 +187,12 @@ func TestGetActiveEscrowRecords(t *testing.T) {
 	// For now, we'll test the function doesn't panic and returns empty slice
 	activeRecords := k.GetActiveEscrowRecords(ctx)
 	require.NotNil(t, activeRecords)
-	require.IsType(t, []keeper.EscrowRecord{}, activeRecords)
+	require.IsType(t, []types.EscrowRecord{}, activeRecords)
 }
 
 func TestEscrowRecordStructure(t *testing.T) {
 	// Test the EscrowRecord structure
-	record := keeper.EscrowRecord{
+	record := types.EscrowRecord{
 		InquiryId: 123,
 		Amount:    sdk.NewCoin("utoken", math.NewInt(1000)),
 		Creator:   "cosmos1test",
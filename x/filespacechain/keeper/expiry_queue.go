@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+// Expiry queues work like the x/gov proposal queues: entries are keyed by the
+// height they fall due at, then by record id, so BeginBlock only iterates the
+// entries that are due. Each pass takes at most MaxExpiriesPerBlock entries
+// from a queue and leaves the rest for the following blocks.
+
+// queueEntry is a record id queued at a height
+type queueEntry struct {
+	height uint64
+	id     uint64
+}
+
+// getDueQueueEntries returns up to limit entries of a queue that are due
+// before the end height, in height then id order
+func (k Keeper) getDueQueueEntries(ctx context.Context, queue string, end uint64, limit uint64) (entries []queueEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(queue))
+	iterator := store.Iterator(nil, Uint64IndexPrefix(end))
+	defer iterator.Close()
+
+	for ; iterator.Valid() && uint64(len(entries)) < limit; iterator.Next() {
+		key := iterator.Key()
+		entries = append(entries, queueEntry{
+			height: binary.BigEndian.Uint64(key[:8]),
+			id:     binary.BigEndian.Uint64(key[8:]),
+		})
+	}
+	return
+}
+
+// setHostingContractQueues queues a PENDING contract until its acceptance
+// deadline and an ACTIVE contract until its end block
+func (k Keeper) setHostingContractQueues(ctx context.Context, contract types.HostingContract) {
+	switch contract.Status {
+	case types.CONTRACT_STATUS_PENDING:
+		if contract.AcceptanceDeadline != 0 {
+			k.setIndexEntry(ctx, types.PendingContractQueueKey, Uint64IndexPrefix(contract.AcceptanceDeadline), contract.Id)
+		}
+	case types.CONTRACT_STATUS_ACTIVE:
+		k.setIndexEntry(ctx, types.ActiveContractQueueKey, Uint64IndexPrefix(contract.EndBlock), contract.Id)
+	}
+}
+
+func (k Keeper) removeHostingContractQueues(ctx context.Context, contract types.HostingContract) {
+	k.removeIndexEntry(ctx, types.PendingContractQueueKey, Uint64IndexPrefix(contract.AcceptanceDeadline), contract.Id)
+	k.removeIndexEntry(ctx, types.ActiveContractQueueKey, Uint64IndexPrefix(contract.EndBlock), contract.Id)
+}
+
+// setPaymentHistoryQueue queues a settled payment history for pruning: one
+// that was paid a completion bonus, or whose contract reached a final status
+func (k Keeper) setPaymentHistoryQueue(ctx context.Context, paymentHistory types.PaymentHistory) {
+	if height, settled := k.paymentHistoryPruneHeight(ctx, paymentHistory); settled {
+		k.setIndexEntry(ctx, types.SettledPaymentHistoryQueueKey, Uint64IndexPrefix(height), paymentHistory.ContractId)
+	}
+}
+
+func (k Keeper) removePaymentHistoryQueue(ctx context.Context, paymentHistory types.PaymentHistory) {
+	if height, settled := k.paymentHistoryPruneHeight(ctx, paymentHistory); settled {
+		k.removeIndexEntry(ctx, types.SettledPaymentHistoryQueueKey, Uint64IndexPrefix(height), paymentHistory.ContractId)
+	}
+	k.removeIndexEntry(ctx, types.SettledPaymentHistoryQueueKey, Uint64IndexPrefix(paymentHistory.LastPaymentBlock), paymentHistory.ContractId)
+}
+
+// paymentHistoryPruneHeight returns the block a settled payment history is
+// queued at: its last payment, but not before the end block of its contract,
+// as the escrow of an inquiry is settled against the histories of contracts
+// that ended early until the inquiry expires
+func (k Keeper) paymentHistoryPruneHeight(ctx context.Context, paymentHistory types.PaymentHistory) (height uint64, settled bool) {
+	contract, found := k.GetHostingContract(ctx, paymentHistory.ContractId)
+	if found && contract.Status.IsFinal() {
+		return max(paymentHistory.LastPaymentBlock, contract.EndBlock), true
+	}
+	return paymentHistory.LastPaymentBlock, paymentHistory.CompletionBonusPaid
+}
+
+// getRunningContractsEnd returns the last end block of the contracts of an
+// inquiry that have not reached a final status yet
+func (k Keeper) getRunningContractsEnd(ctx context.Context, inquiryId uint64) (end uint64, running bool) {
+	for _, contract := range k.GetHostingContractsByInquiry(ctx, inquiryId) {
+		if contract.Status.IsFinal() {
+			continue
+		}
+		running = true
+		if contract.EndBlock > end {
+			end = contract.EndBlock
+		}
+	}
+	return
+}
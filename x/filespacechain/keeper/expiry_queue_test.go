@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/hanshq/filespace-chain/testutil/keeper"
+	"github.com/hanshq/filespace-chain/testutil/sample"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func TestProcessExpiredContractsRespectsCap(t *testing.T) {
+	k, ctx := keepertest.FilespacechainKeeper(t)
+	ctx = ctx.WithBlockHeight(20)
+	params := k.GetParams(ctx)
+	params.MaxExpiriesPerBlock = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	inquiryId := k.AppendHostingInquiry(ctx, types.HostingInquiry{EndTime: 10})
+	var ids []uint64
+	for i := 0; i < 3; i++ {
+		ids = append(ids, k.AppendHostingContract(ctx, types.HostingContract{
+			InquiryId:  inquiryId,
+			StartBlock: 1,
+			EndBlock:   uint64(10 + i),
+			Status:     types.CONTRACT_STATUS_ACTIVE,
+		}))
+	}
+
+	// The two earliest ending contracts complete, the third waits a block
+	require.NoError(t, k.ProcessExpiredContracts(ctx))
+	for i, status := range []types.ContractStatus{
+		types.CONTRACT_STATUS_COMPLETED,
+		types.CONTRACT_STATUS_COMPLETED,
+		types.CONTRACT_STATUS_ACTIVE,
+	} {
+		got, _ := k.GetHostingContract(ctx, ids[i])
+		require.Equal(t, status, got.Status)
+	}
+
+	require.NoError(t, k.ProcessExpiredContracts(ctx.WithBlockHeight(21)))
+	got, _ := k.GetHostingContract(ctx, ids[2])
+	require.Equal(t, types.CONTRACT_STATUS_COMPLETED, got.Status)
+}
+
+func TestProcessExpiredInquiriesWaitsForRunningContracts(t *testing.T) {
+	bank := keepertest.NewMockBankKeeper()
+	k, ctx := keepertest.FilespacechainKeeperWithBank(t, bank)
+	creator := sample.AccAddress()
+	escrow := sdk.NewCoin("token", math.NewInt(1000))
+
+	inquiryId := k.AppendHostingInquiry(ctx, types.HostingInquiry{Creator: creator, EndTime: 10})
+	k.SetEscrowRecord(ctx, inquiryId, escrow, creator)
+	bank.FundModule(types.ModuleName, sdk.NewCoins(escrow))
+	contract := types.HostingContract{InquiryId: inquiryId, StartBlock: 1, EndBlock: 30, Status: types.CONTRACT_STATUS_ACTIVE}
+	contract.Id = k.AppendHostingContract(ctx, contract)
+
+	// The inquiry expired, but its contract still runs on the escrow
+	require.NoError(t, k.ProcessExpiredInquiries(ctx.WithBlockHeight(20)))
+	_, found := k.GetEscrowRecord(ctx, inquiryId)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeight(25).WithEventManager(sdk.NewEventManager())
+	_, err := k.TransitionHostingContract(ctx, contract, types.CONTRACT_STATUS_TERMINATED, "cancelled")
+	require.NoError(t, err)
+
+	// The inquiry is looked at again once the contract's end block has passed
+	require.NoError(t, k.ProcessExpiredInquiries(ctx.WithBlockHeight(30)))
+	_, found = k.GetEscrowRecord(ctx, inquiryId)
+	require.True(t, found)
+
+	require.NoError(t, k.ProcessExpiredInquiries(ctx.WithBlockHeight(31)))
+	_, found = k.GetEscrowRecord(ctx, inquiryId)
+	require.False(t, found)
+	_, found = k.GetHostingInquiry(ctx, inquiryId)
+	require.False(t, found)
+	require.Equal(t, escrow, bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(creator), "token"))
+}
+
+func TestProcessExpiredInquiriesRetriesFailedRefunds(t *testing.T) {
+	bank := keepertest.NewMockBankKeeper()
+	k, ctx := keepertest.FilespacechainKeeperWithBank(t, bank)
+	ctx = ctx.WithBlockHeight(20)
+	creator := sample.AccAddress()
+	escrow := sdk.NewCoin("token", math.NewInt(1000))
+
+	// The module account does not hold the escrow it owes
+	inquiryId := k.AppendHostingInquiry(ctx, types.HostingInquiry{Creator: creator, EndTime: 10})
+	k.SetEscrowRecord(ctx, inquiryId, escrow, creator)
+	badId := k.AppendHostingInquiry(ctx, types.HostingInquiry{Creator: creator, EndTime: 10})
+	k.SetEscrowRecord(ctx, badId, escrow, "invalid")
+
+	// Neither escrow can be settled; both are kept and retried next block
+	require.NoError(t, k.ProcessExpiredInquiries(ctx))
+	for _, id := range []uint64{inquiryId, badId} {
+		_, found := k.GetEscrowRecord(ctx, id)
+		require.True(t, found)
+		_, found = k.GetHostingInquiry(ctx, id)
+		require.True(t, found)
+	}
+	require.True(t, bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(creator), "token").IsZero())
+
+	bank.FundModule(types.ModuleName, sdk.NewCoins(escrow))
+	require.NoError(t, k.ProcessExpiredInquiries(ctx.WithBlockHeight(21)))
+	_, found := k.GetEscrowRecord(ctx, inquiryId)
+	require.False(t, found)
+	require.Equal(t, escrow, bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(creator), "token"))
+
+	_, found = k.GetEscrowRecord(ctx, badId)
+	require.True(t, found)
+	require.NoError(t, k.ProcessExpiredInquiries(ctx.WithBlockHeight(22)))
+	_, found = k.GetEscrowRecord(ctx, badId)
+	require.True(t, found)
+}
+
+func TestCleanupCompletedPaymentHistoryQueue(t *testing.T) {
+	k, ctx := keepertest.FilespacechainKeeper(t)
+	ctx = ctx.WithBlockHeight(200)
+
+	k.SetPaymentHistory(ctx, types.PaymentHistory{ContractId: 1, LastPaymentBlock: 50, CompletionBonusPaid: true})
+	k.SetPaymentHistory(ctx, types.PaymentHistory{ContractId: 2, LastPaymentBlock: 50})
+	k.SetPaymentHistory(ctx, types.PaymentHistory{ContractId: 3, LastPaymentBlock: 150, CompletionBonusPaid: true})
+	// Paying the bonus later moves the history to the back of the queue
+	k.SetPaymentHistory(ctx, types.PaymentHistory{ContractId: 4, LastPaymentBlock: 50})
+	k.SetPaymentHistory(ctx, types.PaymentHistory{ContractId: 4, LastPaymentBlock: 180, CompletionBonusPaid: true})
+
+	require.NoError(t, k.CleanupCompletedPaymentHistory(ctx, 100))
+
+	_, found := k.GetPaymentHistory(ctx, 1)
+	require.False(t, found)
+	for _, id := range []uint64{2, 3, 4} {
+		_, found = k.GetPaymentHistory(ctx, id)
+		require.True(t, found)
+	}
+
+	// Nothing is old enough before the retention window has passed
+	require.NoError(t, k.CleanupCompletedPaymentHistory(ctx.WithBlockHeight(50), 100))
+	_, found = k.GetPaymentHistory(ctx, 3)
+	require.True(t, found)
+}
@@ -51,6 +51,7 @@ func (k Keeper) AppendFileEntry(
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FileEntryKey))
 	appendedValue := k.cdc.MustMarshal(&fileEntry)
 	store.Set(GetFileEntryIDBytes(fileEntry.Id), appendedValue)
+	k.setIndexEntry(ctx, types.FileEntryByCidKey, StringIndexPrefix(fileEntry.Cid), fileEntry.Id)
 
 	// Update fileEntry count
 	k.SetFileEntryCount(ctx, count+1)
@@ -58,12 +59,18 @@ func (k Keeper) AppendFileEntry(
 	return count
 }
 
-// SetFileEntry set a specific fileEntry in the store
+// SetFileEntry set a specific fileEntry in the store and moves its
+// index entry if the indexed field changed
 func (k Keeper) SetFileEntry(ctx context.Context, fileEntry types.FileEntry) {
+	if previous, found := k.GetFileEntry(ctx, fileEntry.Id); found {
+		k.removeIndexEntry(ctx, types.FileEntryByCidKey, StringIndexPrefix(previous.Cid), previous.Id)
+	}
+
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FileEntryKey))
 	b := k.cdc.MustMarshal(&fileEntry)
 	store.Set(GetFileEntryIDBytes(fileEntry.Id), b)
+	k.setIndexEntry(ctx, types.FileEntryByCidKey, StringIndexPrefix(fileEntry.Cid), fileEntry.Id)
 }
 
 // GetFileEntry returns a fileEntry from its id
@@ -78,8 +85,12 @@ func (k Keeper) GetFileEntry(ctx context.Context, id uint64) (val types.FileEntr
 	return val, true
 }
 
-// RemoveFileEntry removes a fileEntry from the store
+// RemoveFileEntry removes a fileEntry and its index entry from the store
 func (k Keeper) RemoveFileEntry(ctx context.Context, id uint64) {
+	if previous, found := k.GetFileEntry(ctx, id); found {
+		k.removeIndexEntry(ctx, types.FileEntryByCidKey, StringIndexPrefix(previous.Cid), id)
+	}
+
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FileEntryKey))
 	store.Delete(GetFileEntryIDBytes(id))
@@ -102,6 +113,16 @@ func (k Keeper) GetAllFileEntry(ctx context.Context) (list []types.FileEntry) {
 	return
 }
 
+// GetFileEntriesByCid returns the file entries registered for a CID in id order
+func (k Keeper) GetFileEntriesByCid(ctx context.Context, cid string) (list []types.FileEntry) {
+	for _, id := range k.getIndexedIds(ctx, types.FileEntryByCidKey, StringIndexPrefix(cid)) {
+		if fileEntry, found := k.GetFileEntry(ctx, id); found {
+			list = append(list, fileEntry)
+		}
+	}
+	return
+}
+
 // GetFileEntryIDBytes returns the byte representation of the ID
 func GetFileEntryIDBytes(id uint64) []byte {
 	bz := types.KeyPrefix(types.FileEntryKey)
@@ -110,21 +131,46 @@ func GetFileEntryIDBytes(id uint64) []byte {
 	return bz
 }
 
-// GetFileEntryByCid returns a fileEntry from its CID
+// GetFileEntryByCid returns the first fileEntry registered for a CID
 func (k Keeper) GetFileEntryByCid(ctx context.Context, cid string) (val types.FileEntry, found bool) {
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FileEntryKey))
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
-
-	defer iterator.Close()
+	ids := k.getIndexedIds(ctx, types.FileEntryByCidKey, StringIndexPrefix(cid))
+	if len(ids) == 0 {
+		return val, false
+	}
+	return k.GetFileEntry(ctx, ids[0])
+}
 
-	for ; iterator.Valid(); iterator.Next() {
-		var fileEntry types.FileEntry
-		k.cdc.MustUnmarshal(iterator.Value(), &fileEntry)
-		if fileEntry.Cid == cid {
+// resolveInquiryFileEntry returns the file entry an inquiry of creator for a
+// CID hosts: the creator's own entry for the CID, or the first one registered
+func (k Keeper) resolveInquiryFileEntry(ctx context.Context, cid, creator string) (val types.FileEntry, found bool) {
+	entries := k.GetFileEntriesByCid(ctx, cid)
+	for _, fileEntry := range entries {
+		if fileEntry.Creator == creator {
 			return fileEntry, true
 		}
 	}
+	if len(entries) == 0 {
+		return val, false
+	}
+	return entries[0], true
+}
+
+// GetInquiryFileEntry returns the file entry an inquiry is bound to, as long
+// as it still carries the inquiry's CID
+func (k Keeper) GetInquiryFileEntry(ctx context.Context, inquiry types.HostingInquiry) (val types.FileEntry, found bool) {
+	val, found = k.GetFileEntry(ctx, inquiry.FileEntryId)
+	if !found || val.Cid != inquiry.FileEntryCid {
+		return types.FileEntry{}, false
+	}
+	return val, true
+}
 
-	return val, false
+// getFileEntryHostingInquiry returns the id of an inquiry that hosts a file
+// entry, if any; contracts and challenges of that inquiry rely on the entry
+func (k Keeper) getFileEntryHostingInquiry(ctx context.Context, fileEntryId uint64) (uint64, bool) {
+	ids := k.getIndexedIds(ctx, types.HostingInquiryByFileEntryKey, Uint64IndexPrefix(fileEntryId))
+	if len(ids) == 0 {
+		return 0, false
+	}
+	return ids[0], true
 }
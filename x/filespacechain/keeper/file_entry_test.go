@@ -1,16 +1,30 @@
 package keeper_test
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
 	keepertest "github.com/hanshq/filespace-chain/testutil/keeper"
 	"github.com/hanshq/filespace-chain/testutil/nullify"
 	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
+	"github.com/hanshq/filespace-chain/x/filespacechain/merkle"
 	"github.com/hanshq/filespace-chain/x/filespacechain/types"
 	"github.com/stretchr/testify/require"
 )
 
+// committedFileEntry returns a file entry with a placeholder Merkle commitment
+// over chunks of 4096 bytes, enough for it to be hosted
+func committedFileEntry(cid string, fileSize uint64) types.FileEntry {
+	return types.FileEntry{
+		Cid:        cid,
+		FileSize:   fileSize,
+		MerkleRoot: bytes.Repeat([]byte{7}, merkle.HashSize),
+		ChunkCount: merkle.ChunkCount(fileSize, 4096),
+		ChunkSize:  4096,
+	}
+}
+
 func createNFileEntry(keeper keeper.Keeper, ctx context.Context, n int) []types.FileEntry {
 	items := make([]types.FileEntry, n)
 	for i := range items {
@@ -57,3 +71,23 @@ func TestFileEntryCount(t *testing.T) {
 	count := uint64(len(items))
 	require.Equal(t, count, keeper.GetFileEntryCount(ctx))
 }
+
+func TestFileEntriesByCid(t *testing.T) {
+	keeper, ctx := keepertest.FilespacechainKeeper(t)
+	first := keeper.AppendFileEntry(ctx, types.FileEntry{Cid: "QmA"})
+	second := keeper.AppendFileEntry(ctx, types.FileEntry{Cid: "QmB"})
+	third := keeper.AppendFileEntry(ctx, types.FileEntry{Cid: "QmA"})
+
+	entries := keeper.GetFileEntriesByCid(ctx, "QmA")
+	require.Len(t, entries, 2)
+	require.Equal(t, first, entries[0].Id)
+	require.Equal(t, third, entries[1].Id)
+
+	got, found := keeper.GetFileEntryByCid(ctx, "QmB")
+	require.True(t, found)
+	require.Equal(t, second, got.Id)
+
+	keeper.RemoveFileEntry(ctx, second)
+	_, found = keeper.GetFileEntryByCid(ctx, "QmB")
+	require.False(t, found)
+}
@@ -48,21 +48,13 @@ func (k Keeper) EscrowRecord(goCtx context.Context, req *types.QueryEscrowRecord
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	
-	// Get the keeper's EscrowRecord
-	keeperRecord, err := k.GetEscrowStatusForInquiry(ctx, req.InquiryId)
+	escrowRecord, err := k.GetEscrowStatusForInquiry(ctx, req.InquiryId)
 	if err != nil {
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 
-	// Convert keeper's EscrowRecord to protobuf EscrowRecord
-	protoRecord := types.EscrowRecord{
-		InquiryId: keeperRecord.InquiryId,
-		Amount:    keeperRecord.Amount,
-		Creator:   keeperRecord.Creator,
-	}
-
 	return &types.QueryEscrowRecordResponse{
-		EscrowRecord: protoRecord,
+		EscrowRecord: escrowRecord,
 	}, nil
 }
 
@@ -74,22 +66,8 @@ func (k Keeper) EscrowRecordAll(goCtx context.Context, req *types.QueryAllEscrow
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	
-	// Get the keeper's EscrowRecords
-	keeperRecords := k.GetAllEscrowStatuses(ctx)
-	
-	// Convert to protobuf EscrowRecords
-	var protoRecords []types.EscrowRecord
-	for _, keeperRecord := range keeperRecords {
-		protoRecord := types.EscrowRecord{
-			InquiryId: keeperRecord.InquiryId,
-			Amount:    keeperRecord.Amount,
-			Creator:   keeperRecord.Creator,
-		}
-		protoRecords = append(protoRecords, protoRecord)
-	}
-
 	return &types.QueryAllEscrowRecordResponse{
-		EscrowRecord: protoRecords,
+		EscrowRecord: k.GetAllEscrowStatuses(ctx),
 	}, nil
 }
 
@@ -101,21 +79,13 @@ func (k Keeper) ProviderStake(goCtx context.Context, req *types.QueryProviderSta
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	
-	// Get the keeper's ProviderStake
-	keeperStake, err := k.GetProviderStakeByAddress(ctx, req.Provider)
+	providerStake, err := k.GetProviderStakeByAddress(ctx, req.Provider)
 	if err != nil {
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 
-	// Convert keeper's ProviderStake to protobuf ProviderStake
-	protoStake := types.ProviderStake{
-		Provider: keeperStake.Provider,
-		Amount:   keeperStake.Amount,
-		Height:   keeperStake.Height,
-	}
-
 	return &types.QueryProviderStakeResponse{
-		ProviderStake: protoStake,
+		ProviderStake: providerStake,
 	}, nil
 }
 
@@ -127,21 +97,7 @@ func (k Keeper) ProviderStakeAll(goCtx context.Context, req *types.QueryAllProvi
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	
-	// Get the keeper's ProviderStakes
-	keeperStakes := k.GetAllProviderStakes(ctx)
-	
-	// Convert to protobuf ProviderStakes
-	var protoStakes []types.ProviderStake
-	for _, keeperStake := range keeperStakes {
-		protoStake := types.ProviderStake{
-			Provider: keeperStake.Provider,
-			Amount:   keeperStake.Amount,
-			Height:   keeperStake.Height,
-		}
-		protoStakes = append(protoStakes, protoStake)
-	}
-
 	return &types.QueryAllProviderStakeResponse{
-		ProviderStake: protoStakes,
+		ProviderStake: k.GetAllProviderStakes(ctx),
 	}, nil
 }
\ No newline at end of file
@@ -4,11 +4,15 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"strconv"
 
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/hanshq/filespace-chain/x/filespacechain/types"
 )
 
@@ -53,6 +57,7 @@ func (k Keeper) AppendHostingContract(
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.HostingContractKey))
 	appendedValue := k.cdc.MustMarshal(&hostingContract)
 	store.Set(GetHostingContractIDBytes(hostingContract.Id), appendedValue)
+	k.setHostingContractIndexes(ctx, hostingContract)
 
 	// Update hostingContract count
 	k.SetHostingContractCount(ctx, count+1)
@@ -60,12 +65,18 @@ func (k Keeper) AppendHostingContract(
 	return count
 }
 
-// SetHostingContract set a specific hostingContract in the store
+// SetHostingContract set a specific hostingContract in the store and moves its
+// index entries if an indexed field changed
 func (k Keeper) SetHostingContract(ctx context.Context, hostingContract types.HostingContract) {
+	if previous, found := k.GetHostingContract(ctx, hostingContract.Id); found {
+		k.removeHostingContractIndexes(ctx, previous)
+	}
+
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.HostingContractKey))
 	b := k.cdc.MustMarshal(&hostingContract)
 	store.Set(GetHostingContractIDBytes(hostingContract.Id), b)
+	k.setHostingContractIndexes(ctx, hostingContract)
 }
 
 // GetHostingContract returns a hostingContract from its id
@@ -80,8 +91,12 @@ func (k Keeper) GetHostingContract(ctx context.Context, id uint64) (val types.Ho
 	return val, true
 }
 
-// RemoveHostingContract removes a hostingContract from the store
+// RemoveHostingContract removes a hostingContract and its index entries from the store
 func (k Keeper) RemoveHostingContract(ctx context.Context, id uint64) {
+	if previous, found := k.GetHostingContract(ctx, id); found {
+		k.removeHostingContractIndexes(ctx, previous)
+	}
+
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.HostingContractKey))
 	store.Delete(GetHostingContractIDBytes(id))
@@ -104,6 +119,47 @@ func (k Keeper) GetAllHostingContract(ctx context.Context) (list []types.Hosting
 	return
 }
 
+// GetHostingContractsByInquiry returns the contracts of an inquiry in id order
+func (k Keeper) GetHostingContractsByInquiry(ctx context.Context, inquiryId uint64) []types.HostingContract {
+	return k.getHostingContracts(ctx, k.getIndexedIds(ctx, types.HostingContractByInquiryKey, Uint64IndexPrefix(inquiryId)))
+}
+
+// GetHostingContractsByProvider returns the contracts of a provider in id order
+func (k Keeper) GetHostingContractsByProvider(ctx context.Context, provider string) []types.HostingContract {
+	return k.getHostingContracts(ctx, k.getIndexedIds(ctx, types.HostingContractByProviderKey, StringIndexPrefix(provider)))
+}
+
+// GetHostingContractsEndingFrom returns the contracts whose end block is at or
+// after height, ordered by end block
+func (k Keeper) GetHostingContractsEndingFrom(ctx context.Context, height uint64) []types.HostingContract {
+	return k.getHostingContracts(ctx, k.getIndexedIdsInRange(ctx, types.HostingContractByEndBlockKey, Uint64IndexPrefix(height), nil))
+}
+
+func (k Keeper) getHostingContracts(ctx context.Context, ids []uint64) (list []types.HostingContract) {
+	for _, id := range ids {
+		if contract, found := k.GetHostingContract(ctx, id); found {
+			list = append(list, contract)
+		}
+	}
+	return
+}
+
+func (k Keeper) setHostingContractIndexes(ctx context.Context, contract types.HostingContract) {
+	k.setIndexEntry(ctx, types.HostingContractByInquiryKey, Uint64IndexPrefix(contract.InquiryId), contract.Id)
+	k.setIndexEntry(ctx, types.HostingContractByProviderKey, StringIndexPrefix(contract.Creator), contract.Id)
+	k.setIndexEntry(ctx, types.HostingContractByEndBlockKey, Uint64IndexPrefix(contract.EndBlock), contract.Id)
+	k.setChallengeableContractIndex(ctx, contract)
+	k.setHostingContractQueues(ctx, contract)
+}
+
+func (k Keeper) removeHostingContractIndexes(ctx context.Context, contract types.HostingContract) {
+	k.removeIndexEntry(ctx, types.HostingContractByInquiryKey, Uint64IndexPrefix(contract.InquiryId), contract.Id)
+	k.removeIndexEntry(ctx, types.HostingContractByProviderKey, StringIndexPrefix(contract.Creator), contract.Id)
+	k.removeIndexEntry(ctx, types.HostingContractByEndBlockKey, Uint64IndexPrefix(contract.EndBlock), contract.Id)
+	k.removeChallengeableContractIndex(ctx, contract.Id)
+	k.removeHostingContractQueues(ctx, contract)
+}
+
 // GetHostingContractIDBytes returns the byte representation of the ID
 func GetHostingContractIDBytes(id uint64) []byte {
 	bz := types.KeyPrefix(types.HostingContractKey)
@@ -112,13 +168,123 @@ func GetHostingContractIDBytes(id uint64) []byte {
 	return bz
 }
 
-// CompleteHostingContract marks a contract as completed and processes completion bonus
+// TransitionHostingContract moves a contract to the next status, persists it and
+// emits a typed event. Transitions not allowed by the contract lifecycle are rejected.
+func (k Keeper) TransitionHostingContract(ctx context.Context, contract types.HostingContract, next types.ContractStatus, reason string) (types.HostingContract, error) {
+	if !contract.Status.CanTransitionTo(next) {
+		return contract, errorsmod.Wrapf(types.ErrInvalidContractTransition,
+			"contract %d cannot move from %s to %s", contract.Id, contract.Status, next)
+	}
+	
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentHeight := uint64(sdkCtx.BlockHeight())
+	
+	previous := contract.Status
+	reachesFinal := !previous.IsFinal() && next.IsFinal()
+	
+	// A slashed contract has drawn part of its replica's share, so a
+	// replacement could not be paid in full: the replica stays filled and the
+	// rest of the share goes back to the inquiry creator
+	if reachesFinal && next == types.CONTRACT_STATUS_SLASHED {
+		if err := k.refundUndrawnShare(ctx, contract); err != nil {
+			return contract, err
+		}
+	}
+	
+	contract.Status = next
+	if next == types.CONTRACT_STATUS_SLASHED {
+		contract.SlashedBlock = currentHeight
+	}
+	k.SetHostingContract(ctx, contract)
+	
+	// A contract reaching a final status frees its capacity for new contracts
+	// of the provider, and its payment history can be pruned. Unless it was
+	// slashed, its replica is freed for new offers.
+	if reachesFinal {
+		if next != types.CONTRACT_STATUS_SLASHED {
+			k.addFilledReplicas(ctx, contract.InquiryId, -1)
+		}
+		k.releaseCapacity(ctx, contract.Creator, contract.ReservedCapacity)
+		if paymentHistory, found := k.GetPaymentHistory(ctx, contract.Id); found {
+			k.setPaymentHistoryQueue(ctx, paymentHistory)
+		}
+	}
+	
+	err := sdkCtx.EventManager().EmitTypedEvent(&types.EventHostingContractStatusChanged{
+		ContractId: contract.Id,
+		InquiryId:  contract.InquiryId,
+		Provider:   contract.Creator,
+		FromStatus: previous,
+		ToStatus:   next,
+		Height:     currentHeight,
+		Reason:     reason,
+	})
+	if err != nil {
+		return contract, err
+	}
+	
+	k.Logger().Info("hosting contract status changed",
+		"contract_id", contract.Id,
+		"from", previous.String(),
+		"to", next.String(),
+		"reason", reason,
+	)
+	
+	return contract, nil
+}
+
+// refundUndrawnShare refunds the part of a contract's escrow share it has not
+// drawn to the inquiry creator and records it as drawn, so the share is not
+// paid out again. Contracts of inquiries whose escrow is settled are skipped.
+func (k Keeper) refundUndrawnShare(ctx context.Context, contract types.HostingContract) error {
+	inquiry, found := k.GetHostingInquiry(ctx, contract.InquiryId)
+	if !found {
+		return nil
+	}
+	escrowRecord, found := k.GetEscrowRecord(ctx, inquiry.Id)
+	if !found {
+		return nil
+	}
+	creatorAddr, err := sdk.AccAddressFromBech32(escrowRecord.Creator)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid creator address in escrow record")
+	}
+	
+	paymentHistory, found := k.GetPaymentHistory(ctx, contract.Id)
+	if !found {
+		paymentHistory = types.PaymentHistory{
+			ContractId: contract.Id,
+			TotalPaid:  sdk.NewCoin(escrowRecord.Amount.Denom, math.ZeroInt()),
+		}
+	}
+	
+	share := types.ContractEscrowShare(escrowRecord.Amount, inquiry.ReplicationRate)
+	refund := sdk.NewCoin(share.Denom, math.MaxInt(share.Amount.Sub(paymentHistory.Drawn().Amount), math.ZeroInt()))
+	if refund.IsPositive() {
+		if err := k.RefundFunds(ctx, creatorAddr, refund); err != nil {
+			return errorsmod.Wrap(err, "failed to refund escrowed funds")
+		}
+	}
+	paymentHistory.AddRefund(refund)
+	paymentHistory.LastPaymentBlock = uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	k.SetPaymentHistory(ctx, paymentHistory)
+	return nil
+}
+
+// CompleteHostingContract processes the completion bonus of an active contract
+// that reached its end block and marks it as completed
 func (k Keeper) CompleteHostingContract(ctx context.Context, contractId uint64) error {
 	contract, found := k.GetHostingContract(ctx, contractId)
 	if !found {
 		return fmt.Errorf("contract %d not found", contractId)
 	}
 	
+	// Only active contracts can complete; terminated or slashed contracts never earn a bonus
+	if contract.Status != types.CONTRACT_STATUS_ACTIVE {
+		return errorsmod.Wrapf(types.ErrInvalidContractTransition,
+			"contract %d is %s and cannot complete", contractId, contract.Status)
+	}
+	
 	// Check if contract has already reached its end block
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	currentHeight := uint64(sdkCtx.BlockHeight())
@@ -128,17 +294,21 @@ func (k Keeper) CompleteHostingContract(ctx context.Context, contractId uint64)
 			contractId, currentHeight, contract.EndBlock)
 	}
 	
-	// Check if completion bonus has already been paid
+	// Process completion bonus unless it has already been paid
 	paymentHistory, found := k.GetPaymentHistory(ctx, contractId)
-	if found && paymentHistory.CompletionBonusPaid {
-		k.Logger().Info("completion bonus already paid for contract", "contract_id", contractId)
-		return nil
+	if !found || !paymentHistory.CompletionBonusPaid {
+		err := k.ProcessCompletionBonus(ctx, contractId)
+		if err != nil {
+			return fmt.Errorf("failed to process completion bonus for contract %d: %w", contractId, err)
+		}
 	}
 	
-	// Process completion bonus
-	err := k.ProcessCompletionBonus(ctx, contractId)
+	_, err := k.TransitionHostingContract(ctx, contract, types.CONTRACT_STATUS_COMPLETED, "end block reached")
 	if err != nil {
-		return fmt.Errorf("failed to process completion bonus for contract %d: %w", contractId, err)
+		return err
+	}
+	if err := k.recordReputationEvent(ctx, contract.Creator, types.REPUTATION_EVENT_CONTRACT_COMPLETED); err != nil {
+		return err
 	}
 	
 	k.Logger().Info("hosting contract completed successfully", 
@@ -150,55 +320,40 @@ func (k Keeper) CompleteHostingContract(ctx context.Context, contractId uint64)
 	return nil
 }
 
-// ProcessExpiredContracts processes all contracts that have passed their end block
-// This should be called periodically to clean up expired contracts and pay completion bonuses
+// ProcessExpiredContracts completes the active contracts that have reached their end block
+// Only ACTIVE contracts are queued, so they are the only ones eligible for a completion bonus
 func (k Keeper) ProcessExpiredContracts(ctx context.Context) error {
-	contracts := k.GetAllHostingContract(ctx)
-	
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	currentHeight := uint64(sdkCtx.BlockHeight())
+	params := k.GetParams(ctx)
 	
-	for _, contract := range contracts {
-		// Skip contracts that haven't ended yet
-		if currentHeight < contract.EndBlock {
-			continue
-		}
-		
-		// Check if completion bonus has already been paid
-		paymentHistory, found := k.GetPaymentHistory(ctx, contract.Id)
-		if found && paymentHistory.CompletionBonusPaid {
-			continue
-		}
-		
-		// Process completion bonus for expired contracts
-		err := k.ProcessCompletionBonus(ctx, contract.Id)
+	// Contracts ending at or before the current height are due
+	for _, entry := range k.getDueQueueEntries(ctx, types.ActiveContractQueueKey, currentHeight+1, params.MaxExpiriesPerBlock) {
+		// Completing the contract takes it off the queue; a failed one is retried next block
+		err := k.CompleteHostingContract(ctx, entry.id)
 		if err != nil {
-			k.Logger().Error("failed to process completion bonus for expired contract",
-				"contract_id", contract.Id,
+			k.Logger().Error("failed to complete expired contract",
+				"contract_id", entry.id,
 				"error", err,
 			)
 			continue
 		}
-		
-		k.Logger().Info("processed completion bonus for expired contract",
-			"contract_id", contract.Id,
-			"end_block", contract.EndBlock,
-			"current_block", currentHeight,
-		)
 	}
 	
 	return nil
 }
 
-// GetActiveContracts returns all contracts that are currently active (within their duration)
+// GetActiveContracts returns all contracts that are currently active (ACTIVE and within their duration)
 func (k Keeper) GetActiveContracts(ctx context.Context) (list []types.HostingContract) {
-	contracts := k.GetAllHostingContract(ctx)
-	
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	currentHeight := uint64(sdkCtx.BlockHeight())
 	
+	// Contracts that already ended are never active, skip them through the end block index
+	contracts := k.GetHostingContractsEndingFrom(ctx, currentHeight)
+	
 	for _, contract := range contracts {
-		if currentHeight >= contract.StartBlock && currentHeight <= contract.EndBlock {
+		if contract.Status == types.CONTRACT_STATUS_ACTIVE &&
+			currentHeight >= contract.StartBlock && currentHeight <= contract.EndBlock {
 			list = append(list, contract)
 		}
 	}
@@ -221,3 +376,285 @@ func (k Keeper) GetExpiredContracts(ctx context.Context) (list []types.HostingCo
 	
 	return
 }
+
+// OpenHostingContract creates a PENDING contract at the offer price for an
+// offer that matched an inquiry. The provider has AcceptanceDeadline blocks
+// to accept it.
+func (k Keeper) OpenHostingContract(ctx context.Context, inquiry types.HostingInquiry, offer types.HostingOffer) uint64 {
+	return k.openHostingContract(ctx, inquiry, offer, offer.PricePerBlock)
+}
+
+// openHostingContract creates a PENDING contract for an offer at a price per block
+func (k Keeper) openHostingContract(ctx context.Context, inquiry types.HostingInquiry, offer types.HostingOffer, price sdk.Coin) uint64 {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentHeight := uint64(sdkCtx.BlockHeight())
+	params := k.GetParams(ctx)
+	fileEntry, _ := k.GetInquiryFileEntry(ctx, inquiry)
+	
+	contract := types.HostingContract{
+		InquiryId:          inquiry.Id,
+		OfferId:            offer.Id,
+		Creator:            offer.Creator,
+		StartBlock:         currentHeight,
+		EndBlock:           inquiry.EndTime,
+		Status:             types.CONTRACT_STATUS_PENDING,
+		AcceptanceDeadline: currentHeight + params.AcceptanceDeadline,
+		AcceptanceChunk:    drawAcceptanceChunk(sdkCtx, k.GetHostingContractCount(ctx), fileEntry.ChunkCount),
+		PricePerBlock:      price,
+		ReservedCapacity:   fileEntry.FileSize,
+	}
+	id := k.AppendHostingContract(ctx, contract)
+	k.addFilledReplicas(ctx, inquiry.Id, 1)
+	k.reserveCapacity(ctx, offer.Creator, contract.ReservedCapacity)
+	
+	// Emit event for each contract creation
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"createHostingContract",
+			sdk.NewAttribute("ContractId", strconv.FormatUint(id, 10)),
+			sdk.NewAttribute("InquiryId", strconv.FormatUint(inquiry.Id, 10)),
+			sdk.NewAttribute("OfferId", strconv.FormatUint(offer.Id, 10)),
+			sdk.NewAttribute("FileEntryCID", inquiry.FileEntryCid),
+			sdk.NewAttribute("HosterId", offer.Creator),
+			sdk.NewAttribute("AcceptanceDeadline", strconv.FormatUint(contract.AcceptanceDeadline, 10)),
+		),
+	)
+	
+	return id
+}
+
+// ReassignContractSlot offers the slot of a contract the provider did not take
+// to the next-cheapest matching offer of a provider not contracted for the inquiry yet
+func (k Keeper) ReassignContractSlot(ctx context.Context, contract types.HostingContract) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	
+	inquiry, found := k.GetHostingInquiry(ctx, contract.InquiryId)
+	if !found {
+		return
+	}
+	
+	// No point in reopening a slot for an inquiry that has already ended
+	if uint64(sdkCtx.BlockHeight()) >= inquiry.EndTime {
+		return
+	}
+	
+	result, err := k.matchAndOpenContracts(ctx, inquiry, 1)
+	if err != nil || len(result.Matched) == 0 {
+		k.Logger().Info("no offer left to take over contract slot",
+			"contract_id", contract.Id,
+			"inquiry_id", inquiry.Id,
+		)
+		return
+	}
+	
+	k.Logger().Info("reassigned contract slot",
+		"previous_contract_id", contract.Id,
+		"offer_id", result.Matched[0].Id,
+	)
+}
+
+// reassignHostingContract hands a running contract over to the provider of
+// another offer. The contract keeps its terms and payment history, so the new
+// provider is paid what is left of it. A contract with a pending storage
+// challenge is not handed over until the challenge is answered or missed, so
+// that the provider it was issued to answers for it.
+func (k Keeper) reassignHostingContract(ctx context.Context, contract types.HostingContract, offer types.HostingOffer, reason string) (types.HostingContract, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	
+	if contract.Status.IsFinal() {
+		return contract, errorsmod.Wrapf(types.ErrInvalidContractTransition, "contract %d is %s", contract.Id, contract.Status)
+	}
+	if k.HasPendingStorageChallenge(ctx, contract.Id) {
+		return contract, errorsmod.Wrapf(types.ErrChallengePending, "contract %d", contract.Id)
+	}
+	inquiry, found := k.GetHostingInquiry(ctx, contract.InquiryId)
+	if !found {
+		return contract, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("inquiry %d doesn't exist", contract.InquiryId))
+	}
+	
+	// The new provider must be one the inquiry could have been matched to
+	if k.getContractedProviders(ctx, inquiry.Id)[offer.Creator] {
+		return contract, errorsmod.Wrapf(types.ErrOfferNotEligible, "offer %d: %s", offer.Id, types.OFFER_REJECTION_REASON_PROVIDER_CONTRACTED)
+	}
+	if rejection := k.CheckOfferEligibility(ctx, inquiry, offer); rejection != types.OFFER_REJECTION_REASON_UNSPECIFIED {
+		return contract, errorsmod.Wrapf(types.ErrOfferNotEligible, "offer %d: %s", offer.Id, rejection)
+	}
+	
+	from := contract.Creator
+	k.releaseCapacity(ctx, from, contract.ReservedCapacity)
+	k.reserveCapacity(ctx, offer.Creator, contract.ReservedCapacity)
+	
+	contract.Creator = offer.Creator
+	contract.OfferId = offer.Id
+	contract.TransferOfferId = 0
+	k.SetHostingContract(ctx, contract)
+	
+	err := sdkCtx.EventManager().EmitTypedEvent(&types.EventHostingContractReassigned{
+		ContractId:   contract.Id,
+		InquiryId:    contract.InquiryId,
+		FromProvider: from,
+		ToProvider:   offer.Creator,
+		OfferId:      offer.Id,
+		Reason:       reason,
+		Height:       uint64(sdkCtx.BlockHeight()),
+	})
+	return contract, err
+}
+
+// TerminateHostingContract ends an active contract before its end block and
+// settles it. The provider is paid the part of the contract's charge it earned
+// up to the current block. A provider walking away has its stake slashed; an
+// inquiry creator walking away pays the provider a penalty out of the charge
+// it did not earn. The rest of the contract's escrow share is refunded to the
+// inquiry creator. The terminated contract keeps its record and its replica,
+// as the replica's share has been paid out: a replacement is bought by raising
+// the inquiry's replication rate. Returns the settlement it emitted.
+func (k Keeper) TerminateHostingContract(ctx context.Context, contract types.HostingContract, terminatedBy string, reason string) (types.EventHostingContractTerminated, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentHeight := uint64(sdkCtx.BlockHeight())
+	params := k.GetParams(ctx)
+
+	if contract.Status != types.CONTRACT_STATUS_ACTIVE {
+		return types.EventHostingContractTerminated{}, errorsmod.Wrapf(types.ErrInvalidContractTransition,
+			"contract %d is %s and cannot be terminated", contract.Id, contract.Status)
+	}
+	if currentHeight >= contract.EndBlock {
+		return types.EventHostingContractTerminated{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"contract %d reached its end block %d", contract.Id, contract.EndBlock)
+	}
+
+	inquiry, found := k.GetHostingInquiry(ctx, contract.InquiryId)
+	if !found {
+		return types.EventHostingContractTerminated{}, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "inquiry %d doesn't exist", contract.InquiryId)
+	}
+	escrowRecord, found := k.GetEscrowRecord(ctx, inquiry.Id)
+	if !found {
+		return types.EventHostingContractTerminated{}, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "inquiry %d has no escrow", inquiry.Id)
+	}
+	providerAddr, err := sdk.AccAddressFromBech32(contract.Creator)
+	if err != nil {
+		return types.EventHostingContractTerminated{}, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid provider address")
+	}
+	creatorAddr, err := sdk.AccAddressFromBech32(escrowRecord.Creator)
+	if err != nil {
+		return types.EventHostingContractTerminated{}, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid creator address in escrow record")
+	}
+	byProvider := terminatedBy == contract.Creator
+
+	paymentHistory, found := k.GetPaymentHistory(ctx, contract.Id)
+	if !found {
+		paymentHistory = types.PaymentHistory{
+			ContractId:       contract.Id,
+			TotalPaid:        sdk.NewCoin(escrowRecord.Amount.Denom, math.ZeroInt()),
+			LastPaymentBlock: contract.StartBlock - 1,
+		}
+	}
+
+	// The provider earned its charge for every block it stored the file,
+	// completion bonus included; periodic payments never run ahead of that
+	share := types.ContractEscrowShare(escrowRecord.Amount, inquiry.ReplicationRate)
+	charge := types.ContractCharge(contract, share)
+	blocksElapsed := currentHeight - contract.StartBlock + 1
+	totalBlocks := contract.EndBlock - contract.StartBlock + 1
+	earned := math.LegacyNewDecFromInt(charge.Amount).
+		Mul(math.LegacyNewDec(int64(blocksElapsed))).
+		Quo(math.LegacyNewDec(int64(totalBlocks))).
+		TruncateInt()
+
+	settlement := types.EventHostingContractTerminated{
+		ContractId:   contract.Id,
+		InquiryId:    contract.InquiryId,
+		Provider:     contract.Creator,
+		TerminatedBy: terminatedBy,
+		Settled:      sdk.NewCoin(share.Denom, math.MaxInt(earned.Sub(paymentHistory.TotalPaid.Amount), math.ZeroInt())),
+		Penalty:      sdk.NewCoin(share.Denom, math.ZeroInt()),
+		Slashed:      sdk.NewCoins(),
+		Reason:       reason,
+		Height:       currentHeight,
+	}
+	if err := k.payProvider(ctx, contract.Id, providerAddr, settlement.Settled, types.PROVIDER_PAYMENT_KIND_TERMINATION_SETTLEMENT); err != nil {
+		return settlement, errorsmod.Wrap(err, "failed to settle contract")
+	}
+	paymentHistory.TotalPaid = paymentHistory.TotalPaid.Add(settlement.Settled)
+
+	if byProvider {
+		if params.ProviderTerminationSlashFraction.IsPositive() {
+			slashed, err := k.SlashProviderForInfraction(ctx, providerAddr, params.ProviderTerminationSlashFraction, currentHeight, contract.Id, "early termination")
+			if err != nil {
+				return settlement, errorsmod.Wrap(err, "failed to slash provider")
+			}
+			settlement.Slashed = slashed
+		}
+		if err := k.recordReputationEvent(ctx, contract.Creator, types.REPUTATION_EVENT_EARLY_TERMINATION); err != nil {
+			return settlement, err
+		}
+	} else {
+		settlement.Penalty.Amount = math.LegacyNewDecFromInt(charge.Amount.Sub(earned)).
+			Mul(params.ClientTerminationPenaltyFraction).
+			TruncateInt()
+		if err := k.payProvider(ctx, contract.Id, providerAddr, settlement.Penalty, types.PROVIDER_PAYMENT_KIND_TERMINATION_PENALTY); err != nil {
+			return settlement, errorsmod.Wrap(err, "failed to pay termination penalty")
+		}
+		paymentHistory.TotalPaid = paymentHistory.TotalPaid.Add(settlement.Penalty)
+	}
+
+	// The rest of the share goes back to the inquiry creator and counts as
+	// drawn, so the share is not refunded again
+	settlement.Refunded = sdk.NewCoin(share.Denom, math.MaxInt(share.Amount.Sub(paymentHistory.Drawn().Amount), math.ZeroInt()))
+	if settlement.Refunded.IsPositive() {
+		if err := k.RefundFunds(ctx, creatorAddr, settlement.Refunded); err != nil {
+			return settlement, errorsmod.Wrap(err, "failed to refund escrowed funds")
+		}
+	}
+	paymentHistory.AddRefund(settlement.Refunded)
+	paymentHistory.LastPaymentBlock = currentHeight
+	k.SetPaymentHistory(ctx, paymentHistory)
+
+	if _, err := k.TransitionHostingContract(ctx, contract, types.CONTRACT_STATUS_TERMINATED, reason); err != nil {
+		return settlement, err
+	}
+	// Keep the replica filled: its share is spent and must not be matched again
+	k.addFilledReplicas(ctx, contract.InquiryId, 1)
+
+	return settlement, sdkCtx.EventManager().EmitTypedEvent(&settlement)
+}
+
+// ProcessAcceptanceDeadlines terminates PENDING contracts whose provider did not
+// accept in time and hands their slot to the next-cheapest offer
+func (k Keeper) ProcessAcceptanceDeadlines(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentHeight := uint64(sdkCtx.BlockHeight())
+	params := k.GetParams(ctx)
+	
+	// Contracts whose deadline lies before the current height are due
+	for _, entry := range k.getDueQueueEntries(ctx, types.PendingContractQueueKey, currentHeight, params.MaxExpiriesPerBlock) {
+		contract, found := k.GetHostingContract(ctx, entry.id)
+		if !found {
+			continue
+		}
+		
+		contract, err := k.TransitionHostingContract(ctx, contract, types.CONTRACT_STATUS_TERMINATED, "acceptance deadline passed")
+		if err != nil {
+			return err
+		}
+		if err := k.recordReputationEvent(ctx, contract.Creator, types.REPUTATION_EVENT_EARLY_TERMINATION); err != nil {
+			k.Logger().Error("failed to record missed acceptance in provider reputation",
+				"contract_id", contract.Id,
+				"provider", contract.Creator,
+				"error", err,
+			)
+		}
+		k.ReassignContractSlot(ctx, contract)
+	}
+	
+	return nil
+}
+
+// getContractedProviders returns the providers that hold or held a contract for an inquiry
+func (k Keeper) getContractedProviders(ctx context.Context, inquiryId uint64) map[string]bool {
+	contracted := make(map[string]bool)
+	for _, contract := range k.GetHostingContractsByInquiry(ctx, inquiryId) {
+		contracted[contract.Creator] = true
+	}
+	return contracted
+}
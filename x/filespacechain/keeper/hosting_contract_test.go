@@ -4,6 +4,8 @@ import (
 	"context"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	keepertest "github.com/hanshq/filespace-chain/testutil/keeper"
 	"github.com/hanshq/filespace-chain/testutil/nullify"
 	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
@@ -57,3 +59,99 @@ func TestHostingContractCount(t *testing.T) {
 	count := uint64(len(items))
 	require.Equal(t, count, keeper.GetHostingContractCount(ctx))
 }
+
+func TestTransitionHostingContract(t *testing.T) {
+	keeper, ctx := keepertest.FilespacechainKeeper(t)
+	ctx = ctx.WithBlockHeight(5).WithEventManager(sdk.NewEventManager())
+	contract := types.HostingContract{StartBlock: 1, EndBlock: 10}
+	contract.Id = keeper.AppendHostingContract(ctx, contract)
+
+	// PENDING cannot complete
+	_, err := keeper.TransitionHostingContract(ctx, contract, types.CONTRACT_STATUS_COMPLETED, "")
+	require.ErrorIs(t, err, types.ErrInvalidContractTransition)
+
+	contract, err = keeper.TransitionHostingContract(ctx, contract, types.CONTRACT_STATUS_ACTIVE, "accepted")
+	require.NoError(t, err)
+	contract, err = keeper.TransitionHostingContract(ctx, contract, types.CONTRACT_STATUS_SLASHED, "proof failed")
+	require.NoError(t, err)
+
+	got, found := keeper.GetHostingContract(ctx, contract.Id)
+	require.True(t, found)
+	require.Equal(t, types.CONTRACT_STATUS_SLASHED, got.Status)
+	require.Equal(t, uint64(5), got.SlashedBlock)
+
+	// Final statuses are final
+	_, err = keeper.TransitionHostingContract(ctx, got, types.CONTRACT_STATUS_ACTIVE, "")
+	require.ErrorIs(t, err, types.ErrInvalidContractTransition)
+
+	var transitions int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "filespacechain.filespacechain.EventHostingContractStatusChanged" {
+			transitions++
+		}
+	}
+	require.Equal(t, 2, transitions)
+}
+
+func TestProcessExpiredContractsCompletesOnlyActive(t *testing.T) {
+	keeper, ctx := keepertest.FilespacechainKeeper(t)
+	ctx = ctx.WithBlockHeight(20)
+	inquiryId := keeper.AppendHostingInquiry(ctx, types.HostingInquiry{EndTime: 10})
+
+	active := types.HostingContract{InquiryId: inquiryId, StartBlock: 1, EndBlock: 10, Status: types.CONTRACT_STATUS_ACTIVE}
+	active.Id = keeper.AppendHostingContract(ctx, active)
+	running := types.HostingContract{InquiryId: inquiryId, StartBlock: 1, EndBlock: 30, Status: types.CONTRACT_STATUS_ACTIVE}
+	running.Id = keeper.AppendHostingContract(ctx, running)
+	terminated := types.HostingContract{InquiryId: inquiryId, StartBlock: 1, EndBlock: 10, Status: types.CONTRACT_STATUS_TERMINATED}
+	terminated.Id = keeper.AppendHostingContract(ctx, terminated)
+
+	require.NoError(t, keeper.ProcessExpiredContracts(ctx))
+
+	got, _ := keeper.GetHostingContract(ctx, active.Id)
+	require.Equal(t, types.CONTRACT_STATUS_COMPLETED, got.Status)
+	got, _ = keeper.GetHostingContract(ctx, running.Id)
+	require.Equal(t, types.CONTRACT_STATUS_ACTIVE, got.Status)
+	got, _ = keeper.GetHostingContract(ctx, terminated.Id)
+	require.Equal(t, types.CONTRACT_STATUS_TERMINATED, got.Status)
+
+	// A terminated contract never pays a completion bonus
+	require.Error(t, keeper.CompleteHostingContract(ctx, terminated.Id))
+	require.Error(t, keeper.ProcessCompletionBonus(ctx, terminated.Id))
+}
+
+func TestHostingContractIndexes(t *testing.T) {
+	keeper, ctx := keepertest.FilespacechainKeeper(t)
+	ctx = ctx.WithBlockHeight(50)
+
+	first := types.HostingContract{InquiryId: 1, Creator: "provider-a", StartBlock: 1, EndBlock: 40, Status: types.CONTRACT_STATUS_ACTIVE}
+	first.Id = keeper.AppendHostingContract(ctx, first)
+	second := types.HostingContract{InquiryId: 1, Creator: "provider-b", StartBlock: 1, EndBlock: 60, Status: types.CONTRACT_STATUS_ACTIVE}
+	second.Id = keeper.AppendHostingContract(ctx, second)
+	third := types.HostingContract{InquiryId: 2, Creator: "provider-a", StartBlock: 1, EndBlock: 80, Status: types.CONTRACT_STATUS_ACTIVE}
+	third.Id = keeper.AppendHostingContract(ctx, third)
+
+	require.Equal(t, []uint64{first.Id, second.Id}, contractIds(keeper.GetHostingContractsByInquiry(ctx, 1)))
+	require.Equal(t, []uint64{first.Id, third.Id}, contractIds(keeper.GetHostingContractsByProvider(ctx, "provider-a")))
+	require.Equal(t, []uint64{second.Id, third.Id}, contractIds(keeper.GetHostingContractsEndingFrom(ctx, 50)))
+	require.Equal(t, []uint64{second.Id, third.Id}, contractIds(keeper.GetActiveContracts(ctx)))
+
+	// Updating an indexed field moves the index entries
+	third.Creator = "provider-b"
+	third.EndBlock = 45
+	keeper.SetHostingContract(ctx, third)
+	require.Equal(t, []uint64{first.Id}, contractIds(keeper.GetHostingContractsByProvider(ctx, "provider-a")))
+	require.Equal(t, []uint64{second.Id, third.Id}, contractIds(keeper.GetHostingContractsByProvider(ctx, "provider-b")))
+	require.Equal(t, []uint64{second.Id}, contractIds(keeper.GetHostingContractsEndingFrom(ctx, 50)))
+
+	keeper.RemoveHostingContract(ctx, second.Id)
+	require.Equal(t, []uint64{first.Id}, contractIds(keeper.GetHostingContractsByInquiry(ctx, 1)))
+	require.Equal(t, []uint64{third.Id}, contractIds(keeper.GetHostingContractsByProvider(ctx, "provider-b")))
+	require.Empty(t, keeper.GetHostingContractsEndingFrom(ctx, 50))
+}
+
+func contractIds(contracts []types.HostingContract) (ids []uint64) {
+	for _, contract := range contracts {
+		ids = append(ids, contract.Id)
+	}
+	return
+}
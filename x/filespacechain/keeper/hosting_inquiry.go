@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/hanshq/filespace-chain/x/filespacechain/types"
 )
 
@@ -51,6 +52,7 @@ func (k Keeper) AppendHostingInquiry(
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.HostingInquiryKey))
 	appendedValue := k.cdc.MustMarshal(&hostingInquiry)
 	store.Set(GetHostingInquiryIDBytes(hostingInquiry.Id), appendedValue)
+	k.setHostingInquiryIndexes(ctx, hostingInquiry)
 
 	// Update hostingInquiry count
 	k.SetHostingInquiryCount(ctx, count+1)
@@ -58,12 +60,18 @@ func (k Keeper) AppendHostingInquiry(
 	return count
 }
 
-// SetHostingInquiry set a specific hostingInquiry in the store
+// SetHostingInquiry set a specific hostingInquiry in the store and moves its
+// index and queue entries if the indexed fields changed
 func (k Keeper) SetHostingInquiry(ctx context.Context, hostingInquiry types.HostingInquiry) {
+	if previous, found := k.GetHostingInquiry(ctx, hostingInquiry.Id); found {
+		k.removeHostingInquiryIndexes(ctx, previous)
+	}
+
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.HostingInquiryKey))
 	b := k.cdc.MustMarshal(&hostingInquiry)
 	store.Set(GetHostingInquiryIDBytes(hostingInquiry.Id), b)
+	k.setHostingInquiryIndexes(ctx, hostingInquiry)
 }
 
 // GetHostingInquiry returns a hostingInquiry from its id
@@ -78,8 +86,12 @@ func (k Keeper) GetHostingInquiry(ctx context.Context, id uint64) (val types.Hos
 	return val, true
 }
 
-// RemoveHostingInquiry removes a hostingInquiry from the store
+// RemoveHostingInquiry removes a hostingInquiry and its index and queue entries from the store
 func (k Keeper) RemoveHostingInquiry(ctx context.Context, id uint64) {
+	if previous, found := k.GetHostingInquiry(ctx, id); found {
+		k.removeHostingInquiryIndexes(ctx, previous)
+	}
+
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.HostingInquiryKey))
 	store.Delete(GetHostingInquiryIDBytes(id))
@@ -102,6 +114,76 @@ func (k Keeper) GetAllHostingInquiry(ctx context.Context) (list []types.HostingI
 	return
 }
 
+// GetHostingInquiriesByCreator returns the inquiries of a creator in id order
+func (k Keeper) GetHostingInquiriesByCreator(ctx context.Context, creator string) (list []types.HostingInquiry) {
+	for _, id := range k.getIndexedIds(ctx, types.HostingInquiryByCreatorKey, StringIndexPrefix(creator)) {
+		if inquiry, found := k.GetHostingInquiry(ctx, id); found {
+			list = append(list, inquiry)
+		}
+	}
+	return
+}
+
+// GetOpenHostingInquiries returns the inquiries that have not ended and have
+// unfilled replicas in id order, oldest first. Inquiries that end without
+// being touched leave the index when they expire.
+func (k Keeper) GetOpenHostingInquiries(ctx context.Context) (list []types.HostingInquiry) {
+	for _, id := range k.getIndexedIds(ctx, types.OpenHostingInquiryKey, nil) {
+		if inquiry, found := k.GetHostingInquiry(ctx, id); found {
+			list = append(list, inquiry)
+		}
+	}
+	return
+}
+
+// addFilledReplicas adjusts the number of replicas an inquiry has filled by
+// delta, reopening or closing it to new offers
+func (k Keeper) addFilledReplicas(ctx context.Context, inquiryId uint64, delta int64) {
+	inquiry, found := k.GetHostingInquiry(ctx, inquiryId)
+	if !found {
+		return
+	}
+	filled := int64(inquiry.FilledReplicas) + delta
+	if filled < 0 {
+		filled = 0
+	}
+	inquiry.FilledReplicas = uint64(filled)
+
+	// Only the open index follows the replica count; the expiry queue entry
+	// may have been moved past the end time and stays where it is
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.HostingInquiryKey))
+	store.Set(GetHostingInquiryIDBytes(inquiry.Id), k.cdc.MustMarshal(&inquiry))
+	if k.isOpenHostingInquiry(ctx, inquiry) {
+		k.setIndexEntry(ctx, types.OpenHostingInquiryKey, nil, inquiry.Id)
+	} else {
+		k.removeIndexEntry(ctx, types.OpenHostingInquiryKey, nil, inquiry.Id)
+	}
+}
+
+func (k Keeper) setHostingInquiryIndexes(ctx context.Context, inquiry types.HostingInquiry) {
+	k.setIndexEntry(ctx, types.HostingInquiryByCreatorKey, StringIndexPrefix(inquiry.Creator), inquiry.Id)
+	k.setIndexEntry(ctx, types.HostingInquiryByFileEntryKey, Uint64IndexPrefix(inquiry.FileEntryId), inquiry.Id)
+	k.setIndexEntry(ctx, types.HostingInquiryQueueKey, Uint64IndexPrefix(inquiry.EndTime), inquiry.Id)
+	if k.isOpenHostingInquiry(ctx, inquiry) {
+		k.setIndexEntry(ctx, types.OpenHostingInquiryKey, nil, inquiry.Id)
+	}
+}
+
+// isOpenHostingInquiry reports whether an inquiry still takes offers: it has
+// not ended and is short of its replication rate
+func (k Keeper) isOpenHostingInquiry(ctx context.Context, inquiry types.HostingInquiry) bool {
+	currentHeight := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	return inquiry.EndTime > currentHeight && inquiry.FilledReplicas < inquiry.ReplicationRate
+}
+
+func (k Keeper) removeHostingInquiryIndexes(ctx context.Context, inquiry types.HostingInquiry) {
+	k.removeIndexEntry(ctx, types.HostingInquiryByCreatorKey, StringIndexPrefix(inquiry.Creator), inquiry.Id)
+	k.removeIndexEntry(ctx, types.HostingInquiryByFileEntryKey, Uint64IndexPrefix(inquiry.FileEntryId), inquiry.Id)
+	k.removeIndexEntry(ctx, types.HostingInquiryQueueKey, Uint64IndexPrefix(inquiry.EndTime), inquiry.Id)
+	k.removeIndexEntry(ctx, types.OpenHostingInquiryKey, nil, inquiry.Id)
+}
+
 // GetHostingInquiryIDBytes returns the byte representation of the ID
 func GetHostingInquiryIDBytes(id uint64) []byte {
 	bz := types.KeyPrefix(types.HostingInquiryKey)
@@ -57,3 +57,25 @@ func TestHostingInquiryCount(t *testing.T) {
 	count := uint64(len(items))
 	require.Equal(t, count, keeper.GetHostingInquiryCount(ctx))
 }
+
+func TestHostingInquiriesByCreator(t *testing.T) {
+	keeper, ctx := keepertest.FilespacechainKeeper(t)
+	first := keeper.AppendHostingInquiry(ctx, types.HostingInquiry{Creator: "alice"})
+	second := keeper.AppendHostingInquiry(ctx, types.HostingInquiry{Creator: "bob"})
+	third := keeper.AppendHostingInquiry(ctx, types.HostingInquiry{Creator: "alice"})
+
+	inquiries := keeper.GetHostingInquiriesByCreator(ctx, "alice")
+	require.Len(t, inquiries, 2)
+	require.Equal(t, first, inquiries[0].Id)
+	require.Equal(t, third, inquiries[1].Id)
+
+	// Changing the creator moves the index entry
+	keeper.SetHostingInquiry(ctx, types.HostingInquiry{Id: third, Creator: "bob"})
+	require.Len(t, keeper.GetHostingInquiriesByCreator(ctx, "alice"), 1)
+	require.Len(t, keeper.GetHostingInquiriesByCreator(ctx, "bob"), 2)
+
+	keeper.RemoveHostingInquiry(ctx, second)
+	inquiries = keeper.GetHostingInquiriesByCreator(ctx, "bob")
+	require.Len(t, inquiries, 1)
+	require.Equal(t, third, inquiries[0].Id)
+}
@@ -51,6 +51,7 @@ func (k Keeper) AppendHostingOffer(
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.HostingOfferKey))
 	appendedValue := k.cdc.MustMarshal(&hostingOffer)
 	store.Set(GetHostingOfferIDBytes(hostingOffer.Id), appendedValue)
+	k.setHostingOfferIndexes(ctx, hostingOffer)
 
 	// Update hostingOffer count
 	k.SetHostingOfferCount(ctx, count+1)
@@ -58,12 +59,18 @@ func (k Keeper) AppendHostingOffer(
 	return count
 }
 
-// SetHostingOffer set a specific hostingOffer in the store
+// SetHostingOffer set a specific hostingOffer in the store and moves its
+// index entries if the indexed fields changed
 func (k Keeper) SetHostingOffer(ctx context.Context, hostingOffer types.HostingOffer) {
+	if previous, found := k.GetHostingOffer(ctx, hostingOffer.Id); found {
+		k.removeHostingOfferIndexes(ctx, previous)
+	}
+
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.HostingOfferKey))
 	b := k.cdc.MustMarshal(&hostingOffer)
 	store.Set(GetHostingOfferIDBytes(hostingOffer.Id), b)
+	k.setHostingOfferIndexes(ctx, hostingOffer)
 }
 
 // GetHostingOffer returns a hostingOffer from its id
@@ -78,8 +85,12 @@ func (k Keeper) GetHostingOffer(ctx context.Context, id uint64) (val types.Hosti
 	return val, true
 }
 
-// RemoveHostingOffer removes a hostingOffer from the store
+// RemoveHostingOffer removes a hostingOffer and its index entries from the store
 func (k Keeper) RemoveHostingOffer(ctx context.Context, id uint64) {
+	if previous, found := k.GetHostingOffer(ctx, id); found {
+		k.removeHostingOfferIndexes(ctx, previous)
+	}
+
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.HostingOfferKey))
 	store.Delete(GetHostingOfferIDBytes(id))
@@ -102,6 +113,26 @@ func (k Keeper) GetAllHostingOffer(ctx context.Context) (list []types.HostingOff
 	return
 }
 
+// getHostingOfferIdsByPrice returns up to limit ids of the offers priced in a
+// denom, cheapest first, with a price per block of at most maxPrice; a zero
+// maxPrice does not cap the price
+func (k Keeper) getHostingOfferIdsByPrice(ctx context.Context, denom string, maxPrice uint64, limit uint64) []uint64 {
+	start := DenomIndexPrefix(denom)
+	end := storetypes.PrefixEndBytes(start)
+	if maxPrice != 0 {
+		end = storetypes.PrefixEndBytes(append(DenomIndexPrefix(denom), Uint64IndexPrefix(maxPrice)...))
+	}
+	return k.getLimitedIndexedIds(ctx, types.HostingOfferByPriceKey, start, end, limit)
+}
+
+func (k Keeper) setHostingOfferIndexes(ctx context.Context, offer types.HostingOffer) {
+	k.setIndexEntry(ctx, types.HostingOfferByPriceKey, OfferPriceIndexPrefix(offer.PricePerBlock), offer.Id)
+}
+
+func (k Keeper) removeHostingOfferIndexes(ctx context.Context, offer types.HostingOffer) {
+	k.removeIndexEntry(ctx, types.HostingOfferByPriceKey, OfferPriceIndexPrefix(offer.PricePerBlock), offer.Id)
+}
+
 // GetHostingOfferIDBytes returns the byte representation of the ID
 func GetHostingOfferIDBytes(id uint64) []byte {
 	bz := types.KeyPrefix(types.HostingOfferKey)
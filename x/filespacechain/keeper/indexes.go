@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	stdmath "math"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+// Secondary indexes map a field of a record to the ids of the records holding
+// it. Index keys are the indexed value followed by the big-endian record id,
+// so iterating a value's prefix yields its records in id order. Values end
+// with the big-endian record id.
+
+// StringIndexPrefix returns the index prefix of a string value such as an address
+func StringIndexPrefix(value string) []byte {
+	return append([]byte(value), '/')
+}
+
+// Uint64IndexPrefix returns the index prefix of a numeric value such as an id or height
+func Uint64IndexPrefix(value uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, value)
+}
+
+// DenomIndexPrefix returns the index prefix of a denom. Denoms may contain
+// slashes, so they are prefixed with their length rather than terminated.
+func DenomIndexPrefix(denom string) []byte {
+	return append([]byte{byte(len(denom))}, denom...)
+}
+
+// OfferPriceIndexPrefix returns the index prefix of a price: its denom, then
+// its amount. Amounts beyond a uint64 sort last.
+func OfferPriceIndexPrefix(price sdk.Coin) []byte {
+	amount := uint64(stdmath.MaxUint64)
+	if !price.Amount.IsNil() && price.Amount.IsUint64() {
+		amount = price.Amount.Uint64()
+	}
+	return append(DenomIndexPrefix(price.Denom), Uint64IndexPrefix(amount)...)
+}
+
+func indexKey(valuePrefix []byte, id uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, valuePrefix...), id)
+}
+
+func (k Keeper) setIndexEntry(ctx context.Context, index string, valuePrefix []byte, id uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(index))
+	store.Set(indexKey(valuePrefix, id), binary.BigEndian.AppendUint64(nil, id))
+}
+
+func (k Keeper) removeIndexEntry(ctx context.Context, index string, valuePrefix []byte, id uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(index))
+	store.Delete(indexKey(valuePrefix, id))
+}
+
+// getIndexedIds collects record ids from a secondary index under a value prefix
+func (k Keeper) getIndexedIds(ctx context.Context, index string, valuePrefix []byte) []uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(index))
+	iterator := storetypes.KVStorePrefixIterator(store, valuePrefix)
+	defer iterator.Close()
+
+	return collectIndexedIds(iterator)
+}
+
+// getIndexedIdsInRange collects record ids from a secondary index between a
+// start key and an exclusive end key; nil bounds are open
+func (k Keeper) getIndexedIdsInRange(ctx context.Context, index string, start, end []byte) []uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(index))
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	return collectIndexedIds(iterator)
+}
+
+// getLimitedIndexedIds collects up to limit record ids from a secondary index
+// between a start key and an exclusive end key; nil bounds are open
+func (k Keeper) getLimitedIndexedIds(ctx context.Context, index string, start, end []byte, limit uint64) (ids []uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(index))
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	for ; iterator.Valid() && uint64(len(ids)) < limit; iterator.Next() {
+		value := iterator.Value()
+		ids = append(ids, binary.BigEndian.Uint64(value[len(value)-8:]))
+	}
+	return
+}
+
+func collectIndexedIds(iterator storetypes.Iterator) (ids []uint64) {
+	for ; iterator.Valid(); iterator.Next() {
+		value := iterator.Value()
+		ids = append(ids, binary.BigEndian.Uint64(value[len(value)-8:]))
+	}
+	return
+}
@@ -62,9 +62,10 @@ func TestEndToEndHostingFlow(t *testing.T) {
 		Id:         1,
 		InquiryId:  inquiry.Id,
 		OfferId:    offer.Id,
-		Creator:    inquiry.Creator,
+		Creator:    provider,
 		StartBlock: 100,
 		EndBlock:   3700,
+		Status:     types.CONTRACT_STATUS_ACTIVE,
 	}
 	k.SetHostingContract(ctx, contract)
 	
@@ -110,11 +111,11 @@ func TestEndToEndHostingFlow(t *testing.T) {
 	require.Equal(t, contract.Id, creatorContracts[0].Id)
 	
 	// Query provider performance
-	performance, err := k.QueryProviderPerformance(ctx, provider)
+	performance, err := k.ProviderPerformance(ctx, &types.QueryProviderPerformanceRequest{Provider: provider})
 	require.NoError(t, err)
-	require.Equal(t, 1, performance["total_contracts"])
-	require.Equal(t, 1, performance["active_contracts"])
-	require.Equal(t, 0, performance["completed_contracts"])
+	require.Equal(t, uint64(1), performance.TotalContracts)
+	require.Equal(t, uint64(1), performance.ActiveContracts)
+	require.Equal(t, uint64(0), performance.CompletedContracts)
 }
 
 // TestMultipleConcurrentContracts tests handling of multiple contracts simultaneously
@@ -196,14 +197,14 @@ func TestMultipleConcurrentContracts(t *testing.T) {
 	require.Len(t, allEscrow, 3)
 	
 	// Test system statistics with multiple entities
-	stats, err := k.QuerySystemStatistics(ctx)
+	stats, err := k.SystemStatistics(ctx, &types.QuerySystemStatisticsRequest{})
 	require.NoError(t, err)
-	require.Equal(t, 3, stats["total_hosting_inquiries"])
-	require.Equal(t, 3, stats["total_hosting_offers"])
-	require.Equal(t, 3, stats["total_hosting_contracts"])
-	require.Equal(t, 3, stats["total_payment_histories"])
-	require.Equal(t, 3, stats["total_escrow_records"])
-	require.Equal(t, 3, stats["total_providers"])
+	require.Equal(t, uint64(3), stats.TotalHostingInquiries)
+	require.Equal(t, uint64(3), stats.TotalHostingOffers)
+	require.Equal(t, uint64(3), stats.TotalHostingContracts)
+	require.Equal(t, uint64(3), stats.TotalPaymentHistories)
+	require.Equal(t, uint64(3), stats.TotalEscrowRecords)
+	require.Equal(t, uint64(3), stats.TotalProviders)
 }
 
 // TestProviderStakingWorkflow tests the complete provider staking workflow
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+// RegisterInvariants registers all filespacechain invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "escrow-solvency", EscrowSolvencyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "bonded-pool-solvency", BondedPoolSolvencyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "payments-within-escrow", PaymentsWithinEscrowInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "contract-references", ContractReferencesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the filespacechain module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			EscrowSolvencyInvariant(k),
+			BondedPoolSolvencyInvariant(k),
+			PaymentsWithinEscrowInvariant(k),
+			ContractReferencesInvariant(k),
+		} {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// EscrowSolvencyInvariant checks that the module account holds exactly the
+// escrowed amounts that have not been paid out yet
+func EscrowSolvencyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected, err := types.OutstandingEscrow(
+			k.GetAllEscrowRecords(ctx),
+			k.GetAllHostingContract(ctx),
+			k.GetAllPaymentHistory(ctx),
+		)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "escrow-solvency", err.Error()), true
+		}
+
+		balance := k.GetEscrowBalance(ctx)
+		broken := !balance.Equal(expected)
+
+		return sdk.FormatInvariant(types.ModuleName, "escrow-solvency", fmt.Sprintf(
+			"\tmodule account balance: %s\n\toutstanding escrow: %s\n", balance, expected,
+		)), broken
+	}
+}
+
+// BondedPoolSolvencyInvariant checks that the hosting bonded pool holds
+// exactly the bonded and unbonding provider stake
+func BondedPoolSolvencyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.NewCoins()
+		for _, stake := range k.GetAllProviderStakes(ctx) {
+			expected = expected.Add(stake.Amount)
+		}
+		for _, entry := range k.GetAllUnbondingEntry(ctx) {
+			expected = expected.Add(entry.Amount)
+		}
+
+		balance := k.GetBondedPoolBalance(ctx)
+		broken := !balance.Equal(expected)
+
+		return sdk.FormatInvariant(types.ModuleName, "bonded-pool-solvency", fmt.Sprintf(
+			"\tbonded pool balance: %s\n\tprovider stake: %s\n", balance, expected,
+		)), broken
+	}
+}
+
+// PaymentsWithinEscrowInvariant checks that no contract has been paid more
+// than the escrow of its inquiry
+func PaymentsWithinEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, history := range k.GetAllPaymentHistory(ctx) {
+			contract, found := k.GetHostingContract(ctx, history.ContractId)
+			if !found {
+				continue
+			}
+			// Settled escrow is removed, and with it the bound
+			escrow, found := k.GetEscrowRecord(ctx, contract.InquiryId)
+			if !found {
+				continue
+			}
+			if !sdk.NewCoins(escrow.Amount).IsAllGTE(sdk.NewCoins(history.Drawn())) {
+				count++
+				msg += fmt.Sprintf("\tcontract %d drew %s, escrow of inquiry %d is %s\n",
+					history.ContractId, history.Drawn(), contract.InquiryId, escrow.Amount)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "payments-within-escrow", fmt.Sprintf(
+			"%d contracts paid more than their escrow\n%s", count, msg,
+		)), count != 0
+	}
+}
+
+// ContractReferencesInvariant checks that every contract still running
+// references an existing inquiry and offer. Finished contracts are kept after
+// their inquiry and offer are removed.
+func ContractReferencesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, contract := range k.GetAllHostingContract(ctx) {
+			if contract.Status.IsFinal() {
+				continue
+			}
+			if _, found := k.GetHostingInquiry(ctx, contract.InquiryId); !found {
+				count++
+				msg += fmt.Sprintf("\tcontract %d references missing inquiry %d\n", contract.Id, contract.InquiryId)
+			}
+			if _, found := k.GetHostingOffer(ctx, contract.OfferId); !found {
+				count++
+				msg += fmt.Sprintf("\tcontract %d references missing offer %d\n", contract.Id, contract.OfferId)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "contract-references", fmt.Sprintf(
+			"%d dangling contract references\n%s", count, msg,
+		)), count != 0
+	}
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/hanshq/filespace-chain/testutil/keeper"
+	"github.com/hanshq/filespace-chain/testutil/sample"
+	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+// setupEscrowedContract escrows 1,000 token for an inquiry with one contract
+// that has been paid 300 token so far
+func setupEscrowedContract(t *testing.T) (keeper.Keeper, sdk.Context, *keepertest.MockBankKeeper, types.HostingContract) {
+	bank := keepertest.NewMockBankKeeper()
+	k, ctx := keepertest.FilespacechainKeeperWithBank(t, bank)
+
+	contract := appendProviderContract(k, ctx, sample.AccAddress(), sample.AccAddress(), 10, types.CONTRACT_STATUS_ACTIVE)
+	k.SetEscrowRecord(ctx, contract.InquiryId, sdk.NewCoin("token", math.NewInt(1000)), sample.AccAddress())
+	k.SetPaymentHistory(ctx, types.PaymentHistory{
+		ContractId: contract.Id,
+		TotalPaid:  sdk.NewCoin("token", math.NewInt(300)),
+	})
+	bank.FundModule(types.ModuleName, sdk.NewCoins(sdk.NewCoin("token", math.NewInt(700))))
+
+	return k, ctx, bank, contract
+}
+
+func TestEscrowSolvencyInvariant(t *testing.T) {
+	k, ctx, bank, _ := setupEscrowedContract(t)
+
+	_, broken := keeper.EscrowSolvencyInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// Funds leaving the module account without being recorded as paid
+	bank.FundModule(types.ModuleName, sdk.NewCoins(sdk.NewCoin("token", math.NewInt(1))))
+	_, broken = keeper.EscrowSolvencyInvariant(k)(ctx)
+	require.True(t, broken)
+}
+
+func TestBondedPoolSolvencyInvariant(t *testing.T) {
+	k, srv, ctx, bank, provider := setupStakedProvider(t)
+
+	_, broken := keeper.BondedPoolSolvencyInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// Unbonding stake stays in the pool
+	_, err := srv.UnstakeFromHosting(ctx, &types.MsgUnstakeFromHosting{
+		Creator: provider,
+		Amount:  sdk.NewCoin("token", math.NewInt(2000000)),
+	})
+	require.NoError(t, err)
+	_, broken = keeper.BondedPoolSolvencyInvariant(k)(ctx)
+	require.False(t, broken)
+
+	bank.FundModule(bondedPool, sdk.NewCoins(sdk.NewCoin("token", math.NewInt(1))))
+	_, broken = keeper.BondedPoolSolvencyInvariant(k)(ctx)
+	require.True(t, broken)
+}
+
+func TestPaymentsWithinEscrowInvariant(t *testing.T) {
+	k, ctx, _, contract := setupEscrowedContract(t)
+
+	_, broken := keeper.PaymentsWithinEscrowInvariant(k)(ctx)
+	require.False(t, broken)
+
+	k.SetPaymentHistory(ctx, types.PaymentHistory{
+		ContractId: contract.Id,
+		TotalPaid:  sdk.NewCoin("token", math.NewInt(1001)),
+	})
+	_, broken = keeper.PaymentsWithinEscrowInvariant(k)(ctx)
+	require.True(t, broken)
+
+	// Once the escrow is settled there is nothing left to compare against
+	k.RemoveEscrowRecord(ctx, contract.InquiryId)
+	_, broken = keeper.PaymentsWithinEscrowInvariant(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestContractReferencesInvariant(t *testing.T) {
+	k, ctx := keepertest.FilespacechainKeeperWithBank(t, keepertest.NewMockBankKeeper())
+	contract := appendProviderContract(k, ctx, sample.AccAddress(), sample.AccAddress(), 10, types.CONTRACT_STATUS_ACTIVE)
+
+	_, broken := keeper.ContractReferencesInvariant(k)(ctx)
+	require.False(t, broken)
+
+	k.RemoveHostingOffer(ctx, contract.OfferId)
+	msg, broken := keeper.ContractReferencesInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "missing offer")
+
+	_, broken = keeper.AllInvariants(k)(ctx)
+	require.True(t, broken)
+
+	// Finished contracts outlive their inquiry and offer
+	contract, err := k.TransitionHostingContract(ctx, contract, types.CONTRACT_STATUS_COMPLETED, "ended")
+	require.NoError(t, err)
+	k.RemoveHostingInquiry(ctx, contract.InquiryId)
+	_, broken = keeper.ContractReferencesInvariant(k)(ctx)
+	require.False(t, broken)
+}
@@ -3,12 +3,16 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/hanshq/filespace-chain/x/filespacechain/types"
 )
@@ -25,6 +29,7 @@ type (
 
 		accountKeeper types.AccountKeeper
 		bankKeeper    types.BankKeeper
+		distrKeeper   types.DistributionKeeper
 	}
 )
 
@@ -35,6 +40,7 @@ func NewKeeper(
 	authority string,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
 
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
@@ -48,6 +54,7 @@ func NewKeeper(
 		logger:        logger,
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
 	}
 }
 
@@ -62,8 +69,12 @@ func (k Keeper) Logger() log.Logger {
 }
 
 // CalculateEscrowAmount calculates the total escrow amount needed for a hosting inquiry
-func (k Keeper) CalculateEscrowAmount(ctx context.Context, fileSize, duration, replication uint64) (sdk.Coin, error) {
-	params := k.GetParams(ctx)
+// paid in the given denom, at that denom's base price
+func (k Keeper) CalculateEscrowAmount(ctx context.Context, denom string, fileSize, duration, replication uint64) (sdk.Coin, error) {
+	paymentDenom, found := k.GetParams(ctx).PaymentDenom(denom)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrDenomNotAccepted, "%s", denom)
+	}
 	
 	// Convert to math types for calculation
 	fileSizeDec := math.LegacyNewDecFromInt(math.NewIntFromUint64(fileSize))
@@ -71,14 +82,12 @@ func (k Keeper) CalculateEscrowAmount(ctx context.Context, fileSize, duration, r
 	replicationDec := math.LegacyNewDecFromInt(math.NewIntFromUint64(replication))
 	
 	// Calculate: fileSize × duration × replication × basePricePerBytePerBlock
-	totalCost := fileSizeDec.Mul(durationDec).Mul(replicationDec).Mul(params.BasePricePerBytePerBlock)
+	totalCost := fileSizeDec.Mul(durationDec).Mul(replicationDec).Mul(paymentDenom.BasePricePerBytePerBlock)
 	
 	// Convert back to Int (truncating decimals)
 	totalCostInt := totalCost.TruncateInt()
 	
-	// For now, use the first denomination from the bank module (typically the native token)
-	// In production, this should be configurable
-	return sdk.NewCoin("token", totalCostInt), nil
+	return sdk.NewCoin(denom, totalCostInt), nil
 }
 
 // EscrowFunds locks funds from sender account to the module account for escrow
@@ -135,11 +144,25 @@ func (k Keeper) RefundFunds(ctx context.Context, recipient sdk.AccAddress, amoun
 	return nil
 }
 
+// GetEscrowBalance returns all coins held in escrow by the module account
+func (k Keeper) GetEscrowBalance(ctx context.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+}
+
+// GetBondedPoolBalance returns all coins held by the hosting bonded pool
+func (k Keeper) GetBondedPoolBalance(ctx context.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress("hosting_bonded_pool"))
+}
+
 // StakeForHostingProvider stakes tokens for a hosting provider
 func (k Keeper) StakeForHostingProvider(ctx context.Context, provider sdk.AccAddress, amount sdk.Coin) error {
 	// Check if provider already has a stake
 	existingStake, found := k.GetProviderStake(ctx, provider.String())
 	if found {
+		if existingStake.Amount.Denom != amount.Denom {
+			return fmt.Errorf("stake is bonded in %s, cannot add %s", existingStake.Amount.Denom, amount.Denom)
+		}
+		
 		// Add to existing stake
 		newAmount := existingStake.Amount.Add(amount)
 		
@@ -180,51 +203,132 @@ func (k Keeper) StakeForHostingProvider(ctx context.Context, provider sdk.AccAdd
 }
 
 // GetProviderStakeAmount returns the staked amount for a provider
-func (k Keeper) GetProviderStakeAmount(ctx context.Context, provider sdk.AccAddress) (sdk.Coin, error) {
+func (k Keeper) GetProviderStakeAmount(ctx context.Context, provider sdk.AccAddress) (sdk.Coins, error) {
 	stake, found := k.GetProviderStake(ctx, provider.String())
 	if !found {
-		// Return zero coin if no stake found
-		return sdk.NewCoin("token", math.ZeroInt()), nil
+		// No coins if no stake found
+		return sdk.NewCoins(), nil
 	}
-	return stake.Amount, nil
+	return sdk.NewCoins(stake.Amount), nil
 }
 
 // SlashProvider slashes a portion of provider's stake for service failures
-func (k Keeper) SlashProvider(ctx context.Context, provider sdk.AccAddress, fraction math.LegacyDec) error {
+func (k Keeper) SlashProvider(ctx context.Context, provider sdk.AccAddress, fraction math.LegacyDec, contractId uint64, reason string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	_, err := k.SlashProviderForInfraction(ctx, provider, fraction, uint64(sdkCtx.BlockHeight()), contractId, reason)
+	return err
+}
+
+// SlashProviderForInfraction slashes a portion of provider's bonded stake and of
+// every unbonding entry created at or after the infraction height, so stake
+// cannot escape a penalty by being unbonded after the fault. The slashed tokens
+// leave the bonded pool according to the SlashDestination param and the slash
+// is recorded as a SlashEvent. Returns the total amount slashed per denom.
+func (k Keeper) SlashProviderForInfraction(ctx context.Context, provider sdk.AccAddress, fraction math.LegacyDec, infractionHeight uint64, contractId uint64, reason string) (sdk.Coins, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := k.GetParams(ctx)
+	
+	var unbondings []types.UnbondingEntry
+	for _, entry := range k.GetProviderUnbondingEntries(ctx, provider.String()) {
+		if entry.CreationHeight >= infractionHeight {
+			unbondings = append(unbondings, entry)
+		}
+	}
+	
 	stake, found := k.GetProviderStake(ctx, provider.String())
-	if !found {
-		return fmt.Errorf("provider %s has no stake to slash", provider.String())
+	if !found && len(unbondings) == 0 {
+		return sdk.NewCoins(), fmt.Errorf("provider %s has no stake to slash", provider.String())
 	}
 	
-	// Calculate slash amount
-	slashAmountDec := math.LegacyNewDecFromInt(stake.Amount.Amount).Mul(fraction)
-	slashAmount := sdk.NewCoin(stake.Amount.Denom, slashAmountDec.TruncateInt())
+	slashed := sdk.NewCoins()
+	var stakeSlash sdk.Coin
+	if found {
+		stakeSlash = sdk.NewCoin(stake.Amount.Denom, math.LegacyNewDecFromInt(stake.Amount.Amount).Mul(fraction).TruncateInt())
+		slashed = slashed.Add(stakeSlash)
+	}
 	
-	// Calculate remaining stake
-	remainingAmount := stake.Amount.Sub(slashAmount)
+	// Unbonding funds still sit in the bonded pool and are slashed the same way;
+	// each entry is slashed in its own denom
+	entrySlashes := make([]sdk.Coin, len(unbondings))
+	for i, entry := range unbondings {
+		entrySlashes[i] = sdk.NewCoin(entry.Amount.Denom, math.LegacyNewDecFromInt(entry.Amount.Amount).Mul(fraction).TruncateInt())
+		slashed = slashed.Add(entrySlashes[i])
+	}
 	
-	// Note: In a real implementation, you might want to burn the slashed tokens
-	// For now, we'll leave the tokens in the module account but reduce the tracked stake
+	// Move the tokens out of the bonded pool before touching the records, so a
+	// failed transfer leaves the stake untouched
+	if !slashed.IsZero() {
+		if err := k.disposeSlashedStake(ctx, params.SlashDestination, slashed, contractId); err != nil {
+			return sdk.NewCoins(), fmt.Errorf("failed to dispose slashed stake: %w", err)
+		}
+	}
 	
-	if remainingAmount.IsZero() {
-		// Remove stake entirely if slashed to zero
-		k.RemoveProviderStake(ctx, provider.String())
-	} else {
-		// Update stake with remaining amount
-		err := k.UpdateProviderStake(ctx, provider.String(), remainingAmount)
-		if err != nil {
-			return fmt.Errorf("failed to update slashed stake: %w", err)
+	if found {
+		// Calculate remaining stake
+		remainingAmount := stake.Amount.Sub(stakeSlash)
+		
+		if remainingAmount.IsZero() {
+			// Remove stake entirely if slashed to zero
+			k.RemoveProviderStake(ctx, provider.String())
+		} else {
+			// Update stake with remaining amount
+			err := k.UpdateProviderStake(ctx, provider.String(), remainingAmount)
+			if err != nil {
+				return sdk.NewCoins(), fmt.Errorf("failed to update slashed stake: %w", err)
+			}
 		}
+		
+		k.Logger().Info("provider stake slashed", 
+			"provider", provider.String(), 
+			"slashed_amount", stakeSlash.String(),
+			"remaining_stake", remainingAmount.String(),
+			"slash_fraction", fraction.String(),
+		)
 	}
 	
-	k.Logger().Info("provider stake slashed", 
-		"provider", provider.String(), 
-		"slashed_amount", slashAmount.String(),
-		"remaining_stake", remainingAmount.String(),
-		"slash_fraction", fraction.String(),
+	for i, entry := range unbondings {
+		if entrySlashes[i].IsZero() {
+			continue
+		}
+		entry.Amount = entry.Amount.Sub(entrySlashes[i])
+		k.SetUnbondingEntry(ctx, entry)
+		
+		k.Logger().Info("provider unbonding entry slashed",
+			"provider", provider.String(),
+			"unbonding_id", entry.Id,
+			"slashed_amount", entrySlashes[i].String(),
+			"remaining_amount", entry.Amount.String(),
+		)
+	}
+	
+	slashEvent := types.SlashEvent{
+		Provider:         provider.String(),
+		ContractId:       contractId,
+		Reason:           reason,
+		Amount:           slashed,
+		Destination:      params.SlashDestination,
+		Height:           uint64(sdkCtx.BlockHeight()),
+		InfractionHeight: infractionHeight,
+	}
+	slashEvent.Id = k.AppendSlashEvent(ctx, slashEvent)
+	
+	if err := k.recordReputationEvent(ctx, slashEvent.Provider, types.REPUTATION_EVENT_SLASHED); err != nil {
+		return sdk.NewCoins(), err
+	}
+	
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"providerSlashed",
+			sdk.NewAttribute("SlashEventId", strconv.FormatUint(slashEvent.Id, 10)),
+			sdk.NewAttribute("Provider", slashEvent.Provider),
+			sdk.NewAttribute("ContractId", strconv.FormatUint(contractId, 10)),
+			sdk.NewAttribute("Amount", slashed.String()),
+			sdk.NewAttribute("Destination", params.SlashDestination.String()),
+			sdk.NewAttribute("Reason", reason),
+		),
 	)
 	
-	return nil
+	return slashed, nil
 }
 
 // ValidateProviderStake checks if a provider has sufficient stake
@@ -250,11 +354,12 @@ func (k Keeper) ValidateProviderStake(ctx context.Context, provider sdk.AccAddre
 // ProcessPeriodicPayments processes periodic payments for all active contracts
 // This should be called in BeginBlock to distribute payments every block
 func (k Keeper) ProcessPeriodicPayments(ctx context.Context) error {
-	// Get all hosting contracts
-	contracts := k.GetAllHostingContract(ctx)
+	// Get active contracts; the end block index skips those that already ended
+	contracts := k.GetActiveContracts(ctx)
 	
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	currentHeight := uint64(sdkCtx.BlockHeight())
+	params := k.GetParams(ctx)
 	
 	for _, contract := range contracts {
 		// Skip if contract hasn't started yet
@@ -267,6 +372,12 @@ func (k Keeper) ProcessPeriodicPayments(ctx context.Context) error {
 			continue
 		}
 		
+		// Only active contracts are paid; payments stop for good once a
+		// contract is terminated or slashed
+		if contract.Status != types.CONTRACT_STATUS_ACTIVE {
+			continue
+		}
+		
 		// Get the associated inquiry to find escrow information
 		inquiry, found := k.GetHostingInquiry(ctx, contract.InquiryId)
 		if !found {
@@ -286,9 +397,12 @@ func (k Keeper) ProcessPeriodicPayments(ctx context.Context) error {
 			continue
 		}
 		
-		// Calculate payment per block per provider
-		// 50% of total escrow is distributed as periodic payments
-		periodicTotal := escrowRecord.Amount.Amount.Quo(math.NewInt(2))
+		// Each contract is charged its price per block, out of its own share of
+		// the escrow; the part not held back for the completion bonus is
+		// distributed as periodic payments
+		share := types.ContractEscrowShare(escrowRecord.Amount, inquiry.ReplicationRate)
+		charge := types.ContractCharge(contract, share)
+		periodicTotal := charge.Amount.Sub(completionBonusPart(charge.Amount, params.CompletionBonusFraction))
 		
 		// Calculate blocks elapsed and total blocks
 		blocksElapsed := currentHeight - contract.StartBlock + 1
@@ -311,59 +425,44 @@ func (k Keeper) ProcessPeriodicPayments(ctx context.Context) error {
 			}
 		}
 		
-		// Calculate payment due (cumulative - already paid)
-		paymentDue := cumulativePayment.Sub(paymentHistory.TotalPaid.Amount)
+		// Calculate payment due (cumulative - already paid). TotalPaid only
+		// counts what was actually released, so a payment that could not be
+		// made carries forward into the next one. A raised completion bonus
+		// fraction can leave it below what was already paid
+		dueAmount := cumulativePayment.Sub(paymentHistory.TotalPaid.Amount)
 		
 		// Only process if payment is due
-		if paymentDue.IsPositive() {
-			// Get hosting offers associated with this contract
-			offers := k.GetHostingOffersByInquiry(ctx, contract.InquiryId)
-			if len(offers) == 0 {
-				k.Logger().Error("no offers found for contract",
-					"contract_id", contract.Id,
-					"inquiry_id", contract.InquiryId,
+		if dueAmount.IsPositive() {
+			paymentDue := sdk.NewCoin(escrowRecord.Amount.Denom, dueAmount)
+			providerAddr, err := sdk.AccAddressFromBech32(contract.Creator)
+			if err != nil {
+				k.Logger().Error("invalid provider address",
+					"address", contract.Creator,
+					"error", err,
 				)
 				continue
 			}
 			
-			// Distribute payment equally among providers
-			numProviders := int64(len(offers))
-			paymentPerProviderDec := math.LegacyNewDecFromInt(paymentDue).Quo(math.LegacyNewDec(numProviders))
-			paymentPerProvider := sdk.NewCoin(escrowRecord.Amount.Denom, paymentPerProviderDec.TruncateInt())
-			
-			// Process payment to each provider
-			for _, offer := range offers {
-				providerAddr, err := sdk.AccAddressFromBech32(offer.Creator)
-				if err != nil {
-					k.Logger().Error("invalid provider address",
-						"address", offer.Creator,
-						"error", err,
-					)
-					continue
-				}
-				
-				// Release payment to provider
-				err = k.ReleaseFunds(ctx, providerAddr, paymentPerProvider)
-				if err != nil {
-					k.Logger().Error("failed to release periodic payment",
-						"provider", offer.Creator,
-						"amount", paymentPerProvider.String(),
-						"error", err,
-					)
-					continue
-				}
+			// Release payment to the contract's provider
+			err = k.payProvider(ctx, contract.Id, providerAddr, paymentDue, types.PROVIDER_PAYMENT_KIND_PERIODIC)
+			if err != nil {
+				k.Logger().Error("failed to release periodic payment",
+					"provider", contract.Creator,
+					"amount", paymentDue.String(),
+					"error", err,
+				)
+				continue
 			}
 			
 			// Update payment history
-			totalPaidAmount := paymentHistory.TotalPaid.Amount.Add(paymentDue)
-			paymentHistory.TotalPaid = sdk.NewCoin(escrowRecord.Amount.Denom, totalPaidAmount)
+			paymentHistory.TotalPaid = paymentHistory.TotalPaid.Add(paymentDue)
 			paymentHistory.LastPaymentBlock = currentHeight
 			k.SetPaymentHistory(ctx, paymentHistory)
 			
-			k.Logger().Info("processed periodic payments",
+			k.Logger().Info("processed periodic payment",
 				"contract_id", contract.Id,
-				"payment_per_provider", paymentPerProvider.String(),
-				"num_providers", numProviders,
+				"provider", contract.Creator,
+				"amount", paymentDue.String(),
 				"total_paid", paymentHistory.TotalPaid.String(),
 			)
 		}
@@ -373,91 +472,163 @@ func (k Keeper) ProcessPeriodicPayments(ctx context.Context) error {
 }
 
 // ProcessCompletionBonus processes the completion bonus for a contract
-// This distributes the remaining 50% of escrow when contract successfully completes
+// This pays the rest of the contract's charge to its provider when it
+// successfully completes, and refunds the part of its escrow share it was not
+// charged to the inquiry creator
 func (k Keeper) ProcessCompletionBonus(ctx context.Context, contractId uint64) error {
 	contract, found := k.GetHostingContract(ctx, contractId)
 	if !found {
 		return fmt.Errorf("contract %d not found", contractId)
 	}
 	
+	// A terminated or slashed contract never earns its completion bonus
+	if contract.Status != types.CONTRACT_STATUS_ACTIVE {
+		return fmt.Errorf("contract %d is %s, no completion bonus is due", contractId, contract.Status)
+	}
+	
 	// Get the associated inquiry
 	inquiry, found := k.GetHostingInquiry(ctx, contract.InquiryId)
 	if !found {
 		return fmt.Errorf("inquiry %d not found for contract %d", contract.InquiryId, contractId)
 	}
 	
-	// Get escrow record
+	// Get escrow record; it is removed once the inquiry has expired and its
+	// outstanding escrow was refunded
 	escrowRecord, found := k.GetEscrowRecord(ctx, inquiry.Id)
 	if !found {
-		return fmt.Errorf("escrow record not found for inquiry %d", inquiry.Id)
-	}
-	
-	// Get payment history to see what's already been paid
-	paymentHistory, found := k.GetPaymentHistory(ctx, contractId)
-	if !found {
-		return fmt.Errorf("payment history not found for contract %d", contractId)
-	}
-	
-	// Calculate completion bonus (total escrow - already paid)
-	completionBonus := escrowRecord.Amount.Amount.Sub(paymentHistory.TotalPaid.Amount)
-	
-	if !completionBonus.IsPositive() {
-		k.Logger().Info("no completion bonus due, all funds already distributed",
+		k.Logger().Info("no completion bonus due, escrow already settled",
 			"contract_id", contractId,
+			"inquiry_id", inquiry.Id,
 		)
 		return nil
 	}
 	
-	// Get hosting offers to distribute bonus
-	offers := k.GetHostingOffersByInquiry(ctx, contract.InquiryId)
-	if len(offers) == 0 {
-		return fmt.Errorf("no offers found for contract %d", contractId)
+	// Get payment history to see what's already been paid; a charge too small
+	// to have paid anything out periodically has none yet
+	paymentHistory, found := k.GetPaymentHistory(ctx, contractId)
+	if !found {
+		paymentHistory = types.PaymentHistory{
+			ContractId: contractId,
+			TotalPaid:  sdk.NewCoin(escrowRecord.Amount.Denom, math.ZeroInt()),
+		}
 	}
 	
-	// Distribute bonus equally among providers
-	numProviders := int64(len(offers))
-	bonusPerProviderDec := math.LegacyNewDecFromInt(completionBonus).Quo(math.LegacyNewDec(numProviders))
-	bonusPerProvider := sdk.NewCoin(escrowRecord.Amount.Denom, bonusPerProviderDec.TruncateInt())
+	// Calculate completion bonus (contract charge - already paid)
+	share := types.ContractEscrowShare(escrowRecord.Amount, inquiry.ReplicationRate)
+	charge := types.ContractCharge(contract, share)
+	completionBonus := sdk.NewCoin(escrowRecord.Amount.Denom, charge.Amount.Sub(paymentHistory.TotalPaid.Amount))
 	
-	// Process bonus to each provider
-	for _, offer := range offers {
-		providerAddr, err := sdk.AccAddressFromBech32(offer.Creator)
+	if completionBonus.IsPositive() {
+		providerAddr, err := sdk.AccAddressFromBech32(contract.Creator)
 		if err != nil {
-			k.Logger().Error("invalid provider address",
-				"address", offer.Creator,
-				"error", err,
-			)
-			continue
+			return fmt.Errorf("invalid provider address %s: %w", contract.Creator, err)
 		}
 		
-		// Release bonus to provider
-		err = k.ReleaseFunds(ctx, providerAddr, bonusPerProvider)
+		// Release bonus to the contract's provider
+		err = k.payProvider(ctx, contractId, providerAddr, completionBonus, types.PROVIDER_PAYMENT_KIND_COMPLETION_BONUS)
 		if err != nil {
-			k.Logger().Error("failed to release completion bonus",
-				"provider", offer.Creator,
-				"amount", bonusPerProvider.String(),
-				"error", err,
-			)
-			continue
+			return fmt.Errorf("failed to release completion bonus of contract %d: %w", contractId, err)
 		}
+		paymentHistory.TotalPaid = paymentHistory.TotalPaid.Add(completionBonus)
+	}
+	
+	// The contract settles for its charge, and the surplus of its share goes
+	// back to the inquiry creator. A surplus that cannot be refunded now is
+	// refunded when the inquiry expires
+	surplus := sdk.NewCoin(escrowRecord.Amount.Denom, math.MaxInt(share.Amount.Sub(paymentHistory.Drawn().Amount), math.ZeroInt()))
+	if err := k.refundContractSurplus(ctx, contract, escrowRecord, surplus); err != nil {
+		k.Logger().Error("failed to refund contract surplus",
+			"contract_id", contractId,
+			"amount", surplus.String(),
+			"error", err,
+		)
+	} else {
+		paymentHistory.AddRefund(surplus)
 	}
 	
-	// Update payment history to reflect full payment
-	paymentHistory.TotalPaid = escrowRecord.Amount
+	// Update payment history
 	paymentHistory.CompletionBonusPaid = true
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	paymentHistory.LastPaymentBlock = uint64(sdkCtx.BlockHeight())
 	k.SetPaymentHistory(ctx, paymentHistory)
 	
-	// Remove escrow record since contract is complete
-	k.RemoveEscrowRecord(ctx, inquiry.Id)
-	
 	k.Logger().Info("processed completion bonus",
 		"contract_id", contractId,
-		"bonus_per_provider", bonusPerProvider.String(),
-		"num_providers", numProviders,
-		"total_bonus", sdk.NewCoin(escrowRecord.Amount.Denom, completionBonus).String(),
+		"provider", contract.Creator,
+		"bonus", completionBonus.String(),
+		"surplus", surplus.String(),
 	)
 	
 	return nil
 }
+
+// completionBonusPart returns the part of a contract's charge held back until
+// the contract completes
+func completionBonusPart(charge math.Int, fraction math.LegacyDec) math.Int {
+	return math.LegacyNewDecFromInt(charge).Mul(fraction).TruncateInt()
+}
+
+// refundContractSurplus refunds the part of a settled contract's escrow share
+// it was not charged to the inquiry creator
+func (k Keeper) refundContractSurplus(ctx context.Context, contract types.HostingContract, escrowRecord types.EscrowRecord, surplus sdk.Coin) error {
+	if !surplus.IsPositive() {
+		return nil
+	}
+	
+	creatorAddr, err := sdk.AccAddressFromBech32(escrowRecord.Creator)
+	if err != nil {
+		return fmt.Errorf("invalid creator address %s: %w", escrowRecord.Creator, err)
+	}
+	if err := k.RefundFunds(ctx, creatorAddr, surplus); err != nil {
+		return err
+	}
+	
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventContractSurplusRefunded{
+		ContractId: contract.Id,
+		InquiryId:  contract.InquiryId,
+		Creator:    escrowRecord.Creator,
+		Amount:     surplus,
+	})
+}
+
+// sweepPaymentDust sends the part of an expired inquiry's escrow that could
+// not be split evenly over its replicas to the configured dust destination
+func (k Keeper) sweepPaymentDust(ctx context.Context, escrowRecord types.EscrowRecord, dust sdk.Coin) error {
+	if dust.IsZero() {
+		return nil
+	}
+	
+	destination := k.GetParams(ctx).DustDestination
+	var recipient sdk.AccAddress
+	switch destination {
+	case types.DUST_DESTINATION_INQUIRY_CREATOR:
+		creatorAddr, err := sdk.AccAddressFromBech32(escrowRecord.Creator)
+		if err != nil {
+			return fmt.Errorf("invalid creator address %s: %w", escrowRecord.Creator, err)
+		}
+		if err := k.RefundFunds(ctx, creatorAddr, dust); err != nil {
+			return err
+		}
+		recipient = creatorAddr
+		
+	case types.DUST_DESTINATION_COMMUNITY_POOL:
+		if k.distrKeeper == nil {
+			return fmt.Errorf("community pool is not available")
+		}
+		moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+		if err := k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(dust), moduleAddr); err != nil {
+			return fmt.Errorf("failed to fund community pool: %w", err)
+		}
+		recipient = authtypes.NewModuleAddress(distrtypes.ModuleName)
+		
+	default:
+		return fmt.Errorf("unknown dust destination: %s", destination)
+	}
+	
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventPaymentDustSwept{
+		InquiryId:   escrowRecord.InquiryId,
+		Destination: destination,
+		Recipient:   recipient.String(),
+		Amount:      dust,
+	})
+}
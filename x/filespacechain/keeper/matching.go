@@ -0,0 +1,289 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+// MatchResult holds the offers matched to an inquiry, best ranked first, and the
+// reason every other offer was passed over
+type MatchResult struct {
+	Matched    []types.HostingOffer
+	Rejections []types.OfferRejection
+}
+
+// MatchedOfferIds returns the ids of the matched offers in match order
+func (r MatchResult) MatchedOfferIds() []uint64 {
+	ids := make([]uint64, len(r.Matched))
+	for i, offer := range r.Matched {
+		ids[i] = offer.Id
+	}
+	return ids
+}
+
+// MatchHostingOffers matches up to slots offers to an inquiry. Only the
+// OfferMatchScanLimit cheapest offers in the inquiry's escrow denom within its
+// maximum price are looked at. They are filtered by the inquiry's region lists
+// and by the stake of their provider. Each provider gets at most one replica
+// of an inquiry, so providers holding or having held a contract for it are
+// skipped. The remaining offers are ranked by their price weighted with the
+// reputation of their provider, then by price and by id, so ties always
+// resolve to the oldest offer. With a zero reputation weight offers are taken
+// by price alone.
+func (k Keeper) MatchHostingOffers(ctx context.Context, inquiry types.HostingInquiry, slots uint64) MatchResult {
+	var result MatchResult
+	reject := func(offer types.HostingOffer, reason types.OfferRejectionReason) {
+		result.Rejections = append(result.Rejections, types.OfferRejection{
+			OfferId:  offer.Id,
+			Provider: offer.Creator,
+			Reason:   reason,
+		})
+	}
+
+	contracted := k.getContractedProviders(ctx, inquiry.Id)
+	filter := k.newOfferFilter(ctx, inquiry)
+	limit := k.GetParams(ctx).OfferMatchScanLimit
+
+	var eligible []types.HostingOffer
+	for _, id := range k.getHostingOfferIdsByPrice(ctx, inquiry.EscrowAmount.Denom, inquiry.MaxPricePerBlock, limit) {
+		offer, found := k.GetHostingOffer(ctx, id)
+		if !found {
+			continue
+		}
+		if contracted[offer.Creator] {
+			reject(offer, types.OFFER_REJECTION_REASON_PROVIDER_CONTRACTED)
+			continue
+		}
+		if reason := filter.check(offer); reason != types.OFFER_REJECTION_REASON_UNSPECIFIED {
+			reject(offer, reason)
+			continue
+		}
+		eligible = append(eligible, offer)
+	}
+
+	weight := k.GetParams(ctx).ReputationWeight
+	scores := make(map[string]uint64)
+	weighted := make(map[uint64]math.LegacyDec, len(eligible))
+	for _, offer := range eligible {
+		score, ok := scores[offer.Creator]
+		if !ok {
+			score = k.GetReputationScore(ctx, offer.Creator)
+			scores[offer.Creator] = score
+		}
+		weighted[offer.Id] = reputationWeightedPrice(offer.PricePerBlock.Amount, score, weight)
+	}
+
+	sort.SliceStable(eligible, func(i, j int) bool {
+		if !weighted[eligible[i].Id].Equal(weighted[eligible[j].Id]) {
+			return weighted[eligible[i].Id].LT(weighted[eligible[j].Id])
+		}
+		if !eligible[i].PricePerBlock.Amount.Equal(eligible[j].PricePerBlock.Amount) {
+			return eligible[i].PricePerBlock.Amount.LT(eligible[j].PricePerBlock.Amount)
+		}
+		return eligible[i].Id < eligible[j].Id
+	})
+
+	matchedProviders := make(map[string]bool)
+	for _, offer := range eligible {
+		switch {
+		case matchedProviders[offer.Creator]:
+			reject(offer, types.OFFER_REJECTION_REASON_DUPLICATE_PROVIDER)
+		case uint64(len(result.Matched)) >= slots:
+			reject(offer, types.OFFER_REJECTION_REASON_SLOTS_FILLED)
+		default:
+			matchedProviders[offer.Creator] = true
+			result.Matched = append(result.Matched, offer)
+		}
+	}
+
+	return result
+}
+
+// reputationWeightedPrice scales a price by how far the provider's score is
+// from neutral: at full weight a perfect score halves the price an offer is
+// ranked at and a zero score makes it rank at one and a half times its price
+func reputationWeightedPrice(price math.Int, score uint64, weight math.LegacyDec) math.LegacyDec {
+	if weight.IsNil() || weight.IsZero() {
+		return math.LegacyNewDecFromInt(price)
+	}
+	deviation := math.LegacyNewDec(int64(types.NeutralReputationScore)).Sub(math.LegacyNewDec(int64(score))).
+		QuoInt64(int64(types.MaxReputationScore))
+	return math.LegacyNewDecFromInt(price).Mul(math.LegacyOneDec().Add(weight.Mul(deviation)))
+}
+
+// matchAndOpenContracts matches offers to the open slots of an inquiry, opens
+// a PENDING contract for every matched offer and emits the match result
+func (k Keeper) matchAndOpenContracts(ctx context.Context, inquiry types.HostingInquiry, slots uint64) (MatchResult, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	result := k.MatchHostingOffers(ctx, inquiry, slots)
+
+	for _, offer := range result.Matched {
+		// The contract stays PENDING until the provider accepts it
+		k.OpenHostingContract(ctx, inquiry, offer)
+	}
+
+	err := sdkCtx.EventManager().EmitTypedEvent(&types.EventHostingOffersMatched{
+		InquiryId:       inquiry.Id,
+		Slots:           slots,
+		MatchedOfferIds: result.MatchedOfferIds(),
+		Rejections:      result.Rejections,
+		Height:          uint64(sdkCtx.BlockHeight()),
+	})
+	return result, err
+}
+
+// fillOpenInquiries matches a new or updated offer against the open inquiries
+// and opens a PENDING contract for one replica of every inquiry it is eligible
+// for. Only OfferMatchScanLimit open inquiries are looked at, in id order from
+// where the previous offer stopped; the rest are filled by their own matching
+// or by newer offers.
+func (k Keeper) fillOpenInquiries(ctx context.Context, offer types.HostingOffer) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentHeight := uint64(sdkCtx.BlockHeight())
+	limit := k.GetParams(ctx).OfferMatchScanLimit
+
+	for _, id := range k.nextOpenInquiryIds(ctx, limit) {
+		inquiry, found := k.GetHostingInquiry(ctx, id)
+		if !found {
+			continue
+		}
+		// Inquiries that ended this block leave the open index when they expire
+		if inquiry.EndTime <= currentHeight {
+			continue
+		}
+		// Auctioned inquiries are filled by their auction first
+		if k.isAuctionPending(ctx, inquiry.Id) {
+			continue
+		}
+		if k.getContractedProviders(ctx, inquiry.Id)[offer.Creator] {
+			continue
+		}
+		if k.CheckOfferEligibility(ctx, inquiry, offer) != types.OFFER_REJECTION_REASON_UNSPECIFIED {
+			continue
+		}
+
+		k.OpenHostingContract(ctx, inquiry, offer)
+
+		err := sdkCtx.EventManager().EmitTypedEvent(&types.EventHostingOffersMatched{
+			InquiryId:       inquiry.Id,
+			Slots:           1,
+			MatchedOfferIds: []uint64{offer.Id},
+			Height:          currentHeight,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextOpenInquiryIds returns up to limit open inquiry ids, starting at the
+// cursor and wrapping around to the oldest, and moves the cursor past them.
+// Inquiries no offer can fill therefore never keep newer ones from being
+// looked at.
+func (k Keeper) nextOpenInquiryIds(ctx context.Context, limit uint64) []uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	cursorKey := types.KeyPrefix(types.OpenHostingInquiryCursorKey)
+	start := storeAdapter.Get(cursorKey)
+
+	ids := k.getLimitedIndexedIds(ctx, types.OpenHostingInquiryKey, start, nil, limit)
+	if remaining := limit - uint64(len(ids)); remaining > 0 && start != nil {
+		ids = append(ids, k.getLimitedIndexedIds(ctx, types.OpenHostingInquiryKey, nil, start, remaining)...)
+	}
+	if len(ids) > 0 {
+		storeAdapter.Set(cursorKey, Uint64IndexPrefix(ids[len(ids)-1]+1))
+	}
+	return ids
+}
+
+// CheckOfferEligibility returns the reason an offer cannot host an inquiry,
+// or OFFER_REJECTION_REASON_UNSPECIFIED if it can
+func (k Keeper) CheckOfferEligibility(ctx context.Context, inquiry types.HostingInquiry, offer types.HostingOffer) types.OfferRejectionReason {
+	return k.newOfferFilter(ctx, inquiry).check(offer)
+}
+
+// offerFilter applies an inquiry's matching criteria to offers
+type offerFilter struct {
+	k       Keeper
+	ctx     context.Context
+	inquiry types.HostingInquiry
+	allowed map[string]bool
+	denied  map[string]bool
+	// A provider's stake is the same for all of its offers, check it once
+	staked map[string]bool
+	// Bytes of the inquiry's file, and whether each provider has room for them
+	fileSize uint64
+	fits     map[string]bool
+}
+
+func (k Keeper) newOfferFilter(ctx context.Context, inquiry types.HostingInquiry) *offerFilter {
+	fileEntry, _ := k.GetInquiryFileEntry(ctx, inquiry)
+	return &offerFilter{
+		k:        k,
+		ctx:      ctx,
+		inquiry:  inquiry,
+		allowed:  regionSet(inquiry.AllowedRegions),
+		denied:   regionSet(inquiry.DeniedRegions),
+		staked:   make(map[string]bool),
+		fileSize: fileEntry.FileSize,
+		fits:     make(map[string]bool),
+	}
+}
+
+func (f *offerFilter) check(offer types.HostingOffer) types.OfferRejectionReason {
+	price := offer.PricePerBlock
+	switch {
+	case price.Denom != f.inquiry.EscrowAmount.Denom:
+		return types.OFFER_REJECTION_REASON_DENOM_MISMATCH
+	case f.inquiry.MaxPricePerBlock != 0 && (!price.Amount.IsUint64() || price.Amount.Uint64() > f.inquiry.MaxPricePerBlock):
+		return types.OFFER_REJECTION_REASON_PRICE_ABOVE_MAX
+	case len(f.allowed) > 0 && !f.allowed[offer.Region]:
+		return types.OFFER_REJECTION_REASON_REGION_NOT_ALLOWED
+	case f.denied[offer.Region]:
+		return types.OFFER_REJECTION_REASON_REGION_DENIED
+	case !f.hasStake(offer.Creator):
+		return types.OFFER_REJECTION_REASON_INSUFFICIENT_STAKE
+	case !f.hasCapacity(offer.Creator):
+		return types.OFFER_REJECTION_REASON_INSUFFICIENT_CAPACITY
+	}
+	return types.OFFER_REJECTION_REASON_UNSPECIFIED
+}
+
+// hasStake reports whether a provider has at least the minimum provider stake
+// bonded in the bond denom
+func (f *offerFilter) hasStake(provider string) bool {
+	ok, checked := f.staked[provider]
+	if !checked {
+		params := f.k.GetParams(f.ctx)
+		stake, found := f.k.GetProviderStake(f.ctx, provider)
+		ok = found && stake.Amount.Denom == params.BondDenom && stake.Amount.Amount.GTE(params.MinProviderStake)
+		f.staked[provider] = ok
+	}
+	return ok
+}
+
+// hasCapacity reports whether the inquiry's file fits in a provider's free
+// capacity. Providers that have not declared a capacity are not limited.
+func (f *offerFilter) hasCapacity(provider string) bool {
+	ok, checked := f.fits[provider]
+	if !checked {
+		profile, found := f.k.GetProviderProfile(f.ctx, provider)
+		ok = !found || f.fileSize <= profile.FreeCapacity()
+		f.fits[provider] = ok
+	}
+	return ok
+}
+
+func regionSet(regions []string) map[string]bool {
+	set := make(map[string]bool, len(regions))
+	for _, region := range regions {
+		set[region] = true
+	}
+	return set
+}
@@ -0,0 +1,225 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/hanshq/filespace-chain/testutil/keeper"
+	"github.com/hanshq/filespace-chain/testutil/sample"
+	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func TestMatchHostingOffers(t *testing.T) {
+	k, ctx := keepertest.FilespacechainKeeper(t)
+	minStake := k.GetParams(ctx).MinProviderStake
+	for _, provider := range []string{"cheap", "tied", "pricey", "eu", "us", "contracted", "other-denom"} {
+		k.SetProviderStake(ctx, provider, sdk.NewCoin("token", minStake), 1)
+	}
+	k.SetProviderStake(ctx, "unstaked", sdk.NewCoin("token", minStake.SubRaw(1)), 1)
+	k.SetProviderStake(ctx, "unbonded", sdk.NewCoin("stake", minStake), 1)
+
+	inquiry := types.HostingInquiry{
+		ReplicationRate:  2,
+		EscrowAmount:     sdk.NewCoin("token", math.NewInt(1000)),
+		EndTime:          1000,
+		MaxPricePerBlock: 50,
+		AllowedRegions:   []string{"eu"},
+	}
+	inquiry.Id = k.AppendHostingInquiry(ctx, inquiry)
+
+	offer := func(provider, region string, price sdk.Coin) uint64 {
+		return k.AppendHostingOffer(ctx, types.HostingOffer{Creator: provider, Region: region, PricePerBlock: price})
+	}
+	tokens := func(amount int64) sdk.Coin { return sdk.NewCoin("token", math.NewInt(amount)) }
+
+	pricey := offer("pricey", "eu", tokens(51))
+	tied := offer("tied", "eu", tokens(20))
+	cheap := offer("cheap", "eu", tokens(20))
+	cheapAgain := offer("cheap", "eu", tokens(30))
+	us := offer("us", "us", tokens(10))
+	asia := offer("eu", "asia", tokens(10))
+	unstaked := offer("unstaked", "eu", tokens(10))
+	unbonded := offer("unbonded", "eu", tokens(10))
+	otherDenom := offer("other-denom", "eu", sdk.NewCoin("stake", math.NewInt(10)))
+	contracted := offer("contracted", "eu", tokens(1))
+	euFilled := offer("eu", "eu", tokens(40))
+	k.AppendHostingContract(ctx, types.HostingContract{InquiryId: inquiry.Id, OfferId: contracted, Creator: "contracted", Status: types.CONTRACT_STATUS_TERMINATED})
+
+	result := k.MatchHostingOffers(ctx, inquiry, inquiry.ReplicationRate)
+
+	// Equal prices resolve to the oldest offer
+	require.Equal(t, []uint64{tied, cheap}, result.MatchedOfferIds())
+
+	// Offers in another denom or above the maximum price are not looked at
+	require.Equal(t, map[uint64]types.OfferRejectionReason{
+		cheapAgain: types.OFFER_REJECTION_REASON_DUPLICATE_PROVIDER,
+		us:         types.OFFER_REJECTION_REASON_REGION_NOT_ALLOWED,
+		asia:       types.OFFER_REJECTION_REASON_REGION_NOT_ALLOWED,
+		unstaked:   types.OFFER_REJECTION_REASON_INSUFFICIENT_STAKE,
+		unbonded:   types.OFFER_REJECTION_REASON_INSUFFICIENT_STAKE,
+		contracted: types.OFFER_REJECTION_REASON_PROVIDER_CONTRACTED,
+		euFilled:   types.OFFER_REJECTION_REASON_SLOTS_FILLED,
+	}, rejectionReasons(result))
+	require.NotContains(t, rejectionReasons(result), pricey)
+	require.NotContains(t, rejectionReasons(result), otherDenom)
+
+	// Without an allow list every region but the denied ones is eligible
+	inquiry.AllowedRegions = nil
+	inquiry.DeniedRegions = []string{"us"}
+	result = k.MatchHostingOffers(ctx, inquiry, inquiry.ReplicationRate)
+	require.Equal(t, []uint64{asia, tied}, result.MatchedOfferIds())
+	require.Equal(t, types.OFFER_REJECTION_REASON_REGION_DENIED, rejectionReasons(result)[us])
+
+	// Only the cheapest offers up to the scan limit are looked at
+	params := k.GetParams(ctx)
+	params.OfferMatchScanLimit = 5
+	require.NoError(t, k.SetParams(ctx, params))
+	result = k.MatchHostingOffers(ctx, inquiry, inquiry.ReplicationRate)
+	require.Equal(t, []uint64{asia}, result.MatchedOfferIds())
+	require.Len(t, result.Rejections, 4)
+}
+
+func rejectionReasons(result keeper.MatchResult) map[uint64]types.OfferRejectionReason {
+	reasons := make(map[uint64]types.OfferRejectionReason)
+	for _, rejection := range result.Rejections {
+		reasons[rejection.OfferId] = rejection.Reason
+	}
+	return reasons
+}
+
+func TestCreateHostingContractChecksEligibility(t *testing.T) {
+	k, srv, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(10)
+	inquiry := setupOpenInquiry(k, ctx)
+	inquiry.DeniedRegions = []string{"moon"}
+	k.SetHostingInquiry(ctx, inquiry)
+
+	offerId := k.AppendHostingOffer(ctx, types.HostingOffer{
+		Creator:       "A",
+		Region:        "moon",
+		PricePerBlock: sdk.NewCoin("token", math.NewInt(1)),
+	})
+
+	_, err := srv.CreateHostingContract(ctx, &types.MsgCreateHostingContract{Creator: "A", InquiryId: inquiry.Id, OfferId: offerId})
+	require.ErrorIs(t, err, types.ErrOfferNotEligible)
+}
+
+func TestCreateHostingOfferFillsOpenInquiries(t *testing.T) {
+	k, srv, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(10)
+	provider := sample.AccAddress()
+	k.SetProviderStake(ctx, provider, sdk.NewCoin("token", k.GetParams(ctx).MinProviderStake), 1)
+
+	inquiry := func(replicationRate uint64, regions ...string) uint64 {
+		return k.AppendHostingInquiry(ctx, types.HostingInquiry{
+			ReplicationRate: replicationRate,
+			EscrowAmount:    sdk.NewCoin("token", math.NewInt(1000)),
+			EndTime:         1000,
+			AllowedRegions:  regions,
+		})
+	}
+	open := inquiry(2)
+	elsewhere := inquiry(1, "us")
+	ended := k.AppendHostingInquiry(ctx, types.HostingInquiry{ReplicationRate: 1, EscrowAmount: sdk.NewCoin("token", math.NewInt(1000)), EndTime: 10})
+
+	resp, err := srv.CreateHostingOffer(ctx, &types.MsgCreateHostingOffer{Creator: provider, Region: "eu", PricePerBlock: sdk.NewCoin("token", math.NewInt(1))})
+	require.NoError(t, err)
+
+	contracts := k.GetHostingContractsByInquiry(ctx, open)
+	require.Len(t, contracts, 1)
+	require.Equal(t, resp.Id, contracts[0].OfferId)
+	require.Equal(t, types.CONTRACT_STATUS_PENDING, contracts[0].Status)
+	require.Empty(t, k.GetHostingContractsByInquiry(ctx, elsewhere))
+	require.Empty(t, k.GetHostingContractsByInquiry(ctx, ended))
+
+	filled, found := k.GetHostingInquiry(ctx, open)
+	require.True(t, found)
+	require.Equal(t, uint64(1), filled.FilledReplicas)
+
+	// One provider hosts one replica, so updating the offer only reaches the
+	// inquiry it now matches
+	_, err = srv.UpdateHostingOffer(ctx, &types.MsgUpdateHostingOffer{Creator: provider, Id: resp.Id, Region: "us", PricePerBlock: sdk.NewCoin("token", math.NewInt(1))})
+	require.NoError(t, err)
+	require.Len(t, k.GetHostingContractsByInquiry(ctx, open), 1)
+	require.Len(t, k.GetHostingContractsByInquiry(ctx, elsewhere), 1)
+
+	var openIds []uint64
+	for _, inquiry := range k.GetOpenHostingInquiries(ctx) {
+		openIds = append(openIds, inquiry.Id)
+	}
+	require.Equal(t, []uint64{open}, openIds)
+
+	// A contract that reaches a final status frees its replica
+	_, err = k.TransitionHostingContract(ctx, contracts[0], types.CONTRACT_STATUS_TERMINATED, "test")
+	require.NoError(t, err)
+	filled, _ = k.GetHostingInquiry(ctx, open)
+	require.Zero(t, filled.FilledReplicas)
+}
+
+func TestCreateHostingOfferScansBoundedOpenInquiries(t *testing.T) {
+	k, srv, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(10)
+	params := k.GetParams(ctx)
+	params.OfferMatchScanLimit = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// The two oldest inquiries pay less than any offer asks
+	var inquiryIds []uint64
+	for _, maxPrice := range []uint64{1, 1, 0, 0} {
+		inquiryIds = append(inquiryIds, k.AppendHostingInquiry(ctx, types.HostingInquiry{
+			ReplicationRate:  2,
+			EscrowAmount:     sdk.NewCoin("token", math.NewInt(1000)),
+			EndTime:          1000,
+			MaxPricePerBlock: maxPrice,
+		}))
+	}
+	createOffer := func() {
+		provider := sample.AccAddress()
+		k.SetProviderStake(ctx, provider, sdk.NewCoin("token", params.MinProviderStake), 1)
+		_, err := srv.CreateHostingOffer(ctx, &types.MsgCreateHostingOffer{Creator: provider, PricePerBlock: sdk.NewCoin("token", math.NewInt(2))})
+		require.NoError(t, err)
+	}
+
+	// Each offer looks at two open inquiries, starting where the previous
+	// one stopped, so the unfillable ones do not hide the newer ones
+	createOffer()
+	for _, id := range inquiryIds {
+		require.Empty(t, k.GetHostingContractsByInquiry(ctx, id))
+	}
+	createOffer()
+	for i, contracts := range []int{0, 0, 1, 1} {
+		require.Len(t, k.GetHostingContractsByInquiry(ctx, inquiryIds[i]), contracts)
+	}
+
+	// The cursor wraps around to the oldest inquiries
+	createOffer()
+	createOffer()
+	for i, contracts := range []int{0, 0, 2, 2} {
+		require.Len(t, k.GetHostingContractsByInquiry(ctx, inquiryIds[i]), contracts)
+	}
+}
+
+func TestExpiredInquiryLeavesOpenIndex(t *testing.T) {
+	k, _, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(10)
+	id := k.AppendHostingInquiry(ctx, types.HostingInquiry{
+		ReplicationRate: 1,
+		EscrowAmount:    sdk.NewCoin("token", math.NewInt(1000)),
+		EndTime:         20,
+	})
+	require.Len(t, k.GetOpenHostingInquiries(ctx), 1)
+
+	ctx = ctx.WithBlockHeight(21)
+	require.NoError(t, k.ProcessExpiredInquiries(ctx))
+	require.Empty(t, k.GetOpenHostingInquiries(ctx))
+
+	// Storing an ended inquiry again does not reopen it
+	inquiry, found := k.GetHostingInquiry(ctx, id)
+	require.True(t, found)
+	k.SetHostingInquiry(ctx, inquiry)
+	require.Empty(t, k.GetOpenHostingInquiries(ctx))
+}
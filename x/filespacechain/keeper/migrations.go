@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v10 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v10"
+	v11 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v11"
+	v12 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v12"
+	v13 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v13"
+	v14 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v14"
+	v2 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v2"
+	v3 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v3"
+	v4 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v4"
+	v5 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v5"
+	v6 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v6"
+	v7 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v7"
+	v8 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v8"
+	v9 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v9"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2: escrow records and provider
+// stakes move from JSON to protobuf encoding, contracts get the status of
+// their term, inquiries are bound to a file entry, and the params added since
+// v1 are set to their defaults.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate2to3 migrates from version 2 to 3: contracts, inquiries and file
+// entries gain secondary indexes, built from the existing records.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeService)
+}
+
+// Migrate3to4 migrates from version 3 to 4: inquiries, contracts and settled
+// payment histories are queued by expiry height, and the per-block queue
+// processing cap is set to its default.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeService)
+}
+
+// Migrate4to5 migrates from version 4 to 5: inquiries count the replicas held
+// by their running contracts, and the inquiries short of their replication
+// rate are indexed as open to new offers.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.storeService)
+}
+
+// Migrate5to6 migrates from version 5 to 6: running contracts reserve the size
+// of their file, which is released from the provider's declared capacity when
+// they end.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeService)
+}
+
+// Migrate6to7 migrates from version 6 to 7: the reputation weight used in
+// matching and the reputation half-life are set to their defaults.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.MigrateStore(ctx, m.keeper.storeService)
+}
+
+// Migrate7to8 migrates from version 7 to 8: the single base price becomes
+// the price of the one accepted payment denom.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	return v8.MigrateStore(ctx, m.keeper.storeService)
+}
+
+// Migrate8to9 migrates from version 8 to 9: the completion bonus fraction of
+// a contract's charge is set to its default.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	return v9.MigrateStore(ctx, m.keeper.storeService)
+}
+
+// Migrate9to10 migrates from version 9 to 10: the penalties for terminating
+// a contract early are set to their defaults.
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	return v10.MigrateStore(ctx, m.keeper.storeService)
+}
+
+// Migrate10to11 migrates from version 10 to 11: payment histories of
+// terminated and slashed contracts are queued for pruning.
+func (m Migrator) Migrate10to11(ctx sdk.Context) error {
+	return v11.MigrateStore(ctx, m.keeper.storeService)
+}
+
+// Migrate11to12 migrates from version 11 to 12: the bond denom providers
+// stake in is set.
+func (m Migrator) Migrate11to12(ctx sdk.Context) error {
+	return v12.MigrateStore(ctx, m.keeper.storeService)
+}
+
+// Migrate12to13 migrates from version 12 to 13: the cap on the open inquiries
+// an offer is matched against is set to its default, and contracts without a
+// price are priced at their offer's price.
+func (m Migrator) Migrate12to13(ctx sdk.Context) error {
+	return v13.MigrateStore(ctx, m.keeper.storeService)
+}
+
+// Migrate13to14 migrates from version 13 to 14: offers are indexed by their
+// price.
+func (m Migrator) Migrate13to14(ctx sdk.Context) error {
+	return v14.MigrateStore(ctx, m.keeper.storeService)
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func (k msgServer) CommitBid(goCtx context.Context, msg *types.MsgCommitBid) (*types.MsgCommitBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	auction, found := k.GetAuction(ctx, msg.InquiryId)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "inquiry %d is not auctioned", msg.InquiryId)
+	}
+
+	currentBlock := uint64(ctx.BlockHeight())
+	if !auction.IsCommitPhase(currentBlock) {
+		return nil, errorsmod.Wrapf(types.ErrAuctionPhase, "bidding on inquiry %d closed at block %d", msg.InquiryId, auction.CommitEnd)
+	}
+
+	// Providers bid with one of their own offers
+	offer, found := k.GetHostingOffer(ctx, msg.OfferId)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("offer %d doesn't exist", msg.OfferId))
+	}
+	if msg.Creator != offer.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	// A provider holds one bid per auction, committing again replaces it
+	k.SetAuctionBid(ctx, types.AuctionBid{
+		InquiryId:    msg.InquiryId,
+		Provider:     msg.Creator,
+		OfferId:      msg.OfferId,
+		Commitment:   msg.Commitment,
+		CommitHeight: currentBlock,
+		Price:        sdk.NewCoin(offer.PricePerBlock.Denom, math.ZeroInt()),
+	})
+
+	return &types.MsgCommitBidResponse{}, nil
+}
+
+func (k msgServer) RevealBid(goCtx context.Context, msg *types.MsgRevealBid) (*types.MsgRevealBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	auction, found := k.GetAuction(ctx, msg.InquiryId)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "inquiry %d is not auctioned", msg.InquiryId)
+	}
+
+	currentBlock := uint64(ctx.BlockHeight())
+	if !auction.IsRevealPhase(currentBlock) {
+		return nil, errorsmod.Wrapf(types.ErrAuctionPhase,
+			"bids on inquiry %d are revealed from block %d to %d", msg.InquiryId, auction.CommitEnd+1, auction.RevealEnd)
+	}
+
+	bid, found := k.GetAuctionBid(ctx, msg.InquiryId, msg.Creator)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "no bid from %s on inquiry %d", msg.Creator, msg.InquiryId)
+	}
+	if bid.Revealed {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "bid on inquiry %d is already revealed", msg.InquiryId)
+	}
+
+	if !bytes.Equal(bid.Commitment, types.BidCommitment(msg.Creator, msg.InquiryId, msg.Price, msg.Salt)) {
+		return nil, errorsmod.Wrapf(types.ErrBidCommitmentMismatch, "bid on inquiry %d", msg.InquiryId)
+	}
+
+	// The revealed price must meet the inquiry's criteria like an offer price
+	inquiry, found := k.GetHostingInquiry(ctx, msg.InquiryId)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("inquiry %d doesn't exist", msg.InquiryId))
+	}
+	offer, found := k.GetHostingOffer(ctx, bid.OfferId)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("offer %d doesn't exist", bid.OfferId))
+	}
+	offer.PricePerBlock = msg.Price
+	if reason := k.CheckOfferEligibility(ctx, inquiry, offer); reason != types.OFFER_REJECTION_REASON_UNSPECIFIED {
+		return nil, errorsmod.Wrapf(types.ErrOfferNotEligible, "offer %d at %s: %s", bid.OfferId, msg.Price, reason)
+	}
+
+	bid.Revealed = true
+	bid.Price = msg.Price
+	k.SetAuctionBid(ctx, bid)
+
+	return &types.MsgRevealBidResponse{}, nil
+}
@@ -14,12 +14,15 @@ func (k msgServer) CreateFileEntry(goCtx context.Context, msg *types.MsgCreateFi
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	var fileEntry = types.FileEntry{
-		Creator:   msg.Creator,
-		Cid:       msg.Cid,
-		RootCid:   msg.RootCid,
-		ParentCid: msg.ParentCid,
-		MetaData:  msg.MetaData,
-		FileSize:  msg.FileSize,
+		Creator:    msg.Creator,
+		Cid:        msg.Cid,
+		RootCid:    msg.RootCid,
+		ParentCid:  msg.ParentCid,
+		MetaData:   msg.MetaData,
+		FileSize:   msg.FileSize,
+		MerkleRoot: msg.MerkleRoot,
+		ChunkCount: msg.ChunkCount,
+		ChunkSize:  msg.ChunkSize,
 	}
 
 	id := k.AppendFileEntry(
@@ -56,6 +59,22 @@ func (k msgServer) UpdateFileEntry(goCtx context.Context, msg *types.MsgUpdateFi
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
+	// Challenges are issued against the hosted file, so its CID cannot change
+	// while an inquiry hosts the entry
+	if msg.Cid != val.Cid {
+		if inquiryId, hosted := k.getFileEntryHostingInquiry(ctx, msg.Id); hosted {
+			return nil, errorsmod.Wrapf(types.ErrFileEntryInUse, "CID of file entry %d is hosted by inquiry %d", msg.Id, inquiryId)
+		}
+	}
+
+	// The content commitment is fixed at creation and must keep matching the file size
+	fileEntry.MerkleRoot = val.MerkleRoot
+	fileEntry.ChunkCount = val.ChunkCount
+	fileEntry.ChunkSize = val.ChunkSize
+	if err := types.ValidateFileCommitment(fileEntry.FileSize, fileEntry.MerkleRoot, fileEntry.ChunkCount, fileEntry.ChunkSize); err != nil {
+		return nil, err
+	}
+
 	k.SetFileEntry(ctx, fileEntry)
 
 	return &types.MsgUpdateFileEntryResponse{}, nil
@@ -75,6 +94,11 @@ func (k msgServer) DeleteFileEntry(goCtx context.Context, msg *types.MsgDeleteFi
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
+	// Providers of a hosting inquiry are challenged against the entry
+	if inquiryId, hosted := k.getFileEntryHostingInquiry(ctx, msg.Id); hosted {
+		return nil, errorsmod.Wrapf(types.ErrFileEntryInUse, "file entry %d is hosted by inquiry %d", msg.Id, inquiryId)
+	}
+
 	k.RemoveFileEntry(ctx, msg.Id)
 
 	return &types.MsgDeleteFileEntryResponse{}, nil
@@ -7,6 +7,8 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
+	keepertest "github.com/hanshq/filespace-chain/testutil/keeper"
+	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
 	"github.com/hanshq/filespace-chain/x/filespacechain/types"
 )
 
@@ -102,3 +104,73 @@ func TestFileEntryMsgServerDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestFileEntryMsgServerHostedEntry(t *testing.T) {
+	k, ctx := keepertest.FilespacechainKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+
+	// Two entries share a CID; the inquiry is bound to one of them
+	otherEntry, err := srv.CreateFileEntry(ctx, &types.MsgCreateFileEntry{Creator: "B", Cid: "bafyfile", FileSize: 100})
+	require.NoError(t, err)
+	ownEntry, err := srv.CreateFileEntry(ctx, &types.MsgCreateFileEntry{Creator: "A", Cid: "bafyfile", FileSize: 100})
+	require.NoError(t, err)
+	inquiry := types.HostingInquiry{Creator: "A", FileEntryCid: "bafyfile", FileEntryId: ownEntry.Id}
+	inquiry.Id = k.AppendHostingInquiry(ctx, inquiry)
+
+	// A file without a Merkle commitment cannot be proven and is not hosted
+	_, err = srv.CreateFileEntry(ctx, &types.MsgCreateFileEntry{Creator: "A", Cid: "bafyplain", FileSize: 100})
+	require.NoError(t, err)
+	_, err = srv.CreateHostingInquiry(ctx, &types.MsgCreateHostingInquiry{Creator: "A", FileEntryCid: "bafyplain", ReplicationRate: 1, EndTime: 100})
+	require.ErrorIs(t, err, types.ErrInvalidFileCommitment)
+
+	// The hosted entry keeps its CID and cannot be deleted
+	_, err = srv.UpdateFileEntry(ctx, &types.MsgUpdateFileEntry{Creator: "A", Id: ownEntry.Id, Cid: "bafyother", FileSize: 100})
+	require.ErrorIs(t, err, types.ErrFileEntryInUse)
+	_, err = srv.DeleteFileEntry(ctx, &types.MsgDeleteFileEntry{Creator: "A", Id: ownEntry.Id})
+	require.ErrorIs(t, err, types.ErrFileEntryInUse)
+	_, err = srv.UpdateFileEntry(ctx, &types.MsgUpdateFileEntry{Creator: "A", Id: ownEntry.Id, Cid: "bafyfile", FileSize: 100, MetaData: "renamed"})
+	require.NoError(t, err)
+
+	// Deleting another entry with the same CID leaves the inquiry's file alone
+	_, err = srv.DeleteFileEntry(ctx, &types.MsgDeleteFileEntry{Creator: "B", Id: otherEntry.Id})
+	require.NoError(t, err)
+	fileEntry, found := k.GetInquiryFileEntry(ctx, inquiry)
+	require.True(t, found)
+	require.Equal(t, ownEntry.Id, fileEntry.Id)
+
+	// Once the inquiry is gone the entry is free again
+	k.RemoveHostingInquiry(ctx, inquiry.Id)
+	_, err = srv.DeleteFileEntry(ctx, &types.MsgDeleteFileEntry{Creator: "A", Id: ownEntry.Id})
+	require.NoError(t, err)
+}
+
+func TestFileEntryMsgServerCommitment(t *testing.T) {
+	k, srv, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	creator := "A"
+	root := make([]byte, 32)
+	resp, err := srv.CreateFileEntry(wctx, &types.MsgCreateFileEntry{
+		Creator:    creator,
+		FileSize:   9000,
+		MerkleRoot: root,
+		ChunkCount: 3,
+		ChunkSize:  4096,
+	})
+	require.NoError(t, err)
+
+	fileEntry, found := k.GetFileEntry(wctx, resp.Id)
+	require.True(t, found)
+	require.Equal(t, root, fileEntry.MerkleRoot)
+	require.Equal(t, uint64(3), fileEntry.ChunkCount)
+	require.Equal(t, uint64(4096), fileEntry.ChunkSize)
+
+	// Updates keep the commitment and cannot change the size under it
+	_, err = srv.UpdateFileEntry(wctx, &types.MsgUpdateFileEntry{Creator: creator, Id: resp.Id, FileSize: 9000, MetaData: "renamed"})
+	require.NoError(t, err)
+	fileEntry, _ = k.GetFileEntry(wctx, resp.Id)
+	require.Equal(t, root, fileEntry.MerkleRoot)
+
+	_, err = srv.UpdateFileEntry(wctx, &types.MsgUpdateFileEntry{Creator: creator, Id: resp.Id, FileSize: 20000})
+	require.ErrorIs(t, err, types.ErrInvalidFileCommitment)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -13,16 +14,49 @@ import (
 func (k msgServer) CreateHostingContract(goCtx context.Context, msg *types.MsgCreateHostingContract) (*types.MsgCreateHostingContractResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	var hostingContract = types.HostingContract{
-		Creator:   msg.Creator,
-		InquiryId: msg.InquiryId,
-		OfferId:   msg.OfferId,
+	// A contract must reference an existing inquiry and offer
+	inquiry, found := k.GetHostingInquiry(ctx, msg.InquiryId)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("inquiry %d doesn't exist", msg.InquiryId))
+	}
+	offer, found := k.GetHostingOffer(ctx, msg.OfferId)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("offer %d doesn't exist", msg.OfferId))
 	}
 
-	id := k.AppendHostingContract(
-		ctx,
-		hostingContract,
-	)
+	// Only the provider of the offer can take a replica with it
+	if msg.Creator != offer.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	// Replicas of an auctioned inquiry go to the winning bids first
+	if k.isAuctionPending(ctx, msg.InquiryId) {
+		return nil, errorsmod.Wrapf(types.ErrAuctionPhase, "inquiry %d is auctioned until its auction settles", msg.InquiryId)
+	}
+
+	// The offer must meet the inquiry's matching criteria like a matched one
+	if reason := k.CheckOfferEligibility(ctx, inquiry, offer); reason != types.OFFER_REJECTION_REASON_UNSPECIFIED {
+		return nil, errorsmod.Wrapf(types.ErrOfferNotEligible, "offer %d: %s", msg.OfferId, reason)
+	}
+
+	currentBlock := uint64(ctx.BlockHeight())
+	if inquiry.EndTime <= currentBlock {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("inquiry %d ended at block %d", msg.InquiryId, inquiry.EndTime))
+	}
+
+	// The offer takes one of the replicas the inquiry still has open
+	for _, contract := range k.GetHostingContractsByInquiry(ctx, msg.InquiryId) {
+		if !contract.Status.IsFinal() && contract.OfferId == msg.OfferId {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "offer %d already hosts inquiry %d", msg.OfferId, msg.InquiryId)
+		}
+	}
+	if inquiry.FilledReplicas >= inquiry.ReplicationRate {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "inquiry %d has no open replicas", msg.InquiryId)
+	}
+
+	// The contract opens PENDING like the ones opened when the inquiry is created
+	id := k.OpenHostingContract(ctx, inquiry, offer)
 
 	return &types.MsgCreateHostingContractResponse{
 		Id: id,
@@ -32,13 +66,6 @@ func (k msgServer) CreateHostingContract(goCtx context.Context, msg *types.MsgCr
 func (k msgServer) UpdateHostingContract(goCtx context.Context, msg *types.MsgUpdateHostingContract) (*types.MsgUpdateHostingContractResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	var hostingContract = types.HostingContract{
-		Creator:   msg.Creator,
-		Id:        msg.Id,
-		InquiryId: msg.InquiryId,
-		OfferId:   msg.OfferId,
-	}
-
 	// Checks that the element exists
 	val, found := k.GetHostingContract(ctx, msg.Id)
 	if !found {
@@ -50,7 +77,43 @@ func (k msgServer) UpdateHostingContract(goCtx context.Context, msg *types.MsgUp
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
-	k.SetHostingContract(ctx, hostingContract)
+	// A contract hosts the inquiry it opened for until it ends
+	if msg.InquiryId != 0 && msg.InquiryId != val.InquiryId {
+		return nil, errorsmod.Wrapf(types.ErrImmutableField, "contract %d hosts inquiry %d", val.Id, val.InquiryId)
+	}
+
+	// No offer, or the contract's own, withdraws a proposed hand-over
+	if msg.OfferId == 0 || msg.OfferId == val.OfferId {
+		val.TransferOfferId = 0
+		k.SetHostingContract(ctx, val)
+		return &types.MsgUpdateHostingContractResponse{}, nil
+	}
+
+	// Only a contract the provider accepted can be handed over; a PENDING one
+	// is rejected instead
+	if val.Status != types.CONTRACT_STATUS_ACTIVE {
+		return nil, errorsmod.Wrapf(types.ErrInvalidContractTransition, "contract %d is %s", val.Id, val.Status)
+	}
+
+	// The offer must be one the inquiry could have been matched to
+	offer, found := k.GetHostingOffer(ctx, msg.OfferId)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("offer %d doesn't exist", msg.OfferId))
+	}
+	inquiry, found := k.GetHostingInquiry(ctx, val.InquiryId)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("inquiry %d doesn't exist", val.InquiryId))
+	}
+	if k.getContractedProviders(ctx, inquiry.Id)[offer.Creator] {
+		return nil, errorsmod.Wrapf(types.ErrOfferNotEligible, "offer %d: %s", offer.Id, types.OFFER_REJECTION_REASON_PROVIDER_CONTRACTED)
+	}
+	if reason := k.CheckOfferEligibility(ctx, inquiry, offer); reason != types.OFFER_REJECTION_REASON_UNSPECIFIED {
+		return nil, errorsmod.Wrapf(types.ErrOfferNotEligible, "offer %d: %s", offer.Id, reason)
+	}
+
+	// The provider of the offer takes the contract over once it accepts
+	val.TransferOfferId = offer.Id
+	k.SetHostingContract(ctx, val)
 
 	return &types.MsgUpdateHostingContractResponse{}, nil
 }
@@ -69,7 +132,223 @@ func (k msgServer) DeleteHostingContract(goCtx context.Context, msg *types.MsgDe
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
+	// Only a contract the provider has not taken up yet can be dropped. An
+	// active contract is settled by terminating it, and contracts that ended
+	// keep their records
+	if val.Status != types.CONTRACT_STATUS_PENDING {
+		return nil, errorsmod.Wrapf(types.ErrInvalidContractTransition, "contract %d is %s", val.Id, val.Status)
+	}
+
 	k.RemoveHostingContract(ctx, msg.Id)
 
+	// The pending contract gives its replica back to the inquiry and its
+	// capacity back to the provider
+	k.addFilledReplicas(ctx, val.InquiryId, -1)
+	k.releaseCapacity(ctx, val.Creator, val.ReservedCapacity)
+
 	return &types.MsgDeleteHostingContractResponse{}, nil
 }
+
+func (k msgServer) TerminateHostingContract(goCtx context.Context, msg *types.MsgTerminateHostingContract) (*types.MsgTerminateHostingContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract, found := k.GetHostingContract(ctx, msg.ContractId)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.ContractId))
+	}
+	inquiry, found := k.GetHostingInquiry(ctx, contract.InquiryId)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("inquiry %d doesn't exist", contract.InquiryId))
+	}
+
+	// Either party to the contract can walk away from it
+	reason := "terminated by provider"
+	switch msg.Creator {
+	case contract.Creator:
+	case inquiry.Creator:
+		reason = "terminated by inquiry creator"
+	default:
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+	if msg.Reason != "" {
+		reason = fmt.Sprintf("%s: %s", reason, msg.Reason)
+	}
+
+	settlement, err := k.Keeper.TerminateHostingContract(ctx, contract, msg.Creator, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTerminateHostingContractResponse{
+		Settled:  settlement.Settled,
+		Penalty:  settlement.Penalty,
+		Refunded: settlement.Refunded,
+	}, nil
+}
+
+func (k msgServer) AcceptHostingContract(goCtx context.Context, msg *types.MsgAcceptHostingContract) (*types.MsgAcceptHostingContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	currentHeight := uint64(ctx.BlockHeight())
+
+	contract, found := k.GetHostingContract(ctx, msg.ContractId)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.ContractId))
+	}
+
+	// Only the provider the contract was offered to can accept it
+	if msg.Creator != contract.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	if contract.Status != types.CONTRACT_STATUS_PENDING {
+		return nil, errorsmod.Wrapf(types.ErrInvalidContractTransition, "contract %d is %s", contract.Id, contract.Status)
+	}
+
+	if contract.AcceptanceDeadline != 0 && currentHeight > contract.AcceptanceDeadline {
+		return nil, errorsmod.Wrapf(types.ErrAcceptanceDeadlinePassed, "deadline was block %d", contract.AcceptanceDeadline)
+	}
+
+	if currentHeight >= contract.EndBlock {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "contract %d ended at block %d", contract.Id, contract.EndBlock)
+	}
+
+	// The provider proves it holds the file
+	if err := k.verifyAcceptanceProof(ctx, contract, msg.Attestation, msg.Proof); err != nil {
+		return nil, err
+	}
+
+	// Escrow only starts streaming to the provider from the block it accepts
+	contract.Attestation = msg.Attestation
+	contract.StartBlock = currentHeight
+
+	if _, err := k.TransitionHostingContract(ctx, contract, types.CONTRACT_STATUS_ACTIVE, "accepted by provider"); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptHostingContractResponse{}, nil
+}
+
+func (k msgServer) RejectHostingContract(goCtx context.Context, msg *types.MsgRejectHostingContract) (*types.MsgRejectHostingContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract, found := k.GetHostingContract(ctx, msg.ContractId)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.ContractId))
+	}
+
+	// Only the provider the contract was offered to can reject it
+	if msg.Creator != contract.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	if contract.Status != types.CONTRACT_STATUS_PENDING {
+		return nil, errorsmod.Wrapf(types.ErrInvalidContractTransition, "contract %d is %s", contract.Id, contract.Status)
+	}
+
+	reason := "rejected by provider"
+	if msg.Reason != "" {
+		reason = fmt.Sprintf("%s: %s", reason, msg.Reason)
+	}
+
+	contract, err := k.TransitionHostingContract(ctx, contract, types.CONTRACT_STATUS_TERMINATED, reason)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.recordReputationEvent(ctx, contract.Creator, types.REPUTATION_EVENT_EARLY_TERMINATION); err != nil {
+		return nil, err
+	}
+
+	k.ReassignContractSlot(ctx, contract)
+
+	return &types.MsgRejectHostingContractResponse{}, nil
+}
+
+func (k msgServer) AcceptHostingContractTransfer(goCtx context.Context, msg *types.MsgAcceptHostingContractTransfer) (*types.MsgAcceptHostingContractTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract, found := k.GetHostingContract(ctx, msg.ContractId)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.ContractId))
+	}
+	if contract.TransferOfferId == 0 {
+		return nil, errorsmod.Wrapf(types.ErrNoContractTransfer, "contract %d", contract.Id)
+	}
+
+	// Only the provider of the offer the contract is proposed to can take it over
+	offer, found := k.GetHostingOffer(ctx, contract.TransferOfferId)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("offer %d doesn't exist", contract.TransferOfferId))
+	}
+	if msg.Creator != offer.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	if contract.Status != types.CONTRACT_STATUS_ACTIVE {
+		return nil, errorsmod.Wrapf(types.ErrInvalidContractTransition, "contract %d is %s", contract.Id, contract.Status)
+	}
+	if currentHeight := uint64(ctx.BlockHeight()); currentHeight >= contract.EndBlock {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "contract %d ended at block %d", contract.Id, contract.EndBlock)
+	}
+
+	// The new provider proves it holds the file like on acceptance
+	if err := k.verifyAcceptanceProof(ctx, contract, msg.Attestation, msg.Proof); err != nil {
+		return nil, err
+	}
+	contract.Attestation = msg.Attestation
+
+	if _, err := k.reassignHostingContract(ctx, contract, offer, "handed over by provider"); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptHostingContractTransferResponse{}, nil
+}
+
+func (k msgServer) ReassignHostingContract(goCtx context.Context, req *types.MsgReassignHostingContract) (*types.MsgReassignHostingContractResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	contract, found := k.GetHostingContract(ctx, req.ContractId)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", req.ContractId))
+	}
+	offer, found := k.GetHostingOffer(ctx, req.OfferId)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("offer %d doesn't exist", req.OfferId))
+	}
+
+	reason := req.Reason
+	if reason == "" {
+		reason = "reassigned by governance"
+	}
+	if _, err := k.reassignHostingContract(ctx, contract, offer, reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReassignHostingContractResponse{}, nil
+}
+
+// verifyAcceptanceProof checks that a provider holds the file of a contract:
+// the attestation must be the file entry's commitment and the contract's
+// acceptance chunk must be included under it
+func (k msgServer) verifyAcceptanceProof(ctx sdk.Context, contract types.HostingContract, attestation []byte, proof types.ChunkProof) error {
+	inquiry, found := k.GetHostingInquiry(ctx, contract.InquiryId)
+	if !found {
+		return errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("inquiry %d doesn't exist", contract.InquiryId))
+	}
+	fileEntry, found := k.GetInquiryFileEntry(ctx, inquiry)
+	if !found || len(fileEntry.MerkleRoot) == 0 {
+		return errorsmod.Wrapf(types.ErrInvalidAttestation, "file entry %d has no merkle commitment to prove against", inquiry.FileEntryId)
+	}
+	if !bytes.Equal(fileEntry.MerkleRoot, attestation) {
+		return errorsmod.Wrap(types.ErrInvalidAttestation, "attestation does not match the file entry's merkle root")
+	}
+	if proof.ChunkIndex != contract.AcceptanceChunk {
+		return errorsmod.Wrapf(types.ErrInvalidStorageProof, "proof is for chunk %d, expected chunk %d", proof.ChunkIndex, contract.AcceptanceChunk)
+	}
+	if err := verifyChunkProof(fileEntry, proof); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidStorageProof, err.Error())
+	}
+	return nil
+}
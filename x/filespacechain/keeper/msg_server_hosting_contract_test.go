@@ -1,25 +1,70 @@
 package keeper_test
 
 import (
+	"bytes"
 	"testing"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
+	keepertest "github.com/hanshq/filespace-chain/testutil/keeper"
+	"github.com/hanshq/filespace-chain/testutil/sample"
+	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
+	"github.com/hanshq/filespace-chain/x/filespacechain/merkle"
 	"github.com/hanshq/filespace-chain/x/filespacechain/types"
 )
 
+// setupOpenInquiry stores an inquiry for one replica and an offer by each of
+// the staked providers A and B
+func setupOpenInquiry(k keeper.Keeper, ctx sdk.Context) types.HostingInquiry {
+	inquiry := types.HostingInquiry{
+		ReplicationRate: 1,
+		EscrowAmount:    sdk.NewCoin("token", math.NewInt(1000)),
+		EndTime:         1000,
+	}
+	inquiry.Id = k.AppendHostingInquiry(ctx, inquiry)
+	for _, provider := range []string{"A", "B"} {
+		k.SetProviderStake(ctx, provider, sdk.NewCoin("token", k.GetParams(ctx).MinProviderStake), 1)
+		k.AppendHostingOffer(ctx, types.HostingOffer{
+			Creator:       provider,
+			PricePerBlock: sdk.NewCoin("token", math.NewInt(1)),
+		})
+	}
+	return inquiry
+}
+
 func TestHostingContractMsgServerCreate(t *testing.T) {
-	_, srv, ctx := setupMsgServer(t)
-	wctx := sdk.UnwrapSDKContext(ctx)
+	k, srv, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(10)
+	inquiry := setupOpenInquiry(k, ctx)
 
-	creator := "A"
-	for i := 0; i < 5; i++ {
-		resp, err := srv.CreateHostingContract(wctx, &types.MsgCreateHostingContract{Creator: creator})
-		require.NoError(t, err)
-		require.Equal(t, i, int(resp.Id))
-	}
+	_, err := srv.CreateHostingContract(ctx, &types.MsgCreateHostingContract{Creator: "A", InquiryId: 5, OfferId: 0})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = srv.CreateHostingContract(ctx, &types.MsgCreateHostingContract{Creator: "A", InquiryId: inquiry.Id, OfferId: 5})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// Only the provider of the offer can take a replica with it
+	_, err = srv.CreateHostingContract(ctx, &types.MsgCreateHostingContract{Creator: "B", InquiryId: inquiry.Id, OfferId: 0})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.CreateHostingContract(ctx.WithBlockHeight(int64(inquiry.EndTime)), &types.MsgCreateHostingContract{Creator: "A", InquiryId: inquiry.Id, OfferId: 0})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// The contract is offered to the provider like a matched one
+	resp, err := srv.CreateHostingContract(ctx, &types.MsgCreateHostingContract{Creator: "A", InquiryId: inquiry.Id, OfferId: 0})
+	require.NoError(t, err)
+	contract, found := k.GetHostingContract(ctx, resp.Id)
+	require.True(t, found)
+	require.Equal(t, types.CONTRACT_STATUS_PENDING, contract.Status)
+	require.Equal(t, uint64(10), contract.StartBlock)
+	require.Equal(t, inquiry.EndTime, contract.EndBlock)
+	require.Equal(t, uint64(10)+k.GetParams(ctx).AcceptanceDeadline, contract.AcceptanceDeadline)
+
+	// The only replica is taken
+	_, err = srv.CreateHostingContract(ctx, &types.MsgCreateHostingContract{Creator: "B", InquiryId: inquiry.Id, OfferId: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
 }
 
 func TestHostingContractMsgServerUpdate(t *testing.T) {
@@ -47,10 +92,11 @@ func TestHostingContractMsgServerUpdate(t *testing.T) {
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
-			_, srv, ctx := setupMsgServer(t)
+			k, srv, ctx := setupMsgServer(t)
 			wctx := sdk.UnwrapSDKContext(ctx)
+			inquiry := setupOpenInquiry(k, wctx)
 
-			_, err := srv.CreateHostingContract(wctx, &types.MsgCreateHostingContract{Creator: creator})
+			_, err := srv.CreateHostingContract(wctx, &types.MsgCreateHostingContract{Creator: creator, InquiryId: inquiry.Id})
 			require.NoError(t, err)
 
 			_, err = srv.UpdateHostingContract(wctx, tc.request)
@@ -88,10 +134,11 @@ func TestHostingContractMsgServerDelete(t *testing.T) {
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
-			_, srv, ctx := setupMsgServer(t)
+			k, srv, ctx := setupMsgServer(t)
 			wctx := sdk.UnwrapSDKContext(ctx)
+			inquiry := setupOpenInquiry(k, wctx)
 
-			_, err := srv.CreateHostingContract(wctx, &types.MsgCreateHostingContract{Creator: creator})
+			_, err := srv.CreateHostingContract(wctx, &types.MsgCreateHostingContract{Creator: creator, InquiryId: inquiry.Id})
 			require.NoError(t, err)
 			_, err = srv.DeleteHostingContract(wctx, tc.request)
 			if tc.err != nil {
@@ -102,3 +149,447 @@ func TestHostingContractMsgServerDelete(t *testing.T) {
 		})
 	}
 }
+
+// hostedFileChunks are the chunks of the file stored by setupPendingContract
+var hostedFileChunks = [][]byte{[]byte("0123"), []byte("4567"), []byte("89")}
+
+func hostedFileTree(t *testing.T) *merkle.Tree {
+	tree, err := merkle.NewTree(hostedFileChunks)
+	require.NoError(t, err)
+	return tree
+}
+
+// setupPendingContract stores a committed file, an inquiry for one replica and
+// three offers, and opens a PENDING contract for the cheapest offer
+func setupPendingContract(t *testing.T, k keeper.Keeper, ctx sdk.Context) (types.HostingContract, []byte) {
+	tree := hostedFileTree(t)
+	fileEntryId := k.AppendFileEntry(ctx, types.FileEntry{
+		Cid:        "bafyfile",
+		MerkleRoot: tree.Root(),
+		ChunkCount: tree.LeafCount(),
+		ChunkSize:  4,
+		FileSize:   10,
+	})
+
+	inquiry := types.HostingInquiry{
+		FileEntryCid:    "bafyfile",
+		FileEntryId:     fileEntryId,
+		ReplicationRate: 1,
+		EscrowAmount:    sdk.NewCoin("token", math.NewInt(1000)),
+		EndTime:         1000,
+	}
+	inquiry.Id = k.AppendHostingInquiry(ctx, inquiry)
+
+	for i, provider := range []string{"cheap", "middle", "expensive"} {
+		k.SetProviderStake(ctx, provider, sdk.NewCoin("token", k.GetParams(ctx).MinProviderStake), 1)
+		k.AppendHostingOffer(ctx, types.HostingOffer{
+			Creator:       provider,
+			PricePerBlock: sdk.NewCoin("token", math.NewInt(int64(10*(i+1)))),
+		})
+	}
+
+	offers := k.MatchHostingOffers(ctx, inquiry, 1).Matched
+	require.Len(t, offers, 1)
+	require.Equal(t, "cheap", offers[0].Creator)
+
+	contract, _ := k.GetHostingContract(ctx, k.OpenHostingContract(ctx, inquiry, offers[0]))
+	return contract, tree.Root()
+}
+
+// acceptContract builds the message the provider of a contract opened by
+// setupPendingContract accepts it with, proving its acceptance chunk
+func acceptContract(t *testing.T, contract types.HostingContract) *types.MsgAcceptHostingContract {
+	tree := hostedFileTree(t)
+	siblings, err := tree.Proof(contract.AcceptanceChunk)
+	require.NoError(t, err)
+	return &types.MsgAcceptHostingContract{
+		Creator:     contract.Creator,
+		ContractId:  contract.Id,
+		Attestation: tree.Root(),
+		Proof: types.ChunkProof{
+			ChunkIndex: contract.AcceptanceChunk,
+			Chunk:      hostedFileChunks[contract.AcceptanceChunk],
+			Siblings:   siblings,
+		},
+	}
+}
+
+func TestAcceptHostingContract(t *testing.T) {
+	k, srv, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(10)
+	contract, root := setupPendingContract(t, k, ctx)
+	require.Equal(t, types.CONTRACT_STATUS_PENDING, contract.Status)
+	require.Equal(t, uint64(10)+k.GetParams(ctx).AcceptanceDeadline, contract.AcceptanceDeadline)
+
+	// Pending contracts are not paid
+	require.Empty(t, k.GetActiveContracts(ctx))
+
+	require.Less(t, contract.AcceptanceChunk, uint64(len(hostedFileChunks)))
+
+	msg := acceptContract(t, contract)
+	msg.Creator = "middle"
+	_, err := srv.AcceptHostingContract(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	msg = acceptContract(t, contract)
+	msg.Attestation = bytes.Repeat([]byte{8}, 32)
+	_, err = srv.AcceptHostingContract(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidAttestation)
+
+	// Knowing the public root is not enough: the chunk must be proven
+	msg = acceptContract(t, contract)
+	msg.Proof.Chunk = []byte("nope")
+	_, err = srv.AcceptHostingContract(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidStorageProof)
+
+	other := contract
+	other.AcceptanceChunk = (contract.AcceptanceChunk + 1) % uint64(len(hostedFileChunks))
+	msg = acceptContract(t, other)
+	_, err = srv.AcceptHostingContract(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidStorageProof)
+
+	accepted := ctx.WithBlockHeight(20)
+	_, err = srv.AcceptHostingContract(accepted, acceptContract(t, contract))
+	require.NoError(t, err)
+
+	got, _ := k.GetHostingContract(accepted, contract.Id)
+	require.Equal(t, types.CONTRACT_STATUS_ACTIVE, got.Status)
+	require.Equal(t, uint64(20), got.StartBlock)
+	require.Equal(t, root, got.Attestation)
+	require.Len(t, k.GetActiveContracts(accepted), 1)
+
+	// Accepting twice is an invalid transition
+	_, err = srv.AcceptHostingContract(accepted, acceptContract(t, contract))
+	require.ErrorIs(t, err, types.ErrInvalidContractTransition)
+}
+
+func TestRejectHostingContractReassignsSlot(t *testing.T) {
+	k, srv, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(10)
+	contract, _ := setupPendingContract(t, k, ctx)
+
+	_, err := srv.RejectHostingContract(ctx, &types.MsgRejectHostingContract{Creator: "cheap", ContractId: contract.Id, Reason: "no capacity"})
+	require.NoError(t, err)
+
+	got, _ := k.GetHostingContract(ctx, contract.Id)
+	require.Equal(t, types.CONTRACT_STATUS_TERMINATED, got.Status)
+
+	next, found := k.GetHostingContract(ctx, contract.Id+1)
+	require.True(t, found)
+	require.Equal(t, "middle", next.Creator)
+	require.Equal(t, types.CONTRACT_STATUS_PENDING, next.Status)
+}
+
+func TestAcceptanceDeadlineReassignsSlot(t *testing.T) {
+	k, srv, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(10)
+	contract, _ := setupPendingContract(t, k, ctx)
+
+	// Nothing happens up to and including the deadline
+	require.NoError(t, k.ProcessAcceptanceDeadlines(ctx.WithBlockHeight(int64(contract.AcceptanceDeadline))))
+	got, _ := k.GetHostingContract(ctx, contract.Id)
+	require.Equal(t, types.CONTRACT_STATUS_PENDING, got.Status)
+
+	late := ctx.WithBlockHeight(int64(contract.AcceptanceDeadline) + 1)
+	_, err := srv.AcceptHostingContract(late, acceptContract(t, contract))
+	require.ErrorIs(t, err, types.ErrAcceptanceDeadlinePassed)
+
+	require.NoError(t, k.ProcessAcceptanceDeadlines(late))
+	got, _ = k.GetHostingContract(late, contract.Id)
+	require.Equal(t, types.CONTRACT_STATUS_TERMINATED, got.Status)
+
+	next, found := k.GetHostingContract(late, contract.Id+1)
+	require.True(t, found)
+	require.Equal(t, "middle", next.Creator)
+	require.Equal(t, uint64(late.BlockHeight())+k.GetParams(late).AcceptanceDeadline, next.AcceptanceDeadline)
+}
+
+func TestHostingContractHandOver(t *testing.T) {
+	k, srv, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(10)
+	contract, _ := setupPendingContract(t, k, ctx)
+
+	// Only an accepted contract is handed over
+	_, err := srv.UpdateHostingContract(ctx, &types.MsgUpdateHostingContract{Creator: "cheap", Id: contract.Id, OfferId: 1})
+	require.ErrorIs(t, err, types.ErrInvalidContractTransition)
+	_, err = srv.AcceptHostingContract(ctx, acceptContract(t, contract))
+	require.NoError(t, err)
+
+	_, err = srv.UpdateHostingContract(ctx, &types.MsgUpdateHostingContract{Creator: "cheap", Id: contract.Id, InquiryId: 7})
+	require.ErrorIs(t, err, types.ErrImmutableField)
+	_, err = srv.UpdateHostingContract(ctx, &types.MsgUpdateHostingContract{Creator: "middle", Id: contract.Id, OfferId: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// The provider of the middle offer takes the contract over by proving it
+	// holds the file
+	_, err = srv.UpdateHostingContract(ctx, &types.MsgUpdateHostingContract{Creator: "cheap", Id: contract.Id, OfferId: 1})
+	require.NoError(t, err)
+	got, _ := k.GetHostingContract(ctx, contract.Id)
+	require.Equal(t, uint64(1), got.TransferOfferId)
+
+	transfer := func(creator string) *types.MsgAcceptHostingContractTransfer {
+		accept := acceptContract(t, got)
+		return &types.MsgAcceptHostingContractTransfer{
+			Creator: creator, ContractId: contract.Id, Attestation: accept.Attestation, Proof: accept.Proof,
+		}
+	}
+	_, err = srv.AcceptHostingContractTransfer(ctx, transfer("expensive"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	msg := transfer("middle")
+	msg.Proof.Chunk = []byte("nope")
+	_, err = srv.AcceptHostingContractTransfer(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidStorageProof)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = srv.AcceptHostingContractTransfer(ctx, transfer("middle"))
+	require.NoError(t, err)
+	got, _ = k.GetHostingContract(ctx, contract.Id)
+	require.Equal(t, "middle", got.Creator)
+	require.Equal(t, uint64(1), got.OfferId)
+	require.Zero(t, got.TransferOfferId)
+	require.Equal(t, types.CONTRACT_STATUS_ACTIVE, got.Status)
+	require.Equal(t, contract.PricePerBlock, got.PricePerBlock)
+	require.Len(t, k.GetHostingContractsByProvider(ctx, "middle"), 1)
+	require.Empty(t, k.GetHostingContractsByProvider(ctx, "cheap"))
+
+	reassigned := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "filespacechain.filespacechain.EventHostingContractReassigned" {
+			reassigned++
+		}
+	}
+	require.Equal(t, 1, reassigned)
+
+	_, err = srv.AcceptHostingContractTransfer(ctx, transfer("middle"))
+	require.ErrorIs(t, err, types.ErrNoContractTransfer)
+
+	// Without consent only governance reassigns a contract, and only to a
+	// provider that holds no replica of the inquiry
+	_, err = srv.ReassignHostingContract(ctx, &types.MsgReassignHostingContract{Authority: "middle", ContractId: contract.Id, OfferId: 2})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = srv.ReassignHostingContract(ctx, &types.MsgReassignHostingContract{Authority: k.GetAuthority(), ContractId: contract.Id, OfferId: 1})
+	require.ErrorIs(t, err, types.ErrOfferNotEligible)
+	_, err = srv.ReassignHostingContract(ctx, &types.MsgReassignHostingContract{Authority: k.GetAuthority(), ContractId: contract.Id, OfferId: 2})
+	require.NoError(t, err)
+	got, _ = k.GetHostingContract(ctx, contract.Id)
+	require.Equal(t, "expensive", got.Creator)
+	require.Equal(t, uint64(2), got.OfferId)
+}
+
+func TestHandOverWaitsForPendingChallenge(t *testing.T) {
+	k, srv, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(10)
+	contract, _ := setupPendingContract(t, k, ctx)
+	_, err := srv.AcceptHostingContract(ctx, acceptContract(t, contract))
+	require.NoError(t, err)
+	_, err = srv.UpdateHostingContract(ctx, &types.MsgUpdateHostingContract{Creator: "cheap", Id: contract.Id, OfferId: 1})
+	require.NoError(t, err)
+
+	challenge := types.StorageChallenge{
+		ContractId:    contract.Id,
+		Provider:      "cheap",
+		IssuedBlock:   10,
+		DeadlineBlock: 20,
+		Status:        types.CHALLENGE_STATUS_PENDING,
+	}
+	challenge.Id = k.AppendStorageChallenge(ctx, challenge)
+
+	// The provider a challenge was issued to answers it before handing over
+	accept := acceptContract(t, contract)
+	transfer := &types.MsgAcceptHostingContractTransfer{
+		Creator: "middle", ContractId: contract.Id, Attestation: accept.Attestation, Proof: accept.Proof,
+	}
+	_, err = srv.AcceptHostingContractTransfer(ctx, transfer)
+	require.ErrorIs(t, err, types.ErrChallengePending)
+	_, err = srv.ReassignHostingContract(ctx, &types.MsgReassignHostingContract{Authority: k.GetAuthority(), ContractId: contract.Id, OfferId: 2})
+	require.ErrorIs(t, err, types.ErrChallengePending)
+
+	challenge.Status = types.CHALLENGE_STATUS_PASSED
+	k.SetStorageChallenge(ctx, challenge)
+	_, err = srv.AcceptHostingContractTransfer(ctx, transfer)
+	require.NoError(t, err)
+
+	// A challenge of the previous provider failing leaves the contract to
+	// its new provider
+	k.FailStorageChallenge(ctx, challenge, types.CHALLENGE_STATUS_MISSED, "missed proof")
+	got, _ := k.GetHostingContract(ctx, contract.Id)
+	require.Equal(t, "middle", got.Creator)
+	require.Equal(t, types.CONTRACT_STATUS_ACTIVE, got.Status)
+}
+
+// setupActiveContracts escrows an inquiry for two replicas with a share of
+// 200 each, running from block 10 to 110, and activates a contract for each
+// replica with a provider charging 1 per block
+func setupActiveContracts(t *testing.T, bank *keepertest.MockBankKeeper, k keeper.Keeper, srv types.MsgServer, ctx sdk.Context) (string, []types.HostingContract) {
+	params := k.GetParams(ctx)
+	creator := sample.AccAddress()
+	bank.Fund(sdk.MustAccAddressFromBech32(creator), sdk.NewCoins(sdk.NewCoin("token", math.NewInt(1000))))
+	k.AppendFileEntry(ctx, types.FileEntry{Creator: creator, Cid: "bafyfile", FileSize: 1_000_000_000_000, MerkleRoot: []byte{1}})
+	resp, err := srv.CreateHostingInquiry(ctx, &types.MsgCreateHostingInquiry{
+		Creator: creator, FileEntryCid: "bafyfile", ReplicationRate: 2, EndTime: 110, EscrowAmount: sdk.NewCoin("token", math.NewInt(400)),
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		provider := sample.AccAddress()
+		k.SetProviderStake(ctx, provider, sdk.NewCoin(params.BondDenom, params.MinProviderStake), 1)
+		bank.FundModule("hosting_bonded_pool", sdk.NewCoins(sdk.NewCoin(params.BondDenom, params.MinProviderStake)))
+		_, err := srv.CreateHostingOffer(ctx, &types.MsgCreateHostingOffer{Creator: provider, PricePerBlock: sdk.NewCoin("token", math.NewInt(1))})
+		require.NoError(t, err)
+	}
+
+	var contracts []types.HostingContract
+	for _, contract := range k.GetHostingContractsByInquiry(ctx, resp.Id) {
+		contract, err := k.TransitionHostingContract(ctx, contract, types.CONTRACT_STATUS_ACTIVE, "accepted by provider")
+		require.NoError(t, err)
+		contracts = append(contracts, contract)
+	}
+	require.Len(t, contracts, 2)
+	return creator, contracts
+}
+
+func TestSlashedContractReplicaNotRefilled(t *testing.T) {
+	bank := keepertest.NewMockBankKeeper()
+	k, ctx := keepertest.FilespacechainKeeperWithBank(t, bank)
+	srv := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(10)
+	params := k.GetParams(ctx)
+
+	creator, contracts := setupActiveContracts(t, bank, k, srv, ctx)
+	creatorAddr := sdk.MustAccAddressFromBech32(creator)
+	inquiryId := contracts[0].InquiryId
+
+	// Each contract has drawn 25 of its share of 200 by block 60
+	ctx = ctx.WithBlockHeight(60)
+	require.NoError(t, k.ProcessPeriodicPayments(ctx))
+
+	slashed := contracts[0]
+	k.FailStorageChallenge(ctx, types.StorageChallenge{ContractId: slashed.Id, Provider: slashed.Creator}, types.CHALLENGE_STATUS_MISSED, "proof missed")
+	got, _ := k.GetHostingContract(ctx, slashed.Id)
+	require.Equal(t, types.CONTRACT_STATUS_SLASHED, got.Status)
+
+	// The undrawn 175 of the share goes back to the creator and the replica
+	// stays filled
+	history, found := k.GetPaymentHistory(ctx, slashed.Id)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(25), history.TotalPaid.Amount)
+	require.Equal(t, math.NewInt(175), history.Refunded.Amount)
+	require.Equal(t, math.NewInt(1000-400+175), bank.GetBalance(ctx, creatorAddr, "token").Amount)
+	inquiry, _ := k.GetHostingInquiry(ctx, inquiryId)
+	require.Equal(t, uint64(2), inquiry.FilledReplicas)
+
+	// A new offer is not matched to the slashed replica
+	other := sample.AccAddress()
+	k.SetProviderStake(ctx, other, sdk.NewCoin(params.BondDenom, params.MinProviderStake), 1)
+	_, err := srv.CreateHostingOffer(ctx, &types.MsgCreateHostingOffer{Creator: other, PricePerBlock: sdk.NewCoin("token", math.NewInt(1))})
+	require.NoError(t, err)
+	require.Len(t, k.GetHostingContractsByInquiry(ctx, inquiryId), 2)
+
+	// The running contract is paid out in full and the escrow stays solvent
+	ctx = ctx.WithBlockHeight(110)
+	require.NoError(t, k.ProcessPeriodicPayments(ctx))
+	require.NoError(t, k.CompleteHostingContract(ctx, contracts[1].Id))
+	msg, broken := keeper.EscrowSolvencyInvariant(k)(ctx)
+	require.False(t, broken, msg)
+	msg, broken = keeper.PaymentsWithinEscrowInvariant(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestTerminateHostingContract(t *testing.T) {
+	bank := keepertest.NewMockBankKeeper()
+	k, ctx := keepertest.FilespacechainKeeperWithBank(t, bank)
+	srv := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(10)
+	params := k.GetParams(ctx)
+	params.ProviderTerminationSlashFraction = math.LegacyNewDecWithPrec(1, 1)
+	params.ClientTerminationPenaltyFraction = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	creator, contracts := setupActiveContracts(t, bank, k, srv, ctx)
+	creatorAddr := sdk.MustAccAddressFromBech32(creator)
+	inquiryId := contracts[0].InquiryId
+
+	// Each contract charges 101 over its term and has been paid 25 of the
+	// half that streams by block 60
+	ctx = ctx.WithBlockHeight(60)
+	require.NoError(t, k.ProcessPeriodicPayments(ctx))
+
+	_, err := srv.TerminateHostingContract(ctx, &types.MsgTerminateHostingContract{Creator: sample.AccAddress(), ContractId: contracts[0].Id})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.TerminateHostingContract(ctx, &types.MsgTerminateHostingContract{Creator: creator, ContractId: 10})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// A provider walking away is paid the 51 it earned and slashed
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	provider := contracts[0].Creator
+	resp, err := srv.TerminateHostingContract(ctx, &types.MsgTerminateHostingContract{Creator: provider, ContractId: contracts[0].Id, Reason: "disk failure"})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(26), resp.Settled.Amount)
+	require.True(t, resp.Penalty.IsZero())
+	require.Equal(t, math.NewInt(149), resp.Refunded.Amount)
+	require.Equal(t, math.NewInt(51), bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(provider), "token").Amount)
+	require.Equal(t, math.NewInt(1000-400+149), bank.GetBalance(ctx, creatorAddr, "token").Amount)
+	stake, found := k.GetProviderStake(ctx, provider)
+	require.True(t, found)
+	require.Equal(t, params.MinProviderStake.MulRaw(9).QuoRaw(10), stake.Amount.Amount)
+	reputation, _ := k.GetProviderReputation(ctx, provider)
+	require.Equal(t, uint64(1), reputation.EarlyTerminations)
+
+	terminated := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "filespacechain.filespacechain.EventHostingContractTerminated" {
+			terminated++
+		}
+	}
+	require.Equal(t, 1, terminated)
+
+	// An inquiry creator walking away pays half of the 50 the provider
+	// did not earn
+	provider = contracts[1].Creator
+	resp, err = srv.TerminateHostingContract(ctx, &types.MsgTerminateHostingContract{Creator: creator, ContractId: contracts[1].Id})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(26), resp.Settled.Amount)
+	require.Equal(t, math.NewInt(25), resp.Penalty.Amount)
+	require.Equal(t, math.NewInt(124), resp.Refunded.Amount)
+	require.Equal(t, math.NewInt(76), bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(provider), "token").Amount)
+	require.Equal(t, math.NewInt(1000-400+149+124), bank.GetBalance(ctx, creatorAddr, "token").Amount)
+	stake, _ = k.GetProviderStake(ctx, provider)
+	require.Equal(t, params.MinProviderStake, stake.Amount.Amount)
+	require.True(t, bank.ModuleBalance(types.ModuleName).IsZero())
+
+	// Terminated contracts keep their records, and their paid out replicas
+	// are not matched again
+	for _, contract := range contracts {
+		got, found := k.GetHostingContract(ctx, contract.Id)
+		require.True(t, found)
+		require.Equal(t, types.CONTRACT_STATUS_TERMINATED, got.Status)
+		_, err = srv.TerminateHostingContract(ctx, &types.MsgTerminateHostingContract{Creator: creator, ContractId: contract.Id})
+		require.ErrorIs(t, err, types.ErrInvalidContractTransition)
+		_, err = srv.DeleteHostingContract(ctx, &types.MsgDeleteHostingContract{Creator: got.Creator, Id: contract.Id})
+		require.ErrorIs(t, err, types.ErrInvalidContractTransition)
+	}
+	inquiry, _ := k.GetHostingInquiry(ctx, inquiryId)
+	require.Equal(t, uint64(2), inquiry.FilledReplicas)
+	other := sample.AccAddress()
+	k.SetProviderStake(ctx, other, sdk.NewCoin(params.BondDenom, params.MinProviderStake), 1)
+	_, err = srv.CreateHostingOffer(ctx, &types.MsgCreateHostingOffer{Creator: other, PricePerBlock: sdk.NewCoin("token", math.NewInt(1))})
+	require.NoError(t, err)
+	require.Len(t, k.GetHostingContractsByInquiry(ctx, inquiryId), 2)
+
+	// Their histories count payouts and refunds apart, and are pruned once
+	// the contracts' end block is far enough behind
+	history, found := k.GetPaymentHistory(ctx, contracts[1].Id)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoin("token", math.NewInt(76)), history.TotalPaid)
+	require.Equal(t, sdk.NewCoin("token", math.NewInt(124)), history.Refunded)
+	require.NoError(t, k.CleanupCompletedPaymentHistory(ctx.WithBlockHeight(150), 50))
+	for _, contract := range contracts {
+		_, found := k.GetPaymentHistory(ctx, contract.Id)
+		require.True(t, found)
+	}
+	require.NoError(t, k.CleanupCompletedPaymentHistory(ctx.WithBlockHeight(161), 50))
+	for _, contract := range contracts {
+		_, found := k.GetPaymentHistory(ctx, contract.Id)
+		require.False(t, found)
+	}
+}
@@ -2,14 +2,10 @@ package keeper
 
 import (
 	"context"
-	"cosmossdk.io/store/prefix"
-	storetypes "cosmossdk.io/store/types"
 	"fmt"
-	"github.com/cosmos/cosmos-sdk/runtime"
-	"sort"
-	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/hanshq/filespace-chain/x/filespacechain/types"
@@ -18,17 +14,35 @@ import (
 func (k msgServer) CreateHostingInquiry(goCtx context.Context, msg *types.MsgCreateHostingInquiry) (*types.MsgCreateHostingInquiryResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// Get file entry to determine file size
-	fileEntry, found := k.GetFileEntryByCid(goCtx, msg.FileEntryCid)
+	// Get file entry to determine file size; the inquiry is bound to its id
+	fileEntry, found := k.resolveInquiryFileEntry(goCtx, msg.FileEntryCid, msg.Creator)
 	if !found {
 		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("file entry with CID %s not found", msg.FileEntryCid))
 	}
 
+	// Providers accept and answer challenges with proofs against the file's
+	// Merkle commitment, so a file without one cannot be hosted
+	if len(fileEntry.MerkleRoot) == 0 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidFileCommitment, "file entry %d has no merkle commitment", fileEntry.Id)
+	}
+
 	// Calculate required escrow amount based on file size, duration, and replication
 	currentBlock := uint64(ctx.BlockHeight())
+	if msg.EndTime <= currentBlock {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("end time %d must be after the current block %d", msg.EndTime, currentBlock))
+	}
 	duration := msg.EndTime - currentBlock
+
+	// An auctioned inquiry must outlast its bidding and reveal windows
+	auctioned := msg.AuctionCommitBlocks > 0
+	if auctioned && msg.EndTime <= currentBlock+msg.AuctionCommitBlocks+msg.AuctionRevealBlocks {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"end time %d must be after the auction closes at block %d", msg.EndTime, currentBlock+msg.AuctionCommitBlocks+msg.AuctionRevealBlocks)
+	}
 	
-	calculatedEscrow, err := k.CalculateEscrowAmount(goCtx, fileEntry.FileSize, duration, msg.ReplicationRate)
+	// The inquiry is paid in the denom of its escrow, which must be accepted
+	calculatedEscrow, err := k.CalculateEscrowAmount(goCtx, msg.EscrowAmount.Denom, fileEntry.FileSize, duration, msg.ReplicationRate)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to calculate escrow amount")
 	}
@@ -39,6 +53,19 @@ func (k msgServer) CreateHostingInquiry(goCtx context.Context, msg *types.MsgCre
 			fmt.Sprintf("escrow amount %s is less than required %s", msg.EscrowAmount.String(), calculatedEscrow.String()))
 	}
 
+	// The price ceiling is kept in the escrow denom, offers in other denoms never match
+	var maxPricePerBlock uint64
+	if !msg.MaxPricePerBlock.IsNil() && msg.MaxPricePerBlock.IsPositive() {
+		if msg.MaxPricePerBlock.Denom != msg.EscrowAmount.Denom {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+				"max price per block %s must be in the escrow denom %s", msg.MaxPricePerBlock, msg.EscrowAmount.Denom)
+		}
+		if !msg.MaxPricePerBlock.Amount.IsUint64() {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "max price per block %s is too large", msg.MaxPricePerBlock)
+		}
+		maxPricePerBlock = msg.MaxPricePerBlock.Amount.Uint64()
+	}
+
 	// Convert creator address
 	creatorAddr, err := sdk.AccAddressFromBech32(msg.Creator)
 	if err != nil {
@@ -52,54 +79,42 @@ func (k msgServer) CreateHostingInquiry(goCtx context.Context, msg *types.MsgCre
 	}
 
 	var hostingInquiry = types.HostingInquiry{
-		Creator:         msg.Creator,
-		FileEntryCid:    msg.FileEntryCid,
-		ReplicationRate: msg.ReplicationRate,
-		EscrowAmount:    msg.EscrowAmount,
-		EndTime:         msg.EndTime,
+		Creator:          msg.Creator,
+		FileEntryCid:     msg.FileEntryCid,
+		FileEntryId:      fileEntry.Id,
+		ReplicationRate:  msg.ReplicationRate,
+		EscrowAmount:     msg.EscrowAmount,
+		EndTime:          msg.EndTime,
+		MaxPricePerBlock: maxPricePerBlock,
+		AllowedRegions:   msg.AllowedRegions,
+		DeniedRegions:    msg.DeniedRegions,
 	}
 
 	id := k.AppendHostingInquiry(
 		ctx,
 		hostingInquiry,
 	)
+	hostingInquiry.Id = id
 
 	// Store escrow tracking record
 	k.SetEscrowRecord(goCtx, id, msg.EscrowAmount, msg.Creator)
 
-	// Find the lowest hosting offers
-	lowestOffers, err := k.GetLowestHostingOffers(ctx, hostingInquiry)
-
-	if err != nil {
-		fmt.Printf("Could not find lowest offers %s\n", err.Error())
+	// Providers bid for the replicas of an auctioned inquiry instead of being matched
+	if auctioned {
+		k.SetAuction(ctx, types.Auction{
+			InquiryId:     id,
+			CommitEnd:     currentBlock + msg.AuctionCommitBlocks,
+			RevealEnd:     currentBlock + msg.AuctionCommitBlocks + msg.AuctionRevealBlocks,
+			ClearingPrice: sdk.NewCoin(msg.EscrowAmount.Denom, math.ZeroInt()),
+		})
+		return &types.MsgCreateHostingInquiryResponse{
+			Id: id,
+		}, nil
 	}
 
-	for i, offer := range lowestOffers {
-		if uint64(i) >= hostingInquiry.ReplicationRate {
-			break // Do not create more contracts than the replication rate
-		}
-
-		// Create the hosting contract
-		contract := types.HostingContract{
-			InquiryId: id,
-			OfferId:   offer.Id,
-			Creator:   offer.Creator,
-			// ... other contract fields
-		}
-		k.AppendHostingContract(ctx, contract)
-
-		// Emit event for each contract creation
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				"createHostingContract", // This is the event type
-				sdk.NewAttribute("InquiryId", strconv.FormatUint(id, 10)),
-				sdk.NewAttribute("OfferId", strconv.FormatUint(offer.Id, 10)),
-				sdk.NewAttribute("FileEntryCID", msg.FileEntryCid),
-				sdk.NewAttribute("HosterId", offer.Creator),
-				sdk.NewAttribute("Test", strconv.Itoa(1)),
-				// ... other attributes
-			),
-		)
+	// Match offers to every replica; the match result explains short matches
+	if _, err := k.matchAndOpenContracts(ctx, hostingInquiry, hostingInquiry.ReplicationRate); err != nil {
+		return nil, err
 	}
 
 	return &types.MsgCreateHostingInquiryResponse{
@@ -107,68 +122,126 @@ func (k msgServer) CreateHostingInquiry(goCtx context.Context, msg *types.MsgCre
 	}, nil
 }
 
-// GetLowestHostingOffers fetches and returns the lowest hosting offers based on the inquiry criteria.
-func (k Keeper) GetLowestHostingOffers(ctx sdk.Context, inquiry types.HostingInquiry) ([]types.HostingOffer, error) {
-	var offers []types.HostingOffer
-	var lowestOffers []types.HostingOffer
-
-	// Example: Fetch all offers from the store
-	// This is a simplified example. You should replace it with the actual method to query offers from your store.
-	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.HostingOfferKey))
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+func (k msgServer) UpdateHostingInquiry(goCtx context.Context, msg *types.MsgUpdateHostingInquiry) (*types.MsgUpdateHostingInquiryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	defer iterator.Close()
+	// Checks that the element exists
+	val, found := k.GetHostingInquiry(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
 
-	for ; iterator.Valid(); iterator.Next() {
-		var val types.HostingOffer
-		k.cdc.MustUnmarshal(iterator.Value(), &val)
-		offers = append(offers, val)
+	// Checks if the msg creator is the same as the current owner
+	if msg.Creator != val.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
-	// Sort offers based on your criteria, e.g., price
-	sort.Slice(offers, func(i, j int) bool {
-		return offers[i].PricePerBlock.IsLT(offers[j].PricePerBlock) // Assuming 'Price' is a field in your HostingOffer type
-	})
+	// Providers accepted the inquiry's file, escrow, term and matching
+	// criteria; fields left empty keep their value
+	if msg.FileEntryCid != "" && msg.FileEntryCid != val.FileEntryCid {
+		return nil, errorsmod.Wrapf(types.ErrImmutableField, "inquiry %d hosts file entry %s", val.Id, val.FileEntryCid)
+	}
+	if !msg.EscrowAmount.IsNil() && !msg.EscrowAmount.IsZero() && msg.EscrowAmount.String() != val.EscrowAmount.String() {
+		return nil, errorsmod.Wrapf(types.ErrImmutableField, "inquiry %d escrows %s; top it up with an extension", val.Id, val.EscrowAmount)
+	}
+	if msg.EndTime != 0 && msg.EndTime != val.EndTime {
+		return nil, errorsmod.Wrapf(types.ErrImmutableField, "inquiry %d ends at block %d; push it out with an extension", val.Id, val.EndTime)
+	}
+	if !msg.MaxPricePerBlock.IsNil() && !msg.MaxPricePerBlock.IsZero() &&
+		(msg.MaxPricePerBlock.Denom != val.EscrowAmount.Denom || !msg.MaxPricePerBlock.Amount.Equal(math.NewIntFromUint64(val.MaxPricePerBlock))) {
+		return nil, errorsmod.Wrapf(types.ErrImmutableField, "inquiry %d has a maximum price per block of %d%s", val.Id, val.MaxPricePerBlock, val.EscrowAmount.Denom)
+	}
 
-	// Filter and select top 'n' offers based on inquiry's replication rate
-	for _, offer := range offers {
-		if uint64(len(lowestOffers)) >= inquiry.ReplicationRate {
-			break
-		}
-		// Additional filtering criteria can be applied here
-		lowestOffers = append(lowestOffers, offer)
+	if msg.ReplicationRate == 0 || msg.ReplicationRate == val.ReplicationRate {
+		return &types.MsgUpdateHostingInquiryResponse{}, nil
+	}
+	if err := k.changeReplicationRate(ctx, val, msg.ReplicationRate); err != nil {
+		return nil, err
 	}
 
-	return lowestOffers, nil
+	return &types.MsgUpdateHostingInquiryResponse{}, nil
 }
 
-func (k msgServer) UpdateHostingInquiry(goCtx context.Context, msg *types.MsgUpdateHostingInquiry) (*types.MsgUpdateHostingInquiryResponse, error) {
-	ctx := sdk.UnwrapSDKContext(goCtx)
+// changeReplicationRate opens or closes replicas of a running inquiry. Every
+// replica keeps the share of the escrow it holds: added replicas are escrowed
+// from the creator at that share and matched to offers, removed ones must be
+// unfilled and their share is refunded.
+func (k msgServer) changeReplicationRate(ctx sdk.Context, inquiry types.HostingInquiry, replicationRate uint64) error {
+	currentBlock := uint64(ctx.BlockHeight())
+	if inquiry.EndTime <= currentBlock {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "inquiry %d ended at block %d", inquiry.Id, inquiry.EndTime)
+	}
 
-	var hostingInquiry = types.HostingInquiry{
-		Creator:         msg.Creator,
-		Id:              msg.Id,
-		FileEntryCid:    msg.FileEntryCid,
-		ReplicationRate: msg.ReplicationRate,
-		EscrowAmount:    msg.EscrowAmount,
-		EndTime:         msg.EndTime,
+	// The replicas of an auctioned inquiry are fixed while providers bid for them
+	if k.isAuctionPending(ctx, inquiry.Id) {
+		return errorsmod.Wrapf(types.ErrAuctionPhase, "inquiry %d is auctioned until its auction settles", inquiry.Id)
+	}
+	if replicationRate < inquiry.FilledReplicas {
+		return errorsmod.Wrapf(types.ErrReplicasInUse,
+			"replication rate %d is below the %d replicas of inquiry %d its contracts hold", replicationRate, inquiry.FilledReplicas, inquiry.Id)
 	}
 
-	// Checks that the element exists
-	val, found := k.GetHostingInquiry(ctx, msg.Id)
+	escrowRecord, found := k.GetEscrowRecord(ctx, inquiry.Id)
 	if !found {
-		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+		return errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "inquiry %d has no escrow", inquiry.Id)
+	}
+	creatorAddr, err := sdk.AccAddressFromBech32(escrowRecord.Creator)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid creator address in escrow record")
 	}
+	share := types.ContractEscrowShare(escrowRecord.Amount, inquiry.ReplicationRate)
+
+	escrowAmount := escrowRecord.Amount
+	if replicationRate > inquiry.ReplicationRate {
+		added := share.Amount.Mul(math.NewIntFromUint64(replicationRate - inquiry.ReplicationRate))
+		escrowAmount = escrowAmount.AddAmount(added)
+		if added.IsPositive() {
+			if err := k.EscrowFunds(ctx, creatorAddr, sdk.NewCoin(escrowAmount.Denom, added)); err != nil {
+				return errorsmod.Wrap(err, "failed to escrow funds")
+			}
+		}
+	} else {
+		// The escrow left must still cover the shares of the running contracts
+		// and what contracts that ended already drew from it
+		kept := share.Amount.Mul(math.NewIntFromUint64(replicationRate))
+		committed := math.ZeroInt()
+		for _, contract := range k.GetHostingContractsByInquiry(ctx, inquiry.Id) {
+			if !contract.Status.IsFinal() {
+				committed = committed.Add(share.Amount)
+				continue
+			}
+			if paymentHistory, found := k.GetPaymentHistory(ctx, contract.Id); found {
+				committed = committed.Add(paymentHistory.Drawn().Amount)
+			}
+		}
+		if kept.LT(committed) {
+			return errorsmod.Wrapf(types.ErrReplicasInUse,
+				"contracts of inquiry %d hold or drew %s%s of its escrow", inquiry.Id, committed, share.Denom)
+		}
 
-	// Checks if the msg creator is the same as the current owner
-	if msg.Creator != val.Creator {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+		escrowAmount = sdk.NewCoin(share.Denom, kept)
+		refund := escrowRecord.Amount.Sub(escrowAmount)
+		if refund.IsPositive() {
+			if err := k.RefundFunds(ctx, creatorAddr, refund); err != nil {
+				return errorsmod.Wrap(err, "failed to refund escrowed funds")
+			}
+		}
+	}
+	if err := k.UpdateEscrowAmount(ctx, inquiry.Id, escrowAmount); err != nil {
+		return err
 	}
 
-	k.SetHostingInquiry(ctx, hostingInquiry)
+	inquiry.ReplicationRate = replicationRate
+	inquiry.EscrowAmount = escrowAmount
+	k.SetHostingInquiry(ctx, inquiry)
 
-	return &types.MsgUpdateHostingInquiryResponse{}, nil
+	// Match offers to the replicas opened
+	if inquiry.FilledReplicas < inquiry.ReplicationRate {
+		if _, err := k.matchAndOpenContracts(ctx, inquiry, inquiry.ReplicationRate-inquiry.FilledReplicas); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (k msgServer) DeleteHostingInquiry(goCtx context.Context, msg *types.MsgDeleteHostingInquiry) (*types.MsgDeleteHostingInquiryResponse, error) {
@@ -185,9 +258,28 @@ func (k msgServer) DeleteHostingInquiry(goCtx context.Context, msg *types.MsgDel
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
-	// Check if there are any active contracts for this inquiry
-	// TODO: Add logic to check for active contracts and handle accordingly
-	// For now, we'll allow deletion and refund escrow
+	// Settle the inquiry's active contracts as terminated by its creator and
+	// terminate the pending ones, so none of them is paid again. A contract
+	// that reached its end block completes
+	currentBlock := uint64(ctx.BlockHeight())
+	for _, contract := range k.GetHostingContractsByInquiry(ctx, msg.Id) {
+		switch contract.Status {
+		case types.CONTRACT_STATUS_ACTIVE:
+			if currentBlock >= contract.EndBlock {
+				if err := k.CompleteHostingContract(ctx, contract.Id); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			if _, err := k.Keeper.TerminateHostingContract(ctx, contract, msg.Creator, "inquiry cancelled"); err != nil {
+				return nil, err
+			}
+		case types.CONTRACT_STATUS_PENDING:
+			if _, err := k.TransitionHostingContract(ctx, contract, types.CONTRACT_STATUS_TERMINATED, "inquiry cancelled"); err != nil {
+				return nil, err
+			}
+		}
+	}
 
 	// Get escrow record
 	escrowRecord, found := k.GetEscrowRecord(goCtx, msg.Id)
@@ -198,17 +290,124 @@ func (k msgServer) DeleteHostingInquiry(goCtx context.Context, msg *types.MsgDel
 			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid creator address in escrow record")
 		}
 
-		// Refund escrowed funds
-		err = k.RefundFunds(goCtx, creatorAddr, escrowRecord.Amount)
-		if err != nil {
-			return nil, errorsmod.Wrap(err, "failed to refund escrowed funds")
+		// Refund what the contracts did not draw from the escrow
+		outstanding := escrowRecord.Amount
+		for _, contract := range k.GetHostingContractsByInquiry(ctx, msg.Id) {
+			if history, found := k.GetPaymentHistory(ctx, contract.Id); found && history.TotalPaid.Denom == outstanding.Denom {
+				outstanding.Amount = outstanding.Amount.Sub(history.Drawn().Amount)
+			}
+		}
+		if outstanding.IsPositive() {
+			if err := k.RefundFunds(goCtx, creatorAddr, outstanding); err != nil {
+				return nil, errorsmod.Wrap(err, "failed to refund escrowed funds")
+			}
 		}
 
 		// Remove escrow record
 		k.RemoveEscrowRecord(goCtx, msg.Id)
 	}
 
+	k.RemoveAuction(ctx, msg.Id)
 	k.RemoveHostingInquiry(ctx, msg.Id)
 
 	return &types.MsgDeleteHostingInquiryResponse{}, nil
 }
+
+func (k msgServer) ExtendHostingInquiry(goCtx context.Context, msg *types.MsgExtendHostingInquiry) (*types.MsgExtendHostingInquiryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Checks that the element exists
+	inquiry, found := k.GetHostingInquiry(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+
+	// Checks if the msg creator is the same as the current owner
+	if msg.Creator != inquiry.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	// Once an inquiry ended its escrow is being settled, so only a running
+	// inquiry can be extended
+	currentBlock := uint64(ctx.BlockHeight())
+	if inquiry.EndTime <= currentBlock {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "inquiry %d ended at block %d", inquiry.Id, inquiry.EndTime)
+	}
+	if msg.EndTime <= inquiry.EndTime {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"end time %d must be after the current end time %d", msg.EndTime, inquiry.EndTime)
+	}
+
+	escrowRecord, found := k.GetEscrowRecord(goCtx, inquiry.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "inquiry %d has no escrow", inquiry.Id)
+	}
+	if msg.AdditionalEscrow.Denom != escrowRecord.Amount.Denom {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"additional escrow %s must be in the escrow denom %s", msg.AdditionalEscrow, escrowRecord.Amount.Denom)
+	}
+
+	fileEntry, found := k.GetInquiryFileEntry(ctx, inquiry)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "file entry of inquiry %d not found", inquiry.Id)
+	}
+
+	// The added blocks are priced like a new inquiry over them
+	calculatedEscrow, err := k.CalculateEscrowAmount(goCtx, escrowRecord.Amount.Denom, fileEntry.FileSize, msg.EndTime-inquiry.EndTime, inquiry.ReplicationRate)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to calculate escrow amount")
+	}
+	if msg.AdditionalEscrow.IsLT(calculatedEscrow) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("additional escrow %s is less than required %s", msg.AdditionalEscrow.String(), calculatedEscrow.String()))
+	}
+
+	// Convert creator address
+	creatorAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid creator address")
+	}
+
+	// Lock the additional escrow funds
+	if msg.AdditionalEscrow.IsPositive() {
+		if err := k.EscrowFunds(goCtx, creatorAddr, msg.AdditionalEscrow); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to escrow funds")
+		}
+	}
+	escrowAmount := escrowRecord.Amount.Add(msg.AdditionalEscrow)
+	if err := k.UpdateEscrowAmount(goCtx, inquiry.Id, escrowAmount); err != nil {
+		return nil, err
+	}
+
+	previousEndTime := inquiry.EndTime
+	inquiry.EscrowAmount = escrowAmount
+	inquiry.EndTime = msg.EndTime
+	k.SetHostingInquiry(ctx, inquiry)
+
+	// Contracts still running, or waiting to be accepted, host the file until
+	// the new end time; their shares of the escrow grow with the top-up
+	for _, contract := range k.GetHostingContractsByInquiry(ctx, inquiry.Id) {
+		if contract.Status.IsFinal() {
+			continue
+		}
+		contract.EndBlock = msg.EndTime
+		k.SetHostingContract(ctx, contract)
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventHostingInquiryExtended{
+		InquiryId:        inquiry.Id,
+		Creator:          inquiry.Creator,
+		PreviousEndTime:  previousEndTime,
+		EndTime:          inquiry.EndTime,
+		AdditionalEscrow: msg.AdditionalEscrow,
+		EscrowAmount:     escrowAmount,
+		Height:           currentBlock,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExtendHostingInquiryResponse{
+		EscrowAmount: escrowAmount,
+	}, nil
+}
@@ -3,10 +3,14 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
+	keepertest "github.com/hanshq/filespace-chain/testutil/keeper"
+	"github.com/hanshq/filespace-chain/testutil/sample"
+	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
 	"github.com/hanshq/filespace-chain/x/filespacechain/types"
 )
 
@@ -102,3 +106,224 @@ func TestHostingInquiryMsgServerDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestHostingInquiryMsgServerPaymentDenom(t *testing.T) {
+	bank := keepertest.NewMockBankKeeper()
+	k, ctx := keepertest.FilespacechainKeeperWithBank(t, bank)
+	srv := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(10)
+
+	stable := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	params := k.GetParams(ctx)
+	params.AcceptedDenoms = append(params.AcceptedDenoms, types.PaymentDenom{
+		Denom:                    stable,
+		BasePricePerBytePerBlock: math.LegacyNewDecWithPrec(1, 6),
+	})
+	require.NoError(t, k.SetParams(ctx, params))
+
+	creator := sample.AccAddress()
+	bank.Fund(sdk.MustAccAddressFromBech32(creator), sdk.NewCoins(
+		sdk.NewCoin(stable, math.NewInt(1000)),
+		sdk.NewCoin("stake", math.NewInt(1000)),
+	))
+	k.AppendFileEntry(ctx, types.FileEntry{Creator: creator, Cid: "bafyfile", FileSize: 1_000_000, MerkleRoot: []byte{1}})
+	inquiry := func(escrow sdk.Coin) (*types.MsgCreateHostingInquiryResponse, error) {
+		return srv.CreateHostingInquiry(ctx, &types.MsgCreateHostingInquiry{
+			Creator: creator, FileEntryCid: "bafyfile", ReplicationRate: 2, EndTime: 110, EscrowAmount: escrow,
+		})
+	}
+
+	// 1,000,000 bytes for 100 blocks at two replicas cost 200 at the stable price
+	escrow, err := k.CalculateEscrowAmount(ctx, stable, 1_000_000, 100, 2)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(stable, math.NewInt(200)), escrow)
+	_, err = inquiry(sdk.NewCoin(stable, math.NewInt(199)))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = inquiry(sdk.NewCoin("stake", math.NewInt(200)))
+	require.ErrorIs(t, err, types.ErrDenomNotAccepted)
+
+	resp, err := inquiry(sdk.NewCoin(stable, math.NewInt(200)))
+	require.NoError(t, err)
+	record, found := k.GetEscrowRecord(ctx, resp.Id)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoin(stable, math.NewInt(200)), record.Amount)
+
+	// Offers are only priced in accepted denoms, and only match inquiries
+	// paid in the same denom
+	provider := sample.AccAddress()
+	k.SetProviderStake(ctx, provider, sdk.NewCoin(params.BondDenom, params.MinProviderStake), 1)
+	_, err = srv.CreateHostingOffer(ctx, &types.MsgCreateHostingOffer{Creator: provider, PricePerBlock: sdk.NewCoin("stake", math.NewInt(1))})
+	require.ErrorIs(t, err, types.ErrDenomNotAccepted)
+	_, err = srv.CreateHostingOffer(ctx, &types.MsgCreateHostingOffer{Creator: provider, PricePerBlock: sdk.NewCoin("token", math.NewInt(1))})
+	require.NoError(t, err)
+	require.Empty(t, k.GetHostingContractsByInquiry(ctx, resp.Id))
+
+	offer, err := srv.CreateHostingOffer(ctx, &types.MsgCreateHostingOffer{Creator: provider, PricePerBlock: sdk.NewCoin(stable, math.NewInt(1))})
+	require.NoError(t, err)
+	contracts := k.GetHostingContractsByInquiry(ctx, resp.Id)
+	require.Len(t, contracts, 1)
+	require.Equal(t, offer.Id, contracts[0].OfferId)
+}
+
+func TestHostingInquiryMsgServerExtend(t *testing.T) {
+	bank := keepertest.NewMockBankKeeper()
+	k, ctx := keepertest.FilespacechainKeeperWithBank(t, bank)
+	srv := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(10)
+	params := k.GetParams(ctx)
+
+	creator := sample.AccAddress()
+	creatorAddr := sdk.MustAccAddressFromBech32(creator)
+	bank.Fund(creatorAddr, sdk.NewCoins(sdk.NewCoin("token", math.NewInt(1_000_000))))
+	k.AppendFileEntry(ctx, types.FileEntry{Creator: creator, Cid: "bafyfile", FileSize: 1_000_000_000_000, MerkleRoot: []byte{1}})
+
+	escrow, err := k.CalculateEscrowAmount(ctx, "token", 1_000_000_000_000, 100, 2)
+	require.NoError(t, err)
+	resp, err := srv.CreateHostingInquiry(ctx, &types.MsgCreateHostingInquiry{
+		Creator: creator, FileEntryCid: "bafyfile", ReplicationRate: 2, EndTime: 110, EscrowAmount: escrow,
+	})
+	require.NoError(t, err)
+
+	provider := sample.AccAddress()
+	k.SetProviderStake(ctx, provider, sdk.NewCoin(params.BondDenom, params.MinProviderStake), 1)
+	_, err = srv.CreateHostingOffer(ctx, &types.MsgCreateHostingOffer{Creator: provider, PricePerBlock: sdk.NewCoin("token", math.NewInt(1))})
+	require.NoError(t, err)
+	require.Len(t, k.GetHostingContractsByInquiry(ctx, resp.Id), 1)
+
+	// Another 50 blocks cost what a new inquiry over them would
+	extension, err := k.CalculateEscrowAmount(ctx, "token", 1_000_000_000_000, 50, 2)
+	require.NoError(t, err)
+	extend := func(creator string, endTime uint64, additional sdk.Coin) (*types.MsgExtendHostingInquiryResponse, error) {
+		return srv.ExtendHostingInquiry(ctx, &types.MsgExtendHostingInquiry{
+			Creator: creator, Id: resp.Id, EndTime: endTime, AdditionalEscrow: additional,
+		})
+	}
+	_, err = extend(sample.AccAddress(), 160, extension)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = extend(creator, 110, extension)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = extend(creator, 160, extension.SubAmount(math.OneInt()))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = extend(creator, 160, sdk.NewCoin("stake", extension.Amount))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	extended, err := extend(creator, 160, extension)
+	require.NoError(t, err)
+	require.Equal(t, escrow.Add(extension), extended.EscrowAmount)
+	require.Equal(t, sdk.NewCoins(escrow.Add(extension)), bank.ModuleBalance(types.ModuleName))
+	record, found := k.GetEscrowRecord(ctx, resp.Id)
+	require.True(t, found)
+	require.Equal(t, escrow.Add(extension), record.Amount)
+
+	inquiry, found := k.GetHostingInquiry(ctx, resp.Id)
+	require.True(t, found)
+	require.Equal(t, uint64(160), inquiry.EndTime)
+	require.Equal(t, escrow.Add(extension), inquiry.EscrowAmount)
+	for _, contract := range k.GetHostingContractsByInquiry(ctx, resp.Id) {
+		require.Equal(t, uint64(160), contract.EndBlock)
+	}
+
+	// The inquiry no longer expires at its old end time
+	require.NoError(t, k.ProcessExpiredInquiries(ctx.WithBlockHeight(111)))
+	_, found = k.GetEscrowRecord(ctx, resp.Id)
+	require.True(t, found)
+
+	// Once the inquiry ended it can no longer be extended
+	ctx = ctx.WithBlockHeight(160)
+	_, err = extend(creator, 200, extension)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestHostingInquiryMsgServerReplicationRate(t *testing.T) {
+	bank := keepertest.NewMockBankKeeper()
+	k, ctx := keepertest.FilespacechainKeeperWithBank(t, bank)
+	srv := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(10)
+	params := k.GetParams(ctx)
+
+	creator := sample.AccAddress()
+	creatorAddr := sdk.MustAccAddressFromBech32(creator)
+	bank.Fund(creatorAddr, sdk.NewCoins(sdk.NewCoin("token", math.NewInt(1000))))
+	k.AppendFileEntry(ctx, types.FileEntry{Creator: creator, Cid: "bafyfile", FileSize: 1_000_000_000_000, MerkleRoot: []byte{1}})
+	offer := func() {
+		provider := sample.AccAddress()
+		k.SetProviderStake(ctx, provider, sdk.NewCoin(params.BondDenom, params.MinProviderStake), 1)
+		_, err := srv.CreateHostingOffer(ctx, &types.MsgCreateHostingOffer{Creator: provider, PricePerBlock: sdk.NewCoin("token", math.NewInt(1))})
+		require.NoError(t, err)
+	}
+
+	// Three replicas hold a share of 100 each; two are filled
+	offer()
+	resp, err := srv.CreateHostingInquiry(ctx, &types.MsgCreateHostingInquiry{
+		Creator: creator, FileEntryCid: "bafyfile", ReplicationRate: 3, EndTime: 110, EscrowAmount: sdk.NewCoin("token", math.NewInt(302)),
+	})
+	require.NoError(t, err)
+	offer()
+	require.Len(t, k.GetHostingContractsByInquiry(ctx, resp.Id), 2)
+
+	update := func(msg types.MsgUpdateHostingInquiry) error {
+		msg.Creator, msg.Id = creator, resp.Id
+		_, err := srv.UpdateHostingInquiry(ctx, &msg)
+		return err
+	}
+	require.ErrorIs(t, update(types.MsgUpdateHostingInquiry{FileEntryCid: "bafyother"}), types.ErrImmutableField)
+	require.ErrorIs(t, update(types.MsgUpdateHostingInquiry{EscrowAmount: sdk.NewCoin("token", math.NewInt(500))}), types.ErrImmutableField)
+	require.ErrorIs(t, update(types.MsgUpdateHostingInquiry{EndTime: 200}), types.ErrImmutableField)
+	require.ErrorIs(t, update(types.MsgUpdateHostingInquiry{MaxPricePerBlock: sdk.NewCoin("token", math.NewInt(5))}), types.ErrImmutableField)
+	require.NoError(t, update(types.MsgUpdateHostingInquiry{FileEntryCid: "bafyfile", EscrowAmount: sdk.NewCoin("token", math.NewInt(302)), EndTime: 110}))
+	_, err = srv.UpdateHostingInquiry(ctx, &types.MsgUpdateHostingInquiry{Creator: sample.AccAddress(), Id: resp.Id, ReplicationRate: 2})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// Filled replicas cannot be closed
+	require.ErrorIs(t, update(types.MsgUpdateHostingInquiry{ReplicationRate: 1}), types.ErrReplicasInUse)
+
+	// Closing the open replica refunds its share and what the split left over
+	require.NoError(t, update(types.MsgUpdateHostingInquiry{ReplicationRate: 2}))
+	require.Equal(t, math.NewInt(1000-302+102), bank.GetBalance(ctx, creatorAddr, "token").Amount)
+	record, found := k.GetEscrowRecord(ctx, resp.Id)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoin("token", math.NewInt(200)), record.Amount)
+	require.Equal(t, sdk.NewCoins(record.Amount), bank.ModuleBalance(types.ModuleName))
+
+	// A full inquiry is not matched to new offers
+	offer()
+	require.Len(t, k.GetHostingContractsByInquiry(ctx, resp.Id), 2)
+
+	// Opening replicas escrows a share for each and matches them
+	require.NoError(t, update(types.MsgUpdateHostingInquiry{ReplicationRate: 4}))
+	require.Equal(t, math.NewInt(1000-302+102-200), bank.GetBalance(ctx, creatorAddr, "token").Amount)
+	record, _ = k.GetEscrowRecord(ctx, resp.Id)
+	require.Equal(t, sdk.NewCoin("token", math.NewInt(400)), record.Amount)
+	require.Equal(t, sdk.NewCoin("token", math.NewInt(100)), types.ContractEscrowShare(record.Amount, 4))
+	require.Len(t, k.GetHostingContractsByInquiry(ctx, resp.Id), 3)
+	inquiry, found := k.GetHostingInquiry(ctx, resp.Id)
+	require.True(t, found)
+	require.Equal(t, uint64(4), inquiry.ReplicationRate)
+	require.Equal(t, uint64(3), inquiry.FilledReplicas)
+	require.Equal(t, record.Amount, inquiry.EscrowAmount)
+}
+
+func TestHostingInquiryMsgServerDeleteSettlesContracts(t *testing.T) {
+	bank := keepertest.NewMockBankKeeper()
+	k, ctx := keepertest.FilespacechainKeeperWithBank(t, bank)
+	srv := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithBlockHeight(10)
+
+	creator, contracts := setupActiveContracts(t, bank, k, srv, ctx)
+	ctx = ctx.WithBlockHeight(60)
+	require.NoError(t, k.ProcessPeriodicPayments(ctx))
+
+	// Cancelling the inquiry terminates its contracts on the creator's
+	// account: each provider keeps the 51 it earned and a tenth of the 50 it
+	// did not, and the creator gets back the rest of the escrow
+	_, err := srv.DeleteHostingInquiry(ctx, &types.MsgDeleteHostingInquiry{Creator: creator, Id: contracts[0].InquiryId})
+	require.NoError(t, err)
+	for _, contract := range contracts {
+		require.Equal(t, math.NewInt(56), bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(contract.Creator), "token").Amount)
+		got, found := k.GetHostingContract(ctx, contract.Id)
+		require.True(t, found)
+		require.Equal(t, types.CONTRACT_STATUS_TERMINATED, got.Status)
+	}
+	require.Equal(t, math.NewInt(1000-2*56), bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(creator), "token").Amount)
+	require.True(t, bank.ModuleBalance(types.ModuleName).IsZero())
+}
@@ -26,6 +26,11 @@ func (k msgServer) CreateHostingOffer(goCtx context.Context, msg *types.MsgCreat
 			fmt.Sprintf("provider stake validation failed: %s", err.Error()))
 	}
 
+	// Offers can only be priced in a denom storage is paid in
+	if !k.GetParams(ctx).IsAcceptedDenom(msg.PricePerBlock.Denom) {
+		return nil, errorsmod.Wrapf(types.ErrDenomNotAccepted, "offer price %s", msg.PricePerBlock)
+	}
+
 	var hostingOffer = types.HostingOffer{
 		Creator:       msg.Creator,
 		Region:        msg.Region,
@@ -36,6 +41,12 @@ func (k msgServer) CreateHostingOffer(goCtx context.Context, msg *types.MsgCreat
 		ctx,
 		hostingOffer,
 	)
+	hostingOffer.Id = id
+
+	// The new offer fills the replicas open inquiries are still short of
+	if err := k.fillOpenInquiries(ctx, hostingOffer); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgCreateHostingOfferResponse{
 		Id: id,
@@ -63,8 +74,18 @@ func (k msgServer) UpdateHostingOffer(goCtx context.Context, msg *types.MsgUpdat
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
+	// Offers can only be priced in a denom storage is paid in
+	if !k.GetParams(ctx).IsAcceptedDenom(msg.PricePerBlock.Denom) {
+		return nil, errorsmod.Wrapf(types.ErrDenomNotAccepted, "offer price %s", msg.PricePerBlock)
+	}
+
 	k.SetHostingOffer(ctx, hostingOffer)
 
+	// A cheaper price or a new region may make the offer match open inquiries
+	if err := k.fillOpenInquiries(ctx, hostingOffer); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgUpdateHostingOfferResponse{}, nil
 }
 
@@ -82,6 +103,13 @@ func (k msgServer) DeleteHostingOffer(goCtx context.Context, msg *types.MsgDelet
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
+	// Running contracts keep the offer they were opened for
+	for _, contract := range k.GetHostingContractsByProvider(ctx, val.Creator) {
+		if contract.OfferId == msg.Id && !contract.Status.IsFinal() {
+			return nil, errorsmod.Wrapf(types.ErrOfferInUse, "offer %d is hosted by contract %d", msg.Id, contract.Id)
+		}
+	}
+
 	k.RemoveHostingOffer(ctx, msg.Id)
 
 	return &types.MsgDeleteHostingOfferResponse{}, nil
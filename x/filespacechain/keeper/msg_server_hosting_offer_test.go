@@ -102,3 +102,18 @@ func TestHostingOfferMsgServerDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestHostingOfferMsgServerDeleteInUse(t *testing.T) {
+	k, srv, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	contract := appendProviderContract(k, wctx, "A", "B", 10, types.CONTRACT_STATUS_ACTIVE)
+	_, err := srv.DeleteHostingOffer(wctx, &types.MsgDeleteHostingOffer{Creator: "A", Id: contract.OfferId})
+	require.ErrorIs(t, err, types.ErrOfferInUse)
+
+	// Once the contract has finished the offer can go
+	_, err = k.TransitionHostingContract(wctx, contract, types.CONTRACT_STATUS_COMPLETED, "ended")
+	require.NoError(t, err)
+	_, err = srv.DeleteHostingOffer(wctx, &types.MsgDeleteHostingOffer{Creator: "A", Id: contract.OfferId})
+	require.NoError(t, err)
+}
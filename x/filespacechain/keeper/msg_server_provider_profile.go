@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func (k msgServer) RegisterProviderProfile(goCtx context.Context, msg *types.MsgRegisterProviderProfile) (*types.MsgRegisterProviderProfileResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetProviderProfile(ctx, msg.Creator); found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "provider %s already has a profile", msg.Creator)
+	}
+
+	// Contracts opened before the provider declared its capacity still take up space
+	k.SetProviderProfile(ctx, types.ProviderProfile{
+		Provider:      msg.Creator,
+		Moniker:       msg.Moniker,
+		TotalCapacity: msg.TotalCapacity,
+		UsedCapacity:  k.getReservedCapacity(ctx, msg.Creator),
+		Regions:       msg.Regions,
+		Endpoints:     msg.Endpoints,
+	})
+
+	return &types.MsgRegisterProviderProfileResponse{}, nil
+}
+
+func (k msgServer) UpdateProviderProfile(goCtx context.Context, msg *types.MsgUpdateProviderProfile) (*types.MsgUpdateProviderProfileResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetProviderProfile(ctx, msg.Creator)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "provider %s has no profile", msg.Creator)
+	}
+
+	// Capacity below what is in use only stops new contracts, running ones keep their space
+	val.Moniker = msg.Moniker
+	val.TotalCapacity = msg.TotalCapacity
+	val.Regions = msg.Regions
+	val.Endpoints = msg.Endpoints
+	k.SetProviderProfile(ctx, val)
+
+	return &types.MsgUpdateProviderProfileResponse{}, nil
+}
@@ -18,17 +18,8 @@ func (k msgServer) StakeForHostingProvider(goCtx context.Context, provider strin
 		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid provider address")
 	}
 
-	// Validate minimum stake amount
-	params := k.GetParams(goCtx)
-	minStakeAmount := sdk.NewCoin(amount.Denom, params.MinProviderStake)
-	
-	// For new stakes, the amount must meet minimum requirement
-	_, found := k.GetProviderStake(goCtx, provider)
-	if !found {
-		if amount.IsLT(minStakeAmount) {
-			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, 
-				fmt.Sprintf("stake amount %s is below minimum required %s", amount.String(), minStakeAmount.String()))
-		}
+	if err := k.validateStakeAmount(goCtx, provider, amount); err != nil {
+		return err
 	}
 
 	// Perform staking operation
@@ -48,56 +39,25 @@ func (k msgServer) UnstakeFromHostingProvider(goCtx context.Context, provider st
 		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid provider address")
 	}
 
-	// Get current stake
-	stake, found := k.GetProviderStake(goCtx, provider)
-	if !found {
-		return errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "provider has no stake")
-	}
-
-	// Validate unstake amount
-	if amount.Amount.GT(stake.Amount.Amount) {
-		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, 
-			fmt.Sprintf("cannot unstake %s, only %s staked", amount.String(), stake.Amount.String()))
-	}
-
-	// Calculate remaining stake after unstaking
-	remainingStake := stake.Amount.Sub(amount)
-	
-	// Check if remaining stake meets minimum requirement (unless unstaking everything)
-	if !remainingStake.IsZero() {
-		params := k.GetParams(goCtx)
-		minStakeAmount := sdk.NewCoin(amount.Denom, params.MinProviderStake)
-		
-		if remainingStake.IsLT(minStakeAmount) {
-			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, 
-				fmt.Sprintf("remaining stake %s would be below minimum required %s", 
-					remainingStake.String(), minStakeAmount.String()))
-		}
-	}
+	// Funds are queued for return after the unbonding period
+	_, err = k.BeginProviderUnbonding(goCtx, providerAddr, amount)
+	return err
+}
 
-	// Transfer funds from staking module account back to provider
-	coins := sdk.NewCoins(amount)
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(goCtx, "hosting_bonded_pool", providerAddr, coins)
-	if err != nil {
-		return errorsmod.Wrap(err, "failed to unstake funds")
+// validateStakeAmount checks that stake is bonded in the bond denom and that
+// a provider's first stake meets the minimum provider stake
+func (k msgServer) validateStakeAmount(goCtx context.Context, provider string, amount sdk.Coin) error {
+	params := k.GetParams(goCtx)
+	if amount.Denom != params.BondDenom {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "stake must be bonded in %s, got %s", params.BondDenom, amount.Denom)
 	}
 
-	// Update or remove stake record
-	if remainingStake.IsZero() {
-		k.RemoveProviderStake(goCtx, provider)
-	} else {
-		err = k.UpdateProviderStake(goCtx, provider, remainingStake)
-		if err != nil {
-			return errorsmod.Wrap(err, "failed to update stake record")
-		}
+	// For new stakes, the amount must meet minimum requirement
+	minStakeAmount := sdk.NewCoin(params.BondDenom, params.MinProviderStake)
+	if _, found := k.GetProviderStake(goCtx, provider); !found && amount.IsLT(minStakeAmount) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("stake amount %s is below minimum required %s", amount.String(), minStakeAmount.String()))
 	}
-
-	k.Logger().Info("funds unstaked from hosting", 
-		"provider", provider, 
-		"unstaked_amount", amount.String(),
-		"remaining_stake", remainingStake.String(),
-	)
-
 	return nil
 }
 
@@ -109,14 +69,8 @@ func (k msgServer) StakeForHosting(goCtx context.Context, msg *types.MsgStakeFor
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid creator address")
 	}
 
-	// Validate minimum stake amount for new stakes
-	params := k.GetParams(goCtx)
-	minStakeAmount := sdk.NewCoin(msg.Amount.Denom, params.MinProviderStake)
-	
-	_, found := k.GetProviderStake(goCtx, msg.Creator)
-	if !found && msg.Amount.IsLT(minStakeAmount) {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, 
-			fmt.Sprintf("stake amount %s is below minimum required %s", msg.Amount.String(), minStakeAmount.String()))
+	if err := k.validateStakeAmount(goCtx, msg.Creator, msg.Amount); err != nil {
+		return nil, err
 	}
 
 	// Perform staking operation using the keeper method
@@ -136,55 +90,11 @@ func (k msgServer) UnstakeFromHosting(goCtx context.Context, msg *types.MsgUnsta
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid creator address")
 	}
 
-	// Get current stake
-	stake, found := k.GetProviderStake(goCtx, msg.Creator)
-	if !found {
-		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "provider has no stake")
-	}
-
-	// Validate unstake amount
-	if msg.Amount.Amount.GT(stake.Amount.Amount) {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, 
-			fmt.Sprintf("cannot unstake %s, only %s staked", msg.Amount.String(), stake.Amount.String()))
-	}
-
-	// Calculate remaining stake after unstaking
-	remainingStake := stake.Amount.Sub(msg.Amount)
-	
-	// Check if remaining stake meets minimum requirement (unless unstaking everything)
-	if !remainingStake.IsZero() {
-		params := k.GetParams(goCtx)
-		minStakeAmount := sdk.NewCoin(msg.Amount.Denom, params.MinProviderStake)
-		
-		if remainingStake.IsLT(minStakeAmount) {
-			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, 
-				fmt.Sprintf("remaining stake %s would be below minimum required %s", 
-					remainingStake.String(), minStakeAmount.String()))
-		}
-	}
-
-	// Transfer funds from staking module account back to provider
-	coins := sdk.NewCoins(msg.Amount)
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(goCtx, "hosting_bonded_pool", creatorAddr, coins)
+	// Funds are queued for return after the unbonding period
+	entry, err := k.BeginProviderUnbonding(goCtx, creatorAddr, msg.Amount)
 	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to unstake funds")
-	}
-
-	// Update or remove stake record
-	if remainingStake.IsZero() {
-		k.RemoveProviderStake(goCtx, msg.Creator)
-	} else {
-		err = k.UpdateProviderStake(goCtx, msg.Creator, remainingStake)
-		if err != nil {
-			return nil, errorsmod.Wrap(err, "failed to update stake record")
-		}
+		return nil, err
 	}
 
-	k.Logger().Info("funds unstaked from hosting", 
-		"provider", msg.Creator, 
-		"unstaked_amount", msg.Amount.String(),
-		"remaining_stake", remainingStake.String(),
-	)
-
-	return &types.MsgUnstakeFromHostingResponse{}, nil
-}
\ No newline at end of file
+	return &types.MsgUnstakeFromHostingResponse{CompletionHeight: entry.CompletionHeight}, nil
+}
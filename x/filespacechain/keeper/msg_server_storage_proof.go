@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func (k msgServer) SubmitStorageProof(goCtx context.Context, msg *types.MsgSubmitStorageProof) (*types.MsgSubmitStorageProofResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	challenge, found := k.GetStorageChallenge(ctx, msg.ChallengeId)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrChallengeNotFound, fmt.Sprintf("challenge %d doesn't exist", msg.ChallengeId))
+	}
+
+	// Only the challenged provider can answer
+	if msg.Creator != challenge.Provider {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the challenged provider can submit a proof")
+	}
+
+	if challenge.Status != types.CHALLENGE_STATUS_PENDING {
+		return nil, errorsmod.Wrap(types.ErrChallengeNotPending, fmt.Sprintf("challenge %d is %s", challenge.Id, challenge.Status))
+	}
+
+	if uint64(ctx.BlockHeight()) > challenge.DeadlineBlock {
+		return nil, errorsmod.Wrap(types.ErrChallengeExpired, fmt.Sprintf("deadline was block %d", challenge.DeadlineBlock))
+	}
+
+	// An invalid proof is a verdict, not a failed transaction: the challenge
+	// is failed and the provider slashed without reverting the state change
+	if err := k.VerifyStorageProofs(ctx, challenge, msg.Proofs); err != nil {
+		k.FailStorageChallenge(ctx, challenge, types.CHALLENGE_STATUS_FAILED, err.Error())
+		return &types.MsgSubmitStorageProofResponse{Verified: false}, nil
+	}
+
+	k.PassStorageChallenge(ctx, challenge)
+
+	return &types.MsgSubmitStorageProofResponse{Verified: true}, nil
+}
@@ -0,0 +1,216 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/hanshq/filespace-chain/testutil/keeper"
+	"github.com/hanshq/filespace-chain/testutil/sample"
+	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func TestContractsChargedAtOfferPrice(t *testing.T) {
+	bank := keepertest.NewMockBankKeeper()
+	k, ctx := keepertest.FilespacechainKeeperWithBank(t, bank)
+	params := k.GetParams(ctx)
+	params.DustDestination = types.DUST_DESTINATION_COMMUNITY_POOL
+	params.CompletionBonusFraction = math.LegacyNewDecWithPrec(2, 1)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	creator := sample.AccAddress()
+	escrow := sdk.NewCoin("token", math.NewInt(1003))
+	inquiryId := k.AppendHostingInquiry(ctx, types.HostingInquiry{Creator: creator, ReplicationRate: 3, EscrowAmount: escrow, EndTime: 10})
+	k.SetEscrowRecord(ctx, inquiryId, escrow, creator)
+	bank.FundModule(types.ModuleName, sdk.NewCoins(escrow))
+
+	// Each contract's share of the escrow is 334; the last provider bid more
+	// than that over the term and is capped at the share
+	var (
+		providers   []string
+		contractIds []uint64
+	)
+	for _, price := range []int64{10, 20, 40} {
+		provider := sample.AccAddress()
+		providers = append(providers, provider)
+		offerId := k.AppendHostingOffer(ctx, types.HostingOffer{Creator: provider, InquiryId: inquiryId})
+		contractIds = append(contractIds, k.AppendHostingContract(ctx, types.HostingContract{
+			InquiryId: inquiryId, OfferId: offerId, Creator: provider, StartBlock: 1, EndBlock: 10,
+			Status: types.CONTRACT_STATUS_ACTIVE, PricePerBlock: sdk.NewCoin("token", math.NewInt(price)),
+		}))
+	}
+	charges := []int64{100, 200, 334}
+
+	// Without the 20% completion bonus, the charge streams over the term
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.ProcessPeriodicPayments(ctx))
+	for i, provider := range providers {
+		require.Equal(t, math.NewInt(charges[i]-charges[i]/5), bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(provider), "token").Amount)
+	}
+
+	// Settlement pays the rest of every charge and refunds the surplus of
+	// each share to the creator
+	require.NoError(t, k.ProcessExpiredContracts(ctx))
+	for i, provider := range providers {
+		require.Equal(t, math.NewInt(charges[i]), bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(provider), "token").Amount)
+	}
+	require.Equal(t, math.NewInt(234+134), bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(creator), "token").Amount)
+	require.True(t, bank.CommunityPool().IsZero())
+
+	// Payment histories count provider payouts only; refunds are kept apart
+	for i, contractId := range contractIds {
+		history, found := k.GetPaymentHistory(ctx, contractId)
+		require.True(t, found)
+		require.Equal(t, sdk.NewCoin("token", math.NewInt(charges[i])), history.TotalPaid)
+		require.Equal(t, sdk.NewCoin("token", math.NewInt(334-charges[i])), history.Refunded)
+		require.Equal(t, sdk.NewCoin("token", math.NewInt(334)), history.Drawn())
+	}
+
+	refunded := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "filespacechain.filespacechain.EventContractSurplusRefunded" {
+			refunded++
+		}
+	}
+	require.Equal(t, 2, refunded)
+
+	// The token the escrow could not split over the contracts is swept to
+	// the community pool once the inquiry expires
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.ProcessExpiredInquiries(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("token", math.NewInt(1))), bank.CommunityPool())
+	require.Equal(t, math.NewInt(234+134), bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(creator), "token").Amount)
+	require.True(t, bank.ModuleBalance(types.ModuleName).IsZero())
+
+	swept := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "filespacechain.filespacechain.EventPaymentDustSwept" {
+			swept++
+		}
+	}
+	require.Equal(t, 1, swept)
+}
+
+// TestPaymentConservation settles thousands of randomized contracts, some of
+// them terminated early, and checks that every escrowed token ends up with a
+// provider, an inquiry creator or the community pool
+func TestPaymentConservation(t *testing.T) {
+	const (
+		numContracts = 2000
+		numProviders = 25
+		maxEndTime   = 300
+	)
+	r := rand.New(rand.NewSource(1))
+
+	bank := keepertest.NewMockBankKeeper()
+	k, ctx := keepertest.FilespacechainKeeperWithBank(t, bank)
+	// Every block runs on a cache store that is committed at its end, as on
+	// chain; iterating over a large set of uncommitted writes gets slow
+	store, ok := ctx.MultiStore().(storetypes.CommitMultiStore)
+	require.True(t, ok)
+	params := k.GetParams(ctx)
+	params.MaxExpiriesPerBlock = numContracts
+
+	var providers []string
+	for i := 0; i < numProviders; i++ {
+		providers = append(providers, sample.AccAddress())
+	}
+
+	var (
+		creators    []string
+		total       = math.ZeroInt()
+		terminateAt = make(map[uint64]uint64)
+	)
+	for created := 0; created < numContracts; {
+		creator := sample.AccAddress()
+		creators = append(creators, creator)
+
+		replicationRate := uint64(r.Intn(5) + 1)
+		endTime := uint64(r.Intn(maxEndTime-10) + 10)
+		escrow := sdk.NewCoin("token", math.NewInt(r.Int63n(1_000_000)+1))
+		total = total.Add(escrow.Amount)
+
+		inquiryId := k.AppendHostingInquiry(ctx, types.HostingInquiry{
+			Creator: creator, ReplicationRate: replicationRate, EscrowAmount: escrow, EndTime: endTime,
+		})
+		k.SetEscrowRecord(ctx, inquiryId, escrow, creator)
+		bank.FundModule(types.ModuleName, sdk.NewCoins(escrow))
+
+		// Not every slot is filled, and some contracts end early
+		for i := 0; i < r.Intn(int(replicationRate))+1; i++ {
+			provider := providers[r.Intn(numProviders)]
+			offerId := k.AppendHostingOffer(ctx, types.HostingOffer{Creator: provider, InquiryId: inquiryId})
+			startBlock := uint64(r.Intn(int(endTime)) + 1)
+			// Bids range up to twice the contract's share of the escrow
+			share := escrow.Amount.QuoRaw(int64(replicationRate)).Int64()
+			price := r.Int63n(2*share/int64(endTime-startBlock+1) + 1)
+			contractId := k.AppendHostingContract(ctx, types.HostingContract{
+				InquiryId:     inquiryId,
+				OfferId:       offerId,
+				Creator:       provider,
+				StartBlock:    startBlock,
+				EndBlock:      endTime,
+				Status:        types.CONTRACT_STATUS_ACTIVE,
+				PricePerBlock: sdk.NewCoin("token", math.NewInt(price)),
+			})
+			if r.Intn(5) == 0 {
+				terminateAt[contractId] = startBlock + uint64(r.Intn(int(endTime-startBlock+1)))
+			}
+			created++
+		}
+	}
+
+	store.Commit()
+
+	for height := uint64(1); height <= maxEndTime+1; height += uint64(r.Intn(40) + 1) {
+		ctx := ctx.WithBlockHeight(int64(height)).WithEventManager(sdk.NewEventManager())
+		ctx, write := ctx.CacheContext()
+
+		// Dust goes to either destination and the completion bonus varies
+		// over the run
+		params.DustDestination = types.DustDestination(r.Intn(2))
+		params.CompletionBonusFraction = math.LegacyNewDecWithPrec(r.Int63n(11), 1)
+		require.NoError(t, k.SetParams(ctx, params))
+
+		for contractId, at := range terminateAt {
+			if at > height {
+				continue
+			}
+			contract, found := k.GetHostingContract(ctx, contractId)
+			require.True(t, found)
+			if contract.Status == types.CONTRACT_STATUS_ACTIVE {
+				_, err := k.TransitionHostingContract(ctx, contract, types.CONTRACT_STATUS_TERMINATED, "terminated")
+				require.NoError(t, err)
+			}
+			delete(terminateAt, contractId)
+		}
+
+		require.NoError(t, k.ProcessPeriodicPayments(ctx))
+		require.NoError(t, k.ProcessExpiredContracts(ctx))
+		require.NoError(t, k.ProcessExpiredInquiries(ctx))
+
+		msg, broken := keeper.EscrowSolvencyInvariant(k)(ctx)
+		require.False(t, broken, msg)
+		write()
+		store.Commit()
+	}
+
+	// Once every inquiry has expired nothing is left in escrow
+	ctx = ctx.WithBlockHeight(maxEndTime + 1)
+	require.NoError(t, k.ProcessExpiredContracts(ctx))
+	require.NoError(t, k.ProcessExpiredInquiries(ctx))
+	require.Empty(t, k.GetAllEscrowRecords(ctx))
+	require.True(t, bank.ModuleBalance(types.ModuleName).IsZero(), bank.ModuleBalance(types.ModuleName).String())
+
+	paidOut := bank.CommunityPool().AmountOf("token")
+	for _, addr := range append(providers, creators...) {
+		paidOut = paidOut.Add(bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(addr), "token").Amount)
+	}
+	require.Equal(t, total, paidOut)
+	require.False(t, bank.CommunityPool().IsZero())
+}
@@ -28,8 +28,13 @@ func (k Keeper) GetPaymentHistory(ctx context.Context, contractId uint64) (types
 	return paymentHistory, true
 }
 
-// SetPaymentHistory sets the payment history for a specific contract
+// SetPaymentHistory sets the payment history for a specific contract and
+// queues it for pruning once the contract is settled
 func (k Keeper) SetPaymentHistory(ctx context.Context, paymentHistory types.PaymentHistory) {
+	if previous, found := k.GetPaymentHistory(ctx, paymentHistory.ContractId); found {
+		k.removePaymentHistoryQueue(ctx, previous)
+	}
+	
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PaymentHistoryKey))
 	
@@ -38,10 +43,15 @@ func (k Keeper) SetPaymentHistory(ctx context.Context, paymentHistory types.Paym
 	
 	val := k.cdc.MustMarshal(&paymentHistory)
 	store.Set(bz, val)
+	k.setPaymentHistoryQueue(ctx, paymentHistory)
 }
 
 // RemovePaymentHistory removes the payment history for a specific contract
 func (k Keeper) RemovePaymentHistory(ctx context.Context, contractId uint64) {
+	if previous, found := k.GetPaymentHistory(ctx, contractId); found {
+		k.removePaymentHistoryQueue(ctx, previous)
+	}
+	
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PaymentHistoryKey))
 	
@@ -68,12 +78,17 @@ func (k Keeper) GetAllPaymentHistory(ctx context.Context) (list []types.PaymentH
 	return
 }
 
-// GetHostingOffersByInquiry returns all hosting offers for a specific inquiry
+// GetHostingOffersByInquiry returns the hosting offers contracted for a specific inquiry
 func (k Keeper) GetHostingOffersByInquiry(ctx context.Context, inquiryId uint64) (list []types.HostingOffer) {
-	allOffers := k.GetAllHostingOffer(ctx)
+	seen := make(map[uint64]bool)
 	
-	for _, offer := range allOffers {
-		if offer.InquiryId == inquiryId {
+	for _, contract := range k.GetHostingContractsByInquiry(ctx, inquiryId) {
+		if seen[contract.OfferId] {
+			continue
+		}
+		seen[contract.OfferId] = true
+		
+		if offer, found := k.GetHostingOffer(ctx, contract.OfferId); found {
 			list = append(list, offer)
 		}
 	}
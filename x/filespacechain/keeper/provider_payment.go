@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+// GetProviderPaymentCount get the total number of providerPayment
+func (k Keeper) GetProviderPaymentCount(ctx context.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.ProviderPaymentCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetProviderPaymentCount set the total number of providerPayment
+func (k Keeper) SetProviderPaymentCount(ctx context.Context, count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.ProviderPaymentCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendProviderPayment appends a providerPayment in the store with a new id and update the count
+func (k Keeper) AppendProviderPayment(ctx context.Context, payment types.ProviderPayment) uint64 {
+	count := k.GetProviderPaymentCount(ctx)
+	payment.Id = count
+	k.SetProviderPayment(ctx, payment)
+	k.SetProviderPaymentCount(ctx, count+1)
+	return count
+}
+
+// SetProviderPayment set a specific providerPayment in the store together
+// with its provider, contract and height index entries
+func (k Keeper) SetProviderPayment(ctx context.Context, payment types.ProviderPayment) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProviderPaymentKey))
+	b := k.cdc.MustMarshal(&payment)
+	store.Set(GetProviderPaymentIDBytes(payment.Id), b)
+
+	k.setIndexEntry(ctx, types.ProviderPaymentByProviderKey, StringIndexPrefix(payment.Provider), payment.Id)
+	k.setIndexEntry(ctx, types.ProviderPaymentByContractKey, Uint64IndexPrefix(payment.ContractId), payment.Id)
+	k.setIndexEntry(ctx, types.ProviderPaymentByHeightKey, Uint64IndexPrefix(payment.Height), payment.Id)
+}
+
+// GetProviderPayment returns a providerPayment from its id
+func (k Keeper) GetProviderPayment(ctx context.Context, id uint64) (val types.ProviderPayment, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProviderPaymentKey))
+	b := store.Get(GetProviderPaymentIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllProviderPayment returns all providerPayment
+func (k Keeper) GetAllProviderPayment(ctx context.Context) (list []types.ProviderPayment) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProviderPaymentKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ProviderPayment
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetProviderPaymentsByProvider returns the payments released to a provider
+func (k Keeper) GetProviderPaymentsByProvider(ctx context.Context, provider string) (list []types.ProviderPayment) {
+	for _, id := range k.getIndexedIds(ctx, types.ProviderPaymentByProviderKey, StringIndexPrefix(provider)) {
+		if payment, found := k.GetProviderPayment(ctx, id); found {
+			list = append(list, payment)
+		}
+	}
+	return
+}
+
+// GetProviderPaymentIDBytes returns the byte representation of the ID
+func GetProviderPaymentIDBytes(id uint64) []byte {
+	bz := types.KeyPrefix(types.ProviderPaymentKey)
+	bz = append(bz, []byte("/")...)
+	bz = binary.BigEndian.AppendUint64(bz, id)
+	return bz
+}
+
+// payProvider releases escrowed funds to a provider for a contract and
+// records the payment in the provider payment ledger. Shares that round down
+// to zero move no funds and are not recorded.
+func (k Keeper) payProvider(ctx context.Context, contractId uint64, provider sdk.AccAddress, amount sdk.Coin, kind types.ProviderPaymentKind) error {
+	if amount.IsZero() {
+		return nil
+	}
+	if err := k.ReleaseFunds(ctx, provider, amount); err != nil {
+		return err
+	}
+
+	k.AppendProviderPayment(ctx, types.ProviderPayment{
+		Provider:   provider.String(),
+		ContractId: contractId,
+		Height:     uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()),
+		Amount:     amount,
+		Kind:       kind,
+	})
+	return nil
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/hanshq/filespace-chain/testutil/keeper"
+	"github.com/hanshq/filespace-chain/testutil/sample"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func TestProviderPaymentLedger(t *testing.T) {
+	bank := keepertest.NewMockBankKeeper()
+	k, ctx := keepertest.FilespacechainKeeperWithBank(t, bank)
+	tokens := func(amount int64) sdk.Coin { return sdk.NewCoin("token", math.NewInt(amount)) }
+
+	providerA, providerB := sample.AccAddress(), sample.AccAddress()
+	inquiryId := k.AppendHostingInquiry(ctx, types.HostingInquiry{Creator: "creator", ReplicationRate: 2, EscrowAmount: tokens(1000), EndTime: 100})
+	k.SetEscrowRecord(ctx, inquiryId, tokens(1000), "creator")
+	contractId := k.AppendHostingContract(ctx, types.HostingContract{
+		InquiryId: inquiryId, Creator: providerA, StartBlock: 1, EndBlock: 10, Status: types.CONTRACT_STATUS_ACTIVE, PricePerBlock: tokens(10),
+	})
+	k.AppendHostingContract(ctx, types.HostingContract{
+		InquiryId: inquiryId, Creator: providerB, StartBlock: 1, EndBlock: 10, Status: types.CONTRACT_STATUS_ACTIVE, PricePerBlock: tokens(20),
+	})
+
+	// Half of each contract's charge streams over its term: by block 5 that
+	// is 25 token for A and 50 token for B, but only the payout to A can be made
+	bank.FundModule(types.ModuleName, sdk.NewCoins(tokens(25)))
+	ctx = ctx.WithBlockHeight(5)
+	require.NoError(t, k.ProcessPeriodicPayments(ctx))
+
+	require.Equal(t, []types.ProviderPayment{{
+		Id:         0,
+		Provider:   providerA,
+		ContractId: contractId,
+		Height:     5,
+		Amount:     tokens(25),
+		Kind:       types.PROVIDER_PAYMENT_KIND_PERIODIC,
+	}}, k.GetAllProviderPayment(ctx))
+	earnings, err := k.QueryProviderEarnings(ctx, providerA)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(tokens(25)), earnings)
+	earnings, err = k.QueryProviderEarnings(ctx, providerB)
+	require.NoError(t, err)
+	require.True(t, earnings.IsZero())
+
+	// The unpaid 50 carries forward: by block 10 another 25 token is due to A
+	// and 100 token to B
+	bank.FundModule(types.ModuleName, sdk.NewCoins(tokens(125)))
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, k.ProcessPeriodicPayments(ctx))
+	require.Equal(t, uint64(3), k.GetProviderPaymentCount(ctx))
+	history, found := k.GetPaymentHistory(ctx, contractId)
+	require.True(t, found)
+	require.Equal(t, tokens(50), history.TotalPaid)
+
+	byProvider, err := k.ProviderPayments(ctx, &types.QueryProviderPaymentsRequest{Provider: providerA})
+	require.NoError(t, err)
+	require.Len(t, byProvider.ProviderPayments, 2)
+	byProvider, err = k.ProviderPayments(ctx, &types.QueryProviderPaymentsRequest{Provider: providerB})
+	require.NoError(t, err)
+	require.Len(t, byProvider.ProviderPayments, 1)
+	require.Equal(t, uint64(10), byProvider.ProviderPayments[0].Height)
+	require.Equal(t, tokens(100), byProvider.ProviderPayments[0].Amount)
+
+	byContract, err := k.ContractProviderPayments(ctx, &types.QueryContractProviderPaymentsRequest{
+		ContractId: contractId,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, byContract.ProviderPayments, 1)
+	require.Equal(t, uint64(2), byContract.Pagination.Total)
+
+	byHeight, err := k.ProviderPaymentsByHeight(ctx, &types.QueryProviderPaymentsByHeightRequest{StartHeight: 6, EndHeight: 10})
+	require.NoError(t, err)
+	require.Len(t, byHeight.ProviderPayments, 2)
+	byHeight, err = k.ProviderPaymentsByHeight(ctx, &types.QueryProviderPaymentsByHeightRequest{StartHeight: 5, EndHeight: 5})
+	require.NoError(t, err)
+	require.Len(t, byHeight.ProviderPayments, 1)
+	_, err = k.ProviderPaymentsByHeight(ctx, &types.QueryProviderPaymentsByHeightRequest{StartHeight: 10, EndHeight: 5})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+// SetProviderProfile stores the profile of a provider
+func (k Keeper) SetProviderProfile(ctx context.Context, profile types.ProviderProfile) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProviderProfileKey))
+	store.Set([]byte(profile.Provider), k.cdc.MustMarshal(&profile))
+}
+
+// GetProviderProfile returns the profile of a provider
+func (k Keeper) GetProviderProfile(ctx context.Context, provider string) (val types.ProviderProfile, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProviderProfileKey))
+	b := store.Get([]byte(provider))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllProviderProfile returns all provider profiles
+func (k Keeper) GetAllProviderProfile(ctx context.Context) (list []types.ProviderProfile) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProviderProfileKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ProviderProfile
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// getReservedCapacity returns the bytes held by a provider's contracts that
+// have not reached a final status
+func (k Keeper) getReservedCapacity(ctx context.Context, provider string) uint64 {
+	var reserved uint64
+	for _, contract := range k.GetHostingContractsByProvider(ctx, provider) {
+		if !contract.Status.IsFinal() {
+			reserved += contract.ReservedCapacity
+		}
+	}
+	return reserved
+}
+
+// reserveCapacity adds the bytes of a new contract to the provider's used
+// capacity. Providers without a profile do not track capacity.
+func (k Keeper) reserveCapacity(ctx context.Context, provider string, bytes uint64) {
+	profile, found := k.GetProviderProfile(ctx, provider)
+	if !found || bytes == 0 {
+		return
+	}
+	profile.UsedCapacity += bytes
+	k.SetProviderProfile(ctx, profile)
+}
+
+// releaseCapacity gives the bytes of a contract that reached a final status
+// back to the provider
+func (k Keeper) releaseCapacity(ctx context.Context, provider string, bytes uint64) {
+	profile, found := k.GetProviderProfile(ctx, provider)
+	if !found || bytes == 0 {
+		return
+	}
+	if bytes > profile.UsedCapacity {
+		bytes = profile.UsedCapacity
+	}
+	profile.UsedCapacity -= bytes
+	k.SetProviderProfile(ctx, profile)
+}
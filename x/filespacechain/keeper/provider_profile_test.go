@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func TestProviderCapacity(t *testing.T) {
+	k, srv, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(10)
+	tokens := func(amount int64) sdk.Coin { return sdk.NewCoin("token", math.NewInt(amount)) }
+
+	inquiry := func(fileSize uint64) types.HostingInquiry {
+		fileEntryId := k.AppendFileEntry(ctx, types.FileEntry{Cid: "cid", FileSize: fileSize})
+		inquiry := types.HostingInquiry{
+			FileEntryCid:    "cid",
+			FileEntryId:     fileEntryId,
+			ReplicationRate: 1,
+			EscrowAmount:    tokens(1000),
+			EndTime:         1000,
+		}
+		inquiry.Id = k.AppendHostingInquiry(ctx, inquiry)
+		return inquiry
+	}
+	for _, provider := range []string{"A", "B"} {
+		k.SetProviderStake(ctx, provider, sdk.NewCoin("token", k.GetParams(ctx).MinProviderStake), 1)
+	}
+	offerA := k.AppendHostingOffer(ctx, types.HostingOffer{Creator: "A", PricePerBlock: tokens(1)})
+	offerB := k.AppendHostingOffer(ctx, types.HostingOffer{Creator: "B", PricePerBlock: tokens(2)})
+
+	// A contract opened before A declared its capacity counts as used
+	first := inquiry(300)
+	_, err := srv.CreateHostingContract(ctx, &types.MsgCreateHostingContract{Creator: "A", InquiryId: first.Id, OfferId: offerA})
+	require.NoError(t, err)
+
+	_, err = srv.UpdateProviderProfile(ctx, &types.MsgUpdateProviderProfile{Creator: "A", TotalCapacity: 1000})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = srv.RegisterProviderProfile(ctx, &types.MsgRegisterProviderProfile{Creator: "A", TotalCapacity: 1000})
+	require.NoError(t, err)
+	_, err = srv.RegisterProviderProfile(ctx, &types.MsgRegisterProviderProfile{Creator: "A", TotalCapacity: 1000})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	profile, found := k.GetProviderProfile(ctx, "A")
+	require.True(t, found)
+	require.Equal(t, uint64(300), profile.UsedCapacity)
+
+	// A has 700 bytes free: the cheaper offer is passed over for the larger file
+	large := inquiry(800)
+	result := k.MatchHostingOffers(ctx, large, 1)
+	require.Equal(t, []uint64{offerB}, result.MatchedOfferIds())
+	require.Equal(t, types.OFFER_REJECTION_REASON_INSUFFICIENT_CAPACITY, rejectionReasons(result)[offerA])
+	_, err = srv.CreateHostingContract(ctx, &types.MsgCreateHostingContract{Creator: "A", InquiryId: large.Id, OfferId: offerA})
+	require.ErrorIs(t, err, types.ErrOfferNotEligible)
+
+	// A file that fits is matched and reserves its size
+	fits := inquiry(700)
+	_, err = srv.CreateHostingContract(ctx, &types.MsgCreateHostingContract{Creator: "A", InquiryId: fits.Id, OfferId: offerA})
+	require.NoError(t, err)
+	profile, _ = k.GetProviderProfile(ctx, "A")
+	require.Equal(t, uint64(1000), profile.UsedCapacity)
+
+	// A contract reaching a final status releases its capacity
+	contract := k.GetHostingContractsByInquiry(ctx, first.Id)[0]
+	_, err = k.TransitionHostingContract(ctx, contract, types.CONTRACT_STATUS_TERMINATED, "test")
+	require.NoError(t, err)
+	profile, _ = k.GetProviderProfile(ctx, "A")
+	require.Equal(t, uint64(700), profile.UsedCapacity)
+
+	// Lowering the declared capacity keeps the used capacity
+	_, err = srv.UpdateProviderProfile(ctx, &types.MsgUpdateProviderProfile{Creator: "A", Moniker: "a", TotalCapacity: 500})
+	require.NoError(t, err)
+	profile, _ = k.GetProviderProfile(ctx, "A")
+	require.Equal(t, "a", profile.Moniker)
+	require.Equal(t, uint64(700), profile.UsedCapacity)
+	require.Zero(t, profile.FreeCapacity())
+}
@@ -0,0 +1,355 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The queries below serve system, contract, provider and payment analytics.
+
+func (k Keeper) SystemStatistics(ctx context.Context, req *types.QuerySystemStatisticsRequest) (*types.QuerySystemStatisticsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return &types.QuerySystemStatisticsResponse{
+		TotalFileEntries:      uint64(len(k.GetAllFileEntry(ctx))),
+		TotalHostingInquiries: uint64(len(k.GetAllHostingInquiry(ctx))),
+		TotalHostingOffers:    uint64(len(k.GetAllHostingOffer(ctx))),
+		TotalHostingContracts: uint64(len(k.GetAllHostingContract(ctx))),
+		TotalPaymentHistories: uint64(len(k.GetAllPaymentHistory(ctx))),
+		TotalEscrowRecords:    uint64(len(k.GetAllEscrowRecords(ctx))),
+		TotalProviders:        uint64(len(k.GetAllProviderStakes(ctx))),
+		ActiveContracts:       uint64(len(k.GetActiveContracts(ctx))),
+		ExpiredContracts:      uint64(len(k.GetExpiredContracts(ctx))),
+	}, nil
+}
+
+func (k Keeper) ContractDetails(ctx context.Context, req *types.QueryContractDetailsRequest) (*types.QueryContractDetailsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	contract, found := k.GetHostingContract(ctx, req.ContractId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	res := &types.QueryContractDetailsResponse{Contract: contract}
+	if inquiry, found := k.GetHostingInquiry(ctx, contract.InquiryId); found {
+		res.Inquiry = &inquiry
+	}
+	if offer, found := k.GetHostingOffer(ctx, contract.OfferId); found {
+		res.Offer = &offer
+	}
+	if paymentHistory, found := k.GetPaymentHistory(ctx, contract.Id); found {
+		res.PaymentHistory = &paymentHistory
+	}
+	if escrowRecord, found := k.GetEscrowRecord(ctx, contract.InquiryId); found {
+		res.EscrowRecord = &escrowRecord
+	}
+
+	currentBlock := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	switch {
+	case currentBlock >= contract.EndBlock:
+		res.Term = "expired"
+	case currentBlock >= contract.StartBlock:
+		res.Term = "active"
+	default:
+		res.Term = "pending"
+	}
+
+	return res, nil
+}
+
+func (k Keeper) ProviderPerformance(ctx context.Context, req *types.QueryProviderPerformanceRequest) (*types.QueryProviderPerformanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider address cannot be empty")
+	}
+
+	offers, err := k.QueryOffersByProvider(ctx, req.Provider)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := &types.QueryProviderPerformanceResponse{
+		TotalOffers:     uint64(len(offers)),
+		ReputationScore: k.GetReputationScore(ctx, req.Provider),
+	}
+	if stake, found := k.GetProviderStake(ctx, req.Provider); found {
+		res.Stake = &stake
+	}
+
+	// Contracts are classified by status; an ACTIVE contract past its end
+	// block only waits to be completed. PENDING, TERMINATED and SLASHED
+	// contracts are neither active nor completed.
+	currentBlock := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	for _, contract := range k.GetHostingContractsByProvider(ctx, req.Provider) {
+		res.TotalContracts++
+		switch {
+		case contract.Status == types.CONTRACT_STATUS_COMPLETED:
+			res.CompletedContracts++
+		case contract.Status != types.CONTRACT_STATUS_ACTIVE:
+		case currentBlock >= contract.EndBlock:
+			res.CompletedContracts++
+		default:
+			res.ActiveContracts++
+		}
+	}
+
+	earnings, err := k.QueryProviderEarnings(ctx, req.Provider)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res.TotalEarnings = earnings
+
+	return res, nil
+}
+
+func (k Keeper) PaymentAnalytics(ctx context.Context, req *types.QueryPaymentAnalyticsRequest) (*types.QueryPaymentAnalyticsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	res := &types.QueryPaymentAnalyticsResponse{TotalPaid: sdk.NewCoins()}
+	counts := make(map[string]uint64)
+	for _, payment := range k.GetAllPaymentHistory(ctx) {
+		res.TotalPaymentRecords++
+		res.TotalPaid = res.TotalPaid.Add(payment.TotalPaid)
+		counts[payment.TotalPaid.Denom]++
+		if payment.CompletionBonusPaid {
+			res.CompletedPayments++
+		} else {
+			res.PendingPayments++
+		}
+	}
+
+	for denom, count := range counts {
+		res.PaymentCounts = append(res.PaymentCounts, types.DenomPaymentCount{Denom: denom, Count: count})
+	}
+	sort.Slice(res.PaymentCounts, func(i, j int) bool {
+		return res.PaymentCounts[i].Denom < res.PaymentCounts[j].Denom
+	})
+
+	return res, nil
+}
+
+func (k Keeper) PaymentDistribution(ctx context.Context, req *types.QueryPaymentDistributionRequest) (*types.QueryPaymentDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom cannot be empty")
+	}
+
+	res := &types.QueryPaymentDistributionResponse{
+		Total:        math.ZeroInt(),
+		Average:      math.ZeroInt(),
+		Min:          math.ZeroInt(),
+		Max:          math.ZeroInt(),
+		Percentile25: math.ZeroInt(),
+		Percentile50: math.ZeroInt(),
+		Percentile75: math.ZeroInt(),
+	}
+
+	var amounts []math.Int
+	for _, payment := range k.GetAllPaymentHistory(ctx) {
+		if payment.TotalPaid.Denom != req.Denom {
+			continue
+		}
+		amounts = append(amounts, payment.TotalPaid.Amount)
+		res.Total = res.Total.Add(payment.TotalPaid.Amount)
+	}
+	count := len(amounts)
+	if count == 0 {
+		return res, nil
+	}
+
+	sort.Slice(amounts, func(i, j int) bool {
+		return amounts[i].LT(amounts[j])
+	})
+
+	res.Count = uint64(count)
+	res.Average = res.Total.Quo(math.NewInt(int64(count)))
+	res.Min = amounts[0]
+	res.Max = amounts[count-1]
+	if count >= 4 {
+		res.Percentile25 = amounts[count/4]
+		res.Percentile50 = amounts[count/2]
+		res.Percentile75 = amounts[count*3/4]
+	}
+
+	return res, nil
+}
+
+func (k Keeper) ProviderPaymentSummary(ctx context.Context, req *types.QueryProviderPaymentSummaryRequest) (*types.QueryProviderPaymentSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var summaries []types.ProviderPaymentSummary
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	stakeStore := prefix.NewStore(store, types.KeyPrefix(types.ProviderStakeKey))
+
+	pageRes, err := query.Paginate(stakeStore, req.Pagination, func(key []byte, value []byte) error {
+		var stake types.ProviderStake
+		if err := k.cdc.Unmarshal(value, &stake); err != nil {
+			return err
+		}
+
+		summaries = append(summaries, k.providerPaymentSummary(ctx, stake.Provider))
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProviderPaymentSummaryResponse{Summaries: summaries, Pagination: pageRes}, nil
+}
+
+// providerPaymentSummary counts the provider's contracts with a payment
+// history and sums the payments released to it
+func (k Keeper) providerPaymentSummary(ctx context.Context, provider string) types.ProviderPaymentSummary {
+	payments := k.GetPaymentHistoryByProvider(ctx, provider)
+	summary := types.ProviderPaymentSummary{
+		Provider:     provider,
+		PaymentCount: uint64(len(payments)),
+		TotalEarned:  sdk.NewCoins(),
+	}
+
+	for _, payment := range payments {
+		if payment.CompletionBonusPaid {
+			summary.CompletedContracts++
+		} else {
+			summary.PendingContracts++
+		}
+	}
+	for _, payment := range k.GetProviderPaymentsByProvider(ctx, provider) {
+		summary.TotalEarned = summary.TotalEarned.Add(payment.Amount)
+	}
+
+	return summary
+}
+
+func (k Keeper) PaymentTrends(ctx context.Context, req *types.QueryPaymentTrendsRequest) (*types.QueryPaymentTrendsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.BlockWindow == 0 {
+		return nil, status.Error(codes.InvalidArgument, "block window must be positive")
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	res := &types.QueryPaymentTrendsResponse{
+		BlockWindow:  req.BlockWindow,
+		CurrentBlock: uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()),
+		Pagination:   &query.PageResponse{},
+	}
+
+	// The height index orders payments by height, so each window is a run of
+	// consecutive entries and a page ends on a window boundary
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	heightStore := prefix.NewStore(store, types.KeyPrefix(types.ProviderPaymentByHeightKey))
+	iterator := heightStore.Iterator(pageReq.Key, nil)
+	defer iterator.Close()
+
+	var (
+		seen    uint64 // windows reached, including those skipped by the offset
+		current uint64
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		start := binary.BigEndian.Uint64(iterator.Key()[:8]) / req.BlockWindow * req.BlockWindow
+		if seen == 0 || start != current {
+			if seen == pageReq.Offset+limit {
+				res.Pagination.NextKey = Uint64IndexPrefix(start)
+				break
+			}
+			seen++
+			current = start
+			if seen > pageReq.Offset {
+				res.Windows = append(res.Windows, types.PaymentWindow{
+					StartBlock: start,
+					EndBlock:   start + req.BlockWindow - 1,
+					Total:      sdk.NewCoins(),
+				})
+			}
+		}
+		if seen <= pageReq.Offset {
+			continue
+		}
+
+		value := iterator.Value()
+		payment, found := k.GetProviderPayment(ctx, binary.BigEndian.Uint64(value[len(value)-8:]))
+		if !found {
+			continue
+		}
+		window := &res.Windows[len(res.Windows)-1]
+		window.PaymentCount++
+		window.Total = window.Total.Add(payment.Amount)
+	}
+
+	return res, nil
+}
+
+func (k Keeper) UnpaidContracts(ctx context.Context, req *types.QueryUnpaidContractsRequest) (*types.QueryUnpaidContractsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var hostingContracts []types.HostingContract
+	currentBlock := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	hostingContractStore := prefix.NewStore(store, types.KeyPrefix(types.HostingContractKey))
+
+	pageRes, err := query.FilteredPaginate(hostingContractStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var hostingContract types.HostingContract
+		if err := k.cdc.Unmarshal(value, &hostingContract); err != nil {
+			return false, err
+		}
+
+		// Started contracts are expected to have a payment history
+		if currentBlock < hostingContract.StartBlock {
+			return false, nil
+		}
+		if _, found := k.GetPaymentHistory(ctx, hostingContract.Id); found {
+			return false, nil
+		}
+		if accumulate {
+			hostingContracts = append(hostingContracts, hostingContract)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUnpaidContractsResponse{HostingContract: hostingContracts, Pagination: pageRes}, nil
+}
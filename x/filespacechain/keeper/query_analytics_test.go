@@ -0,0 +1,233 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/hanshq/filespace-chain/testutil/keeper"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func TestAnalyticsQueries(t *testing.T) {
+	k, ctx := keepertest.FilespacechainKeeper(t)
+	ctx = ctx.WithBlockHeight(250)
+	tokens := func(amount int64) sdk.Coin { return sdk.NewCoin("token", math.NewInt(amount)) }
+
+	k.SetProviderStake(ctx, "provider", tokens(1000), 1)
+	k.SetHostingInquiry(ctx, types.HostingInquiry{Id: 1, Creator: "creator", EscrowAmount: tokens(1000)})
+	k.SetHostingOffer(ctx, types.HostingOffer{Id: 1, Creator: "provider", InquiryId: 1, PricePerBlock: tokens(1)})
+	for id, startBlock := range []uint64{100, 200, 300} {
+		k.SetHostingContract(ctx, types.HostingContract{
+			Id:         uint64(id),
+			InquiryId:  1,
+			OfferId:    1,
+			Creator:    "provider",
+			StartBlock: startBlock,
+			EndBlock:   startBlock + 100,
+			Status:     types.CONTRACT_STATUS_ACTIVE,
+		})
+	}
+	k.SetPaymentHistory(ctx, types.PaymentHistory{ContractId: 0, TotalPaid: tokens(100), LastPaymentBlock: 199, CompletionBonusPaid: true})
+	k.SetEscrowRecord(ctx, 1, tokens(1000), "creator")
+	k.AppendProviderPayment(ctx, types.ProviderPayment{Provider: "provider", ContractId: 0, Height: 199, Amount: tokens(100)})
+
+	stats, err := k.SystemStatistics(ctx, &types.QuerySystemStatisticsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QuerySystemStatisticsResponse{
+		TotalHostingInquiries: 1,
+		TotalHostingOffers:    1,
+		TotalHostingContracts: 3,
+		TotalPaymentHistories: 1,
+		TotalEscrowRecords:    1,
+		TotalProviders:        1,
+		ActiveContracts:       1,
+		ExpiredContracts:      1,
+	}, stats)
+
+	details, err := k.ContractDetails(ctx, &types.QueryContractDetailsRequest{ContractId: 0})
+	require.NoError(t, err)
+	require.Equal(t, "expired", details.Term)
+	require.NotNil(t, details.Inquiry)
+	require.NotNil(t, details.PaymentHistory)
+	require.NotNil(t, details.EscrowRecord)
+	details, err = k.ContractDetails(ctx, &types.QueryContractDetailsRequest{ContractId: 2})
+	require.NoError(t, err)
+	require.Equal(t, "pending", details.Term)
+	require.Nil(t, details.PaymentHistory)
+	_, err = k.ContractDetails(ctx, &types.QueryContractDetailsRequest{ContractId: 3})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	performance, err := k.ProviderPerformance(ctx, &types.QueryProviderPerformanceRequest{Provider: "provider"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), performance.TotalContracts)
+	require.Equal(t, uint64(1), performance.CompletedContracts)
+	require.Equal(t, uint64(1), performance.TotalOffers)
+	require.Equal(t, sdk.NewCoins(tokens(100)), performance.TotalEarnings)
+	require.Equal(t, types.NeutralReputationScore, performance.ReputationScore)
+	require.NotNil(t, performance.Stake)
+
+	trends, err := k.PaymentTrends(ctx, &types.QueryPaymentTrendsRequest{BlockWindow: 100})
+	require.NoError(t, err)
+	require.Equal(t, []types.PaymentWindow{{StartBlock: 100, EndBlock: 199, PaymentCount: 1, Total: sdk.NewCoins(tokens(100))}}, trends.Windows)
+	_, err = k.PaymentTrends(ctx, &types.QueryPaymentTrendsRequest{})
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "block window must be positive"))
+
+	// Contract 1 started without a payment history, contract 2 has not started
+	unpaid, err := k.UnpaidContracts(ctx, &types.QueryUnpaidContractsRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, unpaid.HostingContract, 1)
+	require.Equal(t, uint64(1), unpaid.HostingContract[0].Id)
+	require.Equal(t, uint64(1), unpaid.Pagination.Total)
+
+	summary, err := k.ProviderPaymentSummary(ctx, &types.QueryProviderPaymentSummaryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ProviderPaymentSummary{{
+		Provider:           "provider",
+		PaymentCount:       1,
+		TotalEarned:        sdk.NewCoins(tokens(100)),
+		CompletedContracts: 1,
+	}}, summary.Summaries)
+}
+
+func TestAnalyticsQueriesPerDenom(t *testing.T) {
+	k, ctx := keepertest.FilespacechainKeeper(t)
+	stable := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	tokens := func(amount int64) sdk.Coin { return sdk.NewCoin("token", math.NewInt(amount)) }
+	stables := func(amount int64) sdk.Coin { return sdk.NewCoin(stable, math.NewInt(amount)) }
+
+	k.SetProviderStake(ctx, "provider", tokens(1000), 1)
+	for id, paid := range []sdk.Coin{tokens(100), stables(30), stables(50)} {
+		k.SetHostingContract(ctx, types.HostingContract{Id: uint64(id), Creator: "provider", EndBlock: 100})
+		k.SetPaymentHistory(ctx, types.PaymentHistory{ContractId: uint64(id), TotalPaid: paid, LastPaymentBlock: 50})
+		k.AppendProviderPayment(ctx, types.ProviderPayment{Provider: "provider", ContractId: uint64(id), Height: 50, Amount: paid})
+	}
+
+	// Amounts in different denoms are never added up
+	distribution, err := k.PaymentDistribution(ctx, &types.QueryPaymentDistributionRequest{Denom: stable})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), distribution.Count)
+	require.Equal(t, math.NewInt(80), distribution.Total)
+	require.Equal(t, math.NewInt(30), distribution.Min)
+	require.Equal(t, math.NewInt(50), distribution.Max)
+	distribution, err = k.PaymentDistribution(ctx, &types.QueryPaymentDistributionRequest{Denom: "stake"})
+	require.NoError(t, err)
+	require.Zero(t, distribution.Count)
+	require.True(t, distribution.Total.IsZero())
+	_, err = k.PaymentDistribution(ctx, &types.QueryPaymentDistributionRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	total := sdk.NewCoins(tokens(100), stables(80))
+	performance, err := k.ProviderPerformance(ctx, &types.QueryProviderPerformanceRequest{Provider: "provider"})
+	require.NoError(t, err)
+	require.Equal(t, total, performance.TotalEarnings)
+
+	summary, err := k.ProviderPaymentSummary(ctx, &types.QueryProviderPaymentSummaryRequest{})
+	require.NoError(t, err)
+	require.Len(t, summary.Summaries, 1)
+	require.Equal(t, total, summary.Summaries[0].TotalEarned)
+
+	trends, err := k.PaymentTrends(ctx, &types.QueryPaymentTrendsRequest{BlockWindow: 100})
+	require.NoError(t, err)
+	require.Equal(t, []types.PaymentWindow{{StartBlock: 0, EndBlock: 99, PaymentCount: 3, Total: total}}, trends.Windows)
+}
+
+func TestPaymentTrendsPagination(t *testing.T) {
+	k, ctx := keepertest.FilespacechainKeeper(t)
+	tokens := func(amount int64) sdk.Coin { return sdk.NewCoin("token", math.NewInt(amount)) }
+
+	// One contract paid in three windows is counted in each, not under its
+	// last payment
+	for _, payment := range []types.ProviderPayment{
+		{Provider: "provider", ContractId: 1, Height: 10, Amount: tokens(5)},
+		{Provider: "provider", ContractId: 1, Height: 150, Amount: tokens(7)},
+		{Provider: "other", ContractId: 2, Height: 199, Amount: tokens(3)},
+		{Provider: "provider", ContractId: 1, Height: 420, Amount: tokens(11)},
+	} {
+		k.AppendProviderPayment(ctx, payment)
+	}
+	k.SetPaymentHistory(ctx, types.PaymentHistory{ContractId: 1, TotalPaid: tokens(23), LastPaymentBlock: 420})
+
+	windows := []types.PaymentWindow{
+		{StartBlock: 0, EndBlock: 99, PaymentCount: 1, Total: sdk.NewCoins(tokens(5))},
+		{StartBlock: 100, EndBlock: 199, PaymentCount: 2, Total: sdk.NewCoins(tokens(10))},
+		{StartBlock: 400, EndBlock: 499, PaymentCount: 1, Total: sdk.NewCoins(tokens(11))},
+	}
+
+	trends, err := k.PaymentTrends(ctx, &types.QueryPaymentTrendsRequest{BlockWindow: 100})
+	require.NoError(t, err)
+	require.Equal(t, windows, trends.Windows)
+	require.Nil(t, trends.Pagination.NextKey)
+
+	// Pages end on window boundaries and resume from the next key
+	var paged []types.PaymentWindow
+	var next []byte
+	for {
+		trends, err = k.PaymentTrends(ctx, &types.QueryPaymentTrendsRequest{
+			BlockWindow: 100,
+			Pagination:  &query.PageRequest{Key: next, Limit: 2},
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(trends.Windows), 2)
+		paged = append(paged, trends.Windows...)
+		next = trends.Pagination.NextKey
+		if next == nil {
+			break
+		}
+	}
+	require.Equal(t, windows, paged)
+
+	trends, err = k.PaymentTrends(ctx, &types.QueryPaymentTrendsRequest{
+		BlockWindow: 100,
+		Pagination:  &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, windows[1:2], trends.Windows)
+	require.NotNil(t, trends.Pagination.NextKey)
+
+	_, err = k.PaymentTrends(ctx, &types.QueryPaymentTrendsRequest{
+		BlockWindow: 100,
+		Pagination:  &query.PageRequest{Key: []byte{0}, Offset: 1},
+	})
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "either offset or key is expected, got both"))
+}
+
+func TestProviderPerformanceByStatus(t *testing.T) {
+	k, ctx := keepertest.FilespacechainKeeper(t)
+	ctx = ctx.WithBlockHeight(250)
+
+	for id, contract := range []struct {
+		status   types.ContractStatus
+		endBlock uint64
+	}{
+		{types.CONTRACT_STATUS_ACTIVE, 300},
+		// Waiting to be completed
+		{types.CONTRACT_STATUS_ACTIVE, 200},
+		{types.CONTRACT_STATUS_COMPLETED, 200},
+		// Ended early, whether or not the end block has been reached
+		{types.CONTRACT_STATUS_SLASHED, 300},
+		{types.CONTRACT_STATUS_SLASHED, 200},
+		{types.CONTRACT_STATUS_TERMINATED, 300},
+		{types.CONTRACT_STATUS_TERMINATED, 200},
+		{types.CONTRACT_STATUS_PENDING, 300},
+	} {
+		k.SetHostingContract(ctx, types.HostingContract{
+			Id:       uint64(id),
+			Creator:  "provider",
+			EndBlock: contract.endBlock,
+			Status:   contract.status,
+		})
+	}
+
+	performance, err := k.ProviderPerformance(ctx, &types.QueryProviderPerformanceRequest{Provider: "provider"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), performance.TotalContracts)
+	require.Equal(t, uint64(1), performance.ActiveContracts)
+	require.Equal(t, uint64(2), performance.CompletedContracts)
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Auction returns the auction of an inquiry with its bids. Bids keep their
+// price sealed until they are revealed.
+func (k Keeper) Auction(ctx context.Context, req *types.QueryGetAuctionRequest) (*types.QueryGetAuctionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	auction, found := k.GetAuction(ctx, req.InquiryId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryGetAuctionResponse{
+		Auction: auction,
+		Bids:    k.GetAuctionBids(ctx, req.InquiryId),
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/hanshq/filespace-chain/testutil/keeper"
@@ -87,8 +88,7 @@ func TestQueryProviderEarnings(t *testing.T) {
 	// Test with no payment history
 	earnings, err := k.QueryProviderEarnings(ctx, provider)
 	require.NoError(t, err)
-	require.Equal(t, "utoken", earnings.Denom)
-	require.Equal(t, math.ZeroInt(), earnings.Amount)
+	require.True(t, earnings.Empty())
 }
 
 func TestQueryProviderEarningsWithPayments(t *testing.T) {
@@ -100,43 +100,24 @@ func TestQueryProviderEarningsWithPayments(t *testing.T) {
 	earnings, err := k.QueryProviderEarnings(ctx, provider)
 	require.NoError(t, err)
 	require.NotNil(t, earnings)
-	require.IsType(t, sdk.Coin{}, earnings)
+	require.IsType(t, sdk.Coins{}, earnings)
 }
 
 func TestQuerySystemStatistics(t *testing.T) {
 	k, ctx := keepertest.FilespacechainKeeper(t)
 	
 	// Test system statistics query
-	stats, err := k.QuerySystemStatistics(ctx)
+	stats, err := k.SystemStatistics(ctx, &types.QuerySystemStatisticsRequest{})
 	require.NoError(t, err)
-	require.NotNil(t, stats)
-	
-	// Check that basic stats are present
-	require.Contains(t, stats, "total_file_entries")
-	require.Contains(t, stats, "total_hosting_inquiries")
-	require.Contains(t, stats, "total_hosting_offers")
-	require.Contains(t, stats, "total_hosting_contracts")
-	require.Contains(t, stats, "total_payment_histories")
-	require.Contains(t, stats, "active_contracts")
-	require.Contains(t, stats, "expired_contracts")
-	require.Contains(t, stats, "total_escrow_records")
-	require.Contains(t, stats, "total_provider_stakes")
-	require.Contains(t, stats, "total_providers")
-	
-	// Check that values are integers
-	require.IsType(t, 0, stats["total_file_entries"])
-	require.IsType(t, 0, stats["total_hosting_inquiries"])
-	require.IsType(t, 0, stats["total_hosting_offers"])
-	require.IsType(t, 0, stats["total_hosting_contracts"])
+	require.Equal(t, &types.QuerySystemStatisticsResponse{}, stats)
 }
 
 func TestQueryContractDetailsNotFound(t *testing.T) {
 	k, ctx := keepertest.FilespacechainKeeper(t)
 	
 	// Test with non-existent contract
-	_, err := k.QueryContractDetails(ctx, 999)
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "contract 999 not found")
+	_, err := k.ContractDetails(ctx, &types.QueryContractDetailsRequest{ContractId: 999})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
 }
 
 func TestQueryProviderPerformance(t *testing.T) {
@@ -145,18 +126,14 @@ func TestQueryProviderPerformance(t *testing.T) {
 	provider := "cosmos1provider"
 	
 	// Test with empty data
-	performance, err := k.QueryProviderPerformance(ctx, provider)
+	performance, err := k.ProviderPerformance(ctx, &types.QueryProviderPerformanceRequest{Provider: provider})
 	require.NoError(t, err)
 	require.NotNil(t, performance)
 	
-	// Check basic structure
-	require.Contains(t, performance, "total_contracts")
-	require.Contains(t, performance, "active_contracts")
-	require.Contains(t, performance, "completed_contracts")
-	
-	require.Equal(t, 0, performance["total_contracts"])
-	require.Equal(t, 0, performance["active_contracts"])
-	require.Equal(t, 0, performance["completed_contracts"])
+	require.Equal(t, uint64(0), performance.TotalContracts)
+	require.Equal(t, uint64(0), performance.ActiveContracts)
+	require.Equal(t, uint64(0), performance.CompletedContracts)
+	require.Nil(t, performance.Stake)
 }
 
 func TestQueryProviderPerformanceWithStake(t *testing.T) {
@@ -170,15 +147,12 @@ func TestQueryProviderPerformanceWithStake(t *testing.T) {
 	k.SetProviderStake(ctx, provider, amount, height)
 	
 	// Query performance
-	performance, err := k.QueryProviderPerformance(ctx, provider)
+	performance, err := k.ProviderPerformance(ctx, &types.QueryProviderPerformanceRequest{Provider: provider})
 	require.NoError(t, err)
-	require.NotNil(t, performance)
 	
 	// Check that stake is included
-	require.Contains(t, performance, "stake")
-	
-	stake := performance["stake"]
-	require.NotNil(t, stake)
+	require.NotNil(t, performance.Stake)
+	require.Equal(t, amount, performance.Stake.Amount)
 }
 
 func TestQuerySystemStatisticsWithData(t *testing.T) {
@@ -200,13 +174,13 @@ func TestQuerySystemStatisticsWithData(t *testing.T) {
 	k.SetPaymentHistory(ctx, paymentHistory)
 	
 	// Query statistics
-	stats, err := k.QuerySystemStatistics(ctx)
+	stats, err := k.SystemStatistics(ctx, &types.QuerySystemStatisticsRequest{})
 	require.NoError(t, err)
 	
 	// Verify counts
-	require.Equal(t, 1, stats["total_payment_histories"])
-	require.Equal(t, 2, stats["total_escrow_records"])
-	require.Equal(t, 2, stats["total_providers"])
+	require.Equal(t, uint64(1), stats.TotalPaymentHistories)
+	require.Equal(t, uint64(2), stats.TotalEscrowRecords)
+	require.Equal(t, uint64(2), stats.TotalProviders)
 }
 
 func TestQueryContractDetailsWithData(t *testing.T) {
@@ -258,31 +232,18 @@ func TestQueryContractDetailsWithData(t *testing.T) {
 	k.SetEscrowRecord(ctx, 1, sdk.NewCoin("utoken", math.NewInt(1000)), "cosmos1creator")
 	
 	// Query contract details
-	details, err := k.QueryContractDetails(ctx, 1)
+	details, err := k.ContractDetails(ctx, &types.QueryContractDetailsRequest{ContractId: 1})
 	require.NoError(t, err)
-	require.NotNil(t, details)
 	
 	// Verify all components are present
-	require.Contains(t, details, "contract")
-	require.Contains(t, details, "inquiry")
-	require.Contains(t, details, "offer")
-	require.Contains(t, details, "payment_history")
-	require.Contains(t, details, "escrow_record")
-	require.Contains(t, details, "status")
-	
-	// Verify contract details
-	contractDetail := details["contract"].(types.HostingContract)
-	require.Equal(t, uint64(1), contractDetail.Id)
-	
-	// Verify inquiry details
-	inquiryDetail := details["inquiry"].(types.HostingInquiry)
-	require.Equal(t, uint64(1), inquiryDetail.Id)
-	
-	// Verify offer details
-	offerDetail := details["offer"].(types.HostingOffer)
-	require.Equal(t, uint64(1), offerDetail.Id)
-	
-	// Verify status (should be "active" since start < current < end in test context)
-	status := details["status"].(string)
-	require.Contains(t, []string{"pending", "active", "expired"}, status)
-}
\ No newline at end of file
+	require.Equal(t, uint64(1), details.Contract.Id)
+	require.NotNil(t, details.Inquiry)
+	require.Equal(t, uint64(1), details.Inquiry.Id)
+	require.NotNil(t, details.Offer)
+	require.Equal(t, uint64(1), details.Offer.Id)
+	require.NotNil(t, details.PaymentHistory)
+	require.NotNil(t, details.EscrowRecord)
+	
+	// The test context starts at block 0, before the contract
+	require.Equal(t, "pending", details.Term)
+}
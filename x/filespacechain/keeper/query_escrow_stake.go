@@ -6,6 +6,7 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
 )
 
 // QueryEscrowSummary returns comprehensive escrow statistics
@@ -44,21 +45,21 @@ func (k Keeper) QueryEscrowSummary(ctx context.Context) (map[string]interface{},
 }
 
 // QueryEscrowByCreator returns all escrow records for a specific creator
-func (k Keeper) QueryEscrowByCreator(ctx context.Context, creator string) ([]EscrowRecord, error) {
+func (k Keeper) QueryEscrowByCreator(ctx context.Context, creator string) ([]types.EscrowRecord, error) {
 	return k.GetEscrowRecordsByCreator(ctx, creator), nil
 }
 
 // QueryEscrowByInquiry returns escrow record for a specific inquiry
-func (k Keeper) QueryEscrowByInquiry(ctx context.Context, inquiryId uint64) (EscrowRecord, error) {
+func (k Keeper) QueryEscrowByInquiry(ctx context.Context, inquiryId uint64) (types.EscrowRecord, error) {
 	record, found := k.GetEscrowRecord(ctx, inquiryId)
 	if !found {
-		return EscrowRecord{}, fmt.Errorf("escrow record not found for inquiry %d", inquiryId)
+		return types.EscrowRecord{}, fmt.Errorf("escrow record not found for inquiry %d", inquiryId)
 	}
 	return record, nil
 }
 
 // QueryActiveEscrow returns all currently active escrow records
-func (k Keeper) QueryActiveEscrow(ctx context.Context) ([]EscrowRecord, error) {
+func (k Keeper) QueryActiveEscrow(ctx context.Context) ([]types.EscrowRecord, error) {
 	return k.GetActiveEscrowRecords(ctx), nil
 }
 
@@ -116,21 +117,21 @@ func (k Keeper) QueryStakingSummary(ctx context.Context) (map[string]interface{}
 }
 
 // QueryStakeByProvider returns stake information for a specific provider
-func (k Keeper) QueryStakeByProvider(ctx context.Context, provider string) (ProviderStake, error) {
+func (k Keeper) QueryStakeByProvider(ctx context.Context, provider string) (types.ProviderStake, error) {
 	stake, found := k.GetProviderStake(ctx, provider)
 	if !found {
-		return ProviderStake{}, fmt.Errorf("provider stake not found for %s", provider)
+		return types.ProviderStake{}, fmt.Errorf("provider stake not found for %s", provider)
 	}
 	return stake, nil
 }
 
 // QueryProvidersWithMinStake returns all providers with stake above minimum threshold
-func (k Keeper) QueryProvidersWithMinStake(ctx context.Context, minAmount sdk.Coin) ([]ProviderStake, error) {
+func (k Keeper) QueryProvidersWithMinStake(ctx context.Context, minAmount sdk.Coin) ([]types.ProviderStake, error) {
 	return k.GetProvidersByMinStake(ctx, minAmount), nil
 }
 
 // QueryProvidersByStakeRange returns providers staked within a specific block height range
-func (k Keeper) QueryProvidersByStakeRange(ctx context.Context, startHeight, endHeight uint64) ([]ProviderStake, error) {
+func (k Keeper) QueryProvidersByStakeRange(ctx context.Context, startHeight, endHeight uint64) ([]types.ProviderStake, error) {
 	return k.GetProvidersStakedInRange(ctx, startHeight, endHeight), nil
 }
 
@@ -140,9 +141,9 @@ func (k Keeper) QueryStakeStatistics(ctx context.Context, denom string) (map[str
 }
 
 // QueryTopProvidersByStake returns the top N providers by stake amount for a specific denomination
-func (k Keeper) QueryTopProvidersByStake(ctx context.Context, denom string, limit int) ([]ProviderStake, error) {
+func (k Keeper) QueryTopProvidersByStake(ctx context.Context, denom string, limit int) ([]types.ProviderStake, error) {
 	allStakes := k.GetAllProviderStakes(ctx)
-	var denomStakes []ProviderStake
+	var denomStakes []types.ProviderStake
 	
 	// Filter by denomination
 	for _, stake := range allStakes {
@@ -174,7 +175,7 @@ func (k Keeper) QueryProviderStakeHistory(ctx context.Context) (map[string]inter
 	history := make(map[string]interface{})
 	
 	// Group by block height
-	blockHeights := make(map[uint64][]ProviderStake)
+	blockHeights := make(map[uint64][]types.ProviderStake)
 	for _, stake := range allStakes {
 		blockHeights[stake.Height] = append(blockHeights[stake.Height], stake)
 	}
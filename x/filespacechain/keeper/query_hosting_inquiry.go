@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
 
 	"cosmossdk.io/store/prefix"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/hanshq/filespace-chain/x/filespacechain/types"
@@ -51,3 +53,34 @@ func (k Keeper) HostingInquiry(ctx context.Context, req *types.QueryGetHostingIn
 
 	return &types.QueryGetHostingInquiryResponse{HostingInquiry: hostingInquiry}, nil
 }
+
+// OpenInquiries lists the inquiries that have not ended and are still short of
+// their replication rate, oldest first
+func (k Keeper) OpenInquiries(ctx context.Context, req *types.QueryOpenInquiriesRequest) (*types.QueryOpenInquiriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var hostingInquirys []types.HostingInquiry
+	currentHeight := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	openStore := prefix.NewStore(store, types.KeyPrefix(types.OpenHostingInquiryKey))
+
+	pageRes, err := query.FilteredPaginate(openStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		hostingInquiry, found := k.GetHostingInquiry(ctx, binary.BigEndian.Uint64(value[len(value)-8:]))
+		if !found || hostingInquiry.EndTime <= currentHeight {
+			return false, nil
+		}
+		if accumulate {
+			hostingInquirys = append(hostingInquirys, hostingInquiry)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOpenInquiriesResponse{HostingInquiry: hostingInquirys, Pagination: pageRes}, nil
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
@@ -113,3 +114,22 @@ func TestHostingInquiryQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestOpenInquiriesQuery(t *testing.T) {
+	keeper, ctx := keepertest.FilespacechainKeeper(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(10)
+
+	open := types.HostingInquiry{ReplicationRate: 2, FilledReplicas: 1, EndTime: 100}
+	open.Id = keeper.AppendHostingInquiry(sdkCtx, open)
+	keeper.AppendHostingInquiry(sdkCtx, types.HostingInquiry{ReplicationRate: 1, FilledReplicas: 1, EndTime: 100})
+	keeper.AppendHostingInquiry(sdkCtx, types.HostingInquiry{ReplicationRate: 1, EndTime: 10})
+
+	// Filled and ended inquiries are left out
+	resp, err := keeper.OpenInquiries(sdkCtx, &types.QueryOpenInquiriesRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill([]types.HostingInquiry{open}), nullify.Fill(resp.HostingInquiry))
+	require.Equal(t, uint64(1), resp.Pagination.Total)
+
+	_, err = keeper.OpenInquiries(sdkCtx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
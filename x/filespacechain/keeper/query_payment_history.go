@@ -25,24 +25,24 @@ func (k Keeper) GetAllPaymentHistories(ctx context.Context) []types.PaymentHisto
 }
 
 // GetEscrowStatusForInquiry returns escrow status for a specific inquiry ID
-func (k Keeper) GetEscrowStatusForInquiry(ctx context.Context, inquiryId uint64) (EscrowRecord, error) {
+func (k Keeper) GetEscrowStatusForInquiry(ctx context.Context, inquiryId uint64) (types.EscrowRecord, error) {
 	escrowRecord, found := k.GetEscrowRecord(ctx, inquiryId)
 	if !found {
-		return EscrowRecord{}, fmt.Errorf("escrow record not found for inquiry %d", inquiryId)
+		return types.EscrowRecord{}, fmt.Errorf("escrow record not found for inquiry %d", inquiryId)
 	}
 	return escrowRecord, nil
 }
 
 // GetAllEscrowStatuses returns all escrow records
-func (k Keeper) GetAllEscrowStatuses(ctx context.Context) []EscrowRecord {
+func (k Keeper) GetAllEscrowStatuses(ctx context.Context) []types.EscrowRecord {
 	return k.GetAllEscrowRecords(ctx)
 }
 
 // GetProviderStakeByAddress returns provider stake for a specific address
-func (k Keeper) GetProviderStakeByAddress(ctx context.Context, provider string) (ProviderStake, error) {
+func (k Keeper) GetProviderStakeByAddress(ctx context.Context, provider string) (types.ProviderStake, error) {
 	providerStake, found := k.GetProviderStake(ctx, provider)
 	if !found {
-		return ProviderStake{}, fmt.Errorf("provider stake not found for address %s", provider)
+		return types.ProviderStake{}, fmt.Errorf("provider stake not found for address %s", provider)
 	}
 	return providerStake, nil
 }
@@ -85,7 +85,7 @@ func (k Keeper) FormatPaymentHistoryInfo(paymentHistory types.PaymentHistory) ma
 }
 
 // FormatEscrowInfo formats escrow record for display  
-func (k Keeper) FormatEscrowInfo(escrowRecord EscrowRecord) map[string]string {
+func (k Keeper) FormatEscrowInfo(escrowRecord types.EscrowRecord) map[string]string {
 	return map[string]string{
 		"inquiry_id":     strconv.FormatUint(escrowRecord.InquiryId, 10),
 		"amount":         escrowRecord.Amount.String(),
@@ -94,7 +94,7 @@ func (k Keeper) FormatEscrowInfo(escrowRecord EscrowRecord) map[string]string {
 }
 
 // FormatProviderStakeInfo formats provider stake for display
-func (k Keeper) FormatProviderStakeInfo(providerStake ProviderStake) map[string]string {
+func (k Keeper) FormatProviderStakeInfo(providerStake types.ProviderStake) map[string]string {
 	return map[string]string{
 		"provider":      providerStake.Provider,
 		"amount":        providerStake.Amount.String(),
@@ -105,24 +105,11 @@ func (k Keeper) FormatProviderStakeInfo(providerStake ProviderStake) map[string]
 // GetPaymentHistoryByProvider returns all payment histories where the provider received payments
 func (k Keeper) GetPaymentHistoryByProvider(ctx context.Context, provider string) []types.PaymentHistory {
 	var providerPayments []types.PaymentHistory
-	allPayments := k.GetAllPaymentHistory(ctx)
 	
-	for _, payment := range allPayments {
-		// Get the contract to find associated offers/providers
-		contract, found := k.GetHostingContract(ctx, payment.ContractId)
-		if !found {
-			continue
-		}
-		
-		// Get offers for this contract's inquiry
-		offers := k.GetHostingOffersByInquiry(ctx, contract.InquiryId)
-		
-		// Check if this provider has an offer for this contract
-		for _, offer := range offers {
-			if offer.Creator == provider {
-				providerPayments = append(providerPayments, payment)
-				break
-			}
+	// Look up the payment history of each contract served by the provider
+	for _, contract := range k.GetHostingContractsByProvider(ctx, provider) {
+		if payment, found := k.GetPaymentHistory(ctx, contract.Id); found {
+			providerPayments = append(providerPayments, payment)
 		}
 	}
 	
@@ -181,23 +168,25 @@ func (k Keeper) AddPaymentAmount(ctx context.Context, contractId uint64, amount
 func (k Keeper) CleanupCompletedPaymentHistory(ctx context.Context, olderThanBlocks uint64) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	currentHeight := uint64(sdkCtx.BlockHeight())
+	if currentHeight <= olderThanBlocks {
+		return nil
+	}
 	cutoffHeight := currentHeight - olderThanBlocks
+	params := k.GetParams(ctx)
 	
-	allPayments := k.GetAllPaymentHistory(ctx)
+	// Only settled payment histories are queued for cleanup
 	cleanedCount := 0
-	
-	for _, payment := range allPayments {
-		// Only clean up completed contracts
-		if payment.CompletionBonusPaid && payment.LastPaymentBlock < cutoffHeight {
-			k.RemovePaymentHistory(ctx, payment.ContractId)
-			cleanedCount++
-		}
+	for _, entry := range k.getDueQueueEntries(ctx, types.SettledPaymentHistoryQueueKey, cutoffHeight, params.MaxExpiriesPerBlock) {
+		k.RemovePaymentHistory(ctx, entry.id)
+		cleanedCount++
 	}
 	
-	k.Logger().Info("cleaned up old payment history records",
-		"cleaned_count", cleanedCount,
-		"cutoff_height", cutoffHeight,
-	)
+	if cleanedCount > 0 {
+		k.Logger().Info("cleaned up old payment history records",
+			"cleaned_count", cleanedCount,
+			"cutoff_height", cutoffHeight,
+		)
+	}
 	
 	return nil
 }
\ No newline at end of file
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ProviderPayments(ctx context.Context, req *types.QueryProviderPaymentsRequest) (*types.QueryProviderPaymentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider address cannot be empty")
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	providerStore := prefix.NewStore(store, append(types.KeyPrefix(types.ProviderPaymentByProviderKey), StringIndexPrefix(req.Provider)...))
+
+	payments, pageRes, err := k.paginateProviderPayments(ctx, providerStore, req.Pagination, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProviderPaymentsResponse{ProviderPayments: payments, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ContractProviderPayments(ctx context.Context, req *types.QueryContractProviderPaymentsRequest) (*types.QueryContractProviderPaymentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	contractStore := prefix.NewStore(store, append(types.KeyPrefix(types.ProviderPaymentByContractKey), Uint64IndexPrefix(req.ContractId)...))
+
+	payments, pageRes, err := k.paginateProviderPayments(ctx, contractStore, req.Pagination, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryContractProviderPaymentsResponse{ProviderPayments: payments, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ProviderPaymentsByHeight(ctx context.Context, req *types.QueryProviderPaymentsByHeightRequest) (*types.QueryProviderPaymentsByHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.EndHeight < req.StartHeight {
+		return nil, status.Error(codes.InvalidArgument, "end height must not be before start height")
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	heightStore := prefix.NewStore(store, types.KeyPrefix(types.ProviderPaymentByHeightKey))
+
+	// Index keys start with the big-endian payment height
+	inRange := func(key []byte) bool {
+		height := binary.BigEndian.Uint64(key[:8])
+		return height >= req.StartHeight && height <= req.EndHeight
+	}
+
+	payments, pageRes, err := k.paginateProviderPayments(ctx, heightStore, req.Pagination, inRange)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProviderPaymentsByHeightResponse{ProviderPayments: payments, Pagination: pageRes}, nil
+}
+
+// paginateProviderPayments pages through a provider payment index, keeping
+// the entries whose index key passes the optional filter
+func (k Keeper) paginateProviderPayments(ctx context.Context, indexStore prefix.Store, pageReq *query.PageRequest, filter func(key []byte) bool) ([]types.ProviderPayment, *query.PageResponse, error) {
+	var payments []types.ProviderPayment
+
+	pageRes, err := query.FilteredPaginate(indexStore, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if filter != nil && !filter(key) {
+			return false, nil
+		}
+		payment, found := k.GetProviderPayment(ctx, binary.BigEndian.Uint64(value[len(value)-8:]))
+		if !found {
+			return false, nil
+		}
+		if accumulate {
+			payments = append(payments, payment)
+		}
+		return true, nil
+	})
+
+	return payments, pageRes, err
+}
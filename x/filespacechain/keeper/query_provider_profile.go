@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ProviderProfileAll(ctx context.Context, req *types.QueryAllProviderProfileRequest) (*types.QueryAllProviderProfileResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var providerProfiles []types.ProviderProfile
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	providerProfileStore := prefix.NewStore(store, types.KeyPrefix(types.ProviderProfileKey))
+
+	pageRes, err := query.Paginate(providerProfileStore, req.Pagination, func(key []byte, value []byte) error {
+		var providerProfile types.ProviderProfile
+		if err := k.cdc.Unmarshal(value, &providerProfile); err != nil {
+			return err
+		}
+
+		providerProfiles = append(providerProfiles, providerProfile)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllProviderProfileResponse{ProviderProfile: providerProfiles, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ProviderProfile(ctx context.Context, req *types.QueryGetProviderProfileRequest) (*types.QueryGetProviderProfileResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	providerProfile, found := k.GetProviderProfile(ctx, req.Provider)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryGetProviderProfileResponse{ProviderProfile: providerProfile}, nil
+}
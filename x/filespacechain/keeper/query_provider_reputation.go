@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ProviderReputationAll(ctx context.Context, req *types.QueryAllProviderReputationRequest) (*types.QueryAllProviderReputationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var providerReputations []types.ProviderReputation
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	halfLife := k.GetParams(ctx).ReputationHalfLife
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	providerReputationStore := prefix.NewStore(store, types.KeyPrefix(types.ProviderReputationKey))
+
+	pageRes, err := query.Paginate(providerReputationStore, req.Pagination, func(key []byte, value []byte) error {
+		var providerReputation types.ProviderReputation
+		if err := k.cdc.Unmarshal(value, &providerReputation); err != nil {
+			return err
+		}
+
+		providerReputation.Score = providerReputation.Decayed(height, halfLife)
+		providerReputations = append(providerReputations, providerReputation)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllProviderReputationResponse{ProviderReputation: providerReputations, Pagination: pageRes}, nil
+}
+
+// ProviderReputation returns the reputation of a provider with its score
+// decayed to the current block. Providers without contract outcomes have a
+// neutral reputation.
+func (k Keeper) ProviderReputation(ctx context.Context, req *types.QueryGetProviderReputationRequest) (*types.QueryGetProviderReputationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	providerReputation, found := k.GetProviderReputation(ctx, req.Provider)
+	if !found {
+		providerReputation = types.NewProviderReputation(req.Provider, height)
+	}
+	providerReputation.Score = providerReputation.Decayed(height, k.GetParams(ctx).ReputationHalfLife)
+
+	return &types.QueryGetProviderReputationResponse{ProviderReputation: providerReputation}, nil
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) SlashEventAll(ctx context.Context, req *types.QueryAllSlashEventRequest) (*types.QueryAllSlashEventResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var slashEvents []types.SlashEvent
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	slashEventStore := prefix.NewStore(store, types.KeyPrefix(types.SlashEventKey))
+
+	pageRes, err := query.Paginate(slashEventStore, req.Pagination, func(key []byte, value []byte) error {
+		var slashEvent types.SlashEvent
+		if err := k.cdc.Unmarshal(value, &slashEvent); err != nil {
+			return err
+		}
+
+		slashEvents = append(slashEvents, slashEvent)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllSlashEventResponse{SlashEvent: slashEvents, Pagination: pageRes}, nil
+}
+
+func (k Keeper) SlashEvent(ctx context.Context, req *types.QueryGetSlashEventRequest) (*types.QueryGetSlashEventResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	slashEvent, found := k.GetSlashEvent(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryGetSlashEventResponse{SlashEvent: slashEvent}, nil
+}
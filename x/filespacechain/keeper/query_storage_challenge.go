@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) StorageChallengeAll(ctx context.Context, req *types.QueryAllStorageChallengeRequest) (*types.QueryAllStorageChallengeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var storageChallenges []types.StorageChallenge
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	storageChallengeStore := prefix.NewStore(store, types.KeyPrefix(types.StorageChallengeKey))
+
+	pageRes, err := query.Paginate(storageChallengeStore, req.Pagination, func(key []byte, value []byte) error {
+		var storageChallenge types.StorageChallenge
+		if err := k.cdc.Unmarshal(value, &storageChallenge); err != nil {
+			return err
+		}
+
+		storageChallenges = append(storageChallenges, storageChallenge)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllStorageChallengeResponse{StorageChallenge: storageChallenges, Pagination: pageRes}, nil
+}
+
+func (k Keeper) StorageChallenge(ctx context.Context, req *types.QueryGetStorageChallengeRequest) (*types.QueryGetStorageChallengeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	storageChallenge, found := k.GetStorageChallenge(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryGetStorageChallengeResponse{StorageChallenge: storageChallenge}, nil
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ProviderUnbondings(ctx context.Context, req *types.QueryProviderUnbondingsRequest) (*types.QueryProviderUnbondingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider address cannot be empty")
+	}
+
+	var unbondingEntries []types.UnbondingEntry
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	providerStore := prefix.NewStore(store, append(types.KeyPrefix(types.UnbondingByProviderKey), UnbondingProviderPrefix(req.Provider)...))
+
+	pageRes, err := query.Paginate(providerStore, req.Pagination, func(key []byte, value []byte) error {
+		entry, found := k.GetUnbondingEntry(ctx, binary.BigEndian.Uint64(value[len(value)-8:]))
+		if found {
+			unbondingEntries = append(unbondingEntries, entry)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProviderUnbondingsResponse{UnbondingEntries: unbondingEntries, Pagination: pageRes}, nil
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+// SetProviderReputation stores the reputation of a provider
+func (k Keeper) SetProviderReputation(ctx context.Context, reputation types.ProviderReputation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProviderReputationKey))
+	store.Set([]byte(reputation.Provider), k.cdc.MustMarshal(&reputation))
+}
+
+// GetProviderReputation returns the stored reputation of a provider, with its
+// score as of its last update
+func (k Keeper) GetProviderReputation(ctx context.Context, provider string) (val types.ProviderReputation, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProviderReputationKey))
+	b := store.Get([]byte(provider))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllProviderReputation returns all provider reputations
+func (k Keeper) GetAllProviderReputation(ctx context.Context) (list []types.ProviderReputation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProviderReputationKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ProviderReputation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetReputationScore returns the score of a provider decayed to the current
+// block. Providers without contract outcomes score as neutral.
+func (k Keeper) GetReputationScore(ctx context.Context, provider string) uint64 {
+	reputation, found := k.GetProviderReputation(ctx, provider)
+	if !found {
+		return types.NeutralReputationScore
+	}
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	return reputation.Decayed(height, k.GetParams(ctx).ReputationHalfLife)
+}
+
+// recordReputationEvent applies a contract outcome to the reputation of a
+// provider. Outcomes without a provider are ignored.
+func (k Keeper) recordReputationEvent(ctx context.Context, provider string, event types.ReputationEvent) error {
+	if provider == "" {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := uint64(sdkCtx.BlockHeight())
+
+	reputation, found := k.GetProviderReputation(ctx, provider)
+	if !found {
+		reputation = types.NewProviderReputation(provider, height)
+	}
+	reputation.Record(event, height, k.GetParams(ctx).ReputationHalfLife)
+	k.SetProviderReputation(ctx, reputation)
+
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventProviderReputationChanged{
+		Provider: provider,
+		Event:    event,
+		Score:    reputation.Score,
+		Height:   height,
+	})
+}
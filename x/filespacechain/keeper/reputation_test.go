@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func TestProviderReputation(t *testing.T) {
+	k, srv, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(10)
+	tokens := func(amount int64) sdk.Coin { return sdk.NewCoin("token", math.NewInt(amount)) }
+	halfLife := k.GetParams(ctx).ReputationHalfLife
+
+	inquiry := types.HostingInquiry{
+		ReplicationRate: 1,
+		EscrowAmount:    tokens(1000),
+		EndTime:         1000000,
+	}
+	inquiry.Id = k.AppendHostingInquiry(ctx, inquiry)
+	for _, provider := range []string{"A", "B"} {
+		k.SetProviderStake(ctx, provider, sdk.NewCoin("token", k.GetParams(ctx).MinProviderStake), 1)
+	}
+	offerA := k.AppendHostingOffer(ctx, types.HostingOffer{Creator: "A", PricePerBlock: tokens(100)})
+	offerB := k.AppendHostingOffer(ctx, types.HostingOffer{Creator: "B", PricePerBlock: tokens(110)})
+
+	// Providers without contract outcomes are neutral and offers rank by price
+	require.Equal(t, types.NeutralReputationScore, k.GetReputationScore(ctx, "A"))
+	require.Equal(t, []uint64{offerA}, k.MatchHostingOffers(ctx, inquiry, 1).MatchedOfferIds())
+
+	// Rejecting a contract counts as an early termination
+	_, err := srv.CreateHostingContract(ctx, &types.MsgCreateHostingContract{Creator: "A", InquiryId: inquiry.Id, OfferId: offerA})
+	require.NoError(t, err)
+	contract := k.GetHostingContractsByInquiry(ctx, inquiry.Id)[0]
+	_, err = srv.RejectHostingContract(ctx, &types.MsgRejectHostingContract{Creator: "A", ContractId: contract.Id})
+	require.NoError(t, err)
+
+	reputation, found := k.GetProviderReputation(ctx, "A")
+	require.True(t, found)
+	require.Equal(t, uint64(4500), reputation.Score)
+	require.Equal(t, uint64(1), reputation.EarlyTerminations)
+	require.Equal(t, uint64(10), reputation.LastUpdated)
+
+	// A perfect score makes B's pricier offer rank first
+	k.SetProviderReputation(ctx, types.ProviderReputation{Provider: "B", Score: types.MaxReputationScore, LastUpdated: 10})
+	other := types.HostingInquiry{ReplicationRate: 1, EscrowAmount: tokens(1000), EndTime: 1000000}
+	other.Id = k.AppendHostingInquiry(ctx, other)
+	require.Equal(t, []uint64{offerB}, k.MatchHostingOffers(ctx, other, 1).MatchedOfferIds())
+
+	// Scores decay halfway back to neutral every half-life
+	require.Equal(t, uint64(8750), k.GetReputationScore(ctx.WithBlockHeight(int64(10+halfLife/2)), "B"))
+	require.Equal(t, uint64(7500), k.GetReputationScore(ctx.WithBlockHeight(int64(10+halfLife)), "B"))
+	require.Equal(t, uint64(4750), k.GetReputationScore(ctx.WithBlockHeight(int64(10+halfLife)), "A"))
+
+	res, err := k.ProviderReputation(ctx.WithBlockHeight(int64(10+halfLife)), &types.QueryGetProviderReputationRequest{Provider: "B"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(7500), res.ProviderReputation.Score)
+	res, err = k.ProviderReputation(ctx, &types.QueryGetProviderReputationRequest{Provider: "C"})
+	require.NoError(t, err)
+	require.Equal(t, types.NeutralReputationScore, res.ProviderReputation.Score)
+
+	// Completions raise the decayed score by a tenth of the gap to the maximum
+	ctx = ctx.WithBlockHeight(int64(10 + halfLife))
+	contract.Status = types.CONTRACT_STATUS_ACTIVE
+	contract.Creator = "B"
+	contract.EndBlock = uint64(ctx.BlockHeight())
+	k.SetHostingContract(ctx, contract)
+	require.NoError(t, k.CompleteHostingContract(ctx, contract.Id))
+	reputation, _ = k.GetProviderReputation(ctx, "B")
+	require.Equal(t, uint64(7750), reputation.Score)
+	require.Equal(t, uint64(1), reputation.CompletedContracts)
+
+	// Without a reputation weight offers rank by price alone
+	params := k.GetParams(ctx)
+	params.ReputationWeight = math.LegacyZeroDec()
+	require.NoError(t, k.SetParams(ctx, params))
+	require.Equal(t, []uint64{offerA}, k.MatchHostingOffers(ctx, other, 1).MatchedOfferIds())
+}
@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+// GetSlashEventCount get the total number of slashEvent
+func (k Keeper) GetSlashEventCount(ctx context.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.SlashEventCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetSlashEventCount set the total number of slashEvent
+func (k Keeper) SetSlashEventCount(ctx context.Context, count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.SlashEventCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendSlashEvent appends a slashEvent in the store with a new id and update the count
+func (k Keeper) AppendSlashEvent(ctx context.Context, slashEvent types.SlashEvent) uint64 {
+	count := k.GetSlashEventCount(ctx)
+	slashEvent.Id = count
+	k.SetSlashEvent(ctx, slashEvent)
+	k.SetSlashEventCount(ctx, count+1)
+	return count
+}
+
+// SetSlashEvent set a specific slashEvent in the store
+func (k Keeper) SetSlashEvent(ctx context.Context, slashEvent types.SlashEvent) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.SlashEventKey))
+	b := k.cdc.MustMarshal(&slashEvent)
+	store.Set(GetSlashEventIDBytes(slashEvent.Id), b)
+}
+
+// GetSlashEvent returns a slashEvent from its id
+func (k Keeper) GetSlashEvent(ctx context.Context, id uint64) (val types.SlashEvent, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.SlashEventKey))
+	b := store.Get(GetSlashEventIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllSlashEvent returns all slashEvent
+func (k Keeper) GetAllSlashEvent(ctx context.Context) (list []types.SlashEvent) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.SlashEventKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SlashEvent
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetSlashEventIDBytes returns the byte representation of the ID
+func GetSlashEventIDBytes(id uint64) []byte {
+	bz := types.KeyPrefix(types.SlashEventKey)
+	bz = append(bz, []byte("/")...)
+	bz = binary.BigEndian.AppendUint64(bz, id)
+	return bz
+}
+
+// disposeSlashedStake moves slashed tokens out of the hosting bonded pool to
+// the configured destination
+func (k Keeper) disposeSlashedStake(ctx context.Context, destination types.SlashDestination, slashed sdk.Coins, contractId uint64) error {
+	switch destination {
+	case types.SLASH_DESTINATION_BURN:
+		return k.bankKeeper.BurnCoins(ctx, "hosting_bonded_pool", slashed)
+
+	case types.SLASH_DESTINATION_COMMUNITY_POOL:
+		if k.distrKeeper == nil {
+			return fmt.Errorf("community pool is not available")
+		}
+		return k.distrKeeper.FundCommunityPool(ctx, slashed, authtypes.NewModuleAddress("hosting_bonded_pool"))
+
+	case types.SLASH_DESTINATION_COMPENSATE_CLIENTS:
+		// Each denom is shared out on its own, weighted by what the affected
+		// contracts still pay in that denom
+		for _, coin := range slashed {
+			shares := k.getSlashCompensationShares(ctx, coin, contractId)
+			if len(shares) == 0 {
+				// Nobody to compensate, the tokens are burned instead
+				if err := k.bankKeeper.BurnCoins(ctx, "hosting_bonded_pool", sdk.NewCoins(coin)); err != nil {
+					return err
+				}
+				continue
+			}
+			for _, share := range shares {
+				if !share.amount.IsPositive() {
+					continue
+				}
+				err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, "hosting_bonded_pool", share.creator, sdk.NewCoins(share.amount))
+				if err != nil {
+					return fmt.Errorf("failed to compensate %s: %w", share.creator.String(), err)
+				}
+			}
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown slash destination: %s", destination.String())
+	}
+}
+
+type compensationShare struct {
+	creator sdk.AccAddress
+	amount  sdk.Coin
+}
+
+// getSlashCompensationShares splits slashed tokens between the creators of the
+// inquiries affected by a provider's fault: the slashed contract and every other
+// contract the provider still serves. Each creator's share is proportional to
+// the remaining value of its contracts; when none has value left the split is
+// equal. Rounding dust goes to the creator of the slashed contract.
+func (k Keeper) getSlashCompensationShares(ctx context.Context, slashed sdk.Coin, contractId uint64) []compensationShare {
+	slashedContract, found := k.GetHostingContract(ctx, contractId)
+	if !found {
+		return nil
+	}
+	currentHeight := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	params := k.GetParams(ctx)
+
+	affected := []types.HostingContract{slashedContract}
+	for _, contract := range k.GetHostingContractsByProvider(ctx, slashedContract.Creator) {
+		if contract.Id != contractId && contract.Status == types.CONTRACT_STATUS_ACTIVE {
+			affected = append(affected, contract)
+		}
+	}
+
+	weights := make(map[string]math.Int)
+	firstCreator := ""
+	for _, contract := range affected {
+		inquiry, found := k.GetHostingInquiry(ctx, contract.InquiryId)
+		if !found {
+			continue
+		}
+		if _, err := sdk.AccAddressFromBech32(inquiry.Creator); err != nil {
+			continue
+		}
+		if firstCreator == "" {
+			firstCreator = inquiry.Creator
+		}
+		value, err := convertPaymentValue(params, getRemainingContractValue(contract, currentHeight), contract.PricePerBlock.Denom, slashed.Denom)
+		if err != nil {
+			value = math.ZeroInt()
+		}
+		if existing, ok := weights[inquiry.Creator]; ok {
+			weights[inquiry.Creator] = existing.Add(value)
+		} else {
+			weights[inquiry.Creator] = value
+		}
+	}
+	if len(weights) == 0 {
+		return nil
+	}
+
+	creators := make([]string, 0, len(weights))
+	totalWeight := math.ZeroInt()
+	for creator, weight := range weights {
+		creators = append(creators, creator)
+		totalWeight = totalWeight.Add(weight)
+	}
+	sort.Strings(creators)
+
+	shares := make([]compensationShare, 0, len(creators))
+	distributed := math.ZeroInt()
+	dustIndex := 0
+	for i, creator := range creators {
+		var amount math.Int
+		if totalWeight.IsZero() {
+			amount = slashed.Amount.QuoRaw(int64(len(creators)))
+		} else {
+			amount = slashed.Amount.Mul(weights[creator]).Quo(totalWeight)
+		}
+		if creator == firstCreator {
+			dustIndex = i
+		}
+		distributed = distributed.Add(amount)
+		shares = append(shares, compensationShare{
+			creator: sdk.MustAccAddressFromBech32(creator),
+			amount:  sdk.NewCoin(slashed.Denom, amount),
+		})
+	}
+	shares[dustIndex].amount = shares[dustIndex].amount.AddAmount(slashed.Amount.Sub(distributed))
+
+	return shares
+}
+
+// getRemainingContractValue returns what a contract still pays its provider
+// from height until its end block, in the denom and at the price the contract
+// was opened at. Contracts without a price are worth nothing.
+func getRemainingContractValue(contract types.HostingContract, height uint64) math.Int {
+	price := contract.PricePerBlock
+	if height >= contract.EndBlock || price.Amount.IsNil() {
+		return math.ZeroInt()
+	}
+	return price.Amount.Mul(math.NewIntFromUint64(contract.EndBlock - height))
+}
@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/hanshq/filespace-chain/testutil/keeper"
+	"github.com/hanshq/filespace-chain/testutil/nullify"
+	"github.com/hanshq/filespace-chain/testutil/sample"
+	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func setSlashDestination(t *testing.T, k keeper.Keeper, ctx sdk.Context, destination types.SlashDestination) {
+	params := k.GetParams(ctx)
+	params.SlashDestination = destination
+	require.NoError(t, k.SetParams(ctx, params))
+}
+
+// appendProviderContract stores an inquiry by creator and a contract of the
+// provider for it, priced per block until block 200
+func appendProviderContract(k keeper.Keeper, ctx sdk.Context, provider, creator string, price int64, status types.ContractStatus) types.HostingContract {
+	inquiryId := k.AppendHostingInquiry(ctx, types.HostingInquiry{Creator: creator})
+	offerId := k.AppendHostingOffer(ctx, types.HostingOffer{
+		Creator:       provider,
+		PricePerBlock: sdk.NewCoin("token", math.NewInt(price)),
+	})
+	contract := types.HostingContract{
+		Creator:       provider,
+		InquiryId:     inquiryId,
+		OfferId:       offerId,
+		StartBlock:    1,
+		EndBlock:      200,
+		Status:        status,
+		PricePerBlock: sdk.NewCoin("token", math.NewInt(price)),
+	}
+	contract.Id = k.AppendHostingContract(ctx, contract)
+	return contract
+}
+
+func TestSlashEventGetAll(t *testing.T) {
+	k, ctx := keepertest.FilespacechainKeeper(t)
+	items := make([]types.SlashEvent, 5)
+	for i := range items {
+		items[i].ContractId = uint64(i)
+		items[i].Id = k.AppendSlashEvent(ctx, items[i])
+	}
+	for _, item := range items {
+		got, found := k.GetSlashEvent(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t, nullify.Fill(&item), nullify.Fill(&got))
+	}
+	require.ElementsMatch(t, nullify.Fill(items), nullify.Fill(k.GetAllSlashEvent(ctx)))
+	require.Equal(t, uint64(len(items)), k.GetSlashEventCount(ctx))
+}
+
+func TestSlashBurnsByDefault(t *testing.T) {
+	k, _, ctx, bank, provider := setupStakedProvider(t)
+	contract := appendProviderContract(k, ctx, provider, sample.AccAddress(), 10, types.CONTRACT_STATUS_SLASHED)
+
+	err := k.SlashProvider(ctx, sdk.MustAccAddressFromBech32(provider), math.LegacyNewDecWithPrec(1, 1), contract.Id, "missed proof")
+	require.NoError(t, err)
+
+	require.Equal(t, math.NewInt(500000), bank.Burned().AmountOf("token"))
+	require.Equal(t, math.NewInt(4500000), bank.ModuleBalance(bondedPool).AmountOf("token"))
+
+	events := k.GetAllSlashEvent(ctx)
+	require.Len(t, events, 1)
+	require.Equal(t, provider, events[0].Provider)
+	require.Equal(t, contract.Id, events[0].ContractId)
+	require.Equal(t, "missed proof", events[0].Reason)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("token", math.NewInt(500000))), events[0].Amount)
+	require.Equal(t, types.SLASH_DESTINATION_BURN, events[0].Destination)
+	require.Equal(t, uint64(100), events[0].Height)
+}
+
+func TestSlashToCommunityPool(t *testing.T) {
+	k, _, ctx, bank, provider := setupStakedProvider(t)
+	setSlashDestination(t, k, ctx, types.SLASH_DESTINATION_COMMUNITY_POOL)
+	contract := appendProviderContract(k, ctx, provider, sample.AccAddress(), 10, types.CONTRACT_STATUS_SLASHED)
+
+	err := k.SlashProvider(ctx, sdk.MustAccAddressFromBech32(provider), math.LegacyNewDecWithPrec(1, 1), contract.Id, "missed proof")
+	require.NoError(t, err)
+
+	require.Equal(t, math.NewInt(500000), bank.CommunityPool().AmountOf("token"))
+	require.True(t, bank.Burned().IsZero())
+	require.Equal(t, math.NewInt(4500000), bank.ModuleBalance(bondedPool).AmountOf("token"))
+}
+
+func TestSlashCompensatesClientsProRata(t *testing.T) {
+	k, _, ctx, bank, provider := setupStakedProvider(t)
+	setSlashDestination(t, k, ctx, types.SLASH_DESTINATION_COMPENSATE_CLIENTS)
+
+	// 100 blocks left at 10 and 30 per block: creators share 1:3
+	first, second := sample.AccAddress(), sample.AccAddress()
+	slashed := appendProviderContract(k, ctx, provider, first, 10, types.CONTRACT_STATUS_SLASHED)
+	appendProviderContract(k, ctx, provider, second, 30, types.CONTRACT_STATUS_ACTIVE)
+	// Finished contracts are not affected
+	appendProviderContract(k, ctx, provider, sample.AccAddress(), 50, types.CONTRACT_STATUS_COMPLETED)
+
+	err := k.SlashProvider(ctx, sdk.MustAccAddressFromBech32(provider), math.LegacyNewDecWithPrec(1, 1), slashed.Id, "missed proof")
+	require.NoError(t, err)
+
+	require.Equal(t, math.NewInt(125000), bank.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(first)).AmountOf("token"))
+	require.Equal(t, math.NewInt(375000), bank.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(second)).AmountOf("token"))
+	require.Equal(t, math.NewInt(4500000), bank.ModuleBalance(bondedPool).AmountOf("token"))
+}
+
+func TestSlashCompensationDustGoesToSlashedContract(t *testing.T) {
+	k, _, ctx, bank, provider := setupStakedProvider(t)
+	setSlashDestination(t, k, ctx, types.SLASH_DESTINATION_COMPENSATE_CLIENTS)
+
+	first, second, third := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	slashed := appendProviderContract(k, ctx, provider, first, 10, types.CONTRACT_STATUS_SLASHED)
+	appendProviderContract(k, ctx, provider, second, 10, types.CONTRACT_STATUS_ACTIVE)
+	appendProviderContract(k, ctx, provider, third, 10, types.CONTRACT_STATUS_ACTIVE)
+
+	// 500,000 does not split evenly in three
+	err := k.SlashProvider(ctx, sdk.MustAccAddressFromBech32(provider), math.LegacyNewDecWithPrec(1, 1), slashed.Id, "missed proof")
+	require.NoError(t, err)
+
+	require.Equal(t, math.NewInt(166668), bank.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(first)).AmountOf("token"))
+	require.Equal(t, math.NewInt(166666), bank.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(second)).AmountOf("token"))
+	require.Equal(t, math.NewInt(166666), bank.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(third)).AmountOf("token"))
+	require.Equal(t, math.NewInt(4500000), bank.ModuleBalance(bondedPool).AmountOf("token"))
+}
+
+func TestSlashCompensationSkipsUnpricedContracts(t *testing.T) {
+	k, _, ctx, bank, provider := setupStakedProvider(t)
+	setSlashDestination(t, k, ctx, types.SLASH_DESTINATION_COMPENSATE_CLIENTS)
+
+	// Contracts opened before contracts kept their own price are worth
+	// nothing, so the priced contract's creator is compensated in full
+	first, second := sample.AccAddress(), sample.AccAddress()
+	slashed := appendProviderContract(k, ctx, provider, first, 10, types.CONTRACT_STATUS_SLASHED)
+	unpriced := appendProviderContract(k, ctx, provider, second, 30, types.CONTRACT_STATUS_ACTIVE)
+	unpriced.PricePerBlock = sdk.Coin{}
+	k.SetHostingContract(ctx, unpriced)
+
+	required, err := k.GetRequiredCollateral(ctx, provider, "token")
+	require.NoError(t, err)
+	require.True(t, required.IsZero())
+
+	err = k.SlashProvider(ctx, sdk.MustAccAddressFromBech32(provider), math.LegacyNewDecWithPrec(1, 1), slashed.Id, "missed proof")
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(500000), bank.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(first)).AmountOf("token"))
+	require.True(t, bank.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(second)).AmountOf("token").IsZero())
+}
+
+func TestSlashWithoutFundsKeepsStake(t *testing.T) {
+	bank := keepertest.NewMockBankKeeper()
+	k, ctx := keepertest.FilespacechainKeeperWithBank(t, bank)
+	provider := sample.AccAddress()
+	k.SetProviderStake(ctx, provider, sdk.NewCoin("token", math.NewInt(5000000)), 1)
+
+	// The bonded pool does not hold the stake, so nothing can be burned
+	err := k.SlashProvider(ctx, sdk.MustAccAddressFromBech32(provider), math.LegacyNewDecWithPrec(1, 1), 0, "missed proof")
+	require.Error(t, err)
+
+	stake, found := k.GetProviderStake(ctx, provider)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(5000000), stake.Amount.Amount)
+	require.Empty(t, k.GetAllSlashEvent(ctx))
+}
+
+func TestSlashEventQuery(t *testing.T) {
+	k, _, ctx, _, provider := setupStakedProvider(t)
+	contract := appendProviderContract(k, ctx, provider, sample.AccAddress(), 10, types.CONTRACT_STATUS_SLASHED)
+	require.NoError(t, k.SlashProvider(ctx, sdk.MustAccAddressFromBech32(provider), math.LegacyNewDecWithPrec(1, 1), contract.Id, "missed proof"))
+
+	res, err := k.SlashEvent(ctx, &types.QueryGetSlashEventRequest{Id: 0})
+	require.NoError(t, err)
+	require.Equal(t, contract.Id, res.SlashEvent.ContractId)
+
+	all, err := k.SlashEventAll(ctx, &types.QueryAllSlashEventRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.SlashEvent, 1)
+
+	_, err = k.SlashEvent(ctx, &types.QueryGetSlashEventRequest{Id: 1})
+	require.Error(t, err)
+}
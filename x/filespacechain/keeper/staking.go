@@ -2,88 +2,70 @@ package keeper
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
 )
 
-// ProviderStake tracks staked funds for a hosting provider
-type ProviderStake struct {
-	Provider string   `json:"provider"`
-	Amount   sdk.Coin `json:"amount"`
-	Height   uint64   `json:"height"` // Block height when stake was created
-}
-
-var (
-	ProviderStakeKeyPrefix = []byte{0x02} // Prefix for provider stake records
-)
-
-// ProviderStakeKey returns the store key for provider stake records
+// ProviderStakeKey returns the store key of a provider's stake record
 func ProviderStakeKey(provider string) []byte {
-	key := make([]byte, len(ProviderStakeKeyPrefix)+len(provider))
-	copy(key, ProviderStakeKeyPrefix)
-	copy(key[len(ProviderStakeKeyPrefix):], []byte(provider))
-	return key
+	return []byte(provider)
 }
 
 // SetProviderStake stores a provider stake record
 func (k Keeper) SetProviderStake(ctx context.Context, provider string, amount sdk.Coin, height uint64) {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProviderStakeKey))
 	
-	stake := ProviderStake{
+	stake := types.ProviderStake{
 		Provider: provider,
 		Amount:   amount,
 		Height:   height,
 	}
 	
-	bz, err := json.Marshal(stake)
-	if err != nil {
-		panic(err)
-	}
-	storeAdapter.Set(ProviderStakeKey(provider), bz)
+	b := k.cdc.MustMarshal(&stake)
+	store.Set(ProviderStakeKey(provider), b)
 }
 
 // GetProviderStake retrieves a provider stake record
-func (k Keeper) GetProviderStake(ctx context.Context, provider string) (ProviderStake, bool) {
+func (k Keeper) GetProviderStake(ctx context.Context, provider string) (types.ProviderStake, bool) {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProviderStakeKey))
 	
-	bz := storeAdapter.Get(ProviderStakeKey(provider))
+	bz := store.Get(ProviderStakeKey(provider))
 	if bz == nil {
-		return ProviderStake{}, false
+		return types.ProviderStake{}, false
 	}
 	
-	var stake ProviderStake
-	err := json.Unmarshal(bz, &stake)
-	if err != nil {
-		return ProviderStake{}, false
-	}
+	var stake types.ProviderStake
+	k.cdc.MustUnmarshal(bz, &stake)
 	return stake, true
 }
 
 // RemoveProviderStake removes a provider stake record
 func (k Keeper) RemoveProviderStake(ctx context.Context, provider string) {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	storeAdapter.Delete(ProviderStakeKey(provider))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProviderStakeKey))
+	store.Delete(ProviderStakeKey(provider))
 }
 
 // GetAllProviderStakes returns all provider stake records
-func (k Keeper) GetAllProviderStakes(ctx context.Context) []ProviderStake {
+func (k Keeper) GetAllProviderStakes(ctx context.Context) []types.ProviderStake {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProviderStakeKey))
 	
-	var stakes []ProviderStake
-	iterator := storetypes.KVStorePrefixIterator(storeAdapter, ProviderStakeKeyPrefix)
+	var stakes []types.ProviderStake
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
 	defer iterator.Close()
 	
 	for ; iterator.Valid(); iterator.Next() {
-		var stake ProviderStake
-		err := json.Unmarshal(iterator.Value(), &stake)
-		if err != nil {
-			continue // Skip invalid records
-		}
+		var stake types.ProviderStake
+		k.cdc.MustUnmarshal(iterator.Value(), &stake)
 		stakes = append(stakes, stake)
 	}
 	
@@ -103,9 +85,9 @@ func (k Keeper) UpdateProviderStake(ctx context.Context, provider string, newAmo
 }
 
 // GetProvidersByMinStake returns all providers with stake above the minimum threshold
-func (k Keeper) GetProvidersByMinStake(ctx context.Context, minAmount sdk.Coin) []ProviderStake {
+func (k Keeper) GetProvidersByMinStake(ctx context.Context, minAmount sdk.Coin) []types.ProviderStake {
 	allStakes := k.GetAllProviderStakes(ctx)
-	var qualifiedStakes []ProviderStake
+	var qualifiedStakes []types.ProviderStake
 	
 	for _, stake := range allStakes {
 		if stake.Amount.IsGTE(minAmount) {
@@ -175,9 +157,9 @@ func (k Keeper) DecrementProviderStake(ctx context.Context, provider string, dec
 }
 
 // GetProvidersStakedInRange returns providers with stakes within a specific block height range
-func (k Keeper) GetProvidersStakedInRange(ctx context.Context, startHeight, endHeight uint64) []ProviderStake {
+func (k Keeper) GetProvidersStakedInRange(ctx context.Context, startHeight, endHeight uint64) []types.ProviderStake {
 	allStakes := k.GetAllProviderStakes(ctx)
-	var rangeStakes []ProviderStake
+	var rangeStakes []types.ProviderStake
 	
 	for _, stake := range allStakes {
 		if stake.Height >= startHeight && stake.Height <= endHeight {
@@ -193,7 +175,7 @@ func (k Keeper) GetProviderStakeStats(ctx context.Context, denom string) map[str
 	allStakes := k.GetAllProviderStakes(ctx)
 	stats := make(map[string]interface{})
 	
-	var denomStakes []ProviderStake
+	var denomStakes []types.ProviderStake
 	for _, stake := range allStakes {
 		if stake.Amount.Denom == denom {
 			denomStakes = append(denomStakes, stake)
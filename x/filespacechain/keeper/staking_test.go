@@ -5,10 +5,13 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/hanshq/filespace-chain/testutil/keeper"
+	"github.com/hanshq/filespace-chain/testutil/sample"
 	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
 )
 
 func TestSetGetProviderStake(t *testing.T) {
@@ -343,7 +346,7 @@ func TestGetProviderStakeStatsEmpty(t *testing.T) {
 
 func TestProviderStakeStructure(t *testing.T) {
 	// Test the ProviderStake structure
-	stake := keeper.ProviderStake{
+	stake := types.ProviderStake{
 		Provider: "cosmos1test",
 		Amount:   sdk.NewCoin("utoken", math.NewInt(1000)),
 		Height:   uint64(123),
@@ -370,4 +373,34 @@ func TestProviderStakeKey(t *testing.T) {
 	// Keys for same provider should be same
 	key3 := keeper.ProviderStakeKey(provider)
 	require.Equal(t, key, key3)
-}
\ No newline at end of file
+}
+
+func TestStakeForHostingRequiresBondDenom(t *testing.T) {
+	bank := keepertest.NewMockBankKeeper()
+	k, ctx := keepertest.FilespacechainKeeperWithBank(t, bank)
+	srv := keeper.NewMsgServerImpl(k)
+	params := k.GetParams(ctx)
+
+	provider := sample.AccAddress()
+	bank.Fund(sdk.MustAccAddressFromBech32(provider), sdk.NewCoins(
+		sdk.NewCoin(params.BondDenom, params.MinProviderStake.MulRaw(2)),
+		sdk.NewCoin("other", params.MinProviderStake),
+	))
+
+	// Stake in another denom does not count towards the minimum stake
+	_, err := srv.StakeForHosting(ctx, &types.MsgStakeForHosting{Creator: provider, Amount: sdk.NewCoin("other", params.MinProviderStake)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, found := k.GetProviderStake(ctx, provider)
+	require.False(t, found)
+
+	_, err = srv.StakeForHosting(ctx, &types.MsgStakeForHosting{Creator: provider, Amount: sdk.NewCoin(params.BondDenom, params.MinProviderStake.SubRaw(1))})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.StakeForHosting(ctx, &types.MsgStakeForHosting{Creator: provider, Amount: sdk.NewCoin(params.BondDenom, params.MinProviderStake)})
+	require.NoError(t, err)
+	_, err = srv.StakeForHosting(ctx, &types.MsgStakeForHosting{Creator: provider, Amount: sdk.NewCoin(params.BondDenom, math.OneInt())})
+	require.NoError(t, err)
+	stake, found := k.GetProviderStake(ctx, provider)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoin(params.BondDenom, params.MinProviderStake.AddRaw(1)), stake.Amount)
+}
@@ -0,0 +1,489 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hanshq/filespace-chain/x/filespacechain/merkle"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+// GetStorageChallengeCount get the total number of storageChallenge
+func (k Keeper) GetStorageChallengeCount(ctx context.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.StorageChallengeCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetStorageChallengeCount set the total number of storageChallenge
+func (k Keeper) SetStorageChallengeCount(ctx context.Context, count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.StorageChallengeCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendStorageChallenge appends a storageChallenge in the store with a new id and update the count
+func (k Keeper) AppendStorageChallenge(
+	ctx context.Context,
+	storageChallenge types.StorageChallenge,
+) uint64 {
+	// Create the storageChallenge
+	count := k.GetStorageChallengeCount(ctx)
+
+	// Set the ID of the appended value
+	storageChallenge.Id = count
+
+	k.SetStorageChallenge(ctx, storageChallenge)
+
+	// Update storageChallenge count
+	k.SetStorageChallengeCount(ctx, count+1)
+
+	return count
+}
+
+// SetStorageChallenge set a specific storageChallenge in the store and keeps
+// the pending index of its contract and the deadline queue in sync with the
+// challenge status
+func (k Keeper) SetStorageChallenge(ctx context.Context, storageChallenge types.StorageChallenge) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StorageChallengeKey))
+	b := k.cdc.MustMarshal(&storageChallenge)
+	store.Set(GetStorageChallengeIDBytes(storageChallenge.Id), b)
+
+	pendingStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StorageChallengePendingKey))
+	deadlineStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StorageChallengeDeadlineKey))
+	contractKey := GetHostingContractIDBytes(storageChallenge.ContractId)
+	deadlineKey := getStorageChallengeDeadlineKey(storageChallenge.DeadlineBlock, storageChallenge.Id)
+	if storageChallenge.Status == types.CHALLENGE_STATUS_PENDING {
+		pendingStore.Set(contractKey, GetStorageChallengeIDBytes(storageChallenge.Id))
+		deadlineStore.Set(deadlineKey, []byte{})
+	} else {
+		if k.pendingChallengeIdMatches(pendingStore, contractKey, storageChallenge.Id) {
+			pendingStore.Delete(contractKey)
+		}
+		deadlineStore.Delete(deadlineKey)
+	}
+}
+
+func (k Keeper) pendingChallengeIdMatches(pendingStore prefix.Store, contractKey []byte, id uint64) bool {
+	bz := pendingStore.Get(contractKey)
+	return bz != nil && string(bz) == string(GetStorageChallengeIDBytes(id))
+}
+
+// GetStorageChallenge returns a storageChallenge from its id
+func (k Keeper) GetStorageChallenge(ctx context.Context, id uint64) (val types.StorageChallenge, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StorageChallengeKey))
+	b := store.Get(GetStorageChallengeIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllStorageChallenge returns all storageChallenge
+func (k Keeper) GetAllStorageChallenge(ctx context.Context) (list []types.StorageChallenge) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StorageChallengeKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.StorageChallenge
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetPendingStorageChallenge returns the challenge of a contract that is
+// still waiting for a proof
+func (k Keeper) GetPendingStorageChallenge(ctx context.Context, contractId uint64) (val types.StorageChallenge, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	pendingStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StorageChallengePendingKey))
+	bz := pendingStore.Get(GetHostingContractIDBytes(contractId))
+	if bz == nil {
+		return val, false
+	}
+	return k.GetStorageChallenge(ctx, getStorageChallengeIDFromBytes(bz))
+}
+
+// GetOverdueStorageChallenges returns the pending challenges whose deadline
+// is before the given height, oldest deadline first
+func (k Keeper) GetOverdueStorageChallenges(ctx context.Context, height uint64) (list []types.StorageChallenge) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	deadlineStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StorageChallengeDeadlineKey))
+	iterator := deadlineStore.Iterator(nil, binary.BigEndian.AppendUint64(nil, height))
+
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, binary.BigEndian.Uint64(iterator.Key()[8:]))
+	}
+	iterator.Close()
+
+	for _, id := range ids {
+		challenge, found := k.GetStorageChallenge(ctx, id)
+		if found {
+			list = append(list, challenge)
+		}
+	}
+
+	return
+}
+
+// HasPendingStorageChallenge reports whether a contract has an unanswered challenge
+func (k Keeper) HasPendingStorageChallenge(ctx context.Context, contractId uint64) bool {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	pendingStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StorageChallengePendingKey))
+	return pendingStore.Has(GetHostingContractIDBytes(contractId))
+}
+
+// GetStorageChallengeIDBytes returns the byte representation of the ID
+func GetStorageChallengeIDBytes(id uint64) []byte {
+	bz := types.KeyPrefix(types.StorageChallengeKey)
+	bz = append(bz, []byte("/")...)
+	bz = binary.BigEndian.AppendUint64(bz, id)
+	return bz
+}
+
+func getStorageChallengeIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz[len(bz)-8:])
+}
+
+// getStorageChallengeDeadlineKey orders the deadline queue by deadline, then id
+func getStorageChallengeDeadlineKey(deadline, id uint64) []byte {
+	bz := binary.BigEndian.AppendUint64(nil, deadline)
+	return binary.BigEndian.AppendUint64(bz, id)
+}
+
+// setChallengeableContractIndex keeps a contract in the index challenges are
+// drawn from while it is ACTIVE. Contracts that ran out are only dropped when
+// they are drawn, so the index never has to be swept.
+func (k Keeper) setChallengeableContractIndex(ctx context.Context, contract types.HostingContract) {
+	if contract.Status != types.CONTRACT_STATUS_ACTIVE {
+		k.removeChallengeableContractIndex(ctx, contract.Id)
+		return
+	}
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ChallengeableContractKey))
+	store.Set(GetHostingContractIDBytes(contract.Id), []byte{})
+}
+
+func (k Keeper) removeChallengeableContractIndex(ctx context.Context, contractId uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ChallengeableContractKey))
+	store.Delete(GetHostingContractIDBytes(contractId))
+}
+
+// drawChallengeableContract picks the first indexed contract at or after a
+// random contract id, wrapping around to the start of the index, so a draw
+// costs a single seek however many contracts there are
+func (k Keeper) drawChallengeableContract(ctx context.Context, entropy *challengeEntropy) (uint64, bool) {
+	count := k.GetHostingContractCount(ctx)
+	if count == 0 {
+		return 0, false
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ChallengeableContractKey))
+	for _, start := range [][]byte{GetHostingContractIDBytes(entropy.Uint64n(count)), nil} {
+		iterator := store.Iterator(start, nil)
+		valid := iterator.Valid()
+		var key []byte
+		if valid {
+			key = iterator.Key()
+		}
+		iterator.Close()
+		if valid {
+			return binary.BigEndian.Uint64(key[len(key)-8:]), true
+		}
+	}
+	return 0, false
+}
+
+// challengeEntropy derives deterministic pseudo-random numbers from a block
+// hash, so every validator selects the same contracts and chunks
+type challengeEntropy struct {
+	seed    []byte
+	counter uint64
+}
+
+func newChallengeEntropy(ctx sdk.Context) *challengeEntropy {
+	seed := sha256.New()
+	seed.Write(ctx.HeaderHash())
+	seed.Write(binary.BigEndian.AppendUint64(nil, uint64(ctx.BlockHeight())))
+	return &challengeEntropy{seed: seed.Sum(nil)}
+}
+
+// Uint64n returns a value in [0, n)
+func (e *challengeEntropy) Uint64n(n uint64) uint64 {
+	h := sha256.New()
+	h.Write(e.seed)
+	h.Write(binary.BigEndian.AppendUint64(nil, e.counter))
+	e.counter++
+	return binary.BigEndian.Uint64(h.Sum(nil)[:8]) % n
+}
+
+// sampleChunkIndices picks up to count distinct chunk indices below chunkCount
+func (e *challengeEntropy) sampleChunkIndices(chunkCount, count uint64) []uint64 {
+	if count > chunkCount {
+		count = chunkCount
+	}
+
+	picked := make(map[uint64]bool, count)
+	indices := make([]uint64, 0, count)
+	for uint64(len(indices)) < count {
+		index := e.Uint64n(chunkCount)
+		if picked[index] {
+			continue
+		}
+		picked[index] = true
+		indices = append(indices, index)
+	}
+	return indices
+}
+
+// ProcessStorageChallenges fails challenges whose deadline has passed and
+// issues new challenges to randomly selected active contracts.
+// This should be called in BeginBlock, before periodic payments are made.
+func (k Keeper) ProcessStorageChallenges(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentHeight := uint64(sdkCtx.BlockHeight())
+
+	for _, challenge := range k.GetOverdueStorageChallenges(ctx, currentHeight) {
+		k.FailStorageChallenge(ctx, challenge, types.CHALLENGE_STATUS_MISSED, "no proof submitted before deadline")
+	}
+
+	return k.IssueStorageChallenges(ctx)
+}
+
+// challengeDrawsPerChallenge bounds the draws from the challengeable index
+// spent on each challenge a block may issue, as drawn contracts may turn out
+// to be finished, already challenged or drawn twice
+const challengeDrawsPerChallenge = 4
+
+// IssueStorageChallenges draws contracts at random from the challengeable
+// index, seeded from the block hash, and challenges their providers for
+// random chunks of the file
+func (k Keeper) IssueStorageChallenges(ctx context.Context) error {
+	params := k.GetParams(ctx)
+	if params.ChallengesPerBlock == 0 {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentHeight := uint64(sdkCtx.BlockHeight())
+	entropy := newChallengeEntropy(sdkCtx)
+
+	issued := uint64(0)
+	for draws := uint64(0); issued < params.ChallengesPerBlock && draws < params.ChallengesPerBlock*challengeDrawsPerChallenge; draws++ {
+		contractId, found := k.drawChallengeableContract(ctx, entropy)
+		if !found {
+			break
+		}
+
+		contract, found := k.GetHostingContract(ctx, contractId)
+		if !found || currentHeight > contract.EndBlock {
+			// The contract ran out; it is never challenged again
+			k.removeChallengeableContractIndex(ctx, contractId)
+			continue
+		}
+		if currentHeight < contract.StartBlock || k.HasPendingStorageChallenge(ctx, contract.Id) {
+			continue
+		}
+
+		inquiry, found := k.GetHostingInquiry(ctx, contract.InquiryId)
+		if !found {
+			continue
+		}
+
+		fileEntry, found := k.GetInquiryFileEntry(ctx, inquiry)
+		if !found || fileEntry.ChunkCount == 0 || len(fileEntry.MerkleRoot) == 0 {
+			// Files without a Merkle commitment cannot be challenged
+			continue
+		}
+
+		challenge := types.StorageChallenge{
+			ContractId:    contract.Id,
+			Provider:      contract.Creator,
+			FileEntryCid:  fileEntry.Cid,
+			FileEntryId:   fileEntry.Id,
+			ChunkIndices:  entropy.sampleChunkIndices(fileEntry.ChunkCount, params.ChunksPerChallenge),
+			IssuedBlock:   currentHeight,
+			DeadlineBlock: currentHeight + params.ChallengeWindow,
+			Status:        types.CHALLENGE_STATUS_PENDING,
+		}
+		challenge.Id = k.AppendStorageChallenge(ctx, challenge)
+		issued++
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"storageChallengeIssued",
+				sdk.NewAttribute("ChallengeId", strconv.FormatUint(challenge.Id, 10)),
+				sdk.NewAttribute("ContractId", strconv.FormatUint(challenge.ContractId, 10)),
+				sdk.NewAttribute("Provider", challenge.Provider),
+				sdk.NewAttribute("FileEntryCID", challenge.FileEntryCid),
+				sdk.NewAttribute("ChunkIndices", formatChunkIndices(challenge.ChunkIndices)),
+				sdk.NewAttribute("DeadlineBlock", strconv.FormatUint(challenge.DeadlineBlock, 10)),
+			),
+		)
+	}
+
+	return nil
+}
+
+// PassStorageChallenge records a successfully answered challenge
+func (k Keeper) PassStorageChallenge(ctx context.Context, challenge types.StorageChallenge) {
+	challenge.Status = types.CHALLENGE_STATUS_PASSED
+	k.SetStorageChallenge(ctx, challenge)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"storageProofVerified",
+			sdk.NewAttribute("ChallengeId", strconv.FormatUint(challenge.Id, 10)),
+			sdk.NewAttribute("ContractId", strconv.FormatUint(challenge.ContractId, 10)),
+			sdk.NewAttribute("Provider", challenge.Provider),
+		),
+	)
+}
+
+// FailStorageChallenge records a missed or invalid proof, slashes the provider
+// and stops all further payments for the challenged contract. A contract that
+// has been handed over since is left to its new provider.
+func (k Keeper) FailStorageChallenge(ctx context.Context, challenge types.StorageChallenge, status types.ChallengeStatus, reason string) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	challenge.Status = status
+	k.SetStorageChallenge(ctx, challenge)
+
+	contract, found := k.GetHostingContract(ctx, challenge.ContractId)
+	if found && contract.Status == types.CONTRACT_STATUS_ACTIVE && contract.Creator == challenge.Provider {
+		if _, err := k.TransitionHostingContract(ctx, contract, types.CONTRACT_STATUS_SLASHED, reason); err != nil {
+			k.Logger().Error("failed to mark contract as slashed",
+				"contract_id", contract.Id,
+				"error", err,
+			)
+		}
+	}
+
+	if err := k.recordReputationEvent(ctx, challenge.Provider, types.REPUTATION_EVENT_PROOF_FAILED); err != nil {
+		k.Logger().Error("failed to record failed proof in provider reputation",
+			"challenge_id", challenge.Id,
+			"provider", challenge.Provider,
+			"error", err,
+		)
+	}
+
+	providerAddr, err := sdk.AccAddressFromBech32(challenge.Provider)
+	if err != nil {
+		k.Logger().Error("invalid provider address in storage challenge",
+			"challenge_id", challenge.Id,
+			"provider", challenge.Provider,
+			"error", err,
+		)
+	} else if _, err := k.SlashProviderForInfraction(ctx, providerAddr, k.GetParams(ctx).SlashingFraction, challenge.IssuedBlock, challenge.ContractId, reason); err != nil {
+		k.Logger().Error("failed to slash provider for storage proof failure",
+			"challenge_id", challenge.Id,
+			"provider", challenge.Provider,
+			"error", err,
+		)
+	}
+
+	k.Logger().Info("storage challenge failed",
+		"challenge_id", challenge.Id,
+		"contract_id", challenge.ContractId,
+		"provider", challenge.Provider,
+		"reason", reason,
+	)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"storageProofFailed",
+			sdk.NewAttribute("ChallengeId", strconv.FormatUint(challenge.Id, 10)),
+			sdk.NewAttribute("ContractId", strconv.FormatUint(challenge.ContractId, 10)),
+			sdk.NewAttribute("Provider", challenge.Provider),
+			sdk.NewAttribute("Status", status.String()),
+			sdk.NewAttribute("Reason", reason),
+		),
+	)
+}
+
+// VerifyStorageProofs checks the submitted chunk proofs against the Merkle
+// root committed in the challenged file entry
+func (k Keeper) VerifyStorageProofs(ctx context.Context, challenge types.StorageChallenge, proofs []types.ChunkProof) error {
+	fileEntry, found := k.GetFileEntry(ctx, challenge.FileEntryId)
+	if !found || fileEntry.Cid != challenge.FileEntryCid {
+		return fmt.Errorf("file entry %d not found", challenge.FileEntryId)
+	}
+
+	if len(proofs) != len(challenge.ChunkIndices) {
+		return fmt.Errorf("expected %d chunk proofs, got %d", len(challenge.ChunkIndices), len(proofs))
+	}
+
+	for i, proof := range proofs {
+		if proof.ChunkIndex != challenge.ChunkIndices[i] {
+			return fmt.Errorf("proof %d is for chunk %d, expected chunk %d", i, proof.ChunkIndex, challenge.ChunkIndices[i])
+		}
+		if err := verifyChunkProof(fileEntry, proof); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// verifyChunkProof checks a single chunk proof against the Merkle root
+// committed in a file entry
+func verifyChunkProof(fileEntry types.FileEntry, proof types.ChunkProof) error {
+	if fileEntry.ChunkSize > 0 && uint64(len(proof.Chunk)) > fileEntry.ChunkSize {
+		return fmt.Errorf("chunk %d is %d bytes, larger than the chunk size %d", proof.ChunkIndex, len(proof.Chunk), fileEntry.ChunkSize)
+	}
+	if !merkle.VerifyProof(fileEntry.MerkleRoot, proof.Chunk, proof.ChunkIndex, fileEntry.ChunkCount, proof.Siblings) {
+		return fmt.Errorf("inclusion proof for chunk %d does not match merkle root", proof.ChunkIndex)
+	}
+	return nil
+}
+
+// drawAcceptanceChunk picks the chunk the provider of a new contract proves
+// to accept it; the draw is mixed with the contract id so contracts opened in
+// the same block prove different chunks
+func drawAcceptanceChunk(ctx sdk.Context, contractId, chunkCount uint64) uint64 {
+	if chunkCount == 0 {
+		return 0
+	}
+	entropy := newChallengeEntropy(ctx)
+	entropy.counter = contractId
+	return entropy.Uint64n(chunkCount)
+}
+
+func formatChunkIndices(indices []uint64) string {
+	parts := make([]string, len(indices))
+	for i, index := range indices {
+		parts[i] = strconv.FormatUint(index, 10)
+	}
+	return strings.Join(parts, ",")
+}
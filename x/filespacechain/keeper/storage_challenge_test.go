@@ -0,0 +1,308 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/hanshq/filespace-chain/testutil/keeper"
+	"github.com/hanshq/filespace-chain/testutil/nullify"
+	"github.com/hanshq/filespace-chain/testutil/sample"
+	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
+	"github.com/hanshq/filespace-chain/x/filespacechain/merkle"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func createNStorageChallenge(keeper keeper.Keeper, ctx context.Context, n int) []types.StorageChallenge {
+	items := make([]types.StorageChallenge, n)
+	for i := range items {
+		items[i].ContractId = uint64(i)
+		items[i].Id = keeper.AppendStorageChallenge(ctx, items[i])
+	}
+	return items
+}
+
+func TestStorageChallengeGet(t *testing.T) {
+	keeper, ctx := keepertest.FilespacechainKeeper(t)
+	items := createNStorageChallenge(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetStorageChallenge(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestStorageChallengeGetAll(t *testing.T) {
+	keeper, ctx := keepertest.FilespacechainKeeper(t)
+	items := createNStorageChallenge(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllStorageChallenge(ctx)),
+	)
+	require.Equal(t, uint64(len(items)), keeper.GetStorageChallengeCount(ctx))
+}
+
+func TestStorageChallengePendingIndex(t *testing.T) {
+	keeper, ctx := keepertest.FilespacechainKeeper(t)
+	items := createNStorageChallenge(keeper, ctx, 3)
+	require.Len(t, keeper.GetOverdueStorageChallenges(ctx, 1), 3)
+
+	items[1].Status = types.CHALLENGE_STATUS_PASSED
+	keeper.SetStorageChallenge(ctx, items[1])
+	require.False(t, keeper.HasPendingStorageChallenge(ctx, items[1].ContractId))
+	require.True(t, keeper.HasPendingStorageChallenge(ctx, items[0].ContractId))
+	require.Len(t, keeper.GetOverdueStorageChallenges(ctx, 1), 2)
+
+	got, found := keeper.GetPendingStorageChallenge(ctx, items[2].ContractId)
+	require.True(t, found)
+	require.Equal(t, items[2].Id, got.Id)
+	_, found = keeper.GetPendingStorageChallenge(ctx, items[1].ContractId)
+	require.False(t, found)
+}
+
+func TestStorageChallengeDeadlineQueue(t *testing.T) {
+	keeper, ctx := keepertest.FilespacechainKeeper(t)
+	early := keeper.AppendStorageChallenge(ctx, types.StorageChallenge{ContractId: 0, DeadlineBlock: 20})
+	late := keeper.AppendStorageChallenge(ctx, types.StorageChallenge{ContractId: 1, DeadlineBlock: 10})
+
+	// Only challenges whose deadline is behind the height are due, by deadline
+	require.Empty(t, keeper.GetOverdueStorageChallenges(ctx, 10))
+	due := keeper.GetOverdueStorageChallenges(ctx, 21)
+	require.Len(t, due, 2)
+	require.Equal(t, late, due[0].Id)
+	require.Equal(t, early, due[1].Id)
+}
+
+type challengeFixture struct {
+	provider  string
+	contract  types.HostingContract
+	fileEntry types.FileEntry
+	chunks    [][]byte
+	tree      *merkle.Tree
+}
+
+// setupChallengeFixture stores a file entry committed by a Merkle root, an
+// inquiry for it and an active contract held by a staked provider
+func setupChallengeFixture(t *testing.T, k keeper.Keeper, ctx sdk.Context) challengeFixture {
+	chunks := make([][]byte, 8)
+	for i := range chunks {
+		chunks[i] = []byte(fmt.Sprintf("chunk-%d", i))
+	}
+	tree, err := merkle.NewTree(chunks)
+	require.NoError(t, err)
+
+	fileEntry := types.FileEntry{
+		Cid:        "bafyfile",
+		MerkleRoot: tree.Root(),
+		ChunkCount: tree.LeafCount(),
+	}
+	fileEntry.Id = k.AppendFileEntry(ctx, fileEntry)
+	inquiryId := k.AppendHostingInquiry(ctx, types.HostingInquiry{FileEntryCid: "bafyfile", FileEntryId: fileEntry.Id})
+
+	provider := sample.AccAddress()
+	k.SetProviderStake(ctx, provider, sdk.NewCoin("token", math.NewInt(1000)), 1)
+
+	contract := types.HostingContract{
+		Creator:    provider,
+		InquiryId:  inquiryId,
+		StartBlock: 1,
+		EndBlock:   1000,
+		Status:     types.CONTRACT_STATUS_ACTIVE,
+	}
+	contract.Id = k.AppendHostingContract(ctx, contract)
+
+	return challengeFixture{provider: provider, contract: contract, fileEntry: fileEntry, chunks: chunks, tree: tree}
+}
+
+// fundedBondedPool holds the 1000 token staked by the fixture provider
+func fundedBondedPool() *keepertest.MockBankKeeper {
+	bank := keepertest.NewMockBankKeeper()
+	bank.FundModule("hosting_bonded_pool", sdk.NewCoins(sdk.NewCoin("token", math.NewInt(1000))))
+	return bank
+}
+
+func issueChallenge(t *testing.T, k keeper.Keeper, ctx sdk.Context) types.StorageChallenge {
+	require.NoError(t, k.ProcessStorageChallenges(ctx))
+	require.Equal(t, uint64(1), k.GetStorageChallengeCount(ctx))
+	challenge, found := k.GetStorageChallenge(ctx, 0)
+	require.True(t, found)
+	return challenge
+}
+
+func proveChallenge(t *testing.T, f challengeFixture, challenge types.StorageChallenge) []types.ChunkProof {
+	proofs := make([]types.ChunkProof, len(challenge.ChunkIndices))
+	for i, index := range challenge.ChunkIndices {
+		siblings, err := f.tree.Proof(index)
+		require.NoError(t, err)
+		proofs[i] = types.ChunkProof{ChunkIndex: index, Chunk: f.chunks[index], Siblings: siblings}
+	}
+	return proofs
+}
+
+func TestIssueStorageChallenges(t *testing.T) {
+	k, ctx := keepertest.FilespacechainKeeper(t)
+	ctx = ctx.WithBlockHeight(10).WithHeaderHash([]byte("block-hash"))
+	f := setupChallengeFixture(t, k, ctx)
+	params := k.GetParams(ctx)
+
+	challenge := issueChallenge(t, k, ctx)
+	require.Equal(t, f.contract.Id, challenge.ContractId)
+	require.Equal(t, f.provider, challenge.Provider)
+	require.Equal(t, uint64(10)+params.ChallengeWindow, challenge.DeadlineBlock)
+	require.Len(t, challenge.ChunkIndices, int(params.ChunksPerChallenge))
+	for _, index := range challenge.ChunkIndices {
+		require.Less(t, index, f.tree.LeafCount())
+	}
+
+	// A contract with an outstanding challenge is not challenged again
+	require.NoError(t, k.ProcessStorageChallenges(ctx.WithBlockHeight(11)))
+	require.Equal(t, uint64(1), k.GetStorageChallengeCount(ctx))
+}
+
+func TestSubmitStorageProofValid(t *testing.T) {
+	k, ctx := keepertest.FilespacechainKeeper(t)
+	ctx = ctx.WithBlockHeight(10).WithHeaderHash([]byte("block-hash"))
+	srv := keeper.NewMsgServerImpl(k)
+	f := setupChallengeFixture(t, k, ctx)
+	challenge := issueChallenge(t, k, ctx)
+
+	// Only the challenged provider may answer
+	_, err := srv.SubmitStorageProof(ctx, &types.MsgSubmitStorageProof{
+		Creator:     sample.AccAddress(),
+		ChallengeId: challenge.Id,
+		Proofs:      proveChallenge(t, f, challenge),
+	})
+	require.Error(t, err)
+
+	res, err := srv.SubmitStorageProof(ctx, &types.MsgSubmitStorageProof{
+		Creator:     f.provider,
+		ChallengeId: challenge.Id,
+		Proofs:      proveChallenge(t, f, challenge),
+	})
+	require.NoError(t, err)
+	require.True(t, res.Verified)
+
+	got, found := k.GetStorageChallenge(ctx, challenge.Id)
+	require.True(t, found)
+	require.Equal(t, types.CHALLENGE_STATUS_PASSED, got.Status)
+
+	stake, found := k.GetProviderStake(ctx, f.provider)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(1000), stake.Amount.Amount)
+
+	// Answering twice is rejected
+	_, err = srv.SubmitStorageProof(ctx, &types.MsgSubmitStorageProof{
+		Creator:     f.provider,
+		ChallengeId: challenge.Id,
+		Proofs:      proveChallenge(t, f, challenge),
+	})
+	require.ErrorIs(t, err, types.ErrChallengeNotPending)
+}
+
+func TestSubmitStorageProofInvalid(t *testing.T) {
+	k, ctx := keepertest.FilespacechainKeeperWithBank(t, fundedBondedPool())
+	ctx = ctx.WithBlockHeight(10).WithHeaderHash([]byte("block-hash"))
+	srv := keeper.NewMsgServerImpl(k)
+	f := setupChallengeFixture(t, k, ctx)
+	challenge := issueChallenge(t, k, ctx)
+
+	proofs := proveChallenge(t, f, challenge)
+	proofs[0].Chunk = []byte("forged")
+
+	res, err := srv.SubmitStorageProof(ctx, &types.MsgSubmitStorageProof{
+		Creator:     f.provider,
+		ChallengeId: challenge.Id,
+		Proofs:      proofs,
+	})
+	require.NoError(t, err)
+	require.False(t, res.Verified)
+
+	got, _ := k.GetStorageChallenge(ctx, challenge.Id)
+	require.Equal(t, types.CHALLENGE_STATUS_FAILED, got.Status)
+
+	contract, _ := k.GetHostingContract(ctx, f.contract.Id)
+	require.Equal(t, types.CONTRACT_STATUS_SLASHED, contract.Status)
+	require.Equal(t, uint64(10), contract.SlashedBlock)
+
+	params := k.GetParams(ctx)
+	expected := math.NewInt(1000).Sub(math.LegacyNewDec(1000).Mul(params.SlashingFraction).TruncateInt())
+	stake, found := k.GetProviderStake(ctx, f.provider)
+	require.True(t, found)
+	require.Equal(t, expected, stake.Amount.Amount)
+
+	// Slashed contracts are no longer challenged
+	require.NoError(t, k.ProcessStorageChallenges(ctx.WithBlockHeight(11)))
+	require.False(t, k.HasPendingStorageChallenge(ctx, f.contract.Id))
+	require.Equal(t, uint64(1), k.GetStorageChallengeCount(ctx))
+}
+
+func TestStorageChallengeMissedDeadline(t *testing.T) {
+	k, ctx := keepertest.FilespacechainKeeperWithBank(t, fundedBondedPool())
+	ctx = ctx.WithBlockHeight(10).WithHeaderHash([]byte("block-hash"))
+	srv := keeper.NewMsgServerImpl(k)
+	f := setupChallengeFixture(t, k, ctx)
+	challenge := issueChallenge(t, k, ctx)
+
+	late := ctx.WithBlockHeight(int64(challenge.DeadlineBlock) + 1)
+	_, err := srv.SubmitStorageProof(late, &types.MsgSubmitStorageProof{
+		Creator:     f.provider,
+		ChallengeId: challenge.Id,
+		Proofs:      proveChallenge(t, f, challenge),
+	})
+	require.ErrorIs(t, err, types.ErrChallengeExpired)
+
+	require.NoError(t, k.ProcessStorageChallenges(late))
+
+	got, _ := k.GetStorageChallenge(late, challenge.Id)
+	require.Equal(t, types.CHALLENGE_STATUS_MISSED, got.Status)
+
+	contract, _ := k.GetHostingContract(late, f.contract.Id)
+	require.Equal(t, types.CONTRACT_STATUS_SLASHED, contract.Status)
+	require.Equal(t, challenge.DeadlineBlock+1, contract.SlashedBlock)
+
+	stake, found := k.GetProviderStake(late, f.provider)
+	require.True(t, found)
+	require.True(t, stake.Amount.Amount.LT(math.NewInt(1000)))
+
+	// No completion bonus is paid for a slashed contract
+	require.Error(t, k.ProcessCompletionBonus(late, f.contract.Id))
+}
+
+func TestStorageChallengeUsesBoundFileEntry(t *testing.T) {
+	k, ctx := keepertest.FilespacechainKeeper(t)
+	ctx = ctx.WithBlockHeight(10).WithHeaderHash([]byte("block-hash"))
+	srv := keeper.NewMsgServerImpl(k)
+
+	// An earlier entry registers the same CID under a different commitment
+	k.AppendFileEntry(ctx, types.FileEntry{Cid: "bafyfile", MerkleRoot: make([]byte, 32), ChunkCount: 8})
+	f := setupChallengeFixture(t, k, ctx)
+	challenge := issueChallenge(t, k, ctx)
+	require.Equal(t, f.fileEntry.Id, challenge.FileEntryId)
+
+	res, err := srv.SubmitStorageProof(ctx, &types.MsgSubmitStorageProof{
+		Creator:     f.provider,
+		ChallengeId: challenge.Id,
+		Proofs:      proveChallenge(t, f, challenge),
+	})
+	require.NoError(t, err)
+	require.True(t, res.Verified)
+}
+
+func TestStorageChallengeSkipsFinishedContracts(t *testing.T) {
+	k, ctx := keepertest.FilespacechainKeeper(t)
+	ctx = ctx.WithBlockHeight(1001).WithHeaderHash([]byte("block-hash"))
+	setupChallengeFixture(t, k, ctx)
+
+	// The contract ran out, so it is dropped from the index instead
+	require.NoError(t, k.ProcessStorageChallenges(ctx))
+	require.Zero(t, k.GetStorageChallengeCount(ctx))
+	require.NoError(t, k.ProcessStorageChallenges(ctx.WithBlockHeight(1002)))
+	require.Zero(t, k.GetStorageChallengeCount(ctx))
+}
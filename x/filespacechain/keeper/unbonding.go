@@ -0,0 +1,326 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+// GetUnbondingEntryCount get the total number of unbondingEntry
+func (k Keeper) GetUnbondingEntryCount(ctx context.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.UnbondingEntryCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetUnbondingEntryCount set the total number of unbondingEntry
+func (k Keeper) SetUnbondingEntryCount(ctx context.Context, count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.UnbondingEntryCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendUnbondingEntry appends an unbondingEntry in the store with a new id and update the count
+func (k Keeper) AppendUnbondingEntry(ctx context.Context, entry types.UnbondingEntry) uint64 {
+	count := k.GetUnbondingEntryCount(ctx)
+	entry.Id = count
+	k.SetUnbondingEntry(ctx, entry)
+	k.SetUnbondingEntryCount(ctx, count+1)
+	return count
+}
+
+// SetUnbondingEntry set a specific unbondingEntry in the store together with
+// its completion queue and provider index entries
+func (k Keeper) SetUnbondingEntry(ctx context.Context, entry types.UnbondingEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.UnbondingEntryKey))
+	b := k.cdc.MustMarshal(&entry)
+	store.Set(GetUnbondingEntryIDBytes(entry.Id), b)
+
+	queueStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.UnbondingQueueKey))
+	queueStore.Set(unbondingQueueKey(entry.CompletionHeight, entry.Id), GetUnbondingEntryIDBytes(entry.Id))
+
+	providerStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.UnbondingByProviderKey))
+	providerStore.Set(unbondingProviderKey(entry.Provider, entry.Id), GetUnbondingEntryIDBytes(entry.Id))
+}
+
+// GetUnbondingEntry returns an unbondingEntry from its id
+func (k Keeper) GetUnbondingEntry(ctx context.Context, id uint64) (val types.UnbondingEntry, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.UnbondingEntryKey))
+	b := store.Get(GetUnbondingEntryIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveUnbondingEntry removes an unbondingEntry and its index entries from the store
+func (k Keeper) RemoveUnbondingEntry(ctx context.Context, entry types.UnbondingEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.UnbondingEntryKey))
+	store.Delete(GetUnbondingEntryIDBytes(entry.Id))
+
+	queueStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.UnbondingQueueKey))
+	queueStore.Delete(unbondingQueueKey(entry.CompletionHeight, entry.Id))
+
+	providerStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.UnbondingByProviderKey))
+	providerStore.Delete(unbondingProviderKey(entry.Provider, entry.Id))
+}
+
+// GetAllUnbondingEntry returns all unbondingEntry
+func (k Keeper) GetAllUnbondingEntry(ctx context.Context) (list []types.UnbondingEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.UnbondingEntryKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.UnbondingEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetProviderUnbondingEntries returns the pending unbonding entries of a provider
+func (k Keeper) GetProviderUnbondingEntries(ctx context.Context, provider string) (list []types.UnbondingEntry) {
+	for _, id := range k.getIndexedIds(ctx, types.UnbondingByProviderKey, UnbondingProviderPrefix(provider)) {
+		if entry, found := k.GetUnbondingEntry(ctx, id); found {
+			list = append(list, entry)
+		}
+	}
+	return
+}
+
+// GetMatureUnbondingEntries returns the unbonding entries that complete at or before height
+func (k Keeper) GetMatureUnbondingEntries(ctx context.Context, height uint64) (list []types.UnbondingEntry) {
+	end := binary.BigEndian.AppendUint64(nil, height+1)
+	for _, id := range k.getIndexedIdsInRange(ctx, types.UnbondingQueueKey, nil, end) {
+		if entry, found := k.GetUnbondingEntry(ctx, id); found {
+			list = append(list, entry)
+		}
+	}
+	return
+}
+
+// GetUnbondingEntryIDBytes returns the byte representation of the ID
+func GetUnbondingEntryIDBytes(id uint64) []byte {
+	bz := types.KeyPrefix(types.UnbondingEntryKey)
+	bz = append(bz, []byte("/")...)
+	bz = binary.BigEndian.AppendUint64(bz, id)
+	return bz
+}
+
+// UnbondingProviderPrefix returns the provider index prefix of a provider's entries
+func UnbondingProviderPrefix(provider string) []byte {
+	return append([]byte(provider), '/')
+}
+
+func unbondingQueueKey(completionHeight, id uint64) []byte {
+	bz := binary.BigEndian.AppendUint64(nil, completionHeight)
+	return binary.BigEndian.AppendUint64(bz, id)
+}
+
+func unbondingProviderKey(provider string, id uint64) []byte {
+	return binary.BigEndian.AppendUint64(UnbondingProviderPrefix(provider), id)
+}
+
+// GetRequiredCollateral returns the stake a provider must keep bonded in denom:
+// CollateralRatio times the remaining value of its ACTIVE contracts, priced at
+// the contracts' own prices. Exposure in other payment denoms is converted into
+// denom at the ratio of their base prices; it fails when that is not possible.
+func (k Keeper) GetRequiredCollateral(ctx context.Context, provider string, denom string) (math.Int, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentHeight := uint64(sdkCtx.BlockHeight())
+	params := k.GetParams(ctx)
+
+	exposure := math.ZeroInt()
+	for _, contract := range k.GetHostingContractsByProvider(ctx, provider) {
+		if contract.Status != types.CONTRACT_STATUS_ACTIVE {
+			continue
+		}
+		value, err := convertPaymentValue(params, getRemainingContractValue(contract, currentHeight), contract.PricePerBlock.Denom, denom)
+		if err != nil {
+			return math.ZeroInt(), errorsmod.Wrapf(err, "contract %d", contract.Id)
+		}
+		exposure = exposure.Add(value)
+	}
+
+	return math.LegacyNewDecFromInt(exposure).Mul(params.CollateralRatio).Ceil().TruncateInt(), nil
+}
+
+// convertPaymentValue expresses an amount of valueDenom in denom. Amounts in
+// another payment denom are converted at the ratio of the two denoms' base
+// storage prices, rounded up.
+func convertPaymentValue(params types.Params, amount math.Int, valueDenom string, denom string) (math.Int, error) {
+	if amount.IsZero() || valueDenom == denom {
+		return amount, nil
+	}
+	from, found := params.PaymentDenom(valueDenom)
+	if !found || !from.BasePricePerBytePerBlock.IsPositive() {
+		return math.ZeroInt(), errorsmod.Wrapf(types.ErrDenomNotAccepted, "cannot convert %s into %s", valueDenom, denom)
+	}
+	to, found := params.PaymentDenom(denom)
+	if !found || !to.BasePricePerBytePerBlock.IsPositive() {
+		return math.ZeroInt(), errorsmod.Wrapf(types.ErrDenomNotAccepted, "cannot convert %s into %s", valueDenom, denom)
+	}
+	return math.LegacyNewDecFromInt(amount).Mul(to.BasePricePerBytePerBlock).Quo(from.BasePricePerBytePerBlock).Ceil().TruncateInt(), nil
+}
+
+// BeginProviderUnbonding removes amount from a provider's bonded stake and queues
+// it for return after the unbonding period. The funds stay in the bonded pool,
+// and slashable, until the entry matures.
+func (k Keeper) BeginProviderUnbonding(ctx context.Context, provider sdk.AccAddress, amount sdk.Coin) (types.UnbondingEntry, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentHeight := uint64(sdkCtx.BlockHeight())
+	params := k.GetParams(ctx)
+
+	// Get current stake
+	stake, found := k.GetProviderStake(ctx, provider.String())
+	if !found {
+		return types.UnbondingEntry{}, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "provider has no stake")
+	}
+
+	if stake.Amount.Denom != amount.Denom {
+		return types.UnbondingEntry{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("cannot unstake %s, stake is denominated in %s", amount.String(), stake.Amount.Denom))
+	}
+
+	// Validate unstake amount
+	if amount.Amount.GT(stake.Amount.Amount) {
+		return types.UnbondingEntry{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("cannot unstake %s, only %s staked", amount.String(), stake.Amount.String()))
+	}
+
+	// Calculate remaining stake after unstaking
+	remainingStake := stake.Amount.Sub(amount)
+
+	// Check if remaining stake meets minimum requirement (unless unstaking everything)
+	if !remainingStake.IsZero() {
+		minStakeAmount := sdk.NewCoin(amount.Denom, params.MinProviderStake)
+		if remainingStake.IsLT(minStakeAmount) {
+			return types.UnbondingEntry{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest,
+				fmt.Sprintf("remaining stake %s would be below minimum required %s",
+					remainingStake.String(), minStakeAmount.String()))
+		}
+	}
+
+	// Active contracts must stay collateralized
+	required, err := k.GetRequiredCollateral(ctx, provider.String(), amount.Denom)
+	if err != nil {
+		return types.UnbondingEntry{}, errorsmod.Wrap(types.ErrInsufficientCollateral, err.Error())
+	}
+	if remainingStake.Amount.LT(required) {
+		return types.UnbondingEntry{}, errorsmod.Wrapf(types.ErrInsufficientCollateral,
+			"remaining stake %s is below %s%s required by active contracts", remainingStake.String(), required.String(), amount.Denom)
+	}
+
+	// Update or remove stake record
+	if remainingStake.IsZero() {
+		k.RemoveProviderStake(ctx, provider.String())
+	} else {
+		err := k.UpdateProviderStake(ctx, provider.String(), remainingStake)
+		if err != nil {
+			return types.UnbondingEntry{}, errorsmod.Wrap(err, "failed to update stake record")
+		}
+	}
+
+	entry := types.UnbondingEntry{
+		Provider:         provider.String(),
+		Amount:           amount,
+		CreationHeight:   currentHeight,
+		CompletionHeight: currentHeight + params.UnbondingPeriod,
+	}
+	entry.Id = k.AppendUnbondingEntry(ctx, entry)
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"providerUnbondingStarted",
+			sdk.NewAttribute("UnbondingId", strconv.FormatUint(entry.Id, 10)),
+			sdk.NewAttribute("Provider", entry.Provider),
+			sdk.NewAttribute("Amount", entry.Amount.String()),
+			sdk.NewAttribute("CompletionHeight", strconv.FormatUint(entry.CompletionHeight, 10)),
+		),
+	)
+
+	k.Logger().Info("provider unbonding started",
+		"provider", entry.Provider,
+		"unbonding_amount", entry.Amount.String(),
+		"remaining_stake", remainingStake.String(),
+		"completion_height", entry.CompletionHeight,
+	)
+
+	return entry, nil
+}
+
+// CompleteMatureUnbondings returns the funds of all unbonding entries that have
+// reached their completion height. This should be called in EndBlock.
+func (k Keeper) CompleteMatureUnbondings(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentHeight := uint64(sdkCtx.BlockHeight())
+
+	for _, entry := range k.GetMatureUnbondingEntries(ctx, currentHeight) {
+		providerAddr, err := sdk.AccAddressFromBech32(entry.Provider)
+		if err != nil {
+			k.Logger().Error("invalid provider address in unbonding entry",
+				"unbonding_id", entry.Id,
+				"provider", entry.Provider,
+				"error", err,
+			)
+			continue
+		}
+
+		if entry.Amount.IsPositive() {
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, "hosting_bonded_pool", providerAddr, sdk.NewCoins(entry.Amount))
+			if err != nil {
+				// Keep the entry so the transfer is retried next block
+				k.Logger().Error("failed to return unbonded funds",
+					"unbonding_id", entry.Id,
+					"provider", entry.Provider,
+					"amount", entry.Amount.String(),
+					"error", err,
+				)
+				continue
+			}
+		}
+
+		k.RemoveUnbondingEntry(ctx, entry)
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"providerUnbondingCompleted",
+				sdk.NewAttribute("UnbondingId", strconv.FormatUint(entry.Id, 10)),
+				sdk.NewAttribute("Provider", entry.Provider),
+				sdk.NewAttribute("Amount", entry.Amount.String()),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,288 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/hanshq/filespace-chain/testutil/keeper"
+	"github.com/hanshq/filespace-chain/testutil/sample"
+	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+const bondedPool = "hosting_bonded_pool"
+
+// setupStakedProvider bonds 5,000,000 token for a new provider and funds the
+// bonded pool to match
+func setupStakedProvider(t *testing.T) (keeper.Keeper, types.MsgServer, sdk.Context, *keepertest.MockBankKeeper, string) {
+	bank := keepertest.NewMockBankKeeper()
+	k, ctx := keepertest.FilespacechainKeeperWithBank(t, bank)
+	ctx = ctx.WithBlockHeight(100)
+
+	provider := sample.AccAddress()
+	stake := sdk.NewCoin("token", math.NewInt(5000000))
+	k.SetProviderStake(ctx, provider, stake, 1)
+	bank.FundModule(bondedPool, sdk.NewCoins(stake))
+
+	return k, keeper.NewMsgServerImpl(k), ctx, bank, provider
+}
+
+func TestUnstakeQueuesUnbonding(t *testing.T) {
+	k, srv, ctx, bank, provider := setupStakedProvider(t)
+	params := k.GetParams(ctx)
+
+	res, err := srv.UnstakeFromHosting(ctx, &types.MsgUnstakeFromHosting{
+		Creator: provider,
+		Amount:  sdk.NewCoin("token", math.NewInt(2000000)),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(100)+params.UnbondingPeriod, res.CompletionHeight)
+
+	stake, found := k.GetProviderStake(ctx, provider)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(3000000), stake.Amount.Amount)
+
+	// Funds stay in the bonded pool until the entry matures
+	require.Equal(t, math.NewInt(5000000), bank.ModuleBalance(bondedPool).AmountOf("token"))
+	providerAddr := sdk.MustAccAddressFromBech32(provider)
+	require.True(t, bank.SpendableCoins(ctx, providerAddr).IsZero())
+
+	entries := k.GetProviderUnbondingEntries(ctx, provider)
+	require.Len(t, entries, 1)
+	require.Equal(t, uint64(100), entries[0].CreationHeight)
+	require.Equal(t, res.CompletionHeight, entries[0].CompletionHeight)
+
+	// Nothing is paid out one block early
+	early := ctx.WithBlockHeight(int64(res.CompletionHeight) - 1)
+	require.NoError(t, k.CompleteMatureUnbondings(early))
+	require.Len(t, k.GetProviderUnbondingEntries(early, provider), 1)
+
+	mature := ctx.WithBlockHeight(int64(res.CompletionHeight))
+	require.NoError(t, k.CompleteMatureUnbondings(mature))
+	require.Empty(t, k.GetProviderUnbondingEntries(mature, provider))
+	require.Empty(t, k.GetAllUnbondingEntry(mature))
+	require.Equal(t, math.NewInt(2000000), bank.SpendableCoins(mature, providerAddr).AmountOf("token"))
+	require.Equal(t, math.NewInt(3000000), bank.ModuleBalance(bondedPool).AmountOf("token"))
+}
+
+func TestUnstakeAllRemovesStake(t *testing.T) {
+	k, srv, ctx, _, provider := setupStakedProvider(t)
+
+	_, err := srv.UnstakeFromHosting(ctx, &types.MsgUnstakeFromHosting{
+		Creator: provider,
+		Amount:  sdk.NewCoin("token", math.NewInt(5000000)),
+	})
+	require.NoError(t, err)
+
+	_, found := k.GetProviderStake(ctx, provider)
+	require.False(t, found)
+	require.Len(t, k.GetProviderUnbondingEntries(ctx, provider), 1)
+
+	// Unbonding more than the bonded stake is rejected
+	_, err = srv.UnstakeFromHosting(ctx, &types.MsgUnstakeFromHosting{
+		Creator: provider,
+		Amount:  sdk.NewCoin("token", math.NewInt(1)),
+	})
+	require.Error(t, err)
+}
+
+func TestSlashReachesUnbondingAfterInfraction(t *testing.T) {
+	k, srv, ctx, _, provider := setupStakedProvider(t)
+	providerAddr := sdk.MustAccAddressFromBech32(provider)
+	fraction := math.LegacyNewDecWithPrec(1, 1)
+
+	// Unbonded before the infraction: out of reach
+	_, err := srv.UnstakeFromHosting(ctx, &types.MsgUnstakeFromHosting{
+		Creator: provider,
+		Amount:  sdk.NewCoin("token", math.NewInt(1000000)),
+	})
+	require.NoError(t, err)
+
+	// Unbonded after the infraction, trying to escape the penalty
+	after := ctx.WithBlockHeight(120)
+	_, err = srv.UnstakeFromHosting(after, &types.MsgUnstakeFromHosting{
+		Creator: provider,
+		Amount:  sdk.NewCoin("token", math.NewInt(2000000)),
+	})
+	require.NoError(t, err)
+
+	slashed, err := k.SlashProviderForInfraction(after.WithBlockHeight(130), providerAddr, fraction, 110, 0, "test")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("token", math.NewInt(200000+200000))), slashed)
+
+	stake, found := k.GetProviderStake(after, provider)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(1800000), stake.Amount.Amount)
+
+	amounts := map[uint64]math.Int{}
+	for _, entry := range k.GetProviderUnbondingEntries(after, provider) {
+		amounts[entry.CreationHeight] = entry.Amount.Amount
+	}
+	require.Equal(t, math.NewInt(1000000), amounts[100])
+	require.Equal(t, math.NewInt(1800000), amounts[120])
+}
+
+func TestSlashUnbondingWithoutStake(t *testing.T) {
+	k, srv, ctx, _, provider := setupStakedProvider(t)
+	providerAddr := sdk.MustAccAddressFromBech32(provider)
+
+	_, err := srv.UnstakeFromHosting(ctx, &types.MsgUnstakeFromHosting{
+		Creator: provider,
+		Amount:  sdk.NewCoin("token", math.NewInt(5000000)),
+	})
+	require.NoError(t, err)
+
+	// An infraction before the unbonding still reaches the unbonding funds
+	slashed, err := k.SlashProviderForInfraction(ctx, providerAddr, math.LegacyNewDecWithPrec(1, 1), 90, 0, "test")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("token", math.NewInt(500000))), slashed)
+
+	// Nothing left to slash for an infraction after the unbonding
+	_, err = k.SlashProviderForInfraction(ctx, providerAddr, math.LegacyNewDecWithPrec(1, 1), 101, 0, "test")
+	require.Error(t, err)
+}
+
+func TestSlashGroupsUnbondingDenoms(t *testing.T) {
+	k, _, ctx, bank, provider := setupStakedProvider(t)
+	providerAddr := sdk.MustAccAddressFromBech32(provider)
+
+	// An entry left in another denom is slashed in that denom
+	unbonding := sdk.NewCoin("other", math.NewInt(1000))
+	k.AppendUnbondingEntry(ctx, types.UnbondingEntry{
+		Provider:         provider,
+		Amount:           unbonding,
+		CreationHeight:   100,
+		CompletionHeight: 200,
+	})
+	bank.FundModule(bondedPool, sdk.NewCoins(unbonding))
+
+	slashed, err := k.SlashProviderForInfraction(ctx, providerAddr, math.LegacyNewDecWithPrec(1, 1), 90, 0, "test")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("token", math.NewInt(500000)), sdk.NewCoin("other", math.NewInt(100))), slashed)
+
+	entries := k.GetProviderUnbondingEntries(ctx, provider)
+	require.Len(t, entries, 1)
+	require.Equal(t, sdk.NewCoin("other", math.NewInt(900)), entries[0].Amount)
+
+	// Both denoms leave the bonded pool
+	require.Equal(t, math.NewInt(4500000), bank.ModuleBalance(bondedPool).AmountOf("token"))
+	require.Equal(t, math.NewInt(900), bank.ModuleBalance(bondedPool).AmountOf("other"))
+}
+
+func TestUnstakeRequiresCollateral(t *testing.T) {
+	k, srv, ctx, _, provider := setupStakedProvider(t)
+
+	// 10,000 token per block for 1,000 more blocks at a 10% ratio keeps
+	// 1,000,000 token bonded
+	offerId := k.AppendHostingOffer(ctx, types.HostingOffer{
+		Creator:       provider,
+		PricePerBlock: sdk.NewCoin("token", math.NewInt(10000)),
+	})
+	contract := types.HostingContract{
+		Creator:       provider,
+		OfferId:       offerId,
+		StartBlock:    1,
+		EndBlock:      1100,
+		Status:        types.CONTRACT_STATUS_ACTIVE,
+		PricePerBlock: sdk.NewCoin("token", math.NewInt(10000)),
+	}
+	contract.Id = k.AppendHostingContract(ctx, contract)
+	required, err := k.GetRequiredCollateral(ctx, provider, "token")
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1000000), required)
+
+	// Repricing the offer does not lower the exposure of running contracts
+	offer, _ := k.GetHostingOffer(ctx, offerId)
+	offer.PricePerBlock = sdk.NewCoin("token", math.OneInt())
+	k.SetHostingOffer(ctx, offer)
+	required, err = k.GetRequiredCollateral(ctx, provider, "token")
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1000000), required)
+
+	_, err = srv.UnstakeFromHosting(ctx, &types.MsgUnstakeFromHosting{
+		Creator: provider,
+		Amount:  sdk.NewCoin("token", math.NewInt(5000000)),
+	})
+	require.ErrorIs(t, err, types.ErrInsufficientCollateral)
+
+	_, err = srv.UnstakeFromHosting(ctx, &types.MsgUnstakeFromHosting{
+		Creator: provider,
+		Amount:  sdk.NewCoin("token", math.NewInt(4000000)),
+	})
+	require.NoError(t, err)
+
+	// Completed contracts no longer need collateral
+	contract.Status = types.CONTRACT_STATUS_COMPLETED
+	k.SetHostingContract(ctx, contract)
+	required, err = k.GetRequiredCollateral(ctx, provider, "token")
+	require.NoError(t, err)
+	require.True(t, required.IsZero())
+}
+
+func TestRequiredCollateralAcrossDenoms(t *testing.T) {
+	k, srv, ctx, _, provider := setupStakedProvider(t)
+
+	// "credit" costs twice as many units per byte as "token"
+	params := k.GetParams(ctx)
+	params.AcceptedDenoms = append(params.AcceptedDenoms, types.PaymentDenom{
+		Denom:                    "credit",
+		BasePricePerBytePerBlock: math.LegacyNewDecWithPrec(2, 12),
+	})
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// 20,000 credit per block for 1,000 more blocks is worth 10,000,000 token,
+	// so a 10% ratio keeps 1,000,000 token bonded
+	k.AppendHostingContract(ctx, types.HostingContract{
+		Creator:       provider,
+		StartBlock:    1,
+		EndBlock:      1100,
+		Status:        types.CONTRACT_STATUS_ACTIVE,
+		PricePerBlock: sdk.NewCoin("credit", math.NewInt(20000)),
+	})
+	required, err := k.GetRequiredCollateral(ctx, provider, "token")
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1000000), required)
+
+	_, err = srv.UnstakeFromHosting(ctx, &types.MsgUnstakeFromHosting{
+		Creator: provider,
+		Amount:  sdk.NewCoin("token", math.NewInt(5000000)),
+	})
+	require.ErrorIs(t, err, types.ErrInsufficientCollateral)
+
+	// Exposure that cannot be priced in the stake denom blocks unbonding
+	params.AcceptedDenoms = params.AcceptedDenoms[:1]
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = k.GetRequiredCollateral(ctx, provider, "token")
+	require.ErrorIs(t, err, types.ErrDenomNotAccepted)
+
+	_, err = srv.UnstakeFromHosting(ctx, &types.MsgUnstakeFromHosting{
+		Creator: provider,
+		Amount:  sdk.NewCoin("token", math.NewInt(1000)),
+	})
+	require.ErrorIs(t, err, types.ErrInsufficientCollateral)
+}
+
+func TestProviderUnbondingsQuery(t *testing.T) {
+	k, srv, ctx, _, provider := setupStakedProvider(t)
+	for i := 0; i < 3; i++ {
+		_, err := srv.UnstakeFromHosting(ctx, &types.MsgUnstakeFromHosting{
+			Creator: provider,
+			Amount:  sdk.NewCoin("token", math.NewInt(1000000)),
+		})
+		require.NoError(t, err)
+	}
+
+	res, err := k.ProviderUnbondings(ctx, &types.QueryProviderUnbondingsRequest{Provider: provider})
+	require.NoError(t, err)
+	require.Len(t, res.UnbondingEntries, 3)
+
+	res, err = k.ProviderUnbondings(ctx, &types.QueryProviderUnbondingsRequest{Provider: sample.AccAddress()})
+	require.NoError(t, err)
+	require.Empty(t, res.UnbondingEntries)
+
+	_, err = k.ProviderUnbondings(ctx, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,179 @@
+// Package merkle implements the binary Merkle tree used to commit to the
+// chunks of a file entry and to verify storage proofs against that commitment.
+//
+// Leaves and inner nodes are domain separated (RFC 6962 style) so that a leaf
+// can never be passed off as an inner node. When a level has an odd number of
+// nodes, the last node is promoted to the next level unchanged.
+package merkle
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	leafPrefix = byte(0x00)
+	nodePrefix = byte(0x01)
+)
+
+const (
+	// HashSize is the size in bytes of every node hash, including the root.
+	HashSize = sha256.Size
+	// DefaultChunkSize is the chunk size used by the upload tooling.
+	DefaultChunkSize = 4096
+	// MaxChunkSize bounds the chunk size so a single proof stays small enough
+	// to fit in a transaction.
+	MaxChunkSize = 65536
+)
+
+// ChunkCount returns the number of chunks a file of fileSize bytes is split
+// into with the given chunk size. The last chunk may be shorter.
+func ChunkCount(fileSize, chunkSize uint64) uint64 {
+	if chunkSize == 0 {
+		return 0
+	}
+	return (fileSize + chunkSize - 1) / chunkSize
+}
+
+// LeafHash returns the hash of a single chunk.
+func LeafHash(chunk []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{leafPrefix})
+	h.Write(chunk)
+	return h.Sum(nil)
+}
+
+// NodeHash returns the hash of an inner node from its two children.
+func NodeHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{nodePrefix})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// Tree is a Merkle tree over a list of chunks. levels[0] holds the leaf
+// hashes and the last level holds the root.
+type Tree struct {
+	levels [][][]byte
+}
+
+// NewTree builds a tree from the given chunks.
+func NewTree(chunks [][]byte) (*Tree, error) {
+	if len(chunks) == 0 {
+		return nil, fmt.Errorf("cannot build merkle tree without chunks")
+	}
+
+	leaves := make([][]byte, len(chunks))
+	for i, chunk := range chunks {
+		leaves[i] = LeafHash(chunk)
+	}
+	return newTreeFromLeaves(leaves), nil
+}
+
+// BuildTree reads r to the end, splits it into chunks of chunkSize bytes and
+// builds a tree over them without keeping the chunk data in memory.
+func BuildTree(r io.Reader, chunkSize int) (*Tree, error) {
+	if chunkSize <= 0 || chunkSize > MaxChunkSize {
+		return nil, fmt.Errorf("chunk size must be between 1 and %d, got %d", MaxChunkSize, chunkSize)
+	}
+
+	var leaves [][]byte
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			leaves = append(leaves, LeafHash(buf[:n]))
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read chunk %d: %w", len(leaves), err)
+		}
+	}
+
+	if len(leaves) == 0 {
+		return nil, fmt.Errorf("cannot build merkle tree without chunks")
+	}
+	return newTreeFromLeaves(leaves), nil
+}
+
+func newTreeFromLeaves(leaves [][]byte) *Tree {
+	levels := [][][]byte{leaves}
+	for current := leaves; len(current) > 1; {
+		next := make([][]byte, 0, (len(current)+1)/2)
+		for i := 0; i < len(current); i += 2 {
+			if i+1 < len(current) {
+				next = append(next, NodeHash(current[i], current[i+1]))
+			} else {
+				next = append(next, current[i])
+			}
+		}
+		levels = append(levels, next)
+		current = next
+	}
+	return &Tree{levels: levels}
+}
+
+// Root returns the Merkle root of the tree.
+func (t *Tree) Root() []byte {
+	return t.levels[len(t.levels)-1][0]
+}
+
+// LeafCount returns the number of chunks committed to by the tree.
+func (t *Tree) LeafCount() uint64 {
+	return uint64(len(t.levels[0]))
+}
+
+// Proof returns the sibling hashes needed to prove inclusion of the leaf at
+// index, ordered from the leaf level up to the root.
+func (t *Tree) Proof(index uint64) ([][]byte, error) {
+	if index >= t.LeafCount() {
+		return nil, fmt.Errorf("leaf index %d out of range, tree has %d leaves", index, t.LeafCount())
+	}
+
+	var siblings [][]byte
+	i := index
+	for _, level := range t.levels[:len(t.levels)-1] {
+		sibling := i ^ 1
+		if sibling < uint64(len(level)) {
+			siblings = append(siblings, level[sibling])
+		}
+		i /= 2
+	}
+	return siblings, nil
+}
+
+// VerifyProof checks that chunk is the leaf at index in a tree of leafCount
+// leaves with the given root.
+func VerifyProof(root []byte, chunk []byte, index uint64, leafCount uint64, siblings [][]byte) bool {
+	if index >= leafCount {
+		return false
+	}
+
+	hash := LeafHash(chunk)
+	used := 0
+	for i, n := index, leafCount; n > 1; i, n = i/2, (n+1)/2 {
+		switch {
+		case i%2 == 1:
+			if used >= len(siblings) {
+				return false
+			}
+			hash = NodeHash(siblings[used], hash)
+			used++
+		case i+1 < n:
+			if used >= len(siblings) {
+				return false
+			}
+			hash = NodeHash(hash, siblings[used])
+			used++
+		}
+		// Otherwise the node was promoted without a sibling.
+	}
+
+	return used == len(siblings) && bytes.Equal(hash, root)
+}
@@ -0,0 +1,112 @@
+package merkle_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/merkle"
+)
+
+func testChunks(n int) [][]byte {
+	chunks := make([][]byte, n)
+	for i := range chunks {
+		chunks[i] = []byte(fmt.Sprintf("chunk-%d", i))
+	}
+	return chunks
+}
+
+func TestSingleChunkRootIsLeafHash(t *testing.T) {
+	tree, err := merkle.NewTree(testChunks(1))
+	require.NoError(t, err)
+	require.Equal(t, merkle.LeafHash([]byte("chunk-0")), tree.Root())
+
+	proof, err := tree.Proof(0)
+	require.NoError(t, err)
+	require.Empty(t, proof)
+	require.True(t, merkle.VerifyProof(tree.Root(), []byte("chunk-0"), 0, 1, proof))
+}
+
+func TestProofRoundTrip(t *testing.T) {
+	for _, n := range []int{2, 3, 4, 5, 7, 8, 13, 64} {
+		chunks := testChunks(n)
+		tree, err := merkle.NewTree(chunks)
+		require.NoError(t, err)
+		require.Equal(t, uint64(n), tree.LeafCount())
+
+		for i, chunk := range chunks {
+			proof, err := tree.Proof(uint64(i))
+			require.NoError(t, err)
+			require.True(t, merkle.VerifyProof(tree.Root(), chunk, uint64(i), uint64(n), proof),
+				"leaf %d of %d should verify", i, n)
+		}
+	}
+}
+
+func TestVerifyProofRejectsTampering(t *testing.T) {
+	chunks := testChunks(5)
+	tree, err := merkle.NewTree(chunks)
+	require.NoError(t, err)
+
+	proof, err := tree.Proof(2)
+	require.NoError(t, err)
+
+	// Wrong chunk data
+	require.False(t, merkle.VerifyProof(tree.Root(), []byte("forged"), 2, 5, proof))
+	// Right chunk at the wrong index
+	require.False(t, merkle.VerifyProof(tree.Root(), chunks[2], 3, 5, proof))
+	// Index out of range
+	require.False(t, merkle.VerifyProof(tree.Root(), chunks[2], 5, 5, proof))
+	// Truncated and padded proofs
+	require.False(t, merkle.VerifyProof(tree.Root(), chunks[2], 2, 5, proof[:len(proof)-1]))
+	require.False(t, merkle.VerifyProof(tree.Root(), chunks[2], 2, 5, append(proof, tree.Root())))
+	// Inner node presented as a leaf
+	require.False(t, merkle.VerifyProof(tree.Root(), tree.Root(), 0, 1, nil))
+}
+
+func TestNewTreeWithoutChunks(t *testing.T) {
+	_, err := merkle.NewTree(nil)
+	require.Error(t, err)
+}
+
+func TestBuildTreeMatchesNewTree(t *testing.T) {
+	data := bytes.Repeat([]byte("filespace"), 1000) // 9000 bytes
+	chunkSize := 4096
+
+	tree, err := merkle.BuildTree(bytes.NewReader(data), chunkSize)
+	require.NoError(t, err)
+	require.Equal(t, merkle.ChunkCount(uint64(len(data)), uint64(chunkSize)), tree.LeafCount())
+	require.Equal(t, uint64(3), tree.LeafCount())
+
+	chunks := [][]byte{data[:4096], data[4096:8192], data[8192:]}
+	expected, err := merkle.NewTree(chunks)
+	require.NoError(t, err)
+	require.Equal(t, expected.Root(), tree.Root())
+	require.Len(t, tree.Root(), merkle.HashSize)
+
+	// The short trailing chunk proves like any other
+	proof, err := tree.Proof(2)
+	require.NoError(t, err)
+	require.True(t, merkle.VerifyProof(tree.Root(), chunks[2], 2, 3, proof))
+}
+
+func TestBuildTreeInvalidInput(t *testing.T) {
+	_, err := merkle.BuildTree(bytes.NewReader(nil), merkle.DefaultChunkSize)
+	require.Error(t, err)
+
+	_, err = merkle.BuildTree(bytes.NewReader([]byte("data")), 0)
+	require.Error(t, err)
+
+	_, err = merkle.BuildTree(bytes.NewReader([]byte("data")), merkle.MaxChunkSize+1)
+	require.Error(t, err)
+}
+
+func TestChunkCount(t *testing.T) {
+	require.Equal(t, uint64(0), merkle.ChunkCount(0, 4096))
+	require.Equal(t, uint64(1), merkle.ChunkCount(1, 4096))
+	require.Equal(t, uint64(1), merkle.ChunkCount(4096, 4096))
+	require.Equal(t, uint64(2), merkle.ChunkCount(4097, 4096))
+	require.Equal(t, uint64(0), merkle.ChunkCount(4096, 0))
+}
@@ -0,0 +1,107 @@
+// Package wire reads and rewrites single fields of protobuf encoded records.
+// Store migrations use it to change records by field number, as laid out at
+// the version they migrate from, without decoding them into the current types.
+package wire
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Varint returns the last value of varint field num in an encoded message
+func Varint(bz []byte, num protowire.Number) (value uint64, found bool, err error) {
+	err = walk(bz, func(n protowire.Number, typ protowire.Type, field []byte) error {
+		if n != num || typ != protowire.VarintType {
+			return nil
+		}
+		v, m := protowire.ConsumeVarint(field)
+		if m < 0 {
+			return protowire.ParseError(m)
+		}
+		value, found = v, true
+		return nil
+	})
+	return value, found, err
+}
+
+// Bytes returns the last value of length-delimited field num in an encoded
+// message; strings are read the same way
+func Bytes(bz []byte, num protowire.Number) (value []byte, found bool, err error) {
+	err = walk(bz, func(n protowire.Number, typ protowire.Type, field []byte) error {
+		if n != num || typ != protowire.BytesType {
+			return nil
+		}
+		v, m := protowire.ConsumeBytes(field)
+		if m < 0 {
+			return protowire.ParseError(m)
+		}
+		value, found = v, true
+		return nil
+	})
+	return value, found, err
+}
+
+// SetVarint replaces every occurrence of field num in an encoded message with
+// a single varint value
+func SetVarint(bz []byte, num protowire.Number, value uint64) ([]byte, error) {
+	out, err := without(bz, num)
+	if err != nil {
+		return nil, err
+	}
+	out = protowire.AppendTag(out, num, protowire.VarintType)
+	return protowire.AppendVarint(out, value), nil
+}
+
+// SetBytes replaces every occurrence of field num in an encoded message with
+// a single length-delimited value
+func SetBytes(bz []byte, num protowire.Number, value []byte) ([]byte, error) {
+	out, err := without(bz, num)
+	if err != nil {
+		return nil, err
+	}
+	out = protowire.AppendTag(out, num, protowire.BytesType)
+	return protowire.AppendBytes(out, value), nil
+}
+
+// Clear removes every occurrence of field num from an encoded message
+func Clear(bz []byte, num protowire.Number) ([]byte, error) {
+	return without(bz, num)
+}
+
+// without returns the encoded message with field num left out
+func without(bz []byte, num protowire.Number) ([]byte, error) {
+	out := make([]byte, 0, len(bz))
+	for len(bz) > 0 {
+		n, typ, tagLen := protowire.ConsumeTag(bz)
+		if tagLen < 0 {
+			return nil, protowire.ParseError(tagLen)
+		}
+		valueLen := protowire.ConsumeFieldValue(n, typ, bz[tagLen:])
+		if valueLen < 0 {
+			return nil, protowire.ParseError(valueLen)
+		}
+		if n != num {
+			out = append(out, bz[:tagLen+valueLen]...)
+		}
+		bz = bz[tagLen+valueLen:]
+	}
+	return out, nil
+}
+
+// walk calls fn with the number, type and encoded value of every field
+func walk(bz []byte, fn func(num protowire.Number, typ protowire.Type, field []byte) error) error {
+	for len(bz) > 0 {
+		num, typ, tagLen := protowire.ConsumeTag(bz)
+		if tagLen < 0 {
+			return protowire.ParseError(tagLen)
+		}
+		valueLen := protowire.ConsumeFieldValue(num, typ, bz[tagLen:])
+		if valueLen < 0 {
+			return protowire.ParseError(valueLen)
+		}
+		if err := fn(num, typ, bz[tagLen:tagLen+valueLen]); err != nil {
+			return err
+		}
+		bz = bz[tagLen+valueLen:]
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package v10
+
+import (
+	"context"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/migrations/internal/wire"
+)
+
+// ParamsKey is the raw key params are stored under
+var ParamsKey = []byte("p_filespacechain")
+
+// Field numbers of the v10 params the migration writes
+const (
+	paramsProviderTerminationSlashFractionField protowire.Number = 17
+	paramsClientTerminationPenaltyFractionField protowire.Number = 18
+)
+
+// MigrateStore performs in-place store migrations from v9 to v10. It sets the
+// early termination penalties added in v10 to their defaults.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService) error {
+	store := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	bz := store.Get(ParamsKey)
+	if bz == nil {
+		return nil
+	}
+
+	fractions := []struct {
+		field    protowire.Number
+		fraction math.LegacyDec
+	}{
+		{paramsProviderTerminationSlashFractionField, math.LegacyNewDecWithPrec(1, 2)},
+		{paramsClientTerminationPenaltyFractionField, math.LegacyNewDecWithPrec(1, 1)},
+	}
+	for _, f := range fractions {
+		value, err := f.fraction.Marshal()
+		if err != nil {
+			return err
+		}
+		if bz, err = wire.SetBytes(bz, f.field, value); err != nil {
+			return fmt.Errorf("failed to encode params: %w", err)
+		}
+	}
+
+	store.Set(ParamsKey, bz)
+	return nil
+}
@@ -0,0 +1,49 @@
+package v10_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
+	v10 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v10"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		nil,
+		nil,
+		nil,
+	)
+
+	// v9 params have no early termination penalties
+	v9Params := types.DefaultParams()
+	v9Params.ProviderTerminationSlashFraction = math.LegacyDec{}
+	v9Params.ClientTerminationPenaltyFraction = math.LegacyDec{}
+	bz, err := cdc.Marshal(&v9Params)
+	require.NoError(t, err)
+	ctx.KVStore(storeKey).Set(v10.ParamsKey, bz)
+
+	require.NoError(t, v10.MigrateStore(ctx, runtime.NewKVStoreService(storeKey)))
+
+	params := k.GetParams(ctx)
+	require.Equal(t, types.DefaultParams(), params)
+	require.NoError(t, params.Validate())
+}
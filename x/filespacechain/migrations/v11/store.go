@@ -0,0 +1,121 @@
+package v11
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/migrations/internal/wire"
+)
+
+var (
+	// HostingContractKeyPrefix and PaymentHistoryKeyPrefix are the raw
+	// prefixes records are stored under in v10 and v11
+	HostingContractKeyPrefix = []byte("HostingContract/value/HostingContract/value//")
+	PaymentHistoryKeyPrefix  = []byte("PaymentHistory/value/")
+
+	// SettledPaymentHistoryQueueKeyPrefix queues payment histories for
+	// pruning. Queue keys are the big-endian height the history may be pruned
+	// after followed by the big-endian contract id; values are the contract id.
+	SettledPaymentHistoryQueueKeyPrefix = []byte("PaymentHistory/settledqueue/")
+)
+
+// Field numbers of the v11 records the migration reads
+const (
+	contractEndBlockField protowire.Number = 6
+	contractStatusField   protowire.Number = 8
+
+	paymentHistoryLastPaymentBlockField    protowire.Number = 3
+	paymentHistoryCompletionBonusPaidField protowire.Number = 4
+)
+
+// Final contract statuses that never pay a completion bonus
+const (
+	contractStatusTerminated = 3
+	contractStatusSlashed    = 4
+)
+
+// MigrateStore performs in-place store migrations from v10 to v11. Payment
+// histories of TERMINATED and SLASHED contracts are queued for pruning after
+// their last payment, but not before their contract's end block; until v11
+// only histories that were paid a completion bonus were pruned.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService) error {
+	store := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	endBlocks, err := readEndedContracts(store)
+	if err != nil {
+		return err
+	}
+
+	iterator := storetypes.KVStorePrefixIterator(prefix.NewStore(store, PaymentHistoryKeyPrefix), []byte{})
+	defer iterator.Close()
+
+	type queueEntry struct {
+		height uint64
+		id     []byte
+	}
+	var entries []queueEntry
+	for ; iterator.Valid(); iterator.Next() {
+		if len(iterator.Key()) != 8 {
+			continue
+		}
+		endBlock, ended := endBlocks[binary.BigEndian.Uint64(iterator.Key())]
+		if !ended {
+			continue
+		}
+		// Histories paid a completion bonus are queued already
+		settled, _, err := wire.Varint(iterator.Value(), paymentHistoryCompletionBonusPaidField)
+		if err != nil {
+			return fmt.Errorf("failed to decode payment history %x: %w", iterator.Key(), err)
+		}
+		if settled != 0 {
+			continue
+		}
+		lastPaymentBlock, _, err := wire.Varint(iterator.Value(), paymentHistoryLastPaymentBlockField)
+		if err != nil {
+			return fmt.Errorf("failed to decode payment history %x: %w", iterator.Key(), err)
+		}
+		entries = append(entries, queueEntry{max(lastPaymentBlock, endBlock), iterator.Key()})
+	}
+
+	// The queue is written once the histories have been read, so the store
+	// is not written while iterating
+	for _, entry := range entries {
+		key := binary.BigEndian.AppendUint64(append([]byte{}, SettledPaymentHistoryQueueKeyPrefix...), entry.height)
+		store.Set(append(key, entry.id...), entry.id)
+	}
+	return nil
+}
+
+// readEndedContracts returns the end blocks of TERMINATED and SLASHED
+// contracts by id
+func readEndedContracts(store storetypes.KVStore) (map[uint64]uint64, error) {
+	iterator := storetypes.KVStorePrefixIterator(prefix.NewStore(store, HostingContractKeyPrefix), []byte{})
+	defer iterator.Close()
+
+	endBlocks := make(map[uint64]uint64)
+	for ; iterator.Valid(); iterator.Next() {
+		if len(iterator.Key()) != 8 {
+			continue
+		}
+		status, _, err := wire.Varint(iterator.Value(), contractStatusField)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode hosting contract %x: %w", iterator.Key(), err)
+		}
+		if status != contractStatusTerminated && status != contractStatusSlashed {
+			continue
+		}
+		endBlock, _, err := wire.Varint(iterator.Value(), contractEndBlockField)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode hosting contract %x: %w", iterator.Key(), err)
+		}
+		endBlocks[binary.BigEndian.Uint64(iterator.Key())] = endBlock
+	}
+	return endBlocks, nil
+}
@@ -0,0 +1,85 @@
+package v11_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
+	v11 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v11"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func setup(t *testing.T) (sdk.Context, *storetypes.KVStoreKey, codec.BinaryCodec, keeper.Keeper) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		nil,
+		nil,
+		nil,
+	)
+	return ctx, storeKey, cdc, k
+}
+
+// setV10 stores a record under its raw key, without queue entries
+func setV10(ctx sdk.Context, storeKey *storetypes.KVStoreKey, cdc codec.BinaryCodec, prefix []byte, id uint64, record proto.Message) {
+	key := binary.BigEndian.AppendUint64(append([]byte{}, prefix...), id)
+	ctx.KVStore(storeKey).Set(key, cdc.MustMarshal(record))
+}
+
+func queued(ctx sdk.Context, storeKey *storetypes.KVStoreKey, height, id uint64) bool {
+	key := binary.BigEndian.AppendUint64(append([]byte{}, v11.SettledPaymentHistoryQueueKeyPrefix...), height)
+	return ctx.KVStore(storeKey).Has(binary.BigEndian.AppendUint64(key, id))
+}
+
+func TestMigrateStore(t *testing.T) {
+	ctx, storeKey, cdc, k := setup(t)
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+
+	setV10(ctx, storeKey, cdc, v11.HostingContractKeyPrefix, 0, &types.HostingContract{Id: 0, EndBlock: 50, Status: types.CONTRACT_STATUS_ACTIVE})
+	setV10(ctx, storeKey, cdc, v11.HostingContractKeyPrefix, 1, &types.HostingContract{Id: 1, EndBlock: 60, Status: types.CONTRACT_STATUS_TERMINATED})
+	setV10(ctx, storeKey, cdc, v11.HostingContractKeyPrefix, 2, &types.HostingContract{Id: 2, EndBlock: 70, Status: types.CONTRACT_STATUS_SLASHED})
+	setV10(ctx, storeKey, cdc, v11.PaymentHistoryKeyPrefix, 0, &types.PaymentHistory{ContractId: 0, LastPaymentBlock: 30})
+	setV10(ctx, storeKey, cdc, v11.PaymentHistoryKeyPrefix, 1, &types.PaymentHistory{ContractId: 1, LastPaymentBlock: 30})
+	setV10(ctx, storeKey, cdc, v11.PaymentHistoryKeyPrefix, 2, &types.PaymentHistory{ContractId: 2, LastPaymentBlock: 80})
+
+	require.NoError(t, v11.MigrateStore(ctx, runtime.NewKVStoreService(storeKey)))
+
+	// Histories are kept at least until their contract's end block
+	require.False(t, queued(ctx, storeKey, 30, 0))
+	require.False(t, queued(ctx, storeKey, 50, 0))
+	require.True(t, queued(ctx, storeKey, 60, 1))
+	require.True(t, queued(ctx, storeKey, 80, 2))
+
+	require.NoError(t, k.CleanupCompletedPaymentHistory(ctx.WithBlockHeight(170), 100))
+	_, found := k.GetPaymentHistory(ctx, 1)
+	require.False(t, found)
+	_, found = k.GetPaymentHistory(ctx, 2)
+	require.True(t, found)
+	_, found = k.GetPaymentHistory(ctx, 0)
+	require.True(t, found)
+}
+
+func TestMigrateStoreRejectsCorruptRecords(t *testing.T) {
+	ctx, storeKey, _, _ := setup(t)
+	key := binary.BigEndian.AppendUint64(append([]byte{}, v11.HostingContractKeyPrefix...), 0)
+	ctx.KVStore(storeKey).Set(key, []byte{0xff})
+
+	require.Error(t, v11.MigrateStore(ctx, runtime.NewKVStoreService(storeKey)))
+}
@@ -0,0 +1,42 @@
+package v12
+
+import (
+	"context"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/migrations/internal/wire"
+)
+
+// ParamsKey is the raw key params are stored under
+var ParamsKey = []byte("p_filespacechain")
+
+// Field number of the v12 params the migration writes
+const paramsBondDenomField protowire.Number = 19
+
+// LegacyBondDenom is the denom stakes are counted in from v12 on. It is the
+// denom storage was priced in up to v7, which providers staked in.
+const LegacyBondDenom = "token"
+
+// MigrateStore performs in-place store migrations from v11 to v12. It sets the
+// bond denom added in v12; until v12 stakes in any denom counted towards the
+// minimum provider stake.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService) error {
+	store := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	bz := store.Get(ParamsKey)
+	if bz == nil {
+		return nil
+	}
+
+	bz, err := wire.SetBytes(bz, paramsBondDenomField, []byte(LegacyBondDenom))
+	if err != nil {
+		return fmt.Errorf("failed to encode params: %w", err)
+	}
+
+	store.Set(ParamsKey, bz)
+	return nil
+}
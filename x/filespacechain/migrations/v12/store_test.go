@@ -0,0 +1,47 @@
+package v12_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
+	v12 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v12"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		nil,
+		nil,
+		nil,
+	)
+
+	// v11 params have no bond denom
+	v11Params := types.DefaultParams()
+	v11Params.BondDenom = ""
+	bz, err := cdc.Marshal(&v11Params)
+	require.NoError(t, err)
+	ctx.KVStore(storeKey).Set(v12.ParamsKey, bz)
+
+	require.NoError(t, v12.MigrateStore(ctx, runtime.NewKVStoreService(storeKey)))
+
+	params := k.GetParams(ctx)
+	require.Equal(t, types.DefaultParams(), params)
+	require.NoError(t, params.Validate())
+}
@@ -0,0 +1,119 @@
+package v13
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/migrations/internal/wire"
+)
+
+var (
+	// ParamsKey is the raw key params are stored under
+	ParamsKey = []byte("p_filespacechain")
+
+	// HostingContractKeyPrefix and HostingOfferKeyPrefix are the raw prefixes
+	// records are stored under in v12 and v13, followed by the big-endian id
+	HostingContractKeyPrefix = []byte("HostingContract/value/HostingContract/value//")
+	HostingOfferKeyPrefix    = []byte("HostingOffer/value/HostingOffer/value//")
+)
+
+// Field numbers of the v13 records the migration reads and writes
+const (
+	paramsOfferMatchScanLimitField protowire.Number = 20
+
+	contractOfferIdField       protowire.Number = 3
+	contractPricePerBlockField protowire.Number = 12
+	offerPricePerBlockField    protowire.Number = 3
+	coinDenomField             protowire.Number = 1
+)
+
+// MigrateStore performs in-place store migrations from v12 to v13. It sets the
+// cap on the open inquiries an offer is matched against, added in v13, to its
+// default, and prices contracts opened before contracts kept their own price
+// at the price of their offer.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService) error {
+	store := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	if bz := store.Get(ParamsKey); bz != nil {
+		bz, err := wire.SetVarint(bz, paramsOfferMatchScanLimitField, 100)
+		if err != nil {
+			return fmt.Errorf("failed to encode params: %w", err)
+		}
+		store.Set(ParamsKey, bz)
+	}
+
+	return backfillContractPrices(store)
+}
+
+// backfillContractPrices copies the offer price onto contracts without a
+// price. Contracts whose offer is gone keep no price.
+func backfillContractPrices(store storetypes.KVStore) error {
+	contractStore := prefix.NewStore(store, HostingContractKeyPrefix)
+	offerStore := prefix.NewStore(store, HostingOfferKeyPrefix)
+
+	iterator := storetypes.KVStorePrefixIterator(contractStore, []byte{})
+	defer iterator.Close()
+
+	type update struct {
+		key, contract []byte
+	}
+	var updates []update
+	for ; iterator.Valid(); iterator.Next() {
+		if len(iterator.Key()) != 8 {
+			continue
+		}
+		priced, err := hasPrice(iterator.Value(), contractPricePerBlockField)
+		if err != nil {
+			return fmt.Errorf("failed to decode hosting contract %x: %w", iterator.Key(), err)
+		}
+		if priced {
+			continue
+		}
+
+		offerId, _, err := wire.Varint(iterator.Value(), contractOfferIdField)
+		if err != nil {
+			return fmt.Errorf("failed to decode hosting contract %x: %w", iterator.Key(), err)
+		}
+		offer := offerStore.Get(binary.BigEndian.AppendUint64(nil, offerId))
+		if offer == nil {
+			continue
+		}
+		price, found, err := wire.Bytes(offer, offerPricePerBlockField)
+		if err != nil {
+			return fmt.Errorf("failed to decode hosting offer %d: %w", offerId, err)
+		}
+		if !found {
+			continue
+		}
+
+		contract, err := wire.SetBytes(iterator.Value(), contractPricePerBlockField, price)
+		if err != nil {
+			return fmt.Errorf("failed to encode hosting contract %x: %w", iterator.Key(), err)
+		}
+		updates = append(updates, update{iterator.Key(), contract})
+	}
+
+	// The contracts are written once they have all been read, so the store is
+	// not written while iterating
+	for _, update := range updates {
+		contractStore.Set(update.key, update.contract)
+	}
+	return nil
+}
+
+// hasPrice reports whether the coin in a record's field has a denom
+func hasPrice(bz []byte, num protowire.Number) (bool, error) {
+	coin, found, err := wire.Bytes(bz, num)
+	if err != nil || !found {
+		return false, err
+	}
+	denom, _, err := wire.Bytes(coin, coinDenomField)
+	return len(denom) > 0, err
+}
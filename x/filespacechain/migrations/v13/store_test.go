@@ -0,0 +1,69 @@
+package v13_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
+	v13 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v13"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		nil,
+		nil,
+		nil,
+	)
+
+	// v12 params have no offer match scan limit
+	v12Params := types.DefaultParams()
+	v12Params.OfferMatchScanLimit = 0
+	bz, err := cdc.Marshal(&v12Params)
+	require.NoError(t, err)
+	ctx.KVStore(storeKey).Set(v13.ParamsKey, bz)
+
+	// Contracts opened before v12 have no price of their own
+	offerPrice := sdk.NewCoin("token", math.NewInt(3))
+	k.SetHostingOffer(ctx, types.HostingOffer{Id: 1, Creator: "provider", PricePerBlock: offerPrice})
+	k.SetHostingContract(ctx, types.HostingContract{Id: 1, OfferId: 1, Creator: "provider", EndBlock: 100})
+	k.SetHostingContract(ctx, types.HostingContract{Id: 2, OfferId: 2, Creator: "provider", EndBlock: 100})
+	auctioned := sdk.NewCoin("token", math.NewInt(2))
+	k.SetHostingContract(ctx, types.HostingContract{Id: 3, OfferId: 1, Creator: "provider", EndBlock: 100, PricePerBlock: auctioned})
+
+	require.NoError(t, v13.MigrateStore(ctx, runtime.NewKVStoreService(storeKey)))
+
+	params := k.GetParams(ctx)
+	require.Equal(t, types.DefaultParams(), params)
+	require.NoError(t, params.Validate())
+
+	// Unpriced contracts take their offer's price; contracts whose offer is
+	// gone stay unpriced and priced contracts keep their price
+	contract, found := k.GetHostingContract(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, offerPrice, contract.PricePerBlock)
+	require.Equal(t, uint64(100), contract.EndBlock)
+	contract, _ = k.GetHostingContract(ctx, 2)
+	require.Empty(t, contract.PricePerBlock.Denom)
+	contract, _ = k.GetHostingContract(ctx, 3)
+	require.Equal(t, auctioned, contract.PricePerBlock)
+	require.Len(t, k.GetHostingContractsByProvider(ctx, "provider"), 3)
+}
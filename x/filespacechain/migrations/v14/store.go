@@ -0,0 +1,87 @@
+package v14
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	stdmath "math"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/migrations/internal/wire"
+)
+
+var (
+	// HostingOfferKeyPrefix is the raw prefix offers are stored under in v13
+	// and v14, followed by the big-endian id
+	HostingOfferKeyPrefix = []byte("HostingOffer/value/HostingOffer/value//")
+
+	// HostingOfferByPriceKeyPrefix is the index of offers by price added in
+	// v14. Keys are the length-prefixed price denom, the big-endian price
+	// amount and the big-endian offer id; values are the offer id.
+	HostingOfferByPriceKeyPrefix = []byte("HostingOffer/price/")
+)
+
+// Field numbers of the v14 records the migration reads
+const (
+	offerPricePerBlockField protowire.Number = 3
+	coinDenomField          protowire.Number = 1
+	coinAmountField         protowire.Number = 2
+)
+
+// MigrateStore performs in-place store migrations from v13 to v14. Every
+// offer is indexed by its price.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService) error {
+	store := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	iterator := storetypes.KVStorePrefixIterator(prefix.NewStore(store, HostingOfferKeyPrefix), []byte{})
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if len(iterator.Key()) != 8 {
+			continue
+		}
+		key, err := priceIndexKey(iterator.Value())
+		if err != nil {
+			return fmt.Errorf("failed to decode hosting offer %x: %w", iterator.Key(), err)
+		}
+		keys = append(keys, append(key, iterator.Key()...))
+	}
+
+	// The index is written once the offers have been read, so the store is
+	// not written while iterating
+	for _, key := range keys {
+		store.Set(append(append([]byte{}, HostingOfferByPriceKeyPrefix...), key...), key[len(key)-8:])
+	}
+	return nil
+}
+
+// priceIndexKey returns the price index prefix of an offer. Amounts beyond a
+// uint64 sort last.
+func priceIndexKey(offer []byte) ([]byte, error) {
+	price, _, err := wire.Bytes(offer, offerPricePerBlockField)
+	if err != nil {
+		return nil, err
+	}
+	denom, _, err := wire.Bytes(price, coinDenomField)
+	if err != nil {
+		return nil, err
+	}
+	amountText, _, err := wire.Bytes(price, coinAmountField)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := uint64(stdmath.MaxUint64)
+	if parsed, ok := math.NewIntFromString(string(amountText)); ok && parsed.IsUint64() {
+		amount = parsed.Uint64()
+	}
+	key := append([]byte{byte(len(denom))}, denom...)
+	return binary.BigEndian.AppendUint64(key, amount), nil
+}
@@ -0,0 +1,72 @@
+package v14_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
+	v14 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v14"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		nil,
+		nil,
+		nil,
+	)
+	params := types.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, params))
+	k.SetProviderStake(ctx, "provider", sdk.NewCoin(params.BondDenom, params.MinProviderStake), 1)
+
+	// v13 offers are stored without a price index
+	offers := []types.HostingOffer{
+		{Id: 1, Creator: "provider", PricePerBlock: sdk.NewCoin("token", math.NewInt(3))},
+		{Id: 2, Creator: "provider", PricePerBlock: sdk.NewCoin("token", math.NewInt(1))},
+		{Id: 3, Creator: "provider", PricePerBlock: sdk.NewCoin("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", math.NewInt(1))},
+		{Id: 4, Creator: "provider", PricePerBlock: sdk.NewCoin("token", math.NewInt(9))},
+	}
+	for _, offer := range offers {
+		key := binary.BigEndian.AppendUint64(append([]byte{}, v14.HostingOfferKeyPrefix...), offer.Id)
+		ctx.KVStore(storeKey).Set(key, cdc.MustMarshal(&offer))
+	}
+	inquiry := types.HostingInquiry{ReplicationRate: 3, EscrowAmount: sdk.NewCoin("token", math.NewInt(1000)), MaxPricePerBlock: 5}
+	require.Empty(t, k.MatchHostingOffers(ctx, inquiry, 3).Rejections)
+
+	require.NoError(t, v14.MigrateStore(ctx, runtime.NewKVStoreService(storeKey)))
+
+	// Offers in the inquiry's denom within its maximum price are matched,
+	// cheapest first; one provider hosts one replica
+	result := k.MatchHostingOffers(ctx, inquiry, 3)
+	require.Equal(t, []uint64{2}, result.MatchedOfferIds())
+	require.Len(t, result.Rejections, 1)
+	require.Equal(t, uint64(1), result.Rejections[0].OfferId)
+}
+
+func TestMigrateStoreRejectsCorruptRecords(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	key := binary.BigEndian.AppendUint64(append([]byte{}, v14.HostingOfferKeyPrefix...), 0)
+	ctx.KVStore(storeKey).Set(key, []byte{0xff})
+
+	require.Error(t, v14.MigrateStore(ctx, runtime.NewKVStoreService(storeKey)))
+}
@@ -0,0 +1,298 @@
+package v2
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/migrations/internal/wire"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+var (
+	// LegacyEscrowKeyPrefix is the raw prefix v1 stored JSON escrow records under
+	LegacyEscrowKeyPrefix = []byte{0x01}
+	// LegacyProviderStakeKeyPrefix is the raw prefix v1 stored JSON provider stakes under
+	LegacyProviderStakeKeyPrefix = []byte{0x02}
+
+	// FileEntryKeyPrefix, HostingInquiryKeyPrefix and HostingContractKeyPrefix
+	// are the raw prefixes records are stored under in v1 and v2
+	FileEntryKeyPrefix       = []byte("FileEntry/value/FileEntry/value//")
+	HostingInquiryKeyPrefix  = []byte("HostingInquiry/value/HostingInquiry/value//")
+	HostingContractKeyPrefix = []byte("HostingContract/value/HostingContract/value//")
+	// ChallengeableContractKeyPrefix indexes the contracts storage challenges
+	// are drawn from in v2, by the id key of the contract
+	ChallengeableContractKeyPrefix = []byte("StorageChallenge/contract/HostingContract/value//")
+	// ParamsKey is the raw key params are stored under
+	ParamsKey = []byte("p_filespacechain")
+)
+
+// Field numbers of the v2 records the migration reads or writes
+const (
+	fileEntryCidField     protowire.Number = 2
+	fileEntryCreatorField protowire.Number = 7
+
+	inquiryCidField         protowire.Number = 2
+	inquiryCreatorField     protowire.Number = 6
+	inquiryFileEntryIdField protowire.Number = 8
+
+	contractEndBlockField protowire.Number = 6
+	contractStatusField   protowire.Number = 8
+
+	contractStatusActive    = 1
+	contractStatusCompleted = 2
+
+	paramsChallengesPerBlockField protowire.Number = 4
+	paramsChunksPerChallengeField protowire.Number = 5
+	paramsChallengeWindowField    protowire.Number = 6
+	paramsAcceptanceDeadlineField protowire.Number = 7
+	paramsUnbondingPeriodField    protowire.Number = 8
+	paramsCollateralRatioField    protowire.Number = 9
+)
+
+// LegacyEscrowRecord is the JSON layout of escrow records in v1
+type LegacyEscrowRecord struct {
+	InquiryId uint64   `json:"inquiry_id"`
+	Amount    sdk.Coin `json:"amount"`
+	Creator   string   `json:"creator"`
+}
+
+// LegacyProviderStake is the JSON layout of provider stakes in v1
+type LegacyProviderStake struct {
+	Provider string   `json:"provider"`
+	Amount   sdk.Coin `json:"amount"`
+	Height   uint64   `json:"height"`
+}
+
+// MigrateStore performs in-place store migrations from v1 to v2. It rewrites
+// the JSON encoded escrow records and provider stakes as protobuf under the
+// prefixes defined in types/keys.go, gives contracts the status of their term,
+// binds inquiries to a file entry and sets the params added since v1 to their
+// defaults. An entry that cannot be decoded aborts the migration instead of
+// being dropped.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService, cdc codec.BinaryCodec) error {
+	storeAdapter := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+
+	if err := migrateEscrowRecords(storeAdapter, cdc); err != nil {
+		return err
+	}
+	if err := migrateProviderStakes(storeAdapter, cdc); err != nil {
+		return err
+	}
+	if err := migrateHostingContracts(storeAdapter, height); err != nil {
+		return err
+	}
+	if err := migrateHostingInquiries(storeAdapter); err != nil {
+		return err
+	}
+	return migrateParams(storeAdapter)
+}
+
+func migrateEscrowRecords(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	legacyStore := prefix.NewStore(store, LegacyEscrowKeyPrefix)
+	escrowStore := prefix.NewStore(store, types.KeyPrefix(types.EscrowRecordKey))
+
+	keys, values := collect(legacyStore)
+	for i, key := range keys {
+		var legacy LegacyEscrowRecord
+		if err := json.Unmarshal(values[i], &legacy); err != nil {
+			return fmt.Errorf("failed to decode escrow record %x: %w", key, err)
+		}
+		if len(key) != 8 || binary.BigEndian.Uint64(key) != legacy.InquiryId {
+			return fmt.Errorf("escrow record key %x does not match inquiry %d", key, legacy.InquiryId)
+		}
+
+		record := types.EscrowRecord{
+			InquiryId: legacy.InquiryId,
+			Amount:    legacy.Amount,
+			Creator:   legacy.Creator,
+		}
+		escrowStore.Set(key, cdc.MustMarshal(&record))
+		legacyStore.Delete(key)
+	}
+
+	return nil
+}
+
+func migrateProviderStakes(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	legacyStore := prefix.NewStore(store, LegacyProviderStakeKeyPrefix)
+	stakeStore := prefix.NewStore(store, types.KeyPrefix(types.ProviderStakeKey))
+
+	keys, values := collect(legacyStore)
+	for i, key := range keys {
+		var legacy LegacyProviderStake
+		if err := json.Unmarshal(values[i], &legacy); err != nil {
+			return fmt.Errorf("failed to decode provider stake %s: %w", string(key), err)
+		}
+		if string(key) != legacy.Provider {
+			return fmt.Errorf("provider stake key %s does not match provider %s", string(key), legacy.Provider)
+		}
+
+		stake := types.ProviderStake{
+			Provider: legacy.Provider,
+			Amount:   legacy.Amount,
+			Height:   legacy.Height,
+		}
+		stakeStore.Set(key, cdc.MustMarshal(&stake))
+		legacyStore.Delete(key)
+	}
+
+	return nil
+}
+
+// migrateHostingContracts gives v1 contracts, which have no status, the one of
+// their term: they started hosting when they were created, so they are active
+// until their end block and completed after it. Active contracts are indexed
+// for storage challenges.
+func migrateHostingContracts(store storetypes.KVStore, height uint64) error {
+	contractStore := prefix.NewStore(store, HostingContractKeyPrefix)
+	challengeableStore := prefix.NewStore(store, ChallengeableContractKeyPrefix)
+
+	keys, values := collect(contractStore)
+	for i, key := range keys {
+		endBlock, _, err := wire.Varint(values[i], contractEndBlockField)
+		if err != nil {
+			return fmt.Errorf("failed to decode hosting contract %x: %w", key, err)
+		}
+
+		status := uint64(contractStatusActive)
+		if endBlock < height {
+			status = contractStatusCompleted
+		}
+		bz, err := wire.SetVarint(values[i], contractStatusField, status)
+		if err != nil {
+			return fmt.Errorf("failed to encode hosting contract %x: %w", key, err)
+		}
+		contractStore.Set(key, bz)
+
+		if status == contractStatusActive {
+			challengeableStore.Set(key, []byte{})
+		} else {
+			challengeableStore.Delete(key)
+		}
+	}
+
+	return nil
+}
+
+// migrateHostingInquiries binds inquiries to a file entry instead of a CID.
+// An inquiry takes its creator's entry for the CID, or the first one
+// registered; one whose file is gone is left unbound and never challenged.
+func migrateHostingInquiries(store storetypes.KVStore) error {
+	type cidEntries struct {
+		first     uint64
+		byCreator map[string]uint64
+	}
+	entries := make(map[string]*cidEntries)
+
+	keys, values := collect(prefix.NewStore(store, FileEntryKeyPrefix))
+	for i, key := range keys {
+		cid, _, err := wire.Bytes(values[i], fileEntryCidField)
+		if err != nil {
+			return fmt.Errorf("failed to decode file entry %x: %w", key, err)
+		}
+		creator, _, err := wire.Bytes(values[i], fileEntryCreatorField)
+		if err != nil {
+			return fmt.Errorf("failed to decode file entry %x: %w", key, err)
+		}
+
+		// Keys are id ordered, so the first entry seen for a CID was registered first
+		id := binary.BigEndian.Uint64(key)
+		byCid, found := entries[string(cid)]
+		if !found {
+			byCid = &cidEntries{first: id, byCreator: make(map[string]uint64)}
+			entries[string(cid)] = byCid
+		}
+		if _, found := byCid.byCreator[string(creator)]; !found {
+			byCid.byCreator[string(creator)] = id
+		}
+	}
+
+	inquiryStore := prefix.NewStore(store, HostingInquiryKeyPrefix)
+	keys, values = collect(inquiryStore)
+	for i, key := range keys {
+		cid, _, err := wire.Bytes(values[i], inquiryCidField)
+		if err != nil {
+			return fmt.Errorf("failed to decode hosting inquiry %x: %w", key, err)
+		}
+		creator, _, err := wire.Bytes(values[i], inquiryCreatorField)
+		if err != nil {
+			return fmt.Errorf("failed to decode hosting inquiry %x: %w", key, err)
+		}
+
+		byCid, found := entries[string(cid)]
+		if !found {
+			continue
+		}
+		fileEntryId, found := byCid.byCreator[string(creator)]
+		if !found {
+			fileEntryId = byCid.first
+		}
+		bz, err := wire.SetVarint(values[i], inquiryFileEntryIdField, fileEntryId)
+		if err != nil {
+			return fmt.Errorf("failed to encode hosting inquiry %x: %w", key, err)
+		}
+		inquiryStore.Set(key, bz)
+	}
+
+	return nil
+}
+
+// migrateParams sets the storage proof, acceptance, unbonding and collateral
+// params added since v1 to their v2 defaults. Fields v1 stored are kept as
+// they are.
+func migrateParams(store storetypes.KVStore) error {
+	bz := store.Get(ParamsKey)
+	if bz == nil {
+		return nil
+	}
+
+	collateralRatio, err := math.LegacyNewDecWithPrec(1, 1).Marshal()
+	if err != nil {
+		return err
+	}
+	varints := []struct {
+		field protowire.Number
+		value uint64
+	}{
+		{paramsChallengesPerBlockField, 10},
+		{paramsChunksPerChallengeField, 3},
+		{paramsChallengeWindowField, 50},
+		{paramsAcceptanceDeadlineField, 100},
+		{paramsUnbondingPeriodField, 100800},
+	}
+	for _, param := range varints {
+		if bz, err = wire.SetVarint(bz, param.field, param.value); err != nil {
+			return fmt.Errorf("failed to encode params: %w", err)
+		}
+	}
+	if bz, err = wire.SetBytes(bz, paramsCollateralRatioField, collateralRatio); err != nil {
+		return fmt.Errorf("failed to encode params: %w", err)
+	}
+
+	store.Set(ParamsKey, bz)
+	return nil
+}
+
+// collect reads all entries first so the store is not written while iterating
+func collect(store prefix.Store) (keys [][]byte, values [][]byte) {
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+	return keys, values
+}
@@ -0,0 +1,190 @@
+package v2_test
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hanshq/filespace-chain/testutil/sample"
+	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
+	"github.com/hanshq/filespace-chain/x/filespacechain/migrations/internal/wire"
+	v2 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v2"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func setup(t *testing.T) (sdk.Context, *storetypes.KVStoreKey, codec.BinaryCodec, keeper.Keeper) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		nil,
+		nil,
+		nil,
+	)
+	return ctx, storeKey, cdc, k
+}
+
+func setLegacy(t *testing.T, ctx sdk.Context, storeKey *storetypes.KVStoreKey, prefix []byte, key []byte, value interface{}) {
+	bz, err := json.Marshal(value)
+	require.NoError(t, err)
+	ctx.KVStore(storeKey).Set(append(append([]byte{}, prefix...), key...), bz)
+}
+
+func TestMigrateStore(t *testing.T) {
+	ctx, storeKey, cdc, k := setup(t)
+
+	creator, provider := sample.AccAddress(), sample.AccAddress()
+	escrow := v2.LegacyEscrowRecord{
+		InquiryId: 7,
+		Amount:    sdk.NewCoin("token", math.NewInt(12345)),
+		Creator:   creator,
+	}
+	stake := v2.LegacyProviderStake{
+		Provider: provider,
+		Amount:   sdk.NewCoin("token", math.NewInt(1000000)),
+		Height:   42,
+	}
+	setLegacy(t, ctx, storeKey, v2.LegacyEscrowKeyPrefix, binary.BigEndian.AppendUint64(nil, 7), escrow)
+	setLegacy(t, ctx, storeKey, v2.LegacyProviderStakeKeyPrefix, []byte(provider), stake)
+
+	require.NoError(t, v2.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+
+	gotEscrow, found := k.GetEscrowRecord(ctx, 7)
+	require.True(t, found)
+	require.Equal(t, types.EscrowRecord{InquiryId: 7, Amount: escrow.Amount, Creator: creator}, gotEscrow)
+
+	gotStake, found := k.GetProviderStake(ctx, provider)
+	require.True(t, found)
+	require.Equal(t, types.ProviderStake{Provider: provider, Amount: stake.Amount, Height: 42}, gotStake)
+
+	// The JSON entries are gone
+	store := ctx.KVStore(storeKey)
+	require.False(t, store.Iterator(v2.LegacyEscrowKeyPrefix, storetypes.PrefixEndBytes(v2.LegacyEscrowKeyPrefix)).Valid())
+	require.False(t, store.Iterator(v2.LegacyProviderStakeKeyPrefix, storetypes.PrefixEndBytes(v2.LegacyProviderStakeKeyPrefix)).Valid())
+
+	// Running the migration again is a no-op
+	require.NoError(t, v2.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+	require.Len(t, k.GetAllEscrowRecords(ctx), 1)
+	require.Len(t, k.GetAllProviderStakes(ctx), 1)
+}
+
+func TestMigrateStoreRejectsCorruptEntries(t *testing.T) {
+	ctx, storeKey, cdc, _ := setup(t)
+
+	ctx.KVStore(storeKey).Set(append([]byte{0x01}, binary.BigEndian.AppendUint64(nil, 1)...), []byte("{not json"))
+	require.Error(t, v2.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+}
+
+func TestMigrateStoreRejectsMismatchedKeys(t *testing.T) {
+	ctx, storeKey, cdc, _ := setup(t)
+
+	setLegacy(t, ctx, storeKey, v2.LegacyEscrowKeyPrefix, binary.BigEndian.AppendUint64(nil, 1), v2.LegacyEscrowRecord{InquiryId: 2})
+	require.Error(t, v2.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+}
+
+func TestMigrateStoreContractsAndInquiries(t *testing.T) {
+	ctx, storeKey, cdc, k := setup(t)
+	ctx = ctx.WithBlockHeight(100)
+
+	// v1 contracts have no status
+	ended := k.AppendHostingContract(ctx, types.HostingContract{StartBlock: 10, EndBlock: 99})
+	running := k.AppendHostingContract(ctx, types.HostingContract{StartBlock: 10, EndBlock: 100})
+
+	// Two entries share a CID; each inquiry takes its creator's, or the first
+	creator := sample.AccAddress()
+	first := k.AppendFileEntry(ctx, types.FileEntry{Creator: sample.AccAddress(), Cid: "bafyfile"})
+	own := k.AppendFileEntry(ctx, types.FileEntry{Creator: creator, Cid: "bafyfile"})
+	ownInquiry := k.AppendHostingInquiry(ctx, types.HostingInquiry{Creator: creator, FileEntryCid: "bafyfile"})
+	otherInquiry := k.AppendHostingInquiry(ctx, types.HostingInquiry{Creator: sample.AccAddress(), FileEntryCid: "bafyfile"})
+	goneInquiry := k.AppendHostingInquiry(ctx, types.HostingInquiry{Creator: creator, FileEntryCid: "bafygone"})
+
+	require.NoError(t, v2.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+
+	contract, _ := k.GetHostingContract(ctx, ended)
+	require.Equal(t, types.CONTRACT_STATUS_COMPLETED, contract.Status)
+	contract, _ = k.GetHostingContract(ctx, running)
+	require.Equal(t, types.CONTRACT_STATUS_ACTIVE, contract.Status)
+	require.Equal(t, uint64(100), contract.EndBlock)
+	require.Len(t, k.GetActiveContracts(ctx), 1)
+
+	// Only the running contract is drawn for storage challenges
+	store := ctx.KVStore(storeKey)
+	require.True(t, store.Has(append(append([]byte{}, v2.ChallengeableContractKeyPrefix...), binary.BigEndian.AppendUint64(nil, running)...)))
+	require.False(t, store.Has(append(append([]byte{}, v2.ChallengeableContractKeyPrefix...), binary.BigEndian.AppendUint64(nil, ended)...)))
+
+	inquiry, _ := k.GetHostingInquiry(ctx, ownInquiry)
+	require.Equal(t, own, inquiry.FileEntryId)
+	inquiry, _ = k.GetHostingInquiry(ctx, otherInquiry)
+	require.Equal(t, first, inquiry.FileEntryId)
+	inquiry, _ = k.GetHostingInquiry(ctx, goneInquiry)
+	require.Zero(t, inquiry.FileEntryId)
+	require.Equal(t, creator, inquiry.Creator)
+}
+
+func TestMigrateStoreParams(t *testing.T) {
+	ctx, storeKey, cdc, k := setup(t)
+
+	// v1 params only hold a base price, the minimum provider stake and the
+	// slashing fraction
+	v1 := types.Params{
+		MinProviderStake: math.NewInt(42),
+		SlashingFraction: math.LegacyNewDecWithPrec(2, 2),
+		CollateralRatio:  math.LegacyZeroDec(),
+	}
+	bz, err := cdc.Marshal(&v1)
+	require.NoError(t, err)
+	// The base price field was dropped from params in v8
+	basePrice, err := math.LegacyNewDecWithPrec(3, 9).Marshal()
+	require.NoError(t, err)
+	bz, err = wire.SetBytes(bz, 1, basePrice)
+	require.NoError(t, err)
+	ctx.KVStore(storeKey).Set(v2.ParamsKey, bz)
+
+	require.NoError(t, v2.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+
+	defaults := types.DefaultParams()
+	params := k.GetParams(ctx)
+	migrated, found, err := wire.Bytes(ctx.KVStore(storeKey).Get(v2.ParamsKey), 1)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, basePrice, migrated)
+	require.Equal(t, v1.MinProviderStake, params.MinProviderStake)
+	require.Equal(t, v1.SlashingFraction, params.SlashingFraction)
+	require.Equal(t, defaults.ChallengesPerBlock, params.ChallengesPerBlock)
+	require.Equal(t, defaults.ChunksPerChallenge, params.ChunksPerChallenge)
+	require.Equal(t, defaults.ChallengeWindow, params.ChallengeWindow)
+	require.Equal(t, defaults.AcceptanceDeadline, params.AcceptanceDeadline)
+	require.Equal(t, defaults.UnbondingPeriod, params.UnbondingPeriod)
+	require.Equal(t, defaults.CollateralRatio, params.CollateralRatio)
+
+	// The queue processing cap is only set by the v4 migration, the
+	// reputation weight by the v7 migration, the accepted payment denoms by
+	// the v8 migration, the completion bonus fraction by the v9 migration, the
+	// early termination penalties by the v10 migration, the bond denom by the
+	// v12 migration and the offer match scan limit by the v13 migration
+	params.MaxExpiriesPerBlock = defaults.MaxExpiriesPerBlock
+	params.ReputationWeight = defaults.ReputationWeight
+	params.AcceptedDenoms = defaults.AcceptedDenoms
+	params.CompletionBonusFraction = defaults.CompletionBonusFraction
+	params.ProviderTerminationSlashFraction = defaults.ProviderTerminationSlashFraction
+	params.ClientTerminationPenaltyFraction = defaults.ClientTerminationPenaltyFraction
+	params.BondDenom = defaults.BondDenom
+	params.OfferMatchScanLimit = defaults.OfferMatchScanLimit
+	require.NoError(t, params.Validate())
+}
@@ -0,0 +1,151 @@
+package v3
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/migrations/internal/wire"
+)
+
+var (
+	// FileEntryKeyPrefix, HostingInquiryKeyPrefix and HostingContractKeyPrefix
+	// are the raw prefixes records are stored under in v2 and v3
+	FileEntryKeyPrefix       = []byte("FileEntry/value/FileEntry/value//")
+	HostingInquiryKeyPrefix  = []byte("HostingInquiry/value/HostingInquiry/value//")
+	HostingContractKeyPrefix = []byte("HostingContract/value/HostingContract/value//")
+
+	// The secondary indexes added in v3. Index keys are the indexed value
+	// followed by the big-endian record id; values are the record id.
+	FileEntryByCidKeyPrefix            = []byte("FileEntry/cid/")
+	HostingInquiryByCreatorKeyPrefix   = []byte("HostingInquiry/creator/")
+	HostingInquiryByFileEntryKeyPrefix = []byte("HostingInquiry/fileentry/")
+	HostingContractByInquiryKeyPrefix  = []byte("HostingContract/inquiry/")
+	HostingContractByProviderKeyPrefix = []byte("HostingContract/provider/")
+	HostingContractByEndBlockKeyPrefix = []byte("HostingContract/endblock/")
+)
+
+// Field numbers of the v3 records the migration reads
+const (
+	fileEntryCidField protowire.Number = 2
+
+	inquiryCreatorField     protowire.Number = 6
+	inquiryFileEntryIdField protowire.Number = 8
+
+	contractInquiryIdField protowire.Number = 2
+	contractCreatorField   protowire.Number = 4
+	contractEndBlockField  protowire.Number = 6
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3. It builds the
+// secondary indexes of contracts, inquiries and file entries from the records
+// already stored.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService) error {
+	store := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	if err := indexFileEntries(store); err != nil {
+		return err
+	}
+	if err := indexHostingInquiries(store); err != nil {
+		return err
+	}
+	return indexHostingContracts(store)
+}
+
+func indexFileEntries(store storetypes.KVStore) error {
+	iterator := storetypes.KVStorePrefixIterator(prefix.NewStore(store, FileEntryKeyPrefix), []byte{})
+	defer iterator.Close()
+
+	var entries []indexEntry
+	for ; iterator.Valid(); iterator.Next() {
+		cid, _, err := wire.Bytes(iterator.Value(), fileEntryCidField)
+		if err != nil {
+			return fmt.Errorf("failed to decode file entry %x: %w", iterator.Key(), err)
+		}
+		entries = append(entries, indexEntry{FileEntryByCidKeyPrefix, stringValue(cid), iterator.Key()})
+	}
+	setIndexEntries(store, entries)
+	return nil
+}
+
+func indexHostingInquiries(store storetypes.KVStore) error {
+	iterator := storetypes.KVStorePrefixIterator(prefix.NewStore(store, HostingInquiryKeyPrefix), []byte{})
+	defer iterator.Close()
+
+	var entries []indexEntry
+	for ; iterator.Valid(); iterator.Next() {
+		creator, _, err := wire.Bytes(iterator.Value(), inquiryCreatorField)
+		if err != nil {
+			return fmt.Errorf("failed to decode hosting inquiry %x: %w", iterator.Key(), err)
+		}
+		fileEntryId, _, err := wire.Varint(iterator.Value(), inquiryFileEntryIdField)
+		if err != nil {
+			return fmt.Errorf("failed to decode hosting inquiry %x: %w", iterator.Key(), err)
+		}
+		entries = append(entries,
+			indexEntry{HostingInquiryByCreatorKeyPrefix, stringValue(creator), iterator.Key()},
+			indexEntry{HostingInquiryByFileEntryKeyPrefix, uint64Value(fileEntryId), iterator.Key()},
+		)
+	}
+	setIndexEntries(store, entries)
+	return nil
+}
+
+func indexHostingContracts(store storetypes.KVStore) error {
+	iterator := storetypes.KVStorePrefixIterator(prefix.NewStore(store, HostingContractKeyPrefix), []byte{})
+	defer iterator.Close()
+
+	var entries []indexEntry
+	for ; iterator.Valid(); iterator.Next() {
+		inquiryId, _, err := wire.Varint(iterator.Value(), contractInquiryIdField)
+		if err != nil {
+			return fmt.Errorf("failed to decode hosting contract %x: %w", iterator.Key(), err)
+		}
+		creator, _, err := wire.Bytes(iterator.Value(), contractCreatorField)
+		if err != nil {
+			return fmt.Errorf("failed to decode hosting contract %x: %w", iterator.Key(), err)
+		}
+		endBlock, _, err := wire.Varint(iterator.Value(), contractEndBlockField)
+		if err != nil {
+			return fmt.Errorf("failed to decode hosting contract %x: %w", iterator.Key(), err)
+		}
+		entries = append(entries,
+			indexEntry{HostingContractByInquiryKeyPrefix, uint64Value(inquiryId), iterator.Key()},
+			indexEntry{HostingContractByProviderKeyPrefix, stringValue(creator), iterator.Key()},
+			indexEntry{HostingContractByEndBlockKeyPrefix, uint64Value(endBlock), iterator.Key()},
+		)
+	}
+	setIndexEntries(store, entries)
+	return nil
+}
+
+// indexEntry maps the value of a record field to the key of the record, which
+// is its big-endian id
+type indexEntry struct {
+	index []byte
+	value []byte
+	id    []byte
+}
+
+// setIndexEntries writes the entries once the records have been read, so the
+// store is not written while iterating
+func setIndexEntries(store storetypes.KVStore, entries []indexEntry) {
+	for _, entry := range entries {
+		key := append(append(append([]byte{}, entry.index...), entry.value...), entry.id...)
+		store.Set(key, entry.id)
+	}
+}
+
+func stringValue(value []byte) []byte {
+	return append(append([]byte{}, value...), '/')
+}
+
+func uint64Value(value uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, value)
+}
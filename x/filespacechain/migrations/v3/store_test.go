@@ -0,0 +1,84 @@
+package v3_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hanshq/filespace-chain/testutil/sample"
+	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
+	v3 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v3"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func setup(t *testing.T) (sdk.Context, *storetypes.KVStoreKey, codec.BinaryCodec, keeper.Keeper) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		nil,
+		nil,
+		nil,
+	)
+	return ctx, storeKey, cdc, k
+}
+
+// setV2 stores a record the way v2 did, without index entries
+func setV2(ctx sdk.Context, storeKey *storetypes.KVStoreKey, cdc codec.BinaryCodec, prefix []byte, id uint64, record proto.Message) {
+	key := binary.BigEndian.AppendUint64(append([]byte{}, prefix...), id)
+	ctx.KVStore(storeKey).Set(key, cdc.MustMarshal(record))
+}
+
+func TestMigrateStore(t *testing.T) {
+	ctx, storeKey, cdc, k := setup(t)
+	creator := sample.AccAddress()
+	provider := sample.AccAddress()
+
+	setV2(ctx, storeKey, cdc, v3.FileEntryKeyPrefix, 0, &types.FileEntry{Id: 0, Creator: creator, Cid: "bafyfile"})
+	setV2(ctx, storeKey, cdc, v3.FileEntryKeyPrefix, 1, &types.FileEntry{Id: 1, Creator: provider, Cid: "bafyfile"})
+	setV2(ctx, storeKey, cdc, v3.HostingInquiryKeyPrefix, 0, &types.HostingInquiry{Id: 0, Creator: creator, FileEntryCid: "bafyfile", FileEntryId: 1})
+	setV2(ctx, storeKey, cdc, v3.HostingContractKeyPrefix, 0, &types.HostingContract{Id: 0, InquiryId: 0, Creator: provider, EndBlock: 50})
+	setV2(ctx, storeKey, cdc, v3.HostingContractKeyPrefix, 1, &types.HostingContract{Id: 1, InquiryId: 0, Creator: provider, EndBlock: 200})
+
+	require.Empty(t, k.GetFileEntriesByCid(ctx, "bafyfile"))
+	require.NoError(t, v3.MigrateStore(ctx, runtime.NewKVStoreService(storeKey)))
+
+	entries := k.GetFileEntriesByCid(ctx, "bafyfile")
+	require.Len(t, entries, 2)
+	require.Equal(t, creator, entries[0].Creator)
+	require.Len(t, k.GetHostingInquiriesByCreator(ctx, creator), 1)
+	require.Len(t, k.GetHostingContractsByInquiry(ctx, 0), 2)
+	require.Len(t, k.GetHostingContractsByProvider(ctx, provider), 2)
+	require.Empty(t, k.GetHostingContractsByProvider(ctx, creator))
+
+	ending := k.GetHostingContractsEndingFrom(ctx, 100)
+	require.Len(t, ending, 1)
+	require.Equal(t, uint64(1), ending[0].Id)
+
+	// The file entry an inquiry hosts stays in use
+	_, err := keeper.NewMsgServerImpl(k).DeleteFileEntry(ctx, &types.MsgDeleteFileEntry{Creator: provider, Id: 1})
+	require.ErrorIs(t, err, types.ErrFileEntryInUse)
+}
+
+func TestMigrateStoreRejectsCorruptRecords(t *testing.T) {
+	ctx, storeKey, _, _ := setup(t)
+	key := binary.BigEndian.AppendUint64(append([]byte{}, v3.HostingContractKeyPrefix...), 0)
+	ctx.KVStore(storeKey).Set(key, []byte{0xff})
+
+	require.Error(t, v3.MigrateStore(ctx, runtime.NewKVStoreService(storeKey)))
+}
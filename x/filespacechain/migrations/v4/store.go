@@ -0,0 +1,178 @@
+package v4
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/migrations/internal/wire"
+)
+
+var (
+	// HostingInquiryKeyPrefix, HostingContractKeyPrefix and
+	// PaymentHistoryKeyPrefix are the raw prefixes records are stored under in
+	// v3 and v4
+	HostingInquiryKeyPrefix  = []byte("HostingInquiry/value/HostingInquiry/value//")
+	HostingContractKeyPrefix = []byte("HostingContract/value/HostingContract/value//")
+	PaymentHistoryKeyPrefix  = []byte("PaymentHistory/value/")
+
+	// The expiry queues added in v4. Queue keys are the big-endian height the
+	// record falls due at followed by the big-endian record id; values are the
+	// record id.
+	HostingInquiryQueueKeyPrefix        = []byte("HostingInquiry/queue/")
+	PendingContractQueueKeyPrefix       = []byte("HostingContract/pendingqueue/")
+	ActiveContractQueueKeyPrefix        = []byte("HostingContract/activequeue/")
+	SettledPaymentHistoryQueueKeyPrefix = []byte("PaymentHistory/settledqueue/")
+
+	// ParamsKey is the raw key params are stored under
+	ParamsKey = []byte("p_filespacechain")
+)
+
+// Field numbers of the v4 records the migration reads or writes
+const (
+	inquiryEndTimeField protowire.Number = 5
+
+	contractEndBlockField           protowire.Number = 6
+	contractStatusField             protowire.Number = 8
+	contractAcceptanceDeadlineField protowire.Number = 9
+
+	paymentHistoryLastPaymentBlockField    protowire.Number = 3
+	paymentHistoryCompletionBonusPaidField protowire.Number = 4
+
+	paramsMaxExpiriesPerBlockField protowire.Number = 11
+)
+
+// Contract statuses that are queued
+const (
+	contractStatusPending = 0
+	contractStatusActive  = 1
+)
+
+// MigrateStore performs in-place store migrations from v3 to v4. It queues
+// inquiries by end time, PENDING contracts by acceptance deadline, ACTIVE
+// contracts by end block and settled payment histories by last payment block,
+// and sets the per-block queue processing cap to its default.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService) error {
+	store := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	if err := queueHostingInquiries(store); err != nil {
+		return err
+	}
+	if err := queueHostingContracts(store); err != nil {
+		return err
+	}
+	if err := queuePaymentHistories(store); err != nil {
+		return err
+	}
+	return migrateParams(store)
+}
+
+func queueHostingInquiries(store storetypes.KVStore) error {
+	iterator := storetypes.KVStorePrefixIterator(prefix.NewStore(store, HostingInquiryKeyPrefix), []byte{})
+	defer iterator.Close()
+
+	var entries []queueEntry
+	for ; iterator.Valid(); iterator.Next() {
+		endTime, _, err := wire.Varint(iterator.Value(), inquiryEndTimeField)
+		if err != nil {
+			return fmt.Errorf("failed to decode hosting inquiry %x: %w", iterator.Key(), err)
+		}
+		entries = append(entries, queueEntry{HostingInquiryQueueKeyPrefix, endTime, iterator.Key()})
+	}
+	setQueueEntries(store, entries)
+	return nil
+}
+
+func queueHostingContracts(store storetypes.KVStore) error {
+	iterator := storetypes.KVStorePrefixIterator(prefix.NewStore(store, HostingContractKeyPrefix), []byte{})
+	defer iterator.Close()
+
+	var entries []queueEntry
+	for ; iterator.Valid(); iterator.Next() {
+		status, _, err := wire.Varint(iterator.Value(), contractStatusField)
+		if err != nil {
+			return fmt.Errorf("failed to decode hosting contract %x: %w", iterator.Key(), err)
+		}
+		switch status {
+		case contractStatusPending:
+			deadline, _, err := wire.Varint(iterator.Value(), contractAcceptanceDeadlineField)
+			if err != nil {
+				return fmt.Errorf("failed to decode hosting contract %x: %w", iterator.Key(), err)
+			}
+			if deadline != 0 {
+				entries = append(entries, queueEntry{PendingContractQueueKeyPrefix, deadline, iterator.Key()})
+			}
+		case contractStatusActive:
+			endBlock, _, err := wire.Varint(iterator.Value(), contractEndBlockField)
+			if err != nil {
+				return fmt.Errorf("failed to decode hosting contract %x: %w", iterator.Key(), err)
+			}
+			entries = append(entries, queueEntry{ActiveContractQueueKeyPrefix, endBlock, iterator.Key()})
+		}
+	}
+	setQueueEntries(store, entries)
+	return nil
+}
+
+func queuePaymentHistories(store storetypes.KVStore) error {
+	iterator := storetypes.KVStorePrefixIterator(prefix.NewStore(store, PaymentHistoryKeyPrefix), []byte{})
+	defer iterator.Close()
+
+	var entries []queueEntry
+	for ; iterator.Valid(); iterator.Next() {
+		settled, _, err := wire.Varint(iterator.Value(), paymentHistoryCompletionBonusPaidField)
+		if err != nil {
+			return fmt.Errorf("failed to decode payment history %x: %w", iterator.Key(), err)
+		}
+		if settled == 0 {
+			continue
+		}
+		lastPaymentBlock, _, err := wire.Varint(iterator.Value(), paymentHistoryLastPaymentBlockField)
+		if err != nil {
+			return fmt.Errorf("failed to decode payment history %x: %w", iterator.Key(), err)
+		}
+		entries = append(entries, queueEntry{SettledPaymentHistoryQueueKeyPrefix, lastPaymentBlock, iterator.Key()})
+	}
+	setQueueEntries(store, entries)
+	return nil
+}
+
+// migrateParams sets the per-block queue processing cap added in v4 to its
+// default
+func migrateParams(store storetypes.KVStore) error {
+	bz := store.Get(ParamsKey)
+	if bz == nil {
+		return nil
+	}
+
+	bz, err := wire.SetVarint(bz, paramsMaxExpiriesPerBlockField, 100)
+	if err != nil {
+		return fmt.Errorf("failed to encode params: %w", err)
+	}
+
+	store.Set(ParamsKey, bz)
+	return nil
+}
+
+// queueEntry queues the key of a record, which is its big-endian id, at a
+// height
+type queueEntry struct {
+	queue  []byte
+	height uint64
+	id     []byte
+}
+
+// setQueueEntries writes the entries once the records have been read, so the
+// store is not written while iterating
+func setQueueEntries(store storetypes.KVStore, entries []queueEntry) {
+	for _, entry := range entries {
+		key := binary.BigEndian.AppendUint64(append([]byte{}, entry.queue...), entry.height)
+		store.Set(append(key, entry.id...), entry.id)
+	}
+}
@@ -0,0 +1,98 @@
+package v4_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
+	v4 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v4"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func setup(t *testing.T) (sdk.Context, *storetypes.KVStoreKey, codec.BinaryCodec, keeper.Keeper) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		nil,
+		nil,
+		nil,
+	)
+	return ctx, storeKey, cdc, k
+}
+
+// setV3 stores a record the way v3 did, without queue entries
+func setV3(ctx sdk.Context, storeKey *storetypes.KVStoreKey, cdc codec.BinaryCodec, prefix []byte, id uint64, record proto.Message) {
+	key := binary.BigEndian.AppendUint64(append([]byte{}, prefix...), id)
+	ctx.KVStore(storeKey).Set(key, cdc.MustMarshal(record))
+}
+
+func queued(ctx sdk.Context, storeKey *storetypes.KVStoreKey, queue []byte, height, id uint64) bool {
+	key := binary.BigEndian.AppendUint64(append([]byte{}, queue...), height)
+	return ctx.KVStore(storeKey).Has(binary.BigEndian.AppendUint64(key, id))
+}
+
+func TestMigrateStore(t *testing.T) {
+	ctx, storeKey, cdc, k := setup(t)
+
+	setV3(ctx, storeKey, cdc, v4.HostingInquiryKeyPrefix, 0, &types.HostingInquiry{Id: 0, EndTime: 40})
+	setV3(ctx, storeKey, cdc, v4.HostingContractKeyPrefix, 0, &types.HostingContract{Id: 0, EndBlock: 50, AcceptanceDeadline: 10, Status: types.CONTRACT_STATUS_PENDING})
+	setV3(ctx, storeKey, cdc, v4.HostingContractKeyPrefix, 1, &types.HostingContract{Id: 1, EndBlock: 60, Status: types.CONTRACT_STATUS_ACTIVE})
+	setV3(ctx, storeKey, cdc, v4.HostingContractKeyPrefix, 2, &types.HostingContract{Id: 2, EndBlock: 70, Status: types.CONTRACT_STATUS_COMPLETED})
+	setV3(ctx, storeKey, cdc, v4.PaymentHistoryKeyPrefix, 1, &types.PaymentHistory{ContractId: 1, LastPaymentBlock: 30})
+	setV3(ctx, storeKey, cdc, v4.PaymentHistoryKeyPrefix, 2, &types.PaymentHistory{ContractId: 2, LastPaymentBlock: 70, CompletionBonusPaid: true})
+
+	// v3 params have no queue processing cap
+	v3Params := types.DefaultParams()
+	v3Params.MaxExpiriesPerBlock = 0
+	bz, err := cdc.Marshal(&v3Params)
+	require.NoError(t, err)
+	ctx.KVStore(storeKey).Set(v4.ParamsKey, bz)
+
+	require.NoError(t, v4.MigrateStore(ctx, runtime.NewKVStoreService(storeKey)))
+
+	require.True(t, queued(ctx, storeKey, v4.HostingInquiryQueueKeyPrefix, 40, 0))
+	require.True(t, queued(ctx, storeKey, v4.PendingContractQueueKeyPrefix, 10, 0))
+	require.False(t, queued(ctx, storeKey, v4.ActiveContractQueueKeyPrefix, 50, 0))
+	require.True(t, queued(ctx, storeKey, v4.ActiveContractQueueKeyPrefix, 60, 1))
+	require.False(t, queued(ctx, storeKey, v4.ActiveContractQueueKeyPrefix, 70, 2))
+	require.False(t, queued(ctx, storeKey, v4.SettledPaymentHistoryQueueKeyPrefix, 30, 1))
+	require.True(t, queued(ctx, storeKey, v4.SettledPaymentHistoryQueueKeyPrefix, 70, 2))
+
+	// The settled payment history is pruned from its queue
+	require.NoError(t, k.CleanupCompletedPaymentHistory(ctx.WithBlockHeight(200), 100))
+	_, found := k.GetPaymentHistory(ctx, 2)
+	require.False(t, found)
+	_, found = k.GetPaymentHistory(ctx, 1)
+	require.True(t, found)
+
+	params := k.GetParams(ctx)
+	require.Equal(t, types.DefaultParams().MaxExpiriesPerBlock, params.MaxExpiriesPerBlock)
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 1), params.CollateralRatio)
+	require.NoError(t, params.Validate())
+}
+
+func TestMigrateStoreRejectsCorruptRecords(t *testing.T) {
+	ctx, storeKey, _, _ := setup(t)
+	key := binary.BigEndian.AppendUint64(append([]byte{}, v4.HostingContractKeyPrefix...), 0)
+	ctx.KVStore(storeKey).Set(key, []byte{0xff})
+
+	require.Error(t, v4.MigrateStore(ctx, runtime.NewKVStoreService(storeKey)))
+}
@@ -0,0 +1,123 @@
+package v5
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/migrations/internal/wire"
+)
+
+var (
+	// HostingInquiryKeyPrefix and HostingContractKeyPrefix are the raw
+	// prefixes records are stored under in v4 and v5
+	HostingInquiryKeyPrefix  = []byte("HostingInquiry/value/HostingInquiry/value//")
+	HostingContractKeyPrefix = []byte("HostingContract/value/HostingContract/value//")
+
+	// OpenHostingInquiryKeyPrefix is the index of inquiries with unfilled
+	// replicas added in v5. Keys and values are the big-endian inquiry id.
+	OpenHostingInquiryKeyPrefix = []byte("HostingInquiry/open/")
+)
+
+// Field numbers of the v5 records the migration reads or writes
+const (
+	inquiryReplicationRateField protowire.Number = 3
+	inquiryFilledReplicasField  protowire.Number = 11
+
+	contractInquiryIdField protowire.Number = 2
+	contractStatusField    protowire.Number = 8
+)
+
+// Contract statuses that hold a replica
+const (
+	contractStatusPending = 0
+	contractStatusActive  = 1
+)
+
+// MigrateStore performs in-place store migrations from v4 to v5. Every
+// inquiry gets the number of replicas held by its PENDING and ACTIVE
+// contracts, and the inquiries short of their replication rate are indexed as
+// open.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService) error {
+	store := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	filled, err := countFilledReplicas(store)
+	if err != nil {
+		return err
+	}
+	return setFilledReplicas(store, filled)
+}
+
+// countFilledReplicas counts the contracts holding a replica of each inquiry
+func countFilledReplicas(store storetypes.KVStore) (map[uint64]uint64, error) {
+	iterator := storetypes.KVStorePrefixIterator(prefix.NewStore(store, HostingContractKeyPrefix), []byte{})
+	defer iterator.Close()
+
+	filled := make(map[uint64]uint64)
+	for ; iterator.Valid(); iterator.Next() {
+		status, _, err := wire.Varint(iterator.Value(), contractStatusField)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode hosting contract %x: %w", iterator.Key(), err)
+		}
+		if status != contractStatusPending && status != contractStatusActive {
+			continue
+		}
+		inquiryId, _, err := wire.Varint(iterator.Value(), contractInquiryIdField)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode hosting contract %x: %w", iterator.Key(), err)
+		}
+		filled[inquiryId]++
+	}
+	return filled, nil
+}
+
+// inquiryUpdate is the re-encoded value, if it changed, of an inquiry stored
+// under its key, which is its big-endian id
+type inquiryUpdate struct {
+	id    []byte
+	value []byte
+	open  bool
+}
+
+func setFilledReplicas(store storetypes.KVStore, filled map[uint64]uint64) error {
+	inquiryStore := prefix.NewStore(store, HostingInquiryKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(inquiryStore, []byte{})
+	defer iterator.Close()
+
+	var updates []inquiryUpdate
+	for ; iterator.Valid(); iterator.Next() {
+		replicationRate, _, err := wire.Varint(iterator.Value(), inquiryReplicationRateField)
+		if err != nil {
+			return fmt.Errorf("failed to decode hosting inquiry %x: %w", iterator.Key(), err)
+		}
+		update := inquiryUpdate{id: iterator.Key()}
+		count := filled[binary.BigEndian.Uint64(iterator.Key())]
+		// Zero is the encoding of an unset field, leave those inquiries as they are
+		if count > 0 {
+			update.value, err = wire.SetVarint(iterator.Value(), inquiryFilledReplicasField, count)
+			if err != nil {
+				return fmt.Errorf("failed to encode hosting inquiry %x: %w", iterator.Key(), err)
+			}
+		}
+		update.open = count < replicationRate
+		updates = append(updates, update)
+	}
+
+	// Write once the inquiries have been read, so the store is not written
+	// while iterating
+	for _, update := range updates {
+		if update.value != nil {
+			inquiryStore.Set(update.id, update.value)
+		}
+		if update.open {
+			store.Set(append(append([]byte{}, OpenHostingInquiryKeyPrefix...), update.id...), update.id)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package v5_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
+	v5 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v5"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func setup(t *testing.T) (sdk.Context, *storetypes.KVStoreKey, codec.BinaryCodec, keeper.Keeper) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		nil,
+		nil,
+		nil,
+	)
+	return ctx, storeKey, cdc, k
+}
+
+// setV4 stores a record the way v4 did, without a replica count
+func setV4(ctx sdk.Context, storeKey *storetypes.KVStoreKey, cdc codec.BinaryCodec, prefix []byte, id uint64, record proto.Message) {
+	key := binary.BigEndian.AppendUint64(append([]byte{}, prefix...), id)
+	ctx.KVStore(storeKey).Set(key, cdc.MustMarshal(record))
+}
+
+func TestMigrateStore(t *testing.T) {
+	ctx, storeKey, cdc, k := setup(t)
+
+	setV4(ctx, storeKey, cdc, v5.HostingInquiryKeyPrefix, 0, &types.HostingInquiry{Id: 0, ReplicationRate: 2, EndTime: 100})
+	setV4(ctx, storeKey, cdc, v5.HostingInquiryKeyPrefix, 1, &types.HostingInquiry{Id: 1, ReplicationRate: 1, EndTime: 100})
+	setV4(ctx, storeKey, cdc, v5.HostingInquiryKeyPrefix, 2, &types.HostingInquiry{Id: 2, ReplicationRate: 1, EndTime: 100})
+	setV4(ctx, storeKey, cdc, v5.HostingContractKeyPrefix, 0, &types.HostingContract{Id: 0, InquiryId: 0, Status: types.CONTRACT_STATUS_PENDING})
+	setV4(ctx, storeKey, cdc, v5.HostingContractKeyPrefix, 1, &types.HostingContract{Id: 1, InquiryId: 0, Status: types.CONTRACT_STATUS_TERMINATED})
+	setV4(ctx, storeKey, cdc, v5.HostingContractKeyPrefix, 2, &types.HostingContract{Id: 2, InquiryId: 1, Status: types.CONTRACT_STATUS_ACTIVE})
+
+	require.NoError(t, v5.MigrateStore(ctx, runtime.NewKVStoreService(storeKey)))
+
+	inquiry, found := k.GetHostingInquiry(ctx, 0)
+	require.True(t, found)
+	require.Equal(t, uint64(1), inquiry.FilledReplicas)
+	inquiry, found = k.GetHostingInquiry(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, uint64(1), inquiry.FilledReplicas)
+
+	// Inquiry 1 holds all of its replicas
+	var open []uint64
+	for _, inquiry := range k.GetOpenHostingInquiries(ctx) {
+		open = append(open, inquiry.Id)
+	}
+	require.Equal(t, []uint64{0, 2}, open)
+}
+
+func TestMigrateStoreRejectsCorruptRecords(t *testing.T) {
+	ctx, storeKey, _, _ := setup(t)
+	key := binary.BigEndian.AppendUint64(append([]byte{}, v5.HostingContractKeyPrefix...), 0)
+	ctx.KVStore(storeKey).Set(key, []byte{0xff})
+
+	require.Error(t, v5.MigrateStore(ctx, runtime.NewKVStoreService(storeKey)))
+}
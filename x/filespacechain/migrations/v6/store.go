@@ -0,0 +1,126 @@
+package v6
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/migrations/internal/wire"
+)
+
+var (
+	// FileEntryKeyPrefix, HostingInquiryKeyPrefix and HostingContractKeyPrefix
+	// are the raw prefixes records are stored under in v5 and v6
+	FileEntryKeyPrefix       = []byte("FileEntry/value/FileEntry/value//")
+	HostingInquiryKeyPrefix  = []byte("HostingInquiry/value/HostingInquiry/value//")
+	HostingContractKeyPrefix = []byte("HostingContract/value/HostingContract/value//")
+)
+
+// Field numbers of the v6 records the migration reads or writes
+const (
+	fileEntryFileSizeField protowire.Number = 6
+
+	inquiryFileEntryIdField protowire.Number = 8
+
+	contractInquiryIdField        protowire.Number = 2
+	contractStatusField           protowire.Number = 8
+	contractReservedCapacityField protowire.Number = 13
+)
+
+// Contract statuses that hold a replica
+const (
+	contractStatusPending = 0
+	contractStatusActive  = 1
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6. Every
+// PENDING and ACTIVE contract reserves the size of its inquiry's file, so
+// that it is released from the provider's used capacity when the contract
+// ends. No provider has a profile yet; profiles registered later count the
+// reserved capacity of the provider's running contracts.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService) error {
+	store := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	fileSizes, err := readVarints(store, FileEntryKeyPrefix, fileEntryFileSizeField, "file entry")
+	if err != nil {
+		return err
+	}
+	inquiryFiles, err := readVarints(store, HostingInquiryKeyPrefix, inquiryFileEntryIdField, "hosting inquiry")
+	if err != nil {
+		return err
+	}
+	return setReservedCapacity(store, func(inquiryId uint64) uint64 {
+		fileEntryId, found := inquiryFiles[inquiryId]
+		if !found {
+			return 0
+		}
+		return fileSizes[fileEntryId]
+	})
+}
+
+// readVarints maps the id of every record under prefix, its big-endian key,
+// to the value of one of its varint fields
+func readVarints(store storetypes.KVStore, keyPrefix []byte, num protowire.Number, name string) (map[uint64]uint64, error) {
+	iterator := storetypes.KVStorePrefixIterator(prefix.NewStore(store, keyPrefix), []byte{})
+	defer iterator.Close()
+
+	values := make(map[uint64]uint64)
+	for ; iterator.Valid(); iterator.Next() {
+		value, _, err := wire.Varint(iterator.Value(), num)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s %x: %w", name, iterator.Key(), err)
+		}
+		values[binary.BigEndian.Uint64(iterator.Key())] = value
+	}
+	return values, nil
+}
+
+// contractUpdate is the re-encoded value of a contract stored under its key
+type contractUpdate struct {
+	key   []byte
+	value []byte
+}
+
+func setReservedCapacity(store storetypes.KVStore, fileSize func(inquiryId uint64) uint64) error {
+	contractStore := prefix.NewStore(store, HostingContractKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(contractStore, []byte{})
+	defer iterator.Close()
+
+	var updates []contractUpdate
+	for ; iterator.Valid(); iterator.Next() {
+		status, _, err := wire.Varint(iterator.Value(), contractStatusField)
+		if err != nil {
+			return fmt.Errorf("failed to decode hosting contract %x: %w", iterator.Key(), err)
+		}
+		if status != contractStatusPending && status != contractStatusActive {
+			continue
+		}
+		inquiryId, _, err := wire.Varint(iterator.Value(), contractInquiryIdField)
+		if err != nil {
+			return fmt.Errorf("failed to decode hosting contract %x: %w", iterator.Key(), err)
+		}
+		// Zero is the encoding of an unset field, leave those contracts as they are
+		size := fileSize(inquiryId)
+		if size == 0 {
+			continue
+		}
+		value, err := wire.SetVarint(iterator.Value(), contractReservedCapacityField, size)
+		if err != nil {
+			return fmt.Errorf("failed to encode hosting contract %x: %w", iterator.Key(), err)
+		}
+		updates = append(updates, contractUpdate{key: iterator.Key(), value: value})
+	}
+
+	// Write once the contracts have been read, so the store is not written
+	// while iterating
+	for _, update := range updates {
+		contractStore.Set(update.key, update.value)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package v6_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
+	v6 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v6"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func setup(t *testing.T) (sdk.Context, *storetypes.KVStoreKey, codec.BinaryCodec, keeper.Keeper) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		nil,
+		nil,
+		nil,
+	)
+	return ctx, storeKey, cdc, k
+}
+
+// setV5 stores a record the way v5 did, without reserved capacity
+func setV5(ctx sdk.Context, storeKey *storetypes.KVStoreKey, cdc codec.BinaryCodec, prefix []byte, id uint64, record proto.Message) {
+	key := binary.BigEndian.AppendUint64(append([]byte{}, prefix...), id)
+	ctx.KVStore(storeKey).Set(key, cdc.MustMarshal(record))
+}
+
+func TestMigrateStore(t *testing.T) {
+	ctx, storeKey, cdc, k := setup(t)
+
+	setV5(ctx, storeKey, cdc, v6.FileEntryKeyPrefix, 0, &types.FileEntry{Id: 0, FileSize: 300})
+	setV5(ctx, storeKey, cdc, v6.FileEntryKeyPrefix, 1, &types.FileEntry{Id: 1, FileSize: 500})
+	setV5(ctx, storeKey, cdc, v6.HostingInquiryKeyPrefix, 0, &types.HostingInquiry{Id: 0, FileEntryId: 1})
+	setV5(ctx, storeKey, cdc, v6.HostingInquiryKeyPrefix, 1, &types.HostingInquiry{Id: 1, FileEntryId: 0})
+	setV5(ctx, storeKey, cdc, v6.HostingContractKeyPrefix, 0, &types.HostingContract{Id: 0, InquiryId: 0, Status: types.CONTRACT_STATUS_PENDING})
+	setV5(ctx, storeKey, cdc, v6.HostingContractKeyPrefix, 1, &types.HostingContract{Id: 1, InquiryId: 1, Status: types.CONTRACT_STATUS_ACTIVE})
+	setV5(ctx, storeKey, cdc, v6.HostingContractKeyPrefix, 2, &types.HostingContract{Id: 2, InquiryId: 1, Status: types.CONTRACT_STATUS_COMPLETED})
+	setV5(ctx, storeKey, cdc, v6.HostingContractKeyPrefix, 3, &types.HostingContract{Id: 3, InquiryId: 7, Status: types.CONTRACT_STATUS_ACTIVE})
+
+	require.NoError(t, v6.MigrateStore(ctx, runtime.NewKVStoreService(storeKey)))
+
+	// Final contracts and contracts whose inquiry is gone reserve nothing
+	for id, reserved := range map[uint64]uint64{0: 500, 1: 300, 2: 0, 3: 0} {
+		contract, found := k.GetHostingContract(ctx, id)
+		require.True(t, found)
+		require.Equal(t, reserved, contract.ReservedCapacity, "contract %d", id)
+	}
+}
+
+func TestMigrateStoreRejectsCorruptRecords(t *testing.T) {
+	ctx, storeKey, _, _ := setup(t)
+	key := binary.BigEndian.AppendUint64(append([]byte{}, v6.FileEntryKeyPrefix...), 0)
+	ctx.KVStore(storeKey).Set(key, []byte{0xff})
+
+	require.Error(t, v6.MigrateStore(ctx, runtime.NewKVStoreService(storeKey)))
+}
@@ -0,0 +1,49 @@
+package v7
+
+import (
+	"context"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/migrations/internal/wire"
+)
+
+// ParamsKey is the raw key params are stored under
+var ParamsKey = []byte("p_filespacechain")
+
+// Field numbers of the v7 params the migration writes
+const (
+	paramsReputationWeightField   protowire.Number = 12
+	paramsReputationHalfLifeField protowire.Number = 13
+)
+
+// MigrateStore performs in-place store migrations from v6 to v7. It sets the
+// reputation weight and half-life params added in v7 to their defaults.
+// Providers start without a reputation record and are scored as neutral
+// until their first contract outcome.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService) error {
+	store := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	bz := store.Get(ParamsKey)
+	if bz == nil {
+		return nil
+	}
+
+	reputationWeight, err := math.LegacyNewDecWithPrec(5, 1).Marshal()
+	if err != nil {
+		return err
+	}
+	if bz, err = wire.SetBytes(bz, paramsReputationWeightField, reputationWeight); err != nil {
+		return fmt.Errorf("failed to encode params: %w", err)
+	}
+	if bz, err = wire.SetVarint(bz, paramsReputationHalfLifeField, 100800); err != nil {
+		return fmt.Errorf("failed to encode params: %w", err)
+	}
+
+	store.Set(ParamsKey, bz)
+	return nil
+}
@@ -0,0 +1,49 @@
+package v7_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
+	v7 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v7"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		nil,
+		nil,
+		nil,
+	)
+
+	// v6 params have no reputation weight or half-life
+	v6Params := types.DefaultParams()
+	v6Params.ReputationWeight = math.LegacyDec{}
+	v6Params.ReputationHalfLife = 0
+	bz, err := cdc.Marshal(&v6Params)
+	require.NoError(t, err)
+	ctx.KVStore(storeKey).Set(v7.ParamsKey, bz)
+
+	require.NoError(t, v7.MigrateStore(ctx, runtime.NewKVStoreService(storeKey)))
+
+	params := k.GetParams(ctx)
+	require.Equal(t, types.DefaultParams(), params)
+	require.NoError(t, params.Validate())
+}
@@ -0,0 +1,66 @@
+package v8
+
+import (
+	"context"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/migrations/internal/wire"
+)
+
+// ParamsKey is the raw key params are stored under
+var ParamsKey = []byte("p_filespacechain")
+
+// LegacyPaymentDenom is the denom v7 and earlier priced and escrowed storage in
+const LegacyPaymentDenom = "token"
+
+// Field numbers of the v7 and v8 params the migration reads or writes
+const (
+	paramsBasePricePerBytePerBlockField protowire.Number = 1
+	paramsAcceptedDenomsField           protowire.Number = 15
+
+	paymentDenomDenomField                    protowire.Number = 1
+	paymentDenomBasePricePerBytePerBlockField protowire.Number = 2
+)
+
+// MigrateStore performs in-place store migrations from v7 to v8. The single
+// base price of v7 becomes the price of the one accepted payment denom,
+// the denom v7 computed every escrow in.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService) error {
+	store := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	bz := store.Get(ParamsKey)
+	if bz == nil {
+		return nil
+	}
+
+	basePrice, found, err := wire.Bytes(bz, paramsBasePricePerBytePerBlockField)
+	if err != nil {
+		return fmt.Errorf("failed to decode params: %w", err)
+	}
+	if !found {
+		if basePrice, err = math.LegacyNewDecWithPrec(1, 12).Marshal(); err != nil {
+			return err
+		}
+	}
+
+	var paymentDenom []byte
+	paymentDenom = protowire.AppendTag(paymentDenom, paymentDenomDenomField, protowire.BytesType)
+	paymentDenom = protowire.AppendString(paymentDenom, LegacyPaymentDenom)
+	paymentDenom = protowire.AppendTag(paymentDenom, paymentDenomBasePricePerBytePerBlockField, protowire.BytesType)
+	paymentDenom = protowire.AppendBytes(paymentDenom, basePrice)
+
+	if bz, err = wire.SetBytes(bz, paramsAcceptedDenomsField, paymentDenom); err != nil {
+		return fmt.Errorf("failed to encode params: %w", err)
+	}
+	if bz, err = wire.Clear(bz, paramsBasePricePerBytePerBlockField); err != nil {
+		return fmt.Errorf("failed to encode params: %w", err)
+	}
+
+	store.Set(ParamsKey, bz)
+	return nil
+}
@@ -0,0 +1,59 @@
+package v8_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/keeper"
+	"github.com/hanshq/filespace-chain/x/filespacechain/migrations/internal/wire"
+	v8 "github.com/hanshq/filespace-chain/x/filespacechain/migrations/v8"
+	"github.com/hanshq/filespace-chain/x/filespacechain/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		nil,
+		nil,
+		nil,
+	)
+
+	// v7 params hold a single base price and no accepted payment denoms
+	v7Params := types.DefaultParams()
+	v7Params.AcceptedDenoms = nil
+	bz, err := cdc.Marshal(&v7Params)
+	require.NoError(t, err)
+	basePrice, err := math.LegacyNewDecWithPrec(3, 9).Marshal()
+	require.NoError(t, err)
+	bz, err = wire.SetBytes(bz, 1, basePrice)
+	require.NoError(t, err)
+	ctx.KVStore(storeKey).Set(v8.ParamsKey, bz)
+
+	require.NoError(t, v8.MigrateStore(ctx, runtime.NewKVStoreService(storeKey)))
+
+	params := k.GetParams(ctx)
+	require.Equal(t, []types.PaymentDenom{
+		{Denom: v8.LegacyPaymentDenom, BasePricePerBytePerBlock: math.LegacyNewDecWithPrec(3, 9)},
+	}, params.AcceptedDenoms)
+	require.NoError(t, params.Validate())
+
+	_, found, err := wire.Bytes(ctx.KVStore(storeKey).Get(v8.ParamsKey), 1)
+	require.NoError(t, err)
+	require.False(t, found)
+}
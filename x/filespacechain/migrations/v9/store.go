@@ -0,0 +1,42 @@
+package v9
+
+import (
+	"context"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/migrations/internal/wire"
+)
+
+// ParamsKey is the raw key params are stored under
+var ParamsKey = []byte("p_filespacechain")
+
+// Field number of the v9 param the migration writes
+const paramsCompletionBonusFractionField protowire.Number = 16
+
+// MigrateStore performs in-place store migrations from v8 to v9. It sets the
+// completion bonus fraction added in v9 to the half of a contract's payment
+// v8 held back until completion.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService) error {
+	store := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	bz := store.Get(ParamsKey)
+	if bz == nil {
+		return nil
+	}
+
+	completionBonusFraction, err := math.LegacyNewDecWithPrec(5, 1).Marshal()
+	if err != nil {
+		return err
+	}
+	if bz, err = wire.SetBytes(bz, paramsCompletionBonusFractionField, completionBonusFraction); err != nil {
+		return fmt.Errorf("failed to encode params: %w", err)
+	}
+
+	store.Set(ParamsKey, bz)
+	return nil
+}
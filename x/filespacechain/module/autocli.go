@@ -67,6 +67,101 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query list-hosting-contract-from",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
 				},
+				{
+					RpcMethod: "OpenInquiries",
+					Use:       "open-inquiries",
+					Short:     "List the inquiries that still have unfilled replicas",
+				},
+				{
+					RpcMethod:      "Auction",
+					Use:            "show-auction [inquiry-id]",
+					Short:          "Shows the auction of an inquiry and its bids",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "inquiryId"}},
+				},
+				{
+					RpcMethod: "ProviderProfileAll",
+					Use:       "list-provider-profile",
+					Short:     "List all ProviderProfile",
+				},
+				{
+					RpcMethod:      "ProviderProfile",
+					Use:            "show-provider-profile [provider]",
+					Short:          "Shows the ProviderProfile of a provider",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "provider"}},
+				},
+				{
+					RpcMethod: "ProviderReputationAll",
+					Use:       "list-provider-reputation",
+					Short:     "List all ProviderReputation",
+				},
+				{
+					RpcMethod:      "ProviderReputation",
+					Use:            "show-provider-reputation [provider]",
+					Short:          "Shows the reputation of a provider decayed to the current block",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "provider"}},
+				},
+				{
+					RpcMethod: "SystemStatistics",
+					Use:       "system-statistics",
+					Short:     "Shows the number of records of each kind and of active and expired contracts",
+				},
+				{
+					RpcMethod:      "ContractDetails",
+					Use:            "contract-details [contract-id]",
+					Short:          "Shows a contract with its inquiry, offer, payment history and escrow record",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contractId"}},
+				},
+				{
+					RpcMethod:      "ProviderPerformance",
+					Use:            "provider-performance [provider]",
+					Short:          "Shows the contract, earnings and reputation figures of a provider",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "provider"}},
+				},
+				{
+					RpcMethod: "PaymentAnalytics",
+					Use:       "payment-analytics",
+					Short:     "Shows the amounts paid per denom and the number of settled and running payment histories",
+				},
+				{
+					RpcMethod:      "PaymentDistribution",
+					Use:            "payment-distribution [denom]",
+					Short:          "Shows the spread of the amounts paid per contract in a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "ProviderPaymentSummary",
+					Use:       "provider-payment-summary",
+					Short:     "List the payments received by each staked provider",
+				},
+				{
+					RpcMethod:      "PaymentTrends",
+					Use:            "payment-trends [block-window]",
+					Short:          "Shows the provider payments released per window of blocks",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "blockWindow"}},
+				},
+				{
+					RpcMethod: "UnpaidContracts",
+					Use:       "unpaid-contracts",
+					Short:     "List the started contracts that have no payment history",
+				},
+				{
+					RpcMethod:      "ProviderPayments",
+					Use:            "provider-payments [provider]",
+					Short:          "List the payments released to a provider",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "provider"}},
+				},
+				{
+					RpcMethod:      "ContractProviderPayments",
+					Use:            "contract-provider-payments [contract-id]",
+					Short:          "List the payments released to the providers of a contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contractId"}},
+				},
+				{
+					RpcMethod:      "ProviderPaymentsByHeight",
+					Use:            "provider-payments-by-height [start-height] [end-height]",
+					Short:          "List the payments released to providers within a range of blocks",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "startHeight"}, {ProtoField: "endHeight"}},
+				},
 
 				// this line is used by ignite scaffolding # autocli/query
 			},
@@ -79,6 +174,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "ReassignHostingContract",
+					Skip:      true, // skipped because authority gated
+				},
 				{
 					RpcMethod:      "CreateFileEntry",
 					Use:            "create-file-entry [cid] [rootCid] [parentCid] [metaData] [fileSize]",
@@ -105,9 +204,9 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod:      "UpdateHostingInquiry",
-					Use:            "update-hosting-inquiry [id] [fileEntryCid] [replicationRate] [escrowAmount] [endTime]",
-					Short:          "Update HostingInquiry",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "fileEntryCid"}, {ProtoField: "replicationRate"}, {ProtoField: "escrowAmount"}, {ProtoField: "endTime"}},
+					Use:            "update-hosting-inquiry [id] [replicationRate]",
+					Short:          "Change the replication rate of a HostingInquiry, escrowing or refunding the difference",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "replicationRate"}},
 				},
 				{
 					RpcMethod:      "DeleteHostingInquiry",
@@ -115,24 +214,36 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Delete HostingInquiry",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
 				},
+				{
+					RpcMethod:      "ExtendHostingInquiry",
+					Use:            "extend-hosting-inquiry [id] [endTime] [additionalEscrow]",
+					Short:          "Top up the escrow of a running HostingInquiry and push out its end time",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "endTime"}, {ProtoField: "additionalEscrow"}},
+				},
 				{
 					RpcMethod:      "CreateHostingContract",
 					Use:            "create-hosting-contract [inquiryId] [offerId]",
-					Short:          "Create HostingContract",
+					Short:          "Take an open replica of a HostingInquiry with one of your HostingOffers",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "inquiryId"}, {ProtoField: "offerId"}},
 				},
 				{
 					RpcMethod:      "UpdateHostingContract",
-					Use:            "update-hosting-contract [id] [inquiryId] [offerId]",
-					Short:          "Update HostingContract",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "inquiryId"}, {ProtoField: "offerId"}},
+					Use:            "update-hosting-contract [id] [offerId]",
+					Short:          "Propose handing your HostingContract over to the provider of another offer; offer 0 withdraws the proposal",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "offerId"}},
 				},
 				{
 					RpcMethod:      "DeleteHostingContract",
 					Use:            "delete-hosting-contract [id]",
-					Short:          "Delete HostingContract",
+					Short:          "Delete a HostingContract its provider has not accepted yet",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
 				},
+				{
+					RpcMethod:      "TerminateHostingContract",
+					Use:            "terminate-hosting-contract [contractId] [reason]",
+					Short:          "Terminate an active HostingContract early and settle it pro rata",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contractId"}, {ProtoField: "reason", Optional: true}},
+				},
 				{
 					RpcMethod:      "CreateHostingOffer",
 					Use:            "create-hosting-offer [region] [pricePerBlock]",
@@ -151,6 +262,18 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Delete HostingOffer",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
 				},
+				{
+					RpcMethod:      "RegisterProviderProfile",
+					Use:            "register-provider-profile [moniker] [totalCapacity]",
+					Short:          "Declare your capacity, regions (--regions) and endpoints (--endpoints) as a provider",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "moniker"}, {ProtoField: "totalCapacity"}},
+				},
+				{
+					RpcMethod:      "UpdateProviderProfile",
+					Use:            "update-provider-profile [moniker] [totalCapacity]",
+					Short:          "Update your ProviderProfile",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "moniker"}, {ProtoField: "totalCapacity"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -8,6 +8,8 @@ import (
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
+// It panics if the module account or the hosting bonded pool do not hold
+// exactly the escrow and stake recorded in the genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	// Set all the fileEntry
 	for _, elem := range genState.FileEntryList {
@@ -37,8 +39,68 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	// Set hostingOffer count
 	k.SetHostingOfferCount(ctx, genState.HostingOfferCount)
+	// Set all the storageChallenge
+	for _, elem := range genState.StorageChallengeList {
+		k.SetStorageChallenge(ctx, elem)
+	}
+
+	// Set storageChallenge count
+	k.SetStorageChallengeCount(ctx, genState.StorageChallengeCount)
+	// Set all the slashEvent
+	for _, elem := range genState.SlashEventList {
+		k.SetSlashEvent(ctx, elem)
+	}
+
+	// Set slashEvent count
+	k.SetSlashEventCount(ctx, genState.SlashEventCount)
+	// Set all the escrowRecord
+	for _, elem := range genState.EscrowRecordList {
+		k.SetEscrowRecord(ctx, elem.InquiryId, elem.Amount, elem.Creator)
+	}
+	// Set all the providerStake
+	for _, elem := range genState.ProviderStakeList {
+		k.SetProviderStake(ctx, elem.Provider, elem.Amount, elem.Height)
+	}
+	// Set all the paymentHistory
+	for _, elem := range genState.PaymentHistoryList {
+		k.SetPaymentHistory(ctx, elem)
+	}
+	// Set all the unbondingEntry
+	for _, elem := range genState.UnbondingEntryList {
+		k.SetUnbondingEntry(ctx, elem)
+	}
+
+	// Set unbondingEntry count
+	k.SetUnbondingEntryCount(ctx, genState.UnbondingEntryCount)
+	// Set all the auction
+	for _, elem := range genState.AuctionList {
+		k.SetAuction(ctx, elem)
+	}
+	// Set all the auctionBid
+	for _, elem := range genState.AuctionBidList {
+		k.SetAuctionBid(ctx, elem)
+	}
+	// Set all the providerProfile
+	for _, elem := range genState.ProviderProfileList {
+		k.SetProviderProfile(ctx, elem)
+	}
+	// Set all the providerReputation
+	for _, elem := range genState.ProviderReputationList {
+		k.SetProviderReputation(ctx, elem)
+	}
+	// Set all the providerPayment
+	for _, elem := range genState.ProviderPaymentList {
+		k.SetProviderPayment(ctx, elem)
+	}
+
+	// Set providerPayment count
+	k.SetProviderPaymentCount(ctx, genState.ProviderPaymentCount)
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
+
+	if err := genState.ValidateBalances(k.GetEscrowBalance(ctx), k.GetBondedPoolBalance(ctx)); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -54,6 +116,21 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.HostingContractCount = k.GetHostingContractCount(ctx)
 	genesis.HostingOfferList = k.GetAllHostingOffer(ctx)
 	genesis.HostingOfferCount = k.GetHostingOfferCount(ctx)
+	genesis.StorageChallengeList = k.GetAllStorageChallenge(ctx)
+	genesis.StorageChallengeCount = k.GetStorageChallengeCount(ctx)
+	genesis.SlashEventList = k.GetAllSlashEvent(ctx)
+	genesis.SlashEventCount = k.GetSlashEventCount(ctx)
+	genesis.EscrowRecordList = k.GetAllEscrowRecords(ctx)
+	genesis.ProviderStakeList = k.GetAllProviderStakes(ctx)
+	genesis.PaymentHistoryList = k.GetAllPaymentHistory(ctx)
+	genesis.UnbondingEntryList = k.GetAllUnbondingEntry(ctx)
+	genesis.UnbondingEntryCount = k.GetUnbondingEntryCount(ctx)
+	genesis.AuctionList = k.GetAllAuction(ctx)
+	genesis.AuctionBidList = k.GetAllAuctionBid(ctx)
+	genesis.ProviderProfileList = k.GetAllProviderProfile(ctx)
+	genesis.ProviderReputationList = k.GetAllProviderReputation(ctx)
+	genesis.ProviderPaymentList = k.GetAllProviderPayment(ctx)
+	genesis.ProviderPaymentCount = k.GetProviderPaymentCount(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -3,6 +3,9 @@ package filespacechain_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	keepertest "github.com/hanshq/filespace-chain/testutil/keeper"
 	"github.com/hanshq/filespace-chain/testutil/nullify"
 	"github.com/hanshq/filespace-chain/x/filespacechain/module"
@@ -50,10 +53,135 @@ func TestGenesis(t *testing.T) {
 			},
 		},
 		HostingOfferCount: 2,
+		StorageChallengeList: []types.StorageChallenge{
+			{
+				Id: 0,
+			},
+			{
+				Id: 1,
+			},
+		},
+		StorageChallengeCount: 2,
+		SlashEventList: []types.SlashEvent{
+			{
+				Id: 0,
+			},
+			{
+				Id: 1,
+			},
+		},
+		SlashEventCount: 2,
+		EscrowRecordList: []types.EscrowRecord{
+			{
+				InquiryId: 0,
+				Amount:    sdk.NewCoin("token", math.NewInt(1000)),
+			},
+			{
+				InquiryId: 1,
+				Amount:    sdk.NewCoin("token", math.NewInt(500)),
+			},
+		},
+		ProviderStakeList: []types.ProviderStake{
+			{
+				Provider: "provider0",
+				Amount:   sdk.NewCoin("token", math.NewInt(3000000)),
+			},
+			{
+				Provider: "provider1",
+				Amount:   sdk.NewCoin("token", math.NewInt(2000000)),
+			},
+		},
+		PaymentHistoryList: []types.PaymentHistory{
+			{
+				ContractId: 0,
+				TotalPaid:  sdk.NewCoin("token", math.NewInt(200)),
+			},
+			{
+				ContractId: 1,
+				TotalPaid:  sdk.NewCoin("token", math.NewInt(100)),
+			},
+		},
+		UnbondingEntryList: []types.UnbondingEntry{
+			{
+				Id:     0,
+				Amount: sdk.NewCoin("token", math.NewInt(1000000)),
+			},
+			{
+				Id:     1,
+				Amount: sdk.NewCoin("token", math.NewInt(500000)),
+			},
+		},
+		UnbondingEntryCount: 2,
+		AuctionList: []types.Auction{
+			{
+				InquiryId: 0,
+				CommitEnd: 10,
+				RevealEnd: 20,
+			},
+		},
+		AuctionBidList: []types.AuctionBid{
+			{
+				InquiryId: 0,
+				Provider:  "provider0",
+			},
+			{
+				InquiryId: 0,
+				Provider:  "provider1",
+			},
+		},
+		ProviderProfileList: []types.ProviderProfile{
+			{
+				Provider:      "provider0",
+				TotalCapacity: 1000,
+				UsedCapacity:  100,
+			},
+			{
+				Provider:      "provider1",
+				TotalCapacity: 2000,
+			},
+		},
+		ProviderReputationList: []types.ProviderReputation{
+			{
+				Provider:           "provider0",
+				Score:              6000,
+				LastUpdated:        10,
+				CompletedContracts: 2,
+			},
+			{
+				Provider:     "provider1",
+				Score:        4000,
+				LastUpdated:  20,
+				FailedProofs: 1,
+			},
+		},
+		ProviderPaymentList: []types.ProviderPayment{
+			{
+				Id:         0,
+				Provider:   "provider0",
+				ContractId: 0,
+				Height:     10,
+				Amount:     sdk.NewCoin("token", math.NewInt(100)),
+				Kind:       types.PROVIDER_PAYMENT_KIND_PERIODIC,
+			},
+			{
+				Id:         1,
+				Provider:   "provider1",
+				ContractId: 1,
+				Height:     20,
+				Amount:     sdk.NewCoin("token", math.NewInt(400)),
+				Kind:       types.PROVIDER_PAYMENT_KIND_COMPLETION_BONUS,
+			},
+		},
+		ProviderPaymentCount: 2,
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
-	k, ctx := keepertest.FilespacechainKeeper(t)
+	// Both contracts belong to inquiry 0, so 1,200 token of escrow is left
+	bank := keepertest.NewMockBankKeeper()
+	bank.FundModule(types.ModuleName, sdk.NewCoins(sdk.NewCoin("token", math.NewInt(1200))))
+	bank.FundModule("hosting_bonded_pool", sdk.NewCoins(sdk.NewCoin("token", math.NewInt(6500000))))
+
+	k, ctx := keepertest.FilespacechainKeeperWithBank(t, bank)
 	filespacechain.InitGenesis(ctx, k, genesisState)
 	got := filespacechain.ExportGenesis(ctx, k)
 	require.NotNil(t, got)
@@ -69,5 +197,36 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.HostingContractCount, got.HostingContractCount)
 	require.ElementsMatch(t, genesisState.HostingOfferList, got.HostingOfferList)
 	require.Equal(t, genesisState.HostingOfferCount, got.HostingOfferCount)
+	require.ElementsMatch(t, genesisState.StorageChallengeList, got.StorageChallengeList)
+	require.Equal(t, genesisState.StorageChallengeCount, got.StorageChallengeCount)
+	require.ElementsMatch(t, genesisState.SlashEventList, got.SlashEventList)
+	require.Equal(t, genesisState.SlashEventCount, got.SlashEventCount)
+	require.ElementsMatch(t, genesisState.EscrowRecordList, got.EscrowRecordList)
+	require.ElementsMatch(t, genesisState.ProviderStakeList, got.ProviderStakeList)
+	require.ElementsMatch(t, genesisState.PaymentHistoryList, got.PaymentHistoryList)
+	require.ElementsMatch(t, genesisState.UnbondingEntryList, got.UnbondingEntryList)
+	require.Equal(t, genesisState.UnbondingEntryCount, got.UnbondingEntryCount)
+	require.ElementsMatch(t, genesisState.AuctionList, got.AuctionList)
+	require.ElementsMatch(t, genesisState.AuctionBidList, got.AuctionBidList)
+	require.ElementsMatch(t, genesisState.ProviderProfileList, got.ProviderProfileList)
+	require.ElementsMatch(t, genesisState.ProviderReputationList, got.ProviderReputationList)
+	require.ElementsMatch(t, genesisState.ProviderPaymentList, got.ProviderPaymentList)
+	require.Equal(t, genesisState.ProviderPaymentCount, got.ProviderPaymentCount)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
+
+func TestGenesisRejectsUnbackedEscrow(t *testing.T) {
+	genesisState := *types.DefaultGenesis()
+	genesisState.EscrowRecordList = []types.EscrowRecord{
+		{
+			InquiryId: 0,
+			Amount:    sdk.NewCoin("token", math.NewInt(1000)),
+		},
+	}
+
+	bank := keepertest.NewMockBankKeeper()
+	bank.FundModule(types.ModuleName, sdk.NewCoins(sdk.NewCoin("token", math.NewInt(999))))
+
+	k, ctx := keepertest.FilespacechainKeeperWithBank(t, bank)
+	require.Panics(t, func() { filespacechain.InitGenesis(ctx, k, genesisState) })
+}
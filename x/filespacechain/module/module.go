@@ -118,10 +118,53 @@ func NewAppModule(
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 5 to 6: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 6 to 7: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 7, m.Migrate7to8); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 7 to 8: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 8, m.Migrate8to9); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 8 to 9: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 9, m.Migrate9to10); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 9 to 10: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 10, m.Migrate10to11); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 10 to 11: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 11, m.Migrate11to12); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 11 to 12: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 12, m.Migrate12to13); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 12 to 13: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 13, m.Migrate13to14); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 13 to 14: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the module's genesis initialization. It returns no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) {
@@ -141,13 +184,31 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion is a sequence number for state-breaking change of the module.
 // It should be incremented on each consensus-breaking change introduced by the module.
 // To avoid wrong/empty versions, the initial version should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 14 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
 // The begin block implementation is optional.
 func (am AppModule) BeginBlock(ctx context.Context) error {
+	// Hand slots of contracts that were not accepted in time to the next-cheapest offer
+	err := am.keeper.ProcessAcceptanceDeadlines(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Open contracts for the winning bids of auctions whose reveal window closed
+	err = am.keeper.ProcessAuctions(ctx)
+	if err != nil {
+		return err
+	}
+	
+	// Fail missed storage challenges and issue new ones before paying providers
+	err = am.keeper.ProcessStorageChallenges(ctx)
+	if err != nil {
+		return err
+	}
+	
 	// Process periodic payments for active hosting contracts
-	err := am.keeper.ProcessPeriodicPayments(ctx)
+	err = am.keeper.ProcessPeriodicPayments(ctx)
 	if err != nil {
 		return err
 	}
@@ -158,14 +219,11 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 		return err
 	}
 	
-	// Run cleanup operations every 1000 blocks (approximately every hour)
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	if sdkCtx.BlockHeight()%1000 == 0 {
-		err = am.keeper.PerformMaintenanceCleanup(ctx)
-		if err != nil {
-			// Log error but don't fail the block
-			am.keeper.Logger().Error("maintenance cleanup failed", "error", err)
-		}
+	// Refund expired inquiries and prune old payment history from their queues
+	err = am.keeper.PerformMaintenanceCleanup(ctx)
+	if err != nil {
+		// Log error but don't fail the block
+		am.keeper.Logger().Error("maintenance cleanup failed", "error", err)
 	}
 	
 	return nil
@@ -173,7 +231,12 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 
 // EndBlock contains the logic that is automatically triggered at the end of each block.
 // The end block implementation is optional.
-func (am AppModule) EndBlock(_ context.Context) error {
+func (am AppModule) EndBlock(ctx context.Context) error {
+	// Return provider stake whose unbonding period has elapsed
+	if err := am.keeper.CompleteMatureUnbondings(ctx); err != nil {
+		am.keeper.Logger().Error("failed to complete mature unbondings", "error", err)
+	}
+
 	return nil
 }
 
@@ -204,6 +267,7 @@ type ModuleInputs struct {
 
 	AccountKeeper types.AccountKeeper
 	BankKeeper    types.BankKeeper
+	DistrKeeper   types.DistributionKeeper
 }
 
 type ModuleOutputs struct {
@@ -226,6 +290,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		authority.String(),
 		in.AccountKeeper,
 		in.BankKeeper,
+		in.DistrKeeper,
 	)
 	m := NewAppModule(
 		in.Cdc,
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MinBidSaltLength is the shortest salt a bid commitment may use, so the
+// price of a bid cannot be found by hashing every likely price
+const MinBidSaltLength = 16
+
+// BidCommitment returns the commitment a provider sends with MsgCommitBid for
+// a price. It binds the provider and the inquiry, so a commitment can neither
+// be copied by another provider nor replayed in another auction.
+func BidCommitment(provider string, inquiryId uint64, price sdk.Coin, salt []byte) []byte {
+	h := sha256.New()
+	for _, field := range [][]byte{[]byte(provider), binary.BigEndian.AppendUint64(nil, inquiryId), []byte(price.String()), salt} {
+		h.Write(binary.BigEndian.AppendUint64(nil, uint64(len(field))))
+		h.Write(field)
+	}
+	return h.Sum(nil)
+}
+
+// IsCommitPhase reports whether bids can be committed at height
+func (a Auction) IsCommitPhase(height uint64) bool {
+	return height <= a.CommitEnd
+}
+
+// IsRevealPhase reports whether bids can be revealed at height
+func (a Auction) IsRevealPhase(height uint64) bool {
+	return height > a.CommitEnd && height <= a.RevealEnd
+}
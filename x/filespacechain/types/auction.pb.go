@@ -0,0 +1,912 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: filespacechain/filespacechain/auction.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Auction is the sealed-bid reverse auction of an inquiry. Providers commit
+// to a hashed price until commitEnd and reveal it until revealEnd; the
+// auction then settles at a uniform clearing price.
+type Auction struct {
+	InquiryId uint64 `protobuf:"varint,1,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	// Last block at which bids can be committed
+	CommitEnd uint64 `protobuf:"varint,2,opt,name=commitEnd,proto3" json:"commitEnd,omitempty"`
+	// Last block at which committed bids can be revealed
+	RevealEnd uint64 `protobuf:"varint,3,opt,name=revealEnd,proto3" json:"revealEnd,omitempty"`
+	Settled   bool   `protobuf:"varint,4,opt,name=settled,proto3" json:"settled,omitempty"`
+	// Price per block every winning provider is paid, set on settlement
+	ClearingPrice types.Coin `protobuf:"bytes,5,opt,name=clearingPrice,proto3" json:"clearingPrice"`
+}
+
+func (m *Auction) Reset()         { *m = Auction{} }
+func (m *Auction) String() string { return proto.CompactTextString(m) }
+func (*Auction) ProtoMessage()    {}
+func (*Auction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e39a9184392c4e, []int{0}
+}
+func (m *Auction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Auction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Auction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Auction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Auction.Merge(m, src)
+}
+func (m *Auction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Auction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Auction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Auction proto.InternalMessageInfo
+
+func (m *Auction) GetInquiryId() uint64 {
+	if m != nil {
+		return m.InquiryId
+	}
+	return 0
+}
+
+func (m *Auction) GetCommitEnd() uint64 {
+	if m != nil {
+		return m.CommitEnd
+	}
+	return 0
+}
+
+func (m *Auction) GetRevealEnd() uint64 {
+	if m != nil {
+		return m.RevealEnd
+	}
+	return 0
+}
+
+func (m *Auction) GetSettled() bool {
+	if m != nil {
+		return m.Settled
+	}
+	return false
+}
+
+func (m *Auction) GetClearingPrice() types.Coin {
+	if m != nil {
+		return m.ClearingPrice
+	}
+	return types.Coin{}
+}
+
+// AuctionBid is the bid of a provider in the auction of an inquiry, made
+// with one of its hosting offers
+type AuctionBid struct {
+	InquiryId uint64 `protobuf:"varint,1,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	Provider  string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	OfferId   uint64 `protobuf:"varint,3,opt,name=offerId,proto3" json:"offerId,omitempty"`
+	// SHA-256 commitment to the price, see types.BidCommitment
+	Commitment   []byte `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	CommitHeight uint64 `protobuf:"varint,5,opt,name=commitHeight,proto3" json:"commitHeight,omitempty"`
+	Revealed     bool   `protobuf:"varint,6,opt,name=revealed,proto3" json:"revealed,omitempty"`
+	// Price per block the provider asks, set when the bid is revealed
+	Price types.Coin `protobuf:"bytes,7,opt,name=price,proto3" json:"price"`
+}
+
+func (m *AuctionBid) Reset()         { *m = AuctionBid{} }
+func (m *AuctionBid) String() string { return proto.CompactTextString(m) }
+func (*AuctionBid) ProtoMessage()    {}
+func (*AuctionBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93e39a9184392c4e, []int{1}
+}
+func (m *AuctionBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionBid.Merge(m, src)
+}
+func (m *AuctionBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionBid proto.InternalMessageInfo
+
+func (m *AuctionBid) GetInquiryId() uint64 {
+	if m != nil {
+		return m.InquiryId
+	}
+	return 0
+}
+
+func (m *AuctionBid) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *AuctionBid) GetOfferId() uint64 {
+	if m != nil {
+		return m.OfferId
+	}
+	return 0
+}
+
+func (m *AuctionBid) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *AuctionBid) GetCommitHeight() uint64 {
+	if m != nil {
+		return m.CommitHeight
+	}
+	return 0
+}
+
+func (m *AuctionBid) GetRevealed() bool {
+	if m != nil {
+		return m.Revealed
+	}
+	return false
+}
+
+func (m *AuctionBid) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*Auction)(nil), "filespacechain.filespacechain.Auction")
+	proto.RegisterType((*AuctionBid)(nil), "filespacechain.filespacechain.AuctionBid")
+}
+
+func init() {
+	proto.RegisterFile("filespacechain/filespacechain/auction.proto", fileDescriptor_93e39a9184392c4e)
+}
+
+var fileDescriptor_93e39a9184392c4e = []byte{
+	// 384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbd, 0x6e, 0xdb, 0x30,
+	0x18, 0x14, 0x5b, 0xf9, 0x8f, 0x75, 0x17, 0xa1, 0x83, 0x6a, 0xb4, 0xac, 0xe1, 0xc9, 0x40, 0x51,
+	0x09, 0x6e, 0x51, 0xa0, 0x6b, 0x5d, 0x18, 0xa8, 0xb7, 0x40, 0x63, 0x36, 0x8a, 0xfa, 0x2c, 0x11,
+	0x90, 0x48, 0x99, 0xa2, 0x8d, 0xf8, 0x2d, 0xf2, 0x50, 0x19, 0x3c, 0x7a, 0xcc, 0x14, 0x04, 0xf6,
+	0x43, 0x64, 0x0d, 0x24, 0xda, 0x72, 0xe4, 0x25, 0xd9, 0x74, 0x77, 0xa4, 0x78, 0x77, 0x38, 0xfc,
+	0x7d, 0xc1, 0x53, 0x28, 0x72, 0xca, 0x80, 0x25, 0x94, 0x0b, 0xff, 0x02, 0xd2, 0x15, 0xd3, 0x5c,
+	0x0a, 0x2f, 0x57, 0x52, 0x4b, 0xe7, 0x6b, 0x53, 0xf5, 0x9a, 0x70, 0xf0, 0x29, 0x96, 0xb1, 0xac,
+	0x4e, 0xfa, 0xe5, 0x97, 0xb9, 0x34, 0x20, 0x4c, 0x16, 0x99, 0x2c, 0xfc, 0x90, 0x16, 0xe0, 0xaf,
+	0x27, 0x21, 0x68, 0x3a, 0xf1, 0x99, 0xe4, 0xc7, 0x9f, 0x8e, 0xee, 0x10, 0xee, 0xfc, 0x35, 0xcf,
+	0x38, 0x5f, 0x70, 0x8f, 0x8b, 0xe5, 0x8a, 0xab, 0xcd, 0x3c, 0x72, 0xd1, 0x10, 0x8d, 0xed, 0xe0,
+	0x4c, 0x94, 0x2a, 0x93, 0x59, 0xc6, 0xf5, 0x4c, 0x44, 0xee, 0x3b, 0xa3, 0xd6, 0x44, 0xa9, 0x2a,
+	0x58, 0x03, 0x4d, 0x4b, 0xf5, 0xbd, 0x51, 0x6b, 0xc2, 0x71, 0x71, 0xa7, 0x00, 0xad, 0x53, 0x88,
+	0x5c, 0x7b, 0x88, 0xc6, 0xdd, 0xe0, 0x04, 0x9d, 0x19, 0xfe, 0xc8, 0x52, 0xa0, 0x8a, 0x8b, 0xf8,
+	0x4a, 0x71, 0x06, 0x6e, 0x6b, 0x88, 0xc6, 0x1f, 0x7e, 0x7e, 0xf6, 0x8c, 0x6f, 0xaf, 0xf4, 0xed,
+	0x1d, 0x7d, 0x7b, 0xff, 0x24, 0x17, 0x53, 0x7b, 0xfb, 0xf0, 0xcd, 0x0a, 0x9a, 0xb7, 0x46, 0x4f,
+	0x08, 0xe3, 0x63, 0x8c, 0x29, 0x8f, 0x5e, 0x49, 0x32, 0xc0, 0xdd, 0x5c, 0xc9, 0x35, 0x8f, 0x40,
+	0x55, 0x41, 0x7a, 0x41, 0x8d, 0x4b, 0xa7, 0x72, 0xb1, 0x00, 0x35, 0x3f, 0xa5, 0x38, 0x41, 0x87,
+	0x60, 0x6c, 0xe2, 0x66, 0x20, 0x74, 0x15, 0xa3, 0x1f, 0xbc, 0x60, 0x9c, 0x11, 0xee, 0x1b, 0xf4,
+	0x1f, 0x78, 0x9c, 0xe8, 0x2a, 0x88, 0x1d, 0x34, 0xb8, 0xf2, 0x65, 0x53, 0x0a, 0x44, 0x6e, 0xbb,
+	0x2a, 0xa2, 0xc6, 0xce, 0x6f, 0xdc, 0xca, 0xab, 0x06, 0x3a, 0x6f, 0x6b, 0xc0, 0x9c, 0x9e, 0x06,
+	0xdb, 0x3d, 0x41, 0xbb, 0x3d, 0x41, 0x8f, 0x7b, 0x82, 0x6e, 0x0f, 0xc4, 0xda, 0x1d, 0x88, 0x75,
+	0x7f, 0x20, 0xd6, 0xf5, 0x9f, 0x98, 0xeb, 0x64, 0x15, 0x7a, 0x4c, 0x66, 0x7e, 0x42, 0x45, 0x91,
+	0x2c, 0xcf, 0xfb, 0xfa, 0x61, 0x06, 0x76, 0x73, 0xb9, 0x38, 0xbd, 0xc9, 0xa1, 0x08, 0xdb, 0xd5,
+	0x36, 0x7e, 0x3d, 0x07, 0x00, 0x00, 0xff, 0xff, 0x40, 0x93, 0x4d, 0xaa, 0x9f, 0x02, 0x00, 0x00,
+}
+
+func (m *Auction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Auction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Auction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClearingPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Settled {
+		i--
+		if m.Settled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RevealEnd != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.RevealEnd))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CommitEnd != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.CommitEnd))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InquiryId != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.InquiryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Revealed {
+		i--
+		if m.Revealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CommitHeight != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.CommitHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OfferId != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.OfferId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.InquiryId != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.InquiryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuction(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuction(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Auction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InquiryId != 0 {
+		n += 1 + sovAuction(uint64(m.InquiryId))
+	}
+	if m.CommitEnd != 0 {
+		n += 1 + sovAuction(uint64(m.CommitEnd))
+	}
+	if m.RevealEnd != 0 {
+		n += 1 + sovAuction(uint64(m.RevealEnd))
+	}
+	if m.Settled {
+		n += 2
+	}
+	l = m.ClearingPrice.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *AuctionBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InquiryId != 0 {
+		n += 1 + sovAuction(uint64(m.InquiryId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	if m.OfferId != 0 {
+		n += 1 + sovAuction(uint64(m.OfferId))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	if m.CommitHeight != 0 {
+		n += 1 + sovAuction(uint64(m.CommitHeight))
+	}
+	if m.Revealed {
+		n += 2
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func sovAuction(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuction(x uint64) (n int) {
+	return sovAuction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Auction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Auction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Auction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+			}
+			m.InquiryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InquiryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitEnd", wireType)
+			}
+			m.CommitEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitEnd |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealEnd", wireType)
+			}
+			m.RevealEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealEnd |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Settled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearingPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClearingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuctionBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuctionBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuctionBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+			}
+			m.InquiryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InquiryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			}
+			m.OfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitHeight", wireType)
+			}
+			m.CommitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revealed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revealed = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuction(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuction
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuction
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuction
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuction        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuction          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuction = fmt.Errorf("proto: unexpected end of group")
+)
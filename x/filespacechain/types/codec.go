@@ -17,11 +17,16 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreateHostingInquiry{},
 		&MsgUpdateHostingInquiry{},
 		&MsgDeleteHostingInquiry{},
+		&MsgExtendHostingInquiry{},
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateHostingContract{},
 		&MsgUpdateHostingContract{},
 		&MsgDeleteHostingContract{},
+		&MsgAcceptHostingContract{},
+		&MsgRejectHostingContract{},
+		&MsgAcceptHostingContractTransfer{},
+		&MsgTerminateHostingContract{},
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateHostingOffer{},
@@ -32,10 +37,22 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgStakeForHosting{},
 		&MsgUnstakeFromHosting{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSubmitStorageProof{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCommitBid{},
+		&MsgRevealBid{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterProviderProfile{},
+		&MsgUpdateProviderProfile{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgReassignHostingContract{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
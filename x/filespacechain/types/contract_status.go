@@ -0,0 +1,23 @@
+package types
+
+// contractTransitions lists the statuses each contract status may move to.
+// Statuses without an entry are final.
+var contractTransitions = map[ContractStatus][]ContractStatus{
+	CONTRACT_STATUS_PENDING: {CONTRACT_STATUS_ACTIVE, CONTRACT_STATUS_TERMINATED},
+	CONTRACT_STATUS_ACTIVE:  {CONTRACT_STATUS_COMPLETED, CONTRACT_STATUS_TERMINATED, CONTRACT_STATUS_SLASHED},
+}
+
+// CanTransitionTo reports whether a contract may move from s to next.
+func (s ContractStatus) CanTransitionTo(next ContractStatus) bool {
+	for _, allowed := range contractTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFinal reports whether no further transitions are possible from s.
+func (s ContractStatus) IsFinal() bool {
+	return len(contractTransitions[s]) == 0
+}
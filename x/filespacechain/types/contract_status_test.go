@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractStatus_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from  ContractStatus
+		to    ContractStatus
+		valid bool
+	}{
+		{CONTRACT_STATUS_PENDING, CONTRACT_STATUS_ACTIVE, true},
+		{CONTRACT_STATUS_PENDING, CONTRACT_STATUS_TERMINATED, true},
+		{CONTRACT_STATUS_PENDING, CONTRACT_STATUS_COMPLETED, false},
+		{CONTRACT_STATUS_PENDING, CONTRACT_STATUS_SLASHED, false},
+		{CONTRACT_STATUS_ACTIVE, CONTRACT_STATUS_COMPLETED, true},
+		{CONTRACT_STATUS_ACTIVE, CONTRACT_STATUS_TERMINATED, true},
+		{CONTRACT_STATUS_ACTIVE, CONTRACT_STATUS_SLASHED, true},
+		{CONTRACT_STATUS_ACTIVE, CONTRACT_STATUS_PENDING, false},
+		{CONTRACT_STATUS_COMPLETED, CONTRACT_STATUS_ACTIVE, false},
+		{CONTRACT_STATUS_TERMINATED, CONTRACT_STATUS_ACTIVE, false},
+		{CONTRACT_STATUS_SLASHED, CONTRACT_STATUS_ACTIVE, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.from.String()+"->"+tt.to.String(), func(t *testing.T) {
+			require.Equal(t, tt.valid, tt.from.CanTransitionTo(tt.to))
+		})
+	}
+
+	require.False(t, CONTRACT_STATUS_PENDING.IsFinal())
+	require.False(t, CONTRACT_STATUS_ACTIVE.IsFinal())
+	require.True(t, CONTRACT_STATUS_COMPLETED.IsFinal())
+	require.True(t, CONTRACT_STATUS_TERMINATED.IsFinal())
+	require.True(t, CONTRACT_STATUS_SLASHED.IsFinal())
+}
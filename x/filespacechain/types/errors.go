@@ -11,4 +11,26 @@ var (
 	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
 	ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
 	ErrTest          = sdkerrors.Register(ModuleName, 1102, "test error")
+
+	ErrChallengeNotFound   = sdkerrors.Register(ModuleName, 1103, "storage challenge not found")
+	ErrChallengeNotPending = sdkerrors.Register(ModuleName, 1104, "storage challenge is not pending")
+	ErrChallengeExpired    = sdkerrors.Register(ModuleName, 1105, "storage challenge deadline has passed")
+	ErrInvalidStorageProof = sdkerrors.Register(ModuleName, 1106, "invalid storage proof")
+	ErrFileEntryInUse      = sdkerrors.Register(ModuleName, 1107, "file entry is hosted by a hosting inquiry")
+
+	ErrInvalidFileCommitment = sdkerrors.Register(ModuleName, 1108, "invalid file content commitment")
+
+	ErrInvalidContractTransition = sdkerrors.Register(ModuleName, 1109, "invalid hosting contract status transition")
+	ErrInvalidAttestation        = sdkerrors.Register(ModuleName, 1110, "invalid storage attestation")
+	ErrAcceptanceDeadlinePassed  = sdkerrors.Register(ModuleName, 1111, "hosting contract acceptance deadline has passed")
+	ErrInsufficientCollateral    = sdkerrors.Register(ModuleName, 1112, "stake would fall below the collateral required by active contracts")
+	ErrOfferInUse                = sdkerrors.Register(ModuleName, 1113, "hosting offer is referenced by running contracts")
+	ErrOfferNotEligible          = sdkerrors.Register(ModuleName, 1114, "hosting offer does not meet the inquiry's matching criteria")
+	ErrAuctionPhase              = sdkerrors.Register(ModuleName, 1115, "auction is not in the required phase")
+	ErrBidCommitmentMismatch     = sdkerrors.Register(ModuleName, 1116, "revealed bid does not match its commitment")
+	ErrDenomNotAccepted          = sdkerrors.Register(ModuleName, 1117, "denom is not accepted for storage payments")
+	ErrImmutableField            = sdkerrors.Register(ModuleName, 1118, "field cannot be changed once set")
+	ErrReplicasInUse             = sdkerrors.Register(ModuleName, 1119, "replicas are held by hosting contracts")
+	ErrNoContractTransfer        = sdkerrors.Register(ModuleName, 1120, "no hand-over of the hosting contract is proposed")
+	ErrChallengePending          = sdkerrors.Register(ModuleName, 1121, "hosting contract has a pending storage challenge")
 )
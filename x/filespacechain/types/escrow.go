@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Drawn returns what a contract has drawn from its escrow share: the payouts
+// to its provider and the surplus refunded to the inquiry creator
+func (h PaymentHistory) Drawn() sdk.Coin {
+	if h.Refunded.Denom != h.TotalPaid.Denom || h.Refunded.Amount.IsNil() {
+		return h.TotalPaid
+	}
+	return h.TotalPaid.Add(h.Refunded)
+}
+
+// AddRefund records surplus of the contract's share refunded to the inquiry
+// creator
+func (h *PaymentHistory) AddRefund(refund sdk.Coin) {
+	if h.Refunded.Denom == "" {
+		h.Refunded = refund
+		return
+	}
+	h.Refunded = h.Refunded.Add(refund)
+}
+
+// PaidPerInquiry sums what the given contracts drew from escrow by the
+// inquiry each contract belongs to. Histories of unknown contracts are skipped.
+func PaidPerInquiry(contracts []HostingContract, histories []PaymentHistory) map[uint64]sdk.Coins {
+	contractInquiry := make(map[uint64]uint64, len(contracts))
+	for _, contract := range contracts {
+		contractInquiry[contract.Id] = contract.InquiryId
+	}
+
+	paid := make(map[uint64]sdk.Coins)
+	for _, history := range histories {
+		inquiryId, ok := contractInquiry[history.ContractId]
+		if !ok {
+			continue
+		}
+		paid[inquiryId] = paid[inquiryId].Add(history.Drawn())
+	}
+	return paid
+}
+
+// OutstandingEscrow returns the coins still held in escrow: every escrowed
+// amount less what the contracts of that inquiry have already drawn from it. It fails if an inquiry paid out more than it escrowed.
+func OutstandingEscrow(records []EscrowRecord, contracts []HostingContract, histories []PaymentHistory) (sdk.Coins, error) {
+	paid := PaidPerInquiry(contracts, histories)
+
+	balance := sdk.NewCoins()
+	for _, record := range records {
+		remaining, hasNeg := sdk.NewCoins(record.Amount).SafeSub(paid[record.InquiryId]...)
+		if hasNeg {
+			return nil, fmt.Errorf("inquiry %d paid out %s, more than its escrow of %s",
+				record.InquiryId, paid[record.InquiryId], record.Amount)
+		}
+		balance = balance.Add(remaining...)
+	}
+	return balance, nil
+}
+
+// ContractEscrowShare returns the part of an inquiry's escrow that one of its
+// contracts pays out: the escrow split evenly over the replication rate,
+// rounded down. What the split leaves over is refunded when the inquiry
+// expires.
+func ContractEscrowShare(escrow sdk.Coin, replicationRate uint64) sdk.Coin {
+	if replicationRate == 0 {
+		replicationRate = 1
+	}
+	return sdk.NewCoin(escrow.Denom, escrow.Amount.Quo(math.NewIntFromUint64(replicationRate)))
+}
+
+// ContractCharge returns what a contract pays its provider over its whole
+// term: its price per block for every block from its start to its end block,
+// at most its share of the escrow. A price in another denom than the escrow
+// is not charged.
+func ContractCharge(contract HostingContract, share sdk.Coin) sdk.Coin {
+	charge := sdk.NewCoin(share.Denom, math.ZeroInt())
+	price := contract.PricePerBlock
+	if price.Denom != share.Denom || price.Amount.IsNil() || contract.EndBlock < contract.StartBlock {
+		return charge
+	}
+
+	blocks := math.NewIntFromUint64(contract.EndBlock - contract.StartBlock + 1)
+	charge.Amount = math.MinInt(price.Amount.Mul(blocks), share.Amount)
+	return charge
+}
+
+// EscrowSplitRemainder returns the part of an inquiry's escrow that is left
+// over when it is split evenly over the replication rate
+func EscrowSplitRemainder(escrow sdk.Coin, replicationRate uint64) sdk.Coin {
+	if replicationRate == 0 {
+		replicationRate = 1
+	}
+	return sdk.NewCoin(escrow.Denom, escrow.Amount.Mod(math.NewIntFromUint64(replicationRate)))
+}
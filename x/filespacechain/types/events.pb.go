@@ -0,0 +1,3652 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: filespacechain/filespacechain/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventHostingContractStatusChanged is emitted on every contract status
+// transition.
+type EventHostingContractStatusChanged struct {
+	ContractId uint64         `protobuf:"varint,1,opt,name=contractId,proto3" json:"contractId,omitempty"`
+	InquiryId  uint64         `protobuf:"varint,2,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	Provider   string         `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	FromStatus ContractStatus `protobuf:"varint,4,opt,name=fromStatus,proto3,enum=filespacechain.filespacechain.ContractStatus" json:"fromStatus,omitempty"`
+	ToStatus   ContractStatus `protobuf:"varint,5,opt,name=toStatus,proto3,enum=filespacechain.filespacechain.ContractStatus" json:"toStatus,omitempty"`
+	Height     uint64         `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Reason     string         `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventHostingContractStatusChanged) Reset()         { *m = EventHostingContractStatusChanged{} }
+func (m *EventHostingContractStatusChanged) String() string { return proto.CompactTextString(m) }
+func (*EventHostingContractStatusChanged) ProtoMessage()    {}
+func (*EventHostingContractStatusChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b68237651550fa92, []int{0}
+}
+func (m *EventHostingContractStatusChanged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventHostingContractStatusChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventHostingContractStatusChanged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventHostingContractStatusChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventHostingContractStatusChanged.Merge(m, src)
+}
+func (m *EventHostingContractStatusChanged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventHostingContractStatusChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventHostingContractStatusChanged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventHostingContractStatusChanged proto.InternalMessageInfo
+
+func (m *EventHostingContractStatusChanged) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+func (m *EventHostingContractStatusChanged) GetInquiryId() uint64 {
+	if m != nil {
+		return m.InquiryId
+	}
+	return 0
+}
+
+func (m *EventHostingContractStatusChanged) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *EventHostingContractStatusChanged) GetFromStatus() ContractStatus {
+	if m != nil {
+		return m.FromStatus
+	}
+	return CONTRACT_STATUS_PENDING
+}
+
+func (m *EventHostingContractStatusChanged) GetToStatus() ContractStatus {
+	if m != nil {
+		return m.ToStatus
+	}
+	return CONTRACT_STATUS_PENDING
+}
+
+func (m *EventHostingContractStatusChanged) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EventHostingContractStatusChanged) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventHostingOffersMatched is emitted each time offers are matched to an
+// inquiry, listing the matched offers and why every other offer was passed
+// over.
+type EventHostingOffersMatched struct {
+	InquiryId       uint64           `protobuf:"varint,1,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	Slots           uint64           `protobuf:"varint,2,opt,name=slots,proto3" json:"slots,omitempty"`
+	MatchedOfferIds []uint64         `protobuf:"varint,3,rep,packed,name=matchedOfferIds,proto3" json:"matchedOfferIds,omitempty"`
+	Rejections      []OfferRejection `protobuf:"bytes,4,rep,name=rejections,proto3" json:"rejections"`
+	Height          uint64           `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *EventHostingOffersMatched) Reset()         { *m = EventHostingOffersMatched{} }
+func (m *EventHostingOffersMatched) String() string { return proto.CompactTextString(m) }
+func (*EventHostingOffersMatched) ProtoMessage()    {}
+func (*EventHostingOffersMatched) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b68237651550fa92, []int{1}
+}
+func (m *EventHostingOffersMatched) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventHostingOffersMatched) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventHostingOffersMatched.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventHostingOffersMatched) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventHostingOffersMatched.Merge(m, src)
+}
+func (m *EventHostingOffersMatched) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventHostingOffersMatched) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventHostingOffersMatched.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventHostingOffersMatched proto.InternalMessageInfo
+
+func (m *EventHostingOffersMatched) GetInquiryId() uint64 {
+	if m != nil {
+		return m.InquiryId
+	}
+	return 0
+}
+
+func (m *EventHostingOffersMatched) GetSlots() uint64 {
+	if m != nil {
+		return m.Slots
+	}
+	return 0
+}
+
+func (m *EventHostingOffersMatched) GetMatchedOfferIds() []uint64 {
+	if m != nil {
+		return m.MatchedOfferIds
+	}
+	return nil
+}
+
+func (m *EventHostingOffersMatched) GetRejections() []OfferRejection {
+	if m != nil {
+		return m.Rejections
+	}
+	return nil
+}
+
+func (m *EventHostingOffersMatched) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// EventAuctionSettled is emitted when the auction of an inquiry settles,
+// listing the offers that won a replica at the clearing price.
+type EventAuctionSettled struct {
+	InquiryId       uint64     `protobuf:"varint,1,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	ClearingPrice   types.Coin `protobuf:"bytes,2,opt,name=clearingPrice,proto3" json:"clearingPrice"`
+	WinningOfferIds []uint64   `protobuf:"varint,3,rep,packed,name=winningOfferIds,proto3" json:"winningOfferIds,omitempty"`
+	Height          uint64     `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *EventAuctionSettled) Reset()         { *m = EventAuctionSettled{} }
+func (m *EventAuctionSettled) String() string { return proto.CompactTextString(m) }
+func (*EventAuctionSettled) ProtoMessage()    {}
+func (*EventAuctionSettled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b68237651550fa92, []int{2}
+}
+func (m *EventAuctionSettled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAuctionSettled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAuctionSettled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAuctionSettled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAuctionSettled.Merge(m, src)
+}
+func (m *EventAuctionSettled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAuctionSettled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAuctionSettled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAuctionSettled proto.InternalMessageInfo
+
+func (m *EventAuctionSettled) GetInquiryId() uint64 {
+	if m != nil {
+		return m.InquiryId
+	}
+	return 0
+}
+
+func (m *EventAuctionSettled) GetClearingPrice() types.Coin {
+	if m != nil {
+		return m.ClearingPrice
+	}
+	return types.Coin{}
+}
+
+func (m *EventAuctionSettled) GetWinningOfferIds() []uint64 {
+	if m != nil {
+		return m.WinningOfferIds
+	}
+	return nil
+}
+
+func (m *EventAuctionSettled) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// EventProviderReputationChanged is emitted each time a contract outcome moves
+// the reputation of a provider.
+type EventProviderReputationChanged struct {
+	Provider string          `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Event    ReputationEvent `protobuf:"varint,2,opt,name=event,proto3,enum=filespacechain.filespacechain.ReputationEvent" json:"event,omitempty"`
+	Score    uint64          `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	Height   uint64          `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *EventProviderReputationChanged) Reset()         { *m = EventProviderReputationChanged{} }
+func (m *EventProviderReputationChanged) String() string { return proto.CompactTextString(m) }
+func (*EventProviderReputationChanged) ProtoMessage()    {}
+func (*EventProviderReputationChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b68237651550fa92, []int{3}
+}
+func (m *EventProviderReputationChanged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProviderReputationChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProviderReputationChanged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventProviderReputationChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProviderReputationChanged.Merge(m, src)
+}
+func (m *EventProviderReputationChanged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProviderReputationChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProviderReputationChanged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProviderReputationChanged proto.InternalMessageInfo
+
+func (m *EventProviderReputationChanged) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *EventProviderReputationChanged) GetEvent() ReputationEvent {
+	if m != nil {
+		return m.Event
+	}
+	return REPUTATION_EVENT_UNSPECIFIED
+}
+
+func (m *EventProviderReputationChanged) GetScore() uint64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *EventProviderReputationChanged) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// EventPaymentDustSwept is emitted when the part of an expired inquiry's
+// escrow that cannot be split evenly over its replicas is swept to the dust
+// destination.
+type EventPaymentDustSwept struct {
+	InquiryId   uint64          `protobuf:"varint,2,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	Destination DustDestination `protobuf:"varint,3,opt,name=destination,proto3,enum=filespacechain.filespacechain.DustDestination" json:"destination,omitempty"`
+	Recipient   string          `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount      types.Coin      `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *EventPaymentDustSwept) Reset()         { *m = EventPaymentDustSwept{} }
+func (m *EventPaymentDustSwept) String() string { return proto.CompactTextString(m) }
+func (*EventPaymentDustSwept) ProtoMessage()    {}
+func (*EventPaymentDustSwept) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b68237651550fa92, []int{4}
+}
+func (m *EventPaymentDustSwept) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPaymentDustSwept) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPaymentDustSwept.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPaymentDustSwept) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPaymentDustSwept.Merge(m, src)
+}
+func (m *EventPaymentDustSwept) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPaymentDustSwept) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPaymentDustSwept.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPaymentDustSwept proto.InternalMessageInfo
+
+func (m *EventPaymentDustSwept) GetInquiryId() uint64 {
+	if m != nil {
+		return m.InquiryId
+	}
+	return 0
+}
+
+func (m *EventPaymentDustSwept) GetDestination() DustDestination {
+	if m != nil {
+		return m.Destination
+	}
+	return DUST_DESTINATION_INQUIRY_CREATOR
+}
+
+func (m *EventPaymentDustSwept) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventPaymentDustSwept) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// EventContractSurplusRefunded is emitted when a contract settles for less
+// than its share of the escrow and the surplus is refunded to the inquiry
+// creator.
+type EventContractSurplusRefunded struct {
+	ContractId uint64     `protobuf:"varint,1,opt,name=contractId,proto3" json:"contractId,omitempty"`
+	InquiryId  uint64     `protobuf:"varint,2,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	Creator    string     `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	Amount     types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *EventContractSurplusRefunded) Reset()         { *m = EventContractSurplusRefunded{} }
+func (m *EventContractSurplusRefunded) String() string { return proto.CompactTextString(m) }
+func (*EventContractSurplusRefunded) ProtoMessage()    {}
+func (*EventContractSurplusRefunded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b68237651550fa92, []int{5}
+}
+func (m *EventContractSurplusRefunded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventContractSurplusRefunded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventContractSurplusRefunded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventContractSurplusRefunded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractSurplusRefunded.Merge(m, src)
+}
+func (m *EventContractSurplusRefunded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventContractSurplusRefunded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractSurplusRefunded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventContractSurplusRefunded proto.InternalMessageInfo
+
+func (m *EventContractSurplusRefunded) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+func (m *EventContractSurplusRefunded) GetInquiryId() uint64 {
+	if m != nil {
+		return m.InquiryId
+	}
+	return 0
+}
+
+func (m *EventContractSurplusRefunded) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *EventContractSurplusRefunded) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// EventHostingInquiryExtended is emitted when the creator of a running
+// inquiry tops up its escrow and pushes out its end time.
+type EventHostingInquiryExtended struct {
+	InquiryId        uint64     `protobuf:"varint,1,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	Creator          string     `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	PreviousEndTime  uint64     `protobuf:"varint,3,opt,name=previousEndTime,proto3" json:"previousEndTime,omitempty"`
+	EndTime          uint64     `protobuf:"varint,4,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	AdditionalEscrow types.Coin `protobuf:"bytes,5,opt,name=additionalEscrow,proto3" json:"additionalEscrow"`
+	EscrowAmount     types.Coin `protobuf:"bytes,6,opt,name=escrowAmount,proto3" json:"escrowAmount"`
+	Height           uint64     `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *EventHostingInquiryExtended) Reset()         { *m = EventHostingInquiryExtended{} }
+func (m *EventHostingInquiryExtended) String() string { return proto.CompactTextString(m) }
+func (*EventHostingInquiryExtended) ProtoMessage()    {}
+func (*EventHostingInquiryExtended) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b68237651550fa92, []int{6}
+}
+func (m *EventHostingInquiryExtended) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventHostingInquiryExtended) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventHostingInquiryExtended.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventHostingInquiryExtended) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventHostingInquiryExtended.Merge(m, src)
+}
+func (m *EventHostingInquiryExtended) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventHostingInquiryExtended) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventHostingInquiryExtended.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventHostingInquiryExtended proto.InternalMessageInfo
+
+func (m *EventHostingInquiryExtended) GetInquiryId() uint64 {
+	if m != nil {
+		return m.InquiryId
+	}
+	return 0
+}
+
+func (m *EventHostingInquiryExtended) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *EventHostingInquiryExtended) GetPreviousEndTime() uint64 {
+	if m != nil {
+		return m.PreviousEndTime
+	}
+	return 0
+}
+
+func (m *EventHostingInquiryExtended) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *EventHostingInquiryExtended) GetAdditionalEscrow() types.Coin {
+	if m != nil {
+		return m.AdditionalEscrow
+	}
+	return types.Coin{}
+}
+
+func (m *EventHostingInquiryExtended) GetEscrowAmount() types.Coin {
+	if m != nil {
+		return m.EscrowAmount
+	}
+	return types.Coin{}
+}
+
+func (m *EventHostingInquiryExtended) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// EventHostingContractReassigned is emitted when a contract is handed over to
+// the provider of another offer, by mutual consent or by governance.
+type EventHostingContractReassigned struct {
+	ContractId   uint64 `protobuf:"varint,1,opt,name=contractId,proto3" json:"contractId,omitempty"`
+	InquiryId    uint64 `protobuf:"varint,2,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	FromProvider string `protobuf:"bytes,3,opt,name=fromProvider,proto3" json:"fromProvider,omitempty"`
+	ToProvider   string `protobuf:"bytes,4,opt,name=toProvider,proto3" json:"toProvider,omitempty"`
+	OfferId      uint64 `protobuf:"varint,5,opt,name=offerId,proto3" json:"offerId,omitempty"`
+	Reason       string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Height       uint64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *EventHostingContractReassigned) Reset()         { *m = EventHostingContractReassigned{} }
+func (m *EventHostingContractReassigned) String() string { return proto.CompactTextString(m) }
+func (*EventHostingContractReassigned) ProtoMessage()    {}
+func (*EventHostingContractReassigned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b68237651550fa92, []int{7}
+}
+func (m *EventHostingContractReassigned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventHostingContractReassigned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventHostingContractReassigned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventHostingContractReassigned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventHostingContractReassigned.Merge(m, src)
+}
+func (m *EventHostingContractReassigned) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventHostingContractReassigned) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventHostingContractReassigned.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventHostingContractReassigned proto.InternalMessageInfo
+
+func (m *EventHostingContractReassigned) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+func (m *EventHostingContractReassigned) GetInquiryId() uint64 {
+	if m != nil {
+		return m.InquiryId
+	}
+	return 0
+}
+
+func (m *EventHostingContractReassigned) GetFromProvider() string {
+	if m != nil {
+		return m.FromProvider
+	}
+	return ""
+}
+
+func (m *EventHostingContractReassigned) GetToProvider() string {
+	if m != nil {
+		return m.ToProvider
+	}
+	return ""
+}
+
+func (m *EventHostingContractReassigned) GetOfferId() uint64 {
+	if m != nil {
+		return m.OfferId
+	}
+	return 0
+}
+
+func (m *EventHostingContractReassigned) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *EventHostingContractReassigned) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// EventHostingContractTerminated is emitted when an active contract is ended
+// early by its provider or by the creator of its inquiry and settled: the
+// provider is paid what it earned, the party walking away pays its penalty
+// and the rest of the contract's escrow share is refunded.
+type EventHostingContractTerminated struct {
+	ContractId   uint64     `protobuf:"varint,1,opt,name=contractId,proto3" json:"contractId,omitempty"`
+	InquiryId    uint64     `protobuf:"varint,2,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	Provider     string     `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	TerminatedBy string     `protobuf:"bytes,4,opt,name=terminatedBy,proto3" json:"terminatedBy,omitempty"`
+	Settled      types.Coin `protobuf:"bytes,5,opt,name=settled,proto3" json:"settled"`
+	// paid to the provider when the inquiry creator walks away
+	Penalty types.Coin `protobuf:"bytes,6,opt,name=penalty,proto3" json:"penalty"`
+	// slashed from the provider's stake when it walks away
+	Slashed  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=slashed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"slashed"`
+	Refunded types.Coin                               `protobuf:"bytes,8,opt,name=refunded,proto3" json:"refunded"`
+	Reason   string                                   `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	Height   uint64                                   `protobuf:"varint,10,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *EventHostingContractTerminated) Reset()         { *m = EventHostingContractTerminated{} }
+func (m *EventHostingContractTerminated) String() string { return proto.CompactTextString(m) }
+func (*EventHostingContractTerminated) ProtoMessage()    {}
+func (*EventHostingContractTerminated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b68237651550fa92, []int{8}
+}
+func (m *EventHostingContractTerminated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventHostingContractTerminated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventHostingContractTerminated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventHostingContractTerminated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventHostingContractTerminated.Merge(m, src)
+}
+func (m *EventHostingContractTerminated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventHostingContractTerminated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventHostingContractTerminated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventHostingContractTerminated proto.InternalMessageInfo
+
+func (m *EventHostingContractTerminated) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+func (m *EventHostingContractTerminated) GetInquiryId() uint64 {
+	if m != nil {
+		return m.InquiryId
+	}
+	return 0
+}
+
+func (m *EventHostingContractTerminated) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *EventHostingContractTerminated) GetTerminatedBy() string {
+	if m != nil {
+		return m.TerminatedBy
+	}
+	return ""
+}
+
+func (m *EventHostingContractTerminated) GetSettled() types.Coin {
+	if m != nil {
+		return m.Settled
+	}
+	return types.Coin{}
+}
+
+func (m *EventHostingContractTerminated) GetPenalty() types.Coin {
+	if m != nil {
+		return m.Penalty
+	}
+	return types.Coin{}
+}
+
+func (m *EventHostingContractTerminated) GetSlashed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Slashed
+	}
+	return nil
+}
+
+func (m *EventHostingContractTerminated) GetRefunded() types.Coin {
+	if m != nil {
+		return m.Refunded
+	}
+	return types.Coin{}
+}
+
+func (m *EventHostingContractTerminated) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *EventHostingContractTerminated) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventHostingContractStatusChanged)(nil), "filespacechain.filespacechain.EventHostingContractStatusChanged")
+	proto.RegisterType((*EventHostingOffersMatched)(nil), "filespacechain.filespacechain.EventHostingOffersMatched")
+	proto.RegisterType((*EventAuctionSettled)(nil), "filespacechain.filespacechain.EventAuctionSettled")
+	proto.RegisterType((*EventProviderReputationChanged)(nil), "filespacechain.filespacechain.EventProviderReputationChanged")
+	proto.RegisterType((*EventPaymentDustSwept)(nil), "filespacechain.filespacechain.EventPaymentDustSwept")
+	proto.RegisterType((*EventContractSurplusRefunded)(nil), "filespacechain.filespacechain.EventContractSurplusRefunded")
+	proto.RegisterType((*EventHostingInquiryExtended)(nil), "filespacechain.filespacechain.EventHostingInquiryExtended")
+	proto.RegisterType((*EventHostingContractReassigned)(nil), "filespacechain.filespacechain.EventHostingContractReassigned")
+	proto.RegisterType((*EventHostingContractTerminated)(nil), "filespacechain.filespacechain.EventHostingContractTerminated")
+}
+
+func init() {
+	proto.RegisterFile("filespacechain/filespacechain/events.proto", fileDescriptor_b68237651550fa92)
+}
+
+var fileDescriptor_b68237651550fa92 = []byte{
+	// 944 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0x93, 0x34, 0x49, 0xa7, 0x65, 0x77, 0x65, 0x16, 0xe4, 0x2d, 0x4b, 0xb6, 0xe4, 0x14,
+	0x01, 0x75, 0xd8, 0x82, 0x04, 0x88, 0xd3, 0xb6, 0x8d, 0x44, 0x40, 0x2b, 0x2a, 0x77, 0x4f, 0x5c,
+	0xd0, 0xd4, 0x7e, 0xb1, 0x07, 0xe2, 0x19, 0xef, 0xcc, 0xb8, 0xdd, 0x7c, 0x0b, 0x3e, 0x07, 0x17,
+	0xf8, 0x00, 0xdc, 0x38, 0xb0, 0xc7, 0x3d, 0x72, 0x02, 0xd4, 0x22, 0xc4, 0x81, 0x0f, 0x81, 0x66,
+	0xc6, 0x4e, 0xc6, 0x86, 0x26, 0x81, 0x8a, 0x53, 0xf2, 0x9e, 0xdf, 0xef, 0xcd, 0xef, 0xfd, 0x9b,
+	0x37, 0xe8, 0xcd, 0x09, 0x99, 0x82, 0xc8, 0x70, 0x08, 0x61, 0x82, 0x09, 0x1d, 0xd6, 0x44, 0x38,
+	0x07, 0x2a, 0x85, 0x9f, 0x71, 0x26, 0x99, 0xfb, 0x7a, 0xf5, 0xa3, 0x5f, 0x15, 0x77, 0xef, 0xc6,
+	0x2c, 0x66, 0xda, 0x72, 0xa8, 0xfe, 0x19, 0xd0, 0x6e, 0x2f, 0x64, 0x22, 0x65, 0x62, 0x78, 0x86,
+	0x05, 0x0c, 0xcf, 0x1f, 0x9e, 0x81, 0xc4, 0x0f, 0x87, 0x21, 0x23, 0xb4, 0xf8, 0xfe, 0xde, 0x72,
+	0x02, 0x09, 0x13, 0x92, 0xd0, 0xf8, 0x8b, 0x90, 0x51, 0xc9, 0x71, 0x28, 0x0b, 0xd4, 0xdb, 0xcb,
+	0x51, 0x29, 0x96, 0x61, 0x42, 0x68, 0x5c, 0x58, 0xfb, 0xcb, 0xad, 0x39, 0x64, 0xb9, 0xc4, 0x92,
+	0xb0, 0x92, 0xd3, 0x5b, 0xcb, 0xed, 0x33, 0x3c, 0x4b, 0x81, 0x16, 0x54, 0xfa, 0x3f, 0x36, 0xd0,
+	0x1b, 0x23, 0x95, 0xa6, 0x8f, 0x0d, 0xd5, 0xa3, 0x82, 0xe9, 0xa9, 0xc4, 0x32, 0x17, 0x47, 0x09,
+	0xa6, 0x31, 0x44, 0x6e, 0x0f, 0xa1, 0x32, 0x84, 0x71, 0xe4, 0x39, 0x7b, 0xce, 0xa0, 0x15, 0x58,
+	0x1a, 0xf7, 0x3e, 0xda, 0x22, 0xf4, 0x69, 0x4e, 0xf8, 0x6c, 0x1c, 0x79, 0x0d, 0xfd, 0x79, 0xa1,
+	0x70, 0x77, 0x51, 0x37, 0xe3, 0xec, 0x9c, 0x44, 0xc0, 0xbd, 0xe6, 0x9e, 0x33, 0xd8, 0x0a, 0xe6,
+	0xb2, 0xfb, 0x18, 0xa1, 0x09, 0x67, 0xa9, 0x39, 0xce, 0x6b, 0xed, 0x39, 0x83, 0x5b, 0x07, 0xfb,
+	0xfe, 0xd2, 0x52, 0xf9, 0x55, 0x8e, 0x81, 0xe5, 0xc0, 0x1d, 0xa3, 0xae, 0x64, 0x85, 0xb3, 0xcd,
+	0xff, 0xe2, 0x6c, 0x0e, 0x77, 0x5f, 0x45, 0xed, 0x04, 0x48, 0x9c, 0x48, 0xaf, 0xad, 0x03, 0x2a,
+	0x24, 0xa5, 0xe7, 0x80, 0x05, 0xa3, 0x5e, 0x47, 0xc7, 0x52, 0x48, 0xfd, 0xdf, 0x1d, 0x74, 0xcf,
+	0xce, 0xe4, 0x67, 0x93, 0x09, 0x70, 0xf1, 0x58, 0xd5, 0x12, 0x6a, 0x19, 0x72, 0xea, 0x19, 0xba,
+	0x8b, 0x36, 0xc5, 0x94, 0x49, 0x51, 0xe4, 0xce, 0x08, 0xee, 0x00, 0xdd, 0x4e, 0x0d, 0x5c, 0xfb,
+	0x1a, 0x47, 0xc2, 0x6b, 0xee, 0x35, 0x07, 0xad, 0xa0, 0xae, 0x76, 0x4f, 0x11, 0xe2, 0xf0, 0x25,
+	0x84, 0xaa, 0x0b, 0x54, 0x16, 0x9b, 0x83, 0xed, 0x95, 0x81, 0x6b, 0x70, 0x50, 0xa2, 0x0e, 0x5b,
+	0xcf, 0x7f, 0x7e, 0xb0, 0x11, 0x58, 0x6e, 0xac, 0x04, 0x6c, 0xda, 0x09, 0xe8, 0x7f, 0xef, 0xa0,
+	0x97, 0x75, 0xa0, 0x8f, 0x72, 0x6d, 0x79, 0x0a, 0x52, 0x4e, 0x57, 0x86, 0x38, 0x42, 0x2f, 0x85,
+	0x53, 0xc0, 0x9c, 0xd0, 0xf8, 0x84, 0x93, 0x10, 0x74, 0xa8, 0xdb, 0x07, 0xf7, 0x7c, 0x33, 0x61,
+	0xbe, 0x9a, 0x30, 0xbf, 0x98, 0x30, 0xff, 0x88, 0x91, 0x92, 0x51, 0x15, 0xa5, 0x72, 0x72, 0x41,
+	0x28, 0x2d, 0xf3, 0x6b, 0xe5, 0xa4, 0xa6, 0xb6, 0xe8, 0xb7, 0x2a, 0xf4, 0xbf, 0x73, 0x50, 0x4f,
+	0xd3, 0x3f, 0x29, 0x7a, 0x30, 0x98, 0x0f, 0x50, 0xd9, 0xee, 0x76, 0xc3, 0x3a, 0xb5, 0x86, 0x3d,
+	0x46, 0x9b, 0xfa, 0x5a, 0xd1, 0xfc, 0x6f, 0x1d, 0xf8, 0x2b, 0xb2, 0xbc, 0x70, 0xae, 0xcf, 0x0c,
+	0x0c, 0x58, 0x17, 0x3c, 0x64, 0x1c, 0xf4, 0x3c, 0xa8, 0x82, 0x2b, 0xe1, 0x5a, 0xca, 0xbf, 0x39,
+	0xe8, 0x15, 0x43, 0xd9, 0xcc, 0xee, 0x71, 0x2e, 0xe4, 0xe9, 0x05, 0x64, 0x72, 0xc5, 0xe0, 0x9d,
+	0xa0, 0xed, 0x08, 0x54, 0x33, 0x6a, 0x02, 0xfa, 0xac, 0xd5, 0x8c, 0x95, 0xf3, 0xe3, 0x05, 0x2a,
+	0xb0, 0x5d, 0xa8, 0xf3, 0x38, 0x84, 0x24, 0x23, 0x2a, 0x03, 0x2d, 0x9d, 0x9a, 0x85, 0xc2, 0x7d,
+	0x1f, 0xb5, 0x71, 0xca, 0x72, 0x6a, 0x3a, 0x66, 0x8d, 0xe2, 0x16, 0xe6, 0x9f, 0xb4, 0xba, 0xce,
+	0x9d, 0x46, 0xff, 0x5b, 0x07, 0xdd, 0xd7, 0x61, 0xce, 0x67, 0x32, 0xe7, 0xd9, 0x34, 0x17, 0x01,
+	0x4c, 0x72, 0x1a, 0xdd, 0xf8, 0x1a, 0xf2, 0x50, 0x27, 0xe4, 0x80, 0x25, 0x2b, 0x6f, 0xa1, 0x52,
+	0xb4, 0x78, 0xb7, 0xfe, 0x15, 0xef, 0xfe, 0x0f, 0x0d, 0xf4, 0x9a, 0x3d, 0xf3, 0x63, 0x73, 0xd8,
+	0xe8, 0x99, 0x04, 0x4d, 0x78, 0xf9, 0x48, 0x58, 0x84, 0x1a, 0x55, 0x42, 0x03, 0x74, 0x3b, 0xe3,
+	0x70, 0x4e, 0x58, 0x2e, 0x46, 0x34, 0x7a, 0x42, 0xd2, 0xb2, 0x51, 0xea, 0x6a, 0xe5, 0x03, 0x0a,
+	0x0b, 0xd3, 0x33, 0xa5, 0xe8, 0x7e, 0x8a, 0xee, 0xe0, 0x28, 0x22, 0xaa, 0x6c, 0x78, 0x3a, 0x12,
+	0x21, 0x67, 0x17, 0xeb, 0x96, 0xe5, 0x6f, 0x40, 0xf7, 0x08, 0xed, 0x80, 0xfe, 0xf7, 0xc8, 0xe4,
+	0xa9, 0xbd, 0x9e, 0xa3, 0x0a, 0xc8, 0x6a, 0xef, 0x4e, 0xa5, 0xbd, 0xff, 0x2c, 0x27, 0xb2, 0xb6,
+	0x83, 0x02, 0xc0, 0x42, 0x90, 0x98, 0xde, 0xb8, 0xf2, 0x7d, 0xb4, 0xa3, 0x76, 0xc4, 0x49, 0x75,
+	0x09, 0x55, 0x74, 0xea, 0x04, 0xc9, 0xe6, 0x16, 0xa6, 0xb5, 0x2d, 0x8d, 0x4a, 0x34, 0x33, 0x57,
+	0x4b, 0x71, 0x1d, 0x96, 0xa2, 0xb5, 0x10, 0xda, 0xf6, 0x42, 0xb8, 0x36, 0xdc, 0x3f, 0x9a, 0xff,
+	0x1c, 0xee, 0x13, 0xe0, 0xa9, 0x9a, 0xb3, 0xff, 0x75, 0xdf, 0xf6, 0xd1, 0x8e, 0x9c, 0x9f, 0x73,
+	0x38, 0x2b, 0x02, 0xad, 0xe8, 0xdc, 0x0f, 0x51, 0x47, 0x98, 0x3b, 0x7d, 0xdd, 0x86, 0x29, 0xed,
+	0x15, 0x34, 0x03, 0x8a, 0xa7, 0x72, 0xb6, 0x6e, 0x8b, 0x94, 0xf6, 0x2e, 0xa0, 0x8e, 0x98, 0x62,
+	0x91, 0x40, 0xe4, 0x75, 0xf4, 0x02, 0x5b, 0x02, 0x7d, 0x47, 0x41, 0xbf, 0xf9, 0xe5, 0xc1, 0x20,
+	0x26, 0x32, 0xc9, 0xcf, 0xfc, 0x90, 0xa5, 0xc3, 0xe2, 0xa5, 0x66, 0x7e, 0xf6, 0x45, 0xf4, 0xd5,
+	0x50, 0xce, 0x32, 0x10, 0x1a, 0x20, 0x82, 0xd2, 0xb7, 0xfb, 0x11, 0xea, 0xf2, 0xe2, 0x3e, 0xf1,
+	0xba, 0xeb, 0x51, 0x9c, 0x03, 0xac, 0x52, 0x6f, 0x5d, 0x53, 0x6a, 0x64, 0x97, 0xfa, 0x30, 0x78,
+	0x7e, 0xd9, 0x73, 0x5e, 0x5c, 0xf6, 0x9c, 0x5f, 0x2f, 0x7b, 0xce, 0xd7, 0x57, 0xbd, 0x8d, 0x17,
+	0x57, 0xbd, 0x8d, 0x9f, 0xae, 0x7a, 0x1b, 0x9f, 0x7f, 0x60, 0x31, 0x4f, 0x30, 0x15, 0xc9, 0xd3,
+	0xc5, 0x3b, 0x6d, 0xdf, 0x3c, 0xd4, 0x9e, 0xd5, 0x5f, 0x6e, 0x3a, 0x9e, 0xb3, 0xb6, 0x7e, 0xb8,
+	0xbd, 0xfb, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x28, 0xbf, 0xad, 0x06, 0xfc, 0x0a, 0x00, 0x00,
+}
+
+func (m *EventHostingContractStatusChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventHostingContractStatusChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventHostingContractStatusChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ToStatus != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ToStatus))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FromStatus != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FromStatus))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InquiryId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InquiryId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventHostingOffersMatched) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventHostingOffersMatched) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventHostingOffersMatched) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Rejections) > 0 {
+		for iNdEx := len(m.Rejections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rejections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MatchedOfferIds) > 0 {
+		dAtA2 := make([]byte, len(m.MatchedOfferIds)*10)
+		var j1 int
+		for _, num := range m.MatchedOfferIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintEvents(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Slots != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Slots))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InquiryId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InquiryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAuctionSettled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAuctionSettled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAuctionSettled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.WinningOfferIds) > 0 {
+		dAtA4 := make([]byte, len(m.WinningOfferIds)*10)
+		var j3 int
+		for _, num := range m.WinningOfferIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintEvents(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.ClearingPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.InquiryId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InquiryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventProviderReputationChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProviderReputationChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProviderReputationChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Score != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Event != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Event))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPaymentDustSwept) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPaymentDustSwept) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPaymentDustSwept) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Destination != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InquiryId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InquiryId))
+		i--
+		dAtA[i] = 0x10
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractSurplusRefunded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractSurplusRefunded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractSurplusRefunded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InquiryId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InquiryId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventHostingInquiryExtended) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventHostingInquiryExtended) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventHostingInquiryExtended) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.EscrowAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.AdditionalEscrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.EndTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PreviousEndTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PreviousEndTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.InquiryId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InquiryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventHostingContractReassigned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventHostingContractReassigned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventHostingContractReassigned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.OfferId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OfferId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ToProvider) > 0 {
+		i -= len(m.ToProvider)
+		copy(dAtA[i:], m.ToProvider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToProvider)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FromProvider) > 0 {
+		i -= len(m.FromProvider)
+		copy(dAtA[i:], m.FromProvider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FromProvider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InquiryId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InquiryId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventHostingContractTerminated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventHostingContractTerminated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventHostingContractTerminated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size, err := m.Refunded.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Slashed) > 0 {
+		for iNdEx := len(m.Slashed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.Penalty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Settled.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TerminatedBy) > 0 {
+		i -= len(m.TerminatedBy)
+		copy(dAtA[i:], m.TerminatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TerminatedBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InquiryId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InquiryId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventHostingContractStatusChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	if m.InquiryId != 0 {
+		n += 1 + sovEvents(uint64(m.InquiryId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.FromStatus != 0 {
+		n += 1 + sovEvents(uint64(m.FromStatus))
+	}
+	if m.ToStatus != 0 {
+		n += 1 + sovEvents(uint64(m.ToStatus))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventHostingOffersMatched) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InquiryId != 0 {
+		n += 1 + sovEvents(uint64(m.InquiryId))
+	}
+	if m.Slots != 0 {
+		n += 1 + sovEvents(uint64(m.Slots))
+	}
+	if len(m.MatchedOfferIds) > 0 {
+		l = 0
+		for _, e := range m.MatchedOfferIds {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	if len(m.Rejections) > 0 {
+		for _, e := range m.Rejections {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *EventAuctionSettled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InquiryId != 0 {
+		n += 1 + sovEvents(uint64(m.InquiryId))
+	}
+	l = m.ClearingPrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.WinningOfferIds) > 0 {
+		l = 0
+		for _, e := range m.WinningOfferIds {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *EventProviderReputationChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Event != 0 {
+		n += 1 + sovEvents(uint64(m.Event))
+	}
+	if m.Score != 0 {
+		n += 1 + sovEvents(uint64(m.Score))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *EventPaymentDustSwept) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InquiryId != 0 {
+		n += 1 + sovEvents(uint64(m.InquiryId))
+	}
+	if m.Destination != 0 {
+		n += 1 + sovEvents(uint64(m.Destination))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventContractSurplusRefunded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	if m.InquiryId != 0 {
+		n += 1 + sovEvents(uint64(m.InquiryId))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventHostingInquiryExtended) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InquiryId != 0 {
+		n += 1 + sovEvents(uint64(m.InquiryId))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PreviousEndTime != 0 {
+		n += 1 + sovEvents(uint64(m.PreviousEndTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovEvents(uint64(m.EndTime))
+	}
+	l = m.AdditionalEscrow.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.EscrowAmount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *EventHostingContractReassigned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	if m.InquiryId != 0 {
+		n += 1 + sovEvents(uint64(m.InquiryId))
+	}
+	l = len(m.FromProvider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ToProvider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.OfferId != 0 {
+		n += 1 + sovEvents(uint64(m.OfferId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *EventHostingContractTerminated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	if m.InquiryId != 0 {
+		n += 1 + sovEvents(uint64(m.InquiryId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TerminatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Settled.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Penalty.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.Slashed) > 0 {
+		for _, e := range m.Slashed {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = m.Refunded.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventHostingContractStatusChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventHostingContractStatusChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventHostingContractStatusChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+			}
+			m.InquiryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InquiryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromStatus", wireType)
+			}
+			m.FromStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromStatus |= ContractStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToStatus", wireType)
+			}
+			m.ToStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToStatus |= ContractStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventHostingOffersMatched) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventHostingOffersMatched: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventHostingOffersMatched: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+			}
+			m.InquiryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InquiryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slots", wireType)
+			}
+			m.Slots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slots |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MatchedOfferIds = append(m.MatchedOfferIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MatchedOfferIds) == 0 {
+					m.MatchedOfferIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MatchedOfferIds = append(m.MatchedOfferIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedOfferIds", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rejections = append(m.Rejections, OfferRejection{})
+			if err := m.Rejections[len(m.Rejections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAuctionSettled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAuctionSettled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAuctionSettled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+			}
+			m.InquiryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InquiryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearingPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClearingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.WinningOfferIds = append(m.WinningOfferIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.WinningOfferIds) == 0 {
+					m.WinningOfferIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.WinningOfferIds = append(m.WinningOfferIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningOfferIds", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventProviderReputationChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProviderReputationChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProviderReputationChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			m.Event = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Event |= ReputationEvent(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPaymentDustSwept) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPaymentDustSwept: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPaymentDustSwept: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+			}
+			m.InquiryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InquiryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= DustDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventContractSurplusRefunded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventContractSurplusRefunded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventContractSurplusRefunded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+			}
+			m.InquiryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InquiryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventHostingInquiryExtended) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventHostingInquiryExtended: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventHostingInquiryExtended: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+			}
+			m.InquiryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InquiryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEndTime", wireType)
+			}
+			m.PreviousEndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousEndTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalEscrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdditionalEscrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventHostingContractReassigned) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventHostingContractReassigned: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventHostingContractReassigned: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+			}
+			m.InquiryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InquiryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			}
+			m.OfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventHostingContractTerminated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventHostingContractTerminated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventHostingContractTerminated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+			}
+			m.InquiryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InquiryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TerminatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Settled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Penalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashed = append(m.Slashed, types.Coin{})
+			if err := m.Slashed[len(m.Slashed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -23,13 +23,16 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type FileEntry struct {
-	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Cid       string `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
-	RootCid   string `protobuf:"bytes,3,opt,name=rootCid,proto3" json:"rootCid,omitempty"`
-	ParentCid string `protobuf:"bytes,4,opt,name=parentCid,proto3" json:"parentCid,omitempty"`
-	MetaData  string `protobuf:"bytes,5,opt,name=metaData,proto3" json:"metaData,omitempty"`
-	FileSize  uint64 `protobuf:"varint,6,opt,name=fileSize,proto3" json:"fileSize,omitempty"`
-	Creator   string `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Cid        string `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	RootCid    string `protobuf:"bytes,3,opt,name=rootCid,proto3" json:"rootCid,omitempty"`
+	ParentCid  string `protobuf:"bytes,4,opt,name=parentCid,proto3" json:"parentCid,omitempty"`
+	MetaData   string `protobuf:"bytes,5,opt,name=metaData,proto3" json:"metaData,omitempty"`
+	FileSize   uint64 `protobuf:"varint,6,opt,name=fileSize,proto3" json:"fileSize,omitempty"`
+	Creator    string `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
+	MerkleRoot []byte `protobuf:"bytes,8,opt,name=merkleRoot,proto3" json:"merkleRoot,omitempty"`
+	ChunkCount uint64 `protobuf:"varint,9,opt,name=chunkCount,proto3" json:"chunkCount,omitempty"`
+	ChunkSize  uint64 `protobuf:"varint,10,opt,name=chunkSize,proto3" json:"chunkSize,omitempty"`
 }
 
 func (m *FileEntry) Reset()         { *m = FileEntry{} }
@@ -114,6 +117,27 @@ func (m *FileEntry) GetCreator() string {
 	return ""
 }
 
+func (m *FileEntry) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+func (m *FileEntry) GetChunkCount() uint64 {
+	if m != nil {
+		return m.ChunkCount
+	}
+	return 0
+}
+
+func (m *FileEntry) GetChunkSize() uint64 {
+	if m != nil {
+		return m.ChunkSize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*FileEntry)(nil), "filespacechain.filespacechain.FileEntry")
 }
@@ -123,23 +147,26 @@ func init() {
 }
 
 var fileDescriptor_f5676b2ee239130f = []byte{
-	// 250 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4b, 0xcb, 0xcc, 0x49,
-	0x2d, 0x2e, 0x48, 0x4c, 0x4e, 0x4d, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0xc7, 0xc2, 0x8d, 0x4f, 0xcd,
-	0x2b, 0x29, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x45, 0x55, 0x80, 0xa6, 0x5d,
-	0x69, 0x3b, 0x23, 0x17, 0xa7, 0x5b, 0x66, 0x4e, 0xaa, 0x2b, 0x48, 0x8b, 0x10, 0x1f, 0x17, 0x53,
-	0x66, 0x8a, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x4b, 0x10, 0x53, 0x66, 0x8a, 0x90, 0x00, 0x17, 0x73,
-	0x72, 0x66, 0x8a, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x88, 0x29, 0x24, 0xc1, 0xc5, 0x5e,
-	0x94, 0x9f, 0x5f, 0xe2, 0x9c, 0x99, 0x22, 0xc1, 0x0c, 0x16, 0x85, 0x71, 0x85, 0x64, 0xb8, 0x38,
-	0x0b, 0x12, 0x8b, 0x52, 0xf3, 0xc0, 0x72, 0x2c, 0x60, 0x39, 0x84, 0x80, 0x90, 0x14, 0x17, 0x47,
-	0x6e, 0x6a, 0x49, 0xa2, 0x4b, 0x62, 0x49, 0xa2, 0x04, 0x2b, 0x58, 0x12, 0xce, 0x07, 0xc9, 0x81,
-	0x5c, 0x15, 0x9c, 0x59, 0x95, 0x2a, 0xc1, 0x06, 0xb6, 0x1b, 0xce, 0x07, 0xd9, 0x97, 0x5c, 0x94,
-	0x9a, 0x58, 0x92, 0x5f, 0x24, 0xc1, 0x0e, 0xb1, 0x0f, 0xca, 0x75, 0x0a, 0x3a, 0xf1, 0x48, 0x8e,
-	0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x8b, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4,
-	0xfc, 0x5c, 0xfd, 0x8c, 0xc4, 0xbc, 0xe2, 0x8c, 0x42, 0x44, 0x28, 0xe9, 0x42, 0x82, 0xa9, 0x02,
-	0x3d, 0xdc, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x61, 0x66, 0x0c, 0x08, 0x00, 0x00,
-	0xff, 0xff, 0x7e, 0xf9, 0x0c, 0xd6, 0x65, 0x01, 0x00, 0x00,
+	// 291 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0xeb, 0xb4, 0x7f, 0xdb, 0x58, 0xbf, 0x10, 0xf2, 0x64, 0x21, 0xb0, 0x22, 0xa6, 0x2c,
+	0xa4, 0x03, 0x0b, 0x33, 0x05, 0x1e, 0x20, 0x6c, 0x2c, 0xc8, 0x75, 0x2e, 0xc4, 0x6a, 0x62, 0x07,
+	0xc7, 0x91, 0x28, 0x4f, 0xc1, 0xc2, 0x3b, 0x31, 0x76, 0x64, 0x44, 0xc9, 0x8b, 0x20, 0xbb, 0xd0,
+	0x94, 0x8a, 0xcd, 0xe7, 0x7c, 0xbe, 0xf7, 0x5c, 0xe9, 0xe0, 0xe4, 0x41, 0x16, 0x50, 0x57, 0x5c,
+	0x80, 0xc8, 0xb9, 0x54, 0xb3, 0x3f, 0xe4, 0x3d, 0x28, 0x6b, 0x56, 0x49, 0x65, 0xb4, 0xd5, 0xe4,
+	0xe4, 0xf7, 0x87, 0xbd, 0xf1, 0xd3, 0xb7, 0x00, 0x87, 0x37, 0xb2, 0x80, 0x6b, 0x37, 0x42, 0x0e,
+	0x70, 0x20, 0x33, 0x8a, 0x22, 0x14, 0x8f, 0xd2, 0x40, 0x66, 0xe4, 0x10, 0x0f, 0x85, 0xcc, 0x68,
+	0x10, 0xa1, 0x38, 0x4c, 0xdd, 0x93, 0x50, 0x3c, 0x31, 0x5a, 0xdb, 0xb9, 0xcc, 0xe8, 0xd0, 0xbb,
+	0x3f, 0x92, 0x1c, 0xe3, 0xb0, 0xe2, 0x06, 0x94, 0x67, 0x23, 0xcf, 0x7a, 0x83, 0x1c, 0xe1, 0x69,
+	0x09, 0x96, 0x5f, 0x71, 0xcb, 0xe9, 0x3f, 0x0f, 0xb7, 0xda, 0x31, 0x77, 0xd5, 0xad, 0x7c, 0x01,
+	0x3a, 0xf6, 0xd9, 0x5b, 0xed, 0xf2, 0x84, 0x01, 0x6e, 0xb5, 0xa1, 0x93, 0x4d, 0xde, 0xb7, 0x24,
+	0x0c, 0xe3, 0x12, 0xcc, 0xb2, 0x80, 0x54, 0x6b, 0x4b, 0xa7, 0x11, 0x8a, 0xff, 0xa7, 0x3b, 0x8e,
+	0xe3, 0x22, 0x6f, 0xd4, 0x72, 0xae, 0x1b, 0x65, 0x69, 0xe8, 0xf7, 0xee, 0x38, 0xee, 0x5e, 0xaf,
+	0x7c, 0x2c, 0xf6, 0xb8, 0x37, 0x2e, 0xd3, 0xf7, 0x96, 0xa1, 0x75, 0xcb, 0xd0, 0x67, 0xcb, 0xd0,
+	0x6b, 0xc7, 0x06, 0xeb, 0x8e, 0x0d, 0x3e, 0x3a, 0x36, 0xb8, 0xbb, 0x78, 0x94, 0x36, 0x6f, 0x16,
+	0x89, 0xd0, 0xe5, 0x2c, 0xe7, 0xaa, 0xce, 0x9f, 0xfa, 0x0e, 0xce, 0x36, 0x25, 0x3c, 0xef, 0xb7,
+	0x62, 0x57, 0x15, 0xd4, 0x8b, 0xb1, 0x6f, 0xe4, 0xfc, 0x6b, 0x00, 0xa6, 0x9c, 0x57, 0x65, 0xc3,
+	0x01, 0x00, 0x00,
 }
 
 func (m *FileEntry) Marshal() (dAtA []byte, err error) {
@@ -162,6 +189,23 @@ func (m *FileEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ChunkSize != 0 {
+		i = encodeVarintFileEntry(dAtA, i, uint64(m.ChunkSize))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ChunkCount != 0 {
+		i = encodeVarintFileEntry(dAtA, i, uint64(m.ChunkCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintFileEntry(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -253,6 +297,16 @@ func (m *FileEntry) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovFileEntry(uint64(l))
 	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovFileEntry(uint64(l))
+	}
+	if m.ChunkCount != 0 {
+		n += 1 + sovFileEntry(uint64(m.ChunkCount))
+	}
+	if m.ChunkSize != 0 {
+		n += 1 + sovFileEntry(uint64(m.ChunkSize))
+	}
 	return n
 }
 
@@ -489,6 +543,78 @@ func (m *FileEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFileEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFileEntry
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFileEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkCount", wireType)
+			}
+			m.ChunkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFileEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSize", wireType)
+			}
+			m.ChunkSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFileEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFileEntry(dAtA[iNdEx:])
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultIndex is the default global index
@@ -10,10 +12,21 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		FileEntryList:       []FileEntry{},
-		HostingInquiryList:  []HostingInquiry{},
-		HostingContractList: []HostingContract{},
-		HostingOfferList:    []HostingOffer{},
+		FileEntryList:          []FileEntry{},
+		HostingInquiryList:     []HostingInquiry{},
+		HostingContractList:    []HostingContract{},
+		HostingOfferList:       []HostingOffer{},
+		StorageChallengeList:   []StorageChallenge{},
+		SlashEventList:         []SlashEvent{},
+		EscrowRecordList:       []EscrowRecord{},
+		ProviderStakeList:      []ProviderStake{},
+		PaymentHistoryList:     []PaymentHistory{},
+		UnbondingEntryList:     []UnbondingEntry{},
+		AuctionList:            []Auction{},
+		AuctionBidList:         []AuctionBid{},
+		ProviderProfileList:    []ProviderProfile{},
+		ProviderReputationList: []ProviderReputation{},
+		ProviderPaymentList:    []ProviderPayment{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -70,7 +83,176 @@ func (gs GenesisState) Validate() error {
 		}
 		hostingOfferIdMap[elem.Id] = true
 	}
+	// Check for duplicated ID in storageChallenge
+	storageChallengeIdMap := make(map[uint64]bool)
+	storageChallengeCount := gs.GetStorageChallengeCount()
+	for _, elem := range gs.StorageChallengeList {
+		if _, ok := storageChallengeIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for storageChallenge")
+		}
+		if elem.Id >= storageChallengeCount {
+			return fmt.Errorf("storageChallenge id should be lower or equal than the last id")
+		}
+		storageChallengeIdMap[elem.Id] = true
+	}
+	// Check for duplicated ID in slashEvent
+	slashEventIdMap := make(map[uint64]bool)
+	slashEventCount := gs.GetSlashEventCount()
+	for _, elem := range gs.SlashEventList {
+		if _, ok := slashEventIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for slashEvent")
+		}
+		if elem.Id >= slashEventCount {
+			return fmt.Errorf("slashEvent id should be lower or equal than the last id")
+		}
+		slashEventIdMap[elem.Id] = true
+	}
+	// Check for duplicated inquiry in escrowRecord
+	escrowRecordIdMap := make(map[uint64]bool)
+	for _, elem := range gs.EscrowRecordList {
+		if _, ok := escrowRecordIdMap[elem.InquiryId]; ok {
+			return fmt.Errorf("duplicated inquiry id for escrowRecord")
+		}
+		if err := elem.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid escrowRecord amount for inquiry %d: %w", elem.InquiryId, err)
+		}
+		escrowRecordIdMap[elem.InquiryId] = true
+	}
+	// Check for duplicated provider in providerStake
+	providerStakeMap := make(map[string]bool)
+	for _, elem := range gs.ProviderStakeList {
+		if _, ok := providerStakeMap[elem.Provider]; ok {
+			return fmt.Errorf("duplicated provider for providerStake")
+		}
+		if err := elem.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid providerStake amount for provider %s: %w", elem.Provider, err)
+		}
+		providerStakeMap[elem.Provider] = true
+	}
+	// Check for duplicated contract in paymentHistory
+	paymentHistoryIdMap := make(map[uint64]bool)
+	for _, elem := range gs.PaymentHistoryList {
+		if _, ok := paymentHistoryIdMap[elem.ContractId]; ok {
+			return fmt.Errorf("duplicated contract id for paymentHistory")
+		}
+		if err := elem.TotalPaid.Validate(); err != nil {
+			return fmt.Errorf("invalid paymentHistory total for contract %d: %w", elem.ContractId, err)
+		}
+		if elem.Refunded.Denom != "" {
+			if err := elem.Refunded.Validate(); err != nil {
+				return fmt.Errorf("invalid paymentHistory refund for contract %d: %w", elem.ContractId, err)
+			}
+		}
+		paymentHistoryIdMap[elem.ContractId] = true
+	}
+	// Check for duplicated ID in unbondingEntry
+	unbondingEntryIdMap := make(map[uint64]bool)
+	unbondingEntryCount := gs.GetUnbondingEntryCount()
+	for _, elem := range gs.UnbondingEntryList {
+		if _, ok := unbondingEntryIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for unbondingEntry")
+		}
+		if elem.Id >= unbondingEntryCount {
+			return fmt.Errorf("unbondingEntry id should be lower or equal than the last id")
+		}
+		if err := elem.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid unbondingEntry amount for id %d: %w", elem.Id, err)
+		}
+		unbondingEntryIdMap[elem.Id] = true
+	}
+	// Check for duplicated inquiry in auction
+	auctionIdMap := make(map[uint64]bool)
+	for _, elem := range gs.AuctionList {
+		if _, ok := auctionIdMap[elem.InquiryId]; ok {
+			return fmt.Errorf("duplicated inquiry id for auction")
+		}
+		if elem.RevealEnd < elem.CommitEnd {
+			return fmt.Errorf("auction for inquiry %d closes its reveal window before bidding", elem.InquiryId)
+		}
+		auctionIdMap[elem.InquiryId] = true
+	}
+	// Check for duplicated provider in auctionBid and that every bid has its auction
+	auctionBidMap := make(map[string]bool)
+	for _, elem := range gs.AuctionBidList {
+		if !auctionIdMap[elem.InquiryId] {
+			return fmt.Errorf("auctionBid for inquiry %d has no auction", elem.InquiryId)
+		}
+		key := fmt.Sprintf("%d/%s", elem.InquiryId, elem.Provider)
+		if _, ok := auctionBidMap[key]; ok {
+			return fmt.Errorf("duplicated provider for auctionBid")
+		}
+		auctionBidMap[key] = true
+	}
+	// Check for duplicated provider in providerProfile
+	providerProfileMap := make(map[string]bool)
+	for _, elem := range gs.ProviderProfileList {
+		if _, ok := providerProfileMap[elem.Provider]; ok {
+			return fmt.Errorf("duplicated provider for providerProfile")
+		}
+		providerProfileMap[elem.Provider] = true
+	}
+	// Check for duplicated provider in providerReputation
+	providerReputationMap := make(map[string]bool)
+	for _, elem := range gs.ProviderReputationList {
+		if _, ok := providerReputationMap[elem.Provider]; ok {
+			return fmt.Errorf("duplicated provider for providerReputation")
+		}
+		if elem.Score > MaxReputationScore {
+			return fmt.Errorf("providerReputation score %d of %s exceeds %d", elem.Score, elem.Provider, MaxReputationScore)
+		}
+		providerReputationMap[elem.Provider] = true
+	}
+	// Check for duplicated ID in providerPayment
+	providerPaymentIdMap := make(map[uint64]bool)
+	providerPaymentCount := gs.GetProviderPaymentCount()
+	for _, elem := range gs.ProviderPaymentList {
+		if _, ok := providerPaymentIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for providerPayment")
+		}
+		if elem.Id >= providerPaymentCount {
+			return fmt.Errorf("providerPayment id should be lower or equal than the last id")
+		}
+		if err := elem.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid providerPayment amount for id %d: %w", elem.Id, err)
+		}
+		providerPaymentIdMap[elem.Id] = true
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
 }
+
+// EscrowBalance returns the coins the module account must hold for the
+// escrow records in the genesis state.
+func (gs GenesisState) EscrowBalance() (sdk.Coins, error) {
+	return OutstandingEscrow(gs.EscrowRecordList, gs.HostingContractList, gs.PaymentHistoryList)
+}
+
+// BondedBalance returns the coins the hosting bonded pool must hold for the
+// provider stakes and unbonding entries in the genesis state.
+func (gs GenesisState) BondedBalance() sdk.Coins {
+	balance := sdk.NewCoins()
+	for _, stake := range gs.ProviderStakeList {
+		balance = balance.Add(stake.Amount)
+	}
+	for _, entry := range gs.UnbondingEntryList {
+		balance = balance.Add(entry.Amount)
+	}
+	return balance
+}
+
+// ValidateBalances checks that the module account and the hosting bonded pool
+// hold exactly the funds tracked by the genesis state.
+func (gs GenesisState) ValidateBalances(moduleBalance, bondedPoolBalance sdk.Coins) error {
+	escrow, err := gs.EscrowBalance()
+	if err != nil {
+		return err
+	}
+	if !escrow.Equal(moduleBalance) {
+		return fmt.Errorf("module account balance %s does not match outstanding escrow %s", moduleBalance, escrow)
+	}
+	if bonded := gs.BondedBalance(); !bonded.Equal(bondedPoolBalance) {
+		return fmt.Errorf("hosting bonded pool balance %s does not match bonded stake %s", bondedPoolBalance, bonded)
+	}
+	return nil
+}
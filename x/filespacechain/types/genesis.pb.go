@@ -27,15 +27,30 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the filespacechain module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params               Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	FileEntryList        []FileEntry       `protobuf:"bytes,2,rep,name=fileEntryList,proto3" json:"fileEntryList"`
-	FileEntryCount       uint64            `protobuf:"varint,3,opt,name=fileEntryCount,proto3" json:"fileEntryCount,omitempty"`
-	HostingInquiryList   []HostingInquiry  `protobuf:"bytes,4,rep,name=hostingInquiryList,proto3" json:"hostingInquiryList"`
-	HostingInquiryCount  uint64            `protobuf:"varint,5,opt,name=hostingInquiryCount,proto3" json:"hostingInquiryCount,omitempty"`
-	HostingContractList  []HostingContract `protobuf:"bytes,6,rep,name=hostingContractList,proto3" json:"hostingContractList"`
-	HostingContractCount uint64            `protobuf:"varint,7,opt,name=hostingContractCount,proto3" json:"hostingContractCount,omitempty"`
-	HostingOfferList     []HostingOffer    `protobuf:"bytes,8,rep,name=hostingOfferList,proto3" json:"hostingOfferList"`
-	HostingOfferCount    uint64            `protobuf:"varint,9,opt,name=hostingOfferCount,proto3" json:"hostingOfferCount,omitempty"`
+	Params                 Params               `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	FileEntryList          []FileEntry          `protobuf:"bytes,2,rep,name=fileEntryList,proto3" json:"fileEntryList"`
+	FileEntryCount         uint64               `protobuf:"varint,3,opt,name=fileEntryCount,proto3" json:"fileEntryCount,omitempty"`
+	HostingInquiryList     []HostingInquiry     `protobuf:"bytes,4,rep,name=hostingInquiryList,proto3" json:"hostingInquiryList"`
+	HostingInquiryCount    uint64               `protobuf:"varint,5,opt,name=hostingInquiryCount,proto3" json:"hostingInquiryCount,omitempty"`
+	HostingContractList    []HostingContract    `protobuf:"bytes,6,rep,name=hostingContractList,proto3" json:"hostingContractList"`
+	HostingContractCount   uint64               `protobuf:"varint,7,opt,name=hostingContractCount,proto3" json:"hostingContractCount,omitempty"`
+	HostingOfferList       []HostingOffer       `protobuf:"bytes,8,rep,name=hostingOfferList,proto3" json:"hostingOfferList"`
+	HostingOfferCount      uint64               `protobuf:"varint,9,opt,name=hostingOfferCount,proto3" json:"hostingOfferCount,omitempty"`
+	StorageChallengeList   []StorageChallenge   `protobuf:"bytes,10,rep,name=storageChallengeList,proto3" json:"storageChallengeList"`
+	StorageChallengeCount  uint64               `protobuf:"varint,11,opt,name=storageChallengeCount,proto3" json:"storageChallengeCount,omitempty"`
+	SlashEventList         []SlashEvent         `protobuf:"bytes,12,rep,name=slashEventList,proto3" json:"slashEventList"`
+	SlashEventCount        uint64               `protobuf:"varint,13,opt,name=slashEventCount,proto3" json:"slashEventCount,omitempty"`
+	EscrowRecordList       []EscrowRecord       `protobuf:"bytes,14,rep,name=escrowRecordList,proto3" json:"escrowRecordList"`
+	ProviderStakeList      []ProviderStake      `protobuf:"bytes,15,rep,name=providerStakeList,proto3" json:"providerStakeList"`
+	PaymentHistoryList     []PaymentHistory     `protobuf:"bytes,16,rep,name=paymentHistoryList,proto3" json:"paymentHistoryList"`
+	UnbondingEntryList     []UnbondingEntry     `protobuf:"bytes,17,rep,name=unbondingEntryList,proto3" json:"unbondingEntryList"`
+	UnbondingEntryCount    uint64               `protobuf:"varint,18,opt,name=unbondingEntryCount,proto3" json:"unbondingEntryCount,omitempty"`
+	AuctionList            []Auction            `protobuf:"bytes,19,rep,name=auctionList,proto3" json:"auctionList"`
+	AuctionBidList         []AuctionBid         `protobuf:"bytes,20,rep,name=auctionBidList,proto3" json:"auctionBidList"`
+	ProviderProfileList    []ProviderProfile    `protobuf:"bytes,21,rep,name=providerProfileList,proto3" json:"providerProfileList"`
+	ProviderReputationList []ProviderReputation `protobuf:"bytes,22,rep,name=providerReputationList,proto3" json:"providerReputationList"`
+	ProviderPaymentList    []ProviderPayment    `protobuf:"bytes,23,rep,name=providerPaymentList,proto3" json:"providerPaymentList"`
+	ProviderPaymentCount   uint64               `protobuf:"varint,24,opt,name=providerPaymentCount,proto3" json:"providerPaymentCount,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -134,6 +149,111 @@ func (m *GenesisState) GetHostingOfferCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetStorageChallengeList() []StorageChallenge {
+	if m != nil {
+		return m.StorageChallengeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStorageChallengeCount() uint64 {
+	if m != nil {
+		return m.StorageChallengeCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSlashEventList() []SlashEvent {
+	if m != nil {
+		return m.SlashEventList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashEventCount() uint64 {
+	if m != nil {
+		return m.SlashEventCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetEscrowRecordList() []EscrowRecord {
+	if m != nil {
+		return m.EscrowRecordList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProviderStakeList() []ProviderStake {
+	if m != nil {
+		return m.ProviderStakeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPaymentHistoryList() []PaymentHistory {
+	if m != nil {
+		return m.PaymentHistoryList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnbondingEntryList() []UnbondingEntry {
+	if m != nil {
+		return m.UnbondingEntryList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnbondingEntryCount() uint64 {
+	if m != nil {
+		return m.UnbondingEntryCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAuctionList() []Auction {
+	if m != nil {
+		return m.AuctionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuctionBidList() []AuctionBid {
+	if m != nil {
+		return m.AuctionBidList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProviderProfileList() []ProviderProfile {
+	if m != nil {
+		return m.ProviderProfileList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProviderReputationList() []ProviderReputation {
+	if m != nil {
+		return m.ProviderReputationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProviderPaymentList() []ProviderPayment {
+	if m != nil {
+		return m.ProviderPaymentList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProviderPaymentCount() uint64 {
+	if m != nil {
+		return m.ProviderPaymentCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "filespacechain.filespacechain.GenesisState")
 }
@@ -143,35 +263,56 @@ func init() {
 }
 
 var fileDescriptor_55344afe73cc9860 = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0xee, 0xd2, 0x40,
-	0x10, 0xc7, 0x5b, 0xf9, 0xa3, 0x2c, 0x6a, 0x64, 0xe5, 0x40, 0x48, 0xac, 0xc4, 0x44, 0xd3, 0x80,
-	0xb4, 0x0a, 0x1e, 0x3c, 0x43, 0x54, 0x4c, 0x4c, 0x34, 0xd5, 0x93, 0x89, 0x21, 0x4b, 0xb3, 0x6d,
-	0x37, 0x91, 0xdd, 0xd2, 0x2e, 0x89, 0xbc, 0x85, 0x27, 0x9f, 0xc1, 0xa3, 0x8f, 0xc1, 0x91, 0xa3,
-	0x27, 0x63, 0xe0, 0xe0, 0x6b, 0x98, 0xee, 0x2e, 0xfc, 0x7e, 0x2d, 0x4d, 0xe9, 0xa5, 0xd9, 0xf9,
-	0xce, 0x7c, 0x67, 0x3f, 0x3b, 0x1d, 0x30, 0xf0, 0xc8, 0x57, 0x1c, 0x87, 0xc8, 0xc5, 0x6e, 0x80,
-	0x08, 0xb5, 0x33, 0xa1, 0x8f, 0x29, 0x8e, 0x49, 0x6c, 0x85, 0x11, 0xe3, 0x0c, 0x3e, 0x48, 0x67,
-	0xad, 0x74, 0xd8, 0x6d, 0xa1, 0x25, 0xa1, 0xcc, 0x16, 0x5f, 0xe9, 0xe8, 0xb6, 0x7d, 0xe6, 0x33,
-	0x71, 0xb4, 0x93, 0x93, 0x52, 0xfb, 0xc5, 0x97, 0x86, 0x28, 0x42, 0x4b, 0x75, 0x67, 0xd7, 0x2a,
-	0xae, 0x4d, 0xc2, 0x39, 0xa6, 0x3c, 0xda, 0xa8, 0xfa, 0x71, 0x71, 0x7d, 0xc0, 0x62, 0x4e, 0xa8,
-	0x3f, 0x27, 0x74, 0xb5, 0x26, 0x27, 0xd3, 0x8b, 0x72, 0x26, 0x97, 0x51, 0x1e, 0x21, 0x97, 0x2b,
-	0xd7, 0xf3, 0x72, 0x2e, 0xe6, 0x79, 0x38, 0x92, 0x96, 0x47, 0x3f, 0x6a, 0xe0, 0xf6, 0x1b, 0x39,
-	0xd3, 0x8f, 0x1c, 0x71, 0x0c, 0x67, 0xa0, 0x2e, 0x9f, 0xdb, 0xd1, 0x7b, 0xba, 0xd9, 0x1c, 0x3d,
-	0xb6, 0x0a, 0x67, 0x6c, 0x7d, 0x10, 0xc5, 0x93, 0xc6, 0xf6, 0xcf, 0x43, 0xed, 0xe7, 0xbf, 0x5f,
-	0x7d, 0xdd, 0x51, 0x7e, 0xf8, 0x09, 0xdc, 0x49, 0x6a, 0x5f, 0x25, 0xb3, 0x78, 0x47, 0x62, 0xde,
-	0xb9, 0xd1, 0xab, 0x98, 0xcd, 0x91, 0x79, 0xa1, 0xe1, 0xeb, 0xa3, 0x67, 0x52, 0x4d, 0x7a, 0x3a,
-	0xe9, 0x26, 0xf0, 0x09, 0xb8, 0x7b, 0x12, 0xa6, 0x6c, 0x4d, 0x79, 0xa7, 0xd2, 0xd3, 0xcd, 0xaa,
-	0x93, 0x51, 0xa1, 0x0b, 0xa0, 0x7a, 0xef, 0x5b, 0x39, 0x59, 0x81, 0x50, 0x15, 0x08, 0xc3, 0x0b,
-	0x08, 0xb3, 0x94, 0x51, 0x71, 0xe4, 0xb4, 0x83, 0xcf, 0xc0, 0xfd, 0xb4, 0x2a, 0x89, 0x6a, 0x82,
-	0x28, 0x2f, 0x05, 0xbd, 0x93, 0x63, 0xaa, 0xfe, 0x9d, 0xe0, 0xaa, 0x0b, 0x2e, 0xab, 0x1c, 0xd7,
-	0xd1, 0xa9, 0xc0, 0xf2, 0x1a, 0xc2, 0x11, 0x68, 0x67, 0x64, 0x89, 0x76, 0x53, 0xa0, 0xe5, 0xe6,
-	0xe0, 0x17, 0x70, 0x4f, 0xe9, 0xef, 0x93, 0x0d, 0x11, 0x60, 0xb7, 0x04, 0xd8, 0xa0, 0x1c, 0x98,
-	0xb0, 0x29, 0xaa, 0xb3, 0x56, 0xf0, 0x29, 0x68, 0x5d, 0xd7, 0x24, 0x4f, 0x43, 0xf0, 0x9c, 0x27,
-	0x26, 0xce, 0x76, 0x6f, 0xe8, 0xbb, 0xbd, 0xa1, 0xff, 0xdd, 0x1b, 0xfa, 0xf7, 0x83, 0xa1, 0xed,
-	0x0e, 0x86, 0xf6, 0xfb, 0x60, 0x68, 0x9f, 0x5f, 0xfa, 0x84, 0x07, 0xeb, 0x85, 0xe5, 0xb2, 0xa5,
-	0x1d, 0x20, 0x1a, 0x07, 0xab, 0xab, 0x45, 0x1f, 0xca, 0x4d, 0xff, 0x96, 0x5d, 0x7d, 0xbe, 0x09,
-	0x71, 0xbc, 0xa8, 0x8b, 0x9d, 0x1f, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x5f, 0x61, 0x6d,
-	0x64, 0x04, 0x00, 0x00,
+	// 782 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xcd, 0x6e, 0x13, 0x3b,
+	0x14, 0xc7, 0x93, 0xdb, 0xde, 0xde, 0x5b, 0xa7, 0x5f, 0x71, 0xd3, 0x12, 0x55, 0x22, 0x54, 0x48,
+	0x54, 0xa1, 0x1f, 0x09, 0xfd, 0x58, 0xb0, 0x6d, 0xaa, 0x42, 0x91, 0x10, 0x44, 0x29, 0x08, 0x09,
+	0x09, 0x15, 0x77, 0xea, 0xcc, 0x58, 0x24, 0xf6, 0x74, 0xc6, 0x29, 0xf4, 0x2d, 0xd8, 0xf0, 0x0e,
+	0x2c, 0x79, 0x8c, 0x2e, 0xbb, 0x64, 0x85, 0x50, 0xbb, 0xe0, 0x35, 0x90, 0x8f, 0x3d, 0x49, 0x66,
+	0x32, 0x64, 0xcc, 0x26, 0xca, 0x9c, 0xe3, 0xff, 0xff, 0xfc, 0x66, 0x7c, 0x7c, 0x8c, 0x36, 0xda,
+	0xac, 0x43, 0x43, 0x9f, 0x38, 0xd4, 0xf1, 0x08, 0xe3, 0xf5, 0xc4, 0xa3, 0x4b, 0x39, 0x0d, 0x59,
+	0x58, 0xf3, 0x03, 0x21, 0x05, 0xbe, 0x1b, 0xcf, 0xd6, 0xe2, 0x8f, 0x2b, 0x45, 0xd2, 0x65, 0x5c,
+	0xd4, 0xe1, 0x57, 0x2b, 0x56, 0x4a, 0xae, 0x70, 0x05, 0xfc, 0xad, 0xab, 0x7f, 0x26, 0xba, 0x3e,
+	0xbe, 0xa8, 0x4f, 0x02, 0xd2, 0x35, 0x35, 0x57, 0x6a, 0xe3, 0xd7, 0xaa, 0xc7, 0x13, 0xca, 0x65,
+	0x70, 0x69, 0xd6, 0xef, 0x8e, 0x5f, 0xef, 0x89, 0x50, 0x32, 0xee, 0x9e, 0x30, 0x7e, 0xde, 0x63,
+	0x7d, 0xd1, 0x9e, 0x9d, 0xc8, 0x11, 0x5c, 0x06, 0xc4, 0x91, 0x46, 0xb5, 0x6d, 0xa7, 0x12, 0xed,
+	0x36, 0x0d, 0xec, 0x24, 0xa1, 0x14, 0x01, 0x71, 0xe9, 0x89, 0x1f, 0x08, 0xd1, 0x36, 0x92, 0xcd,
+	0x0c, 0x49, 0x87, 0x84, 0x1e, 0xe3, 0xae, 0x59, 0xbd, 0x91, 0xf5, 0x69, 0x2f, 0xbb, 0x94, 0x4b,
+	0xbb, 0xc5, 0xa4, 0xe7, 0x48, 0x26, 0xb8, 0xdd, 0x37, 0xf2, 0x03, 0x71, 0xc1, 0xce, 0x68, 0xa0,
+	0xd8, 0x55, 0xca, 0x6e, 0xfb, 0x02, 0xea, 0xf7, 0x24, 0x19, 0x54, 0xb9, 0xff, 0x65, 0x1e, 0xcd,
+	0x3c, 0xd5, 0x4d, 0x77, 0x2c, 0x89, 0xa4, 0xf8, 0x08, 0x4d, 0xe9, 0x7e, 0x28, 0xe7, 0x57, 0xf3,
+	0xd5, 0xc2, 0xce, 0x83, 0xda, 0xd8, 0x26, 0xac, 0x35, 0x61, 0x71, 0x63, 0xfa, 0xea, 0xc7, 0xbd,
+	0xdc, 0xd7, 0x5f, 0xdf, 0xd6, 0xf3, 0x2d, 0xa3, 0xc7, 0xaf, 0xd0, 0xac, 0x5a, 0x7b, 0xa8, 0x9a,
+	0xe5, 0x39, 0x0b, 0x65, 0xf9, 0x9f, 0xd5, 0x89, 0x6a, 0x61, 0xa7, 0x9a, 0x61, 0xf8, 0x24, 0xd2,
+	0x34, 0x26, 0x95, 0x67, 0x2b, 0x6e, 0x82, 0xd7, 0xd0, 0x5c, 0x3f, 0x70, 0x20, 0x7a, 0x5c, 0x96,
+	0x27, 0x56, 0xf3, 0xd5, 0xc9, 0x56, 0x22, 0x8a, 0x1d, 0x84, 0x4d, 0x43, 0x3c, 0xd3, 0xad, 0x07,
+	0x08, 0x93, 0x80, 0xb0, 0x95, 0x81, 0x70, 0x14, 0x13, 0x1a, 0x8e, 0x14, 0x3b, 0xfc, 0x08, 0x2d,
+	0xc6, 0xa3, 0x9a, 0xe8, 0x5f, 0x20, 0x4a, 0x4b, 0xe1, 0x76, 0x5f, 0x71, 0x60, 0x9a, 0x1b, 0xb8,
+	0xa6, 0x80, 0xab, 0x66, 0xc7, 0x15, 0x29, 0x0d, 0x58, 0x9a, 0x21, 0xde, 0x41, 0xa5, 0x44, 0x58,
+	0xa3, 0xfd, 0x07, 0x68, 0xa9, 0x39, 0xfc, 0x0e, 0x2d, 0x98, 0xf8, 0x4b, 0x75, 0x84, 0x00, 0xec,
+	0x7f, 0x00, 0xdb, 0xb0, 0x03, 0x03, 0x99, 0xa1, 0x1a, 0xb1, 0xc2, 0x9b, 0xa8, 0x38, 0x1c, 0xd3,
+	0x3c, 0xd3, 0xc0, 0x33, 0x9a, 0xc0, 0x0c, 0x95, 0xcc, 0xe9, 0x3c, 0xf0, 0x48, 0xa7, 0x43, 0xb9,
+	0x4b, 0x01, 0x08, 0x01, 0x50, 0x3d, 0x03, 0xe8, 0x38, 0x21, 0x35, 0x50, 0xa9, 0x96, 0x78, 0x0f,
+	0x2d, 0x25, 0xe3, 0x1a, 0xae, 0x00, 0x70, 0xe9, 0x49, 0xfc, 0x06, 0xcd, 0xc1, 0x2c, 0x38, 0xbc,
+	0xa0, 0x5c, 0x6f, 0xe2, 0x0c, 0xa0, 0x3d, 0xcc, 0x42, 0xeb, 0x8b, 0x0c, 0x54, 0xc2, 0x06, 0x57,
+	0xd1, 0xfc, 0x20, 0xa2, 0x41, 0x66, 0x01, 0x24, 0x19, 0x56, 0x1b, 0x46, 0x43, 0x27, 0x10, 0x1f,
+	0x5b, 0xd4, 0x11, 0xc1, 0x19, 0x40, 0xcc, 0x59, 0x6d, 0xd8, 0xe1, 0x90, 0x2c, 0xda, 0xb0, 0xa4,
+	0x15, 0x7e, 0x8f, 0x8a, 0xd1, 0x94, 0x39, 0x96, 0xe4, 0x83, 0xfe, 0xfe, 0xf3, 0xe0, 0xbf, 0x99,
+	0x35, 0x15, 0x86, 0x75, 0xa6, 0xc0, 0xa8, 0x99, 0x3a, 0xa4, 0x66, 0x42, 0x1e, 0x31, 0xf5, 0x95,
+	0xf5, 0x21, 0x5d, 0xb0, 0x3a, 0xa4, 0xcd, 0x98, 0x30, 0x3a, 0xa4, 0xa3, 0x76, 0xaa, 0x48, 0x8f,
+	0x9f, 0x0a, 0x7e, 0xc6, 0xb8, 0x3b, 0x18, 0x46, 0x45, 0xab, 0x22, 0xaf, 0x63, 0xc2, 0xa8, 0xc8,
+	0xa8, 0x9d, 0x9a, 0x04, 0xf1, 0xa8, 0xde, 0x38, 0xac, 0x27, 0x41, 0x4a, 0x0a, 0xbf, 0x40, 0x05,
+	0x33, 0xf0, 0x81, 0x67, 0x11, 0x78, 0xd6, 0x32, 0x78, 0xf6, 0xb5, 0xc2, 0x80, 0x0c, 0x1b, 0xa8,
+	0x7e, 0x34, 0x8f, 0x0d, 0xa6, 0x5b, 0xa1, 0x64, 0xd5, 0x8f, 0xfb, 0x7d, 0x51, 0xd4, 0x8f, 0x71,
+	0x1b, 0x35, 0xb2, 0xa2, 0x9d, 0x6b, 0xea, 0xbb, 0x06, 0xdc, 0x97, 0xac, 0x46, 0x56, 0x33, 0xae,
+	0x8c, 0x46, 0x56, 0x8a, 0x21, 0x16, 0x68, 0x39, 0x0a, 0xb7, 0xfa, 0xd7, 0x14, 0x94, 0x5a, 0x86,
+	0x52, 0xdb, 0x96, 0xa5, 0x06, 0x62, 0x53, 0xed, 0x0f, 0xb6, 0xb1, 0x17, 0xd3, 0x6d, 0x03, 0xd5,
+	0xee, 0xfc, 0xdd, 0x8b, 0x69, 0xe5, 0xc8, 0x8b, 0x0d, 0x0c, 0xd5, 0x2c, 0x4e, 0x84, 0x75, 0x73,
+	0x94, 0xf5, 0x2c, 0x4e, 0xcb, 0x35, 0x5a, 0x57, 0x37, 0x95, 0xfc, 0xf5, 0x4d, 0x25, 0xff, 0xf3,
+	0xa6, 0x92, 0xff, 0x7c, 0x5b, 0xc9, 0x5d, 0xdf, 0x56, 0x72, 0xdf, 0x6f, 0x2b, 0xb9, 0xb7, 0x8f,
+	0x5d, 0x26, 0xbd, 0xde, 0x69, 0xcd, 0x11, 0xdd, 0xba, 0x47, 0x78, 0xe8, 0x9d, 0x0f, 0x2e, 0xf9,
+	0x2d, 0x7d, 0xcb, 0x7f, 0x4a, 0x5e, 0xfb, 0xf2, 0xd2, 0xa7, 0xe1, 0xe9, 0x14, 0x5c, 0xf9, 0xbb,
+	0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x7f, 0xa7, 0x85, 0x9e, 0x84, 0x0a, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -194,6 +335,198 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProviderPaymentCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ProviderPaymentCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.ProviderPaymentList) > 0 {
+		for iNdEx := len(m.ProviderPaymentList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderPaymentList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.ProviderReputationList) > 0 {
+		for iNdEx := len(m.ProviderReputationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderReputationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.ProviderProfileList) > 0 {
+		for iNdEx := len(m.ProviderProfileList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderProfileList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.AuctionBidList) > 0 {
+		for iNdEx := len(m.AuctionBidList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuctionBidList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.AuctionList) > 0 {
+		for iNdEx := len(m.AuctionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuctionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.UnbondingEntryCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondingEntryCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.UnbondingEntryList) > 0 {
+		for iNdEx := len(m.UnbondingEntryList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingEntryList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.PaymentHistoryList) > 0 {
+		for iNdEx := len(m.PaymentHistoryList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentHistoryList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.ProviderStakeList) > 0 {
+		for iNdEx := len(m.ProviderStakeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderStakeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.EscrowRecordList) > 0 {
+		for iNdEx := len(m.EscrowRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.SlashEventCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SlashEventCount))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.SlashEventList) > 0 {
+		for iNdEx := len(m.SlashEventList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashEventList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.StorageChallengeCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StorageChallengeCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.StorageChallengeList) > 0 {
+		for iNdEx := len(m.StorageChallengeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageChallengeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.HostingOfferCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.HostingOfferCount))
 		i--
@@ -338,6 +671,84 @@ func (m *GenesisState) Size() (n int) {
 	if m.HostingOfferCount != 0 {
 		n += 1 + sovGenesis(uint64(m.HostingOfferCount))
 	}
+	if len(m.StorageChallengeList) > 0 {
+		for _, e := range m.StorageChallengeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.StorageChallengeCount != 0 {
+		n += 1 + sovGenesis(uint64(m.StorageChallengeCount))
+	}
+	if len(m.SlashEventList) > 0 {
+		for _, e := range m.SlashEventList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SlashEventCount != 0 {
+		n += 1 + sovGenesis(uint64(m.SlashEventCount))
+	}
+	if len(m.EscrowRecordList) > 0 {
+		for _, e := range m.EscrowRecordList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProviderStakeList) > 0 {
+		for _, e := range m.ProviderStakeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PaymentHistoryList) > 0 {
+		for _, e := range m.PaymentHistoryList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbondingEntryList) > 0 {
+		for _, e := range m.UnbondingEntryList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.UnbondingEntryCount != 0 {
+		n += 2 + sovGenesis(uint64(m.UnbondingEntryCount))
+	}
+	if len(m.AuctionList) > 0 {
+		for _, e := range m.AuctionList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuctionBidList) > 0 {
+		for _, e := range m.AuctionBidList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProviderProfileList) > 0 {
+		for _, e := range m.ProviderProfileList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProviderReputationList) > 0 {
+		for _, e := range m.ProviderReputationList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProviderPaymentList) > 0 {
+		for _, e := range m.ProviderPaymentList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ProviderPaymentCount != 0 {
+		n += 2 + sovGenesis(uint64(m.ProviderPaymentCount))
+	}
 	return n
 }
 
@@ -621,6 +1032,456 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageChallengeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageChallengeList = append(m.StorageChallengeList, StorageChallenge{})
+			if err := m.StorageChallengeList[len(m.StorageChallengeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageChallengeCount", wireType)
+			}
+			m.StorageChallengeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageChallengeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashEventList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashEventList = append(m.SlashEventList, SlashEvent{})
+			if err := m.SlashEventList[len(m.SlashEventList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashEventCount", wireType)
+			}
+			m.SlashEventCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashEventCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowRecordList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowRecordList = append(m.EscrowRecordList, EscrowRecord{})
+			if err := m.EscrowRecordList[len(m.EscrowRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderStakeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderStakeList = append(m.ProviderStakeList, ProviderStake{})
+			if err := m.ProviderStakeList[len(m.ProviderStakeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentHistoryList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentHistoryList = append(m.PaymentHistoryList, PaymentHistory{})
+			if err := m.PaymentHistoryList[len(m.PaymentHistoryList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEntryList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingEntryList = append(m.UnbondingEntryList, UnbondingEntry{})
+			if err := m.UnbondingEntryList[len(m.UnbondingEntryList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEntryCount", wireType)
+			}
+			m.UnbondingEntryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingEntryCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionList = append(m.AuctionList, Auction{})
+			if err := m.AuctionList[len(m.AuctionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionBidList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionBidList = append(m.AuctionBidList, AuctionBid{})
+			if err := m.AuctionBidList[len(m.AuctionBidList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderProfileList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderProfileList = append(m.ProviderProfileList, ProviderProfile{})
+			if err := m.ProviderProfileList[len(m.ProviderProfileList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderReputationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderReputationList = append(m.ProviderReputationList, ProviderReputation{})
+			if err := m.ProviderReputationList[len(m.ProviderReputationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPaymentList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderPaymentList = append(m.ProviderPaymentList, ProviderPayment{})
+			if err := m.ProviderPaymentList[len(m.ProviderPaymentList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPaymentCount", wireType)
+			}
+			m.ProviderPaymentCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProviderPaymentCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
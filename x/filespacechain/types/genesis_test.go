@@ -3,6 +3,9 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/hanshq/filespace-chain/x/filespacechain/types"
 	"github.com/stretchr/testify/require"
 )
@@ -58,6 +61,113 @@ func TestGenesisState_Validate(t *testing.T) {
 					},
 				},
 				HostingOfferCount: 2,
+				StorageChallengeList: []types.StorageChallenge{
+					{
+						Id: 0,
+					},
+					{
+						Id: 1,
+					},
+				},
+				StorageChallengeCount: 2,
+				SlashEventList: []types.SlashEvent{
+					{
+						Id: 0,
+					},
+					{
+						Id: 1,
+					},
+				},
+				SlashEventCount: 2,
+				EscrowRecordList: []types.EscrowRecord{
+					{
+						InquiryId: 0,
+						Amount:    sdk.NewCoin("token", math.NewInt(1000)),
+					},
+					{
+						InquiryId: 1,
+						Amount:    sdk.NewCoin("token", math.NewInt(1000)),
+					},
+				},
+				ProviderStakeList: []types.ProviderStake{
+					{
+						Provider: "provider0",
+						Amount:   sdk.NewCoin("token", math.NewInt(1000000)),
+					},
+					{
+						Provider: "provider1",
+						Amount:   sdk.NewCoin("token", math.NewInt(1000000)),
+					},
+				},
+				PaymentHistoryList: []types.PaymentHistory{
+					{
+						ContractId: 0,
+						TotalPaid:  sdk.NewCoin("token", math.NewInt(100)),
+					},
+					{
+						ContractId: 1,
+						TotalPaid:  sdk.NewCoin("token", math.NewInt(100)),
+					},
+				},
+				UnbondingEntryList: []types.UnbondingEntry{
+					{
+						Id:     0,
+						Amount: sdk.NewCoin("token", math.NewInt(1000)),
+					},
+					{
+						Id:     1,
+						Amount: sdk.NewCoin("token", math.NewInt(1000)),
+					},
+				},
+				UnbondingEntryCount: 2,
+				AuctionList: []types.Auction{
+					{
+						InquiryId: 0,
+						CommitEnd: 10,
+						RevealEnd: 20,
+					},
+				},
+				AuctionBidList: []types.AuctionBid{
+					{
+						InquiryId: 0,
+						Provider:  "provider0",
+					},
+					{
+						InquiryId: 0,
+						Provider:  "provider1",
+					},
+				},
+				ProviderProfileList: []types.ProviderProfile{
+					{
+						Provider:      "provider0",
+						TotalCapacity: 1000,
+					},
+					{
+						Provider:      "provider1",
+						TotalCapacity: 1000,
+					},
+				},
+				ProviderReputationList: []types.ProviderReputation{
+					{
+						Provider: "provider0",
+						Score:    5000,
+					},
+					{
+						Provider: "provider1",
+						Score:    10000,
+					},
+				},
+				ProviderPaymentList: []types.ProviderPayment{
+					{
+						Id:     0,
+						Amount: sdk.NewCoin("token", math.NewInt(100)),
+					},
+					{
+						Id:     1,
+						Amount: sdk.NewCoin("token", math.NewInt(100)),
+					},
+				},
+				ProviderPaymentCount: 2,
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -166,6 +276,264 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated storageChallenge",
+			genState: &types.GenesisState{
+				StorageChallengeList: []types.StorageChallenge{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid storageChallenge count",
+			genState: &types.GenesisState{
+				StorageChallengeList: []types.StorageChallenge{
+					{
+						Id: 1,
+					},
+				},
+				StorageChallengeCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated slashEvent",
+			genState: &types.GenesisState{
+				SlashEventList: []types.SlashEvent{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid slashEvent count",
+			genState: &types.GenesisState{
+				SlashEventList: []types.SlashEvent{
+					{
+						Id: 1,
+					},
+				},
+				SlashEventCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated escrowRecord",
+			genState: &types.GenesisState{
+				EscrowRecordList: []types.EscrowRecord{
+					{
+						InquiryId: 0,
+						Amount:    sdk.NewCoin("token", math.NewInt(1000)),
+					},
+					{
+						InquiryId: 0,
+						Amount:    sdk.NewCoin("token", math.NewInt(1000)),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid escrowRecord amount",
+			genState: &types.GenesisState{
+				EscrowRecordList: []types.EscrowRecord{
+					{
+						InquiryId: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated providerStake",
+			genState: &types.GenesisState{
+				ProviderStakeList: []types.ProviderStake{
+					{
+						Provider: "provider0",
+						Amount:   sdk.NewCoin("token", math.NewInt(1000000)),
+					},
+					{
+						Provider: "provider0",
+						Amount:   sdk.NewCoin("token", math.NewInt(1000000)),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated paymentHistory",
+			genState: &types.GenesisState{
+				PaymentHistoryList: []types.PaymentHistory{
+					{
+						ContractId: 0,
+						TotalPaid:  sdk.NewCoin("token", math.NewInt(100)),
+					},
+					{
+						ContractId: 0,
+						TotalPaid:  sdk.NewCoin("token", math.NewInt(100)),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated unbondingEntry",
+			genState: &types.GenesisState{
+				UnbondingEntryList: []types.UnbondingEntry{
+					{
+						Id:     0,
+						Amount: sdk.NewCoin("token", math.NewInt(1000)),
+					},
+					{
+						Id:     0,
+						Amount: sdk.NewCoin("token", math.NewInt(1000)),
+					},
+				},
+				UnbondingEntryCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid unbondingEntry count",
+			genState: &types.GenesisState{
+				UnbondingEntryList: []types.UnbondingEntry{
+					{
+						Id:     1,
+						Amount: sdk.NewCoin("token", math.NewInt(1000)),
+					},
+				},
+				UnbondingEntryCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated auction",
+			genState: &types.GenesisState{
+				AuctionList: []types.Auction{
+					{
+						InquiryId: 0,
+					},
+					{
+						InquiryId: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated auctionBid",
+			genState: &types.GenesisState{
+				AuctionList: []types.Auction{
+					{
+						InquiryId: 0,
+					},
+				},
+				AuctionBidList: []types.AuctionBid{
+					{
+						InquiryId: 0,
+						Provider:  "provider0",
+					},
+					{
+						InquiryId: 0,
+						Provider:  "provider0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "auctionBid without auction",
+			genState: &types.GenesisState{
+				AuctionBidList: []types.AuctionBid{
+					{
+						InquiryId: 0,
+						Provider:  "provider0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated providerProfile",
+			genState: &types.GenesisState{
+				ProviderProfileList: []types.ProviderProfile{
+					{
+						Provider: "provider0",
+					},
+					{
+						Provider: "provider0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated providerReputation",
+			genState: &types.GenesisState{
+				ProviderReputationList: []types.ProviderReputation{
+					{
+						Provider: "provider0",
+					},
+					{
+						Provider: "provider0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "providerReputation score above maximum",
+			genState: &types.GenesisState{
+				ProviderReputationList: []types.ProviderReputation{
+					{
+						Provider: "provider0",
+						Score:    10001,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated providerPayment",
+			genState: &types.GenesisState{
+				ProviderPaymentList: []types.ProviderPayment{
+					{
+						Id:     0,
+						Amount: sdk.NewCoin("token", math.NewInt(100)),
+					},
+					{
+						Id:     0,
+						Amount: sdk.NewCoin("token", math.NewInt(100)),
+					},
+				},
+				ProviderPaymentCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid providerPayment count",
+			genState: &types.GenesisState{
+				ProviderPaymentList: []types.ProviderPayment{
+					{
+						Id:     1,
+						Amount: sdk.NewCoin("token", math.NewInt(100)),
+					},
+				},
+				ProviderPaymentCount: 0,
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -179,3 +547,88 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisState_ValidateBalances(t *testing.T) {
+	genState := types.GenesisState{
+		HostingContractList: []types.HostingContract{
+			{Id: 0, InquiryId: 0},
+			{Id: 1, InquiryId: 0},
+			{Id: 2, InquiryId: 1},
+		},
+		EscrowRecordList: []types.EscrowRecord{
+			{InquiryId: 0, Amount: sdk.NewCoin("token", math.NewInt(1000))},
+			{InquiryId: 1, Amount: sdk.NewCoin("token", math.NewInt(500))},
+		},
+		PaymentHistoryList: []types.PaymentHistory{
+			{ContractId: 0, TotalPaid: sdk.NewCoin("token", math.NewInt(100))},
+			{ContractId: 1, TotalPaid: sdk.NewCoin("token", math.NewInt(150))},
+			{ContractId: 2, TotalPaid: sdk.NewCoin("token", math.NewInt(50))},
+		},
+		ProviderStakeList: []types.ProviderStake{
+			{Provider: "provider0", Amount: sdk.NewCoin("token", math.NewInt(2000000))},
+		},
+		UnbondingEntryList: []types.UnbondingEntry{
+			{Id: 0, Amount: sdk.NewCoin("token", math.NewInt(500000))},
+		},
+		UnbondingEntryCount: 1,
+	}
+	escrow := sdk.NewCoins(sdk.NewCoin("token", math.NewInt(1200)))
+	bonded := sdk.NewCoins(sdk.NewCoin("token", math.NewInt(2500000)))
+
+	tests := []struct {
+		desc    string
+		escrow  sdk.Coins
+		bonded  sdk.Coins
+		genFunc func(gs *types.GenesisState)
+		valid   bool
+	}{
+		{
+			desc:   "balances match",
+			escrow: escrow,
+			bonded: bonded,
+			valid:  true,
+		},
+		{
+			desc:   "module account short of escrow",
+			escrow: escrow.Sub(sdk.NewCoin("token", math.OneInt())),
+			bonded: bonded,
+			valid:  false,
+		},
+		{
+			desc:   "module account holds untracked funds",
+			escrow: escrow.Add(sdk.NewCoin("stake", math.OneInt())),
+			bonded: bonded,
+			valid:  false,
+		},
+		{
+			desc:   "bonded pool short of stake",
+			escrow: escrow,
+			bonded: sdk.NewCoins(sdk.NewCoin("token", math.NewInt(2000000))),
+			valid:  false,
+		},
+		{
+			desc:   "paid out more than escrowed",
+			escrow: escrow,
+			bonded: bonded,
+			genFunc: func(gs *types.GenesisState) {
+				gs.PaymentHistoryList[2].TotalPaid = sdk.NewCoin("token", math.NewInt(501))
+			},
+			valid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			gs := genState
+			gs.PaymentHistoryList = append([]types.PaymentHistory{}, genState.PaymentHistoryList...)
+			if tc.genFunc != nil {
+				tc.genFunc(&gs)
+			}
+			err := gs.ValidateBalances(tc.escrow, tc.bonded)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
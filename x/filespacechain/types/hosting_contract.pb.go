@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -22,13 +24,68 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ContractStatus is the lifecycle state of a hosting contract. PENDING
+// contracts may become ACTIVE or TERMINATED; ACTIVE contracts end as
+// COMPLETED, TERMINATED or SLASHED. The last three are final.
+type ContractStatus int32
+
+const (
+	CONTRACT_STATUS_PENDING    ContractStatus = 0
+	CONTRACT_STATUS_ACTIVE     ContractStatus = 1
+	CONTRACT_STATUS_COMPLETED  ContractStatus = 2
+	CONTRACT_STATUS_TERMINATED ContractStatus = 3
+	CONTRACT_STATUS_SLASHED    ContractStatus = 4
+)
+
+var ContractStatus_name = map[int32]string{
+	0: "CONTRACT_STATUS_PENDING",
+	1: "CONTRACT_STATUS_ACTIVE",
+	2: "CONTRACT_STATUS_COMPLETED",
+	3: "CONTRACT_STATUS_TERMINATED",
+	4: "CONTRACT_STATUS_SLASHED",
+}
+
+var ContractStatus_value = map[string]int32{
+	"CONTRACT_STATUS_PENDING":    0,
+	"CONTRACT_STATUS_ACTIVE":     1,
+	"CONTRACT_STATUS_COMPLETED":  2,
+	"CONTRACT_STATUS_TERMINATED": 3,
+	"CONTRACT_STATUS_SLASHED":    4,
+}
+
+func (x ContractStatus) String() string {
+	return proto.EnumName(ContractStatus_name, int32(x))
+}
+
+func (ContractStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0c3c44867a41fc3e, []int{0}
+}
+
 type HostingContract struct {
-	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	InquiryId  uint64 `protobuf:"varint,2,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
-	OfferId    uint64 `protobuf:"varint,3,opt,name=offerId,proto3" json:"offerId,omitempty"`
-	Creator    string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
-	StartBlock uint64 `protobuf:"varint,5,opt,name=startBlock,proto3" json:"startBlock,omitempty"`
-	EndBlock   uint64 `protobuf:"varint,6,opt,name=endBlock,proto3" json:"endBlock,omitempty"`
+	Id           uint64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	InquiryId    uint64         `protobuf:"varint,2,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	OfferId      uint64         `protobuf:"varint,3,opt,name=offerId,proto3" json:"offerId,omitempty"`
+	Creator      string         `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	StartBlock   uint64         `protobuf:"varint,5,opt,name=startBlock,proto3" json:"startBlock,omitempty"`
+	EndBlock     uint64         `protobuf:"varint,6,opt,name=endBlock,proto3" json:"endBlock,omitempty"`
+	SlashedBlock uint64         `protobuf:"varint,7,opt,name=slashedBlock,proto3" json:"slashedBlock,omitempty"`
+	Status       ContractStatus `protobuf:"varint,8,opt,name=status,proto3,enum=filespacechain.filespacechain.ContractStatus" json:"status,omitempty"`
+	// Last block at which the provider may accept a PENDING contract
+	AcceptanceDeadline uint64 `protobuf:"varint,9,opt,name=acceptanceDeadline,proto3" json:"acceptanceDeadline,omitempty"`
+	// Merkle root the provider computed over its copy of the file on acceptance
+	Attestation []byte `protobuf:"bytes,10,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	// Chunk of the file, drawn when the contract opens, whose Merkle proof the
+	// provider submits to accept it
+	AcceptanceChunk uint64 `protobuf:"varint,11,opt,name=acceptanceChunk,proto3" json:"acceptanceChunk,omitempty"`
+	// Price per block the provider hosts for: the offer price of a matched
+	// contract, the clearing price of an auctioned one
+	PricePerBlock types.Coin `protobuf:"bytes,12,opt,name=pricePerBlock,proto3" json:"pricePerBlock"`
+	// Bytes of the provider's capacity the contract holds until it is final:
+	// the size of the file when the contract opened
+	ReservedCapacity uint64 `protobuf:"varint,13,opt,name=reservedCapacity,proto3" json:"reservedCapacity,omitempty"`
+	// Offer of the provider the contract's provider proposed to hand it over
+	// to; zero while no hand-over is proposed
+	TransferOfferId uint64 `protobuf:"varint,14,opt,name=transferOfferId,proto3" json:"transferOfferId,omitempty"`
 }
 
 func (m *HostingContract) Reset()         { *m = HostingContract{} }
@@ -106,7 +163,64 @@ func (m *HostingContract) GetEndBlock() uint64 {
 	return 0
 }
 
+func (m *HostingContract) GetSlashedBlock() uint64 {
+	if m != nil {
+		return m.SlashedBlock
+	}
+	return 0
+}
+
+func (m *HostingContract) GetStatus() ContractStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CONTRACT_STATUS_PENDING
+}
+
+func (m *HostingContract) GetAcceptanceDeadline() uint64 {
+	if m != nil {
+		return m.AcceptanceDeadline
+	}
+	return 0
+}
+
+func (m *HostingContract) GetAttestation() []byte {
+	if m != nil {
+		return m.Attestation
+	}
+	return nil
+}
+
+func (m *HostingContract) GetAcceptanceChunk() uint64 {
+	if m != nil {
+		return m.AcceptanceChunk
+	}
+	return 0
+}
+
+func (m *HostingContract) GetPricePerBlock() types.Coin {
+	if m != nil {
+		return m.PricePerBlock
+	}
+	return types.Coin{}
+}
+
+func (m *HostingContract) GetReservedCapacity() uint64 {
+	if m != nil {
+		return m.ReservedCapacity
+	}
+	return 0
+}
+
+func (m *HostingContract) GetTransferOfferId() uint64 {
+	if m != nil {
+		return m.TransferOfferId
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("filespacechain.filespacechain.ContractStatus", ContractStatus_name, ContractStatus_value)
 	proto.RegisterType((*HostingContract)(nil), "filespacechain.filespacechain.HostingContract")
 }
 
@@ -115,23 +229,42 @@ func init() {
 }
 
 var fileDescriptor_0c3c44867a41fc3e = []byte{
-	// 250 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x31, 0x4e, 0xc3, 0x30,
-	0x14, 0x86, 0xe3, 0x50, 0x0a, 0xf5, 0x00, 0x92, 0x27, 0x0b, 0x81, 0x55, 0x31, 0x75, 0x21, 0x1d,
-	0x60, 0x60, 0x2e, 0x0b, 0x5d, 0x33, 0xb2, 0x20, 0xd7, 0x76, 0x6a, 0x8b, 0x62, 0xa7, 0xf6, 0xab,
-	0x44, 0x6f, 0xc1, 0x4d, 0xb8, 0x06, 0x63, 0x47, 0x46, 0x94, 0x5c, 0x04, 0xe1, 0x50, 0xd2, 0x64,
-	0xfc, 0xde, 0xf7, 0xfe, 0xe5, 0xc3, 0x77, 0x85, 0x59, 0xa9, 0x50, 0x72, 0xa1, 0x84, 0xe6, 0xc6,
-	0x4e, 0x7b, 0xa8, 0x5d, 0x00, 0x63, 0x97, 0xcf, 0xc2, 0x59, 0xf0, 0x5c, 0x40, 0x56, 0x7a, 0x07,
-	0x8e, 0x5c, 0x75, 0xdf, 0xb2, 0x2e, 0x5e, 0x7f, 0x20, 0x7c, 0xfe, 0xd8, 0x2c, 0x1f, 0xfe, 0x86,
-	0xe4, 0x0c, 0xa7, 0x46, 0x52, 0x34, 0x46, 0x93, 0x41, 0x9e, 0x1a, 0x49, 0x2e, 0xf1, 0xc8, 0xd8,
-	0xf5, 0xc6, 0xf8, 0xed, 0x5c, 0xd2, 0x34, 0x9e, 0xdb, 0x03, 0xa1, 0xf8, 0xc4, 0x15, 0x85, 0xf2,
-	0x73, 0x49, 0x8f, 0xa2, 0xdb, 0xe3, 0xaf, 0x11, 0x5e, 0x71, 0x70, 0x9e, 0x0e, 0xc6, 0x68, 0x32,
-	0xca, 0xf7, 0x48, 0x18, 0xc6, 0x01, 0xb8, 0x87, 0xd9, 0xca, 0x89, 0x17, 0x7a, 0x1c, 0x67, 0x07,
-	0x17, 0x72, 0x81, 0x4f, 0x95, 0x95, 0x8d, 0x1d, 0x46, 0xfb, 0xcf, 0xb3, 0xfc, 0xb3, 0x62, 0x68,
-	0x57, 0x31, 0xf4, 0x5d, 0x31, 0xf4, 0x5e, 0xb3, 0x64, 0x57, 0xb3, 0xe4, 0xab, 0x66, 0xc9, 0xd3,
-	0xfd, 0xd2, 0x80, 0xde, 0x2c, 0x32, 0xe1, 0x5e, 0xa7, 0x9a, 0xdb, 0xa0, 0xd7, 0x6d, 0xa3, 0x9b,
-	0x26, 0xd2, 0x5b, 0xbf, 0x1a, 0x6c, 0x4b, 0x15, 0x16, 0xc3, 0xd8, 0xea, 0xf6, 0x27, 0x00, 0x00,
-	0xff, 0xff, 0x36, 0xc4, 0x2a, 0xc9, 0x63, 0x01, 0x00, 0x00,
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xcf, 0x4e, 0xdb, 0x4c,
+	0x14, 0xc5, 0xed, 0xe0, 0x8f, 0x3f, 0x03, 0x84, 0x68, 0xf4, 0xa9, 0x35, 0x6e, 0x71, 0x2d, 0x56,
+	0x16, 0x12, 0xb6, 0xa0, 0x5d, 0x74, 0x1b, 0x1c, 0xab, 0x44, 0x82, 0x80, 0x1c, 0xb7, 0x8b, 0x6e,
+	0xd0, 0x64, 0x3c, 0x89, 0x47, 0x84, 0x19, 0x33, 0x33, 0x41, 0xcd, 0x1b, 0x74, 0xd9, 0x77, 0xe8,
+	0xb2, 0xcb, 0xbe, 0x04, 0x4b, 0x96, 0x5d, 0x55, 0x55, 0xf2, 0x22, 0x95, 0xed, 0xa4, 0x21, 0x2e,
+	0xea, 0xce, 0xf7, 0xfc, 0xe6, 0xf8, 0xcc, 0xdc, 0xab, 0x0b, 0xde, 0xf4, 0xe9, 0x90, 0xc8, 0x0c,
+	0x61, 0x82, 0x53, 0x44, 0x99, 0x5f, 0x29, 0x53, 0x2e, 0x15, 0x65, 0x83, 0x2b, 0xcc, 0x99, 0x12,
+	0x08, 0x2b, 0x2f, 0x13, 0x5c, 0x71, 0xb8, 0xb7, 0x7c, 0xcc, 0x5b, 0x2e, 0xad, 0xff, 0x07, 0x7c,
+	0xc0, 0x8b, 0x93, 0x7e, 0xfe, 0x55, 0x9a, 0x2c, 0x1b, 0x73, 0x79, 0xc3, 0xa5, 0xdf, 0x43, 0x92,
+	0xf8, 0x77, 0x47, 0x3d, 0xa2, 0xd0, 0x91, 0x8f, 0x39, 0x65, 0x25, 0xdf, 0xff, 0x6e, 0x80, 0x9d,
+	0xd3, 0x32, 0x2f, 0x98, 0xc5, 0xc1, 0x3a, 0xa8, 0xd1, 0xc4, 0xd4, 0x1d, 0xdd, 0x35, 0xa2, 0x1a,
+	0x4d, 0xe0, 0x4b, 0xb0, 0x41, 0xd9, 0xed, 0x88, 0x8a, 0x71, 0x3b, 0x31, 0x6b, 0x85, 0xbc, 0x10,
+	0xa0, 0x09, 0xd6, 0x78, 0xbf, 0x4f, 0x44, 0x3b, 0x31, 0x57, 0x0a, 0x36, 0x2f, 0x73, 0x82, 0x05,
+	0x41, 0x8a, 0x0b, 0xd3, 0x70, 0x74, 0x77, 0x23, 0x9a, 0x97, 0xd0, 0x06, 0x40, 0x2a, 0x24, 0xd4,
+	0xc9, 0x90, 0xe3, 0x6b, 0xf3, 0xbf, 0xc2, 0xf6, 0x48, 0x81, 0x16, 0x58, 0x27, 0x2c, 0x29, 0xe9,
+	0x6a, 0x41, 0xff, 0xd4, 0x70, 0x1f, 0x6c, 0xc9, 0x21, 0x92, 0x29, 0x99, 0xf1, 0xb5, 0x82, 0x2f,
+	0x69, 0x30, 0x04, 0xab, 0x52, 0x21, 0x35, 0x92, 0xe6, 0xba, 0xa3, 0xbb, 0xf5, 0xe3, 0x43, 0xef,
+	0x9f, 0xbd, 0xf3, 0xe6, 0x4f, 0xef, 0x16, 0xa6, 0x68, 0x66, 0x86, 0x1e, 0x80, 0x08, 0x63, 0x92,
+	0x29, 0xc4, 0x30, 0x69, 0x11, 0x94, 0x0c, 0x29, 0x23, 0xe6, 0x46, 0x11, 0xf8, 0x04, 0x81, 0x0e,
+	0xd8, 0x44, 0x4a, 0x91, 0xdc, 0x4d, 0x39, 0x33, 0x81, 0xa3, 0xbb, 0x5b, 0xd1, 0x63, 0x09, 0xba,
+	0x60, 0x67, 0xe1, 0x0b, 0xd2, 0x11, 0xbb, 0x36, 0x37, 0x8b, 0xdf, 0x55, 0x65, 0x18, 0x82, 0xed,
+	0x4c, 0x50, 0x4c, 0x2e, 0x89, 0x28, 0xdf, 0xb9, 0xe5, 0xe8, 0xee, 0xe6, 0xf1, 0xae, 0x57, 0x0e,
+	0xd4, 0xcb, 0x07, 0xea, 0xcd, 0x06, 0xea, 0x05, 0x9c, 0xb2, 0x13, 0xe3, 0xfe, 0xe7, 0x2b, 0x2d,
+	0x5a, 0x76, 0xc1, 0x03, 0xd0, 0x10, 0x44, 0x12, 0x71, 0x47, 0x92, 0x00, 0x65, 0x08, 0x53, 0x35,
+	0x36, 0xb7, 0x8b, 0xc4, 0xbf, 0xf4, 0xfc, 0x72, 0x4a, 0x20, 0x26, 0xfb, 0x44, 0x5c, 0xcc, 0x26,
+	0x5a, 0x2f, 0x2f, 0x57, 0x91, 0x0f, 0xbe, 0xe9, 0xa0, 0xbe, 0xdc, 0x33, 0xf8, 0x02, 0x3c, 0x0f,
+	0x2e, 0x3a, 0x71, 0xd4, 0x0c, 0xe2, 0xab, 0x6e, 0xdc, 0x8c, 0xdf, 0x77, 0xaf, 0x2e, 0xc3, 0x4e,
+	0xab, 0xdd, 0x79, 0xd7, 0xd0, 0xa0, 0x05, 0x9e, 0x55, 0x61, 0x33, 0x88, 0xdb, 0x1f, 0xc2, 0x86,
+	0x0e, 0xf7, 0xc0, 0x6e, 0x95, 0x05, 0x17, 0xe7, 0x97, 0x67, 0x61, 0x1c, 0xb6, 0x1a, 0x35, 0x68,
+	0x03, 0xab, 0x8a, 0xe3, 0x30, 0x3a, 0x6f, 0x77, 0x9a, 0x39, 0x5f, 0x79, 0x2a, 0xb7, 0x7b, 0xd6,
+	0xec, 0x9e, 0x86, 0xad, 0x86, 0x61, 0x19, 0x9f, 0xbf, 0xda, 0xda, 0x49, 0x74, 0x3f, 0xb1, 0xf5,
+	0x87, 0x89, 0xad, 0xff, 0x9a, 0xd8, 0xfa, 0x97, 0xa9, 0xad, 0x3d, 0x4c, 0x6d, 0xed, 0xc7, 0xd4,
+	0xd6, 0x3e, 0xbe, 0x1d, 0x50, 0x95, 0x8e, 0x7a, 0x1e, 0xe6, 0x37, 0x7e, 0x8a, 0x98, 0x4c, 0x6f,
+	0x17, 0xbb, 0x78, 0x58, 0x2e, 0xe3, 0xa7, 0xea, 0x76, 0xaa, 0x71, 0x46, 0x64, 0x6f, 0xb5, 0x58,
+	0x9f, 0xd7, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xce, 0xf4, 0xe4, 0xa1, 0xcb, 0x03, 0x00, 0x00,
 }
 
 func (m *HostingContract) Marshal() (dAtA []byte, err error) {
@@ -154,6 +287,53 @@ func (m *HostingContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TransferOfferId != 0 {
+		i = encodeVarintHostingContract(dAtA, i, uint64(m.TransferOfferId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ReservedCapacity != 0 {
+		i = encodeVarintHostingContract(dAtA, i, uint64(m.ReservedCapacity))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size, err := m.PricePerBlock.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHostingContract(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.AcceptanceChunk != 0 {
+		i = encodeVarintHostingContract(dAtA, i, uint64(m.AcceptanceChunk))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Attestation) > 0 {
+		i -= len(m.Attestation)
+		copy(dAtA[i:], m.Attestation)
+		i = encodeVarintHostingContract(dAtA, i, uint64(len(m.Attestation)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.AcceptanceDeadline != 0 {
+		i = encodeVarintHostingContract(dAtA, i, uint64(m.AcceptanceDeadline))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Status != 0 {
+		i = encodeVarintHostingContract(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SlashedBlock != 0 {
+		i = encodeVarintHostingContract(dAtA, i, uint64(m.SlashedBlock))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.EndBlock != 0 {
 		i = encodeVarintHostingContract(dAtA, i, uint64(m.EndBlock))
 		i--
@@ -225,6 +405,30 @@ func (m *HostingContract) Size() (n int) {
 	if m.EndBlock != 0 {
 		n += 1 + sovHostingContract(uint64(m.EndBlock))
 	}
+	if m.SlashedBlock != 0 {
+		n += 1 + sovHostingContract(uint64(m.SlashedBlock))
+	}
+	if m.Status != 0 {
+		n += 1 + sovHostingContract(uint64(m.Status))
+	}
+	if m.AcceptanceDeadline != 0 {
+		n += 1 + sovHostingContract(uint64(m.AcceptanceDeadline))
+	}
+	l = len(m.Attestation)
+	if l > 0 {
+		n += 1 + l + sovHostingContract(uint64(l))
+	}
+	if m.AcceptanceChunk != 0 {
+		n += 1 + sovHostingContract(uint64(m.AcceptanceChunk))
+	}
+	l = m.PricePerBlock.Size()
+	n += 1 + l + sovHostingContract(uint64(l))
+	if m.ReservedCapacity != 0 {
+		n += 1 + sovHostingContract(uint64(m.ReservedCapacity))
+	}
+	if m.TransferOfferId != 0 {
+		n += 1 + sovHostingContract(uint64(m.TransferOfferId))
+	}
 	return n
 }
 
@@ -390,6 +594,187 @@ func (m *HostingContract) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBlock", wireType)
+			}
+			m.SlashedBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostingContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostingContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ContractStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptanceDeadline", wireType)
+			}
+			m.AcceptanceDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostingContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcceptanceDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostingContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHostingContract
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostingContract
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestation = append(m.Attestation[:0], dAtA[iNdEx:postIndex]...)
+			if m.Attestation == nil {
+				m.Attestation = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptanceChunk", wireType)
+			}
+			m.AcceptanceChunk = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostingContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcceptanceChunk |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricePerBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostingContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostingContract
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostingContract
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PricePerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedCapacity", wireType)
+			}
+			m.ReservedCapacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostingContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReservedCapacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferOfferId", wireType)
+			}
+			m.TransferOfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostingContract
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferOfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHostingContract(dAtA[iNdEx:])
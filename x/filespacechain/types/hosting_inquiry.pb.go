@@ -32,6 +32,16 @@ type HostingInquiry struct {
 	EndTime          uint64     `protobuf:"varint,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
 	Creator          string     `protobuf:"bytes,6,opt,name=creator,proto3" json:"creator,omitempty"`
 	MaxPricePerBlock uint64     `protobuf:"varint,7,opt,name=maxPricePerBlock,proto3" json:"maxPricePerBlock,omitempty"`
+	// File entry the inquiry hosts; CIDs are not unique, so contracts and
+	// challenges resolve the file through this id rather than fileEntryCid
+	FileEntryId uint64 `protobuf:"varint,8,opt,name=fileEntryId,proto3" json:"fileEntryId,omitempty"`
+	// Regions offers must be in to be matched; empty allows every region
+	AllowedRegions []string `protobuf:"bytes,9,rep,name=allowedRegions,proto3" json:"allowedRegions,omitempty"`
+	// Regions offers must not be in to be matched
+	DeniedRegions []string `protobuf:"bytes,10,rep,name=deniedRegions,proto3" json:"deniedRegions,omitempty"`
+	// Replicas held by contracts that have not reached a final status; the
+	// inquiry stays open to new offers while this is below replicationRate
+	FilledReplicas uint64 `protobuf:"varint,11,opt,name=filledReplicas,proto3" json:"filledReplicas,omitempty"`
 }
 
 func (m *HostingInquiry) Reset()         { *m = HostingInquiry{} }
@@ -116,6 +126,34 @@ func (m *HostingInquiry) GetMaxPricePerBlock() uint64 {
 	return 0
 }
 
+func (m *HostingInquiry) GetFileEntryId() uint64 {
+	if m != nil {
+		return m.FileEntryId
+	}
+	return 0
+}
+
+func (m *HostingInquiry) GetAllowedRegions() []string {
+	if m != nil {
+		return m.AllowedRegions
+	}
+	return nil
+}
+
+func (m *HostingInquiry) GetDeniedRegions() []string {
+	if m != nil {
+		return m.DeniedRegions
+	}
+	return nil
+}
+
+func (m *HostingInquiry) GetFilledReplicas() uint64 {
+	if m != nil {
+		return m.FilledReplicas
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*HostingInquiry)(nil), "filespacechain.filespacechain.HostingInquiry")
 }
@@ -125,29 +163,33 @@ func init() {
 }
 
 var fileDescriptor_6a298bb519cdbacc = []byte{
-	// 346 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x51, 0xc1, 0x6a, 0xdb, 0x40,
-	0x14, 0x94, 0x54, 0xd7, 0xa6, 0x5b, 0xe3, 0x16, 0xd1, 0xc3, 0xd6, 0x50, 0xd5, 0xf8, 0x24, 0x0a,
-	0x95, 0x70, 0x7c, 0xc9, 0x35, 0x36, 0x81, 0xe4, 0x66, 0x44, 0x4e, 0xb9, 0x84, 0xd5, 0x6a, 0x23,
-	0x3d, 0x62, 0xed, 0x93, 0x77, 0xd7, 0x89, 0xfd, 0x17, 0x81, 0xfc, 0x94, 0x8f, 0x3e, 0xe6, 0x14,
-	0x82, 0xfd, 0x23, 0x41, 0x52, 0x4c, 0x90, 0x73, 0xdb, 0x99, 0xf7, 0x66, 0x67, 0x78, 0x43, 0xc6,
-	0xb7, 0x30, 0x17, 0xba, 0x60, 0x5c, 0xf0, 0x8c, 0x81, 0x0c, 0x8f, 0x60, 0x86, 0xda, 0x80, 0x4c,
-	0x6f, 0x40, 0x2e, 0x96, 0xa0, 0xd6, 0x41, 0xa1, 0xd0, 0xa0, 0xfb, 0xa7, 0xb9, 0x15, 0x34, 0x61,
-	0xff, 0x57, 0x8a, 0x29, 0x56, 0x9b, 0x61, 0xf9, 0xaa, 0x45, 0x7d, 0x8f, 0xa3, 0xce, 0x51, 0x87,
-	0x31, 0xd3, 0x22, 0xbc, 0x1f, 0xc5, 0xc2, 0xb0, 0x51, 0xc8, 0x11, 0x64, 0x3d, 0x1f, 0x3e, 0x39,
-	0xa4, 0x77, 0x51, 0xdb, 0x5d, 0xd6, 0x6e, 0x6e, 0x8f, 0x38, 0x90, 0x50, 0x7b, 0x60, 0xfb, 0xad,
-	0xc8, 0x81, 0xc4, 0x1d, 0x92, 0x6e, 0x69, 0x75, 0x2e, 0x8d, 0x5a, 0x4f, 0x21, 0xa1, 0xce, 0xc0,
-	0xf6, 0xbf, 0x45, 0x0d, 0xce, 0xf5, 0xc9, 0x0f, 0x25, 0x8a, 0x39, 0x70, 0x66, 0x00, 0x65, 0xc4,
-	0x8c, 0xa0, 0x5f, 0xaa, 0x0f, 0x8e, 0x69, 0x77, 0x4a, 0xba, 0x42, 0x73, 0x85, 0x0f, 0x67, 0x39,
-	0x2e, 0xa5, 0xa1, 0xad, 0x81, 0xed, 0x7f, 0x3f, 0xf9, 0x1d, 0xd4, 0x39, 0x83, 0x32, 0x67, 0xf0,
-	0x9e, 0x33, 0x98, 0x22, 0xc8, 0x49, 0x6b, 0xf3, 0xf2, 0xd7, 0x8a, 0x1a, 0x22, 0x97, 0x92, 0x8e,
-	0x90, 0xc9, 0x15, 0xe4, 0x82, 0x7e, 0xad, 0x6c, 0x0e, 0xb0, 0x9c, 0x70, 0x25, 0x98, 0x41, 0x45,
-	0xdb, 0x55, 0xce, 0x03, 0x74, 0xff, 0x91, 0x9f, 0x39, 0x5b, 0xcd, 0x14, 0x70, 0x31, 0x13, 0x6a,
-	0x32, 0x47, 0x7e, 0x47, 0x3b, 0x95, 0xf8, 0x13, 0x3f, 0x89, 0x36, 0x3b, 0xcf, 0xde, 0xee, 0x3c,
-	0xfb, 0x75, 0xe7, 0xd9, 0x8f, 0x7b, 0xcf, 0xda, 0xee, 0x3d, 0xeb, 0x79, 0xef, 0x59, 0xd7, 0xa7,
-	0x29, 0x98, 0x6c, 0x19, 0x07, 0x1c, 0xf3, 0x30, 0x63, 0x52, 0x67, 0x8b, 0x8f, 0xf2, 0xfe, 0xd7,
-	0xed, 0xad, 0x8e, 0xeb, 0x34, 0xeb, 0x42, 0xe8, 0xb8, 0x5d, 0x1d, 0x7c, 0xfc, 0x16, 0x00, 0x00,
-	0xff, 0xff, 0x3b, 0x5c, 0x34, 0xaa, 0xfc, 0x01, 0x00, 0x00,
+	// 409 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xe3, 0x24, 0xb4, 0x64, 0x53, 0x02, 0x5a, 0x71, 0x58, 0x2a, 0x61, 0xac, 0x0a, 0x21,
+	0x0b, 0x09, 0x5b, 0xa5, 0x17, 0xae, 0x24, 0x42, 0xa2, 0xb7, 0xca, 0xe2, 0xc4, 0x05, 0x6d, 0xd6,
+	0x53, 0x7b, 0x84, 0xbd, 0x93, 0xee, 0x6e, 0x68, 0xf3, 0x16, 0xbc, 0x07, 0x2f, 0xd2, 0x63, 0x8f,
+	0x9c, 0x10, 0x4a, 0x5e, 0x04, 0x79, 0x4d, 0x29, 0x76, 0x6f, 0x9e, 0x6f, 0xff, 0xd9, 0xff, 0xf7,
+	0xea, 0x67, 0x27, 0xe7, 0x58, 0x81, 0x5d, 0x49, 0x05, 0xaa, 0x94, 0xa8, 0xd3, 0xde, 0x58, 0x92,
+	0x75, 0xa8, 0x8b, 0x2f, 0xa8, 0x2f, 0xd6, 0x68, 0x36, 0xc9, 0xca, 0x90, 0x23, 0xfe, 0xbc, 0xab,
+	0x4a, 0xba, 0xe3, 0xe1, 0xd3, 0x82, 0x0a, 0xf2, 0xca, 0xb4, 0xf9, 0x6a, 0x97, 0x0e, 0x43, 0x45,
+	0xb6, 0x26, 0x9b, 0x2e, 0xa5, 0x85, 0xf4, 0xdb, 0xf1, 0x12, 0x9c, 0x3c, 0x4e, 0x15, 0xa1, 0x6e,
+	0xcf, 0x8f, 0x7e, 0x8c, 0xd8, 0xec, 0x63, 0x6b, 0x77, 0xda, 0xba, 0xf1, 0x19, 0x1b, 0x62, 0x2e,
+	0x82, 0x28, 0x88, 0xc7, 0xd9, 0x10, 0x73, 0x7e, 0xc4, 0x0e, 0x1a, 0xab, 0x0f, 0xda, 0x99, 0xcd,
+	0x02, 0x73, 0x31, 0x8c, 0x82, 0x78, 0x92, 0x75, 0x18, 0x8f, 0xd9, 0x63, 0x03, 0xab, 0x0a, 0x95,
+	0x74, 0x48, 0x3a, 0x93, 0x0e, 0xc4, 0xc8, 0x5f, 0xd0, 0xc7, 0x7c, 0xc1, 0x0e, 0xc0, 0x2a, 0x43,
+	0x97, 0xef, 0x6b, 0x5a, 0x6b, 0x27, 0xc6, 0x51, 0x10, 0x4f, 0xdf, 0x3e, 0x4b, 0xda, 0x9c, 0x49,
+	0x93, 0x33, 0xf9, 0x9b, 0x33, 0x59, 0x10, 0xea, 0xf9, 0xf8, 0xfa, 0xd7, 0x8b, 0x41, 0xd6, 0x59,
+	0xe2, 0x82, 0xed, 0x83, 0xce, 0x3f, 0x61, 0x0d, 0xe2, 0x81, 0xb7, 0xb9, 0x1d, 0x9b, 0x13, 0x65,
+	0x40, 0x3a, 0x32, 0x62, 0xcf, 0xe7, 0xbc, 0x1d, 0xf9, 0x6b, 0xf6, 0xa4, 0x96, 0x57, 0x67, 0x06,
+	0x15, 0x9c, 0x81, 0x99, 0x57, 0xa4, 0xbe, 0x8a, 0x7d, 0xbf, 0x7c, 0x8f, 0xf3, 0x88, 0x4d, 0xff,
+	0xfd, 0xde, 0x69, 0x2e, 0x1e, 0x7a, 0xd9, 0xff, 0x88, 0xbf, 0x62, 0x33, 0x59, 0x55, 0x74, 0x09,
+	0x79, 0x06, 0x05, 0x92, 0xb6, 0x62, 0x12, 0x8d, 0xe2, 0x49, 0xd6, 0xa3, 0xfc, 0x25, 0x7b, 0x94,
+	0x83, 0xc6, 0x3b, 0x19, 0xf3, 0xb2, 0x2e, 0x6c, 0x6e, 0x3b, 0xc7, 0xaa, 0x6a, 0x80, 0x7f, 0x2d,
+	0x2b, 0xa6, 0xde, 0xb2, 0x47, 0xe7, 0xd9, 0xf5, 0x36, 0x0c, 0x6e, 0xb6, 0x61, 0xf0, 0x7b, 0x1b,
+	0x06, 0xdf, 0x77, 0xe1, 0xe0, 0x66, 0x17, 0x0e, 0x7e, 0xee, 0xc2, 0xc1, 0xe7, 0x77, 0x05, 0xba,
+	0x72, 0xbd, 0x4c, 0x14, 0xd5, 0x69, 0x29, 0xb5, 0x2d, 0x2f, 0xee, 0x4a, 0xf5, 0xa6, 0x6d, 0xd5,
+	0x55, 0xbf, 0x66, 0x6e, 0xb3, 0x02, 0xbb, 0xdc, 0xf3, 0x45, 0x38, 0xf9, 0x13, 0x00, 0x00, 0xff,
+	0xff, 0x73, 0xb6, 0xbe, 0x3d, 0x94, 0x02, 0x00, 0x00,
 }
 
 func (m *HostingInquiry) Marshal() (dAtA []byte, err error) {
@@ -170,6 +212,34 @@ func (m *HostingInquiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FilledReplicas != 0 {
+		i = encodeVarintHostingInquiry(dAtA, i, uint64(m.FilledReplicas))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.DeniedRegions) > 0 {
+		for iNdEx := len(m.DeniedRegions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedRegions[iNdEx])
+			copy(dAtA[i:], m.DeniedRegions[iNdEx])
+			i = encodeVarintHostingInquiry(dAtA, i, uint64(len(m.DeniedRegions[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AllowedRegions) > 0 {
+		for iNdEx := len(m.AllowedRegions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRegions[iNdEx])
+			copy(dAtA[i:], m.AllowedRegions[iNdEx])
+			i = encodeVarintHostingInquiry(dAtA, i, uint64(len(m.AllowedRegions[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.FileEntryId != 0 {
+		i = encodeVarintHostingInquiry(dAtA, i, uint64(m.FileEntryId))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.MaxPricePerBlock != 0 {
 		i = encodeVarintHostingInquiry(dAtA, i, uint64(m.MaxPricePerBlock))
 		i--
@@ -256,6 +326,24 @@ func (m *HostingInquiry) Size() (n int) {
 	if m.MaxPricePerBlock != 0 {
 		n += 1 + sovHostingInquiry(uint64(m.MaxPricePerBlock))
 	}
+	if m.FileEntryId != 0 {
+		n += 1 + sovHostingInquiry(uint64(m.FileEntryId))
+	}
+	if len(m.AllowedRegions) > 0 {
+		for _, s := range m.AllowedRegions {
+			l = len(s)
+			n += 1 + l + sovHostingInquiry(uint64(l))
+		}
+	}
+	if len(m.DeniedRegions) > 0 {
+		for _, s := range m.DeniedRegions {
+			l = len(s)
+			n += 1 + l + sovHostingInquiry(uint64(l))
+		}
+	}
+	if m.FilledReplicas != 0 {
+		n += 1 + sovHostingInquiry(uint64(m.FilledReplicas))
+	}
 	return n
 }
 
@@ -467,6 +555,108 @@ func (m *HostingInquiry) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileEntryId", wireType)
+			}
+			m.FileEntryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostingInquiry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileEntryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRegions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostingInquiry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostingInquiry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostingInquiry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRegions = append(m.AllowedRegions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedRegions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostingInquiry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostingInquiry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostingInquiry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedRegions = append(m.DeniedRegions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledReplicas", wireType)
+			}
+			m.FilledReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostingInquiry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FilledReplicas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHostingInquiry(dAtA[iNdEx:])
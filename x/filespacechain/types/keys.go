@@ -22,23 +22,111 @@ func KeyPrefix(p string) []byte {
 const (
 	FileEntryKey      = "FileEntry/value/"
 	FileEntryCountKey = "FileEntry/count/"
+	// FileEntryByCidKey indexes file entries by CID, then id
+	FileEntryByCidKey = "FileEntry/cid/"
 )
 
 const (
 	HostingInquiryKey      = "HostingInquiry/value/"
 	HostingInquiryCountKey = "HostingInquiry/count/"
+	// HostingInquiryByCreatorKey indexes inquiries by creator address, then id
+	HostingInquiryByCreatorKey = "HostingInquiry/creator/"
+	// HostingInquiryByFileEntryKey indexes inquiries by file entry id, then id
+	HostingInquiryByFileEntryKey = "HostingInquiry/fileentry/"
+	// HostingInquiryQueueKey queues inquiries by end time, then id
+	HostingInquiryQueueKey = "HostingInquiry/queue/"
+	// OpenHostingInquiryKey indexes inquiries that have not ended and have
+	// unfilled replicas by id
+	OpenHostingInquiryKey = "HostingInquiry/open/"
+	// OpenHostingInquiryCursorKey stores the open inquiry id the next offer
+	// starts matching at
+	OpenHostingInquiryCursorKey = "HostingInquiry/opencursor/"
 )
 
 const (
 	HostingContractKey      = "HostingContract/value/"
 	HostingContractCountKey = "HostingContract/count/"
+	// HostingContractByInquiryKey indexes contracts by inquiry id, then id
+	HostingContractByInquiryKey = "HostingContract/inquiry/"
+	// HostingContractByProviderKey indexes contracts by provider address, then id
+	HostingContractByProviderKey = "HostingContract/provider/"
+	// HostingContractByEndBlockKey indexes contracts by end block, then id
+	HostingContractByEndBlockKey = "HostingContract/endblock/"
+	// PendingContractQueueKey queues PENDING contracts by acceptance deadline, then id
+	PendingContractQueueKey = "HostingContract/pendingqueue/"
+	// ActiveContractQueueKey queues ACTIVE contracts by end block, then id
+	ActiveContractQueueKey = "HostingContract/activequeue/"
 )
 
 const (
 	HostingOfferKey      = "HostingOffer/value/"
 	HostingOfferCountKey = "HostingOffer/count/"
+	// HostingOfferByPriceKey indexes offers by price denom, then price per
+	// block, then id
+	HostingOfferByPriceKey = "HostingOffer/price/"
 )
 
 const (
 	PaymentHistoryKey = "PaymentHistory/value/"
+	// SettledPaymentHistoryQueueKey queues settled payment histories by last
+	// payment block, or their contract's end block if later, then contract id
+	SettledPaymentHistoryQueueKey = "PaymentHistory/settledqueue/"
+)
+
+const (
+	ProviderPaymentKey      = "ProviderPayment/value/"
+	ProviderPaymentCountKey = "ProviderPayment/count/"
+	// ProviderPaymentByProviderKey indexes provider payments by provider address, then id
+	ProviderPaymentByProviderKey = "ProviderPayment/provider/"
+	// ProviderPaymentByContractKey indexes provider payments by contract id, then id
+	ProviderPaymentByContractKey = "ProviderPayment/contract/"
+	// ProviderPaymentByHeightKey indexes provider payments by height, then id
+	ProviderPaymentByHeightKey = "ProviderPayment/height/"
+)
+
+const (
+	// EscrowRecordKey stores escrow records by inquiry id
+	EscrowRecordKey = "EscrowRecord/value/"
+	// ProviderStakeKey stores provider stakes by provider address
+	ProviderStakeKey = "ProviderStake/value/"
+)
+
+const (
+	// AuctionKey stores auctions by inquiry id
+	AuctionKey = "Auction/value/"
+	// AuctionBidKey stores bids by inquiry id, then provider address
+	AuctionBidKey = "Auction/bid/"
+	// AuctionQueueKey queues unsettled auctions by reveal end, then inquiry id
+	AuctionQueueKey = "Auction/queue/"
+)
+
+const (
+	// ProviderProfileKey stores provider profiles by provider address
+	ProviderProfileKey = "ProviderProfile/value/"
+	// ProviderReputationKey stores provider reputations by provider address
+	ProviderReputationKey = "ProviderReputation/value/"
+)
+
+const (
+	StorageChallengeKey        = "StorageChallenge/value/"
+	StorageChallengeCountKey   = "StorageChallenge/count/"
+	StorageChallengePendingKey = "StorageChallenge/pending/"
+	// StorageChallengeDeadlineKey queues pending challenges by deadline, then id
+	StorageChallengeDeadlineKey = "StorageChallenge/deadline/"
+	// ChallengeableContractKey indexes the contracts storage challenges are drawn from
+	ChallengeableContractKey = "StorageChallenge/contract/"
+)
+
+const (
+	SlashEventKey      = "SlashEvent/value/"
+	SlashEventCountKey = "SlashEvent/count/"
+)
+
+const (
+	UnbondingEntryKey      = "UnbondingEntry/value/"
+	UnbondingEntryCountKey = "UnbondingEntry/count/"
+	// UnbondingQueueKey indexes entries by completion height, then id
+	UnbondingQueueKey = "UnbondingEntry/queue/"
+	// UnbondingByProviderKey indexes entries by provider address, then id
+	UnbondingByProviderKey = "UnbondingEntry/provider/"
 )
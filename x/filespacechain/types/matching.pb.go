@@ -0,0 +1,468 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: filespacechain/filespacechain/matching.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OfferRejectionReason explains why the matching engine did not match a
+// hosting offer to an inquiry.
+type OfferRejectionReason int32
+
+const (
+	OFFER_REJECTION_REASON_UNSPECIFIED OfferRejectionReason = 0
+	// The offer is priced in another denom than the inquiry's escrow.
+	OFFER_REJECTION_REASON_DENOM_MISMATCH OfferRejectionReason = 1
+	// The offer's price per block is above the inquiry's maximum.
+	OFFER_REJECTION_REASON_PRICE_ABOVE_MAX OfferRejectionReason = 2
+	// The offer's region is not on the inquiry's allow list.
+	OFFER_REJECTION_REASON_REGION_NOT_ALLOWED OfferRejectionReason = 3
+	// The offer's region is on the inquiry's deny list.
+	OFFER_REJECTION_REASON_REGION_DENIED OfferRejectionReason = 4
+	// The offer's provider is below the minimum provider stake.
+	OFFER_REJECTION_REASON_INSUFFICIENT_STAKE OfferRejectionReason = 5
+	// The offer's provider holds or held a contract for the inquiry.
+	OFFER_REJECTION_REASON_PROVIDER_CONTRACTED OfferRejectionReason = 6
+	// A cheaper offer of the same provider was matched instead.
+	OFFER_REJECTION_REASON_DUPLICATE_PROVIDER OfferRejectionReason = 7
+	// Every open slot of the inquiry went to a cheaper offer.
+	OFFER_REJECTION_REASON_SLOTS_FILLED OfferRejectionReason = 8
+	// The file does not fit in the free capacity the offer's provider declared.
+	OFFER_REJECTION_REASON_INSUFFICIENT_CAPACITY OfferRejectionReason = 9
+)
+
+var OfferRejectionReason_name = map[int32]string{
+	0: "OFFER_REJECTION_REASON_UNSPECIFIED",
+	1: "OFFER_REJECTION_REASON_DENOM_MISMATCH",
+	2: "OFFER_REJECTION_REASON_PRICE_ABOVE_MAX",
+	3: "OFFER_REJECTION_REASON_REGION_NOT_ALLOWED",
+	4: "OFFER_REJECTION_REASON_REGION_DENIED",
+	5: "OFFER_REJECTION_REASON_INSUFFICIENT_STAKE",
+	6: "OFFER_REJECTION_REASON_PROVIDER_CONTRACTED",
+	7: "OFFER_REJECTION_REASON_DUPLICATE_PROVIDER",
+	8: "OFFER_REJECTION_REASON_SLOTS_FILLED",
+	9: "OFFER_REJECTION_REASON_INSUFFICIENT_CAPACITY",
+}
+
+var OfferRejectionReason_value = map[string]int32{
+	"OFFER_REJECTION_REASON_UNSPECIFIED":           0,
+	"OFFER_REJECTION_REASON_DENOM_MISMATCH":        1,
+	"OFFER_REJECTION_REASON_PRICE_ABOVE_MAX":       2,
+	"OFFER_REJECTION_REASON_REGION_NOT_ALLOWED":    3,
+	"OFFER_REJECTION_REASON_REGION_DENIED":         4,
+	"OFFER_REJECTION_REASON_INSUFFICIENT_STAKE":    5,
+	"OFFER_REJECTION_REASON_PROVIDER_CONTRACTED":   6,
+	"OFFER_REJECTION_REASON_DUPLICATE_PROVIDER":    7,
+	"OFFER_REJECTION_REASON_SLOTS_FILLED":          8,
+	"OFFER_REJECTION_REASON_INSUFFICIENT_CAPACITY": 9,
+}
+
+func (x OfferRejectionReason) String() string {
+	return proto.EnumName(OfferRejectionReason_name, int32(x))
+}
+
+func (OfferRejectionReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e2111cdbdb54f5d6, []int{0}
+}
+
+// OfferRejection records an offer the matching engine passed over.
+type OfferRejection struct {
+	OfferId  uint64               `protobuf:"varint,1,opt,name=offerId,proto3" json:"offerId,omitempty"`
+	Provider string               `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Reason   OfferRejectionReason `protobuf:"varint,3,opt,name=reason,proto3,enum=filespacechain.filespacechain.OfferRejectionReason" json:"reason,omitempty"`
+}
+
+func (m *OfferRejection) Reset()         { *m = OfferRejection{} }
+func (m *OfferRejection) String() string { return proto.CompactTextString(m) }
+func (*OfferRejection) ProtoMessage()    {}
+func (*OfferRejection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2111cdbdb54f5d6, []int{0}
+}
+func (m *OfferRejection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OfferRejection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OfferRejection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OfferRejection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OfferRejection.Merge(m, src)
+}
+func (m *OfferRejection) XXX_Size() int {
+	return m.Size()
+}
+func (m *OfferRejection) XXX_DiscardUnknown() {
+	xxx_messageInfo_OfferRejection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OfferRejection proto.InternalMessageInfo
+
+func (m *OfferRejection) GetOfferId() uint64 {
+	if m != nil {
+		return m.OfferId
+	}
+	return 0
+}
+
+func (m *OfferRejection) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *OfferRejection) GetReason() OfferRejectionReason {
+	if m != nil {
+		return m.Reason
+	}
+	return OFFER_REJECTION_REASON_UNSPECIFIED
+}
+
+func init() {
+	proto.RegisterEnum("filespacechain.filespacechain.OfferRejectionReason", OfferRejectionReason_name, OfferRejectionReason_value)
+	proto.RegisterType((*OfferRejection)(nil), "filespacechain.filespacechain.OfferRejection")
+}
+
+func init() {
+	proto.RegisterFile("filespacechain/filespacechain/matching.proto", fileDescriptor_e2111cdbdb54f5d6)
+}
+
+var fileDescriptor_e2111cdbdb54f5d6 = []byte{
+	// 455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x1c, 0xc4, 0xed, 0x26, 0xbf, 0xb4, 0xdd, 0x43, 0x65, 0xad, 0x7a, 0x88, 0x22, 0xfd, 0xac, 0xa8,
+	0x40, 0x49, 0xa3, 0xd6, 0x41, 0xf4, 0xc2, 0x75, 0xbb, 0xfe, 0x1a, 0x96, 0x3a, 0xde, 0x68, 0xbd,
+	0x29, 0x7f, 0x2e, 0x2b, 0xd7, 0x75, 0x62, 0x23, 0xea, 0x0d, 0x8e, 0x41, 0xf0, 0x06, 0x1c, 0x91,
+	0x78, 0x04, 0x5e, 0x86, 0x63, 0x8f, 0x5c, 0x90, 0x50, 0xf2, 0x22, 0x28, 0x29, 0x14, 0xa5, 0xc2,
+	0x11, 0x37, 0x8f, 0xf7, 0x33, 0xb3, 0x73, 0x98, 0x45, 0x87, 0xa3, 0xec, 0x75, 0x32, 0x9d, 0x44,
+	0x71, 0x12, 0xa7, 0x51, 0x96, 0xf7, 0x6e, 0xc9, 0xcb, 0xa8, 0x8c, 0xd3, 0x2c, 0x1f, 0x3b, 0x93,
+	0x42, 0x97, 0x1a, 0xff, 0xbf, 0x7a, 0xec, 0xac, 0xca, 0xd6, 0xee, 0x58, 0x8f, 0xf5, 0x92, 0xec,
+	0x2d, 0xbe, 0xae, 0x4d, 0x7b, 0x9f, 0x4d, 0xb4, 0xc3, 0x47, 0xa3, 0xa4, 0x10, 0xc9, 0xab, 0x24,
+	0x2e, 0x33, 0x9d, 0xe3, 0x26, 0xda, 0xd4, 0x8b, 0x3f, 0xec, 0xa2, 0x69, 0xb6, 0xcd, 0x4e, 0x5d,
+	0xfc, 0x96, 0xb8, 0x85, 0xb6, 0x26, 0x85, 0x7e, 0x97, 0x5d, 0x24, 0x45, 0x73, 0xa3, 0x6d, 0x76,
+	0xb6, 0xc5, 0x8d, 0xc6, 0xa7, 0xa8, 0x51, 0x24, 0xd1, 0x54, 0xe7, 0xcd, 0x5a, 0xdb, 0xec, 0xec,
+	0x3c, 0x3c, 0x76, 0xd6, 0xd6, 0x71, 0x56, 0x2f, 0x15, 0x4b, 0xab, 0xf8, 0x15, 0xd1, 0xfd, 0x5e,
+	0x43, 0xbb, 0x7f, 0x03, 0xf0, 0x3e, 0xda, 0xe3, 0x9e, 0x07, 0x42, 0x09, 0x78, 0x0a, 0x54, 0x32,
+	0x1e, 0x28, 0x01, 0x24, 0xe4, 0x81, 0x1a, 0x06, 0xe1, 0x00, 0x28, 0xf3, 0x18, 0xb8, 0x96, 0x81,
+	0x0f, 0xd0, 0xbd, 0x0a, 0xce, 0x85, 0x80, 0xf7, 0x55, 0x9f, 0x85, 0x7d, 0x22, 0xe9, 0x13, 0xcb,
+	0xc4, 0x5d, 0xb4, 0x5f, 0x81, 0x0e, 0x04, 0xa3, 0xa0, 0xc8, 0x09, 0x3f, 0x03, 0xd5, 0x27, 0xcf,
+	0xad, 0x0d, 0x7c, 0x84, 0x0e, 0x2a, 0x58, 0x01, 0x8f, 0x17, 0x2a, 0xe0, 0x52, 0x11, 0xdf, 0xe7,
+	0xcf, 0xc0, 0xb5, 0x6a, 0xb8, 0x83, 0xee, 0xae, 0xc7, 0x5d, 0x08, 0x16, 0x7d, 0xeb, 0x6b, 0x82,
+	0x59, 0x10, 0x0e, 0x3d, 0x8f, 0x51, 0x06, 0x81, 0x54, 0xa1, 0x24, 0xa7, 0x60, 0xfd, 0x87, 0x1d,
+	0xd4, 0xad, 0xec, 0xcc, 0xcf, 0x98, 0x0b, 0x42, 0x51, 0x1e, 0x48, 0x41, 0xa8, 0x04, 0xd7, 0x6a,
+	0xac, 0x89, 0x77, 0x87, 0x03, 0x9f, 0x51, 0x22, 0xe1, 0xc6, 0x69, 0x6d, 0xe2, 0xfb, 0xe8, 0x4e,
+	0x05, 0x1e, 0xfa, 0x5c, 0x86, 0xca, 0x63, 0xbe, 0x0f, 0xae, 0xb5, 0x85, 0x1f, 0xa0, 0xc3, 0x7f,
+	0xa9, 0x4d, 0xc9, 0x80, 0x50, 0x26, 0x5f, 0x58, 0xdb, 0xad, 0xfa, 0xc7, 0x2f, 0xb6, 0x71, 0x22,
+	0xbe, 0xce, 0x6c, 0xf3, 0x6a, 0x66, 0x9b, 0x3f, 0x66, 0xb6, 0xf9, 0x69, 0x6e, 0x1b, 0x57, 0x73,
+	0xdb, 0xf8, 0x36, 0xb7, 0x8d, 0x97, 0x8f, 0xc6, 0x59, 0x99, 0xbe, 0x3d, 0x77, 0x62, 0x7d, 0xd9,
+	0x4b, 0xa3, 0x7c, 0x9a, 0xbe, 0xf9, 0xb3, 0xfa, 0xa3, 0xeb, 0xd9, 0xbf, 0xbf, 0xfd, 0x0e, 0xca,
+	0x0f, 0x93, 0x64, 0x7a, 0xde, 0x58, 0x0e, 0xfa, 0xf8, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbb,
+	0x5b, 0x2c, 0xfa, 0x35, 0x03, 0x00, 0x00,
+}
+
+func (m *OfferRejection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OfferRejection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OfferRejection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		i = encodeVarintMatching(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintMatching(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OfferId != 0 {
+		i = encodeVarintMatching(dAtA, i, uint64(m.OfferId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMatching(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMatching(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OfferRejection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OfferId != 0 {
+		n += 1 + sovMatching(uint64(m.OfferId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovMatching(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovMatching(uint64(m.Reason))
+	}
+	return n
+}
+
+func sovMatching(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMatching(x uint64) (n int) {
+	return sovMatching(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OfferRejection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMatching
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OfferRejection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OfferRejection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			}
+			m.OfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMatching
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatching
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= OfferRejectionReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMatching(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMatching
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMatching(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMatching
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMatching
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMatching
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMatching
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMatching
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMatching
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMatching        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMatching          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMatching = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCommitBid{}
+
+func NewMsgCommitBid(creator string, inquiryId uint64, offerId uint64, commitment []byte) *MsgCommitBid {
+	return &MsgCommitBid{
+		Creator:    creator,
+		InquiryId:  inquiryId,
+		OfferId:    offerId,
+		Commitment: commitment,
+	}
+}
+
+func (msg *MsgCommitBid) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Commitment) != sha256.Size {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "commitment must be a %d byte hash, got %d bytes", sha256.Size, len(msg.Commitment))
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgRevealBid{}
+
+func NewMsgRevealBid(creator string, inquiryId uint64, price sdk.Coin, salt []byte) *MsgRevealBid {
+	return &MsgRevealBid{
+		Creator:   creator,
+		InquiryId: inquiryId,
+		Price:     price,
+		Salt:      salt,
+	}
+}
+
+func (msg *MsgRevealBid) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if !msg.Price.IsValid() || !msg.Price.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid bid price %s", msg.Price)
+	}
+	if len(msg.Salt) < MinBidSaltLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "salt must be at least %d bytes", MinBidSaltLength)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/hanshq/filespace-chain/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCommitBid_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCommitBid
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCommitBid{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "short commitment",
+			msg: MsgCommitBid{
+				Creator:    sample.AccAddress(),
+				Commitment: []byte("price"),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgCommitBid{
+				Creator:    sample.AccAddress(),
+				Commitment: bytes.Repeat([]byte{1}, 32),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRevealBid_ValidateBasic(t *testing.T) {
+	salt := bytes.Repeat([]byte{1}, MinBidSaltLength)
+	tests := []struct {
+		name string
+		msg  MsgRevealBid
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRevealBid{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero price",
+			msg: MsgRevealBid{
+				Creator: sample.AccAddress(),
+				Price:   sdk.NewCoin("token", math.ZeroInt()),
+				Salt:    salt,
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "short salt",
+			msg: MsgRevealBid{
+				Creator: sample.AccAddress(),
+				Price:   sdk.NewCoin("token", math.NewInt(5)),
+				Salt:    salt[1:],
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgRevealBid{
+				Creator: sample.AccAddress(),
+				Price:   sdk.NewCoin("token", math.NewInt(5)),
+				Salt:    salt,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBidCommitment(t *testing.T) {
+	price := sdk.NewCoin("token", math.NewInt(5))
+	salt := []byte("0123456789abcdef")
+	commitment := BidCommitment("provider", 1, price, salt)
+	require.Len(t, commitment, 32)
+	require.Equal(t, commitment, BidCommitment("provider", 1, price, salt))
+
+	require.NotEqual(t, commitment, BidCommitment("other", 1, price, salt))
+	require.NotEqual(t, commitment, BidCommitment("provider", 2, price, salt))
+	require.NotEqual(t, commitment, BidCommitment("provider", 1, sdk.NewCoin("token", math.NewInt(6)), salt))
+}
@@ -4,18 +4,23 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/merkle"
 )
 
 var _ sdk.Msg = &MsgCreateFileEntry{}
 
-func NewMsgCreateFileEntry(creator string, cid string, rootCid string, parentCid string, metaData string, fileSize uint64) *MsgCreateFileEntry {
+func NewMsgCreateFileEntry(creator string, cid string, rootCid string, parentCid string, metaData string, fileSize uint64, merkleRoot []byte, chunkCount uint64, chunkSize uint64) *MsgCreateFileEntry {
 	return &MsgCreateFileEntry{
-		Creator:   creator,
-		Cid:       cid,
-		RootCid:   rootCid,
-		ParentCid: parentCid,
-		MetaData:  metaData,
-		FileSize:  fileSize,
+		Creator:    creator,
+		Cid:        cid,
+		RootCid:    rootCid,
+		ParentCid:  parentCid,
+		MetaData:   metaData,
+		FileSize:   fileSize,
+		MerkleRoot: merkleRoot,
+		ChunkCount: chunkCount,
+		ChunkSize:  chunkSize,
 	}
 }
 
@@ -24,6 +29,28 @@ func (msg *MsgCreateFileEntry) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	return ValidateFileCommitment(msg.FileSize, msg.MerkleRoot, msg.ChunkCount, msg.ChunkSize)
+}
+
+// ValidateFileCommitment checks that a Merkle commitment is either absent or
+// consistent with the size of the file it commits to
+func ValidateFileCommitment(fileSize uint64, merkleRoot []byte, chunkCount uint64, chunkSize uint64) error {
+	if len(merkleRoot) == 0 && chunkCount == 0 && chunkSize == 0 {
+		return nil
+	}
+
+	if len(merkleRoot) != merkle.HashSize {
+		return errorsmod.Wrapf(ErrInvalidFileCommitment, "merkle root must be %d bytes, got %d", merkle.HashSize, len(merkleRoot))
+	}
+	if chunkSize == 0 || chunkSize > merkle.MaxChunkSize {
+		return errorsmod.Wrapf(ErrInvalidFileCommitment, "chunk size must be between 1 and %d, got %d", merkle.MaxChunkSize, chunkSize)
+	}
+	if fileSize == 0 {
+		return errorsmod.Wrap(ErrInvalidFileCommitment, "file size must be positive when a merkle root is set")
+	}
+	if expected := merkle.ChunkCount(fileSize, chunkSize); chunkCount != expected {
+		return errorsmod.Wrapf(ErrInvalidFileCommitment, "file of %d bytes in chunks of %d has %d chunks, got %d", fileSize, chunkSize, expected, chunkCount)
+	}
 	return nil
 }
 
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"testing"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -25,6 +26,53 @@ func TestMsgCreateFileEntry_ValidateBasic(t *testing.T) {
 			msg: MsgCreateFileEntry{
 				Creator: sample.AccAddress(),
 			},
+		}, {
+			name: "valid commitment",
+			msg: MsgCreateFileEntry{
+				Creator:    sample.AccAddress(),
+				FileSize:   9000,
+				MerkleRoot: bytes.Repeat([]byte{1}, 32),
+				ChunkCount: 3,
+				ChunkSize:  4096,
+			},
+		}, {
+			name: "chunk count inconsistent with file size",
+			msg: MsgCreateFileEntry{
+				Creator:    sample.AccAddress(),
+				FileSize:   9000,
+				MerkleRoot: bytes.Repeat([]byte{1}, 32),
+				ChunkCount: 2,
+				ChunkSize:  4096,
+			},
+			err: ErrInvalidFileCommitment,
+		}, {
+			name: "short merkle root",
+			msg: MsgCreateFileEntry{
+				Creator:    sample.AccAddress(),
+				FileSize:   9000,
+				MerkleRoot: []byte{1, 2, 3},
+				ChunkCount: 3,
+				ChunkSize:  4096,
+			},
+			err: ErrInvalidFileCommitment,
+		}, {
+			name: "chunk size too large",
+			msg: MsgCreateFileEntry{
+				Creator:    sample.AccAddress(),
+				FileSize:   9000,
+				MerkleRoot: bytes.Repeat([]byte{1}, 32),
+				ChunkCount: 1,
+				ChunkSize:  1 << 20,
+			},
+			err: ErrInvalidFileCommitment,
+		}, {
+			name: "partial commitment",
+			msg: MsgCreateFileEntry{
+				Creator:    sample.AccAddress(),
+				FileSize:   9000,
+				ChunkCount: 3,
+			},
+			err: ErrInvalidFileCommitment,
 		},
 	}
 	for _, tt := range tests {
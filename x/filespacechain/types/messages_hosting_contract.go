@@ -4,6 +4,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/hanshq/filespace-chain/x/filespacechain/merkle"
 )
 
 var _ sdk.Msg = &MsgCreateHostingContract{}
@@ -59,3 +61,99 @@ func (msg *MsgDeleteHostingContract) ValidateBasic() error {
 	}
 	return nil
 }
+
+var _ sdk.Msg = &MsgAcceptHostingContract{}
+
+func NewMsgAcceptHostingContract(creator string, contractId uint64, attestation []byte, proof ChunkProof) *MsgAcceptHostingContract {
+	return &MsgAcceptHostingContract{
+		Creator:     creator,
+		ContractId:  contractId,
+		Attestation: attestation,
+		Proof:       proof,
+	}
+}
+
+func (msg *MsgAcceptHostingContract) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Attestation) != merkle.HashSize {
+		return errorsmod.Wrapf(ErrInvalidAttestation, "attestation must be a %d byte merkle root, got %d bytes", merkle.HashSize, len(msg.Attestation))
+	}
+	if len(msg.Proof.Chunk) == 0 {
+		return errorsmod.Wrap(ErrInvalidStorageProof, "no chunk proof provided")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgRejectHostingContract{}
+
+func NewMsgRejectHostingContract(creator string, contractId uint64, reason string) *MsgRejectHostingContract {
+	return &MsgRejectHostingContract{
+		Creator:    creator,
+		ContractId: contractId,
+		Reason:     reason,
+	}
+}
+
+func (msg *MsgRejectHostingContract) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgAcceptHostingContractTransfer{}
+
+func NewMsgAcceptHostingContractTransfer(creator string, contractId uint64, attestation []byte, proof ChunkProof) *MsgAcceptHostingContractTransfer {
+	return &MsgAcceptHostingContractTransfer{
+		Creator:     creator,
+		ContractId:  contractId,
+		Attestation: attestation,
+		Proof:       proof,
+	}
+}
+
+func (msg *MsgAcceptHostingContractTransfer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Attestation) != merkle.HashSize {
+		return errorsmod.Wrapf(ErrInvalidAttestation, "attestation must be a %d byte merkle root, got %d bytes", merkle.HashSize, len(msg.Attestation))
+	}
+	if len(msg.Proof.Chunk) == 0 {
+		return errorsmod.Wrap(ErrInvalidStorageProof, "no chunk proof provided")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgTerminateHostingContract{}
+
+func NewMsgTerminateHostingContract(creator string, contractId uint64, reason string) *MsgTerminateHostingContract {
+	return &MsgTerminateHostingContract{
+		Creator:    creator,
+		ContractId: contractId,
+		Reason:     reason,
+	}
+}
+
+func (msg *MsgTerminateHostingContract) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgReassignHostingContract{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgReassignHostingContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	return nil
+}
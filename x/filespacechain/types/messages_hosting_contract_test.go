@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"testing"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -100,3 +101,159 @@ func TestMsgDeleteHostingContract_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgAcceptHostingContract_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAcceptHostingContract
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAcceptHostingContract{
+				Creator:     "invalid_address",
+				Attestation: bytes.Repeat([]byte{1}, 32),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing attestation",
+			msg: MsgAcceptHostingContract{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrInvalidAttestation,
+		}, {
+			name: "missing proof",
+			msg: MsgAcceptHostingContract{
+				Creator:     sample.AccAddress(),
+				Attestation: bytes.Repeat([]byte{1}, 32),
+			},
+			err: ErrInvalidStorageProof,
+		}, {
+			name: "valid",
+			msg: MsgAcceptHostingContract{
+				Creator:     sample.AccAddress(),
+				Attestation: bytes.Repeat([]byte{1}, 32),
+				Proof:       ChunkProof{Chunk: []byte("chunk")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRejectHostingContract_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRejectHostingContract
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRejectHostingContract{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgRejectHostingContract{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgAcceptHostingContractTransfer_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAcceptHostingContractTransfer
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAcceptHostingContractTransfer{
+				Creator:     "invalid_address",
+				Attestation: bytes.Repeat([]byte{1}, 32),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing attestation",
+			msg: MsgAcceptHostingContractTransfer{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrInvalidAttestation,
+		}, {
+			name: "missing proof",
+			msg: MsgAcceptHostingContractTransfer{
+				Creator:     sample.AccAddress(),
+				Attestation: bytes.Repeat([]byte{1}, 32),
+			},
+			err: ErrInvalidStorageProof,
+		}, {
+			name: "valid",
+			msg: MsgAcceptHostingContractTransfer{
+				Creator:     sample.AccAddress(),
+				Attestation: bytes.Repeat([]byte{1}, 32),
+				Proof:       ChunkProof{Chunk: []byte("chunk")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgTerminateHostingContract_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgTerminateHostingContract
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgTerminateHostingContract{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgTerminateHostingContract{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -23,6 +23,16 @@ func (msg *MsgCreateHostingInquiry) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	for _, allowed := range msg.AllowedRegions {
+		for _, denied := range msg.DeniedRegions {
+			if allowed == denied {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "region %s is both allowed and denied", allowed)
+			}
+		}
+	}
+	if (msg.AuctionCommitBlocks == 0) != (msg.AuctionRevealBlocks == 0) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "an auction needs both a commit and a reveal window")
+	}
 	return nil
 }
 
@@ -63,3 +73,25 @@ func (msg *MsgDeleteHostingInquiry) ValidateBasic() error {
 	}
 	return nil
 }
+
+var _ sdk.Msg = &MsgExtendHostingInquiry{}
+
+func NewMsgExtendHostingInquiry(creator string, id uint64, endTime uint64, additionalEscrow sdk.Coin) *MsgExtendHostingInquiry {
+	return &MsgExtendHostingInquiry{
+		Creator:          creator,
+		Id:               id,
+		EndTime:          endTime,
+		AdditionalEscrow: additionalEscrow,
+	}
+}
+
+func (msg *MsgExtendHostingInquiry) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if !msg.AdditionalEscrow.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid additional escrow %s", msg.AdditionalEscrow)
+	}
+	return nil
+}
@@ -3,6 +3,8 @@ package types
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/hanshq/filespace-chain/testutil/sample"
 	"github.com/stretchr/testify/require"
@@ -25,6 +27,21 @@ func TestMsgCreateHostingInquiry_ValidateBasic(t *testing.T) {
 			msg: MsgCreateHostingInquiry{
 				Creator: sample.AccAddress(),
 			},
+		}, {
+			name: "region allowed and denied",
+			msg: MsgCreateHostingInquiry{
+				Creator:        sample.AccAddress(),
+				AllowedRegions: []string{"eu", "us"},
+				DeniedRegions:  []string{"us"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "auction without reveal window",
+			msg: MsgCreateHostingInquiry{
+				Creator:             sample.AccAddress(),
+				AuctionCommitBlocks: 10,
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		},
 	}
 	for _, tt := range tests {
@@ -100,3 +117,41 @@ func TestMsgDeleteHostingInquiry_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgExtendHostingInquiry_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgExtendHostingInquiry
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgExtendHostingInquiry{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing additional escrow",
+			msg: MsgExtendHostingInquiry{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid",
+			msg: MsgExtendHostingInquiry{
+				Creator:          sample.AccAddress(),
+				AdditionalEscrow: sdk.NewCoin("token", math.NewInt(100)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
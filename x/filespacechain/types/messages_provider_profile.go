@@ -0,0 +1,47 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRegisterProviderProfile{}
+
+func NewMsgRegisterProviderProfile(creator string, moniker string, totalCapacity uint64, regions []string, endpoints []string) *MsgRegisterProviderProfile {
+	return &MsgRegisterProviderProfile{
+		Creator:       creator,
+		Moniker:       moniker,
+		TotalCapacity: totalCapacity,
+		Regions:       regions,
+		Endpoints:     endpoints,
+	}
+}
+
+func (msg *MsgRegisterProviderProfile) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return validateProfileFields(msg.Moniker, msg.TotalCapacity, msg.Regions, msg.Endpoints)
+}
+
+var _ sdk.Msg = &MsgUpdateProviderProfile{}
+
+func NewMsgUpdateProviderProfile(creator string, moniker string, totalCapacity uint64, regions []string, endpoints []string) *MsgUpdateProviderProfile {
+	return &MsgUpdateProviderProfile{
+		Creator:       creator,
+		Moniker:       moniker,
+		TotalCapacity: totalCapacity,
+		Regions:       regions,
+		Endpoints:     endpoints,
+	}
+}
+
+func (msg *MsgUpdateProviderProfile) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return validateProfileFields(msg.Moniker, msg.TotalCapacity, msg.Regions, msg.Endpoints)
+}
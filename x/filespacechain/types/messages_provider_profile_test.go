@@ -0,0 +1,106 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/hanshq/filespace-chain/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRegisterProviderProfile_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRegisterProviderProfile
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRegisterProviderProfile{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no capacity",
+			msg: MsgRegisterProviderProfile{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicate region",
+			msg: MsgRegisterProviderProfile{
+				Creator:       sample.AccAddress(),
+				TotalCapacity: 1 << 30,
+				Regions:       []string{"eu", "eu"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid endpoint",
+			msg: MsgRegisterProviderProfile{
+				Creator:       sample.AccAddress(),
+				TotalCapacity: 1 << 30,
+				Endpoints:     []string{"example.com:4001"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgRegisterProviderProfile{
+				Creator:       sample.AccAddress(),
+				Moniker:       "provider",
+				TotalCapacity: 1 << 30,
+				Regions:       []string{"eu", "us"},
+				Endpoints:     []string{"/ip4/192.0.2.1/tcp/4001", "/dns4/example.com/udp/4001/quic-v1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateProviderProfile_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUpdateProviderProfile
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateProviderProfile{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "long moniker",
+			msg: MsgUpdateProviderProfile{
+				Creator:       sample.AccAddress(),
+				Moniker:       string(make([]byte, MaxMonikerLength+1)),
+				TotalCapacity: 1 << 30,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgUpdateProviderProfile{
+				Creator:       sample.AccAddress(),
+				TotalCapacity: 1 << 30,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
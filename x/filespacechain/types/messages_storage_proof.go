@@ -0,0 +1,35 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSubmitStorageProof{}
+
+func NewMsgSubmitStorageProof(creator string, challengeId uint64, proofs []ChunkProof) *MsgSubmitStorageProof {
+	return &MsgSubmitStorageProof{
+		Creator:     creator,
+		ChallengeId: challengeId,
+		Proofs:      proofs,
+	}
+}
+
+func (msg *MsgSubmitStorageProof) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Proofs) == 0 {
+		return errorsmod.Wrap(ErrInvalidStorageProof, "no chunk proofs provided")
+	}
+	seen := make(map[uint64]bool, len(msg.Proofs))
+	for _, proof := range msg.Proofs {
+		if seen[proof.ChunkIndex] {
+			return errorsmod.Wrapf(ErrInvalidStorageProof, "duplicate proof for chunk %d", proof.ChunkIndex)
+		}
+		seen[proof.ChunkIndex] = true
+	}
+	return nil
+}
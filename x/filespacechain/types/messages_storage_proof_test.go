@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/hanshq/filespace-chain/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSubmitStorageProof_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSubmitStorageProof
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSubmitStorageProof{
+				Creator: "invalid_address",
+				Proofs:  []ChunkProof{{ChunkIndex: 0}},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no proofs",
+			msg: MsgSubmitStorageProof{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrInvalidStorageProof,
+		}, {
+			name: "duplicate chunk index",
+			msg: MsgSubmitStorageProof{
+				Creator: sample.AccAddress(),
+				Proofs:  []ChunkProof{{ChunkIndex: 1}, {ChunkIndex: 1}},
+			},
+			err: ErrInvalidStorageProof,
+		}, {
+			name: "valid",
+			msg: MsgSubmitStorageProof{
+				Creator: sample.AccAddress(),
+				Proofs:  []ChunkProof{{ChunkIndex: 0}, {ChunkIndex: 4}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -4,17 +4,40 @@ import (
 	"fmt"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 var (
-	KeyBasePricePerBytePerBlock = []byte("BasePricePerBytePerBlock")
-	KeyMinProviderStake         = []byte("MinProviderStake")
-	KeySlashingFraction         = []byte("SlashingFraction")
+	KeyMinProviderStake                 = []byte("MinProviderStake")
+	KeySlashingFraction                 = []byte("SlashingFraction")
+	KeyChallengesPerBlock               = []byte("ChallengesPerBlock")
+	KeyChunksPerChallenge               = []byte("ChunksPerChallenge")
+	KeyChallengeWindow                  = []byte("ChallengeWindow")
+	KeyAcceptanceDeadline               = []byte("AcceptanceDeadline")
+	KeyUnbondingPeriod                  = []byte("UnbondingPeriod")
+	KeyCollateralRatio                  = []byte("CollateralRatio")
+	KeySlashDestination                 = []byte("SlashDestination")
+	KeyMaxExpiriesPerBlock              = []byte("MaxExpiriesPerBlock")
+	KeyReputationWeight                 = []byte("ReputationWeight")
+	KeyReputationHalfLife               = []byte("ReputationHalfLife")
+	KeyDustDestination                  = []byte("DustDestination")
+	KeyAcceptedDenoms                   = []byte("AcceptedDenoms")
+	KeyCompletionBonusFraction          = []byte("CompletionBonusFraction")
+	KeyProviderTerminationSlashFraction = []byte("ProviderTerminationSlashFraction")
+	KeyClientTerminationPenaltyFraction = []byte("ClientTerminationPenaltyFraction")
+	KeyBondDenom                        = []byte("BondDenom")
+	KeyOfferMatchScanLimit              = []byte("OfferMatchScanLimit")
 )
 
+// DefaultPaymentDenom is the denom storage is paid in by default
+const DefaultPaymentDenom = "token"
+
+// DefaultBondDenom is the denom providers stake in by default
+const DefaultBondDenom = "token"
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -22,60 +45,177 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 // NewParams creates a new Params instance
 func NewParams(
-	basePricePerBytePerBlock math.LegacyDec,
 	minProviderStake math.Int,
 	slashingFraction math.LegacyDec,
+	challengesPerBlock uint64,
+	chunksPerChallenge uint64,
+	challengeWindow uint64,
+	acceptanceDeadline uint64,
+	unbondingPeriod uint64,
+	collateralRatio math.LegacyDec,
+	slashDestination SlashDestination,
+	maxExpiriesPerBlock uint64,
+	reputationWeight math.LegacyDec,
+	reputationHalfLife uint64,
+	dustDestination DustDestination,
+	acceptedDenoms []PaymentDenom,
+	completionBonusFraction math.LegacyDec,
+	providerTerminationSlashFraction math.LegacyDec,
+	clientTerminationPenaltyFraction math.LegacyDec,
+	bondDenom string,
+	offerMatchScanLimit uint64,
 ) Params {
 	return Params{
-		BasePricePerBytePerBlock: basePricePerBytePerBlock,
-		MinProviderStake:         minProviderStake,
-		SlashingFraction:         slashingFraction,
+		MinProviderStake:                 minProviderStake,
+		SlashingFraction:                 slashingFraction,
+		ChallengesPerBlock:               challengesPerBlock,
+		ChunksPerChallenge:               chunksPerChallenge,
+		ChallengeWindow:                  challengeWindow,
+		AcceptanceDeadline:               acceptanceDeadline,
+		UnbondingPeriod:                  unbondingPeriod,
+		CollateralRatio:                  collateralRatio,
+		SlashDestination:                 slashDestination,
+		MaxExpiriesPerBlock:              maxExpiriesPerBlock,
+		ReputationWeight:                 reputationWeight,
+		ReputationHalfLife:               reputationHalfLife,
+		DustDestination:                  dustDestination,
+		AcceptedDenoms:                   acceptedDenoms,
+		CompletionBonusFraction:          completionBonusFraction,
+		ProviderTerminationSlashFraction: providerTerminationSlashFraction,
+		ClientTerminationPenaltyFraction: clientTerminationPenaltyFraction,
+		BondDenom:                        bondDenom,
+		OfferMatchScanLimit:              offerMatchScanLimit,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
 	return NewParams(
-		math.LegacyNewDecWithPrec(1, 12), // 0.000000000001 (1e-12)
 		math.NewInt(1000000),             // 1,000,000 base units
 		math.LegacyNewDecWithPrec(5, 2),  // 0.05 (5%)
+		10,                               // contracts challenged per block
+		3,                                // chunks sampled per challenge
+		50,                               // blocks to answer a challenge
+		100,                              // blocks a provider has to accept a contract
+		100800,                           // blocks unstaked funds stay bonded (about a week)
+		math.LegacyNewDecWithPrec(1, 1),  // 0.1 (10%) of active contract value stays bonded
+		SLASH_DESTINATION_BURN,           // slashed stake is burned
+		100,                              // queued expiries processed per block
+		math.LegacyNewDecWithPrec(5, 1),  // 0.5 (50%) weight of reputation next to price
+		100800,                           // blocks for reputation to decay halfway to neutral (about a week)
+		DUST_DESTINATION_INQUIRY_CREATOR, // payment dust goes back to the inquiry creator
+		[]PaymentDenom{
+			{Denom: DefaultPaymentDenom, BasePricePerBytePerBlock: math.LegacyNewDecWithPrec(1, 12)}, // 0.000000000001 (1e-12)
+		},
+		math.LegacyNewDecWithPrec(5, 1), // 0.5 (50%) of a contract's charge paid on completion
+		math.LegacyNewDecWithPrec(1, 2), // 0.01 (1%) of stake slashed from a provider walking away
+		math.LegacyNewDecWithPrec(1, 1), // 0.1 (10%) of the unearned charge paid by a client walking away
+		DefaultBondDenom,                // providers stake in the default payment denom
+		100,                             // open inquiries a new or updated offer is matched against
 	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
-		paramtypes.NewParamSetPair(KeyBasePricePerBytePerBlock, &p.BasePricePerBytePerBlock, validateBasePricePerBytePerBlock),
 		paramtypes.NewParamSetPair(KeyMinProviderStake, &p.MinProviderStake, validateMinProviderStake),
 		paramtypes.NewParamSetPair(KeySlashingFraction, &p.SlashingFraction, validateSlashingFraction),
+		paramtypes.NewParamSetPair(KeyChallengesPerBlock, &p.ChallengesPerBlock, validateChallengesPerBlock),
+		paramtypes.NewParamSetPair(KeyChunksPerChallenge, &p.ChunksPerChallenge, validateChunksPerChallenge),
+		paramtypes.NewParamSetPair(KeyChallengeWindow, &p.ChallengeWindow, validateChallengeWindow),
+		paramtypes.NewParamSetPair(KeyAcceptanceDeadline, &p.AcceptanceDeadline, validateAcceptanceDeadline),
+		paramtypes.NewParamSetPair(KeyUnbondingPeriod, &p.UnbondingPeriod, validateUnbondingPeriod),
+		paramtypes.NewParamSetPair(KeyCollateralRatio, &p.CollateralRatio, validateCollateralRatio),
+		paramtypes.NewParamSetPair(KeySlashDestination, &p.SlashDestination, validateSlashDestination),
+		paramtypes.NewParamSetPair(KeyMaxExpiriesPerBlock, &p.MaxExpiriesPerBlock, validateMaxExpiriesPerBlock),
+		paramtypes.NewParamSetPair(KeyReputationWeight, &p.ReputationWeight, validateReputationWeight),
+		paramtypes.NewParamSetPair(KeyReputationHalfLife, &p.ReputationHalfLife, validateReputationHalfLife),
+		paramtypes.NewParamSetPair(KeyDustDestination, &p.DustDestination, validateDustDestination),
+		paramtypes.NewParamSetPair(KeyAcceptedDenoms, &p.AcceptedDenoms, validateAcceptedDenoms),
+		paramtypes.NewParamSetPair(KeyCompletionBonusFraction, &p.CompletionBonusFraction, validateCompletionBonusFraction),
+		paramtypes.NewParamSetPair(KeyProviderTerminationSlashFraction, &p.ProviderTerminationSlashFraction, validateProviderTerminationSlashFraction),
+		paramtypes.NewParamSetPair(KeyClientTerminationPenaltyFraction, &p.ClientTerminationPenaltyFraction, validateClientTerminationPenaltyFraction),
+		paramtypes.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
+		paramtypes.NewParamSetPair(KeyOfferMatchScanLimit, &p.OfferMatchScanLimit, validateOfferMatchScanLimit),
 	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	if err := validateBasePricePerBytePerBlock(p.BasePricePerBytePerBlock); err != nil {
-		return err
-	}
 	if err := validateMinProviderStake(p.MinProviderStake); err != nil {
 		return err
 	}
 	if err := validateSlashingFraction(p.SlashingFraction); err != nil {
 		return err
 	}
+	if err := validateChallengesPerBlock(p.ChallengesPerBlock); err != nil {
+		return err
+	}
+	if err := validateChunksPerChallenge(p.ChunksPerChallenge); err != nil {
+		return err
+	}
+	if err := validateChallengeWindow(p.ChallengeWindow); err != nil {
+		return err
+	}
+	if err := validateAcceptanceDeadline(p.AcceptanceDeadline); err != nil {
+		return err
+	}
+	if err := validateUnbondingPeriod(p.UnbondingPeriod); err != nil {
+		return err
+	}
+	if err := validateCollateralRatio(p.CollateralRatio); err != nil {
+		return err
+	}
+	if err := validateSlashDestination(p.SlashDestination); err != nil {
+		return err
+	}
+	if err := validateMaxExpiriesPerBlock(p.MaxExpiriesPerBlock); err != nil {
+		return err
+	}
+	if err := validateReputationWeight(p.ReputationWeight); err != nil {
+		return err
+	}
+	if err := validateReputationHalfLife(p.ReputationHalfLife); err != nil {
+		return err
+	}
+	if err := validateDustDestination(p.DustDestination); err != nil {
+		return err
+	}
+	if err := validateAcceptedDenoms(p.AcceptedDenoms); err != nil {
+		return err
+	}
+	if err := validateCompletionBonusFraction(p.CompletionBonusFraction); err != nil {
+		return err
+	}
+	if err := validateProviderTerminationSlashFraction(p.ProviderTerminationSlashFraction); err != nil {
+		return err
+	}
+	if err := validateClientTerminationPenaltyFraction(p.ClientTerminationPenaltyFraction); err != nil {
+		return err
+	}
+	if err := validateBondDenom(p.BondDenom); err != nil {
+		return err
+	}
+	if err := validateOfferMatchScanLimit(p.OfferMatchScanLimit); err != nil {
+		return err
+	}
 	return nil
 }
 
-func validateBasePricePerBytePerBlock(i interface{}) error {
-	v, ok := i.(math.LegacyDec)
-	if !ok {
-		return fmt.Errorf("invalid parameter type: %T", i)
-	}
-
-	if v.IsNegative() {
-		return fmt.Errorf("base price per byte per block must be positive: %s", v)
+// PaymentDenom returns the accepted payment denom with the given name
+func (p Params) PaymentDenom(denom string) (PaymentDenom, bool) {
+	for _, accepted := range p.AcceptedDenoms {
+		if accepted.Denom == denom {
+			return accepted, true
+		}
 	}
+	return PaymentDenom{}, false
+}
 
-	return nil
+// IsAcceptedDenom reports whether storage can be paid in the given denom
+func (p Params) IsAcceptedDenom(denom string) bool {
+	_, found := p.PaymentDenom(denom)
+	return found
 }
 
 func validateMinProviderStake(i interface{}) error {
@@ -107,3 +247,246 @@ func validateSlashingFraction(i interface{}) error {
 
 	return nil
 }
+
+func validateChallengesPerBlock(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// Zero disables storage challenges
+	return nil
+}
+
+func validateChunksPerChallenge(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("chunks per challenge must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateChallengeWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("challenge window must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateAcceptanceDeadline(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("acceptance deadline must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateUnbondingPeriod(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// Zero returns unstaked funds at the end of the same block
+	return nil
+}
+
+func validateCollateralRatio(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("collateral ratio must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateSlashDestination(i interface{}) error {
+	v, ok := i.(SlashDestination)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, known := SlashDestination_name[int32(v)]; !known {
+		return fmt.Errorf("unknown slash destination: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxExpiriesPerBlock(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max expiries per block must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateReputationWeight(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("reputation weight must be positive: %s", v)
+	}
+
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("reputation weight must be less than or equal to 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateReputationHalfLife(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// Zero keeps reputation from decaying
+	return nil
+}
+
+func validateDustDestination(i interface{}) error {
+	v, ok := i.(DustDestination)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, known := DustDestination_name[int32(v)]; !known {
+		return fmt.Errorf("unknown dust destination: %d", v)
+	}
+
+	return nil
+}
+
+func validateAcceptedDenoms(i interface{}) error {
+	v, ok := i.([]PaymentDenom)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(v) == 0 {
+		return fmt.Errorf("at least one payment denom must be accepted")
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, accepted := range v {
+		if err := sdk.ValidateDenom(accepted.Denom); err != nil {
+			return fmt.Errorf("invalid payment denom: %w", err)
+		}
+		if seen[accepted.Denom] {
+			return fmt.Errorf("duplicate payment denom: %s", accepted.Denom)
+		}
+		seen[accepted.Denom] = true
+
+		if accepted.BasePricePerBytePerBlock.IsNil() || accepted.BasePricePerBytePerBlock.IsNegative() {
+			return fmt.Errorf("base price per byte per block of %s must be positive: %s", accepted.Denom, accepted.BasePricePerBytePerBlock)
+		}
+	}
+
+	return nil
+}
+
+func validateCompletionBonusFraction(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("completion bonus fraction must be positive: %s", v)
+	}
+
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("completion bonus fraction must be less than or equal to 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateProviderTerminationSlashFraction(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("provider termination slash fraction must be positive: %s", v)
+	}
+
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("provider termination slash fraction must be less than or equal to 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateClientTerminationPenaltyFraction(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("client termination penalty fraction must be positive: %s", v)
+	}
+
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("client termination penalty fraction must be less than or equal to 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateBondDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := sdk.ValidateDenom(v); err != nil {
+		return fmt.Errorf("invalid bond denom: %w", err)
+	}
+
+	return nil
+}
+
+func validateOfferMatchScanLimit(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("offer match scan limit must be positive: %d", v)
+	}
+
+	return nil
+}
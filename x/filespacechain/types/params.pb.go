@@ -27,12 +27,57 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
-	// Base price per byte per block for storage services
-	BasePricePerBytePerBlock cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_price_per_byte_per_block,json=basePricePerBytePerBlock,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_price_per_byte_per_block"`
 	// Minimum stake required for hosting providers
 	MinProviderStake cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=min_provider_stake,json=minProviderStake,proto3,customtype=cosmossdk.io/math.Int" json:"min_provider_stake"`
 	// Fraction of stake to slash for provider failures (0.0 to 1.0)
 	SlashingFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=slashing_fraction,json=slashingFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slashing_fraction"`
+	// Number of active hosting contracts challenged for a storage proof each block
+	ChallengesPerBlock uint64 `protobuf:"varint,4,opt,name=challenges_per_block,json=challengesPerBlock,proto3" json:"challenges_per_block,omitempty"`
+	// Number of chunk indices sampled in a single storage challenge
+	ChunksPerChallenge uint64 `protobuf:"varint,5,opt,name=chunks_per_challenge,json=chunksPerChallenge,proto3" json:"chunks_per_challenge,omitempty"`
+	// Number of blocks a provider has to answer a storage challenge
+	ChallengeWindow uint64 `protobuf:"varint,6,opt,name=challenge_window,json=challengeWindow,proto3" json:"challenge_window,omitempty"`
+	// Number of blocks a provider has to accept a hosting contract before its slot is offered to the next-cheapest offer
+	AcceptanceDeadline uint64 `protobuf:"varint,7,opt,name=acceptance_deadline,json=acceptanceDeadline,proto3" json:"acceptance_deadline,omitempty"`
+	// Number of blocks unstaked funds stay in the bonded pool before they are returned
+	UnbondingPeriod uint64 `protobuf:"varint,8,opt,name=unbonding_period,json=unbondingPeriod,proto3" json:"unbonding_period,omitempty"`
+	// Minimum stake, as a fraction of the remaining value of a provider's active
+	// contracts, that must stay bonded when unstaking
+	CollateralRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=collateral_ratio,json=collateralRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"collateral_ratio"`
+	// Where slashed provider stake goes: burned, the community pool or the
+	// affected inquiry creators
+	SlashDestination SlashDestination `protobuf:"varint,10,opt,name=slash_destination,json=slashDestination,proto3,enum=filespacechain.filespacechain.SlashDestination" json:"slash_destination,omitempty"`
+	// Maximum number of entries taken from each expiry queue in one block; the
+	// rest are processed in the following blocks
+	MaxExpiriesPerBlock uint64 `protobuf:"varint,11,opt,name=max_expiries_per_block,json=maxExpiriesPerBlock,proto3" json:"max_expiries_per_block,omitempty"`
+	// Weight of provider reputation next to price when matching offers (0.0 to
+	// 1.0); zero matches on price alone
+	ReputationWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=reputation_weight,json=reputationWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reputation_weight"`
+	// Number of blocks in which a provider's reputation decays halfway back to
+	// neutral; zero disables decay
+	ReputationHalfLife uint64 `protobuf:"varint,13,opt,name=reputation_half_life,json=reputationHalfLife,proto3" json:"reputation_half_life,omitempty"`
+	// Where the rounding remainder of splitting an expired inquiry's escrow
+	// over its replicas goes: back to the inquiry creator or to the community
+	// pool
+	DustDestination DustDestination `protobuf:"varint,14,opt,name=dust_destination,json=dustDestination,proto3,enum=filespacechain.filespacechain.DustDestination" json:"dust_destination,omitempty"`
+	// Denoms inquiries can be escrowed and offers priced in, each with its own
+	// base price per byte per block for storage services
+	AcceptedDenoms []PaymentDenom `protobuf:"bytes,15,rep,name=accepted_denoms,json=acceptedDenoms,proto3" json:"accepted_denoms"`
+	// Fraction of a contract's charge held back as a completion bonus (0.0 to
+	// 1.0); the rest streams to the provider block by block
+	CompletionBonusFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,16,opt,name=completion_bonus_fraction,json=completionBonusFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"completion_bonus_fraction"`
+	// Fraction of a provider's stake slashed when it terminates an active
+	// contract early (0.0 to 1.0)
+	ProviderTerminationSlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,17,opt,name=provider_termination_slash_fraction,json=providerTerminationSlashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"provider_termination_slash_fraction"`
+	// Fraction of the unearned charge of an active contract paid to its
+	// provider when the inquiry creator terminates it early (0.0 to 1.0)
+	ClientTerminationPenaltyFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,18,opt,name=client_termination_penalty_fraction,json=clientTerminationPenaltyFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"client_termination_penalty_fraction"`
+	// Denom providers stake in; min_provider_stake is counted in it
+	BondDenom string `protobuf:"bytes,19,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	// Open inquiries a new or updated offer is matched against at most, in id
+	// order from where the previous offer stopped, and offers an inquiry is
+	// matched against at most, cheapest first
+	OfferMatchScanLimit uint64 `protobuf:"varint,20,opt,name=offer_match_scan_limit,json=offerMatchScanLimit,proto3" json:"offer_match_scan_limit,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -68,8 +113,140 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetChallengesPerBlock() uint64 {
+	if m != nil {
+		return m.ChallengesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetChunksPerChallenge() uint64 {
+	if m != nil {
+		return m.ChunksPerChallenge
+	}
+	return 0
+}
+
+func (m *Params) GetChallengeWindow() uint64 {
+	if m != nil {
+		return m.ChallengeWindow
+	}
+	return 0
+}
+
+func (m *Params) GetAcceptanceDeadline() uint64 {
+	if m != nil {
+		return m.AcceptanceDeadline
+	}
+	return 0
+}
+
+func (m *Params) GetUnbondingPeriod() uint64 {
+	if m != nil {
+		return m.UnbondingPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetSlashDestination() SlashDestination {
+	if m != nil {
+		return m.SlashDestination
+	}
+	return SLASH_DESTINATION_BURN
+}
+
+func (m *Params) GetMaxExpiriesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxExpiriesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetReputationHalfLife() uint64 {
+	if m != nil {
+		return m.ReputationHalfLife
+	}
+	return 0
+}
+
+func (m *Params) GetDustDestination() DustDestination {
+	if m != nil {
+		return m.DustDestination
+	}
+	return DUST_DESTINATION_INQUIRY_CREATOR
+}
+
+func (m *Params) GetAcceptedDenoms() []PaymentDenom {
+	if m != nil {
+		return m.AcceptedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetBondDenom() string {
+	if m != nil {
+		return m.BondDenom
+	}
+	return ""
+}
+
+func (m *Params) GetOfferMatchScanLimit() uint64 {
+	if m != nil {
+		return m.OfferMatchScanLimit
+	}
+	return 0
+}
+
+// PaymentDenom is a denom accepted for storage payments and its base price
+type PaymentDenom struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Base price per byte per block for storage services paid in this denom
+	BasePricePerBytePerBlock cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_price_per_byte_per_block,json=basePricePerBytePerBlock,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_price_per_byte_per_block"`
+}
+
+func (m *PaymentDenom) Reset()         { *m = PaymentDenom{} }
+func (m *PaymentDenom) String() string { return proto.CompactTextString(m) }
+func (*PaymentDenom) ProtoMessage()    {}
+func (*PaymentDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4d34b46c360ad71, []int{1}
+}
+func (m *PaymentDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymentDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymentDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaymentDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentDenom.Merge(m, src)
+}
+func (m *PaymentDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymentDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentDenom proto.InternalMessageInfo
+
+func (m *PaymentDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "filespacechain.filespacechain.Params")
+	proto.RegisterType((*PaymentDenom)(nil), "filespacechain.filespacechain.PaymentDenom")
 }
 
 func init() {
@@ -77,29 +254,59 @@ func init() {
 }
 
 var fileDescriptor_c4d34b46c360ad71 = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
-	0x10, 0xc7, 0x93, 0x7e, 0x50, 0xf8, 0x72, 0x6a, 0x83, 0x42, 0xa8, 0x34, 0x15, 0x05, 0x91, 0x82,
-	0xc9, 0xc1, 0x8b, 0x78, 0x2c, 0x22, 0x88, 0x1e, 0x42, 0xbd, 0x79, 0x09, 0x93, 0xed, 0x36, 0x59,
-	0xda, 0xdd, 0x59, 0x77, 0x57, 0x31, 0xaf, 0xe0, 0xc9, 0x47, 0xf0, 0x11, 0x7c, 0x02, 0xcf, 0x3d,
-	0xf6, 0x28, 0x1e, 0x8a, 0xb4, 0x07, 0x7d, 0x0c, 0x49, 0x1a, 0x91, 0x56, 0x04, 0x2f, 0xbb, 0xff,
-	0xd9, 0xe5, 0xf7, 0x63, 0x98, 0x71, 0xba, 0x43, 0x36, 0xa6, 0x5a, 0x02, 0xa1, 0x24, 0x03, 0x26,
-	0xc2, 0xb5, 0x52, 0x82, 0x02, 0xae, 0x03, 0xa9, 0xd0, 0xa0, 0xdb, 0x5e, 0xfd, 0x0c, 0x56, 0xcb,
-	0x56, 0x13, 0x38, 0x13, 0x18, 0x96, 0xe7, 0x92, 0x68, 0x6d, 0xa4, 0x98, 0x62, 0x19, 0xc3, 0x22,
-	0x2d, 0x5f, 0x77, 0x9e, 0x6b, 0x4e, 0x3d, 0x2a, 0xc5, 0xee, 0xc0, 0x69, 0x27, 0xa0, 0x69, 0x2c,
-	0x15, 0x23, 0x34, 0x96, 0x54, 0xc5, 0x49, 0x6e, 0xaa, 0x30, 0x46, 0x32, 0xf2, 0xec, 0x6d, 0x7b,
-	0xff, 0x7f, 0x6f, 0x77, 0x32, 0xeb, 0x58, 0xaf, 0xb3, 0xce, 0x16, 0x41, 0xcd, 0x51, 0xeb, 0xc1,
-	0x28, 0x60, 0x18, 0x72, 0x30, 0x59, 0x70, 0x41, 0x53, 0x20, 0xf9, 0x09, 0x25, 0x7d, 0xaf, 0x30,
-	0x45, 0x85, 0x28, 0xa2, 0xaa, 0x97, 0x9b, 0xf2, 0x2a, 0x24, 0xee, 0xb9, 0xe3, 0x72, 0x26, 0x62,
-	0xa9, 0xf0, 0x96, 0x0d, 0xa8, 0x8a, 0xb5, 0x81, 0x11, 0xf5, 0x6a, 0xa5, 0xba, 0x5d, 0xa9, 0x37,
-	0x7f, 0xaa, 0xcf, 0x84, 0xe9, 0x37, 0x38, 0x13, 0x51, 0xc5, 0x5d, 0x16, 0x98, 0x1b, 0x39, 0x4d,
-	0x3d, 0x06, 0x9d, 0x31, 0x91, 0xc6, 0x43, 0x05, 0xc4, 0x30, 0x14, 0xde, 0xbf, 0xbf, 0xb7, 0xd9,
-	0xf8, 0xa2, 0x4f, 0x2b, 0xf8, 0x38, 0xfc, 0x78, 0xec, 0xd8, 0xf7, 0xef, 0x4f, 0xdd, 0xbd, 0xb5,
-	0xe9, 0xdf, 0xad, 0xaf, 0x63, 0x39, 0xb5, 0x5e, 0x7f, 0x32, 0xf7, 0xed, 0xe9, 0xdc, 0xb7, 0xdf,
-	0xe6, 0xbe, 0xfd, 0xb0, 0xf0, 0xad, 0xe9, 0xc2, 0xb7, 0x5e, 0x16, 0xbe, 0x75, 0x75, 0x94, 0x32,
-	0x93, 0xdd, 0x24, 0x01, 0x41, 0x1e, 0x66, 0x20, 0x74, 0x76, 0xfd, 0xad, 0x38, 0xf8, 0x45, 0x6a,
-	0x72, 0x49, 0x75, 0x52, 0x2f, 0x77, 0x73, 0xf8, 0x19, 0x00, 0x00, 0xff, 0xff, 0x17, 0x4d, 0x09,
-	0xf7, 0x11, 0x02, 0x00, 0x00,
+	// 830 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x6f, 0x1c, 0x35,
+	0x14, 0xcf, 0xb4, 0x69, 0x68, 0x9c, 0x92, 0xdd, 0x38, 0x01, 0x86, 0xa2, 0x6c, 0x56, 0xad, 0x84,
+	0x42, 0x0a, 0xbb, 0xa8, 0xbd, 0xa0, 0x1e, 0x97, 0x05, 0xf1, 0x27, 0xa0, 0xd1, 0x06, 0xa9, 0x02,
+	0x21, 0x59, 0x5e, 0xcf, 0x9b, 0x1d, 0x2b, 0x1e, 0x7b, 0xb0, 0xbd, 0x24, 0xfb, 0x15, 0x38, 0xf5,
+	0x23, 0x70, 0xec, 0xb1, 0x1f, 0xa3, 0xc7, 0x1e, 0x11, 0x87, 0x0a, 0x25, 0x87, 0xf2, 0x31, 0x90,
+	0xed, 0xd9, 0x99, 0xdd, 0xa0, 0x26, 0xe9, 0x65, 0x35, 0x7e, 0x3f, 0xff, 0x7e, 0xcf, 0xfe, 0xbd,
+	0xf7, 0xbc, 0xe8, 0x20, 0xe3, 0x02, 0x4c, 0x49, 0x19, 0xb0, 0x9c, 0x72, 0xd9, 0xbf, 0xb0, 0x2c,
+	0xa9, 0xa6, 0x85, 0xe9, 0x95, 0x5a, 0x59, 0x85, 0x77, 0x97, 0xc1, 0xde, 0xf2, 0xf2, 0xee, 0x16,
+	0x2d, 0xb8, 0x54, 0x7d, 0xff, 0x1b, 0x18, 0x77, 0x77, 0x26, 0x6a, 0xa2, 0xfc, 0x67, 0xdf, 0x7d,
+	0x55, 0xd1, 0x4f, 0x2f, 0xcf, 0x69, 0x04, 0x35, 0x39, 0x97, 0x93, 0x6a, 0xf7, 0x83, 0xab, 0x4e,
+	0x38, 0x2b, 0x40, 0xda, 0xb0, 0xf9, 0xde, 0xb3, 0x0d, 0xb4, 0x96, 0xf8, 0x33, 0xe3, 0xef, 0x11,
+	0x2e, 0xb8, 0x24, 0xa5, 0x56, 0xbf, 0xf3, 0x14, 0x34, 0x31, 0x96, 0x1e, 0x43, 0x7c, 0xa3, 0x1b,
+	0xed, 0xaf, 0x0f, 0x76, 0x5f, 0xbc, 0xda, 0x5b, 0xf9, 0xfb, 0xd5, 0xde, 0x7b, 0x4c, 0x99, 0x42,
+	0x19, 0x93, 0x1e, 0xf7, 0xb8, 0xea, 0x17, 0xd4, 0xe6, 0xbd, 0x6f, 0xa5, 0x1d, 0xb5, 0x0b, 0x2e,
+	0x93, 0x8a, 0x77, 0xe4, 0x68, 0x38, 0x41, 0x5b, 0xf3, 0x63, 0x91, 0x4c, 0x53, 0x66, 0xb9, 0x92,
+	0xf1, 0x4d, 0xaf, 0x75, 0xbf, 0xd2, 0xfa, 0xe8, 0xff, 0x5a, 0x87, 0x30, 0xa1, 0x6c, 0x36, 0x04,
+	0x36, 0x6a, 0xcf, 0xd9, 0x5f, 0x57, 0x64, 0xfc, 0x39, 0xda, 0x61, 0x39, 0x15, 0x02, 0xe4, 0x04,
+	0x0c, 0x29, 0x41, 0x93, 0xb1, 0x50, 0xec, 0x38, 0x5e, 0xed, 0x46, 0xfb, 0xab, 0x23, 0xdc, 0x60,
+	0x09, 0xe8, 0x81, 0x43, 0x02, 0x63, 0x2a, 0x8f, 0xc3, 0xee, 0x7a, 0x43, 0x7c, 0x6b, 0xce, 0x70,
+	0x58, 0x02, 0xfa, 0xcb, 0x39, 0x82, 0x3f, 0x41, 0xed, 0x7a, 0x1b, 0x39, 0xe1, 0x32, 0x55, 0x27,
+	0xf1, 0x9a, 0xdf, 0xdd, 0xaa, 0xe3, 0x4f, 0x7c, 0x18, 0xf7, 0xd1, 0x36, 0x65, 0x0c, 0x4a, 0x4b,
+	0x25, 0x03, 0x92, 0x02, 0x4d, 0x05, 0x97, 0x10, 0xbf, 0x13, 0xb4, 0x1b, 0x68, 0x58, 0x21, 0x4e,
+	0x7b, 0x2a, 0xc7, 0x4a, 0xa6, 0xce, 0x92, 0x12, 0x34, 0x57, 0x69, 0x7c, 0x3b, 0x68, 0xd7, 0xf1,
+	0xc4, 0x87, 0xf1, 0x8f, 0xa8, 0xcd, 0x94, 0x10, 0xd4, 0x82, 0xa6, 0x82, 0x68, 0x6a, 0xb9, 0x8a,
+	0xd7, 0xaf, 0xef, 0x5d, 0xab, 0x21, 0x8f, 0x1c, 0x17, 0xff, 0x5a, 0x15, 0x83, 0xa4, 0x60, 0x2c,
+	0x97, 0xd4, 0x17, 0x03, 0x75, 0xa3, 0xfd, 0xcd, 0x87, 0xfd, 0xde, 0xa5, 0x3d, 0xda, 0x3b, 0x72,
+	0xbc, 0x61, 0x43, 0xab, 0x0a, 0xb3, 0x10, 0xc1, 0x8f, 0xd0, 0xfb, 0x05, 0x3d, 0x25, 0x70, 0x5a,
+	0x72, 0xcd, 0x97, 0x4a, 0xb3, 0xe1, 0xaf, 0xb7, 0x5d, 0xd0, 0xd3, 0xaf, 0x2a, 0xb0, 0xae, 0x4d,
+	0x82, 0xb6, 0x34, 0x94, 0x53, 0xeb, 0x25, 0xc8, 0x09, 0xf0, 0x49, 0x6e, 0xe3, 0x3b, 0x6f, 0xd1,
+	0x1f, 0x0d, 0xfb, 0x89, 0x27, 0xbb, 0x6a, 0x2f, 0x28, 0xe6, 0x54, 0x64, 0x44, 0xf0, 0x0c, 0xe2,
+	0x77, 0x43, 0x45, 0x1a, 0xec, 0x1b, 0x2a, 0xb2, 0x43, 0x9e, 0x01, 0xfe, 0x19, 0xb5, 0xd3, 0xa9,
+	0xb1, 0x4b, 0xae, 0x6c, 0x7a, 0x57, 0x7a, 0x57, 0xb8, 0x32, 0x9c, 0x1a, 0xbb, 0x68, 0x4a, 0x2b,
+	0x5d, 0x0e, 0x60, 0x82, 0x5a, 0xa1, 0x05, 0x20, 0x25, 0x29, 0x48, 0x55, 0x98, 0xb8, 0xd5, 0xbd,
+	0xb9, 0xbf, 0xf1, 0xf0, 0xc1, 0x15, 0xca, 0x49, 0x98, 0xce, 0xa1, 0xe3, 0x0c, 0xd6, 0x9d, 0x13,
+	0xcf, 0x5e, 0x3f, 0x3f, 0x88, 0x46, 0x9b, 0x73, 0x39, 0x8f, 0x18, 0x4c, 0xd0, 0x87, 0x4c, 0x15,
+	0xa5, 0x00, 0x7f, 0xdb, 0xb1, 0x92, 0x53, 0xd3, 0xcc, 0x59, 0xfb, 0xfa, 0x3e, 0x7e, 0xd0, 0xa8,
+	0x0c, 0x9c, 0x48, 0x3d, 0x6e, 0x1a, 0xdd, 0xaf, 0x5f, 0x02, 0x0b, 0xba, 0xa8, 0x6e, 0x46, 0x42,
+	0x23, 0xd5, 0xa9, 0xb6, 0xae, 0x9f, 0xaa, 0x3b, 0xd7, 0xfb, 0xa9, 0x91, 0xf3, 0xed, 0xb5, 0x98,
+	0x93, 0x09, 0x0e, 0xd2, 0x2e, 0x65, 0x2c, 0x41, 0x52, 0x61, 0x67, 0x4d, 0x4e, 0xfc, 0x16, 0x39,
+	0x83, 0xde, 0x42, 0xc6, 0x24, 0xa8, 0xd5, 0x39, 0x77, 0x11, 0x72, 0xc3, 0x17, 0xaa, 0x14, 0x6f,
+	0x3b, 0xe9, 0xd1, 0xba, 0x8b, 0x78, 0xa3, 0x5d, 0x73, 0xab, 0x2c, 0x03, 0x4d, 0x0a, 0x6a, 0x59,
+	0x4e, 0x0c, 0xa3, 0x92, 0x08, 0x5e, 0x70, 0x1b, 0xef, 0x84, 0xe6, 0xf6, 0xe8, 0x0f, 0x0e, 0x3c,
+	0x62, 0x54, 0x1e, 0x3a, 0xe8, 0x71, 0xff, 0xdf, 0x3f, 0xf7, 0xa2, 0x3f, 0x5e, 0x3f, 0x3f, 0xf8,
+	0xf8, 0xc2, 0xdb, 0x7b, 0x7a, 0xf1, 0x31, 0x0e, 0x4f, 0xef, 0x77, 0xab, 0xb7, 0xa3, 0xf6, 0x8d,
+	0xd1, 0xee, 0x98, 0x1a, 0x20, 0xa5, 0xe6, 0x0c, 0xc2, 0x10, 0xcd, 0x2c, 0x34, 0xd3, 0x74, 0xef,
+	0x69, 0x84, 0xee, 0x2c, 0xb6, 0x07, 0xde, 0x41, 0xb7, 0xc2, 0xa9, 0x23, 0x7f, 0xea, 0xb0, 0xc0,
+	0x29, 0xba, 0x5c, 0xa7, 0x7a, 0xd1, 0xaf, 0x65, 0x5f, 0xec, 0x94, 0x12, 0x27, 0xe4, 0x06, 0x77,
+	0x66, 0x61, 0x3e, 0xbf, 0x8f, 0x57, 0xdd, 0x15, 0x07, 0xa3, 0x17, 0x67, 0x9d, 0xe8, 0xe5, 0x59,
+	0x27, 0xfa, 0xe7, 0xac, 0x13, 0x3d, 0x3d, 0xef, 0xac, 0xbc, 0x3c, 0xef, 0xac, 0xfc, 0x75, 0xde,
+	0x59, 0xf9, 0xe5, 0x8b, 0x09, 0xb7, 0xf9, 0x74, 0xdc, 0x63, 0xaa, 0xe8, 0xe7, 0x54, 0x9a, 0xfc,
+	0xb7, 0xe6, 0xea, 0x9f, 0xbd, 0xc1, 0x0c, 0x3b, 0x2b, 0xc1, 0x8c, 0xd7, 0xfc, 0x1f, 0xd3, 0xa3,
+	0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x3f, 0x75, 0x80, 0x69, 0x07, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -121,15 +328,95 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.BasePricePerBytePerBlock.Equal(that1.BasePricePerBytePerBlock) {
-		return false
-	}
 	if !this.MinProviderStake.Equal(that1.MinProviderStake) {
 		return false
 	}
 	if !this.SlashingFraction.Equal(that1.SlashingFraction) {
 		return false
 	}
+	if this.ChallengesPerBlock != that1.ChallengesPerBlock {
+		return false
+	}
+	if this.ChunksPerChallenge != that1.ChunksPerChallenge {
+		return false
+	}
+	if this.ChallengeWindow != that1.ChallengeWindow {
+		return false
+	}
+	if this.AcceptanceDeadline != that1.AcceptanceDeadline {
+		return false
+	}
+	if this.UnbondingPeriod != that1.UnbondingPeriod {
+		return false
+	}
+	if !this.CollateralRatio.Equal(that1.CollateralRatio) {
+		return false
+	}
+	if this.SlashDestination != that1.SlashDestination {
+		return false
+	}
+	if this.MaxExpiriesPerBlock != that1.MaxExpiriesPerBlock {
+		return false
+	}
+	if !this.ReputationWeight.Equal(that1.ReputationWeight) {
+		return false
+	}
+	if this.ReputationHalfLife != that1.ReputationHalfLife {
+		return false
+	}
+	if this.DustDestination != that1.DustDestination {
+		return false
+	}
+	if len(this.AcceptedDenoms) != len(that1.AcceptedDenoms) {
+		return false
+	}
+	for i := range this.AcceptedDenoms {
+		if !this.AcceptedDenoms[i].Equal(&that1.AcceptedDenoms[i]) {
+			return false
+		}
+	}
+	if !this.CompletionBonusFraction.Equal(that1.CompletionBonusFraction) {
+		return false
+	}
+	if !this.ProviderTerminationSlashFraction.Equal(that1.ProviderTerminationSlashFraction) {
+		return false
+	}
+	if !this.ClientTerminationPenaltyFraction.Equal(that1.ClientTerminationPenaltyFraction) {
+		return false
+	}
+	if this.BondDenom != that1.BondDenom {
+		return false
+	}
+	if this.OfferMatchScanLimit != that1.OfferMatchScanLimit {
+		return false
+	}
+	return true
+}
+func (this *PaymentDenom) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PaymentDenom)
+	if !ok {
+		that2, ok := that.(PaymentDenom)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.BasePricePerBytePerBlock.Equal(that1.BasePricePerBytePerBlock) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -152,6 +439,137 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OfferMatchScanLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OfferMatchScanLimit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	{
+		size := m.ClientTerminationPenaltyFraction.Size()
+		i -= size
+		if _, err := m.ClientTerminationPenaltyFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.ProviderTerminationSlashFraction.Size()
+		i -= size
+		if _, err := m.ProviderTerminationSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.CompletionBonusFraction.Size()
+		i -= size
+		if _, err := m.CompletionBonusFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.AcceptedDenoms) > 0 {
+		for iNdEx := len(m.AcceptedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AcceptedDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.DustDestination != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DustDestination))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ReputationHalfLife != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReputationHalfLife))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.ReputationWeight.Size()
+		i -= size
+		if _, err := m.ReputationWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.MaxExpiriesPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxExpiriesPerBlock))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.SlashDestination != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SlashDestination))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.CollateralRatio.Size()
+		i -= size
+		if _, err := m.CollateralRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.UnbondingPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UnbondingPeriod))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AcceptanceDeadline != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AcceptanceDeadline))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ChallengeWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChallengeWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ChunksPerChallenge != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChunksPerChallenge))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ChallengesPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChallengesPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.SlashingFraction.Size()
 		i -= size
@@ -172,6 +590,29 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *PaymentDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
 		size := m.BasePricePerBytePerBlock.Size()
 		i -= size
@@ -181,7 +622,14 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -202,12 +650,75 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
-	l = m.BasePricePerBytePerBlock.Size()
-	n += 1 + l + sovParams(uint64(l))
 	l = m.MinProviderStake.Size()
 	n += 1 + l + sovParams(uint64(l))
 	l = m.SlashingFraction.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.ChallengesPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.ChallengesPerBlock))
+	}
+	if m.ChunksPerChallenge != 0 {
+		n += 1 + sovParams(uint64(m.ChunksPerChallenge))
+	}
+	if m.ChallengeWindow != 0 {
+		n += 1 + sovParams(uint64(m.ChallengeWindow))
+	}
+	if m.AcceptanceDeadline != 0 {
+		n += 1 + sovParams(uint64(m.AcceptanceDeadline))
+	}
+	if m.UnbondingPeriod != 0 {
+		n += 1 + sovParams(uint64(m.UnbondingPeriod))
+	}
+	l = m.CollateralRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.SlashDestination != 0 {
+		n += 1 + sovParams(uint64(m.SlashDestination))
+	}
+	if m.MaxExpiriesPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxExpiriesPerBlock))
+	}
+	l = m.ReputationWeight.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ReputationHalfLife != 0 {
+		n += 1 + sovParams(uint64(m.ReputationHalfLife))
+	}
+	if m.DustDestination != 0 {
+		n += 1 + sovParams(uint64(m.DustDestination))
+	}
+	if len(m.AcceptedDenoms) > 0 {
+		for _, e := range m.AcceptedDenoms {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.CompletionBonusFraction.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.ProviderTerminationSlashFraction.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.ClientTerminationPenaltyFraction.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.OfferMatchScanLimit != 0 {
+		n += 2 + sovParams(uint64(m.OfferMatchScanLimit))
+	}
+	return n
+}
+
+func (m *PaymentDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.BasePricePerBytePerBlock.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -246,9 +757,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasePricePerBytePerBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinProviderStake", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -276,13 +787,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BasePricePerBytePerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinProviderStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinProviderStake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -310,15 +821,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinProviderStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SlashingFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingFraction", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengesPerBlock", wireType)
 			}
-			var stringLen uint64
+			m.ChallengesPerBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -328,23 +839,531 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChallengesPerBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunksPerChallenge", wireType)
 			}
-			if err := m.SlashingFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChunksPerChallenge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunksPerChallenge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeWindow", wireType)
+			}
+			m.ChallengeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptanceDeadline", wireType)
+			}
+			m.AcceptanceDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcceptanceDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			m.UnbondingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashDestination", wireType)
+			}
+			m.SlashDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashDestination |= SlashDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExpiriesPerBlock", wireType)
+			}
+			m.MaxExpiriesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExpiriesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputationWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReputationWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputationHalfLife", wireType)
+			}
+			m.ReputationHalfLife = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReputationHalfLife |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustDestination", wireType)
+			}
+			m.DustDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DustDestination |= DustDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedDenoms = append(m.AcceptedDenoms, PaymentDenom{})
+			if err := m.AcceptedDenoms[len(m.AcceptedDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionBonusFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CompletionBonusFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderTerminationSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProviderTerminationSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientTerminationPenaltyFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClientTerminationPenaltyFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferMatchScanLimit", wireType)
+			}
+			m.OfferMatchScanLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferMatchScanLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaymentDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymentDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymentDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasePricePerBytePerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BasePricePerBytePerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
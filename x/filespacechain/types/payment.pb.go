@@ -24,11 +24,83 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DustDestination decides where the part of an inquiry's escrow that cannot
+// be split evenly over its replicas goes when the inquiry expires.
+type DustDestination int32
+
+const (
+	// Dust is returned to the creator of the contract's inquiry.
+	DUST_DESTINATION_INQUIRY_CREATOR DustDestination = 0
+	// Dust is sent to the community pool.
+	DUST_DESTINATION_COMMUNITY_POOL DustDestination = 1
+)
+
+var DustDestination_name = map[int32]string{
+	0: "DUST_DESTINATION_INQUIRY_CREATOR",
+	1: "DUST_DESTINATION_COMMUNITY_POOL",
+}
+
+var DustDestination_value = map[string]int32{
+	"DUST_DESTINATION_INQUIRY_CREATOR": 0,
+	"DUST_DESTINATION_COMMUNITY_POOL":  1,
+}
+
+func (x DustDestination) String() string {
+	return proto.EnumName(DustDestination_name, int32(x))
+}
+
+func (DustDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3fe96bcb3c73d2a9, []int{0}
+}
+
+// ProviderPaymentKind is what a provider payment was made for
+type ProviderPaymentKind int32
+
+const (
+	PROVIDER_PAYMENT_KIND_UNSPECIFIED ProviderPaymentKind = 0
+	// periodic payment of an active contract
+	PROVIDER_PAYMENT_KIND_PERIODIC ProviderPaymentKind = 1
+	// completion bonus of a completed contract
+	PROVIDER_PAYMENT_KIND_COMPLETION_BONUS ProviderPaymentKind = 2
+	// earned part of the charge of a contract terminated early
+	PROVIDER_PAYMENT_KIND_TERMINATION_SETTLEMENT ProviderPaymentKind = 3
+	// penalty paid by an inquiry creator terminating a contract early
+	PROVIDER_PAYMENT_KIND_TERMINATION_PENALTY ProviderPaymentKind = 4
+)
+
+var ProviderPaymentKind_name = map[int32]string{
+	0: "PROVIDER_PAYMENT_KIND_UNSPECIFIED",
+	1: "PROVIDER_PAYMENT_KIND_PERIODIC",
+	2: "PROVIDER_PAYMENT_KIND_COMPLETION_BONUS",
+	3: "PROVIDER_PAYMENT_KIND_TERMINATION_SETTLEMENT",
+	4: "PROVIDER_PAYMENT_KIND_TERMINATION_PENALTY",
+}
+
+var ProviderPaymentKind_value = map[string]int32{
+	"PROVIDER_PAYMENT_KIND_UNSPECIFIED":            0,
+	"PROVIDER_PAYMENT_KIND_PERIODIC":               1,
+	"PROVIDER_PAYMENT_KIND_COMPLETION_BONUS":       2,
+	"PROVIDER_PAYMENT_KIND_TERMINATION_SETTLEMENT": 3,
+	"PROVIDER_PAYMENT_KIND_TERMINATION_PENALTY":    4,
+}
+
+func (x ProviderPaymentKind) String() string {
+	return proto.EnumName(ProviderPaymentKind_name, int32(x))
+}
+
+func (ProviderPaymentKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3fe96bcb3c73d2a9, []int{1}
+}
+
 type PaymentHistory struct {
-	ContractId          uint64     `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// Escrow released to the contract's provider
 	TotalPaid           types.Coin `protobuf:"bytes,2,opt,name=total_paid,json=totalPaid,proto3" json:"total_paid"`
 	LastPaymentBlock    uint64     `protobuf:"varint,3,opt,name=last_payment_block,json=lastPaymentBlock,proto3" json:"last_payment_block,omitempty"`
 	CompletionBonusPaid bool       `protobuf:"varint,4,opt,name=completion_bonus_paid,json=completionBonusPaid,proto3" json:"completion_bonus_paid,omitempty"`
+	// Surplus of the contract's escrow share refunded to the inquiry creator
+	// once the contract settled
+	Refunded types.Coin `protobuf:"bytes,5,opt,name=refunded,proto3" json:"refunded"`
 }
 
 func (m *PaymentHistory) Reset()         { *m = PaymentHistory{} }
@@ -92,6 +164,13 @@ func (m *PaymentHistory) GetCompletionBonusPaid() bool {
 	return false
 }
 
+func (m *PaymentHistory) GetRefunded() types.Coin {
+	if m != nil {
+		return m.Refunded
+	}
+	return types.Coin{}
+}
+
 type EscrowRecord struct {
 	InquiryId uint64     `protobuf:"varint,1,opt,name=inquiry_id,json=inquiryId,proto3" json:"inquiry_id,omitempty"`
 	Amount    types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
@@ -212,10 +291,178 @@ func (m *ProviderStake) GetHeight() uint64 {
 	return 0
 }
 
+// UnbondingEntry is stake a provider asked to withdraw. It stays in the
+// hosting bonded pool, and can still be slashed, until completion_height.
+type UnbondingEntry struct {
+	Id               uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Provider         string     `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Amount           types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	CreationHeight   uint64     `protobuf:"varint,4,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	CompletionHeight uint64     `protobuf:"varint,5,opt,name=completion_height,json=completionHeight,proto3" json:"completion_height,omitempty"`
+}
+
+func (m *UnbondingEntry) Reset()         { *m = UnbondingEntry{} }
+func (m *UnbondingEntry) String() string { return proto.CompactTextString(m) }
+func (*UnbondingEntry) ProtoMessage()    {}
+func (*UnbondingEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fe96bcb3c73d2a9, []int{3}
+}
+func (m *UnbondingEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingEntry.Merge(m, src)
+}
+func (m *UnbondingEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingEntry proto.InternalMessageInfo
+
+func (m *UnbondingEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UnbondingEntry) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *UnbondingEntry) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *UnbondingEntry) GetCreationHeight() uint64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+func (m *UnbondingEntry) GetCompletionHeight() uint64 {
+	if m != nil {
+		return m.CompletionHeight
+	}
+	return 0
+}
+
+// ProviderPayment records funds actually released from escrow to a provider
+// for one of its contracts. Failed releases leave no entry.
+type ProviderPayment struct {
+	Id         uint64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Provider   string              `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	ContractId uint64              `protobuf:"varint,3,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Height     uint64              `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Amount     types.Coin          `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+	Kind       ProviderPaymentKind `protobuf:"varint,6,opt,name=kind,proto3,enum=filespacechain.filespacechain.ProviderPaymentKind" json:"kind,omitempty"`
+}
+
+func (m *ProviderPayment) Reset()         { *m = ProviderPayment{} }
+func (m *ProviderPayment) String() string { return proto.CompactTextString(m) }
+func (*ProviderPayment) ProtoMessage()    {}
+func (*ProviderPayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fe96bcb3c73d2a9, []int{4}
+}
+func (m *ProviderPayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderPayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderPayment.Merge(m, src)
+}
+func (m *ProviderPayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderPayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderPayment proto.InternalMessageInfo
+
+func (m *ProviderPayment) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ProviderPayment) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *ProviderPayment) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+func (m *ProviderPayment) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ProviderPayment) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *ProviderPayment) GetKind() ProviderPaymentKind {
+	if m != nil {
+		return m.Kind
+	}
+	return PROVIDER_PAYMENT_KIND_UNSPECIFIED
+}
+
 func init() {
+	proto.RegisterEnum("filespacechain.filespacechain.DustDestination", DustDestination_name, DustDestination_value)
+	proto.RegisterEnum("filespacechain.filespacechain.ProviderPaymentKind", ProviderPaymentKind_name, ProviderPaymentKind_value)
 	proto.RegisterType((*PaymentHistory)(nil), "filespacechain.filespacechain.PaymentHistory")
 	proto.RegisterType((*EscrowRecord)(nil), "filespacechain.filespacechain.EscrowRecord")
 	proto.RegisterType((*ProviderStake)(nil), "filespacechain.filespacechain.ProviderStake")
+	proto.RegisterType((*UnbondingEntry)(nil), "filespacechain.filespacechain.UnbondingEntry")
+	proto.RegisterType((*ProviderPayment)(nil), "filespacechain.filespacechain.ProviderPayment")
 }
 
 func init() {
@@ -223,34 +470,54 @@ func init() {
 }
 
 var fileDescriptor_3fe96bcb3c73d2a9 = []byte{
-	// 417 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x4f, 0x6f, 0xd3, 0x40,
-	0x10, 0xc5, 0xb3, 0x10, 0x85, 0x66, 0x0a, 0x08, 0x2d, 0x7f, 0x14, 0x22, 0xd5, 0x8d, 0x72, 0x8a,
-	0x04, 0xd8, 0x6a, 0x39, 0xc0, 0x89, 0x43, 0x10, 0x12, 0xbd, 0x45, 0xe6, 0xc6, 0xc5, 0x5a, 0xaf,
-	0x97, 0x78, 0x55, 0x7b, 0xc7, 0xdd, 0x1d, 0x17, 0x2c, 0x71, 0xe0, 0x23, 0xf0, 0xb1, 0x7a, 0xac,
-	0xc4, 0x85, 0x13, 0x42, 0xc9, 0x17, 0x41, 0xb6, 0xb7, 0x44, 0xed, 0x09, 0x71, 0xf3, 0x7b, 0x6f,
-	0x47, 0xbf, 0x19, 0xeb, 0xc1, 0xb3, 0x4f, 0xba, 0x50, 0xae, 0x12, 0x52, 0xc9, 0x5c, 0x68, 0x13,
-	0xdd, 0x90, 0x95, 0x68, 0x4a, 0x65, 0x28, 0xac, 0x2c, 0x12, 0xf2, 0x83, 0xeb, 0x69, 0x78, 0x5d,
-	0x4e, 0x1f, 0xad, 0x71, 0x8d, 0xdd, 0xcb, 0xa8, 0xfd, 0xea, 0x87, 0xa6, 0x81, 0x44, 0x57, 0xa2,
-	0x8b, 0x52, 0xe1, 0x54, 0x74, 0x7e, 0x94, 0x2a, 0x12, 0x47, 0x91, 0x44, 0x6d, 0xfa, 0x7c, 0xfe,
-	0x83, 0xc1, 0xfd, 0x55, 0x8f, 0x79, 0xaf, 0x1d, 0xa1, 0x6d, 0xf8, 0x21, 0xec, 0x4b, 0x34, 0x64,
-	0x85, 0xa4, 0x44, 0x67, 0x13, 0x36, 0x63, 0x8b, 0x61, 0x0c, 0x57, 0xd6, 0x49, 0xc6, 0xdf, 0x00,
-	0x10, 0x92, 0x28, 0x92, 0x4a, 0xe8, 0x6c, 0x72, 0x6b, 0xc6, 0x16, 0xfb, 0xc7, 0x4f, 0xc3, 0x1e,
-	0x14, 0xb6, 0xa0, 0xd0, 0x83, 0xc2, 0xb7, 0xa8, 0xcd, 0x72, 0x78, 0xf1, 0xeb, 0x70, 0x10, 0x8f,
-	0xbb, 0x91, 0x95, 0xd0, 0x19, 0x7f, 0x0e, 0xbc, 0x10, 0x8e, 0x12, 0x7f, 0x5e, 0x92, 0x16, 0x28,
-	0x4f, 0x27, 0xb7, 0x3b, 0xce, 0x83, 0x36, 0xf1, 0x0b, 0x2d, 0x5b, 0x9f, 0x1f, 0xc3, 0x63, 0x89,
-	0x65, 0x55, 0x28, 0xd2, 0x68, 0x92, 0x14, 0x4d, 0xed, 0x7a, 0xf0, 0x70, 0xc6, 0x16, 0x7b, 0xf1,
-	0xc3, 0x5d, 0xb8, 0x6c, 0xb3, 0x96, 0x30, 0xff, 0xc6, 0xe0, 0xee, 0x3b, 0x27, 0x2d, 0x7e, 0x8e,
-	0x95, 0x44, 0x9b, 0xf1, 0x03, 0x00, 0x6d, 0xce, 0x6a, 0x6d, 0x9b, 0xdd, 0x49, 0x63, 0xef, 0x9c,
-	0x64, 0xfc, 0x15, 0x8c, 0x44, 0x89, 0xb5, 0xa1, 0x7f, 0xbd, 0xc6, 0x3f, 0xe7, 0x13, 0xb8, 0x23,
-	0xad, 0x12, 0x84, 0xb6, 0xdb, 0x7f, 0x1c, 0x5f, 0xc9, 0xf9, 0x57, 0xb8, 0xb7, 0xb2, 0x78, 0xae,
-	0x33, 0x65, 0x3f, 0x90, 0x38, 0x55, 0x7c, 0x0a, 0x7b, 0x95, 0x37, 0xba, 0x05, 0xc6, 0xf1, 0x5f,
-	0xfd, 0xff, 0xfc, 0x27, 0x30, 0xca, 0x95, 0x5e, 0xe7, 0xe4, 0x7f, 0x9f, 0x57, 0xcb, 0xf8, 0x62,
-	0x13, 0xb0, 0xcb, 0x4d, 0xc0, 0x7e, 0x6f, 0x02, 0xf6, 0x7d, 0x1b, 0x0c, 0x2e, 0xb7, 0xc1, 0xe0,
-	0xe7, 0x36, 0x18, 0x7c, 0x7c, 0xbd, 0xd6, 0x94, 0xd7, 0x69, 0x28, 0xb1, 0x8c, 0x72, 0x61, 0x5c,
-	0x7e, 0xb6, 0x6b, 0xdd, 0x8b, 0xbe, 0x76, 0x5f, 0x6e, 0xf6, 0x90, 0x9a, 0x4a, 0xb9, 0x74, 0xd4,
-	0x35, 0xe6, 0xe5, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x10, 0xad, 0x43, 0xb5, 0x02, 0x00,
-	0x00,
+	// 748 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xeb, 0x44,
+	0x14, 0x8e, 0x13, 0x37, 0x34, 0xe7, 0x42, 0x6a, 0xe6, 0x02, 0x0a, 0x91, 0xae, 0x1b, 0xc2, 0x5f,
+	0xe8, 0xbd, 0x75, 0x68, 0x58, 0x80, 0x84, 0x84, 0x94, 0xc4, 0xae, 0x6a, 0x35, 0xb1, 0x8d, 0xe3,
+	0x20, 0x85, 0x8d, 0xe5, 0xbf, 0x26, 0xa3, 0x26, 0x33, 0xa9, 0x3d, 0x29, 0x44, 0x62, 0xc1, 0x92,
+	0x65, 0xdf, 0x81, 0x97, 0xa9, 0xc4, 0xa6, 0x4b, 0x56, 0x08, 0xb5, 0x8f, 0xc0, 0x82, 0x2d, 0x8a,
+	0xed, 0x34, 0x6d, 0x1a, 0xa0, 0xbd, 0x3b, 0x9f, 0x73, 0xbe, 0xa3, 0xef, 0xc7, 0x33, 0x03, 0x2f,
+	0x4f, 0xf0, 0x38, 0x88, 0xa6, 0x8e, 0x17, 0x78, 0x23, 0x07, 0x93, 0xfa, 0x5a, 0x39, 0x75, 0xe6,
+	0x93, 0x80, 0x30, 0x69, 0x1a, 0x52, 0x46, 0xd1, 0x8b, 0xfb, 0x53, 0xe9, 0x7e, 0x59, 0x7e, 0x67,
+	0x48, 0x87, 0x34, 0x46, 0xd6, 0x17, 0x5f, 0xc9, 0x52, 0x59, 0xf4, 0x68, 0x34, 0xa1, 0x51, 0xdd,
+	0x75, 0xa2, 0xa0, 0x7e, 0x7e, 0xe0, 0x06, 0xcc, 0x39, 0xa8, 0x7b, 0x14, 0x93, 0x64, 0x5e, 0xbd,
+	0xc8, 0x42, 0xd1, 0x48, 0x68, 0x8e, 0x70, 0xc4, 0x68, 0x38, 0x47, 0xbb, 0xf0, 0xcc, 0xa3, 0x84,
+	0x85, 0x8e, 0xc7, 0x6c, 0xec, 0x97, 0xb8, 0x0a, 0x57, 0xe3, 0x4d, 0x58, 0xb6, 0x54, 0x1f, 0x7d,
+	0x03, 0xc0, 0x28, 0x73, 0xc6, 0xf6, 0xd4, 0xc1, 0x7e, 0x29, 0x5b, 0xe1, 0x6a, 0xcf, 0x1a, 0xef,
+	0x4b, 0x09, 0x91, 0xb4, 0x20, 0x92, 0x52, 0x22, 0xa9, 0x4d, 0x31, 0x69, 0xf1, 0x97, 0x7f, 0xec,
+	0x66, 0xcc, 0x42, 0xbc, 0x62, 0x38, 0xd8, 0x47, 0xaf, 0x00, 0x8d, 0x9d, 0x88, 0xd9, 0xa9, 0x3d,
+	0xdb, 0x1d, 0x53, 0xef, 0xb4, 0x94, 0x8b, 0x79, 0x84, 0xc5, 0x24, 0x15, 0xd4, 0x5a, 0xf4, 0x51,
+	0x03, 0xde, 0xf5, 0xe8, 0x64, 0x3a, 0x0e, 0x18, 0xa6, 0xc4, 0x76, 0x29, 0x99, 0x45, 0x09, 0x31,
+	0x5f, 0xe1, 0x6a, 0xdb, 0xe6, 0xf3, 0xd5, 0xb0, 0xb5, 0x98, 0xc5, 0x0c, 0x5f, 0xc3, 0x76, 0x18,
+	0x9c, 0xcc, 0x88, 0x1f, 0xf8, 0xa5, 0xad, 0xc7, 0xe9, 0xbb, 0x5d, 0xa8, 0xfe, 0xcc, 0xc1, 0x9b,
+	0x4a, 0xe4, 0x85, 0xf4, 0x07, 0x33, 0xf0, 0x68, 0xe8, 0xa3, 0x17, 0x00, 0x98, 0x9c, 0xcd, 0x70,
+	0x38, 0x5f, 0xe5, 0x51, 0x48, 0x3b, 0xaa, 0x8f, 0xbe, 0x84, 0xbc, 0x33, 0xa1, 0x33, 0xc2, 0x1e,
+	0x1b, 0x45, 0x0a, 0x47, 0x25, 0x78, 0xc3, 0x0b, 0x03, 0x87, 0xd1, 0x30, 0x36, 0x5f, 0x30, 0x97,
+	0x65, 0xf5, 0x27, 0x78, 0xcb, 0x08, 0xe9, 0x39, 0xf6, 0x83, 0xb0, 0xc7, 0x9c, 0xd3, 0x00, 0x95,
+	0x61, 0x7b, 0x9a, 0x36, 0x62, 0x01, 0x05, 0xf3, 0xb6, 0x7e, 0x7d, 0xfe, 0xf7, 0x20, 0x3f, 0x0a,
+	0xf0, 0x70, 0xc4, 0xd2, 0xec, 0xd3, 0xaa, 0xfa, 0x1b, 0x07, 0xc5, 0x3e, 0x71, 0x29, 0xf1, 0x31,
+	0x19, 0x2a, 0x84, 0x85, 0x73, 0x54, 0x84, 0xec, 0xad, 0xf5, 0x2c, 0xf6, 0xef, 0xe9, 0xc9, 0xfe,
+	0xab, 0x9e, 0xdc, 0xd3, 0xf4, 0x7c, 0x0a, 0x3b, 0x71, 0x00, 0x8b, 0xff, 0x9c, 0x0a, 0xe3, 0x63,
+	0xc6, 0xe2, 0xb2, 0x7d, 0x14, 0x77, 0xd1, 0x4b, 0x78, 0xfb, 0xce, 0x91, 0x48, 0xa1, 0x5b, 0xc9,
+	0xf9, 0x59, 0x0d, 0x12, 0x70, 0xf5, 0x6f, 0x0e, 0x76, 0x96, 0x61, 0xa6, 0x07, 0xeb, 0x49, 0x76,
+	0xd6, 0xae, 0x43, 0xee, 0xc1, 0x75, 0x58, 0xc5, 0xc8, 0xdf, 0x8d, 0xf1, 0x4e, 0x0e, 0x5b, 0x4f,
+	0xcb, 0xe1, 0x10, 0xf8, 0x53, 0x4c, 0xfc, 0x52, 0xbe, 0xc2, 0xd5, 0x8a, 0x8d, 0x86, 0xf4, 0x9f,
+	0xf7, 0x5e, 0x5a, 0xf3, 0x76, 0x8c, 0x89, 0x6f, 0xc6, 0xfb, 0x7b, 0x2e, 0xec, 0xc8, 0xb3, 0x88,
+	0xc9, 0x41, 0xc4, 0x30, 0x89, 0xf3, 0x43, 0x1f, 0x41, 0x45, 0xee, 0xf7, 0x2c, 0x5b, 0x56, 0x7a,
+	0x96, 0xaa, 0x35, 0x2d, 0x55, 0xd7, 0x6c, 0x55, 0xfb, 0xb6, 0xaf, 0x9a, 0x03, 0xbb, 0x6d, 0x2a,
+	0x4d, 0x4b, 0x37, 0x85, 0x0c, 0xfa, 0x10, 0x76, 0x1f, 0xa0, 0xda, 0x7a, 0xb7, 0xdb, 0xd7, 0x54,
+	0x6b, 0x60, 0x1b, 0xba, 0xde, 0x11, 0xb8, 0x32, 0xff, 0xcb, 0xaf, 0x62, 0x66, 0xef, 0x2f, 0x0e,
+	0x9e, 0x6f, 0x50, 0x80, 0x3e, 0x86, 0x0f, 0x0c, 0x53, 0xff, 0x4e, 0x95, 0x15, 0xd3, 0x36, 0x9a,
+	0x83, 0xae, 0xa2, 0x59, 0xf6, 0xb1, 0xaa, 0xc9, 0x76, 0x5f, 0xeb, 0x19, 0x4a, 0x5b, 0x3d, 0x54,
+	0x15, 0x59, 0xc8, 0xa0, 0x2a, 0x88, 0x9b, 0x61, 0x86, 0x62, 0xaa, 0xba, 0xac, 0xb6, 0x05, 0x0e,
+	0xed, 0xc1, 0x27, 0x9b, 0x31, 0x6d, 0xbd, 0x6b, 0x74, 0x94, 0x58, 0x5d, 0x4b, 0xd7, 0xfa, 0x3d,
+	0x21, 0x8b, 0x3e, 0x87, 0x57, 0x9b, 0xb1, 0x96, 0x62, 0x76, 0x97, 0x56, 0x7a, 0x8a, 0x65, 0x75,
+	0x94, 0xc5, 0x4c, 0xc8, 0xa1, 0x7d, 0xf8, 0xec, 0xff, 0x37, 0x0c, 0x45, 0x6b, 0x76, 0xac, 0x81,
+	0xc0, 0x27, 0xae, 0x5b, 0xe6, 0xe5, 0xb5, 0xc8, 0x5d, 0x5d, 0x8b, 0xdc, 0x9f, 0xd7, 0x22, 0x77,
+	0x71, 0x23, 0x66, 0xae, 0x6e, 0xc4, 0xcc, 0xef, 0x37, 0x62, 0xe6, 0xfb, 0xaf, 0x86, 0x98, 0x8d,
+	0x66, 0xae, 0xe4, 0xd1, 0x49, 0x7d, 0xe4, 0x90, 0x68, 0x74, 0xb6, 0x7a, 0xd4, 0xf7, 0x93, 0x57,
+	0xfd, 0xc7, 0xf5, 0x67, 0x9e, 0xcd, 0xa7, 0x41, 0xe4, 0xe6, 0xe3, 0x07, 0xf9, 0x8b, 0x7f, 0x02,
+	0x00, 0x00, 0xff, 0xff, 0x6f, 0x8f, 0x21, 0x1b, 0x14, 0x06, 0x00, 0x00,
 }
 
 func (m *PaymentHistory) Marshal() (dAtA []byte, err error) {
@@ -273,6 +540,16 @@ func (m *PaymentHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Refunded.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPayment(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.CompletionBonusPaid {
 		i--
 		if m.CompletionBonusPaid {
@@ -396,6 +673,121 @@ func (m *ProviderStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UnbondingEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionHeight != 0 {
+		i = encodeVarintPayment(dAtA, i, uint64(m.CompletionHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CreationHeight != 0 {
+		i = encodeVarintPayment(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPayment(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintPayment(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintPayment(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		i = encodeVarintPayment(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPayment(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintPayment(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintPayment(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintPayment(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintPayment(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPayment(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPayment(v)
 	base := offset
@@ -424,6 +816,8 @@ func (m *PaymentHistory) Size() (n int) {
 	if m.CompletionBonusPaid {
 		n += 2
 	}
+	l = m.Refunded.Size()
+	n += 1 + l + sovPayment(uint64(l))
 	return n
 }
 
@@ -463,15 +857,66 @@ func (m *ProviderStake) Size() (n int) {
 	return n
 }
 
-func sovPayment(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPayment(x uint64) (n int) {
-	return sovPayment(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *UnbondingEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPayment(uint64(m.Id))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovPayment(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovPayment(uint64(l))
+	if m.CreationHeight != 0 {
+		n += 1 + sovPayment(uint64(m.CreationHeight))
+	}
+	if m.CompletionHeight != 0 {
+		n += 1 + sovPayment(uint64(m.CompletionHeight))
+	}
+	return n
 }
-func (m *PaymentHistory) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+
+func (m *ProviderPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPayment(uint64(m.Id))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovPayment(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovPayment(uint64(m.ContractId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPayment(uint64(m.Height))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovPayment(uint64(l))
+	if m.Kind != 0 {
+		n += 1 + sovPayment(uint64(m.Kind))
+	}
+	return n
+}
+
+func sovPayment(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPayment(x uint64) (n int) {
+	return sovPayment(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PaymentHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -589,6 +1034,39 @@ func (m *PaymentHistory) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.CompletionBonusPaid = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayment(dAtA[iNdEx:])
@@ -878,6 +1356,369 @@ func (m *ProviderStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UnbondingEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionHeight", wireType)
+			}
+			m.CompletionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPayment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderPayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderPayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= ProviderPaymentKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPayment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPayment(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxMonikerLength is the longest moniker a provider profile may have
+const MaxMonikerLength = 70
+
+// FreeCapacity returns the bytes the provider can still take on
+func (p ProviderProfile) FreeCapacity() uint64 {
+	if p.UsedCapacity >= p.TotalCapacity {
+		return 0
+	}
+	return p.TotalCapacity - p.UsedCapacity
+}
+
+// validateProfileFields checks the fields a provider declares in its profile
+func validateProfileFields(moniker string, totalCapacity uint64, regions []string, endpoints []string) error {
+	if len(moniker) > MaxMonikerLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "moniker is longer than %d bytes", MaxMonikerLength)
+	}
+	if totalCapacity == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "total capacity must be positive")
+	}
+	seen := make(map[string]bool, len(regions))
+	for _, region := range regions {
+		if region == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "region must not be empty")
+		}
+		if seen[region] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate region %s", region)
+		}
+		seen[region] = true
+	}
+	for _, endpoint := range endpoints {
+		if !isMultiaddr(endpoint) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "endpoint %q is not a multiaddr", endpoint)
+		}
+	}
+	return nil
+}
+
+// isMultiaddr reports whether s has the shape of a multiaddr: a slash
+// separated path of protocols and their values such as /ip4/1.2.3.4/tcp/4001.
+// The protocols themselves are not checked.
+func isMultiaddr(s string) bool {
+	if !strings.HasPrefix(s, "/") || strings.HasSuffix(s, "/") {
+		return false
+	}
+	for _, part := range strings.Split(s[1:], "/") {
+		if part == "" {
+			return false
+		}
+	}
+	return true
+}
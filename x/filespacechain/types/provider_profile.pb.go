@@ -0,0 +1,559 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: filespacechain/filespacechain/provider_profile.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProviderProfile is what a provider declares about itself. Offers of a
+// provider with a profile are only matched to files that fit in its free
+// capacity.
+type ProviderProfile struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Moniker  string `protobuf:"bytes,2,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	// Bytes the provider declares it can store
+	TotalCapacity uint64 `protobuf:"varint,3,opt,name=totalCapacity,proto3" json:"totalCapacity,omitempty"`
+	// Bytes held by the provider's PENDING and ACTIVE contracts
+	UsedCapacity uint64   `protobuf:"varint,4,opt,name=usedCapacity,proto3" json:"usedCapacity,omitempty"`
+	Regions      []string `protobuf:"bytes,5,rep,name=regions,proto3" json:"regions,omitempty"`
+	// Multiaddrs the provider serves hosted files at
+	Endpoints []string `protobuf:"bytes,6,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+}
+
+func (m *ProviderProfile) Reset()         { *m = ProviderProfile{} }
+func (m *ProviderProfile) String() string { return proto.CompactTextString(m) }
+func (*ProviderProfile) ProtoMessage()    {}
+func (*ProviderProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d161e46093b2c51, []int{0}
+}
+func (m *ProviderProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderProfile.Merge(m, src)
+}
+func (m *ProviderProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderProfile proto.InternalMessageInfo
+
+func (m *ProviderProfile) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *ProviderProfile) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
+	}
+	return ""
+}
+
+func (m *ProviderProfile) GetTotalCapacity() uint64 {
+	if m != nil {
+		return m.TotalCapacity
+	}
+	return 0
+}
+
+func (m *ProviderProfile) GetUsedCapacity() uint64 {
+	if m != nil {
+		return m.UsedCapacity
+	}
+	return 0
+}
+
+func (m *ProviderProfile) GetRegions() []string {
+	if m != nil {
+		return m.Regions
+	}
+	return nil
+}
+
+func (m *ProviderProfile) GetEndpoints() []string {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ProviderProfile)(nil), "filespacechain.filespacechain.ProviderProfile")
+}
+
+func init() {
+	proto.RegisterFile("filespacechain/filespacechain/provider_profile.proto", fileDescriptor_2d161e46093b2c51)
+}
+
+var fileDescriptor_2d161e46093b2c51 = []byte{
+	// 255 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x49, 0xcb, 0xcc, 0x49,
+	0x2d, 0x2e, 0x48, 0x4c, 0x4e, 0x4d, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x47, 0xe3, 0x16, 0x14, 0xe5,
+	0x97, 0x65, 0xa6, 0xa4, 0x16, 0xc5, 0x17, 0x14, 0xe5, 0x83, 0xa4, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b,
+	0xf2, 0x85, 0x64, 0x51, 0x95, 0xe9, 0xa1, 0x72, 0x95, 0x4e, 0x32, 0x72, 0xf1, 0x07, 0x40, 0x75,
+	0x06, 0x40, 0x34, 0x0a, 0x49, 0x71, 0x71, 0xc0, 0x0c, 0x93, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c,
+	0x82, 0xf3, 0x85, 0x24, 0xb8, 0xd8, 0x73, 0xf3, 0xf3, 0x32, 0xb3, 0x53, 0x8b, 0x24, 0x98, 0xc0,
+	0x52, 0x30, 0xae, 0x90, 0x0a, 0x17, 0x6f, 0x49, 0x7e, 0x49, 0x62, 0x8e, 0x73, 0x62, 0x41, 0x62,
+	0x72, 0x66, 0x49, 0xa5, 0x04, 0xb3, 0x02, 0xa3, 0x06, 0x4b, 0x10, 0xaa, 0xa0, 0x90, 0x12, 0x17,
+	0x4f, 0x69, 0x71, 0x6a, 0x0a, 0x5c, 0x11, 0x0b, 0x58, 0x11, 0x8a, 0x18, 0xc8, 0x8e, 0xa2, 0xd4,
+	0xf4, 0xcc, 0xfc, 0xbc, 0x62, 0x09, 0x56, 0x05, 0x66, 0x90, 0x1d, 0x50, 0xae, 0x90, 0x0c, 0x17,
+	0x67, 0x6a, 0x5e, 0x4a, 0x41, 0x7e, 0x66, 0x5e, 0x49, 0xb1, 0x04, 0x1b, 0x58, 0x0e, 0x21, 0xe0,
+	0x14, 0x74, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
+	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x16, 0xe9, 0x99, 0x25,
+	0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x19, 0x89, 0x79, 0xc5, 0x19, 0x85, 0x88, 0xd0,
+	0xd3, 0x85, 0x04, 0x5f, 0x05, 0x7a, 0x78, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x43,
+	0xd1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x35, 0x01, 0x35, 0x75, 0x7d, 0x01, 0x00, 0x00,
+}
+
+func (m *ProviderProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Endpoints[iNdEx])
+			copy(dAtA[i:], m.Endpoints[iNdEx])
+			i = encodeVarintProviderProfile(dAtA, i, uint64(len(m.Endpoints[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Regions) > 0 {
+		for iNdEx := len(m.Regions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Regions[iNdEx])
+			copy(dAtA[i:], m.Regions[iNdEx])
+			i = encodeVarintProviderProfile(dAtA, i, uint64(len(m.Regions[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.UsedCapacity != 0 {
+		i = encodeVarintProviderProfile(dAtA, i, uint64(m.UsedCapacity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalCapacity != 0 {
+		i = encodeVarintProviderProfile(dAtA, i, uint64(m.TotalCapacity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintProviderProfile(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintProviderProfile(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProviderProfile(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProviderProfile(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProviderProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovProviderProfile(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovProviderProfile(uint64(l))
+	}
+	if m.TotalCapacity != 0 {
+		n += 1 + sovProviderProfile(uint64(m.TotalCapacity))
+	}
+	if m.UsedCapacity != 0 {
+		n += 1 + sovProviderProfile(uint64(m.UsedCapacity))
+	}
+	if len(m.Regions) > 0 {
+		for _, s := range m.Regions {
+			l = len(s)
+			n += 1 + l + sovProviderProfile(uint64(l))
+		}
+	}
+	if len(m.Endpoints) > 0 {
+		for _, s := range m.Endpoints {
+			l = len(s)
+			n += 1 + l + sovProviderProfile(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProviderProfile(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProviderProfile(x uint64) (n int) {
+	return sovProviderProfile(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProviderProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProviderProfile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCapacity", wireType)
+			}
+			m.TotalCapacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalCapacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedCapacity", wireType)
+			}
+			m.UsedCapacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedCapacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regions = append(m.Regions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProviderProfile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProviderProfile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProviderProfile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProviderProfile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProviderProfile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProviderProfile(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProviderProfile
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProviderProfile
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProviderProfile
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProviderProfile
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProviderProfile
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProviderProfile
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProviderProfile        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProviderProfile          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProviderProfile = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,7 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -1561,2467 +1564,14212 @@ func (m *QueryAllProviderStakeResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "filespacechain.filespacechain.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "filespacechain.filespacechain.QueryParamsResponse")
-	proto.RegisterType((*QueryGetFileEntryRequest)(nil), "filespacechain.filespacechain.QueryGetFileEntryRequest")
-	proto.RegisterType((*QueryGetFileEntryResponse)(nil), "filespacechain.filespacechain.QueryGetFileEntryResponse")
-	proto.RegisterType((*QueryAllFileEntryRequest)(nil), "filespacechain.filespacechain.QueryAllFileEntryRequest")
-	proto.RegisterType((*QueryAllFileEntryResponse)(nil), "filespacechain.filespacechain.QueryAllFileEntryResponse")
-	proto.RegisterType((*QueryGetHostingInquiryRequest)(nil), "filespacechain.filespacechain.QueryGetHostingInquiryRequest")
-	proto.RegisterType((*QueryGetHostingInquiryResponse)(nil), "filespacechain.filespacechain.QueryGetHostingInquiryResponse")
-	proto.RegisterType((*QueryAllHostingInquiryRequest)(nil), "filespacechain.filespacechain.QueryAllHostingInquiryRequest")
-	proto.RegisterType((*QueryAllHostingInquiryResponse)(nil), "filespacechain.filespacechain.QueryAllHostingInquiryResponse")
-	proto.RegisterType((*QueryGetHostingContractRequest)(nil), "filespacechain.filespacechain.QueryGetHostingContractRequest")
-	proto.RegisterType((*QueryGetHostingContractResponse)(nil), "filespacechain.filespacechain.QueryGetHostingContractResponse")
-	proto.RegisterType((*QueryAllHostingContractRequest)(nil), "filespacechain.filespacechain.QueryAllHostingContractRequest")
-	proto.RegisterType((*QueryAllHostingContractFromRequest)(nil), "filespacechain.filespacechain.QueryAllHostingContractFromRequest")
-	proto.RegisterType((*QueryAllHostingContractResponse)(nil), "filespacechain.filespacechain.QueryAllHostingContractResponse")
-	proto.RegisterType((*QueryGetHostingOfferRequest)(nil), "filespacechain.filespacechain.QueryGetHostingOfferRequest")
-	proto.RegisterType((*QueryGetHostingOfferResponse)(nil), "filespacechain.filespacechain.QueryGetHostingOfferResponse")
-	proto.RegisterType((*QueryAllHostingOfferRequest)(nil), "filespacechain.filespacechain.QueryAllHostingOfferRequest")
-	proto.RegisterType((*QueryAllHostingOfferResponse)(nil), "filespacechain.filespacechain.QueryAllHostingOfferResponse")
-	proto.RegisterType((*QueryListHostingContractFromRequest)(nil), "filespacechain.filespacechain.QueryListHostingContractFromRequest")
-	proto.RegisterType((*QueryListHostingContractFromResponse)(nil), "filespacechain.filespacechain.QueryListHostingContractFromResponse")
-	proto.RegisterType((*QueryPaymentHistoryRequest)(nil), "filespacechain.filespacechain.QueryPaymentHistoryRequest")
-	proto.RegisterType((*QueryPaymentHistoryResponse)(nil), "filespacechain.filespacechain.QueryPaymentHistoryResponse")
-	proto.RegisterType((*QueryAllPaymentHistoryRequest)(nil), "filespacechain.filespacechain.QueryAllPaymentHistoryRequest")
-	proto.RegisterType((*QueryAllPaymentHistoryResponse)(nil), "filespacechain.filespacechain.QueryAllPaymentHistoryResponse")
-	proto.RegisterType((*QueryEscrowRecordRequest)(nil), "filespacechain.filespacechain.QueryEscrowRecordRequest")
-	proto.RegisterType((*QueryEscrowRecordResponse)(nil), "filespacechain.filespacechain.QueryEscrowRecordResponse")
-	proto.RegisterType((*QueryAllEscrowRecordRequest)(nil), "filespacechain.filespacechain.QueryAllEscrowRecordRequest")
-	proto.RegisterType((*QueryAllEscrowRecordResponse)(nil), "filespacechain.filespacechain.QueryAllEscrowRecordResponse")
-	proto.RegisterType((*QueryProviderStakeRequest)(nil), "filespacechain.filespacechain.QueryProviderStakeRequest")
-	proto.RegisterType((*QueryProviderStakeResponse)(nil), "filespacechain.filespacechain.QueryProviderStakeResponse")
-	proto.RegisterType((*QueryAllProviderStakeRequest)(nil), "filespacechain.filespacechain.QueryAllProviderStakeRequest")
-	proto.RegisterType((*QueryAllProviderStakeResponse)(nil), "filespacechain.filespacechain.QueryAllProviderStakeResponse")
+// Storage Challenge Queries
+type QueryGetStorageChallengeRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("filespacechain/filespacechain/query.proto", fileDescriptor_1bd8b9ca09eda9eb)
+func (m *QueryGetStorageChallengeRequest) Reset()         { *m = QueryGetStorageChallengeRequest{} }
+func (m *QueryGetStorageChallengeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetStorageChallengeRequest) ProtoMessage()    {}
+func (*QueryGetStorageChallengeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{33}
 }
-
-var fileDescriptor_1bd8b9ca09eda9eb = []byte{
-	// 1431 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x99, 0xdb, 0x6f, 0xe3, 0xc4,
-	0x17, 0xc7, 0x3b, 0xcd, 0xfe, 0xf6, 0x47, 0x67, 0x7b, 0x61, 0x87, 0x95, 0xe8, 0x9a, 0x6d, 0x8a,
-	0xcc, 0x6d, 0xe9, 0xd2, 0x98, 0xb6, 0xbb, 0xdb, 0xa6, 0x4d, 0x0b, 0x4d, 0x77, 0xbb, 0xad, 0x28,
-	0xa2, 0x64, 0x05, 0x12, 0x17, 0x11, 0xb9, 0x89, 0x93, 0x58, 0x24, 0x9e, 0xd4, 0x76, 0x77, 0xa9,
-	0xa2, 0x4a, 0xc0, 0x0b, 0xaf, 0x48, 0xfc, 0x0f, 0x88, 0x47, 0xc4, 0x33, 0x5a, 0x84, 0x10, 0x50,
-	0xad, 0x10, 0x54, 0xdc, 0xc4, 0x13, 0x42, 0x2d, 0x12, 0xcf, 0x3c, 0xf1, 0x8a, 0x3c, 0x3e, 0x4e,
-	0x6c, 0xc7, 0x4e, 0xc6, 0xae, 0x5f, 0x78, 0xa9, 0xe2, 0xf1, 0x9c, 0x33, 0xe7, 0x73, 0xce, 0xf1,
-	0x78, 0xbe, 0x2e, 0x7e, 0xba, 0xa2, 0xd6, 0x15, 0xa3, 0x29, 0x97, 0x94, 0x52, 0x4d, 0x56, 0x35,
-	0xc9, 0x77, 0xb9, 0xbb, 0xa7, 0xe8, 0xfb, 0x99, 0xa6, 0x4e, 0x4d, 0x4a, 0x26, 0xbc, 0xf7, 0x32,
-	0xde, 0x4b, 0xe1, 0xbc, 0xdc, 0x50, 0x35, 0x2a, 0xb1, 0xbf, 0xb6, 0x85, 0x70, 0xa1, 0x4a, 0xab,
-	0x94, 0xfd, 0x94, 0xac, 0x5f, 0x30, 0x7a, 0xa9, 0x4a, 0x69, 0xb5, 0xae, 0x48, 0x72, 0x53, 0x95,
-	0x64, 0x4d, 0xa3, 0xa6, 0x6c, 0xaa, 0x54, 0x33, 0xe0, 0xee, 0x54, 0x89, 0x1a, 0x0d, 0x6a, 0x48,
-	0x3b, 0xb2, 0xa1, 0xd8, 0xcb, 0x4b, 0x77, 0x66, 0x76, 0x14, 0x53, 0x9e, 0x91, 0x9a, 0x72, 0x55,
-	0xd5, 0xd8, 0x64, 0x67, 0x6e, 0xef, 0xe0, 0x9b, 0xb2, 0x2e, 0x37, 0x1c, 0xbf, 0x99, 0xde, 0x73,
-	0xad, 0xcb, 0xa2, 0xa2, 0x99, 0x0e, 0xad, 0x30, 0xd7, 0x7b, 0x7e, 0x8d, 0x1a, 0xa6, 0xaa, 0x55,
-	0x8b, 0xaa, 0xb6, 0xbb, 0xa7, 0xb6, 0x8d, 0xae, 0xf2, 0x19, 0x95, 0xa8, 0x66, 0xea, 0x72, 0xc9,
-	0x04, 0xab, 0x19, 0x3e, 0x2b, 0x5a, 0xa9, 0x28, 0x3a, 0x98, 0x5c, 0xe9, 0x47, 0xbe, 0xdf, 0x50,
-	0x34, 0xf0, 0x2f, 0x5e, 0xc0, 0xe4, 0x65, 0x2b, 0x91, 0xdb, 0x2c, 0x1f, 0x05, 0x65, 0x77, 0x4f,
-	0x31, 0x4c, 0xb1, 0x88, 0x1f, 0xf2, 0x8c, 0x1a, 0x4d, 0xaa, 0x19, 0x0a, 0xd9, 0xc0, 0x67, 0xed,
-	0xbc, 0x8d, 0xa3, 0x47, 0xd1, 0xe5, 0x73, 0xb3, 0x4f, 0x64, 0x7a, 0x96, 0x3d, 0x63, 0x9b, 0xe7,
-	0x87, 0x0e, 0x7f, 0x9f, 0x1c, 0xf8, 0xe4, 0xaf, 0x4f, 0xa7, 0x50, 0x01, 0xec, 0xc5, 0x29, 0x3c,
-	0xce, 0x16, 0xb8, 0xa5, 0x98, 0xeb, 0x6a, 0x5d, 0xb9, 0x69, 0x25, 0x17, 0x16, 0x27, 0xa3, 0x78,
-	0x50, 0x2d, 0xb3, 0x15, 0xce, 0x14, 0x06, 0xd5, 0xb2, 0xa8, 0xe2, 0x8b, 0x01, 0x73, 0x21, 0xa4,
-	0x2d, 0x3c, 0xd4, 0x1e, 0x84, 0xa8, 0x2e, 0xf7, 0x89, 0xaa, 0x3d, 0x3f, 0x7f, 0xc6, 0x0a, 0xac,
-	0xd0, 0x71, 0x20, 0xee, 0x40, 0x58, 0xab, 0xf5, 0x7a, 0x57, 0x58, 0xeb, 0x18, 0x77, 0x9a, 0x0c,
-	0x96, 0x7a, 0x32, 0x63, 0x77, 0x64, 0xc6, 0xea, 0xc8, 0x8c, 0xfd, 0x40, 0x40, 0x47, 0x66, 0xb6,
-	0xe5, 0xaa, 0x02, 0xb6, 0x05, 0x97, 0xa5, 0xf8, 0x19, 0x02, 0x1e, 0xef, 0x22, 0xc1, 0x3c, 0xa9,
-	0x53, 0xf1, 0x90, 0x5b, 0x9e, 0x98, 0x07, 0x59, 0xcc, 0x4f, 0xf5, 0x8d, 0xd9, 0x0e, 0xc5, 0x13,
-	0xb4, 0x84, 0x27, 0x9c, 0x1a, 0x6c, 0xd8, 0x2d, 0xb7, 0x69, 0x37, 0x77, 0x58, 0xd1, 0x0e, 0x70,
-	0x3a, 0xcc, 0x00, 0x48, 0xdf, 0xc0, 0xa3, 0xde, 0x3b, 0x90, 0xd3, 0xe9, 0x3e, 0xb8, 0x5e, 0x23,
-	0x60, 0xf6, 0xb9, 0x12, 0xab, 0x10, 0xef, 0x6a, 0xbd, 0x1e, 0x1c, 0x6f, 0x52, 0xd5, 0xfc, 0x06,
-	0x01, 0x68, 0xc0, 0x4a, 0x3d, 0x40, 0x53, 0x09, 0x81, 0x26, 0x57, 0xe1, 0x67, 0xbb, 0x0a, 0xb6,
-	0x06, 0x3b, 0x51, 0x58, 0x89, 0xdf, 0x43, 0x78, 0x32, 0xd4, 0x04, 0xd8, 0xdf, 0xc2, 0x63, 0xbe,
-	0x5b, 0x90, 0xeb, 0x0c, 0x1f, 0xbc, 0x63, 0x05, 0xf4, 0x7e, 0x67, 0x62, 0xad, 0x2b, 0xfb, 0xfe,
-	0xa8, 0x93, 0x2a, 0xf4, 0xbb, 0x08, 0x8b, 0x21, 0x4b, 0xad, 0xeb, 0xb4, 0x91, 0xf0, 0x72, 0x84,
-	0xe0, 0x33, 0x15, 0x9d, 0x36, 0x58, 0x45, 0x87, 0x0a, 0xec, 0xb7, 0x78, 0xdf, 0x49, 0x78, 0x10,
-	0x6d, 0xaf, 0x84, 0xa7, 0x12, 0x4b, 0x78, 0x72, 0xfd, 0x36, 0x8d, 0x1f, 0xf1, 0x35, 0xcf, 0x4b,
-	0xd6, 0x3b, 0x2c, 0xac, 0xd9, 0xf6, 0xf0, 0xa5, 0xe0, 0xe9, 0xc0, 0xfd, 0x0a, 0x1e, 0x76, 0x8f,
-	0x43, 0xe6, 0xaf, 0xf0, 0x41, 0x33, 0x13, 0x20, 0xf6, 0xb8, 0x11, 0x15, 0x88, 0xb2, 0x93, 0x71,
-	0x4f, 0x94, 0x49, 0x35, 0xd7, 0x3d, 0x04, 0x78, 0x5d, 0xeb, 0x84, 0xe2, 0xa5, 0x12, 0xc0, 0x4b,
-	0xae, 0x9a, 0x1f, 0x20, 0xfc, 0x18, 0x03, 0xd8, 0x52, 0x0d, 0xb3, 0xc7, 0xe3, 0x31, 0x8e, 0xff,
-	0x5f, 0xd2, 0x15, 0xd9, 0xa4, 0x76, 0x85, 0x86, 0x0a, 0xce, 0xa5, 0x2f, 0x95, 0x83, 0xb1, 0x53,
-	0xf9, 0x03, 0xc2, 0x8f, 0xf7, 0x8e, 0xe4, 0xbf, 0xf6, 0xa4, 0x2c, 0x63, 0x01, 0x0e, 0x63, 0xec,
-	0xe0, 0xb6, 0xa1, 0x1a, 0x26, 0xed, 0xbc, 0xc8, 0x26, 0xf1, 0x39, 0xe7, 0xc8, 0x58, 0x6c, 0x3f,
-	0x31, 0xd8, 0x19, 0xda, 0x2c, 0x8b, 0x2d, 0x68, 0x61, 0xbf, 0x39, 0xa4, 0xe1, 0x4d, 0x3c, 0x06,
-	0x27, 0xc2, 0x62, 0xcd, 0xbe, 0xc5, 0xf9, 0x1e, 0xf6, 0xfa, 0x73, 0x5e, 0x4f, 0x4d, 0xcf, 0xa8,
-	0xfb, 0x3d, 0x1c, 0x1c, 0x7e, 0x52, 0x4f, 0xd0, 0xb7, 0xae, 0xf7, 0x70, 0x14, 0xd2, 0x54, 0x42,
-	0xa4, 0xc9, 0x95, 0x3b, 0x0b, 0x67, 0xd0, 0x9b, 0x46, 0x49, 0xa7, 0x77, 0x0b, 0x4a, 0x89, 0xea,
-	0x65, 0x27, 0x5b, 0x13, 0x18, 0x83, 0xa8, 0xe8, 0xd4, 0x7a, 0x08, 0x46, 0x36, 0xcb, 0xa2, 0x01,
-	0x27, 0x4b, 0xaf, 0x29, 0xe0, 0xbf, 0x8a, 0x47, 0x14, 0x36, 0x5e, 0xd4, 0xd9, 0x0d, 0xce, 0x2d,
-	0xd2, 0xed, 0xcb, 0xd9, 0x43, 0x14, 0xd7, 0x98, 0x7b, 0x8b, 0x0c, 0x0a, 0x39, 0xa9, 0x02, 0x7f,
-	0xe1, 0xda, 0x22, 0x79, 0xf9, 0x52, 0x09, 0xf0, 0x25, 0x57, 0xd8, 0x79, 0xa8, 0xce, 0xb6, 0x4e,
-	0xef, 0xa8, 0x65, 0x45, 0xbf, 0x6d, 0xca, 0x6f, 0x3b, 0xa8, 0x44, 0xc0, 0x0f, 0x34, 0x61, 0x1c,
-	0x76, 0xc6, 0xf6, 0xb5, 0x78, 0xd7, 0xd9, 0x00, 0xbc, 0x86, 0xc0, 0xfd, 0x1a, 0x1e, 0x75, 0x66,
-	0x16, 0x0d, 0xeb, 0x0e, 0x24, 0xf9, 0x99, 0x7e, 0x5d, 0xed, 0xf6, 0x06, 0xe4, 0x23, 0x4d, 0xf7,
-	0xa0, 0x58, 0xe9, 0xa4, 0x3c, 0x30, 0xe8, 0xa4, 0x6a, 0xfb, 0x15, 0x72, 0x6d, 0x13, 0xdc, 0x90,
-	0xa9, 0x44, 0x20, 0x13, 0xab, 0xef, 0xec, 0xdf, 0x17, 0xf1, 0xff, 0x18, 0x05, 0xf9, 0x18, 0xe1,
-	0xb3, 0xb6, 0xf6, 0x25, 0x33, 0x7d, 0x02, 0xec, 0x16, 0xdf, 0xc2, 0x6c, 0x14, 0x13, 0x3b, 0x0e,
-	0xf1, 0xda, 0xfb, 0x3f, 0xfd, 0xf9, 0xd1, 0xa0, 0x44, 0xa6, 0xa5, 0x9a, 0xac, 0x19, 0xb5, 0xdd,
-	0x8e, 0xe8, 0x9f, 0xee, 0xf1, 0xf9, 0x83, 0xdc, 0x43, 0x2e, 0xb9, 0x49, 0xe6, 0x79, 0x16, 0x0e,
-	0x50, 0xec, 0xc2, 0x42, 0x74, 0x43, 0x88, 0x7b, 0x85, 0xc5, 0xbd, 0x40, 0xae, 0x73, 0xc6, 0xdd,
-	0xf9, 0x14, 0x23, 0xb5, 0xd4, 0xf2, 0x01, 0xf9, 0x1c, 0xe1, 0xe1, 0xb6, 0xd7, 0xd5, 0x7a, 0x9d,
-	0x8f, 0x21, 0x40, 0xde, 0xf3, 0x31, 0x04, 0x49, 0x76, 0x31, 0xcb, 0x18, 0xe6, 0xc8, 0x4c, 0x64,
-	0x06, 0xf2, 0x23, 0xf2, 0x6b, 0x43, 0x92, 0xe3, 0xcc, 0x65, 0xa0, 0xac, 0x15, 0x96, 0x63, 0x5a,
-	0x03, 0xca, 0x1a, 0x43, 0x59, 0x26, 0x4b, 0x9c, 0x28, 0xbe, 0x2f, 0x5d, 0x76, 0x4d, 0x8e, 0x10,
-	0x3e, 0xef, 0xf5, 0x6f, 0x15, 0x26, 0xc7, 0x99, 0xdf, 0x53, 0x70, 0x85, 0x4a, 0xf0, 0xc8, 0x6d,
-	0xe6, 0xe3, 0x22, 0xbf, 0xa2, 0xae, 0xc3, 0x22, 0x89, 0x98, 0x6a, 0x9f, 0x2e, 0x15, 0x56, 0xe2,
-	0x9a, 0x03, 0xd2, 0x0d, 0x86, 0xb4, 0x42, 0x72, 0x11, 0x91, 0x9c, 0x93, 0xa1, 0x5d, 0xab, 0x9f,
-	0x11, 0x26, 0xbe, 0x15, 0xac, 0x62, 0x45, 0x4c, 0x77, 0x2c, 0xb6, 0x70, 0x11, 0x2b, 0x3e, 0xc7,
-	0xd8, 0xb2, 0x64, 0x3e, 0x26, 0x1b, 0xb9, 0x8f, 0xbc, 0x7a, 0x89, 0x2c, 0x46, 0xcb, 0xb6, 0x5b,
-	0xe4, 0x09, 0x4b, 0xb1, 0x6c, 0x01, 0x65, 0x95, 0xa1, 0x2c, 0x91, 0x6c, 0x44, 0x14, 0xf6, 0x41,
-	0xd7, 0xae, 0xd1, 0x61, 0xa7, 0xf9, 0x98, 0x6f, 0xab, 0x40, 0x8b, 0xd1, 0x32, 0x1c, 0x9d, 0x27,
-	0x44, 0x88, 0x8a, 0x39, 0xc6, 0x73, 0x9d, 0x5c, 0x8d, 0xc3, 0x43, 0xfe, 0x41, 0xf8, 0xe1, 0x10,
-	0x5d, 0x46, 0xf2, 0x3c, 0x61, 0xf5, 0x96, 0x97, 0xc2, 0xda, 0xa9, 0x7c, 0x00, 0xe2, 0x6d, 0x86,
-	0xf8, 0x22, 0x79, 0x81, 0x13, 0xb1, 0xae, 0x1a, 0x66, 0xd1, 0xdf, 0x82, 0xc5, 0x8a, 0x4e, 0x1b,
-	0x52, 0x0b, 0xd4, 0xed, 0x01, 0xf9, 0x05, 0xe1, 0x51, 0xaf, 0x8e, 0x20, 0x59, 0xbe, 0xf7, 0x7c,
-	0x80, 0x6a, 0x12, 0x16, 0xe3, 0x98, 0x02, 0xde, 0x16, 0xc3, 0x5b, 0x27, 0x37, 0xb8, 0x8f, 0x0a,
-	0x1e, 0xcd, 0x24, 0xb5, 0x5c, 0x72, 0xd3, 0xde, 0xec, 0xbd, 0x0b, 0x45, 0xd9, 0xec, 0x83, 0xe9,
-	0x96, 0x63, 0x5a, 0xc7, 0xdc, 0xec, 0x7d, 0x80, 0xe4, 0x6b, 0x84, 0x87, 0xdd, 0xaa, 0x80, 0xef,
-	0x4c, 0x11, 0xa0, 0x7d, 0xf8, 0xce, 0x14, 0x41, 0x62, 0x46, 0xcc, 0x33, 0x86, 0x1c, 0x59, 0xe4,
-	0x64, 0xb0, 0x15, 0x8b, 0xd4, 0xea, 0xa8, 0xc3, 0x03, 0xf2, 0x25, 0xc2, 0x63, 0x6e, 0xe7, 0x51,
-	0xf6, 0x8d, 0x20, 0x9a, 0xa5, 0x58, 0xb6, 0x31, 0x0f, 0xa8, 0x36, 0x10, 0xf9, 0x1e, 0xe1, 0x11,
-	0xcf, 0x19, 0x9e, 0x70, 0xe5, 0x34, 0x48, 0xad, 0x08, 0xd9, 0x18, 0x96, 0x10, 0xfd, 0x06, 0x8b,
-	0x3e, 0x4f, 0x9e, 0xe7, 0x6d, 0x29, 0x8f, 0x56, 0x91, 0x5a, 0xce, 0xf5, 0x01, 0xf9, 0x0e, 0xe1,
-	0x07, 0x3d, 0x6b, 0x58, 0x55, 0xe1, 0xcd, 0x6c, 0x20, 0x56, 0x2e, 0x9e, 0x31, 0x90, 0x2d, 0x33,
-	0xb2, 0x79, 0x72, 0x2d, 0x16, 0x59, 0xbe, 0x70, 0x78, 0x9c, 0x46, 0x47, 0xc7, 0x69, 0xf4, 0xc7,
-	0x71, 0x1a, 0x7d, 0x78, 0x92, 0x1e, 0x38, 0x3a, 0x49, 0x0f, 0xfc, 0x76, 0x92, 0x1e, 0x78, 0x7d,
-	0xa1, 0xaa, 0x9a, 0xb5, 0xbd, 0x9d, 0x4c, 0x89, 0x36, 0xc2, 0x5c, 0xbf, 0xe3, 0x77, 0x6e, 0xee,
-	0x37, 0x15, 0x63, 0xe7, 0x2c, 0xfb, 0xcf, 0xe4, 0xdc, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9c,
-	0x61, 0x7c, 0xf1, 0x7f, 0x1e, 0x00, 0x00,
+func (m *QueryGetStorageChallengeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetStorageChallengeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetStorageChallengeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetStorageChallengeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetStorageChallengeRequest.Merge(m, src)
+}
+func (m *QueryGetStorageChallengeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetStorageChallengeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetStorageChallengeRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetStorageChallengeRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of FileEntry items.
-	FileEntry(ctx context.Context, in *QueryGetFileEntryRequest, opts ...grpc.CallOption) (*QueryGetFileEntryResponse, error)
-	FileEntryAll(ctx context.Context, in *QueryAllFileEntryRequest, opts ...grpc.CallOption) (*QueryAllFileEntryResponse, error)
-	// Queries a list of HostingInquiry items.
-	HostingInquiry(ctx context.Context, in *QueryGetHostingInquiryRequest, opts ...grpc.CallOption) (*QueryGetHostingInquiryResponse, error)
-	HostingInquiryAll(ctx context.Context, in *QueryAllHostingInquiryRequest, opts ...grpc.CallOption) (*QueryAllHostingInquiryResponse, error)
-	// Queries a list of HostingContract items.
-	HostingContract(ctx context.Context, in *QueryGetHostingContractRequest, opts ...grpc.CallOption) (*QueryGetHostingContractResponse, error)
-	HostingContractAll(ctx context.Context, in *QueryAllHostingContractRequest, opts ...grpc.CallOption) (*QueryAllHostingContractResponse, error)
-	// Queries a list of HostingOffer items.
-	HostingOffer(ctx context.Context, in *QueryGetHostingOfferRequest, opts ...grpc.CallOption) (*QueryGetHostingOfferResponse, error)
-	HostingOfferAll(ctx context.Context, in *QueryAllHostingOfferRequest, opts ...grpc.CallOption) (*QueryAllHostingOfferResponse, error)
-	// Queries a list of ListHostingContractFrom items.
-	ListHostingContractFrom(ctx context.Context, in *QueryListHostingContractFromRequest, opts ...grpc.CallOption) (*QueryListHostingContractFromResponse, error)
-	// Queries payment history for a specific contract.
-	PaymentHistory(ctx context.Context, in *QueryPaymentHistoryRequest, opts ...grpc.CallOption) (*QueryPaymentHistoryResponse, error)
-	// Queries all payment history records.
-	PaymentHistoryAll(ctx context.Context, in *QueryAllPaymentHistoryRequest, opts ...grpc.CallOption) (*QueryAllPaymentHistoryResponse, error)
-	// Queries escrow record for a specific inquiry.
-	EscrowRecord(ctx context.Context, in *QueryEscrowRecordRequest, opts ...grpc.CallOption) (*QueryEscrowRecordResponse, error)
-	// Queries all escrow records.
-	EscrowRecordAll(ctx context.Context, in *QueryAllEscrowRecordRequest, opts ...grpc.CallOption) (*QueryAllEscrowRecordResponse, error)
-	// Queries provider stake for a specific address.
-	ProviderStake(ctx context.Context, in *QueryProviderStakeRequest, opts ...grpc.CallOption) (*QueryProviderStakeResponse, error)
-	// Queries all provider stakes.
-	ProviderStakeAll(ctx context.Context, in *QueryAllProviderStakeRequest, opts ...grpc.CallOption) (*QueryAllProviderStakeResponse, error)
+func (m *QueryGetStorageChallengeRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryGetStorageChallengeResponse struct {
+	StorageChallenge StorageChallenge `protobuf:"bytes,1,opt,name=StorageChallenge,proto3" json:"StorageChallenge"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetStorageChallengeResponse) Reset()         { *m = QueryGetStorageChallengeResponse{} }
+func (m *QueryGetStorageChallengeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetStorageChallengeResponse) ProtoMessage()    {}
+func (*QueryGetStorageChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{34}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetStorageChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetStorageChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetStorageChallengeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetStorageChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetStorageChallengeResponse.Merge(m, src)
+}
+func (m *QueryGetStorageChallengeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetStorageChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetStorageChallengeResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) FileEntry(ctx context.Context, in *QueryGetFileEntryRequest, opts ...grpc.CallOption) (*QueryGetFileEntryResponse, error) {
-	out := new(QueryGetFileEntryResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/FileEntry", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetStorageChallengeResponse proto.InternalMessageInfo
+
+func (m *QueryGetStorageChallengeResponse) GetStorageChallenge() StorageChallenge {
+	if m != nil {
+		return m.StorageChallenge
 	}
-	return out, nil
+	return StorageChallenge{}
 }
 
-func (c *queryClient) FileEntryAll(ctx context.Context, in *QueryAllFileEntryRequest, opts ...grpc.CallOption) (*QueryAllFileEntryResponse, error) {
-	out := new(QueryAllFileEntryResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/FileEntryAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAllStorageChallengeRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) HostingInquiry(ctx context.Context, in *QueryGetHostingInquiryRequest, opts ...grpc.CallOption) (*QueryGetHostingInquiryResponse, error) {
-	out := new(QueryGetHostingInquiryResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/HostingInquiry", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllStorageChallengeRequest) Reset()         { *m = QueryAllStorageChallengeRequest{} }
+func (m *QueryAllStorageChallengeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllStorageChallengeRequest) ProtoMessage()    {}
+func (*QueryAllStorageChallengeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{35}
+}
+func (m *QueryAllStorageChallengeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllStorageChallengeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllStorageChallengeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllStorageChallengeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllStorageChallengeRequest.Merge(m, src)
+}
+func (m *QueryAllStorageChallengeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllStorageChallengeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllStorageChallengeRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) HostingInquiryAll(ctx context.Context, in *QueryAllHostingInquiryRequest, opts ...grpc.CallOption) (*QueryAllHostingInquiryResponse, error) {
-	out := new(QueryAllHostingInquiryResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/HostingInquiryAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllStorageChallengeRequest proto.InternalMessageInfo
+
+func (m *QueryAllStorageChallengeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) HostingContract(ctx context.Context, in *QueryGetHostingContractRequest, opts ...grpc.CallOption) (*QueryGetHostingContractResponse, error) {
-	out := new(QueryGetHostingContractResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/HostingContract", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAllStorageChallengeResponse struct {
+	StorageChallenge []StorageChallenge  `protobuf:"bytes,1,rep,name=StorageChallenge,proto3" json:"StorageChallenge"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) HostingContractAll(ctx context.Context, in *QueryAllHostingContractRequest, opts ...grpc.CallOption) (*QueryAllHostingContractResponse, error) {
-	out := new(QueryAllHostingContractResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/HostingContractAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllStorageChallengeResponse) Reset()         { *m = QueryAllStorageChallengeResponse{} }
+func (m *QueryAllStorageChallengeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllStorageChallengeResponse) ProtoMessage()    {}
+func (*QueryAllStorageChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{36}
+}
+func (m *QueryAllStorageChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllStorageChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllStorageChallengeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllStorageChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllStorageChallengeResponse.Merge(m, src)
+}
+func (m *QueryAllStorageChallengeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllStorageChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllStorageChallengeResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) HostingOffer(ctx context.Context, in *QueryGetHostingOfferRequest, opts ...grpc.CallOption) (*QueryGetHostingOfferResponse, error) {
-	out := new(QueryGetHostingOfferResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/HostingOffer", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllStorageChallengeResponse proto.InternalMessageInfo
+
+func (m *QueryAllStorageChallengeResponse) GetStorageChallenge() []StorageChallenge {
+	if m != nil {
+		return m.StorageChallenge
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) HostingOfferAll(ctx context.Context, in *QueryAllHostingOfferRequest, opts ...grpc.CallOption) (*QueryAllHostingOfferResponse, error) {
-	out := new(QueryAllHostingOfferResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/HostingOfferAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllStorageChallengeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ListHostingContractFrom(ctx context.Context, in *QueryListHostingContractFromRequest, opts ...grpc.CallOption) (*QueryListHostingContractFromResponse, error) {
-	out := new(QueryListHostingContractFromResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/ListHostingContractFrom", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// Slash Event Queries
+type QueryGetSlashEventRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (c *queryClient) PaymentHistory(ctx context.Context, in *QueryPaymentHistoryRequest, opts ...grpc.CallOption) (*QueryPaymentHistoryResponse, error) {
-	out := new(QueryPaymentHistoryResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/PaymentHistory", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetSlashEventRequest) Reset()         { *m = QueryGetSlashEventRequest{} }
+func (m *QueryGetSlashEventRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSlashEventRequest) ProtoMessage()    {}
+func (*QueryGetSlashEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{37}
+}
+func (m *QueryGetSlashEventRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSlashEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSlashEventRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetSlashEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSlashEventRequest.Merge(m, src)
+}
+func (m *QueryGetSlashEventRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSlashEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSlashEventRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) PaymentHistoryAll(ctx context.Context, in *QueryAllPaymentHistoryRequest, opts ...grpc.CallOption) (*QueryAllPaymentHistoryResponse, error) {
-	out := new(QueryAllPaymentHistoryResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/PaymentHistoryAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetSlashEventRequest proto.InternalMessageInfo
+
+func (m *QueryGetSlashEventRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) EscrowRecord(ctx context.Context, in *QueryEscrowRecordRequest, opts ...grpc.CallOption) (*QueryEscrowRecordResponse, error) {
-	out := new(QueryEscrowRecordResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/EscrowRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryGetSlashEventResponse struct {
+	SlashEvent SlashEvent `protobuf:"bytes,1,opt,name=SlashEvent,proto3" json:"SlashEvent"`
 }
 
-func (c *queryClient) EscrowRecordAll(ctx context.Context, in *QueryAllEscrowRecordRequest, opts ...grpc.CallOption) (*QueryAllEscrowRecordResponse, error) {
-	out := new(QueryAllEscrowRecordResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/EscrowRecordAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetSlashEventResponse) Reset()         { *m = QueryGetSlashEventResponse{} }
+func (m *QueryGetSlashEventResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSlashEventResponse) ProtoMessage()    {}
+func (*QueryGetSlashEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{38}
+}
+func (m *QueryGetSlashEventResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSlashEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSlashEventResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetSlashEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSlashEventResponse.Merge(m, src)
+}
+func (m *QueryGetSlashEventResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSlashEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSlashEventResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ProviderStake(ctx context.Context, in *QueryProviderStakeRequest, opts ...grpc.CallOption) (*QueryProviderStakeResponse, error) {
-	out := new(QueryProviderStakeResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/ProviderStake", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetSlashEventResponse proto.InternalMessageInfo
+
+func (m *QueryGetSlashEventResponse) GetSlashEvent() SlashEvent {
+	if m != nil {
+		return m.SlashEvent
 	}
-	return out, nil
+	return SlashEvent{}
 }
 
-func (c *queryClient) ProviderStakeAll(ctx context.Context, in *QueryAllProviderStakeRequest, opts ...grpc.CallOption) (*QueryAllProviderStakeResponse, error) {
-	out := new(QueryAllProviderStakeResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/ProviderStakeAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAllSlashEventRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of FileEntry items.
-	FileEntry(context.Context, *QueryGetFileEntryRequest) (*QueryGetFileEntryResponse, error)
-	FileEntryAll(context.Context, *QueryAllFileEntryRequest) (*QueryAllFileEntryResponse, error)
-	// Queries a list of HostingInquiry items.
-	HostingInquiry(context.Context, *QueryGetHostingInquiryRequest) (*QueryGetHostingInquiryResponse, error)
-	HostingInquiryAll(context.Context, *QueryAllHostingInquiryRequest) (*QueryAllHostingInquiryResponse, error)
-	// Queries a list of HostingContract items.
-	HostingContract(context.Context, *QueryGetHostingContractRequest) (*QueryGetHostingContractResponse, error)
-	HostingContractAll(context.Context, *QueryAllHostingContractRequest) (*QueryAllHostingContractResponse, error)
-	// Queries a list of HostingOffer items.
-	HostingOffer(context.Context, *QueryGetHostingOfferRequest) (*QueryGetHostingOfferResponse, error)
-	HostingOfferAll(context.Context, *QueryAllHostingOfferRequest) (*QueryAllHostingOfferResponse, error)
-	// Queries a list of ListHostingContractFrom items.
-	ListHostingContractFrom(context.Context, *QueryListHostingContractFromRequest) (*QueryListHostingContractFromResponse, error)
-	// Queries payment history for a specific contract.
-	PaymentHistory(context.Context, *QueryPaymentHistoryRequest) (*QueryPaymentHistoryResponse, error)
-	// Queries all payment history records.
-	PaymentHistoryAll(context.Context, *QueryAllPaymentHistoryRequest) (*QueryAllPaymentHistoryResponse, error)
-	// Queries escrow record for a specific inquiry.
-	EscrowRecord(context.Context, *QueryEscrowRecordRequest) (*QueryEscrowRecordResponse, error)
-	// Queries all escrow records.
-	EscrowRecordAll(context.Context, *QueryAllEscrowRecordRequest) (*QueryAllEscrowRecordResponse, error)
-	// Queries provider stake for a specific address.
-	ProviderStake(context.Context, *QueryProviderStakeRequest) (*QueryProviderStakeResponse, error)
-	// Queries all provider stakes.
-	ProviderStakeAll(context.Context, *QueryAllProviderStakeRequest) (*QueryAllProviderStakeResponse, error)
+func (m *QueryAllSlashEventRequest) Reset()         { *m = QueryAllSlashEventRequest{} }
+func (m *QueryAllSlashEventRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSlashEventRequest) ProtoMessage()    {}
+func (*QueryAllSlashEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{39}
+}
+func (m *QueryAllSlashEventRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSlashEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSlashEventRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllSlashEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSlashEventRequest.Merge(m, src)
+}
+func (m *QueryAllSlashEventRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSlashEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSlashEventRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryAllSlashEventRequest proto.InternalMessageInfo
+
+func (m *QueryAllSlashEventRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+type QueryAllSlashEventResponse struct {
+	SlashEvent []SlashEvent        `protobuf:"bytes,1,rep,name=SlashEvent,proto3" json:"SlashEvent"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) FileEntry(ctx context.Context, req *QueryGetFileEntryRequest) (*QueryGetFileEntryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FileEntry not implemented")
+
+func (m *QueryAllSlashEventResponse) Reset()         { *m = QueryAllSlashEventResponse{} }
+func (m *QueryAllSlashEventResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSlashEventResponse) ProtoMessage()    {}
+func (*QueryAllSlashEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{40}
 }
-func (*UnimplementedQueryServer) FileEntryAll(ctx context.Context, req *QueryAllFileEntryRequest) (*QueryAllFileEntryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FileEntryAll not implemented")
+func (m *QueryAllSlashEventResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) HostingInquiry(ctx context.Context, req *QueryGetHostingInquiryRequest) (*QueryGetHostingInquiryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HostingInquiry not implemented")
+func (m *QueryAllSlashEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSlashEventResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) HostingInquiryAll(ctx context.Context, req *QueryAllHostingInquiryRequest) (*QueryAllHostingInquiryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HostingInquiryAll not implemented")
+func (m *QueryAllSlashEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSlashEventResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) HostingContract(ctx context.Context, req *QueryGetHostingContractRequest) (*QueryGetHostingContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HostingContract not implemented")
+func (m *QueryAllSlashEventResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) HostingContractAll(ctx context.Context, req *QueryAllHostingContractRequest) (*QueryAllHostingContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HostingContractAll not implemented")
+func (m *QueryAllSlashEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSlashEventResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) HostingOffer(ctx context.Context, req *QueryGetHostingOfferRequest) (*QueryGetHostingOfferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HostingOffer not implemented")
+
+var xxx_messageInfo_QueryAllSlashEventResponse proto.InternalMessageInfo
+
+func (m *QueryAllSlashEventResponse) GetSlashEvent() []SlashEvent {
+	if m != nil {
+		return m.SlashEvent
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) HostingOfferAll(ctx context.Context, req *QueryAllHostingOfferRequest) (*QueryAllHostingOfferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HostingOfferAll not implemented")
+
+func (m *QueryAllSlashEventResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) ListHostingContractFrom(ctx context.Context, req *QueryListHostingContractFromRequest) (*QueryListHostingContractFromResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListHostingContractFrom not implemented")
+
+// Provider Unbonding Queries
+type QueryProviderUnbondingsRequest struct {
+	Provider   string             `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) PaymentHistory(ctx context.Context, req *QueryPaymentHistoryRequest) (*QueryPaymentHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentHistory not implemented")
+
+func (m *QueryProviderUnbondingsRequest) Reset()         { *m = QueryProviderUnbondingsRequest{} }
+func (m *QueryProviderUnbondingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderUnbondingsRequest) ProtoMessage()    {}
+func (*QueryProviderUnbondingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{41}
 }
-func (*UnimplementedQueryServer) PaymentHistoryAll(ctx context.Context, req *QueryAllPaymentHistoryRequest) (*QueryAllPaymentHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentHistoryAll not implemented")
+func (m *QueryProviderUnbondingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) EscrowRecord(ctx context.Context, req *QueryEscrowRecordRequest) (*QueryEscrowRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EscrowRecord not implemented")
+func (m *QueryProviderUnbondingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderUnbondingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) EscrowRecordAll(ctx context.Context, req *QueryAllEscrowRecordRequest) (*QueryAllEscrowRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EscrowRecordAll not implemented")
+func (m *QueryProviderUnbondingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderUnbondingsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ProviderStake(ctx context.Context, req *QueryProviderStakeRequest) (*QueryProviderStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProviderStake not implemented")
+func (m *QueryProviderUnbondingsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ProviderStakeAll(ctx context.Context, req *QueryAllProviderStakeRequest) (*QueryAllProviderStakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProviderStakeAll not implemented")
+func (m *QueryProviderUnbondingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderUnbondingsRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryProviderUnbondingsRequest proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryProviderUnbondingsRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_FileEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetFileEntryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FileEntry(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Query/FileEntry",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FileEntry(ctx, req.(*QueryGetFileEntryRequest))
+func (m *QueryProviderUnbondingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_FileEntryAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllFileEntryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FileEntryAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Query/FileEntryAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FileEntryAll(ctx, req.(*QueryAllFileEntryRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryProviderUnbondingsResponse struct {
+	UnbondingEntries []UnbondingEntry    `protobuf:"bytes,1,rep,name=unbonding_entries,json=unbondingEntries,proto3" json:"unbonding_entries"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_HostingInquiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetHostingInquiryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HostingInquiry(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Query/HostingInquiry",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HostingInquiry(ctx, req.(*QueryGetHostingInquiryRequest))
+func (m *QueryProviderUnbondingsResponse) Reset()         { *m = QueryProviderUnbondingsResponse{} }
+func (m *QueryProviderUnbondingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderUnbondingsResponse) ProtoMessage()    {}
+func (*QueryProviderUnbondingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{42}
+}
+func (m *QueryProviderUnbondingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderUnbondingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderUnbondingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryProviderUnbondingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderUnbondingsResponse.Merge(m, src)
+}
+func (m *QueryProviderUnbondingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderUnbondingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderUnbondingsResponse.DiscardUnknown(m)
 }
 
-func _Query_HostingInquiryAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllHostingInquiryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProviderUnbondingsResponse proto.InternalMessageInfo
+
+func (m *QueryProviderUnbondingsResponse) GetUnbondingEntries() []UnbondingEntry {
+	if m != nil {
+		return m.UnbondingEntries
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).HostingInquiryAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Query/HostingInquiryAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HostingInquiryAll(ctx, req.(*QueryAllHostingInquiryRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_HostingContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetHostingContractRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HostingContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Query/HostingContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HostingContract(ctx, req.(*QueryGetHostingContractRequest))
+func (m *QueryProviderUnbondingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_HostingContractAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllHostingContractRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HostingContractAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Query/HostingContractAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HostingContractAll(ctx, req.(*QueryAllHostingContractRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// Open Inquiry Queries
+type QueryOpenInquiriesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_HostingOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetHostingOfferRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HostingOffer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Query/HostingOffer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HostingOffer(ctx, req.(*QueryGetHostingOfferRequest))
+func (m *QueryOpenInquiriesRequest) Reset()         { *m = QueryOpenInquiriesRequest{} }
+func (m *QueryOpenInquiriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOpenInquiriesRequest) ProtoMessage()    {}
+func (*QueryOpenInquiriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{43}
+}
+func (m *QueryOpenInquiriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOpenInquiriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOpenInquiriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryOpenInquiriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOpenInquiriesRequest.Merge(m, src)
+}
+func (m *QueryOpenInquiriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOpenInquiriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOpenInquiriesRequest.DiscardUnknown(m)
 }
 
-func _Query_HostingOfferAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllHostingOfferRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HostingOfferAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Query/HostingOfferAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HostingOfferAll(ctx, req.(*QueryAllHostingOfferRequest))
+var xxx_messageInfo_QueryOpenInquiriesRequest proto.InternalMessageInfo
+
+func (m *QueryOpenInquiriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ListHostingContractFrom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListHostingContractFromRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListHostingContractFrom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Query/ListHostingContractFrom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListHostingContractFrom(ctx, req.(*QueryListHostingContractFromRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryOpenInquiriesResponse struct {
+	HostingInquiry []HostingInquiry    `protobuf:"bytes,1,rep,name=HostingInquiry,proto3" json:"HostingInquiry"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_PaymentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaymentHistoryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PaymentHistory(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Query/PaymentHistory",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PaymentHistory(ctx, req.(*QueryPaymentHistoryRequest))
+func (m *QueryOpenInquiriesResponse) Reset()         { *m = QueryOpenInquiriesResponse{} }
+func (m *QueryOpenInquiriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOpenInquiriesResponse) ProtoMessage()    {}
+func (*QueryOpenInquiriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{44}
+}
+func (m *QueryOpenInquiriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOpenInquiriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOpenInquiriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryOpenInquiriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOpenInquiriesResponse.Merge(m, src)
+}
+func (m *QueryOpenInquiriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOpenInquiriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOpenInquiriesResponse.DiscardUnknown(m)
 }
 
-func _Query_PaymentHistoryAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPaymentHistoryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PaymentHistoryAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Query/PaymentHistoryAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PaymentHistoryAll(ctx, req.(*QueryAllPaymentHistoryRequest))
+var xxx_messageInfo_QueryOpenInquiriesResponse proto.InternalMessageInfo
+
+func (m *QueryOpenInquiriesResponse) GetHostingInquiry() []HostingInquiry {
+	if m != nil {
+		return m.HostingInquiry
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_EscrowRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEscrowRecordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EscrowRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Query/EscrowRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EscrowRecord(ctx, req.(*QueryEscrowRecordRequest))
+func (m *QueryOpenInquiriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_EscrowRecordAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllEscrowRecordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EscrowRecordAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Query/EscrowRecordAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EscrowRecordAll(ctx, req.(*QueryAllEscrowRecordRequest))
+// Auction Queries
+type QueryGetAuctionRequest struct {
+	InquiryId uint64 `protobuf:"varint,1,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+}
+
+func (m *QueryGetAuctionRequest) Reset()         { *m = QueryGetAuctionRequest{} }
+func (m *QueryGetAuctionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAuctionRequest) ProtoMessage()    {}
+func (*QueryGetAuctionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{45}
+}
+func (m *QueryGetAuctionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAuctionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAuctionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetAuctionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAuctionRequest.Merge(m, src)
+}
+func (m *QueryGetAuctionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAuctionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAuctionRequest.DiscardUnknown(m)
 }
 
-func _Query_ProviderStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProviderStakeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAuctionRequest proto.InternalMessageInfo
+
+func (m *QueryGetAuctionRequest) GetInquiryId() uint64 {
+	if m != nil {
+		return m.InquiryId
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ProviderStake(ctx, in)
+	return 0
+}
+
+type QueryGetAuctionResponse struct {
+	Auction Auction      `protobuf:"bytes,1,opt,name=Auction,proto3" json:"Auction"`
+	Bids    []AuctionBid `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids"`
+}
+
+func (m *QueryGetAuctionResponse) Reset()         { *m = QueryGetAuctionResponse{} }
+func (m *QueryGetAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAuctionResponse) ProtoMessage()    {}
+func (*QueryGetAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{46}
+}
+func (m *QueryGetAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Query/ProviderStake",
+}
+func (m *QueryGetAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAuctionResponse.Merge(m, src)
+}
+func (m *QueryGetAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAuctionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetAuctionResponse proto.InternalMessageInfo
+
+func (m *QueryGetAuctionResponse) GetAuction() Auction {
+	if m != nil {
+		return m.Auction
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProviderStake(ctx, req.(*QueryProviderStakeRequest))
+	return Auction{}
+}
+
+func (m *QueryGetAuctionResponse) GetBids() []AuctionBid {
+	if m != nil {
+		return m.Bids
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ProviderStakeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllProviderStakeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ProviderStakeAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Query/ProviderStakeAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProviderStakeAll(ctx, req.(*QueryAllProviderStakeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetProviderProfileRequest struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "filespacechain.filespacechain.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "FileEntry",
-			Handler:    _Query_FileEntry_Handler,
-		},
-		{
-			MethodName: "FileEntryAll",
-			Handler:    _Query_FileEntryAll_Handler,
-		},
-		{
-			MethodName: "HostingInquiry",
-			Handler:    _Query_HostingInquiry_Handler,
-		},
-		{
-			MethodName: "HostingInquiryAll",
-			Handler:    _Query_HostingInquiryAll_Handler,
-		},
-		{
-			MethodName: "HostingContract",
-			Handler:    _Query_HostingContract_Handler,
-		},
-		{
-			MethodName: "HostingContractAll",
-			Handler:    _Query_HostingContractAll_Handler,
-		},
-		{
-			MethodName: "HostingOffer",
-			Handler:    _Query_HostingOffer_Handler,
-		},
-		{
-			MethodName: "HostingOfferAll",
-			Handler:    _Query_HostingOfferAll_Handler,
-		},
-		{
-			MethodName: "ListHostingContractFrom",
-			Handler:    _Query_ListHostingContractFrom_Handler,
-		},
-		{
-			MethodName: "PaymentHistory",
-			Handler:    _Query_PaymentHistory_Handler,
-		},
-		{
-			MethodName: "PaymentHistoryAll",
-			Handler:    _Query_PaymentHistoryAll_Handler,
-		},
-		{
-			MethodName: "EscrowRecord",
-			Handler:    _Query_EscrowRecord_Handler,
-		},
-		{
-			MethodName: "EscrowRecordAll",
-			Handler:    _Query_EscrowRecordAll_Handler,
-		},
-		{
-			MethodName: "ProviderStake",
-			Handler:    _Query_ProviderStake_Handler,
-		},
-		{
-			MethodName: "ProviderStakeAll",
-			Handler:    _Query_ProviderStakeAll_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "filespacechain/filespacechain/query.proto",
+func (m *QueryGetProviderProfileRequest) Reset()         { *m = QueryGetProviderProfileRequest{} }
+func (m *QueryGetProviderProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProviderProfileRequest) ProtoMessage()    {}
+func (*QueryGetProviderProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{47}
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetProviderProfileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProviderProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProviderProfileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetProviderProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProviderProfileRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryGetProviderProfileRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProviderProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProviderProfileRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetProviderProfileRequest proto.InternalMessageInfo
+
+func (m *QueryGetProviderProfileRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetProviderProfileResponse struct {
+	ProviderProfile ProviderProfile `protobuf:"bytes,1,opt,name=ProviderProfile,proto3" json:"ProviderProfile"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetProviderProfileResponse) Reset()         { *m = QueryGetProviderProfileResponse{} }
+func (m *QueryGetProviderProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProviderProfileResponse) ProtoMessage()    {}
+func (*QueryGetProviderProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{48}
+}
+func (m *QueryGetProviderProfileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProviderProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProviderProfileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetProviderProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProviderProfileResponse.Merge(m, src)
+}
+func (m *QueryGetProviderProfileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProviderProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProviderProfileResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetFileEntryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetProviderProfileResponse proto.InternalMessageInfo
+
+func (m *QueryGetProviderProfileResponse) GetProviderProfile() ProviderProfile {
+	if m != nil {
+		return m.ProviderProfile
 	}
-	return dAtA[:n], nil
+	return ProviderProfile{}
 }
 
-func (m *QueryGetFileEntryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllProviderProfileRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetFileEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryAllProviderProfileRequest) Reset()         { *m = QueryAllProviderProfileRequest{} }
+func (m *QueryAllProviderProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllProviderProfileRequest) ProtoMessage()    {}
+func (*QueryAllProviderProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{49}
+}
+func (m *QueryAllProviderProfileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllProviderProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllProviderProfileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllProviderProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllProviderProfileRequest.Merge(m, src)
+}
+func (m *QueryAllProviderProfileRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllProviderProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllProviderProfileRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetFileEntryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllProviderProfileRequest proto.InternalMessageInfo
+
+func (m *QueryAllProviderProfileRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetFileEntryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllProviderProfileResponse struct {
+	ProviderProfile []ProviderProfile   `protobuf:"bytes,1,rep,name=ProviderProfile,proto3" json:"ProviderProfile"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetFileEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.FileEntry.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllProviderProfileResponse) Reset()         { *m = QueryAllProviderProfileResponse{} }
+func (m *QueryAllProviderProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllProviderProfileResponse) ProtoMessage()    {}
+func (*QueryAllProviderProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{50}
+}
+func (m *QueryAllProviderProfileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllProviderProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllProviderProfileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllProviderProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllProviderProfileResponse.Merge(m, src)
+}
+func (m *QueryAllProviderProfileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllProviderProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllProviderProfileResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllFileEntryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllProviderProfileResponse proto.InternalMessageInfo
+
+func (m *QueryAllProviderProfileResponse) GetProviderProfile() []ProviderProfile {
+	if m != nil {
+		return m.ProviderProfile
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllFileEntryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllProviderProfileResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllFileEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type QueryGetProviderReputationRequest struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
 }
 
-func (m *QueryAllFileEntryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetProviderReputationRequest) Reset()         { *m = QueryGetProviderReputationRequest{} }
+func (m *QueryGetProviderReputationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProviderReputationRequest) ProtoMessage()    {}
+func (*QueryGetProviderReputationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{51}
 }
-
-func (m *QueryAllFileEntryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetProviderReputationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryAllFileEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FileEntry) > 0 {
-		for iNdEx := len(m.FileEntry) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FileEntry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetProviderReputationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProviderReputationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetHostingInquiryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetProviderReputationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProviderReputationRequest.Merge(m, src)
 }
-
-func (m *QueryGetHostingInquiryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetProviderReputationRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetHostingInquiryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryGetProviderReputationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProviderReputationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetHostingInquiryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetProviderReputationRequest proto.InternalMessageInfo
+
+func (m *QueryGetProviderReputationRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetHostingInquiryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetProviderReputationResponse struct {
+	ProviderReputation ProviderReputation `protobuf:"bytes,1,opt,name=ProviderReputation,proto3" json:"ProviderReputation"`
 }
 
-func (m *QueryGetHostingInquiryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.HostingInquiry.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetProviderReputationResponse) Reset()         { *m = QueryGetProviderReputationResponse{} }
+func (m *QueryGetProviderReputationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProviderReputationResponse) ProtoMessage()    {}
+func (*QueryGetProviderReputationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{52}
+}
+func (m *QueryGetProviderReputationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProviderReputationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProviderReputationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetProviderReputationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProviderReputationResponse.Merge(m, src)
+}
+func (m *QueryGetProviderReputationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProviderReputationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProviderReputationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllHostingInquiryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetProviderReputationResponse proto.InternalMessageInfo
+
+func (m *QueryGetProviderReputationResponse) GetProviderReputation() ProviderReputation {
+	if m != nil {
+		return m.ProviderReputation
 	}
-	return dAtA[:n], nil
+	return ProviderReputation{}
 }
 
-func (m *QueryAllHostingInquiryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllProviderReputationRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllHostingInquiryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAllProviderReputationRequest) Reset()         { *m = QueryAllProviderReputationRequest{} }
+func (m *QueryAllProviderReputationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllProviderReputationRequest) ProtoMessage()    {}
+func (*QueryAllProviderReputationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{53}
+}
+func (m *QueryAllProviderReputationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllProviderReputationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllProviderReputationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllProviderReputationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllProviderReputationRequest.Merge(m, src)
+}
+func (m *QueryAllProviderReputationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllProviderReputationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllProviderReputationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllHostingInquiryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllProviderReputationRequest proto.InternalMessageInfo
+
+func (m *QueryAllProviderReputationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllHostingInquiryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllProviderReputationResponse struct {
+	ProviderReputation []ProviderReputation `protobuf:"bytes,1,rep,name=ProviderReputation,proto3" json:"ProviderReputation"`
+	Pagination         *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllHostingInquiryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAllProviderReputationResponse) Reset()         { *m = QueryAllProviderReputationResponse{} }
+func (m *QueryAllProviderReputationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllProviderReputationResponse) ProtoMessage()    {}
+func (*QueryAllProviderReputationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{54}
+}
+func (m *QueryAllProviderReputationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllProviderReputationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllProviderReputationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.HostingInquiry) > 0 {
-		for iNdEx := len(m.HostingInquiry) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HostingInquiry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryAllProviderReputationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllProviderReputationResponse.Merge(m, src)
+}
+func (m *QueryAllProviderReputationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllProviderReputationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllProviderReputationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllProviderReputationResponse proto.InternalMessageInfo
+
+func (m *QueryAllProviderReputationResponse) GetProviderReputation() []ProviderReputation {
+	if m != nil {
+		return m.ProviderReputation
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetHostingContractRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllProviderReputationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetHostingContractRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Analytics Queries
+type QuerySystemStatisticsRequest struct {
 }
 
-func (m *QueryGetHostingContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryGetHostingContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySystemStatisticsRequest) Reset()         { *m = QuerySystemStatisticsRequest{} }
+func (m *QuerySystemStatisticsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySystemStatisticsRequest) ProtoMessage()    {}
+func (*QuerySystemStatisticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{55}
 }
-
-func (m *QueryGetHostingContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySystemStatisticsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryGetHostingContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.HostingContract.MarshalToSizedBuffer(dAtA[:i])
+func (m *QuerySystemStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySystemStatisticsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllHostingContractRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySystemStatisticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySystemStatisticsRequest.Merge(m, src)
+}
+func (m *QuerySystemStatisticsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySystemStatisticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySystemStatisticsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllHostingContractRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySystemStatisticsRequest proto.InternalMessageInfo
+
+type QuerySystemStatisticsResponse struct {
+	TotalFileEntries      uint64 `protobuf:"varint,1,opt,name=totalFileEntries,proto3" json:"totalFileEntries,omitempty"`
+	TotalHostingInquiries uint64 `protobuf:"varint,2,opt,name=totalHostingInquiries,proto3" json:"totalHostingInquiries,omitempty"`
+	TotalHostingOffers    uint64 `protobuf:"varint,3,opt,name=totalHostingOffers,proto3" json:"totalHostingOffers,omitempty"`
+	TotalHostingContracts uint64 `protobuf:"varint,4,opt,name=totalHostingContracts,proto3" json:"totalHostingContracts,omitempty"`
+	TotalPaymentHistories uint64 `protobuf:"varint,5,opt,name=totalPaymentHistories,proto3" json:"totalPaymentHistories,omitempty"`
+	TotalEscrowRecords    uint64 `protobuf:"varint,6,opt,name=totalEscrowRecords,proto3" json:"totalEscrowRecords,omitempty"`
+	// Providers with a stake
+	TotalProviders uint64 `protobuf:"varint,7,opt,name=totalProviders,proto3" json:"totalProviders,omitempty"`
+	// ACTIVE contracts within their term
+	ActiveContracts uint64 `protobuf:"varint,8,opt,name=activeContracts,proto3" json:"activeContracts,omitempty"`
+	// Contracts past their end block
+	ExpiredContracts uint64 `protobuf:"varint,9,opt,name=expiredContracts,proto3" json:"expiredContracts,omitempty"`
 }
 
-func (m *QueryAllHostingContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySystemStatisticsResponse) Reset()         { *m = QuerySystemStatisticsResponse{} }
+func (m *QuerySystemStatisticsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySystemStatisticsResponse) ProtoMessage()    {}
+func (*QuerySystemStatisticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{56}
+}
+func (m *QuerySystemStatisticsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySystemStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySystemStatisticsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllHostingContractFromRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySystemStatisticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySystemStatisticsResponse.Merge(m, src)
 }
-
-func (m *QueryAllHostingContractFromRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySystemStatisticsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySystemStatisticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySystemStatisticsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllHostingContractFromRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QuerySystemStatisticsResponse proto.InternalMessageInfo
+
+func (m *QuerySystemStatisticsResponse) GetTotalFileEntries() uint64 {
+	if m != nil {
+		return m.TotalFileEntries
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAllHostingContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySystemStatisticsResponse) GetTotalHostingInquiries() uint64 {
+	if m != nil {
+		return m.TotalHostingInquiries
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllHostingContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySystemStatisticsResponse) GetTotalHostingOffers() uint64 {
+	if m != nil {
+		return m.TotalHostingOffers
+	}
+	return 0
 }
 
-func (m *QueryAllHostingContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QuerySystemStatisticsResponse) GetTotalHostingContracts() uint64 {
+	if m != nil {
+		return m.TotalHostingContracts
 	}
-	if len(m.HostingContract) > 0 {
-		for iNdEx := len(m.HostingContract) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HostingContract[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return 0
+}
+
+func (m *QuerySystemStatisticsResponse) GetTotalPaymentHistories() uint64 {
+	if m != nil {
+		return m.TotalPaymentHistories
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryGetHostingOfferRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySystemStatisticsResponse) GetTotalEscrowRecords() uint64 {
+	if m != nil {
+		return m.TotalEscrowRecords
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetHostingOfferRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySystemStatisticsResponse) GetTotalProviders() uint64 {
+	if m != nil {
+		return m.TotalProviders
+	}
+	return 0
 }
 
-func (m *QueryGetHostingOfferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QuerySystemStatisticsResponse) GetActiveContracts() uint64 {
+	if m != nil {
+		return m.ActiveContracts
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryGetHostingOfferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySystemStatisticsResponse) GetExpiredContracts() uint64 {
+	if m != nil {
+		return m.ExpiredContracts
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetHostingOfferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryContractDetailsRequest struct {
+	ContractId uint64 `protobuf:"varint,1,opt,name=contractId,proto3" json:"contractId,omitempty"`
 }
 
-func (m *QueryGetHostingOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.HostingOffer.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryContractDetailsRequest) Reset()         { *m = QueryContractDetailsRequest{} }
+func (m *QueryContractDetailsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractDetailsRequest) ProtoMessage()    {}
+func (*QueryContractDetailsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{57}
+}
+func (m *QueryContractDetailsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractDetailsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryContractDetailsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractDetailsRequest.Merge(m, src)
+}
+func (m *QueryContractDetailsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractDetailsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractDetailsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllHostingOfferRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryContractDetailsRequest proto.InternalMessageInfo
+
+func (m *QueryContractDetailsRequest) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllHostingOfferRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryContractDetailsResponse holds a contract and the records it refers
+// to; records that no longer exist are left unset.
+type QueryContractDetailsResponse struct {
+	Contract       HostingContract `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract"`
+	Inquiry        *HostingInquiry `protobuf:"bytes,2,opt,name=inquiry,proto3" json:"inquiry,omitempty"`
+	Offer          *HostingOffer   `protobuf:"bytes,3,opt,name=offer,proto3" json:"offer,omitempty"`
+	PaymentHistory *PaymentHistory `protobuf:"bytes,4,opt,name=paymentHistory,proto3" json:"paymentHistory,omitempty"`
+	EscrowRecord   *EscrowRecord   `protobuf:"bytes,5,opt,name=escrowRecord,proto3" json:"escrowRecord,omitempty"`
+	// Where the current block falls in the contract's term: pending, active or expired
+	Term string `protobuf:"bytes,6,opt,name=term,proto3" json:"term,omitempty"`
 }
 
-func (m *QueryAllHostingOfferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryContractDetailsResponse) Reset()         { *m = QueryContractDetailsResponse{} }
+func (m *QueryContractDetailsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractDetailsResponse) ProtoMessage()    {}
+func (*QueryContractDetailsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{58}
+}
+func (m *QueryContractDetailsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractDetailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractDetailsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryContractDetailsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractDetailsResponse.Merge(m, src)
+}
+func (m *QueryContractDetailsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractDetailsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractDetailsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllHostingOfferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryContractDetailsResponse proto.InternalMessageInfo
+
+func (m *QueryContractDetailsResponse) GetContract() HostingContract {
+	if m != nil {
+		return m.Contract
 	}
-	return dAtA[:n], nil
+	return HostingContract{}
 }
 
-func (m *QueryAllHostingOfferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryContractDetailsResponse) GetInquiry() *HostingInquiry {
+	if m != nil {
+		return m.Inquiry
+	}
+	return nil
 }
 
-func (m *QueryAllHostingOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QueryContractDetailsResponse) GetOffer() *HostingOffer {
+	if m != nil {
+		return m.Offer
 	}
-	if len(m.HostingOffer) > 0 {
-		for iNdEx := len(m.HostingOffer) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HostingOffer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return nil
+}
+
+func (m *QueryContractDetailsResponse) GetPaymentHistory() *PaymentHistory {
+	if m != nil {
+		return m.PaymentHistory
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryListHostingContractFromRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryContractDetailsResponse) GetEscrowRecord() *EscrowRecord {
+	if m != nil {
+		return m.EscrowRecord
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryListHostingContractFromRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryContractDetailsResponse) GetTerm() string {
+	if m != nil {
+		return m.Term
+	}
+	return ""
 }
 
-func (m *QueryListHostingContractFromRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type QueryProviderPerformanceRequest struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+}
+
+func (m *QueryProviderPerformanceRequest) Reset()         { *m = QueryProviderPerformanceRequest{} }
+func (m *QueryProviderPerformanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderPerformanceRequest) ProtoMessage()    {}
+func (*QueryProviderPerformanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{59}
+}
+func (m *QueryProviderPerformanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderPerformanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProviderPerformanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderPerformanceRequest.Merge(m, src)
+}
+func (m *QueryProviderPerformanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderPerformanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderPerformanceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryListHostingContractFromResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProviderPerformanceRequest proto.InternalMessageInfo
+
+func (m *QueryProviderPerformanceRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryListHostingContractFromResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryProviderPerformanceResponse struct {
+	// Unset when the provider has no stake
+	Stake          *ProviderStake `protobuf:"bytes,1,opt,name=stake,proto3" json:"stake,omitempty"`
+	TotalContracts uint64         `protobuf:"varint,2,opt,name=totalContracts,proto3" json:"totalContracts,omitempty"`
+	// ACTIVE contracts whose end block has not been reached
+	ActiveContracts uint64 `protobuf:"varint,3,opt,name=activeContracts,proto3" json:"activeContracts,omitempty"`
+	// COMPLETED contracts, and ACTIVE contracts whose end block has been
+	// reached; PENDING, TERMINATED and SLASHED contracts only count in total
+	CompletedContracts uint64 `protobuf:"varint,4,opt,name=completedContracts,proto3" json:"completedContracts,omitempty"`
+	TotalOffers        uint64 `protobuf:"varint,6,opt,name=totalOffers,proto3" json:"totalOffers,omitempty"`
+	// Reputation score in basis points, decayed to the current block
+	ReputationScore uint64 `protobuf:"varint,7,opt,name=reputationScore,proto3" json:"reputationScore,omitempty"`
+	// Payments released to the provider, per denom
+	TotalEarnings github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=totalEarnings,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"totalEarnings"`
+}
+
+func (m *QueryProviderPerformanceResponse) Reset()         { *m = QueryProviderPerformanceResponse{} }
+func (m *QueryProviderPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderPerformanceResponse) ProtoMessage()    {}
+func (*QueryProviderPerformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{60}
+}
+func (m *QueryProviderPerformanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryListHostingContractFromResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.HostingContract) > 0 {
-		for iNdEx := len(m.HostingContract) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HostingContract[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryProviderPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderPerformanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProviderPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderPerformanceResponse.Merge(m, src)
+}
+func (m *QueryProviderPerformanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderPerformanceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPaymentHistoryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProviderPerformanceResponse proto.InternalMessageInfo
+
+func (m *QueryProviderPerformanceResponse) GetStake() *ProviderStake {
+	if m != nil {
+		return m.Stake
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPaymentHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProviderPerformanceResponse) GetTotalContracts() uint64 {
+	if m != nil {
+		return m.TotalContracts
+	}
+	return 0
 }
 
-func (m *QueryPaymentHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ContractId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryProviderPerformanceResponse) GetActiveContracts() uint64 {
+	if m != nil {
+		return m.ActiveContracts
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryPaymentHistoryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProviderPerformanceResponse) GetCompletedContracts() uint64 {
+	if m != nil {
+		return m.CompletedContracts
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryPaymentHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProviderPerformanceResponse) GetTotalOffers() uint64 {
+	if m != nil {
+		return m.TotalOffers
+	}
+	return 0
 }
 
-func (m *QueryPaymentHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PaymentHistory.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryProviderPerformanceResponse) GetReputationScore() uint64 {
+	if m != nil {
+		return m.ReputationScore
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAllPaymentHistoryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProviderPerformanceResponse) GetTotalEarnings() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalEarnings
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllPaymentHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPaymentAnalyticsRequest struct {
 }
 
-func (m *QueryAllPaymentHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPaymentAnalyticsRequest) Reset()         { *m = QueryPaymentAnalyticsRequest{} }
+func (m *QueryPaymentAnalyticsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentAnalyticsRequest) ProtoMessage()    {}
+func (*QueryPaymentAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{61}
+}
+func (m *QueryPaymentAnalyticsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentAnalyticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentAnalyticsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPaymentAnalyticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentAnalyticsRequest.Merge(m, src)
+}
+func (m *QueryPaymentAnalyticsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentAnalyticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentAnalyticsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllPaymentHistoryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPaymentAnalyticsRequest proto.InternalMessageInfo
+
+// DenomPaymentCount is the number of payment histories paid in a denom
+type DenomPaymentCount struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *DenomPaymentCount) Reset()         { *m = DenomPaymentCount{} }
+func (m *DenomPaymentCount) String() string { return proto.CompactTextString(m) }
+func (*DenomPaymentCount) ProtoMessage()    {}
+func (*DenomPaymentCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{62}
+}
+func (m *DenomPaymentCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPaymentCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPaymentCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *DenomPaymentCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPaymentCount.Merge(m, src)
+}
+func (m *DenomPaymentCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPaymentCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPaymentCount.DiscardUnknown(m)
 }
 
-func (m *QueryAllPaymentHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_DenomPaymentCount proto.InternalMessageInfo
+
+func (m *DenomPaymentCount) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *QueryAllPaymentHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *DenomPaymentCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	if len(m.PaymentHistory) > 0 {
-		for iNdEx := len(m.PaymentHistory) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PaymentHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	return 0
+}
+
+type QueryPaymentAnalyticsResponse struct {
+	TotalPaymentRecords uint64                                   `protobuf:"varint,1,opt,name=totalPaymentRecords,proto3" json:"totalPaymentRecords,omitempty"`
+	TotalPaid           github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=totalPaid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"totalPaid"`
+	PaymentCounts       []DenomPaymentCount                      `protobuf:"bytes,3,rep,name=paymentCounts,proto3" json:"paymentCounts"`
+	// Payment histories whose completion bonus has been paid
+	CompletedPayments uint64 `protobuf:"varint,4,opt,name=completedPayments,proto3" json:"completedPayments,omitempty"`
+	PendingPayments   uint64 `protobuf:"varint,5,opt,name=pendingPayments,proto3" json:"pendingPayments,omitempty"`
+}
+
+func (m *QueryPaymentAnalyticsResponse) Reset()         { *m = QueryPaymentAnalyticsResponse{} }
+func (m *QueryPaymentAnalyticsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentAnalyticsResponse) ProtoMessage()    {}
+func (*QueryPaymentAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{63}
+}
+func (m *QueryPaymentAnalyticsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentAnalyticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentAnalyticsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPaymentAnalyticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentAnalyticsResponse.Merge(m, src)
+}
+func (m *QueryPaymentAnalyticsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentAnalyticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentAnalyticsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryEscrowRecordRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPaymentAnalyticsResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentAnalyticsResponse) GetTotalPaymentRecords() uint64 {
+	if m != nil {
+		return m.TotalPaymentRecords
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryEscrowRecordRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPaymentAnalyticsResponse) GetTotalPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalPaid
+	}
+	return nil
 }
 
-func (m *QueryEscrowRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InquiryId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.InquiryId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryPaymentAnalyticsResponse) GetPaymentCounts() []DenomPaymentCount {
+	if m != nil {
+		return m.PaymentCounts
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryEscrowRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPaymentAnalyticsResponse) GetCompletedPayments() uint64 {
+	if m != nil {
+		return m.CompletedPayments
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryEscrowRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPaymentAnalyticsResponse) GetPendingPayments() uint64 {
+	if m != nil {
+		return m.PendingPayments
+	}
+	return 0
 }
 
-func (m *QueryEscrowRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.EscrowRecord.MarshalToSizedBuffer(dAtA[:i])
+type QueryPaymentDistributionRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryPaymentDistributionRequest) Reset()         { *m = QueryPaymentDistributionRequest{} }
+func (m *QueryPaymentDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentDistributionRequest) ProtoMessage()    {}
+func (*QueryPaymentDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{64}
+}
+func (m *QueryPaymentDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPaymentDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentDistributionRequest.Merge(m, src)
+}
+func (m *QueryPaymentDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentDistributionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllEscrowRecordRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPaymentDistributionRequest proto.InternalMessageInfo
+
+func (m *QueryPaymentDistributionRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllEscrowRecordRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPaymentDistributionResponse describes the amounts paid per contract
+// in the requested denom. All amounts are zero without payment histories in
+// that denom.
+type QueryPaymentDistributionResponse struct {
+	Count   uint64                `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Total   cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total,proto3,customtype=cosmossdk.io/math.Int" json:"total"`
+	Average cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=average,proto3,customtype=cosmossdk.io/math.Int" json:"average"`
+	Min     cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=min,proto3,customtype=cosmossdk.io/math.Int" json:"min"`
+	Max     cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=max,proto3,customtype=cosmossdk.io/math.Int" json:"max"`
+	// Percentiles are only set from four payment histories on
+	Percentile25 cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=percentile25,proto3,customtype=cosmossdk.io/math.Int" json:"percentile25"`
+	Percentile50 cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=percentile50,proto3,customtype=cosmossdk.io/math.Int" json:"percentile50"`
+	Percentile75 cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=percentile75,proto3,customtype=cosmossdk.io/math.Int" json:"percentile75"`
+}
+
+func (m *QueryPaymentDistributionResponse) Reset()         { *m = QueryPaymentDistributionResponse{} }
+func (m *QueryPaymentDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentDistributionResponse) ProtoMessage()    {}
+func (*QueryPaymentDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{65}
+}
+func (m *QueryPaymentDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryAllEscrowRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPaymentDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPaymentDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentDistributionResponse.Merge(m, src)
+}
+func (m *QueryPaymentDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentDistributionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllEscrowRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPaymentDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentDistributionResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllEscrowRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ProviderPaymentSummary is what a provider received over its contracts
+type ProviderPaymentSummary struct {
+	Provider           string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	PaymentCount       uint64 `protobuf:"varint,2,opt,name=paymentCount,proto3" json:"paymentCount,omitempty"`
+	CompletedContracts uint64 `protobuf:"varint,4,opt,name=completedContracts,proto3" json:"completedContracts,omitempty"`
+	PendingContracts   uint64 `protobuf:"varint,5,opt,name=pendingContracts,proto3" json:"pendingContracts,omitempty"`
+	// Payments released to the provider, per denom
+	TotalEarned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=totalEarned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"totalEarned"`
 }
 
-func (m *QueryAllEscrowRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *ProviderPaymentSummary) Reset()         { *m = ProviderPaymentSummary{} }
+func (m *ProviderPaymentSummary) String() string { return proto.CompactTextString(m) }
+func (*ProviderPaymentSummary) ProtoMessage()    {}
+func (*ProviderPaymentSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{66}
+}
+func (m *ProviderPaymentSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderPaymentSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderPaymentSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.EscrowRecord) > 0 {
-		for iNdEx := len(m.EscrowRecord) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EscrowRecord[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *ProviderPaymentSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderPaymentSummary.Merge(m, src)
+}
+func (m *ProviderPaymentSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderPaymentSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderPaymentSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderPaymentSummary proto.InternalMessageInfo
+
+func (m *ProviderPaymentSummary) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryProviderStakeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProviderPaymentSummary) GetPaymentCount() uint64 {
+	if m != nil {
+		return m.PaymentCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryProviderStakeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProviderPaymentSummary) GetCompletedContracts() uint64 {
+	if m != nil {
+		return m.CompletedContracts
+	}
+	return 0
 }
 
-func (m *QueryProviderStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0xa
+func (m *ProviderPaymentSummary) GetPendingContracts() uint64 {
+	if m != nil {
+		return m.PendingContracts
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryProviderStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProviderPaymentSummary) GetTotalEarned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalEarned
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryProviderStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryProviderPaymentSummaryRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryProviderStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ProviderStake.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryProviderPaymentSummaryRequest) Reset()         { *m = QueryProviderPaymentSummaryRequest{} }
+func (m *QueryProviderPaymentSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderPaymentSummaryRequest) ProtoMessage()    {}
+func (*QueryProviderPaymentSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{67}
+}
+func (m *QueryProviderPaymentSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderPaymentSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderPaymentSummaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryProviderPaymentSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderPaymentSummaryRequest.Merge(m, src)
+}
+func (m *QueryProviderPaymentSummaryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderPaymentSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderPaymentSummaryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllProviderStakeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProviderPaymentSummaryRequest proto.InternalMessageInfo
+
+func (m *QueryProviderPaymentSummaryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllProviderStakeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryProviderPaymentSummaryResponse struct {
+	Summaries  []ProviderPaymentSummary `protobuf:"bytes,1,rep,name=summaries,proto3" json:"summaries"`
+	Pagination *query.PageResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllProviderStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryProviderPaymentSummaryResponse) Reset()         { *m = QueryProviderPaymentSummaryResponse{} }
+func (m *QueryProviderPaymentSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderPaymentSummaryResponse) ProtoMessage()    {}
+func (*QueryProviderPaymentSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{68}
+}
+func (m *QueryProviderPaymentSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderPaymentSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderPaymentSummaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProviderPaymentSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderPaymentSummaryResponse.Merge(m, src)
+}
+func (m *QueryProviderPaymentSummaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderPaymentSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderPaymentSummaryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllProviderStakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProviderPaymentSummaryResponse proto.InternalMessageInfo
+
+func (m *QueryProviderPaymentSummaryResponse) GetSummaries() []ProviderPaymentSummary {
+	if m != nil {
+		return m.Summaries
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllProviderStakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProviderPaymentSummaryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllProviderStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProviderStake) > 0 {
-		for iNdEx := len(m.ProviderStake) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ProviderStake[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+type QueryPaymentTrendsRequest struct {
+	BlockWindow uint64 `protobuf:"varint,1,opt,name=blockWindow,proto3" json:"blockWindow,omitempty"`
+	// Pages through windows; the key is the start block of the next window
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPaymentTrendsRequest) Reset()         { *m = QueryPaymentTrendsRequest{} }
+func (m *QueryPaymentTrendsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentTrendsRequest) ProtoMessage()    {}
+func (*QueryPaymentTrendsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{69}
+}
+func (m *QueryPaymentTrendsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentTrendsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentTrendsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPaymentTrendsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentTrendsRequest.Merge(m, src)
+}
+func (m *QueryPaymentTrendsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentTrendsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentTrendsRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryPaymentTrendsRequest proto.InternalMessageInfo
+
+func (m *QueryPaymentTrendsRequest) GetBlockWindow() uint64 {
+	if m != nil {
+		return m.BlockWindow
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryPaymentTrendsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// PaymentWindow sums the provider payments released within a window of blocks
+type PaymentWindow struct {
+	StartBlock   uint64 `protobuf:"varint,1,opt,name=startBlock,proto3" json:"startBlock,omitempty"`
+	EndBlock     uint64 `protobuf:"varint,2,opt,name=endBlock,proto3" json:"endBlock,omitempty"`
+	PaymentCount uint64 `protobuf:"varint,3,opt,name=paymentCount,proto3" json:"paymentCount,omitempty"`
+	// Amounts paid, per denom
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
 }
 
-func (m *QueryGetFileEntryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
-	}
-	return n
+func (m *PaymentWindow) Reset()         { *m = PaymentWindow{} }
+func (m *PaymentWindow) String() string { return proto.CompactTextString(m) }
+func (*PaymentWindow) ProtoMessage()    {}
+func (*PaymentWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{70}
 }
-
-func (m *QueryGetFileEntryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PaymentWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymentWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymentWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.FileEntry.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *PaymentWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentWindow.Merge(m, src)
+}
+func (m *PaymentWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymentWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentWindow.DiscardUnknown(m)
 }
 
-func (m *QueryAllFileEntryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_PaymentWindow proto.InternalMessageInfo
+
+func (m *PaymentWindow) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
 	}
-	return n
+	return 0
 }
 
-func (m *QueryAllFileEntryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.FileEntry) > 0 {
-		for _, e := range m.FileEntry {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *PaymentWindow) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
 	}
-	return n
+	return 0
 }
 
-func (m *QueryGetHostingInquiryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+func (m *PaymentWindow) GetPaymentCount() uint64 {
+	if m != nil {
+		return m.PaymentCount
 	}
-	return n
+	return 0
 }
 
-func (m *QueryGetHostingInquiryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PaymentWindow) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
 	}
-	var l int
-	_ = l
-	l = m.HostingInquiry.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllHostingInquiryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryPaymentTrendsResponse struct {
+	BlockWindow  uint64 `protobuf:"varint,1,opt,name=blockWindow,proto3" json:"blockWindow,omitempty"`
+	CurrentBlock uint64 `protobuf:"varint,2,opt,name=currentBlock,proto3" json:"currentBlock,omitempty"`
+	// Windows with payments, oldest first
+	Windows    []PaymentWindow     `protobuf:"bytes,3,rep,name=windows,proto3" json:"windows"`
+	Pagination *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllHostingInquiryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.HostingInquiry) > 0 {
-		for _, e := range m.HostingInquiry {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPaymentTrendsResponse) Reset()         { *m = QueryPaymentTrendsResponse{} }
+func (m *QueryPaymentTrendsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentTrendsResponse) ProtoMessage()    {}
+func (*QueryPaymentTrendsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{71}
+}
+func (m *QueryPaymentTrendsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentTrendsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentTrendsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryPaymentTrendsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentTrendsResponse.Merge(m, src)
+}
+func (m *QueryPaymentTrendsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentTrendsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentTrendsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetHostingContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+var xxx_messageInfo_QueryPaymentTrendsResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentTrendsResponse) GetBlockWindow() uint64 {
+	if m != nil {
+		return m.BlockWindow
 	}
-	return n
+	return 0
 }
 
-func (m *QueryGetHostingContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPaymentTrendsResponse) GetCurrentBlock() uint64 {
+	if m != nil {
+		return m.CurrentBlock
 	}
-	var l int
-	_ = l
-	l = m.HostingContract.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryAllHostingContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPaymentTrendsResponse) GetWindows() []PaymentWindow {
+	if m != nil {
+		return m.Windows
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAllHostingContractFromRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPaymentTrendsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAllHostingContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.HostingContract) > 0 {
-		for _, e := range m.HostingContract {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+type QueryUnpaidContractsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUnpaidContractsRequest) Reset()         { *m = QueryUnpaidContractsRequest{} }
+func (m *QueryUnpaidContractsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnpaidContractsRequest) ProtoMessage()    {}
+func (*QueryUnpaidContractsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{72}
+}
+func (m *QueryUnpaidContractsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnpaidContractsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnpaidContractsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryUnpaidContractsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnpaidContractsRequest.Merge(m, src)
+}
+func (m *QueryUnpaidContractsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnpaidContractsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnpaidContractsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetHostingOfferRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+var xxx_messageInfo_QueryUnpaidContractsRequest proto.InternalMessageInfo
+
+func (m *QueryUnpaidContractsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetHostingOfferResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.HostingOffer.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+type QueryUnpaidContractsResponse struct {
+	HostingContract []HostingContract   `protobuf:"bytes,1,rep,name=HostingContract,proto3" json:"HostingContract"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllHostingOfferRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryUnpaidContractsResponse) Reset()         { *m = QueryUnpaidContractsResponse{} }
+func (m *QueryUnpaidContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnpaidContractsResponse) ProtoMessage()    {}
+func (*QueryUnpaidContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{73}
+}
+func (m *QueryUnpaidContractsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnpaidContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnpaidContractsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryUnpaidContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnpaidContractsResponse.Merge(m, src)
+}
+func (m *QueryUnpaidContractsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnpaidContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnpaidContractsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllHostingOfferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryUnpaidContractsResponse proto.InternalMessageInfo
+
+func (m *QueryUnpaidContractsResponse) GetHostingContract() []HostingContract {
+	if m != nil {
+		return m.HostingContract
 	}
-	var l int
-	_ = l
-	if len(m.HostingOffer) > 0 {
-		for _, e := range m.HostingOffer {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return nil
 }
 
-func (m *QueryListHostingContractFromRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryUnpaidContractsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryProviderPaymentsRequest struct {
+	Provider   string             `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProviderPaymentsRequest) Reset()         { *m = QueryProviderPaymentsRequest{} }
+func (m *QueryProviderPaymentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderPaymentsRequest) ProtoMessage()    {}
+func (*QueryProviderPaymentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{74}
+}
+func (m *QueryProviderPaymentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderPaymentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryProviderPaymentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderPaymentsRequest.Merge(m, src)
+}
+func (m *QueryProviderPaymentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderPaymentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderPaymentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderPaymentsRequest proto.InternalMessageInfo
+
+func (m *QueryProviderPaymentsRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	return n
+	return ""
 }
 
-func (m *QueryListHostingContractFromResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProviderPaymentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.HostingContract) > 0 {
-		for _, e := range m.HostingContract {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryProviderPaymentsResponse struct {
+	ProviderPayments []ProviderPayment   `protobuf:"bytes,1,rep,name=providerPayments,proto3" json:"providerPayments"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProviderPaymentsResponse) Reset()         { *m = QueryProviderPaymentsResponse{} }
+func (m *QueryProviderPaymentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderPaymentsResponse) ProtoMessage()    {}
+func (*QueryProviderPaymentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{75}
+}
+func (m *QueryProviderPaymentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderPaymentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryProviderPaymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderPaymentsResponse.Merge(m, src)
+}
+func (m *QueryProviderPaymentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderPaymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderPaymentsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPaymentHistoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ContractId != 0 {
-		n += 1 + sovQuery(uint64(m.ContractId))
+var xxx_messageInfo_QueryProviderPaymentsResponse proto.InternalMessageInfo
+
+func (m *QueryProviderPaymentsResponse) GetProviderPayments() []ProviderPayment {
+	if m != nil {
+		return m.ProviderPayments
 	}
-	return n
+	return nil
 }
 
-func (m *QueryPaymentHistoryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProviderPaymentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.PaymentHistory.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllPaymentHistoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryContractProviderPaymentsRequest struct {
+	ContractId uint64             `protobuf:"varint,1,opt,name=contractId,proto3" json:"contractId,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContractProviderPaymentsRequest) Reset()         { *m = QueryContractProviderPaymentsRequest{} }
+func (m *QueryContractProviderPaymentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractProviderPaymentsRequest) ProtoMessage()    {}
+func (*QueryContractProviderPaymentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{76}
+}
+func (m *QueryContractProviderPaymentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractProviderPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractProviderPaymentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryContractProviderPaymentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractProviderPaymentsRequest.Merge(m, src)
+}
+func (m *QueryContractProviderPaymentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractProviderPaymentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractProviderPaymentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractProviderPaymentsRequest proto.InternalMessageInfo
+
+func (m *QueryContractProviderPaymentsRequest) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return n
+	return 0
 }
 
-func (m *QueryAllPaymentHistoryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryContractProviderPaymentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.PaymentHistory) > 0 {
-		for _, e := range m.PaymentHistory {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryContractProviderPaymentsResponse struct {
+	ProviderPayments []ProviderPayment   `protobuf:"bytes,1,rep,name=providerPayments,proto3" json:"providerPayments"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContractProviderPaymentsResponse) Reset()         { *m = QueryContractProviderPaymentsResponse{} }
+func (m *QueryContractProviderPaymentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractProviderPaymentsResponse) ProtoMessage()    {}
+func (*QueryContractProviderPaymentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{77}
+}
+func (m *QueryContractProviderPaymentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractProviderPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractProviderPaymentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryContractProviderPaymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractProviderPaymentsResponse.Merge(m, src)
+}
+func (m *QueryContractProviderPaymentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractProviderPaymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractProviderPaymentsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryEscrowRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryContractProviderPaymentsResponse proto.InternalMessageInfo
+
+func (m *QueryContractProviderPaymentsResponse) GetProviderPayments() []ProviderPayment {
+	if m != nil {
+		return m.ProviderPayments
 	}
-	var l int
-	_ = l
-	if m.InquiryId != 0 {
-		n += 1 + sovQuery(uint64(m.InquiryId))
+	return nil
+}
+
+func (m *QueryContractProviderPaymentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryEscrowRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryProviderPaymentsByHeightRequest selects the payments made from
+// startHeight to endHeight, both inclusive
+type QueryProviderPaymentsByHeightRequest struct {
+	StartHeight uint64             `protobuf:"varint,1,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	EndHeight   uint64             `protobuf:"varint,2,opt,name=endHeight,proto3" json:"endHeight,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProviderPaymentsByHeightRequest) Reset()         { *m = QueryProviderPaymentsByHeightRequest{} }
+func (m *QueryProviderPaymentsByHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderPaymentsByHeightRequest) ProtoMessage()    {}
+func (*QueryProviderPaymentsByHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{78}
+}
+func (m *QueryProviderPaymentsByHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderPaymentsByHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderPaymentsByHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.EscrowRecord.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryProviderPaymentsByHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderPaymentsByHeightRequest.Merge(m, src)
+}
+func (m *QueryProviderPaymentsByHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderPaymentsByHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderPaymentsByHeightRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllEscrowRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryProviderPaymentsByHeightRequest proto.InternalMessageInfo
+
+func (m *QueryProviderPaymentsByHeightRequest) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *QueryProviderPaymentsByHeightRequest) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	return n
+	return 0
 }
 
-func (m *QueryAllEscrowRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProviderPaymentsByHeightRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.EscrowRecord) > 0 {
-		for _, e := range m.EscrowRecord {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryProviderPaymentsByHeightResponse struct {
+	ProviderPayments []ProviderPayment   `protobuf:"bytes,1,rep,name=providerPayments,proto3" json:"providerPayments"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProviderPaymentsByHeightResponse) Reset()         { *m = QueryProviderPaymentsByHeightResponse{} }
+func (m *QueryProviderPaymentsByHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderPaymentsByHeightResponse) ProtoMessage()    {}
+func (*QueryProviderPaymentsByHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bd8b9ca09eda9eb, []int{79}
+}
+func (m *QueryProviderPaymentsByHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderPaymentsByHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderPaymentsByHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderPaymentsByHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderPaymentsByHeightResponse.Merge(m, src)
+}
+func (m *QueryProviderPaymentsByHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderPaymentsByHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderPaymentsByHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderPaymentsByHeightResponse proto.InternalMessageInfo
+
+func (m *QueryProviderPaymentsByHeightResponse) GetProviderPayments() []ProviderPayment {
+	if m != nil {
+		return m.ProviderPayments
+	}
+	return nil
+}
+
+func (m *QueryProviderPaymentsByHeightResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "filespacechain.filespacechain.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "filespacechain.filespacechain.QueryParamsResponse")
+	proto.RegisterType((*QueryGetFileEntryRequest)(nil), "filespacechain.filespacechain.QueryGetFileEntryRequest")
+	proto.RegisterType((*QueryGetFileEntryResponse)(nil), "filespacechain.filespacechain.QueryGetFileEntryResponse")
+	proto.RegisterType((*QueryAllFileEntryRequest)(nil), "filespacechain.filespacechain.QueryAllFileEntryRequest")
+	proto.RegisterType((*QueryAllFileEntryResponse)(nil), "filespacechain.filespacechain.QueryAllFileEntryResponse")
+	proto.RegisterType((*QueryGetHostingInquiryRequest)(nil), "filespacechain.filespacechain.QueryGetHostingInquiryRequest")
+	proto.RegisterType((*QueryGetHostingInquiryResponse)(nil), "filespacechain.filespacechain.QueryGetHostingInquiryResponse")
+	proto.RegisterType((*QueryAllHostingInquiryRequest)(nil), "filespacechain.filespacechain.QueryAllHostingInquiryRequest")
+	proto.RegisterType((*QueryAllHostingInquiryResponse)(nil), "filespacechain.filespacechain.QueryAllHostingInquiryResponse")
+	proto.RegisterType((*QueryGetHostingContractRequest)(nil), "filespacechain.filespacechain.QueryGetHostingContractRequest")
+	proto.RegisterType((*QueryGetHostingContractResponse)(nil), "filespacechain.filespacechain.QueryGetHostingContractResponse")
+	proto.RegisterType((*QueryAllHostingContractRequest)(nil), "filespacechain.filespacechain.QueryAllHostingContractRequest")
+	proto.RegisterType((*QueryAllHostingContractFromRequest)(nil), "filespacechain.filespacechain.QueryAllHostingContractFromRequest")
+	proto.RegisterType((*QueryAllHostingContractResponse)(nil), "filespacechain.filespacechain.QueryAllHostingContractResponse")
+	proto.RegisterType((*QueryGetHostingOfferRequest)(nil), "filespacechain.filespacechain.QueryGetHostingOfferRequest")
+	proto.RegisterType((*QueryGetHostingOfferResponse)(nil), "filespacechain.filespacechain.QueryGetHostingOfferResponse")
+	proto.RegisterType((*QueryAllHostingOfferRequest)(nil), "filespacechain.filespacechain.QueryAllHostingOfferRequest")
+	proto.RegisterType((*QueryAllHostingOfferResponse)(nil), "filespacechain.filespacechain.QueryAllHostingOfferResponse")
+	proto.RegisterType((*QueryListHostingContractFromRequest)(nil), "filespacechain.filespacechain.QueryListHostingContractFromRequest")
+	proto.RegisterType((*QueryListHostingContractFromResponse)(nil), "filespacechain.filespacechain.QueryListHostingContractFromResponse")
+	proto.RegisterType((*QueryPaymentHistoryRequest)(nil), "filespacechain.filespacechain.QueryPaymentHistoryRequest")
+	proto.RegisterType((*QueryPaymentHistoryResponse)(nil), "filespacechain.filespacechain.QueryPaymentHistoryResponse")
+	proto.RegisterType((*QueryAllPaymentHistoryRequest)(nil), "filespacechain.filespacechain.QueryAllPaymentHistoryRequest")
+	proto.RegisterType((*QueryAllPaymentHistoryResponse)(nil), "filespacechain.filespacechain.QueryAllPaymentHistoryResponse")
+	proto.RegisterType((*QueryEscrowRecordRequest)(nil), "filespacechain.filespacechain.QueryEscrowRecordRequest")
+	proto.RegisterType((*QueryEscrowRecordResponse)(nil), "filespacechain.filespacechain.QueryEscrowRecordResponse")
+	proto.RegisterType((*QueryAllEscrowRecordRequest)(nil), "filespacechain.filespacechain.QueryAllEscrowRecordRequest")
+	proto.RegisterType((*QueryAllEscrowRecordResponse)(nil), "filespacechain.filespacechain.QueryAllEscrowRecordResponse")
+	proto.RegisterType((*QueryProviderStakeRequest)(nil), "filespacechain.filespacechain.QueryProviderStakeRequest")
+	proto.RegisterType((*QueryProviderStakeResponse)(nil), "filespacechain.filespacechain.QueryProviderStakeResponse")
+	proto.RegisterType((*QueryAllProviderStakeRequest)(nil), "filespacechain.filespacechain.QueryAllProviderStakeRequest")
+	proto.RegisterType((*QueryAllProviderStakeResponse)(nil), "filespacechain.filespacechain.QueryAllProviderStakeResponse")
+	proto.RegisterType((*QueryGetStorageChallengeRequest)(nil), "filespacechain.filespacechain.QueryGetStorageChallengeRequest")
+	proto.RegisterType((*QueryGetStorageChallengeResponse)(nil), "filespacechain.filespacechain.QueryGetStorageChallengeResponse")
+	proto.RegisterType((*QueryAllStorageChallengeRequest)(nil), "filespacechain.filespacechain.QueryAllStorageChallengeRequest")
+	proto.RegisterType((*QueryAllStorageChallengeResponse)(nil), "filespacechain.filespacechain.QueryAllStorageChallengeResponse")
+	proto.RegisterType((*QueryGetSlashEventRequest)(nil), "filespacechain.filespacechain.QueryGetSlashEventRequest")
+	proto.RegisterType((*QueryGetSlashEventResponse)(nil), "filespacechain.filespacechain.QueryGetSlashEventResponse")
+	proto.RegisterType((*QueryAllSlashEventRequest)(nil), "filespacechain.filespacechain.QueryAllSlashEventRequest")
+	proto.RegisterType((*QueryAllSlashEventResponse)(nil), "filespacechain.filespacechain.QueryAllSlashEventResponse")
+	proto.RegisterType((*QueryProviderUnbondingsRequest)(nil), "filespacechain.filespacechain.QueryProviderUnbondingsRequest")
+	proto.RegisterType((*QueryProviderUnbondingsResponse)(nil), "filespacechain.filespacechain.QueryProviderUnbondingsResponse")
+	proto.RegisterType((*QueryOpenInquiriesRequest)(nil), "filespacechain.filespacechain.QueryOpenInquiriesRequest")
+	proto.RegisterType((*QueryOpenInquiriesResponse)(nil), "filespacechain.filespacechain.QueryOpenInquiriesResponse")
+	proto.RegisterType((*QueryGetAuctionRequest)(nil), "filespacechain.filespacechain.QueryGetAuctionRequest")
+	proto.RegisterType((*QueryGetAuctionResponse)(nil), "filespacechain.filespacechain.QueryGetAuctionResponse")
+	proto.RegisterType((*QueryGetProviderProfileRequest)(nil), "filespacechain.filespacechain.QueryGetProviderProfileRequest")
+	proto.RegisterType((*QueryGetProviderProfileResponse)(nil), "filespacechain.filespacechain.QueryGetProviderProfileResponse")
+	proto.RegisterType((*QueryAllProviderProfileRequest)(nil), "filespacechain.filespacechain.QueryAllProviderProfileRequest")
+	proto.RegisterType((*QueryAllProviderProfileResponse)(nil), "filespacechain.filespacechain.QueryAllProviderProfileResponse")
+	proto.RegisterType((*QueryGetProviderReputationRequest)(nil), "filespacechain.filespacechain.QueryGetProviderReputationRequest")
+	proto.RegisterType((*QueryGetProviderReputationResponse)(nil), "filespacechain.filespacechain.QueryGetProviderReputationResponse")
+	proto.RegisterType((*QueryAllProviderReputationRequest)(nil), "filespacechain.filespacechain.QueryAllProviderReputationRequest")
+	proto.RegisterType((*QueryAllProviderReputationResponse)(nil), "filespacechain.filespacechain.QueryAllProviderReputationResponse")
+	proto.RegisterType((*QuerySystemStatisticsRequest)(nil), "filespacechain.filespacechain.QuerySystemStatisticsRequest")
+	proto.RegisterType((*QuerySystemStatisticsResponse)(nil), "filespacechain.filespacechain.QuerySystemStatisticsResponse")
+	proto.RegisterType((*QueryContractDetailsRequest)(nil), "filespacechain.filespacechain.QueryContractDetailsRequest")
+	proto.RegisterType((*QueryContractDetailsResponse)(nil), "filespacechain.filespacechain.QueryContractDetailsResponse")
+	proto.RegisterType((*QueryProviderPerformanceRequest)(nil), "filespacechain.filespacechain.QueryProviderPerformanceRequest")
+	proto.RegisterType((*QueryProviderPerformanceResponse)(nil), "filespacechain.filespacechain.QueryProviderPerformanceResponse")
+	proto.RegisterType((*QueryPaymentAnalyticsRequest)(nil), "filespacechain.filespacechain.QueryPaymentAnalyticsRequest")
+	proto.RegisterType((*DenomPaymentCount)(nil), "filespacechain.filespacechain.DenomPaymentCount")
+	proto.RegisterType((*QueryPaymentAnalyticsResponse)(nil), "filespacechain.filespacechain.QueryPaymentAnalyticsResponse")
+	proto.RegisterType((*QueryPaymentDistributionRequest)(nil), "filespacechain.filespacechain.QueryPaymentDistributionRequest")
+	proto.RegisterType((*QueryPaymentDistributionResponse)(nil), "filespacechain.filespacechain.QueryPaymentDistributionResponse")
+	proto.RegisterType((*ProviderPaymentSummary)(nil), "filespacechain.filespacechain.ProviderPaymentSummary")
+	proto.RegisterType((*QueryProviderPaymentSummaryRequest)(nil), "filespacechain.filespacechain.QueryProviderPaymentSummaryRequest")
+	proto.RegisterType((*QueryProviderPaymentSummaryResponse)(nil), "filespacechain.filespacechain.QueryProviderPaymentSummaryResponse")
+	proto.RegisterType((*QueryPaymentTrendsRequest)(nil), "filespacechain.filespacechain.QueryPaymentTrendsRequest")
+	proto.RegisterType((*PaymentWindow)(nil), "filespacechain.filespacechain.PaymentWindow")
+	proto.RegisterType((*QueryPaymentTrendsResponse)(nil), "filespacechain.filespacechain.QueryPaymentTrendsResponse")
+	proto.RegisterType((*QueryUnpaidContractsRequest)(nil), "filespacechain.filespacechain.QueryUnpaidContractsRequest")
+	proto.RegisterType((*QueryUnpaidContractsResponse)(nil), "filespacechain.filespacechain.QueryUnpaidContractsResponse")
+	proto.RegisterType((*QueryProviderPaymentsRequest)(nil), "filespacechain.filespacechain.QueryProviderPaymentsRequest")
+	proto.RegisterType((*QueryProviderPaymentsResponse)(nil), "filespacechain.filespacechain.QueryProviderPaymentsResponse")
+	proto.RegisterType((*QueryContractProviderPaymentsRequest)(nil), "filespacechain.filespacechain.QueryContractProviderPaymentsRequest")
+	proto.RegisterType((*QueryContractProviderPaymentsResponse)(nil), "filespacechain.filespacechain.QueryContractProviderPaymentsResponse")
+	proto.RegisterType((*QueryProviderPaymentsByHeightRequest)(nil), "filespacechain.filespacechain.QueryProviderPaymentsByHeightRequest")
+	proto.RegisterType((*QueryProviderPaymentsByHeightResponse)(nil), "filespacechain.filespacechain.QueryProviderPaymentsByHeightResponse")
+}
+
+func init() {
+	proto.RegisterFile("filespacechain/filespacechain/query.proto", fileDescriptor_1bd8b9ca09eda9eb)
+}
+
+var fileDescriptor_1bd8b9ca09eda9eb = []byte{
+	// 3538 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5c, 0xdf, 0x6f, 0x1c, 0x57,
+	0xf5, 0xcf, 0x78, 0xed, 0xc4, 0xbe, 0x89, 0x13, 0xe7, 0xa6, 0x3f, 0xdc, 0xfd, 0x26, 0x4e, 0xbe,
+	0x03, 0x2d, 0x69, 0x9a, 0xec, 0xe6, 0x97, 0xe3, 0x38, 0xb1, 0xeb, 0x78, 0xed, 0x24, 0x4e, 0x9a,
+	0x36, 0x66, 0x4d, 0x8a, 0x80, 0xaa, 0x66, 0xbc, 0x7b, 0xbd, 0x3b, 0xca, 0xee, 0xcc, 0x66, 0x66,
+	0x36, 0x89, 0x65, 0x59, 0x82, 0x8a, 0x8a, 0x07, 0x04, 0x42, 0xe2, 0x7f, 0xa8, 0x00, 0x09, 0x84,
+	0x78, 0x40, 0x3c, 0x54, 0x05, 0x5a, 0x01, 0xa5, 0xad, 0xfa, 0x8b, 0x52, 0x45, 0x05, 0x4a, 0x95,
+	0x20, 0xe8, 0x33, 0x2f, 0x88, 0x07, 0x24, 0x34, 0xf7, 0x9e, 0x3b, 0x73, 0xe7, 0xd7, 0xee, 0x9d,
+	0xf5, 0x80, 0x94, 0x97, 0xd6, 0x73, 0xef, 0x9c, 0x73, 0xcf, 0xe7, 0x9c, 0x73, 0xcf, 0x3d, 0xf7,
+	0xcc, 0xd9, 0xa0, 0xc7, 0x57, 0xf5, 0x06, 0xb1, 0x5b, 0x5a, 0x85, 0x54, 0xea, 0x9a, 0x6e, 0x14,
+	0x43, 0x8f, 0x37, 0xda, 0xc4, 0x5a, 0x2b, 0xb4, 0x2c, 0xd3, 0x31, 0xf1, 0xbe, 0xe0, 0x5c, 0x21,
+	0xf8, 0x98, 0xdf, 0xad, 0x35, 0x75, 0xc3, 0x2c, 0xd2, 0xff, 0x32, 0x8a, 0xfc, 0x03, 0x35, 0xb3,
+	0x66, 0xd2, 0x3f, 0x8b, 0xee, 0x5f, 0x30, 0xba, 0xb7, 0x66, 0x9a, 0xb5, 0x06, 0x29, 0x6a, 0x2d,
+	0xbd, 0xa8, 0x19, 0x86, 0xe9, 0x68, 0x8e, 0x6e, 0x1a, 0x36, 0xcc, 0x1e, 0xaa, 0x98, 0x76, 0xd3,
+	0xb4, 0x8b, 0x2b, 0x9a, 0x4d, 0xd8, 0xf2, 0xc5, 0x9b, 0xc7, 0x56, 0x88, 0xa3, 0x1d, 0x2b, 0xb6,
+	0xb4, 0x9a, 0x6e, 0xd0, 0x97, 0xe1, 0xdd, 0x31, 0xf1, 0x5d, 0xfe, 0x56, 0xc5, 0xd4, 0xf9, 0xfc,
+	0xa1, 0xce, 0xe0, 0x5a, 0x9a, 0xa5, 0x35, 0xf9, 0xba, 0x85, 0xce, 0xef, 0xba, 0x8f, 0xcb, 0xc4,
+	0x70, 0xb8, 0x36, 0xf2, 0x27, 0x3a, 0xbf, 0x5f, 0x37, 0x6d, 0x47, 0x37, 0x6a, 0xcb, 0xba, 0x71,
+	0xa3, 0xad, 0x7b, 0x44, 0x27, 0xe5, 0x88, 0x2a, 0xa6, 0xe1, 0x58, 0x5a, 0xc5, 0x01, 0xaa, 0x63,
+	0x72, 0x54, 0xe6, 0xea, 0x2a, 0xb1, 0x80, 0xe4, 0x89, 0x6e, 0xc8, 0xd7, 0x9a, 0xc4, 0x90, 0xe4,
+	0x6f, 0x3b, 0xa6, 0xa5, 0xd5, 0xc8, 0x72, 0xcb, 0x32, 0xcd, 0x55, 0x20, 0x39, 0xdc, 0x85, 0xa4,
+	0xa1, 0xd9, 0x75, 0xdd, 0xa8, 0xc9, 0x49, 0xa3, 0xb5, 0x2b, 0x82, 0x51, 0xbb, 0xe8, 0xa8, 0x65,
+	0x99, 0x37, 0xf5, 0x2a, 0xb1, 0x5c, 0x71, 0xdc, 0x29, 0x39, 0xf3, 0x59, 0xa4, 0xd5, 0x76, 0x04,
+	0xd7, 0x51, 0x1f, 0x40, 0xf8, 0xf3, 0xae, 0x73, 0x2d, 0x52, 0x1f, 0x28, 0x93, 0x1b, 0x6d, 0x62,
+	0x3b, 0xea, 0x32, 0xda, 0x13, 0x18, 0xb5, 0x5b, 0xa6, 0x61, 0x13, 0xbc, 0x80, 0xb6, 0x32, 0x5f,
+	0x19, 0x55, 0x0e, 0x28, 0x07, 0xb7, 0x1f, 0x7f, 0xb4, 0xd0, 0x71, 0x2b, 0x14, 0x18, 0x79, 0x69,
+	0xe8, 0xf5, 0x8f, 0xf7, 0x6f, 0xf9, 0xfe, 0xdf, 0x7f, 0x72, 0x48, 0x29, 0x03, 0xbd, 0x7a, 0x08,
+	0x8d, 0xd2, 0x05, 0x2e, 0x12, 0xe7, 0x82, 0xde, 0x20, 0xe7, 0x5d, 0x87, 0x82, 0xc5, 0xf1, 0x4e,
+	0xd4, 0xa7, 0x57, 0xe9, 0x0a, 0xfd, 0xe5, 0x3e, 0xbd, 0xaa, 0xea, 0xe8, 0x91, 0x98, 0x77, 0x41,
+	0xa4, 0x2b, 0x68, 0xc8, 0x1b, 0x04, 0xa9, 0x0e, 0x76, 0x91, 0xca, 0x7b, 0xbf, 0xd4, 0xef, 0x0a,
+	0x56, 0xf6, 0x19, 0xa8, 0x2b, 0x20, 0xd6, 0x6c, 0xa3, 0x11, 0x11, 0xeb, 0x02, 0x42, 0xfe, 0xc6,
+	0x83, 0xa5, 0x1e, 0x2b, 0xb0, 0x9d, 0x57, 0x70, 0x77, 0x5e, 0x81, 0x05, 0x09, 0xd8, 0x7f, 0x85,
+	0x45, 0xad, 0x46, 0x80, 0xb6, 0x2c, 0x50, 0xaa, 0x3f, 0x55, 0x00, 0x4f, 0x70, 0x91, 0x78, 0x3c,
+	0xb9, 0x4d, 0xe1, 0xc1, 0x17, 0x03, 0x32, 0xf7, 0x51, 0x99, 0x3f, 0xd7, 0x55, 0x66, 0x26, 0x4a,
+	0x40, 0xe8, 0x22, 0xda, 0xc7, 0x6d, 0xb0, 0xc0, 0xb6, 0xd9, 0x25, 0xb6, 0xa1, 0x93, 0x8c, 0xb6,
+	0x81, 0xc6, 0x92, 0x08, 0x00, 0xe9, 0x57, 0xd0, 0xce, 0xe0, 0x0c, 0xe8, 0xf4, 0x48, 0x17, 0xb8,
+	0x41, 0x22, 0xc0, 0x1c, 0x62, 0xa5, 0xd6, 0x40, 0xde, 0xd9, 0x46, 0x23, 0x5e, 0xde, 0xac, 0xac,
+	0xf9, 0x1b, 0x05, 0x80, 0xc6, 0xac, 0xd4, 0x01, 0x68, 0x2e, 0x23, 0xa0, 0xd9, 0x59, 0xf8, 0x68,
+	0xc4, 0x60, 0x73, 0x10, 0x7d, 0x93, 0x4c, 0xfc, 0x75, 0x05, 0xed, 0x4f, 0x24, 0x01, 0xec, 0xcf,
+	0xa3, 0x5d, 0xa1, 0x29, 0xd0, 0x75, 0x41, 0x0e, 0x3c, 0xa7, 0x02, 0xf4, 0x61, 0x66, 0x6a, 0x3d,
+	0xa2, 0xfd, 0xb0, 0xd4, 0x59, 0x19, 0xfa, 0x6b, 0x0a, 0x52, 0x13, 0x96, 0xba, 0x60, 0x99, 0xcd,
+	0x8c, 0x97, 0xc3, 0x18, 0xf5, 0xaf, 0x5a, 0x66, 0x93, 0x5a, 0x74, 0xa8, 0x4c, 0xff, 0x56, 0xdf,
+	0xe0, 0x0a, 0x8f, 0x43, 0xdb, 0x49, 0xe1, 0xb9, 0xcc, 0x14, 0x9e, 0x9d, 0xbf, 0x1d, 0x41, 0xff,
+	0x17, 0x72, 0x9e, 0xab, 0xee, 0xb9, 0x9d, 0xe4, 0x6c, 0x6d, 0xb4, 0x37, 0xfe, 0x75, 0xc0, 0x7d,
+	0x0d, 0xed, 0x10, 0xc7, 0x41, 0xf3, 0x4f, 0xc8, 0x81, 0xa6, 0x24, 0x80, 0x38, 0xc0, 0x46, 0x25,
+	0x20, 0xa5, 0xaf, 0xf1, 0x80, 0x94, 0x59, 0x39, 0xd7, 0x2b, 0x0a, 0xc0, 0x8b, 0xac, 0x93, 0x08,
+	0x2f, 0x97, 0x01, 0xbc, 0xec, 0xac, 0xf9, 0x4d, 0x05, 0x7d, 0x86, 0x02, 0xb8, 0xa2, 0xdb, 0x4e,
+	0x87, 0xed, 0x31, 0x8a, 0xb6, 0x55, 0x2c, 0xa2, 0x39, 0x26, 0xb3, 0xd0, 0x50, 0x99, 0x3f, 0x86,
+	0x54, 0xd9, 0xd7, 0xb3, 0x2a, 0xdf, 0x51, 0xd0, 0x67, 0x3b, 0x4b, 0x72, 0xbf, 0xed, 0x94, 0x69,
+	0x94, 0x87, 0x64, 0x8c, 0x26, 0xab, 0x0b, 0xba, 0x9b, 0x88, 0x7a, 0x07, 0xd9, 0x7e, 0xb4, 0x9d,
+	0xa7, 0xc9, 0xcb, 0xde, 0x8e, 0x41, 0x7c, 0xe8, 0x52, 0x55, 0x5d, 0x07, 0x17, 0x0e, 0x93, 0x83,
+	0x1a, 0x9e, 0x43, 0xbb, 0x20, 0x0b, 0x5e, 0xae, 0xb3, 0x29, 0xc9, 0x73, 0x38, 0xc8, 0x8f, 0x1f,
+	0x4f, 0xad, 0xc0, 0xa8, 0x78, 0x0e, 0xc7, 0x8b, 0x9f, 0xd5, 0x0e, 0xfa, 0xad, 0x70, 0x0e, 0xa7,
+	0x41, 0x9a, 0xcb, 0x08, 0x69, 0x76, 0xe6, 0x9e, 0x84, 0x1c, 0xf4, 0xbc, 0x5d, 0xb1, 0xcc, 0x5b,
+	0x65, 0x52, 0x31, 0xad, 0x2a, 0xd7, 0xd6, 0x3e, 0x84, 0xe0, 0x22, 0xe5, 0xdb, 0x7a, 0x08, 0x46,
+	0x2e, 0x55, 0x55, 0x1b, 0x32, 0xcb, 0x20, 0x29, 0xc0, 0x7f, 0x16, 0x0d, 0x13, 0x3a, 0xbe, 0x6c,
+	0xd1, 0x09, 0xc9, 0x10, 0x29, 0xf2, 0xe2, 0x31, 0x84, 0x08, 0x63, 0x62, 0x88, 0x8c, 0x13, 0x39,
+	0x2b, 0x03, 0xff, 0x42, 0x08, 0x91, 0xb2, 0xf8, 0x72, 0x19, 0xe0, 0xcb, 0xce, 0xb0, 0x13, 0x60,
+	0x9d, 0x45, 0xb8, 0xb9, 0x2d, 0x39, 0xda, 0x75, 0x0e, 0x15, 0xe7, 0xd1, 0x20, 0xbf, 0xd1, 0x41,
+	0x64, 0xf4, 0x9e, 0xd5, 0x5b, 0x3c, 0x00, 0x04, 0x09, 0x01, 0xf7, 0x97, 0xd0, 0x4e, 0xef, 0x2e,
+	0x68, 0xbb, 0x33, 0xa0, 0xe4, 0xc3, 0xdd, 0xbc, 0x5a, 0xe4, 0x06, 0xc8, 0x87, 0x5b, 0xe2, 0xa0,
+	0xba, 0xea, 0xab, 0x3c, 0x56, 0xe8, 0xac, 0x6c, 0xfb, 0x9a, 0x22, 0x84, 0x09, 0x69, 0x90, 0xb9,
+	0x4c, 0x40, 0x66, 0x67, 0xdf, 0x63, 0x7e, 0x3a, 0xbc, 0xc4, 0x4a, 0x05, 0x73, 0x75, 0xad, 0xd1,
+	0x20, 0x86, 0x07, 0x3a, 0x92, 0xd5, 0xbc, 0xa8, 0xa0, 0x03, 0xc9, 0x34, 0x80, 0x5d, 0x43, 0x23,
+	0xe1, 0x39, 0xd0, 0x75, 0xb1, 0x0b, 0xfa, 0x30, 0x19, 0x28, 0x20, 0xc2, 0x4e, 0xd5, 0xfd, 0xc4,
+	0x32, 0x49, 0xf4, 0xac, 0x6c, 0xfd, 0x36, 0x87, 0x1c, 0xbb, 0x56, 0x47, 0xc8, 0xb9, 0x0c, 0x21,
+	0x67, 0x67, 0xf6, 0x27, 0xfc, 0xf2, 0xc4, 0x52, 0x43, 0xb3, 0xeb, 0xe7, 0x6f, 0x12, 0x23, 0xf1,
+	0xce, 0xd4, 0x84, 0xad, 0x1c, 0x7a, 0x19, 0x60, 0x5f, 0x45, 0xc8, 0x1f, 0x05, 0x1d, 0x3f, 0xde,
+	0x0d, 0xb0, 0x47, 0x00, 0x50, 0x05, 0x16, 0x6a, 0xc5, 0x2f, 0x35, 0x44, 0x65, 0xcb, 0xca, 0xa2,
+	0x3f, 0x53, 0x00, 0x54, 0x68, 0x95, 0x04, 0x50, 0xb9, 0x4d, 0x82, 0xca, 0xce, 0x72, 0xdf, 0xe0,
+	0x39, 0x03, 0x8f, 0x12, 0xd7, 0x8c, 0x15, 0xd3, 0xa8, 0xea, 0x46, 0xcd, 0x96, 0x08, 0xcb, 0x99,
+	0x65, 0xac, 0x6f, 0xf1, 0x6b, 0x5d, 0x9c, 0x18, 0xa0, 0xc4, 0xaf, 0xa2, 0xdd, 0x6d, 0x3e, 0x4a,
+	0xcb, 0xaf, 0x3a, 0xb1, 0x25, 0xb3, 0x17, 0x8f, 0x9b, 0x58, 0x23, 0x1a, 0x69, 0x8b, 0xa3, 0x3a,
+	0xb1, 0xb3, 0xd3, 0x2a, 0xf7, 0xb9, 0xab, 0x2d, 0x62, 0xb0, 0x2a, 0x85, 0x4e, 0xec, 0xac, 0x7d,
+	0xee, 0x55, 0xee, 0x73, 0xa1, 0x55, 0xee, 0xab, 0x92, 0xcb, 0x29, 0xf4, 0x10, 0x0f, 0x06, 0xb3,
+	0xac, 0xf4, 0xcb, 0xd5, 0xb4, 0x17, 0xf9, 0x59, 0x5d, 0x34, 0xcd, 0x7b, 0x49, 0x41, 0x0f, 0x47,
+	0x08, 0x01, 0xf9, 0x05, 0xb4, 0x0d, 0x86, 0x3c, 0xed, 0x76, 0x86, 0x0c, 0x6f, 0x03, 0x56, 0x4e,
+	0x8c, 0xe7, 0x50, 0xff, 0x8a, 0x5e, 0xb5, 0x47, 0xfb, 0xa4, 0xf6, 0x2b, 0x67, 0xa2, 0xf3, 0x14,
+	0x8a, 0x12, 0xab, 0x53, 0x7e, 0x4d, 0x89, 0xfb, 0xf6, 0x22, 0xab, 0x56, 0xcb, 0xa4, 0x3d, 0x62,
+	0x7d, 0x29, 0x42, 0xee, 0x5f, 0xe2, 0x42, 0x53, 0x92, 0xf5, 0xa5, 0x10, 0x15, 0xbf, 0xc4, 0x85,
+	0x86, 0xc5, 0xfa, 0x52, 0x02, 0x82, 0xac, 0x3c, 0x5a, 0x2c, 0xee, 0xa4, 0x42, 0x9b, 0xcb, 0x0c,
+	0x6d, 0x76, 0x9e, 0x3d, 0x83, 0xfe, 0x3f, 0x6c, 0xb9, 0xb2, 0xf7, 0xe5, 0x41, 0xc6, 0xf6, 0xdf,
+	0xe6, 0xd5, 0xb6, 0x04, 0x0e, 0xa0, 0x90, 0x1a, 0xc2, 0xd1, 0x59, 0x30, 0xc2, 0x31, 0x49, 0x9d,
+	0xf8, 0x84, 0xa0, 0x96, 0x18, 0x96, 0xea, 0x75, 0x00, 0x24, 0x18, 0x27, 0x0a, 0x28, 0x2b, 0x57,
+	0xf8, 0x50, 0x28, 0x35, 0xf6, 0x00, 0x3e, 0x97, 0x31, 0xf8, 0xec, 0xdc, 0x62, 0x0c, 0xee, 0x13,
+	0x4b, 0x6b, 0xb6, 0x43, 0x9a, 0x4b, 0x2e, 0x7b, 0xdb, 0xd1, 0x2b, 0xde, 0x67, 0xa7, 0x37, 0x73,
+	0x70, 0x0f, 0x88, 0xbe, 0x00, 0x98, 0x0f, 0xa1, 0x11, 0xc7, 0x74, 0x34, 0xef, 0xc3, 0x09, 0x3b,
+	0x06, 0xdd, 0xf8, 0x18, 0x19, 0xc7, 0x27, 0xd1, 0x83, 0x74, 0x2c, 0x10, 0xbe, 0x5d, 0x82, 0x3e,
+	0x4a, 0x10, 0x3f, 0x89, 0x0b, 0x08, 0x8b, 0x13, 0xb4, 0x4e, 0x66, 0x8f, 0xe6, 0x28, 0x49, 0xcc,
+	0x4c, 0x78, 0x15, 0x5e, 0xfe, 0xb1, 0x47, 0xfb, 0xa3, 0xab, 0x78, 0x93, 0x1e, 0x55, 0xa0, 0xb4,
+	0xe0, 0xca, 0x36, 0x20, 0x50, 0x85, 0x27, 0x3d, 0xd9, 0xc4, 0x3b, 0xab, 0x3d, 0xba, 0x55, 0x90,
+	0x2d, 0x30, 0x83, 0x1f, 0x43, 0x3b, 0x19, 0x23, 0xb0, 0xa9, 0x3d, 0xba, 0x8d, 0xbe, 0x1b, 0x1a,
+	0xc5, 0x07, 0xd1, 0x2e, 0xad, 0xe2, 0xe8, 0x37, 0x89, 0x2f, 0xfd, 0x20, 0x7d, 0x31, 0x3c, 0xec,
+	0xea, 0x9f, 0xdc, 0x6e, 0xe9, 0x16, 0xa9, 0xfa, 0xaf, 0x0e, 0x31, 0xfd, 0x87, 0xc7, 0xd5, 0x69,
+	0x28, 0x0c, 0xf0, 0x91, 0x79, 0xe2, 0x68, 0x7a, 0xc3, 0x4b, 0x05, 0xc6, 0x90, 0x50, 0xa5, 0x8a,
+	0xa9, 0x5b, 0xfd, 0x3c, 0x07, 0xde, 0x12, 0xa1, 0x07, 0x5f, 0x58, 0x44, 0x83, 0x95, 0x2c, 0x3e,
+	0x2a, 0x78, 0x5c, 0xf0, 0x45, 0xb4, 0x0d, 0x4e, 0x59, 0xf0, 0xf2, 0x74, 0xf9, 0x42, 0x99, 0x53,
+	0xe3, 0x59, 0x34, 0x40, 0xbf, 0x42, 0x53, 0xbf, 0x49, 0x57, 0xa7, 0x2d, 0x33, 0x4a, 0x7c, 0x0d,
+	0x85, 0x2a, 0x4c, 0xd4, 0xa1, 0xd2, 0x16, 0xab, 0x22, 0x65, 0xaa, 0xab, 0x28, 0x50, 0xdd, 0xa0,
+	0xfe, 0x96, 0xae, 0x48, 0x12, 0x2a, 0x8f, 0x60, 0xd4, 0xef, 0x10, 0xab, 0x49, 0xbd, 0x70, 0xa8,
+	0x4c, 0xff, 0x56, 0xa7, 0x43, 0x09, 0xed, 0x22, 0xb1, 0x56, 0x4d, 0xab, 0xa9, 0x19, 0x15, 0xa9,
+	0x83, 0xff, 0x47, 0x39, 0xb8, 0x22, 0xc6, 0xd2, 0x83, 0xf5, 0x4b, 0x68, 0xa0, 0xe7, 0x6a, 0x47,
+	0x99, 0x91, 0x7a, 0xfb, 0xc3, 0xf7, 0xe5, 0x3e, 0x61, 0x7f, 0xf8, 0x5e, 0x1f, 0xb3, 0x3f, 0x72,
+	0xf1, 0xfb, 0xa3, 0x80, 0x70, 0xc5, 0x6c, 0xb6, 0x1a, 0xc4, 0x11, 0x77, 0x08, 0x0b, 0x05, 0x31,
+	0x33, 0xf8, 0x00, 0xda, 0x4e, 0xd7, 0x82, 0x30, 0xc3, 0xb6, 0xb2, 0x38, 0xe4, 0xae, 0xed, 0x7f,
+	0xb4, 0x5f, 0xaa, 0x98, 0x16, 0x81, 0x4d, 0x1c, 0x1e, 0xc6, 0x37, 0xd0, 0x30, 0x8b, 0x01, 0x9a,
+	0x65, 0xb8, 0x97, 0x87, 0xd1, 0x41, 0x7a, 0x14, 0x3c, 0x12, 0x88, 0xd4, 0x3c, 0x46, 0xcf, 0x99,
+	0xba, 0x51, 0x3a, 0xea, 0xfa, 0xff, 0x0f, 0xff, 0xb2, 0xff, 0x60, 0x4d, 0x77, 0xea, 0xed, 0x95,
+	0x42, 0xc5, 0x6c, 0x16, 0xa1, 0x95, 0x84, 0xfd, 0xef, 0x88, 0x5d, 0xbd, 0x5e, 0x74, 0xd6, 0x5a,
+	0xc4, 0xa6, 0x04, 0x76, 0x39, 0xb8, 0xc2, 0xe5, 0xfe, 0xc1, 0x81, 0x91, 0xad, 0x5e, 0x58, 0x07,
+	0xd7, 0x9b, 0x35, 0xb4, 0xc6, 0x9a, 0x18, 0xd6, 0x67, 0xd0, 0xee, 0x79, 0x62, 0x98, 0x4d, 0x98,
+	0x9f, 0x33, 0xdb, 0x86, 0x83, 0x1f, 0x40, 0x03, 0x55, 0x77, 0x10, 0xac, 0xcf, 0x1e, 0xdc, 0xd1,
+	0x8a, 0x3b, 0x0d, 0x86, 0x60, 0x0f, 0xea, 0x3f, 0xfa, 0xe0, 0x5c, 0x88, 0xae, 0x00, 0xde, 0x70,
+	0x14, 0xed, 0x11, 0x43, 0x26, 0x0f, 0x8d, 0x2c, 0xaa, 0xc4, 0x4d, 0x61, 0x1d, 0x0d, 0xc1, 0xb0,
+	0x5e, 0x85, 0x2c, 0x37, 0x53, 0x4d, 0xf9, 0xdc, 0xf1, 0x73, 0x68, 0xb8, 0x25, 0x40, 0x77, 0x9d,
+	0xc7, 0x5d, 0xee, 0x68, 0x17, 0x97, 0x8d, 0xe8, 0xcc, 0xab, 0x5f, 0x89, 0xcc, 0xf0, 0x61, 0xb4,
+	0xdb, 0x73, 0x2c, 0x78, 0x9b, 0x7b, 0x5c, 0x74, 0xc2, 0x75, 0xa7, 0x16, 0xa1, 0x17, 0x3f, 0xef,
+	0x5d, 0x76, 0xe4, 0x84, 0x87, 0xd5, 0x09, 0xbe, 0x89, 0xd9, 0xc0, 0xbc, 0x6e, 0x3b, 0x96, 0xbe,
+	0xd2, 0x16, 0x13, 0x9e, 0x58, 0x1b, 0xaa, 0xef, 0x79, 0xdb, 0x37, 0x8e, 0x12, 0x0c, 0xe6, 0x19,
+	0x5a, 0x11, 0x0c, 0x8d, 0x4f, 0xa0, 0x01, 0xaa, 0x36, 0xf6, 0xd9, 0xb3, 0xb4, 0xcf, 0xc5, 0xfb,
+	0xd1, 0xc7, 0xfb, 0x1f, 0x64, 0x3a, 0xb6, 0xab, 0xd7, 0x0b, 0xba, 0x59, 0x6c, 0x6a, 0x4e, 0xbd,
+	0x70, 0xc9, 0x70, 0xca, 0xec, 0x5d, 0x3c, 0x81, 0xb6, 0x69, 0x37, 0x89, 0xa5, 0xd5, 0x08, 0xdd,
+	0x95, 0x5d, 0xc9, 0xf8, 0xdb, 0xb8, 0x88, 0x72, 0x4d, 0xdd, 0xa0, 0xba, 0xea, 0x4a, 0xe4, 0xbe,
+	0x49, 0x09, 0xb4, 0xdb, 0x54, 0x61, 0x12, 0x04, 0xda, 0x6d, 0x3c, 0x8b, 0x76, 0xb4, 0x88, 0x55,
+	0x21, 0x86, 0xa3, 0x37, 0xc8, 0xf1, 0x71, 0x16, 0x24, 0xbb, 0x51, 0x06, 0x48, 0x82, 0x2c, 0xc6,
+	0x8f, 0xd2, 0xcd, 0x9f, 0x86, 0xc5, 0xf8, 0xd1, 0x20, 0x8b, 0x89, 0x71, 0x7a, 0xb6, 0xa7, 0x61,
+	0x31, 0x31, 0xae, 0xfe, 0xa0, 0x0f, 0x3d, 0xe4, 0x45, 0x63, 0x66, 0xd6, 0xa5, 0x76, 0xb3, 0xa9,
+	0x59, 0x6b, 0x1d, 0x4b, 0x24, 0x2a, 0xda, 0x21, 0x3a, 0x2b, 0xec, 0xea, 0xc0, 0x58, 0xea, 0x90,
+	0x79, 0x08, 0x8d, 0x80, 0xab, 0xfa, 0x6f, 0x33, 0x17, 0x8e, 0x8c, 0xe3, 0x26, 0x84, 0x57, 0x37,
+	0x60, 0x91, 0xea, 0xe8, 0xd6, 0xec, 0xb7, 0xb9, 0xc8, 0xff, 0x72, 0xff, 0x60, 0x6e, 0xa4, 0x5f,
+	0x6d, 0x40, 0xf6, 0x1e, 0xaf, 0xaf, 0xac, 0x2f, 0x0b, 0xbf, 0xe3, 0x5f, 0x5e, 0x93, 0x96, 0xf3,
+	0x2a, 0xe8, 0x43, 0x36, 0x1d, 0xf2, 0x2b, 0x47, 0xe3, 0xb2, 0xb7, 0xc6, 0x00, 0x47, 0xde, 0x65,
+	0xe4, 0x71, 0xcb, 0xee, 0x7e, 0xf0, 0x22, 0x6f, 0x8d, 0x82, 0x15, 0xbf, 0x60, 0x11, 0xa3, 0xea,
+	0x25, 0x8c, 0x07, 0xd0, 0xf6, 0x95, 0x86, 0x59, 0xb9, 0xfe, 0x45, 0xdd, 0xa8, 0x9a, 0xb7, 0x20,
+	0x70, 0x88, 0x43, 0x99, 0x55, 0xe4, 0xee, 0x28, 0x68, 0x18, 0x44, 0x00, 0xce, 0x63, 0x08, 0xd9,
+	0x8e, 0x66, 0x39, 0x25, 0x77, 0x35, 0x9e, 0xac, 0xfa, 0x23, 0xee, 0x26, 0x20, 0x46, 0x95, 0xcd,
+	0x32, 0x27, 0xf7, 0x9e, 0x23, 0x9b, 0x20, 0x17, 0xb3, 0x09, 0x34, 0x1e, 0xf8, 0x06, 0xb2, 0x77,
+	0x51, 0xc6, 0xf9, 0x72, 0xff, 0x60, 0xff, 0xc8, 0x80, 0xfa, 0x2f, 0x25, 0xf8, 0x35, 0x99, 0xab,
+	0x18, 0xbc, 0xa4, 0xbb, 0x8e, 0x55, 0xb4, 0xa3, 0xd2, 0xb6, 0x2c, 0x62, 0x38, 0x22, 0xda, 0xc0,
+	0x18, 0xbe, 0x82, 0xb6, 0xdd, 0xa2, 0x6f, 0xf3, 0xa3, 0xee, 0xb0, 0x5c, 0xd2, 0xca, 0x96, 0xe0,
+	0xa5, 0x28, 0x60, 0x11, 0x72, 0xaf, 0xfe, 0xde, 0xdd, 0x8b, 0x7f, 0xa9, 0xbc, 0x66, 0xb4, 0x34,
+	0xdd, 0x8f, 0x28, 0xff, 0x85, 0x4f, 0xd1, 0x7b, 0xe3, 0xd7, 0xb9, 0xdf, 0x3a, 0x0f, 0x5e, 0xe0,
+	0x48, 0x42, 0x91, 0xe0, 0x7f, 0x5a, 0x1e, 0x7f, 0x83, 0x7f, 0x1c, 0x8c, 0x0a, 0xe1, 0x15, 0xc7,
+	0x47, 0x5a, 0xa1, 0xb9, 0xb4, 0x75, 0x31, 0x46, 0xc6, 0x8b, 0xe3, 0x61, 0x6e, 0xd9, 0x69, 0xf4,
+	0x3b, 0xbc, 0x3b, 0x85, 0x1b, 0x2b, 0x49, 0xb3, 0x5d, 0x6e, 0xc7, 0x99, 0x69, 0xf7, 0x7d, 0x05,
+	0x3d, 0xda, 0x45, 0xa0, 0xfb, 0x4f, 0xcb, 0x3f, 0xe6, 0x5a, 0x0e, 0x83, 0x29, 0xad, 0x2d, 0x10,
+	0xbd, 0x56, 0x77, 0x84, 0x23, 0x85, 0x06, 0x71, 0x36, 0xca, 0xc3, 0x9d, 0x30, 0x84, 0xf7, 0xa2,
+	0x21, 0x62, 0x54, 0x61, 0x9e, 0xc5, 0x3a, 0x7f, 0x20, 0x64, 0x85, 0xdc, 0xe6, 0xad, 0x90, 0x2c,
+	0xf0, 0x7d, 0x67, 0x85, 0xe3, 0x7f, 0x9e, 0x41, 0x03, 0x14, 0x14, 0x7e, 0x49, 0x41, 0x5b, 0x59,
+	0x2f, 0x38, 0xee, 0x56, 0x98, 0x8c, 0x36, 0xa3, 0xe7, 0x8f, 0xa7, 0x21, 0x61, 0x72, 0xa8, 0xe3,
+	0x2f, 0xfc, 0xfe, 0xaf, 0xdf, 0xeb, 0x2b, 0xe2, 0x23, 0xc5, 0xba, 0x66, 0xd8, 0xf5, 0x1b, 0x7e,
+	0x1f, 0xfc, 0x91, 0x0e, 0x3f, 0x81, 0xc0, 0xaf, 0x28, 0x42, 0xfb, 0x35, 0x9e, 0x90, 0x59, 0x38,
+	0xa6, 0x83, 0x3d, 0x7f, 0x3a, 0x3d, 0x21, 0xc8, 0xfd, 0x24, 0x95, 0xfb, 0x34, 0x3e, 0x25, 0x29,
+	0xb7, 0xff, 0x73, 0x8c, 0xe2, 0xba, 0x5e, 0xdd, 0xc0, 0x2f, 0x2b, 0x68, 0x87, 0xc7, 0x75, 0xb6,
+	0xd1, 0x90, 0xc3, 0x10, 0xd3, 0xee, 0x2e, 0x87, 0x21, 0xae, 0x85, 0x5d, 0x9d, 0xa4, 0x18, 0x4e,
+	0xe0, 0x63, 0xa9, 0x31, 0xe0, 0xf7, 0x95, 0xf0, 0x87, 0x3b, 0x3c, 0x25, 0xa9, 0xcb, 0xd8, 0x36,
+	0xef, 0xfc, 0x74, 0x8f, 0xd4, 0x00, 0x65, 0x8e, 0x42, 0x99, 0xc6, 0x67, 0x25, 0xa1, 0x84, 0x7e,
+	0xed, 0xc2, 0x6c, 0xf2, 0xae, 0x82, 0x76, 0x07, 0xf9, 0xbb, 0x86, 0x99, 0x92, 0xd4, 0xef, 0x26,
+	0x70, 0x25, 0xb6, 0xa4, 0xa7, 0x76, 0xb3, 0x10, 0x2e, 0xfc, 0xa1, 0x12, 0x49, 0x61, 0x70, 0x4a,
+	0x55, 0x87, 0xfa, 0xb4, 0xf3, 0x4f, 0xf6, 0x4a, 0x0e, 0x90, 0xe6, 0x29, 0xa4, 0x27, 0xf1, 0x54,
+	0x4a, 0x48, 0xfc, 0x4c, 0x65, 0xb6, 0xfa, 0x40, 0x41, 0x38, 0xb4, 0x82, 0x6b, 0xac, 0x94, 0xea,
+	0xee, 0x09, 0x5b, 0x72, 0x53, 0xb7, 0x3a, 0x43, 0xb1, 0x4d, 0xe2, 0x89, 0x1e, 0xb1, 0xe1, 0x37,
+	0x94, 0x60, 0xff, 0x30, 0x3e, 0x93, 0x4e, 0xdb, 0x62, 0xd3, 0x73, 0xfe, 0x6c, 0x4f, 0xb4, 0x00,
+	0x65, 0x96, 0x42, 0x39, 0x8b, 0x27, 0x53, 0x42, 0xa1, 0x45, 0x71, 0x66, 0xa3, 0xd7, 0x7d, 0xe7,
+	0xa3, 0xbc, 0x5d, 0x03, 0x9d, 0x49, 0xa7, 0xe1, 0xf4, 0x78, 0x12, 0x1a, 0xb3, 0xd5, 0x29, 0x8a,
+	0xe7, 0x14, 0x3e, 0xd9, 0x0b, 0x1e, 0xfc, 0x4f, 0x05, 0x3d, 0x9c, 0xd0, 0xa7, 0x8c, 0x4b, 0x32,
+	0x62, 0x75, 0x6e, 0xb7, 0xce, 0xcf, 0x6d, 0x8a, 0x07, 0x40, 0x5c, 0xa2, 0x10, 0x9f, 0xc6, 0x4f,
+	0x49, 0x42, 0x6c, 0xe8, 0xb6, 0xb3, 0x1c, 0x76, 0xc1, 0xe5, 0x55, 0xcb, 0x6c, 0x16, 0xd7, 0xa1,
+	0xdb, 0x7b, 0x03, 0xff, 0x41, 0x41, 0x3b, 0x83, 0x9f, 0x2a, 0xf0, 0xa4, 0xdc, 0x39, 0x1f, 0xd3,
+	0x45, 0x9c, 0x3f, 0xd3, 0x0b, 0x29, 0xc0, 0xbb, 0x42, 0xe1, 0x5d, 0xc0, 0xf3, 0xd2, 0xa9, 0x42,
+	0xa0, 0x87, 0xb8, 0xb8, 0x2e, 0xb4, 0x5f, 0xb3, 0x60, 0x1f, 0x5c, 0x28, 0x4d, 0xb0, 0x8f, 0x47,
+	0x37, 0xdd, 0x23, 0x75, 0x8f, 0xc1, 0x3e, 0x04, 0x10, 0xff, 0x5a, 0x41, 0x3b, 0xc4, 0x0f, 0x40,
+	0x72, 0x39, 0x45, 0x4c, 0x2f, 0xb0, 0x5c, 0x4e, 0x11, 0xd7, 0xdc, 0xab, 0x96, 0x28, 0x86, 0x29,
+	0x7c, 0x46, 0x12, 0x03, 0xfb, 0x44, 0x55, 0x5c, 0xf7, 0xbb, 0xa5, 0x37, 0xf0, 0xaf, 0x14, 0xb4,
+	0x4b, 0x64, 0x9e, 0x26, 0x6e, 0xc4, 0xa1, 0x39, 0xdb, 0x13, 0x6d, 0x8f, 0x09, 0x2a, 0x03, 0x84,
+	0xdf, 0x56, 0xd0, 0x70, 0xe0, 0x53, 0x16, 0x96, 0xd2, 0x69, 0x5c, 0xf7, 0x6e, 0x7e, 0xb2, 0x07,
+	0x4a, 0x90, 0x7e, 0x81, 0x4a, 0x5f, 0xc2, 0xe7, 0x64, 0x5d, 0x2a, 0xd0, 0xbb, 0x5b, 0x5c, 0xe7,
+	0xcf, 0x1b, 0xf8, 0x2d, 0x05, 0x8d, 0x04, 0xd6, 0x70, 0xad, 0x22, 0xab, 0xd9, 0x58, 0x58, 0x53,
+	0xbd, 0x11, 0x03, 0xb2, 0x69, 0x8a, 0x6c, 0x02, 0x8f, 0xf7, 0x84, 0x0c, 0x7f, 0xa4, 0x44, 0x3b,
+	0x57, 0xb1, 0x6c, 0x6a, 0x93, 0xd0, 0x7a, 0x9b, 0x9f, 0xe9, 0x99, 0x1e, 0x40, 0x9d, 0xa7, 0xa0,
+	0x66, 0xf0, 0xb4, 0x24, 0x28, 0xfe, 0x4b, 0xe7, 0x0a, 0xe7, 0xc4, 0x0e, 0xde, 0x3b, 0x0a, 0xda,
+	0x13, 0x5e, 0xc3, 0x35, 0x97, 0x6c, 0x7a, 0xb3, 0x29, 0x7c, 0x1d, 0xda, 0x85, 0xd5, 0x73, 0x14,
+	0xdf, 0x19, 0x7c, 0xba, 0x57, 0x7c, 0xf8, 0x55, 0x45, 0xec, 0x52, 0xc5, 0xb2, 0x17, 0xb8, 0x48,
+	0x53, 0xad, 0xdc, 0xa6, 0x8a, 0xed, 0xfe, 0x4d, 0x9d, 0xe5, 0xd1, 0x1f, 0x97, 0x2f, 0x13, 0x97,
+	0x07, 0xb3, 0xcf, 0x2f, 0x15, 0x34, 0xec, 0xf3, 0x75, 0x2d, 0x23, 0x7b, 0x89, 0xeb, 0x11, 0x47,
+	0x6c, 0xc3, 0xaf, 0x7a, 0x86, 0xe2, 0x38, 0x89, 0x8f, 0xa7, 0xc7, 0x81, 0xef, 0x2a, 0x7e, 0x53,
+	0x93, 0xdf, 0x06, 0x2b, 0x97, 0x7f, 0x27, 0x76, 0xf1, 0xca, 0xe5, 0xdf, 0xc9, 0xdd, 0xb7, 0xea,
+	0xd3, 0x14, 0xd1, 0x45, 0x7c, 0x3e, 0x6d, 0x50, 0xf0, 0xba, 0x6c, 0x6d, 0x31, 0xe6, 0xbd, 0xa6,
+	0xa0, 0xe1, 0x40, 0xdf, 0xaa, 0x9c, 0x9d, 0xe2, 0x1a, 0x6a, 0xe5, 0xec, 0x14, 0xdb, 0x24, 0x9b,
+	0x3a, 0xd4, 0x99, 0x2d, 0x62, 0xc0, 0x0d, 0xd0, 0x95, 0xf9, 0x65, 0xc5, 0x6b, 0x35, 0xc5, 0xe3,
+	0x92, 0x5e, 0x1f, 0x6c, 0x73, 0xcd, 0x9f, 0x4a, 0x4b, 0xd6, 0x63, 0x36, 0x00, 0xff, 0xb0, 0x82,
+	0x97, 0x0e, 0x5c, 0xaa, 0x6e, 0xe0, 0x3f, 0x29, 0x91, 0x66, 0x4a, 0xe9, 0x2b, 0x6c, 0x7c, 0x2b,
+	0xa8, 0xf4, 0x15, 0x36, 0xa1, 0xbd, 0x53, 0xbd, 0x4c, 0x61, 0xcd, 0xe3, 0x52, 0x5a, 0x37, 0x83,
+	0x7f, 0x02, 0x42, 0xf4, 0xb1, 0x0f, 0x84, 0x8d, 0x04, 0xeb, 0xa4, 0xb9, 0xc8, 0x6e, 0x06, 0x61,
+	0x72, 0x03, 0x6b, 0xea, 0x10, 0x17, 0x46, 0x88, 0x3f, 0x55, 0xe2, 0x9a, 0x1e, 0xf1, 0xb9, 0x94,
+	0x9a, 0x8f, 0xf4, 0x6e, 0xe6, 0x67, 0x37, 0xc1, 0x61, 0xb3, 0x51, 0xc2, 0x6f, 0xe4, 0x11, 0x2d,
+	0xf8, 0x89, 0x82, 0x1e, 0x8c, 0xae, 0xe6, 0x1a, 0xf1, 0x5c, 0x4a, 0x2b, 0xf4, 0x88, 0xb6, 0x63,
+	0xf7, 0x69, 0xea, 0x3d, 0x18, 0x83, 0x16, 0xbf, 0xe3, 0x66, 0x4b, 0xa1, 0x56, 0x4f, 0xb9, 0xe4,
+	0x2f, 0xa1, 0x83, 0x54, 0x2e, 0xf9, 0x4b, 0xea, 0x2e, 0x4d, 0x9f, 0x47, 0x50, 0x46, 0x6e, 0xea,
+	0xc7, 0x85, 0xbf, 0xa3, 0xa0, 0x5d, 0xa1, 0x7e, 0x45, 0xb9, 0x3b, 0x46, 0x7c, 0x93, 0xa4, 0xdc,
+	0x1d, 0x23, 0xa1, 0x41, 0x32, 0xf5, 0xcd, 0xd6, 0xbb, 0xc8, 0x56, 0x19, 0x23, 0xff, 0x6a, 0xeb,
+	0x06, 0xcc, 0xbf, 0x29, 0x68, 0x4f, 0x4c, 0x43, 0x1e, 0x4e, 0x75, 0xb8, 0x46, 0x3b, 0x01, 0xe5,
+	0xb2, 0xbf, 0x0e, 0x9d, 0x80, 0xea, 0x33, 0x14, 0xe6, 0x02, 0xbe, 0x90, 0x3a, 0xa8, 0xf8, 0xcc,
+	0xc4, 0x8d, 0xe7, 0x7a, 0x65, 0xb8, 0xd1, 0x4c, 0xce, 0x2b, 0x13, 0x1a, 0xe0, 0xe4, 0xbc, 0x32,
+	0xa9, 0xb7, 0x2d, 0xb5, 0x57, 0xf2, 0xfb, 0xbb, 0xe6, 0x09, 0xff, 0x47, 0xd7, 0x74, 0xd1, 0x66,
+	0x2c, 0x49, 0xd3, 0x25, 0xf6, 0x7f, 0x49, 0x9a, 0x2e, 0xb9, 0x0b, 0x2c, 0x75, 0x7d, 0x9d, 0x43,
+	0xab, 0x8a, 0x28, 0x3e, 0x55, 0x12, 0x7b, 0x93, 0x66, 0x53, 0xf9, 0x56, 0x5c, 0x9f, 0x4e, 0xbe,
+	0xb4, 0x19, 0x16, 0x00, 0xf3, 0x22, 0x85, 0x39, 0x8b, 0x67, 0x52, 0x7b, 0x28, 0xe0, 0xb5, 0x01,
+	0xcf, 0x7b, 0x7e, 0x63, 0x0a, 0x6b, 0xdc, 0x90, 0xbc, 0xfe, 0xc7, 0xb4, 0xd3, 0xe4, 0x27, 0x7b,
+	0xa0, 0x04, 0x3c, 0x4f, 0x51, 0x3c, 0xe7, 0xf1, 0x5c, 0x4a, 0xb3, 0x39, 0x94, 0x4d, 0x71, 0x5d,
+	0xe8, 0x27, 0xd9, 0xc0, 0x6f, 0x2a, 0x68, 0x57, 0xa8, 0x53, 0x42, 0x2e, 0x64, 0xc6, 0xb7, 0x71,
+	0xc8, 0x85, 0xcc, 0x84, 0xd6, 0x8c, 0xd4, 0x09, 0x4a, 0x9b, 0xf2, 0xf1, 0xaa, 0x9c, 0x36, 0x2d,
+	0x00, 0x84, 0x3f, 0xe2, 0x4a, 0x06, 0x8f, 0xf8, 0x4e, 0x00, 0xc9, 0xe0, 0x91, 0xf0, 0xd5, 0x3e,
+	0xbd, 0xa9, 0x42, 0xae, 0x17, 0xb8, 0xb8, 0xfc, 0x5b, 0x41, 0xa3, 0x49, 0x7d, 0x02, 0x78, 0x2e,
+	0xcd, 0x51, 0x95, 0x04, 0x76, 0x7e, 0x73, 0x4c, 0x00, 0xf4, 0xb3, 0x14, 0xf4, 0x22, 0x7e, 0x26,
+	0xed, 0xc1, 0x17, 0x83, 0x5e, 0x3c, 0x02, 0xbf, 0xd5, 0x87, 0x46, 0x93, 0xbe, 0xd0, 0xcb, 0xe1,
+	0xef, 0xd2, 0x90, 0x20, 0x87, 0xbf, 0x5b, 0x93, 0x80, 0x5a, 0xa5, 0xf8, 0x9f, 0xc7, 0xcf, 0xf5,
+	0x6a, 0xf4, 0xe5, 0x95, 0xb5, 0xe5, 0x3a, 0xe5, 0x59, 0x5c, 0x17, 0xba, 0x21, 0x36, 0x8a, 0xeb,
+	0x5e, 0xef, 0xc3, 0x46, 0xa9, 0xfc, 0xfa, 0xdd, 0x31, 0xe5, 0xdd, 0xbb, 0x63, 0xca, 0x27, 0x77,
+	0xc7, 0x94, 0xef, 0xde, 0x1b, 0xdb, 0xf2, 0xee, 0xbd, 0xb1, 0x2d, 0x77, 0xee, 0x8d, 0x6d, 0xf9,
+	0xf2, 0x69, 0xa1, 0x37, 0x2d, 0x41, 0x82, 0xdb, 0x61, 0x19, 0x68, 0xc7, 0xda, 0xca, 0x56, 0xfa,
+	0xaf, 0xd2, 0x9d, 0xf8, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x42, 0x16, 0x46, 0x8f, 0x51,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of FileEntry items.
+	FileEntry(ctx context.Context, in *QueryGetFileEntryRequest, opts ...grpc.CallOption) (*QueryGetFileEntryResponse, error)
+	FileEntryAll(ctx context.Context, in *QueryAllFileEntryRequest, opts ...grpc.CallOption) (*QueryAllFileEntryResponse, error)
+	// Queries a list of HostingInquiry items.
+	HostingInquiry(ctx context.Context, in *QueryGetHostingInquiryRequest, opts ...grpc.CallOption) (*QueryGetHostingInquiryResponse, error)
+	HostingInquiryAll(ctx context.Context, in *QueryAllHostingInquiryRequest, opts ...grpc.CallOption) (*QueryAllHostingInquiryResponse, error)
+	// Queries a list of HostingContract items.
+	HostingContract(ctx context.Context, in *QueryGetHostingContractRequest, opts ...grpc.CallOption) (*QueryGetHostingContractResponse, error)
+	HostingContractAll(ctx context.Context, in *QueryAllHostingContractRequest, opts ...grpc.CallOption) (*QueryAllHostingContractResponse, error)
+	// Queries a list of HostingOffer items.
+	HostingOffer(ctx context.Context, in *QueryGetHostingOfferRequest, opts ...grpc.CallOption) (*QueryGetHostingOfferResponse, error)
+	HostingOfferAll(ctx context.Context, in *QueryAllHostingOfferRequest, opts ...grpc.CallOption) (*QueryAllHostingOfferResponse, error)
+	// Queries a list of ListHostingContractFrom items.
+	ListHostingContractFrom(ctx context.Context, in *QueryListHostingContractFromRequest, opts ...grpc.CallOption) (*QueryListHostingContractFromResponse, error)
+	// Queries payment history for a specific contract.
+	PaymentHistory(ctx context.Context, in *QueryPaymentHistoryRequest, opts ...grpc.CallOption) (*QueryPaymentHistoryResponse, error)
+	// Queries all payment history records.
+	PaymentHistoryAll(ctx context.Context, in *QueryAllPaymentHistoryRequest, opts ...grpc.CallOption) (*QueryAllPaymentHistoryResponse, error)
+	// Queries escrow record for a specific inquiry.
+	EscrowRecord(ctx context.Context, in *QueryEscrowRecordRequest, opts ...grpc.CallOption) (*QueryEscrowRecordResponse, error)
+	// Queries all escrow records.
+	EscrowRecordAll(ctx context.Context, in *QueryAllEscrowRecordRequest, opts ...grpc.CallOption) (*QueryAllEscrowRecordResponse, error)
+	// Queries provider stake for a specific address.
+	ProviderStake(ctx context.Context, in *QueryProviderStakeRequest, opts ...grpc.CallOption) (*QueryProviderStakeResponse, error)
+	// Queries all provider stakes.
+	ProviderStakeAll(ctx context.Context, in *QueryAllProviderStakeRequest, opts ...grpc.CallOption) (*QueryAllProviderStakeResponse, error)
+	// Queries a list of StorageChallenge items.
+	StorageChallenge(ctx context.Context, in *QueryGetStorageChallengeRequest, opts ...grpc.CallOption) (*QueryGetStorageChallengeResponse, error)
+	StorageChallengeAll(ctx context.Context, in *QueryAllStorageChallengeRequest, opts ...grpc.CallOption) (*QueryAllStorageChallengeResponse, error)
+	// Queries a list of SlashEvent items.
+	SlashEvent(ctx context.Context, in *QueryGetSlashEventRequest, opts ...grpc.CallOption) (*QueryGetSlashEventResponse, error)
+	SlashEventAll(ctx context.Context, in *QueryAllSlashEventRequest, opts ...grpc.CallOption) (*QueryAllSlashEventResponse, error)
+	// Queries the pending unbonding entries of a provider.
+	ProviderUnbondings(ctx context.Context, in *QueryProviderUnbondingsRequest, opts ...grpc.CallOption) (*QueryProviderUnbondingsResponse, error)
+	// Queries the inquiries that have not ended and still have unfilled replicas.
+	OpenInquiries(ctx context.Context, in *QueryOpenInquiriesRequest, opts ...grpc.CallOption) (*QueryOpenInquiriesResponse, error)
+	// Queries the auction of an inquiry and its bids.
+	Auction(ctx context.Context, in *QueryGetAuctionRequest, opts ...grpc.CallOption) (*QueryGetAuctionResponse, error)
+	// Queries a list of ProviderProfile items.
+	ProviderProfile(ctx context.Context, in *QueryGetProviderProfileRequest, opts ...grpc.CallOption) (*QueryGetProviderProfileResponse, error)
+	ProviderProfileAll(ctx context.Context, in *QueryAllProviderProfileRequest, opts ...grpc.CallOption) (*QueryAllProviderProfileResponse, error)
+	// Queries the reputation of a provider, decayed to the current block.
+	ProviderReputation(ctx context.Context, in *QueryGetProviderReputationRequest, opts ...grpc.CallOption) (*QueryGetProviderReputationResponse, error)
+	ProviderReputationAll(ctx context.Context, in *QueryAllProviderReputationRequest, opts ...grpc.CallOption) (*QueryAllProviderReputationResponse, error)
+	// Queries the number of records of each kind and of active and expired contracts.
+	SystemStatistics(ctx context.Context, in *QuerySystemStatisticsRequest, opts ...grpc.CallOption) (*QuerySystemStatisticsResponse, error)
+	// Queries a contract together with its inquiry, offer, payment history and escrow record.
+	ContractDetails(ctx context.Context, in *QueryContractDetailsRequest, opts ...grpc.CallOption) (*QueryContractDetailsResponse, error)
+	// Queries the contract, earnings and reputation figures of a provider.
+	ProviderPerformance(ctx context.Context, in *QueryProviderPerformanceRequest, opts ...grpc.CallOption) (*QueryProviderPerformanceResponse, error)
+	// Queries the amounts paid per denom and the number of settled and running payment histories.
+	PaymentAnalytics(ctx context.Context, in *QueryPaymentAnalyticsRequest, opts ...grpc.CallOption) (*QueryPaymentAnalyticsResponse, error)
+	// Queries the spread of the amounts paid per contract in a denom.
+	PaymentDistribution(ctx context.Context, in *QueryPaymentDistributionRequest, opts ...grpc.CallOption) (*QueryPaymentDistributionResponse, error)
+	// Queries the payments received by each staked provider.
+	ProviderPaymentSummary(ctx context.Context, in *QueryProviderPaymentSummaryRequest, opts ...grpc.CallOption) (*QueryProviderPaymentSummaryResponse, error)
+	// Queries the provider payments released per window of blocks.
+	PaymentTrends(ctx context.Context, in *QueryPaymentTrendsRequest, opts ...grpc.CallOption) (*QueryPaymentTrendsResponse, error)
+	// Queries the started contracts that have no payment history.
+	UnpaidContracts(ctx context.Context, in *QueryUnpaidContractsRequest, opts ...grpc.CallOption) (*QueryUnpaidContractsResponse, error)
+	// Queries the payments released to a provider.
+	ProviderPayments(ctx context.Context, in *QueryProviderPaymentsRequest, opts ...grpc.CallOption) (*QueryProviderPaymentsResponse, error)
+	// Queries the payments released to the providers of a contract.
+	ContractProviderPayments(ctx context.Context, in *QueryContractProviderPaymentsRequest, opts ...grpc.CallOption) (*QueryContractProviderPaymentsResponse, error)
+	// Queries the payments released to providers within a range of blocks.
+	ProviderPaymentsByHeight(ctx context.Context, in *QueryProviderPaymentsByHeightRequest, opts ...grpc.CallOption) (*QueryProviderPaymentsByHeightResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FileEntry(ctx context.Context, in *QueryGetFileEntryRequest, opts ...grpc.CallOption) (*QueryGetFileEntryResponse, error) {
+	out := new(QueryGetFileEntryResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/FileEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FileEntryAll(ctx context.Context, in *QueryAllFileEntryRequest, opts ...grpc.CallOption) (*QueryAllFileEntryResponse, error) {
+	out := new(QueryAllFileEntryResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/FileEntryAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HostingInquiry(ctx context.Context, in *QueryGetHostingInquiryRequest, opts ...grpc.CallOption) (*QueryGetHostingInquiryResponse, error) {
+	out := new(QueryGetHostingInquiryResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/HostingInquiry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HostingInquiryAll(ctx context.Context, in *QueryAllHostingInquiryRequest, opts ...grpc.CallOption) (*QueryAllHostingInquiryResponse, error) {
+	out := new(QueryAllHostingInquiryResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/HostingInquiryAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HostingContract(ctx context.Context, in *QueryGetHostingContractRequest, opts ...grpc.CallOption) (*QueryGetHostingContractResponse, error) {
+	out := new(QueryGetHostingContractResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/HostingContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HostingContractAll(ctx context.Context, in *QueryAllHostingContractRequest, opts ...grpc.CallOption) (*QueryAllHostingContractResponse, error) {
+	out := new(QueryAllHostingContractResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/HostingContractAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HostingOffer(ctx context.Context, in *QueryGetHostingOfferRequest, opts ...grpc.CallOption) (*QueryGetHostingOfferResponse, error) {
+	out := new(QueryGetHostingOfferResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/HostingOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HostingOfferAll(ctx context.Context, in *QueryAllHostingOfferRequest, opts ...grpc.CallOption) (*QueryAllHostingOfferResponse, error) {
+	out := new(QueryAllHostingOfferResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/HostingOfferAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListHostingContractFrom(ctx context.Context, in *QueryListHostingContractFromRequest, opts ...grpc.CallOption) (*QueryListHostingContractFromResponse, error) {
+	out := new(QueryListHostingContractFromResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/ListHostingContractFrom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymentHistory(ctx context.Context, in *QueryPaymentHistoryRequest, opts ...grpc.CallOption) (*QueryPaymentHistoryResponse, error) {
+	out := new(QueryPaymentHistoryResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/PaymentHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymentHistoryAll(ctx context.Context, in *QueryAllPaymentHistoryRequest, opts ...grpc.CallOption) (*QueryAllPaymentHistoryResponse, error) {
+	out := new(QueryAllPaymentHistoryResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/PaymentHistoryAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowRecord(ctx context.Context, in *QueryEscrowRecordRequest, opts ...grpc.CallOption) (*QueryEscrowRecordResponse, error) {
+	out := new(QueryEscrowRecordResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/EscrowRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowRecordAll(ctx context.Context, in *QueryAllEscrowRecordRequest, opts ...grpc.CallOption) (*QueryAllEscrowRecordResponse, error) {
+	out := new(QueryAllEscrowRecordResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/EscrowRecordAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderStake(ctx context.Context, in *QueryProviderStakeRequest, opts ...grpc.CallOption) (*QueryProviderStakeResponse, error) {
+	out := new(QueryProviderStakeResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/ProviderStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderStakeAll(ctx context.Context, in *QueryAllProviderStakeRequest, opts ...grpc.CallOption) (*QueryAllProviderStakeResponse, error) {
+	out := new(QueryAllProviderStakeResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/ProviderStakeAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageChallenge(ctx context.Context, in *QueryGetStorageChallengeRequest, opts ...grpc.CallOption) (*QueryGetStorageChallengeResponse, error) {
+	out := new(QueryGetStorageChallengeResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/StorageChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageChallengeAll(ctx context.Context, in *QueryAllStorageChallengeRequest, opts ...grpc.CallOption) (*QueryAllStorageChallengeResponse, error) {
+	out := new(QueryAllStorageChallengeResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/StorageChallengeAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashEvent(ctx context.Context, in *QueryGetSlashEventRequest, opts ...grpc.CallOption) (*QueryGetSlashEventResponse, error) {
+	out := new(QueryGetSlashEventResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/SlashEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashEventAll(ctx context.Context, in *QueryAllSlashEventRequest, opts ...grpc.CallOption) (*QueryAllSlashEventResponse, error) {
+	out := new(QueryAllSlashEventResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/SlashEventAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderUnbondings(ctx context.Context, in *QueryProviderUnbondingsRequest, opts ...grpc.CallOption) (*QueryProviderUnbondingsResponse, error) {
+	out := new(QueryProviderUnbondingsResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/ProviderUnbondings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OpenInquiries(ctx context.Context, in *QueryOpenInquiriesRequest, opts ...grpc.CallOption) (*QueryOpenInquiriesResponse, error) {
+	out := new(QueryOpenInquiriesResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/OpenInquiries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Auction(ctx context.Context, in *QueryGetAuctionRequest, opts ...grpc.CallOption) (*QueryGetAuctionResponse, error) {
+	out := new(QueryGetAuctionResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/Auction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderProfile(ctx context.Context, in *QueryGetProviderProfileRequest, opts ...grpc.CallOption) (*QueryGetProviderProfileResponse, error) {
+	out := new(QueryGetProviderProfileResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/ProviderProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderProfileAll(ctx context.Context, in *QueryAllProviderProfileRequest, opts ...grpc.CallOption) (*QueryAllProviderProfileResponse, error) {
+	out := new(QueryAllProviderProfileResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/ProviderProfileAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderReputation(ctx context.Context, in *QueryGetProviderReputationRequest, opts ...grpc.CallOption) (*QueryGetProviderReputationResponse, error) {
+	out := new(QueryGetProviderReputationResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/ProviderReputation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderReputationAll(ctx context.Context, in *QueryAllProviderReputationRequest, opts ...grpc.CallOption) (*QueryAllProviderReputationResponse, error) {
+	out := new(QueryAllProviderReputationResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/ProviderReputationAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SystemStatistics(ctx context.Context, in *QuerySystemStatisticsRequest, opts ...grpc.CallOption) (*QuerySystemStatisticsResponse, error) {
+	out := new(QuerySystemStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/SystemStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractDetails(ctx context.Context, in *QueryContractDetailsRequest, opts ...grpc.CallOption) (*QueryContractDetailsResponse, error) {
+	out := new(QueryContractDetailsResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/ContractDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderPerformance(ctx context.Context, in *QueryProviderPerformanceRequest, opts ...grpc.CallOption) (*QueryProviderPerformanceResponse, error) {
+	out := new(QueryProviderPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/ProviderPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymentAnalytics(ctx context.Context, in *QueryPaymentAnalyticsRequest, opts ...grpc.CallOption) (*QueryPaymentAnalyticsResponse, error) {
+	out := new(QueryPaymentAnalyticsResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/PaymentAnalytics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymentDistribution(ctx context.Context, in *QueryPaymentDistributionRequest, opts ...grpc.CallOption) (*QueryPaymentDistributionResponse, error) {
+	out := new(QueryPaymentDistributionResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/PaymentDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderPaymentSummary(ctx context.Context, in *QueryProviderPaymentSummaryRequest, opts ...grpc.CallOption) (*QueryProviderPaymentSummaryResponse, error) {
+	out := new(QueryProviderPaymentSummaryResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/ProviderPaymentSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymentTrends(ctx context.Context, in *QueryPaymentTrendsRequest, opts ...grpc.CallOption) (*QueryPaymentTrendsResponse, error) {
+	out := new(QueryPaymentTrendsResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/PaymentTrends", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnpaidContracts(ctx context.Context, in *QueryUnpaidContractsRequest, opts ...grpc.CallOption) (*QueryUnpaidContractsResponse, error) {
+	out := new(QueryUnpaidContractsResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/UnpaidContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderPayments(ctx context.Context, in *QueryProviderPaymentsRequest, opts ...grpc.CallOption) (*QueryProviderPaymentsResponse, error) {
+	out := new(QueryProviderPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/ProviderPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractProviderPayments(ctx context.Context, in *QueryContractProviderPaymentsRequest, opts ...grpc.CallOption) (*QueryContractProviderPaymentsResponse, error) {
+	out := new(QueryContractProviderPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/ContractProviderPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProviderPaymentsByHeight(ctx context.Context, in *QueryProviderPaymentsByHeightRequest, opts ...grpc.CallOption) (*QueryProviderPaymentsByHeightResponse, error) {
+	out := new(QueryProviderPaymentsByHeightResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Query/ProviderPaymentsByHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of FileEntry items.
+	FileEntry(context.Context, *QueryGetFileEntryRequest) (*QueryGetFileEntryResponse, error)
+	FileEntryAll(context.Context, *QueryAllFileEntryRequest) (*QueryAllFileEntryResponse, error)
+	// Queries a list of HostingInquiry items.
+	HostingInquiry(context.Context, *QueryGetHostingInquiryRequest) (*QueryGetHostingInquiryResponse, error)
+	HostingInquiryAll(context.Context, *QueryAllHostingInquiryRequest) (*QueryAllHostingInquiryResponse, error)
+	// Queries a list of HostingContract items.
+	HostingContract(context.Context, *QueryGetHostingContractRequest) (*QueryGetHostingContractResponse, error)
+	HostingContractAll(context.Context, *QueryAllHostingContractRequest) (*QueryAllHostingContractResponse, error)
+	// Queries a list of HostingOffer items.
+	HostingOffer(context.Context, *QueryGetHostingOfferRequest) (*QueryGetHostingOfferResponse, error)
+	HostingOfferAll(context.Context, *QueryAllHostingOfferRequest) (*QueryAllHostingOfferResponse, error)
+	// Queries a list of ListHostingContractFrom items.
+	ListHostingContractFrom(context.Context, *QueryListHostingContractFromRequest) (*QueryListHostingContractFromResponse, error)
+	// Queries payment history for a specific contract.
+	PaymentHistory(context.Context, *QueryPaymentHistoryRequest) (*QueryPaymentHistoryResponse, error)
+	// Queries all payment history records.
+	PaymentHistoryAll(context.Context, *QueryAllPaymentHistoryRequest) (*QueryAllPaymentHistoryResponse, error)
+	// Queries escrow record for a specific inquiry.
+	EscrowRecord(context.Context, *QueryEscrowRecordRequest) (*QueryEscrowRecordResponse, error)
+	// Queries all escrow records.
+	EscrowRecordAll(context.Context, *QueryAllEscrowRecordRequest) (*QueryAllEscrowRecordResponse, error)
+	// Queries provider stake for a specific address.
+	ProviderStake(context.Context, *QueryProviderStakeRequest) (*QueryProviderStakeResponse, error)
+	// Queries all provider stakes.
+	ProviderStakeAll(context.Context, *QueryAllProviderStakeRequest) (*QueryAllProviderStakeResponse, error)
+	// Queries a list of StorageChallenge items.
+	StorageChallenge(context.Context, *QueryGetStorageChallengeRequest) (*QueryGetStorageChallengeResponse, error)
+	StorageChallengeAll(context.Context, *QueryAllStorageChallengeRequest) (*QueryAllStorageChallengeResponse, error)
+	// Queries a list of SlashEvent items.
+	SlashEvent(context.Context, *QueryGetSlashEventRequest) (*QueryGetSlashEventResponse, error)
+	SlashEventAll(context.Context, *QueryAllSlashEventRequest) (*QueryAllSlashEventResponse, error)
+	// Queries the pending unbonding entries of a provider.
+	ProviderUnbondings(context.Context, *QueryProviderUnbondingsRequest) (*QueryProviderUnbondingsResponse, error)
+	// Queries the inquiries that have not ended and still have unfilled replicas.
+	OpenInquiries(context.Context, *QueryOpenInquiriesRequest) (*QueryOpenInquiriesResponse, error)
+	// Queries the auction of an inquiry and its bids.
+	Auction(context.Context, *QueryGetAuctionRequest) (*QueryGetAuctionResponse, error)
+	// Queries a list of ProviderProfile items.
+	ProviderProfile(context.Context, *QueryGetProviderProfileRequest) (*QueryGetProviderProfileResponse, error)
+	ProviderProfileAll(context.Context, *QueryAllProviderProfileRequest) (*QueryAllProviderProfileResponse, error)
+	// Queries the reputation of a provider, decayed to the current block.
+	ProviderReputation(context.Context, *QueryGetProviderReputationRequest) (*QueryGetProviderReputationResponse, error)
+	ProviderReputationAll(context.Context, *QueryAllProviderReputationRequest) (*QueryAllProviderReputationResponse, error)
+	// Queries the number of records of each kind and of active and expired contracts.
+	SystemStatistics(context.Context, *QuerySystemStatisticsRequest) (*QuerySystemStatisticsResponse, error)
+	// Queries a contract together with its inquiry, offer, payment history and escrow record.
+	ContractDetails(context.Context, *QueryContractDetailsRequest) (*QueryContractDetailsResponse, error)
+	// Queries the contract, earnings and reputation figures of a provider.
+	ProviderPerformance(context.Context, *QueryProviderPerformanceRequest) (*QueryProviderPerformanceResponse, error)
+	// Queries the amounts paid per denom and the number of settled and running payment histories.
+	PaymentAnalytics(context.Context, *QueryPaymentAnalyticsRequest) (*QueryPaymentAnalyticsResponse, error)
+	// Queries the spread of the amounts paid per contract in a denom.
+	PaymentDistribution(context.Context, *QueryPaymentDistributionRequest) (*QueryPaymentDistributionResponse, error)
+	// Queries the payments received by each staked provider.
+	ProviderPaymentSummary(context.Context, *QueryProviderPaymentSummaryRequest) (*QueryProviderPaymentSummaryResponse, error)
+	// Queries the provider payments released per window of blocks.
+	PaymentTrends(context.Context, *QueryPaymentTrendsRequest) (*QueryPaymentTrendsResponse, error)
+	// Queries the started contracts that have no payment history.
+	UnpaidContracts(context.Context, *QueryUnpaidContractsRequest) (*QueryUnpaidContractsResponse, error)
+	// Queries the payments released to a provider.
+	ProviderPayments(context.Context, *QueryProviderPaymentsRequest) (*QueryProviderPaymentsResponse, error)
+	// Queries the payments released to the providers of a contract.
+	ContractProviderPayments(context.Context, *QueryContractProviderPaymentsRequest) (*QueryContractProviderPaymentsResponse, error)
+	// Queries the payments released to providers within a range of blocks.
+	ProviderPaymentsByHeight(context.Context, *QueryProviderPaymentsByHeightRequest) (*QueryProviderPaymentsByHeightResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) FileEntry(ctx context.Context, req *QueryGetFileEntryRequest) (*QueryGetFileEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FileEntry not implemented")
+}
+func (*UnimplementedQueryServer) FileEntryAll(ctx context.Context, req *QueryAllFileEntryRequest) (*QueryAllFileEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FileEntryAll not implemented")
+}
+func (*UnimplementedQueryServer) HostingInquiry(ctx context.Context, req *QueryGetHostingInquiryRequest) (*QueryGetHostingInquiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostingInquiry not implemented")
+}
+func (*UnimplementedQueryServer) HostingInquiryAll(ctx context.Context, req *QueryAllHostingInquiryRequest) (*QueryAllHostingInquiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostingInquiryAll not implemented")
+}
+func (*UnimplementedQueryServer) HostingContract(ctx context.Context, req *QueryGetHostingContractRequest) (*QueryGetHostingContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostingContract not implemented")
+}
+func (*UnimplementedQueryServer) HostingContractAll(ctx context.Context, req *QueryAllHostingContractRequest) (*QueryAllHostingContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostingContractAll not implemented")
+}
+func (*UnimplementedQueryServer) HostingOffer(ctx context.Context, req *QueryGetHostingOfferRequest) (*QueryGetHostingOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostingOffer not implemented")
+}
+func (*UnimplementedQueryServer) HostingOfferAll(ctx context.Context, req *QueryAllHostingOfferRequest) (*QueryAllHostingOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostingOfferAll not implemented")
+}
+func (*UnimplementedQueryServer) ListHostingContractFrom(ctx context.Context, req *QueryListHostingContractFromRequest) (*QueryListHostingContractFromResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHostingContractFrom not implemented")
+}
+func (*UnimplementedQueryServer) PaymentHistory(ctx context.Context, req *QueryPaymentHistoryRequest) (*QueryPaymentHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentHistory not implemented")
+}
+func (*UnimplementedQueryServer) PaymentHistoryAll(ctx context.Context, req *QueryAllPaymentHistoryRequest) (*QueryAllPaymentHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentHistoryAll not implemented")
+}
+func (*UnimplementedQueryServer) EscrowRecord(ctx context.Context, req *QueryEscrowRecordRequest) (*QueryEscrowRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowRecord not implemented")
+}
+func (*UnimplementedQueryServer) EscrowRecordAll(ctx context.Context, req *QueryAllEscrowRecordRequest) (*QueryAllEscrowRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowRecordAll not implemented")
+}
+func (*UnimplementedQueryServer) ProviderStake(ctx context.Context, req *QueryProviderStakeRequest) (*QueryProviderStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderStake not implemented")
+}
+func (*UnimplementedQueryServer) ProviderStakeAll(ctx context.Context, req *QueryAllProviderStakeRequest) (*QueryAllProviderStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderStakeAll not implemented")
+}
+func (*UnimplementedQueryServer) StorageChallenge(ctx context.Context, req *QueryGetStorageChallengeRequest) (*QueryGetStorageChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageChallenge not implemented")
+}
+func (*UnimplementedQueryServer) StorageChallengeAll(ctx context.Context, req *QueryAllStorageChallengeRequest) (*QueryAllStorageChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageChallengeAll not implemented")
+}
+func (*UnimplementedQueryServer) SlashEvent(ctx context.Context, req *QueryGetSlashEventRequest) (*QueryGetSlashEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashEvent not implemented")
+}
+func (*UnimplementedQueryServer) SlashEventAll(ctx context.Context, req *QueryAllSlashEventRequest) (*QueryAllSlashEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashEventAll not implemented")
+}
+func (*UnimplementedQueryServer) ProviderUnbondings(ctx context.Context, req *QueryProviderUnbondingsRequest) (*QueryProviderUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderUnbondings not implemented")
+}
+func (*UnimplementedQueryServer) OpenInquiries(ctx context.Context, req *QueryOpenInquiriesRequest) (*QueryOpenInquiriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenInquiries not implemented")
+}
+func (*UnimplementedQueryServer) Auction(ctx context.Context, req *QueryGetAuctionRequest) (*QueryGetAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Auction not implemented")
+}
+func (*UnimplementedQueryServer) ProviderProfile(ctx context.Context, req *QueryGetProviderProfileRequest) (*QueryGetProviderProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderProfile not implemented")
+}
+func (*UnimplementedQueryServer) ProviderProfileAll(ctx context.Context, req *QueryAllProviderProfileRequest) (*QueryAllProviderProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderProfileAll not implemented")
+}
+func (*UnimplementedQueryServer) ProviderReputation(ctx context.Context, req *QueryGetProviderReputationRequest) (*QueryGetProviderReputationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderReputation not implemented")
+}
+func (*UnimplementedQueryServer) ProviderReputationAll(ctx context.Context, req *QueryAllProviderReputationRequest) (*QueryAllProviderReputationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderReputationAll not implemented")
+}
+func (*UnimplementedQueryServer) SystemStatistics(ctx context.Context, req *QuerySystemStatisticsRequest) (*QuerySystemStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemStatistics not implemented")
+}
+func (*UnimplementedQueryServer) ContractDetails(ctx context.Context, req *QueryContractDetailsRequest) (*QueryContractDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractDetails not implemented")
+}
+func (*UnimplementedQueryServer) ProviderPerformance(ctx context.Context, req *QueryProviderPerformanceRequest) (*QueryProviderPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderPerformance not implemented")
+}
+func (*UnimplementedQueryServer) PaymentAnalytics(ctx context.Context, req *QueryPaymentAnalyticsRequest) (*QueryPaymentAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentAnalytics not implemented")
+}
+func (*UnimplementedQueryServer) PaymentDistribution(ctx context.Context, req *QueryPaymentDistributionRequest) (*QueryPaymentDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentDistribution not implemented")
+}
+func (*UnimplementedQueryServer) ProviderPaymentSummary(ctx context.Context, req *QueryProviderPaymentSummaryRequest) (*QueryProviderPaymentSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderPaymentSummary not implemented")
+}
+func (*UnimplementedQueryServer) PaymentTrends(ctx context.Context, req *QueryPaymentTrendsRequest) (*QueryPaymentTrendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentTrends not implemented")
+}
+func (*UnimplementedQueryServer) UnpaidContracts(ctx context.Context, req *QueryUnpaidContractsRequest) (*QueryUnpaidContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpaidContracts not implemented")
+}
+func (*UnimplementedQueryServer) ProviderPayments(ctx context.Context, req *QueryProviderPaymentsRequest) (*QueryProviderPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderPayments not implemented")
+}
+func (*UnimplementedQueryServer) ContractProviderPayments(ctx context.Context, req *QueryContractProviderPaymentsRequest) (*QueryContractProviderPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractProviderPayments not implemented")
+}
+func (*UnimplementedQueryServer) ProviderPaymentsByHeight(ctx context.Context, req *QueryProviderPaymentsByHeightRequest) (*QueryProviderPaymentsByHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderPaymentsByHeight not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FileEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetFileEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FileEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/FileEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FileEntry(ctx, req.(*QueryGetFileEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FileEntryAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllFileEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FileEntryAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/FileEntryAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FileEntryAll(ctx, req.(*QueryAllFileEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostingInquiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetHostingInquiryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostingInquiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/HostingInquiry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostingInquiry(ctx, req.(*QueryGetHostingInquiryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostingInquiryAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllHostingInquiryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostingInquiryAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/HostingInquiryAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostingInquiryAll(ctx, req.(*QueryAllHostingInquiryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostingContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetHostingContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostingContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/HostingContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostingContract(ctx, req.(*QueryGetHostingContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostingContractAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllHostingContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostingContractAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/HostingContractAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostingContractAll(ctx, req.(*QueryAllHostingContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostingOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetHostingOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostingOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/HostingOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostingOffer(ctx, req.(*QueryGetHostingOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostingOfferAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllHostingOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostingOfferAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/HostingOfferAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostingOfferAll(ctx, req.(*QueryAllHostingOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListHostingContractFrom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListHostingContractFromRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListHostingContractFrom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/ListHostingContractFrom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListHostingContractFrom(ctx, req.(*QueryListHostingContractFromRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/PaymentHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentHistory(ctx, req.(*QueryPaymentHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentHistoryAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPaymentHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentHistoryAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/PaymentHistoryAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentHistoryAll(ctx, req.(*QueryAllPaymentHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/EscrowRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowRecord(ctx, req.(*QueryEscrowRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowRecordAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllEscrowRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowRecordAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/EscrowRecordAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowRecordAll(ctx, req.(*QueryAllEscrowRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/ProviderStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderStake(ctx, req.(*QueryProviderStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderStakeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllProviderStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderStakeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/ProviderStakeAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderStakeAll(ctx, req.(*QueryAllProviderStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetStorageChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/StorageChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageChallenge(ctx, req.(*QueryGetStorageChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageChallengeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllStorageChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageChallengeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/StorageChallengeAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageChallengeAll(ctx, req.(*QueryAllStorageChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSlashEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/SlashEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashEvent(ctx, req.(*QueryGetSlashEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashEventAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSlashEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashEventAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/SlashEventAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashEventAll(ctx, req.(*QueryAllSlashEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderUnbondingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/ProviderUnbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderUnbondings(ctx, req.(*QueryProviderUnbondingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OpenInquiries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOpenInquiriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OpenInquiries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/OpenInquiries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OpenInquiries(ctx, req.(*QueryOpenInquiriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Auction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Auction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/Auction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Auction(ctx, req.(*QueryGetAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProviderProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/ProviderProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderProfile(ctx, req.(*QueryGetProviderProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderProfileAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllProviderProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderProfileAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/ProviderProfileAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderProfileAll(ctx, req.(*QueryAllProviderProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderReputation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProviderReputationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderReputation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/ProviderReputation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderReputation(ctx, req.(*QueryGetProviderReputationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderReputationAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllProviderReputationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderReputationAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/ProviderReputationAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderReputationAll(ctx, req.(*QueryAllProviderReputationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SystemStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySystemStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SystemStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/SystemStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SystemStatistics(ctx, req.(*QuerySystemStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/ContractDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractDetails(ctx, req.(*QueryContractDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/ProviderPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderPerformance(ctx, req.(*QueryProviderPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/PaymentAnalytics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentAnalytics(ctx, req.(*QueryPaymentAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/PaymentDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentDistribution(ctx, req.(*QueryPaymentDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderPaymentSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderPaymentSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderPaymentSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/ProviderPaymentSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderPaymentSummary(ctx, req.(*QueryProviderPaymentSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentTrends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentTrendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentTrends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/PaymentTrends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentTrends(ctx, req.(*QueryPaymentTrendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnpaidContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnpaidContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnpaidContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/UnpaidContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnpaidContracts(ctx, req.(*QueryUnpaidContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/ProviderPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderPayments(ctx, req.(*QueryProviderPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractProviderPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractProviderPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractProviderPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/ContractProviderPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractProviderPayments(ctx, req.(*QueryContractProviderPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProviderPaymentsByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderPaymentsByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderPaymentsByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Query/ProviderPaymentsByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderPaymentsByHeight(ctx, req.(*QueryProviderPaymentsByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "filespacechain.filespacechain.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "FileEntry",
+			Handler:    _Query_FileEntry_Handler,
+		},
+		{
+			MethodName: "FileEntryAll",
+			Handler:    _Query_FileEntryAll_Handler,
+		},
+		{
+			MethodName: "HostingInquiry",
+			Handler:    _Query_HostingInquiry_Handler,
+		},
+		{
+			MethodName: "HostingInquiryAll",
+			Handler:    _Query_HostingInquiryAll_Handler,
+		},
+		{
+			MethodName: "HostingContract",
+			Handler:    _Query_HostingContract_Handler,
+		},
+		{
+			MethodName: "HostingContractAll",
+			Handler:    _Query_HostingContractAll_Handler,
+		},
+		{
+			MethodName: "HostingOffer",
+			Handler:    _Query_HostingOffer_Handler,
+		},
+		{
+			MethodName: "HostingOfferAll",
+			Handler:    _Query_HostingOfferAll_Handler,
+		},
+		{
+			MethodName: "ListHostingContractFrom",
+			Handler:    _Query_ListHostingContractFrom_Handler,
+		},
+		{
+			MethodName: "PaymentHistory",
+			Handler:    _Query_PaymentHistory_Handler,
+		},
+		{
+			MethodName: "PaymentHistoryAll",
+			Handler:    _Query_PaymentHistoryAll_Handler,
+		},
+		{
+			MethodName: "EscrowRecord",
+			Handler:    _Query_EscrowRecord_Handler,
+		},
+		{
+			MethodName: "EscrowRecordAll",
+			Handler:    _Query_EscrowRecordAll_Handler,
+		},
+		{
+			MethodName: "ProviderStake",
+			Handler:    _Query_ProviderStake_Handler,
+		},
+		{
+			MethodName: "ProviderStakeAll",
+			Handler:    _Query_ProviderStakeAll_Handler,
+		},
+		{
+			MethodName: "StorageChallenge",
+			Handler:    _Query_StorageChallenge_Handler,
+		},
+		{
+			MethodName: "StorageChallengeAll",
+			Handler:    _Query_StorageChallengeAll_Handler,
+		},
+		{
+			MethodName: "SlashEvent",
+			Handler:    _Query_SlashEvent_Handler,
+		},
+		{
+			MethodName: "SlashEventAll",
+			Handler:    _Query_SlashEventAll_Handler,
+		},
+		{
+			MethodName: "ProviderUnbondings",
+			Handler:    _Query_ProviderUnbondings_Handler,
+		},
+		{
+			MethodName: "OpenInquiries",
+			Handler:    _Query_OpenInquiries_Handler,
+		},
+		{
+			MethodName: "Auction",
+			Handler:    _Query_Auction_Handler,
+		},
+		{
+			MethodName: "ProviderProfile",
+			Handler:    _Query_ProviderProfile_Handler,
+		},
+		{
+			MethodName: "ProviderProfileAll",
+			Handler:    _Query_ProviderProfileAll_Handler,
+		},
+		{
+			MethodName: "ProviderReputation",
+			Handler:    _Query_ProviderReputation_Handler,
+		},
+		{
+			MethodName: "ProviderReputationAll",
+			Handler:    _Query_ProviderReputationAll_Handler,
+		},
+		{
+			MethodName: "SystemStatistics",
+			Handler:    _Query_SystemStatistics_Handler,
+		},
+		{
+			MethodName: "ContractDetails",
+			Handler:    _Query_ContractDetails_Handler,
+		},
+		{
+			MethodName: "ProviderPerformance",
+			Handler:    _Query_ProviderPerformance_Handler,
+		},
+		{
+			MethodName: "PaymentAnalytics",
+			Handler:    _Query_PaymentAnalytics_Handler,
+		},
+		{
+			MethodName: "PaymentDistribution",
+			Handler:    _Query_PaymentDistribution_Handler,
+		},
+		{
+			MethodName: "ProviderPaymentSummary",
+			Handler:    _Query_ProviderPaymentSummary_Handler,
+		},
+		{
+			MethodName: "PaymentTrends",
+			Handler:    _Query_PaymentTrends_Handler,
+		},
+		{
+			MethodName: "UnpaidContracts",
+			Handler:    _Query_UnpaidContracts_Handler,
+		},
+		{
+			MethodName: "ProviderPayments",
+			Handler:    _Query_ProviderPayments_Handler,
+		},
+		{
+			MethodName: "ContractProviderPayments",
+			Handler:    _Query_ContractProviderPayments_Handler,
+		},
+		{
+			MethodName: "ProviderPaymentsByHeight",
+			Handler:    _Query_ProviderPaymentsByHeight_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "filespacechain/filespacechain/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetFileEntryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetFileEntryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetFileEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetFileEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetFileEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetFileEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FileEntry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllFileEntryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllFileEntryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllFileEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllFileEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllFileEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllFileEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FileEntry) > 0 {
+		for iNdEx := len(m.FileEntry) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FileEntry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetHostingInquiryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetHostingInquiryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetHostingInquiryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetHostingInquiryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetHostingInquiryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetHostingInquiryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HostingInquiry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllHostingInquiryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllHostingInquiryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllHostingInquiryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllHostingInquiryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllHostingInquiryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllHostingInquiryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HostingInquiry) > 0 {
+		for iNdEx := len(m.HostingInquiry) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostingInquiry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetHostingContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetHostingContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetHostingContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetHostingContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetHostingContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetHostingContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HostingContract.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllHostingContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllHostingContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllHostingContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllHostingContractFromRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllHostingContractFromRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllHostingContractFromRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllHostingContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllHostingContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllHostingContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HostingContract) > 0 {
+		for iNdEx := len(m.HostingContract) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostingContract[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetHostingOfferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetHostingOfferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetHostingOfferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetHostingOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetHostingOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetHostingOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HostingOffer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllHostingOfferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllHostingOfferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllHostingOfferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllHostingOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllHostingOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllHostingOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HostingOffer) > 0 {
+		for iNdEx := len(m.HostingOffer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostingOffer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListHostingContractFromRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListHostingContractFromRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListHostingContractFromRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListHostingContractFromResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListHostingContractFromResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListHostingContractFromResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HostingContract) > 0 {
+		for iNdEx := len(m.HostingContract) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostingContract[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PaymentHistory.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPaymentHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPaymentHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPaymentHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPaymentHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPaymentHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPaymentHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PaymentHistory) > 0 {
+		for iNdEx := len(m.PaymentHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InquiryId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InquiryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EscrowRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllEscrowRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllEscrowRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllEscrowRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllEscrowRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllEscrowRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllEscrowRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EscrowRecord) > 0 {
+		for iNdEx := len(m.EscrowRecord) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowRecord[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProviderStake.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllProviderStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllProviderStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllProviderStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllProviderStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllProviderStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllProviderStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderStake) > 0 {
+		for iNdEx := len(m.ProviderStake) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderStake[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStorageChallengeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStorageChallengeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStorageChallengeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStorageChallengeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStorageChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStorageChallengeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StorageChallenge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllStorageChallengeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllStorageChallengeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllStorageChallengeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllStorageChallengeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllStorageChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllStorageChallengeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StorageChallenge) > 0 {
+		for iNdEx := len(m.StorageChallenge) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageChallenge[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSlashEventRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSlashEventRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSlashEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSlashEventResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSlashEventResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSlashEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SlashEvent.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSlashEventRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSlashEventRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSlashEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSlashEventResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSlashEventResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSlashEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SlashEvent) > 0 {
+		for iNdEx := len(m.SlashEvent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashEvent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderUnbondingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderUnbondingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UnbondingEntries) > 0 {
+		for iNdEx := len(m.UnbondingEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOpenInquiriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOpenInquiriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOpenInquiriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOpenInquiriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOpenInquiriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOpenInquiriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HostingInquiry) > 0 {
+		for iNdEx := len(m.HostingInquiry) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostingInquiry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAuctionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InquiryId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InquiryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProviderProfileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProviderProfileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProviderProfileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProviderProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProviderProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProviderProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProviderProfile.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllProviderProfileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllProviderProfileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllProviderProfileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllProviderProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllProviderProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllProviderProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderProfile) > 0 {
+		for iNdEx := len(m.ProviderProfile) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderProfile[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProviderReputationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProviderReputationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProviderReputationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProviderReputationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProviderReputationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProviderReputationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProviderReputation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllProviderReputationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllProviderReputationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllProviderReputationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllProviderReputationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllProviderReputationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllProviderReputationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderReputation) > 0 {
+		for iNdEx := len(m.ProviderReputation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderReputation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySystemStatisticsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySystemStatisticsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySystemStatisticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySystemStatisticsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySystemStatisticsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySystemStatisticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiredContracts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpiredContracts))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ActiveContracts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActiveContracts))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TotalProviders != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalProviders))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TotalEscrowRecords != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalEscrowRecords))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TotalPaymentHistories != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPaymentHistories))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalHostingContracts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalHostingContracts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalHostingOffers != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalHostingOffers))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalHostingInquiries != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalHostingInquiries))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TotalFileEntries != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalFileEntries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractDetailsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractDetailsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Term) > 0 {
+		i -= len(m.Term)
+		copy(dAtA[i:], m.Term)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Term)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EscrowRecord != nil {
+		{
+			size, err := m.EscrowRecord.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PaymentHistory != nil {
+		{
+			size, err := m.PaymentHistory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Offer != nil {
+		{
+			size, err := m.Offer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Inquiry != nil {
+		{
+			size, err := m.Inquiry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderPerformanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderPerformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderPerformanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalEarnings) > 0 {
+		for iNdEx := len(m.TotalEarnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalEarnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ReputationScore != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReputationScore))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TotalOffers != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalOffers))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CompletedContracts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CompletedContracts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ActiveContracts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActiveContracts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalContracts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalContracts))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Stake != nil {
+		{
+			size, err := m.Stake.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentAnalyticsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentAnalyticsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAnalyticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPaymentCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPaymentCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPaymentCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentAnalyticsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentAnalyticsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentAnalyticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingPayments != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PendingPayments))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CompletedPayments != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CompletedPayments))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PaymentCounts) > 0 {
+		for iNdEx := len(m.PaymentCounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TotalPaid) > 0 {
+		for iNdEx := len(m.TotalPaid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalPaid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TotalPaymentRecords != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPaymentRecords))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Percentile75.Size()
+		i -= size
+		if _, err := m.Percentile75.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Percentile50.Size()
+		i -= size
+		if _, err := m.Percentile50.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Percentile25.Size()
+		i -= size
+		if _, err := m.Percentile25.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Max.Size()
+		i -= size
+		if _, err := m.Max.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Min.Size()
+		i -= size
+		if _, err := m.Min.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Average.Size()
+		i -= size
+		if _, err := m.Average.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderPaymentSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderPaymentSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderPaymentSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalEarned) > 0 {
+		for iNdEx := len(m.TotalEarned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalEarned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.PendingContracts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PendingContracts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CompletedContracts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CompletedContracts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PaymentCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PaymentCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderPaymentSummaryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderPaymentSummaryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderPaymentSummaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderPaymentSummaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderPaymentSummaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderPaymentSummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Summaries) > 0 {
+		for iNdEx := len(m.Summaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Summaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentTrendsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentTrendsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentTrendsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockWindow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PaymentWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.PaymentCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PaymentCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EndBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentTrendsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentTrendsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentTrendsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.CurrentBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockWindow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnpaidContractsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnpaidContractsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnpaidContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnpaidContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnpaidContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnpaidContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HostingContract) > 0 {
+		for iNdEx := len(m.HostingContract) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostingContract[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderPaymentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderPaymentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderPaymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderPaymentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderPayments) > 0 {
+		for iNdEx := len(m.ProviderPayments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderPayments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractProviderPaymentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractProviderPaymentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractProviderPaymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractProviderPaymentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractProviderPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractProviderPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderPayments) > 0 {
+		for iNdEx := len(m.ProviderPayments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderPayments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderPaymentsByHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderPaymentsByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderPaymentsByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderPaymentsByHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderPaymentsByHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderPaymentsByHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderPayments) > 0 {
+		for iNdEx := len(m.ProviderPayments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderPayments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetFileEntryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetFileEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FileEntry.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllFileEntryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllFileEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FileEntry) > 0 {
+		for _, e := range m.FileEntry {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetHostingInquiryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetHostingInquiryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HostingInquiry.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllHostingInquiryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllHostingInquiryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HostingInquiry) > 0 {
+		for _, e := range m.HostingInquiry {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetHostingContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetHostingContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HostingContract.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllHostingContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllHostingContractFromRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllHostingContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HostingContract) > 0 {
+		for _, e := range m.HostingContract {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetHostingOfferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetHostingOfferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HostingOffer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllHostingOfferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllHostingOfferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HostingOffer) > 0 {
+		for _, e := range m.HostingOffer {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListHostingContractFromRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListHostingContractFromResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HostingContract) > 0 {
+		for _, e := range m.HostingContract {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovQuery(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *QueryPaymentHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PaymentHistory.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPaymentHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPaymentHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PaymentHistory) > 0 {
+		for _, e := range m.PaymentHistory {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InquiryId != 0 {
+		n += 1 + sovQuery(uint64(m.InquiryId))
+	}
+	return n
+}
+
+func (m *QueryEscrowRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EscrowRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllEscrowRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllEscrowRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EscrowRecord) > 0 {
+		for _, e := range m.EscrowRecord {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProviderStake.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllProviderStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllProviderStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProviderStake) > 0 {
+		for _, e := range m.ProviderStake {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetStorageChallengeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetStorageChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StorageChallenge.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllStorageChallengeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllStorageChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StorageChallenge) > 0 {
+		for _, e := range m.StorageChallenge {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSlashEventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetSlashEventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SlashEvent.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllSlashEventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllSlashEventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SlashEvent) > 0 {
+		for _, e := range m.SlashEvent {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderUnbondingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderUnbondingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnbondingEntries) > 0 {
+		for _, e := range m.UnbondingEntries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOpenInquiriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOpenInquiriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HostingInquiry) > 0 {
+		for _, e := range m.HostingInquiry {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAuctionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InquiryId != 0 {
+		n += 1 + sovQuery(uint64(m.InquiryId))
+	}
+	return n
+}
+
+func (m *QueryGetAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Auction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProviderProfileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProviderProfileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProviderProfile.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllProviderProfileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllProviderProfileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProviderProfile) > 0 {
+		for _, e := range m.ProviderProfile {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProviderReputationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProviderReputationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProviderReputation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllProviderReputationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllProviderReputationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProviderReputation) > 0 {
+		for _, e := range m.ProviderReputation {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySystemStatisticsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySystemStatisticsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalFileEntries != 0 {
+		n += 1 + sovQuery(uint64(m.TotalFileEntries))
+	}
+	if m.TotalHostingInquiries != 0 {
+		n += 1 + sovQuery(uint64(m.TotalHostingInquiries))
+	}
+	if m.TotalHostingOffers != 0 {
+		n += 1 + sovQuery(uint64(m.TotalHostingOffers))
+	}
+	if m.TotalHostingContracts != 0 {
+		n += 1 + sovQuery(uint64(m.TotalHostingContracts))
+	}
+	if m.TotalPaymentHistories != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPaymentHistories))
+	}
+	if m.TotalEscrowRecords != 0 {
+		n += 1 + sovQuery(uint64(m.TotalEscrowRecords))
+	}
+	if m.TotalProviders != 0 {
+		n += 1 + sovQuery(uint64(m.TotalProviders))
+	}
+	if m.ActiveContracts != 0 {
+		n += 1 + sovQuery(uint64(m.ActiveContracts))
+	}
+	if m.ExpiredContracts != 0 {
+		n += 1 + sovQuery(uint64(m.ExpiredContracts))
+	}
+	return n
+}
+
+func (m *QueryContractDetailsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovQuery(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *QueryContractDetailsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Contract.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Inquiry != nil {
+		l = m.Inquiry.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Offer != nil {
+		l = m.Offer.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PaymentHistory != nil {
+		l = m.PaymentHistory.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EscrowRecord != nil {
+		l = m.EscrowRecord.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Term)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Stake != nil {
+		l = m.Stake.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TotalContracts != 0 {
+		n += 1 + sovQuery(uint64(m.TotalContracts))
+	}
+	if m.ActiveContracts != 0 {
+		n += 1 + sovQuery(uint64(m.ActiveContracts))
+	}
+	if m.CompletedContracts != 0 {
+		n += 1 + sovQuery(uint64(m.CompletedContracts))
+	}
+	if m.TotalOffers != 0 {
+		n += 1 + sovQuery(uint64(m.TotalOffers))
+	}
+	if m.ReputationScore != 0 {
+		n += 1 + sovQuery(uint64(m.ReputationScore))
+	}
+	if len(m.TotalEarnings) > 0 {
+		for _, e := range m.TotalEarnings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPaymentAnalyticsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DenomPaymentCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryPaymentAnalyticsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalPaymentRecords != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPaymentRecords))
+	}
+	if len(m.TotalPaid) > 0 {
+		for _, e := range m.TotalPaid {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PaymentCounts) > 0 {
+		for _, e := range m.PaymentCounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CompletedPayments != 0 {
+		n += 1 + sovQuery(uint64(m.CompletedPayments))
+	}
+	if m.PendingPayments != 0 {
+		n += 1 + sovQuery(uint64(m.PendingPayments))
+	}
+	return n
+}
+
+func (m *QueryPaymentDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Average.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Min.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Max.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Percentile25.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Percentile50.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Percentile75.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ProviderPaymentSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PaymentCount != 0 {
+		n += 1 + sovQuery(uint64(m.PaymentCount))
+	}
+	if m.CompletedContracts != 0 {
+		n += 1 + sovQuery(uint64(m.CompletedContracts))
+	}
+	if m.PendingContracts != 0 {
+		n += 1 + sovQuery(uint64(m.PendingContracts))
+	}
+	if len(m.TotalEarned) > 0 {
+		for _, e := range m.TotalEarned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProviderPaymentSummaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderPaymentSummaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Summaries) > 0 {
+		for _, e := range m.Summaries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentTrendsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockWindow != 0 {
+		n += 1 + sovQuery(uint64(m.BlockWindow))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PaymentWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartBlock != 0 {
+		n += 1 + sovQuery(uint64(m.StartBlock))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovQuery(uint64(m.EndBlock))
+	}
+	if m.PaymentCount != 0 {
+		n += 1 + sovQuery(uint64(m.PaymentCount))
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPaymentTrendsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockWindow != 0 {
+		n += 1 + sovQuery(uint64(m.BlockWindow))
+	}
+	if m.CurrentBlock != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentBlock))
+	}
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnpaidContractsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnpaidContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HostingContract) > 0 {
+		for _, e := range m.HostingContract {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderPaymentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderPaymentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProviderPayments) > 0 {
+		for _, e := range m.ProviderPayments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractProviderPaymentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovQuery(uint64(m.ContractId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractProviderPaymentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProviderPayments) > 0 {
+		for _, e := range m.ProviderPayments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderPaymentsByHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderPaymentsByHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProviderPayments) > 0 {
+		for _, e := range m.ProviderPayments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetFileEntryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetFileEntryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetFileEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetFileEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetFileEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetFileEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileEntry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FileEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllFileEntryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllFileEntryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllFileEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllFileEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllFileEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllFileEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileEntry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileEntry = append(m.FileEntry, FileEntry{})
+			if err := m.FileEntry[len(m.FileEntry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetHostingInquiryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetHostingInquiryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetHostingInquiryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetHostingInquiryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetHostingInquiryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetHostingInquiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostingInquiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HostingInquiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllHostingInquiryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllHostingInquiryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllHostingInquiryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllHostingInquiryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllHostingInquiryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllHostingInquiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostingInquiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostingInquiry = append(m.HostingInquiry, HostingInquiry{})
+			if err := m.HostingInquiry[len(m.HostingInquiry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetHostingContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetHostingContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetHostingContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetHostingContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetHostingContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetHostingContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostingContract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HostingContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllHostingContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllHostingContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllHostingContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllHostingContractFromRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllHostingContractFromRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllHostingContractFromRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllHostingContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllHostingContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllHostingContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostingContract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostingContract = append(m.HostingContract, HostingContract{})
+			if err := m.HostingContract[len(m.HostingContract)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetHostingOfferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetHostingOfferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetHostingOfferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetHostingOfferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetHostingOfferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetHostingOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostingOffer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HostingOffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllHostingOfferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllHostingOfferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllHostingOfferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllHostingOfferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllHostingOfferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllHostingOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostingOffer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostingOffer = append(m.HostingOffer, HostingOffer{})
+			if err := m.HostingOffer[len(m.HostingOffer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListHostingContractFromRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListHostingContractFromRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListHostingContractFromRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListHostingContractFromResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListHostingContractFromResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListHostingContractFromResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostingContract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostingContract = append(m.HostingContract, HostingContract{})
+			if err := m.HostingContract[len(m.HostingContract)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymentHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PaymentHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPaymentHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPaymentHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPaymentHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPaymentHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPaymentHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPaymentHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentHistory = append(m.PaymentHistory, PaymentHistory{})
+			if err := m.PaymentHistory[len(m.PaymentHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+			}
+			m.InquiryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InquiryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllEscrowRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllEscrowRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllEscrowRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllEscrowRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllEscrowRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllEscrowRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowRecord = append(m.EscrowRecord, EscrowRecord{})
+			if err := m.EscrowRecord[len(m.EscrowRecord)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderStakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderStakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderStake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProviderStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllProviderStakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllProviderStakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllProviderStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllProviderStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllProviderStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllProviderStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderStake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderStake = append(m.ProviderStake, ProviderStake{})
+			if err := m.ProviderStake[len(m.ProviderStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStorageChallengeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStorageChallengeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStorageChallengeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStorageChallengeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStorageChallengeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStorageChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageChallenge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorageChallenge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllStorageChallengeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllStorageChallengeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllStorageChallengeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllStorageChallengeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllStorageChallengeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllStorageChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageChallenge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageChallenge = append(m.StorageChallenge, StorageChallenge{})
+			if err := m.StorageChallenge[len(m.StorageChallenge)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSlashEventRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSlashEventRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSlashEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSlashEventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSlashEventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSlashEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSlashEventRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSlashEventRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSlashEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSlashEventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSlashEventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSlashEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashEvent = append(m.SlashEvent, SlashEvent{})
+			if err := m.SlashEvent[len(m.SlashEvent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderUnbondingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderUnbondingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderUnbondingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderUnbondingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingEntries = append(m.UnbondingEntries, UnbondingEntry{})
+			if err := m.UnbondingEntries[len(m.UnbondingEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOpenInquiriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOpenInquiriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOpenInquiriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOpenInquiriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOpenInquiriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOpenInquiriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostingInquiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostingInquiry = append(m.HostingInquiry, HostingInquiry{})
+			if err := m.HostingInquiry[len(m.HostingInquiry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAuctionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAuctionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+			}
+			m.InquiryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InquiryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, AuctionBid{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProviderProfileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProviderProfileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProviderProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProviderProfileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProviderProfileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProviderProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProviderProfile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllProviderProfileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllProviderProfileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllProviderProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllProviderProfileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllProviderProfileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllProviderProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderProfile = append(m.ProviderProfile, ProviderProfile{})
+			if err := m.ProviderProfile[len(m.ProviderProfile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProviderReputationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProviderReputationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProviderReputationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProviderReputationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProviderReputationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProviderReputationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderReputation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProviderReputation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllProviderReputationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllProviderReputationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllProviderReputationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllProviderReputationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllProviderReputationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllProviderReputationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderReputation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderReputation = append(m.ProviderReputation, ProviderReputation{})
+			if err := m.ProviderReputation[len(m.ProviderReputation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySystemStatisticsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySystemStatisticsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySystemStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySystemStatisticsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySystemStatisticsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySystemStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFileEntries", wireType)
+			}
+			m.TotalFileEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalFileEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalHostingInquiries", wireType)
+			}
+			m.TotalHostingInquiries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalHostingInquiries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalHostingOffers", wireType)
+			}
+			m.TotalHostingOffers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalHostingOffers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalHostingContracts", wireType)
+			}
+			m.TotalHostingContracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalHostingContracts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPaymentHistories", wireType)
+			}
+			m.TotalPaymentHistories = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPaymentHistories |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEscrowRecords", wireType)
+			}
+			m.TotalEscrowRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalEscrowRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalProviders", wireType)
+			}
+			m.TotalProviders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalProviders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveContracts", wireType)
+			}
+			m.ActiveContracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveContracts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredContracts", wireType)
+			}
+			m.ExpiredContracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiredContracts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryProviderStakeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryProviderStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ProviderStake.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryAllProviderStakeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryAllProviderStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ProviderStake) > 0 {
-		for _, e := range m.ProviderStake {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractDetailsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4044,12 +15792,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractDetailsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4071,7 +15838,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractDetailsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4094,15 +15861,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractDetailsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4129,65 +15896,51 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inquiry", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetFileEntryRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Inquiry == nil {
+				m.Inquiry = &HostingInquiry{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetFileEntryRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetFileEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if err := m.Inquiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Id = 0
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offer", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4197,64 +15950,67 @@ func (m *QueryGetFileEntryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetFileEntryResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if m.Offer == nil {
+				m.Offer = &HostingOffer{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.Offer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentHistory", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetFileEntryResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetFileEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PaymentHistory == nil {
+				m.PaymentHistory = &PaymentHistory{}
+			}
+			if err := m.PaymentHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileEntry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowRecord", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4281,10 +16037,45 @@ func (m *QueryGetFileEntryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FileEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.EscrowRecord == nil {
+				m.EscrowRecord = &EscrowRecord{}
+			}
+			if err := m.EscrowRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Term = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4306,7 +16097,7 @@ func (m *QueryGetFileEntryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllFileEntryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderPerformanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4329,17 +16120,17 @@ func (m *QueryAllFileEntryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllFileEntryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderPerformanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllFileEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4349,27 +16140,23 @@ func (m *QueryAllFileEntryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4392,7 +16179,7 @@ func (m *QueryAllFileEntryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllFileEntryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderPerformanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4415,15 +16202,15 @@ func (m *QueryAllFileEntryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllFileEntryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderPerformanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllFileEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileEntry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4450,16 +16237,18 @@ func (m *QueryAllFileEntryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileEntry = append(m.FileEntry, FileEntry{})
-			if err := m.FileEntry[len(m.FileEntry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Stake == nil {
+				m.Stake = &ProviderStake{}
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalContracts", wireType)
 			}
-			var msglen int
+			m.TotalContracts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4469,83 +16258,92 @@ func (m *QueryAllFileEntryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TotalContracts |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveContracts", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ActiveContracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveContracts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedContracts", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			m.CompletedContracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedContracts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalOffers", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetHostingInquiryRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.TotalOffers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalOffers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputationScore", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ReputationScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReputationScore |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetHostingInquiryRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetHostingInquiryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEarnings", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4555,11 +16353,26 @@ func (m *QueryGetHostingInquiryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalEarnings = append(m.TotalEarnings, types.Coin{})
+			if err := m.TotalEarnings[len(m.TotalEarnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4581,7 +16394,7 @@ func (m *QueryGetHostingInquiryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetHostingInquiryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAnalyticsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4604,45 +16417,12 @@ func (m *QueryGetHostingInquiryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetHostingInquiryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAnalyticsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetHostingInquiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAnalyticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostingInquiry", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.HostingInquiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4664,7 +16444,7 @@ func (m *QueryGetHostingInquiryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllHostingInquiryRequest) Unmarshal(dAtA []byte) error {
+func (m *DenomPaymentCount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4687,17 +16467,17 @@ func (m *QueryAllHostingInquiryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllHostingInquiryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomPaymentCount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllHostingInquiryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomPaymentCount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4707,28 +16487,43 @@ func (m *QueryAllHostingInquiryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4750,7 +16545,7 @@ func (m *QueryAllHostingInquiryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllHostingInquiryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentAnalyticsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4773,15 +16568,34 @@ func (m *QueryAllHostingInquiryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllHostingInquiryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentAnalyticsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllHostingInquiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentAnalyticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPaymentRecords", wireType)
+			}
+			m.TotalPaymentRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPaymentRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostingInquiry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPaid", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4808,14 +16622,14 @@ func (m *QueryAllHostingInquiryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostingInquiry = append(m.HostingInquiry, HostingInquiry{})
-			if err := m.HostingInquiry[len(m.HostingInquiry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalPaid = append(m.TotalPaid, types.Coin{})
+			if err := m.TotalPaid[len(m.TotalPaid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentCounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4842,68 +16656,35 @@ func (m *QueryAllHostingInquiryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PaymentCounts = append(m.PaymentCounts, DenomPaymentCount{})
+			if err := m.PaymentCounts[len(m.PaymentCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetHostingContractRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedPayments", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.CompletedPayments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedPayments |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetHostingContractRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetHostingContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPayments", wireType)
 			}
-			m.Id = 0
+			m.PendingPayments = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4913,7 +16694,7 @@ func (m *QueryGetHostingContractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.PendingPayments |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4939,7 +16720,7 @@ func (m *QueryGetHostingContractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetHostingContractResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentDistributionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4962,17 +16743,17 @@ func (m *QueryGetHostingContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetHostingContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentDistributionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetHostingContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostingContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4982,24 +16763,23 @@ func (m *QueryGetHostingContractResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HostingContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5022,7 +16802,7 @@ func (m *QueryGetHostingContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllHostingContractRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5045,17 +16825,36 @@ func (m *QueryAllHostingContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllHostingContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllHostingContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5065,83 +16864,99 @@ func (m *QueryAllHostingContractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Average", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllHostingContractFromRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.Average.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllHostingContractFromRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllHostingContractFromRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5151,31 +16966,29 @@ func (m *QueryAllHostingContractFromRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentile25", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5203,63 +17016,15 @@ func (m *QueryAllHostingContractFromRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Percentile25.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllHostingContractResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllHostingContractResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllHostingContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostingContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentile50", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5269,31 +17034,31 @@ func (m *QueryAllHostingContractResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostingContract = append(m.HostingContract, HostingContract{})
-			if err := m.HostingContract[len(m.HostingContract)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Percentile50.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentile75", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5303,25 +17068,23 @@ func (m *QueryAllHostingContractResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Percentile75.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5346,7 +17109,7 @@ func (m *QueryAllHostingContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetHostingOfferRequest) Unmarshal(dAtA []byte) error {
+func (m *ProviderPaymentSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5369,17 +17132,17 @@ func (m *QueryGetHostingOfferRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetHostingOfferRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProviderPaymentSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetHostingOfferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProviderPaymentSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5389,66 +17152,29 @@ func (m *QueryGetHostingOfferRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetHostingOfferResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetHostingOfferResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetHostingOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostingOffer", wireType)
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentCount", wireType)
 			}
-			var msglen int
+			m.PaymentCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5458,78 +17184,52 @@ func (m *QueryGetHostingOfferResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PaymentCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.HostingOffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedContracts", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllHostingOfferRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.CompletedContracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedContracts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingContracts", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PendingContracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingContracts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllHostingOfferRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllHostingOfferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEarned", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5556,10 +17256,8 @@ func (m *QueryAllHostingOfferRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalEarned = append(m.TotalEarned, types.Coin{})
+			if err := m.TotalEarned[len(m.TotalEarned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5584,7 +17282,7 @@ func (m *QueryAllHostingOfferRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllHostingOfferResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderPaymentSummaryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5607,47 +17305,13 @@ func (m *QueryAllHostingOfferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllHostingOfferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderPaymentSummaryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllHostingOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderPaymentSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostingOffer", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HostingOffer = append(m.HostingOffer, HostingOffer{})
-			if err := m.HostingOffer[len(m.HostingOffer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5677,7 +17341,7 @@ func (m *QueryAllHostingOfferResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5704,7 +17368,7 @@ func (m *QueryAllHostingOfferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListHostingContractFromRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderPaymentSummaryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5727,17 +17391,17 @@ func (m *QueryListHostingContractFromRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListHostingContractFromRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderPaymentSummaryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListHostingContractFromRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderPaymentSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Summaries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5747,23 +17411,25 @@ func (m *QueryListHostingContractFromRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Summaries = append(m.Summaries, ProviderPaymentSummary{})
+			if err := m.Summaries[len(m.Summaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -5795,7 +17461,7 @@ func (m *QueryListHostingContractFromRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5822,7 +17488,7 @@ func (m *QueryListHostingContractFromRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListHostingContractFromResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentTrendsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5845,17 +17511,17 @@ func (m *QueryListHostingContractFromResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListHostingContractFromResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentTrendsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListHostingContractFromResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentTrendsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostingContract", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockWindow", wireType)
 			}
-			var msglen int
+			m.BlockWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5865,26 +17531,11 @@ func (m *QueryListHostingContractFromResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockWindow |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HostingContract = append(m.HostingContract, HostingContract{})
-			if err := m.HostingContract[len(m.HostingContract)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -5915,7 +17566,7 @@ func (m *QueryListHostingContractFromResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5942,7 +17593,7 @@ func (m *QueryListHostingContractFromResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaymentHistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *PaymentWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5965,17 +17616,17 @@ func (m *QueryPaymentHistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentHistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PaymentWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PaymentWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
 			}
-			m.ContractId = 0
+			m.StartBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5985,64 +17636,52 @@ func (m *QueryPaymentHistoryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContractId |= uint64(b&0x7F) << shift
+				m.StartBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPaymentHistoryResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentCount", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PaymentCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaymentCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaymentHistoryResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaymentHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6069,7 +17708,8 @@ func (m *QueryPaymentHistoryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PaymentHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6094,7 +17734,7 @@ func (m *QueryPaymentHistoryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPaymentHistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentTrendsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6117,17 +17757,17 @@ func (m *QueryAllPaymentHistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPaymentHistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentTrendsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPaymentHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentTrendsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockWindow", wireType)
 			}
-			var msglen int
+			m.BlockWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6137,81 +17777,33 @@ func (m *QueryAllPaymentHistoryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockWindow |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllPaymentHistoryResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBlock", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.CurrentBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPaymentHistoryResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPaymentHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6238,12 +17830,12 @@ func (m *QueryAllPaymentHistoryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentHistory = append(m.PaymentHistory, PaymentHistory{})
-			if err := m.PaymentHistory[len(m.PaymentHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Windows = append(m.Windows, PaymentWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -6300,7 +17892,7 @@ func (m *QueryAllPaymentHistoryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnpaidContractsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6323,17 +17915,17 @@ func (m *QueryEscrowRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnpaidContractsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnpaidContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.InquiryId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6343,11 +17935,28 @@ func (m *QueryEscrowRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InquiryId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6369,7 +17978,7 @@ func (m *QueryEscrowRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnpaidContractsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6392,15 +18001,15 @@ func (m *QueryEscrowRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnpaidContractsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnpaidContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostingContract", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6427,7 +18036,44 @@ func (m *QueryEscrowRecordResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EscrowRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HostingContract = append(m.HostingContract, HostingContract{})
+			if err := m.HostingContract[len(m.HostingContract)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6452,7 +18098,7 @@ func (m *QueryEscrowRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllEscrowRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderPaymentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6475,13 +18121,45 @@ func (m *QueryAllEscrowRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEscrowRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderPaymentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEscrowRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderPaymentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -6538,7 +18216,7 @@ func (m *QueryAllEscrowRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllEscrowRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderPaymentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6561,15 +18239,15 @@ func (m *QueryAllEscrowRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEscrowRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderPaymentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEscrowRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderPaymentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPayments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6596,8 +18274,8 @@ func (m *QueryAllEscrowRecordResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EscrowRecord = append(m.EscrowRecord, EscrowRecord{})
-			if err := m.EscrowRecord[len(m.EscrowRecord)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ProviderPayments = append(m.ProviderPayments, ProviderPayment{})
+			if err := m.ProviderPayments[len(m.ProviderPayments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6658,7 +18336,7 @@ func (m *QueryAllEscrowRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProviderStakeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractProviderPaymentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6681,17 +18359,36 @@ func (m *QueryProviderStakeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProviderStakeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractProviderPaymentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProviderStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractProviderPaymentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6701,23 +18398,27 @@ func (m *QueryProviderStakeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6740,7 +18441,7 @@ func (m *QueryProviderStakeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProviderStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractProviderPaymentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6763,15 +18464,15 @@ func (m *QueryProviderStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProviderStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractProviderPaymentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProviderStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractProviderPaymentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderStake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPayments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6798,7 +18499,44 @@ func (m *QueryProviderStakeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProviderStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ProviderPayments = append(m.ProviderPayments, ProviderPayment{})
+			if err := m.ProviderPayments[len(m.ProviderPayments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6823,7 +18561,7 @@ func (m *QueryProviderStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllProviderStakeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderPaymentsByHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6846,13 +18584,51 @@ func (m *QueryAllProviderStakeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllProviderStakeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderPaymentsByHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllProviderStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderPaymentsByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -6909,7 +18685,7 @@ func (m *QueryAllProviderStakeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllProviderStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderPaymentsByHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6932,15 +18708,15 @@ func (m *QueryAllProviderStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllProviderStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderPaymentsByHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllProviderStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderPaymentsByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderStake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPayments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6967,8 +18743,8 @@ func (m *QueryAllProviderStakeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderStake = append(m.ProviderStake, ProviderStake{})
-			if err := m.ProviderStake[len(m.ProviderStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ProviderPayments = append(m.ProviderPayments, ProviderPayment{})
+			if err := m.ProviderPayments[len(m.ProviderPayments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
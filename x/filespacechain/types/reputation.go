@@ -0,0 +1,87 @@
+package types
+
+import "math/bits"
+
+// Reputation scores are in basis points. A provider without a record scores
+// NeutralReputationScore.
+const (
+	MaxReputationScore     uint64 = 10000
+	NeutralReputationScore uint64 = 5000
+)
+
+// A completed contract closes this share of the gap to the maximum score, in
+// basis points
+const reputationCompletionGain uint64 = 1000
+
+// Share of the score a failure takes away, in basis points
+var reputationPenalties = map[ReputationEvent]uint64{
+	REPUTATION_EVENT_PROOF_FAILED:      2000,
+	REPUTATION_EVENT_SLASHED:           3000,
+	REPUTATION_EVENT_EARLY_TERMINATION: 1000,
+}
+
+// NewProviderReputation returns the neutral reputation of a provider that has
+// no contract outcomes yet
+func NewProviderReputation(provider string, height uint64) ProviderReputation {
+	return ProviderReputation{
+		Provider:    provider,
+		Score:       NeutralReputationScore,
+		LastUpdated: height,
+	}
+}
+
+// Decayed returns the score at a height. The distance to the neutral score
+// halves every halfLife blocks since the score was last updated, and shrinks
+// linearly within a half-life. A zero halfLife disables decay.
+func (r ProviderReputation) Decayed(height, halfLife uint64) uint64 {
+	if halfLife == 0 || height <= r.LastUpdated {
+		return r.Score
+	}
+
+	above := r.Score >= NeutralReputationScore
+	distance := r.Score - NeutralReputationScore
+	if !above {
+		distance = NeutralReputationScore - r.Score
+	}
+
+	elapsed := height - r.LastUpdated
+	if halvings := elapsed / halfLife; halvings >= 64 {
+		distance = 0
+	} else {
+		distance >>= halvings
+	}
+	// distance*rem/halfLife cannot overflow the quotient as rem < halfLife
+	hi, lo := bits.Mul64(distance, elapsed%halfLife)
+	partial, _ := bits.Div64(hi, lo, halfLife)
+	distance -= partial / 2
+
+	if above {
+		return NeutralReputationScore + distance
+	}
+	return NeutralReputationScore - distance
+}
+
+// Record decays the score to height and applies a contract outcome to it
+func (r *ProviderReputation) Record(event ReputationEvent, height, halfLife uint64) {
+	score := r.Decayed(height, halfLife)
+
+	switch event {
+	case REPUTATION_EVENT_CONTRACT_COMPLETED:
+		score += (MaxReputationScore - score) * reputationCompletionGain / MaxReputationScore
+		r.CompletedContracts++
+	case REPUTATION_EVENT_PROOF_FAILED:
+		r.FailedProofs++
+	case REPUTATION_EVENT_SLASHED:
+		r.Slashes++
+	case REPUTATION_EVENT_EARLY_TERMINATION:
+		r.EarlyTerminations++
+	}
+	if penalty, ok := reputationPenalties[event]; ok {
+		score -= score * penalty / MaxReputationScore
+	}
+
+	r.Score = score
+	if height > r.LastUpdated {
+		r.LastUpdated = height
+	}
+}
@@ -0,0 +1,588 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: filespacechain/filespacechain/reputation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReputationEvent is a contract outcome that moves a provider's reputation.
+type ReputationEvent int32
+
+const (
+	REPUTATION_EVENT_UNSPECIFIED ReputationEvent = 0
+	// An ACTIVE contract of the provider reached its end block.
+	REPUTATION_EVENT_CONTRACT_COMPLETED ReputationEvent = 1
+	// The provider missed a storage challenge or answered it with an invalid proof.
+	REPUTATION_EVENT_PROOF_FAILED ReputationEvent = 2
+	// The provider's stake was slashed.
+	REPUTATION_EVENT_SLASHED ReputationEvent = 3
+	// The provider rejected a contract or let its acceptance deadline pass.
+	REPUTATION_EVENT_EARLY_TERMINATION ReputationEvent = 4
+)
+
+var ReputationEvent_name = map[int32]string{
+	0: "REPUTATION_EVENT_UNSPECIFIED",
+	1: "REPUTATION_EVENT_CONTRACT_COMPLETED",
+	2: "REPUTATION_EVENT_PROOF_FAILED",
+	3: "REPUTATION_EVENT_SLASHED",
+	4: "REPUTATION_EVENT_EARLY_TERMINATION",
+}
+
+var ReputationEvent_value = map[string]int32{
+	"REPUTATION_EVENT_UNSPECIFIED":        0,
+	"REPUTATION_EVENT_CONTRACT_COMPLETED": 1,
+	"REPUTATION_EVENT_PROOF_FAILED":       2,
+	"REPUTATION_EVENT_SLASHED":            3,
+	"REPUTATION_EVENT_EARLY_TERMINATION":  4,
+}
+
+func (x ReputationEvent) String() string {
+	return proto.EnumName(ReputationEvent_name, int32(x))
+}
+
+func (ReputationEvent) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f1f2b66de011f2a5, []int{0}
+}
+
+// ProviderReputation is the reputation of a provider built from the outcomes
+// of its contracts. The score is in basis points, from 0 to 10000; it starts
+// at 5000 and decays back towards it while nothing happens.
+type ProviderReputation struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Score as of lastUpdated
+	Score              uint64 `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	LastUpdated        uint64 `protobuf:"varint,3,opt,name=lastUpdated,proto3" json:"lastUpdated,omitempty"`
+	CompletedContracts uint64 `protobuf:"varint,4,opt,name=completedContracts,proto3" json:"completedContracts,omitempty"`
+	FailedProofs       uint64 `protobuf:"varint,5,opt,name=failedProofs,proto3" json:"failedProofs,omitempty"`
+	Slashes            uint64 `protobuf:"varint,6,opt,name=slashes,proto3" json:"slashes,omitempty"`
+	EarlyTerminations  uint64 `protobuf:"varint,7,opt,name=earlyTerminations,proto3" json:"earlyTerminations,omitempty"`
+}
+
+func (m *ProviderReputation) Reset()         { *m = ProviderReputation{} }
+func (m *ProviderReputation) String() string { return proto.CompactTextString(m) }
+func (*ProviderReputation) ProtoMessage()    {}
+func (*ProviderReputation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1f2b66de011f2a5, []int{0}
+}
+func (m *ProviderReputation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderReputation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderReputation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderReputation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderReputation.Merge(m, src)
+}
+func (m *ProviderReputation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderReputation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderReputation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderReputation proto.InternalMessageInfo
+
+func (m *ProviderReputation) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *ProviderReputation) GetScore() uint64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *ProviderReputation) GetLastUpdated() uint64 {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return 0
+}
+
+func (m *ProviderReputation) GetCompletedContracts() uint64 {
+	if m != nil {
+		return m.CompletedContracts
+	}
+	return 0
+}
+
+func (m *ProviderReputation) GetFailedProofs() uint64 {
+	if m != nil {
+		return m.FailedProofs
+	}
+	return 0
+}
+
+func (m *ProviderReputation) GetSlashes() uint64 {
+	if m != nil {
+		return m.Slashes
+	}
+	return 0
+}
+
+func (m *ProviderReputation) GetEarlyTerminations() uint64 {
+	if m != nil {
+		return m.EarlyTerminations
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("filespacechain.filespacechain.ReputationEvent", ReputationEvent_name, ReputationEvent_value)
+	proto.RegisterType((*ProviderReputation)(nil), "filespacechain.filespacechain.ProviderReputation")
+}
+
+func init() {
+	proto.RegisterFile("filespacechain/filespacechain/reputation.proto", fileDescriptor_f1f2b66de011f2a5)
+}
+
+var fileDescriptor_f1f2b66de011f2a5 = []byte{
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x6f, 0xd3, 0x30,
+	0x14, 0xc6, 0xe3, 0xae, 0xdb, 0xc0, 0x20, 0x11, 0xac, 0x1d, 0xac, 0x6a, 0x8b, 0x4a, 0x91, 0x60,
+	0x42, 0xd0, 0x1e, 0xb8, 0x70, 0x0d, 0x8d, 0x2b, 0x22, 0x65, 0x49, 0xe4, 0xa6, 0x48, 0x70, 0x89,
+	0xbc, 0xc4, 0x6d, 0x22, 0xa5, 0x71, 0xb0, 0xbd, 0x89, 0xdd, 0x39, 0x70, 0xe4, 0x7f, 0xe0, 0x9f,
+	0x41, 0xe2, 0xb2, 0x23, 0x47, 0xd4, 0xfe, 0x23, 0x68, 0x2e, 0x6c, 0xac, 0xd9, 0xed, 0x7d, 0xdf,
+	0xf7, 0x7b, 0x4f, 0x7a, 0xd2, 0x07, 0x87, 0xf3, 0xb2, 0xe2, 0xaa, 0x61, 0x19, 0xcf, 0x0a, 0x56,
+	0xd6, 0xa3, 0x2d, 0x29, 0x79, 0x73, 0xa6, 0x99, 0x2e, 0x45, 0x3d, 0x6c, 0xa4, 0xd0, 0x02, 0x1d,
+	0xdd, 0x06, 0xb6, 0xd6, 0x7b, 0x07, 0x0b, 0xb1, 0x10, 0x86, 0x1c, 0x5d, 0x4d, 0x9b, 0xa5, 0xc1,
+	0x97, 0x0e, 0x44, 0xb1, 0x14, 0xe7, 0x65, 0xce, 0x25, 0xbd, 0xbe, 0x88, 0x7a, 0xf0, 0x5e, 0xf3,
+	0xd7, 0xc5, 0xa0, 0x0f, 0x8e, 0xef, 0xd3, 0x6b, 0x8d, 0x0e, 0xe0, 0xae, 0xca, 0x84, 0xe4, 0xb8,
+	0xd3, 0x07, 0xc7, 0x5d, 0xba, 0x11, 0xa8, 0x0f, 0x1f, 0x54, 0x4c, 0xe9, 0x59, 0x93, 0x33, 0xcd,
+	0x73, 0xbc, 0x63, 0xb2, 0xff, 0x2d, 0x34, 0x84, 0x28, 0x13, 0xcb, 0xa6, 0xe2, 0x9a, 0xe7, 0x63,
+	0x51, 0x6b, 0xc9, 0x32, 0xad, 0x70, 0xd7, 0x80, 0x77, 0x24, 0x68, 0x00, 0x1f, 0xce, 0x59, 0x59,
+	0xf1, 0x3c, 0x96, 0x42, 0xcc, 0x15, 0xde, 0x35, 0xe4, 0x2d, 0x0f, 0x61, 0xb8, 0xaf, 0x2a, 0xa6,
+	0x0a, 0xae, 0xf0, 0x9e, 0x89, 0xff, 0x49, 0xf4, 0x12, 0x3e, 0xe6, 0x4c, 0x56, 0x17, 0x09, 0x97,
+	0xcb, 0xb2, 0x36, 0x5f, 0x29, 0xbc, 0x6f, 0x98, 0x76, 0xf0, 0xe2, 0x27, 0x80, 0x8f, 0x6e, 0xde,
+	0x27, 0xe7, 0xbc, 0xd6, 0xa8, 0x0f, 0x0f, 0x29, 0x89, 0x67, 0x89, 0x9b, 0xf8, 0x51, 0x98, 0x92,
+	0xf7, 0x24, 0x4c, 0xd2, 0x59, 0x38, 0x8d, 0xc9, 0xd8, 0x9f, 0xf8, 0xc4, 0xb3, 0x2d, 0xf4, 0x1c,
+	0x3e, 0x6d, 0x11, 0xe3, 0x28, 0x4c, 0xa8, 0x3b, 0xbe, 0x1a, 0x4e, 0xe2, 0x80, 0x24, 0xc4, 0xb3,
+	0x01, 0x7a, 0x02, 0x8f, 0x5a, 0x60, 0x4c, 0xa3, 0x68, 0x92, 0x4e, 0x5c, 0x3f, 0x20, 0x9e, 0xdd,
+	0x41, 0x87, 0x10, 0xb7, 0x90, 0x69, 0xe0, 0x4e, 0xdf, 0x11, 0xcf, 0xde, 0x41, 0xcf, 0xe0, 0xa0,
+	0x95, 0x12, 0x97, 0x06, 0x1f, 0xd2, 0x84, 0xd0, 0x13, 0x3f, 0x34, 0xbe, 0xdd, 0xed, 0x75, 0xbf,
+	0x7e, 0x77, 0xac, 0xb7, 0xf4, 0xc7, 0xca, 0x01, 0x97, 0x2b, 0x07, 0xfc, 0x5e, 0x39, 0xe0, 0xdb,
+	0xda, 0xb1, 0x2e, 0xd7, 0x8e, 0xf5, 0x6b, 0xed, 0x58, 0x1f, 0xdf, 0x2c, 0x4a, 0x5d, 0x9c, 0x9d,
+	0x0e, 0x33, 0xb1, 0x1c, 0x15, 0xac, 0x56, 0xc5, 0xa7, 0x9b, 0x5a, 0xbd, 0xda, 0xf4, 0xea, 0xf3,
+	0x76, 0xd1, 0xf4, 0x45, 0xc3, 0xd5, 0xe9, 0x9e, 0xe9, 0xcb, 0xeb, 0x3f, 0x01, 0x00, 0x00, 0xff,
+	0xff, 0x14, 0xc0, 0xe3, 0xe1, 0x96, 0x02, 0x00, 0x00,
+}
+
+func (m *ProviderReputation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderReputation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderReputation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EarlyTerminations != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.EarlyTerminations))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Slashes != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.Slashes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FailedProofs != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.FailedProofs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CompletedContracts != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.CompletedContracts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastUpdated != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.LastUpdated))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Score != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintReputation(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReputation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReputation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProviderReputation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovReputation(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 1 + sovReputation(uint64(m.Score))
+	}
+	if m.LastUpdated != 0 {
+		n += 1 + sovReputation(uint64(m.LastUpdated))
+	}
+	if m.CompletedContracts != 0 {
+		n += 1 + sovReputation(uint64(m.CompletedContracts))
+	}
+	if m.FailedProofs != 0 {
+		n += 1 + sovReputation(uint64(m.FailedProofs))
+	}
+	if m.Slashes != 0 {
+		n += 1 + sovReputation(uint64(m.Slashes))
+	}
+	if m.EarlyTerminations != 0 {
+		n += 1 + sovReputation(uint64(m.EarlyTerminations))
+	}
+	return n
+}
+
+func sovReputation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReputation(x uint64) (n int) {
+	return sovReputation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProviderReputation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReputation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderReputation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderReputation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			}
+			m.LastUpdated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedContracts", wireType)
+			}
+			m.CompletedContracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedContracts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedProofs", wireType)
+			}
+			m.FailedProofs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedProofs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+			}
+			m.Slashes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slashes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyTerminations", wireType)
+			}
+			m.EarlyTerminations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EarlyTerminations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReputation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReputation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReputation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReputation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReputation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReputation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReputation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReputation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReputation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReputation = fmt.Errorf("proto: unexpected end of group")
+)
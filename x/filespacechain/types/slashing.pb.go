@@ -0,0 +1,661 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: filespacechain/filespacechain/slashing.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SlashDestination decides what happens to stake removed from a provider.
+type SlashDestination int32
+
+const (
+	// Slashed tokens are burned from the hosting bonded pool.
+	SLASH_DESTINATION_BURN SlashDestination = 0
+	// Slashed tokens are sent to the community pool.
+	SLASH_DESTINATION_COMMUNITY_POOL SlashDestination = 1
+	// Slashed tokens compensate the creators of the affected inquiries, pro
+	// rata to the remaining value of their contracts with the provider.
+	SLASH_DESTINATION_COMPENSATE_CLIENTS SlashDestination = 2
+)
+
+var SlashDestination_name = map[int32]string{
+	0: "SLASH_DESTINATION_BURN",
+	1: "SLASH_DESTINATION_COMMUNITY_POOL",
+	2: "SLASH_DESTINATION_COMPENSATE_CLIENTS",
+}
+
+var SlashDestination_value = map[string]int32{
+	"SLASH_DESTINATION_BURN":               0,
+	"SLASH_DESTINATION_COMMUNITY_POOL":     1,
+	"SLASH_DESTINATION_COMPENSATE_CLIENTS": 2,
+}
+
+func (x SlashDestination) String() string {
+	return proto.EnumName(SlashDestination_name, int32(x))
+}
+
+func (SlashDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a73d4fb9e99a8be8, []int{0}
+}
+
+// SlashEvent records a single slash of a provider for auditing.
+type SlashEvent struct {
+	Id               uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Provider         string                                   `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	ContractId       uint64                                   `protobuf:"varint,3,opt,name=contractId,proto3" json:"contractId,omitempty"`
+	Reason           string                                   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Destination      SlashDestination                         `protobuf:"varint,6,opt,name=destination,proto3,enum=filespacechain.filespacechain.SlashDestination" json:"destination,omitempty"`
+	Height           uint64                                   `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	InfractionHeight uint64                                   `protobuf:"varint,8,opt,name=infractionHeight,proto3" json:"infractionHeight,omitempty"`
+}
+
+func (m *SlashEvent) Reset()         { *m = SlashEvent{} }
+func (m *SlashEvent) String() string { return proto.CompactTextString(m) }
+func (*SlashEvent) ProtoMessage()    {}
+func (*SlashEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a73d4fb9e99a8be8, []int{0}
+}
+func (m *SlashEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashEvent.Merge(m, src)
+}
+func (m *SlashEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashEvent proto.InternalMessageInfo
+
+func (m *SlashEvent) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SlashEvent) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *SlashEvent) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+func (m *SlashEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *SlashEvent) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *SlashEvent) GetDestination() SlashDestination {
+	if m != nil {
+		return m.Destination
+	}
+	return SLASH_DESTINATION_BURN
+}
+
+func (m *SlashEvent) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SlashEvent) GetInfractionHeight() uint64 {
+	if m != nil {
+		return m.InfractionHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("filespacechain.filespacechain.SlashDestination", SlashDestination_name, SlashDestination_value)
+	proto.RegisterType((*SlashEvent)(nil), "filespacechain.filespacechain.SlashEvent")
+}
+
+func init() {
+	proto.RegisterFile("filespacechain/filespacechain/slashing.proto", fileDescriptor_a73d4fb9e99a8be8)
+}
+
+var fileDescriptor_a73d4fb9e99a8be8 = []byte{
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0xb5, 0xdd, 0x10, 0xca, 0x55, 0xaa, 0xac, 0x13, 0xaa, 0x8c, 0x25, 0x5c, 0x0b, 0x75, 0xb0,
+	0x2a, 0x7a, 0xa6, 0x65, 0x61, 0x4d, 0x52, 0x4b, 0xb5, 0x94, 0x3a, 0xc5, 0x76, 0x07, 0x58, 0xa2,
+	0x8b, 0x7d, 0xb5, 0x4f, 0x34, 0x77, 0xc1, 0x77, 0x8d, 0x60, 0x67, 0x60, 0xe4, 0x3f, 0xb0, 0xf1,
+	0x4b, 0x3a, 0x76, 0x64, 0x02, 0x94, 0xac, 0xfc, 0x08, 0xe4, 0xb3, 0x45, 0xd3, 0x14, 0x31, 0xd9,
+	0xef, 0xbe, 0xf7, 0xee, 0xbe, 0xf7, 0xf4, 0xc0, 0xf3, 0x0b, 0x7a, 0x49, 0xc4, 0x0c, 0x67, 0x24,
+	0x2b, 0x31, 0x65, 0xfe, 0x1a, 0x14, 0x97, 0x58, 0x94, 0x94, 0x15, 0x68, 0x56, 0x71, 0xc9, 0xe1,
+	0xd3, 0xbb, 0x63, 0x74, 0x17, 0xda, 0x8f, 0x0b, 0x5e, 0x70, 0xc5, 0xf4, 0xeb, 0xbf, 0x46, 0x64,
+	0x3b, 0x19, 0x17, 0x53, 0x2e, 0xfc, 0x09, 0x16, 0xc4, 0x9f, 0x1f, 0x4e, 0x88, 0xc4, 0x87, 0x7e,
+	0xc6, 0x29, 0x6b, 0xe6, 0xcf, 0x7e, 0x1b, 0x00, 0x24, 0xf5, 0x3b, 0xc1, 0x9c, 0x30, 0x09, 0xb7,
+	0x81, 0x41, 0x73, 0x4b, 0x77, 0x75, 0xaf, 0x13, 0x1b, 0x34, 0x87, 0x36, 0xd8, 0x9c, 0x55, 0x7c,
+	0x4e, 0x73, 0x52, 0x59, 0x86, 0xab, 0x7b, 0x8f, 0xe2, 0xbf, 0x18, 0x3a, 0x00, 0x64, 0x9c, 0xc9,
+	0x0a, 0x67, 0x32, 0xcc, 0xad, 0x0d, 0xa5, 0x59, 0x39, 0x81, 0x3b, 0xa0, 0x5b, 0x11, 0x2c, 0x38,
+	0xb3, 0x3a, 0x4a, 0xd9, 0x22, 0x98, 0x81, 0x2e, 0x9e, 0xf2, 0x2b, 0x26, 0xad, 0x07, 0xee, 0x86,
+	0xb7, 0x75, 0xf4, 0x04, 0x35, 0x3b, 0xa2, 0x7a, 0x47, 0xd4, 0xee, 0x88, 0x06, 0x9c, 0xb2, 0xfe,
+	0x8b, 0xeb, 0x1f, 0xbb, 0xda, 0xb7, 0x9f, 0xbb, 0x5e, 0x41, 0x65, 0x79, 0x35, 0x41, 0x19, 0x9f,
+	0xfa, 0xad, 0xa1, 0xe6, 0x73, 0x20, 0xf2, 0x77, 0xbe, 0xfc, 0x38, 0x23, 0x42, 0x09, 0x44, 0xdc,
+	0x5e, 0x0d, 0x5f, 0x83, 0xad, 0x9c, 0x08, 0x49, 0x19, 0x96, 0x94, 0x33, 0xab, 0xeb, 0xea, 0xde,
+	0xf6, 0x91, 0x8f, 0xfe, 0x1b, 0x21, 0x52, 0x41, 0x1c, 0xdf, 0xca, 0xe2, 0xd5, 0x3b, 0x6a, 0x3f,
+	0x25, 0xa1, 0x45, 0x29, 0xad, 0x87, 0xca, 0x6b, 0x8b, 0xe0, 0x3e, 0x30, 0x29, 0xbb, 0xa8, 0x4d,
+	0x53, 0xce, 0x4e, 0x1a, 0xc6, 0xa6, 0x62, 0xdc, 0x3b, 0xdf, 0xff, 0xa4, 0x03, 0x73, 0xfd, 0x15,
+	0x68, 0x83, 0x9d, 0x64, 0xd8, 0x4b, 0x4e, 0xc6, 0xc7, 0x41, 0x92, 0x86, 0x51, 0x2f, 0x0d, 0x47,
+	0xd1, 0xb8, 0x7f, 0x1e, 0x47, 0xa6, 0x06, 0xf7, 0x80, 0x7b, 0x7f, 0x36, 0x18, 0x9d, 0x9e, 0x9e,
+	0x47, 0x61, 0xfa, 0x66, 0x7c, 0x36, 0x1a, 0x0d, 0x4d, 0x1d, 0x7a, 0x60, 0xef, 0x9f, 0xac, 0xb3,
+	0x20, 0x4a, 0x7a, 0x69, 0x30, 0x1e, 0x0c, 0xc3, 0x20, 0x4a, 0x13, 0xd3, 0xb0, 0x3b, 0x9f, 0xbf,
+	0x3a, 0x5a, 0x3f, 0xbe, 0x5e, 0x38, 0xfa, 0xcd, 0xc2, 0xd1, 0x7f, 0x2d, 0x1c, 0xfd, 0xcb, 0xd2,
+	0xd1, 0x6e, 0x96, 0x8e, 0xf6, 0x7d, 0xe9, 0x68, 0x6f, 0x5f, 0xad, 0x24, 0x5d, 0x62, 0x26, 0xca,
+	0xf7, 0xb7, 0xad, 0x3c, 0x68, 0x6a, 0xf9, 0x61, 0xbd, 0xa7, 0x2a, 0xff, 0x49, 0x57, 0x15, 0xea,
+	0xe5, 0x9f, 0x01, 0x00, 0xd7, 0x7d, 0x58, 0x7c, 0xd5, 0x02, 0x00, 0x00,
+}
+
+func (m *SlashEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InfractionHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.InfractionHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Destination != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashing(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SlashEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSlashing(uint64(m.Id))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovSlashing(uint64(m.ContractId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	if m.Destination != 0 {
+		n += 1 + sovSlashing(uint64(m.Destination))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	if m.InfractionHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.InfractionHeight))
+	}
+	return n
+}
+
+func sovSlashing(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashing(x uint64) (n int) {
+	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SlashEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= SlashDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
+			}
+			m.InfractionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSlashing(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSlashing
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSlashing
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSlashing
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSlashing        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSlashing          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSlashing = fmt.Errorf("proto: unexpected end of group")
+)
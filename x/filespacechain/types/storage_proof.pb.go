@@ -0,0 +1,1005 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: filespacechain/filespacechain/storage_proof.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ChallengeStatus int32
+
+const (
+	CHALLENGE_STATUS_PENDING ChallengeStatus = 0
+	CHALLENGE_STATUS_PASSED  ChallengeStatus = 1
+	CHALLENGE_STATUS_FAILED  ChallengeStatus = 2
+	CHALLENGE_STATUS_MISSED  ChallengeStatus = 3
+)
+
+var ChallengeStatus_name = map[int32]string{
+	0: "CHALLENGE_STATUS_PENDING",
+	1: "CHALLENGE_STATUS_PASSED",
+	2: "CHALLENGE_STATUS_FAILED",
+	3: "CHALLENGE_STATUS_MISSED",
+}
+
+var ChallengeStatus_value = map[string]int32{
+	"CHALLENGE_STATUS_PENDING": 0,
+	"CHALLENGE_STATUS_PASSED":  1,
+	"CHALLENGE_STATUS_FAILED":  2,
+	"CHALLENGE_STATUS_MISSED":  3,
+}
+
+func (x ChallengeStatus) String() string {
+	return proto.EnumName(ChallengeStatus_name, int32(x))
+}
+
+func (ChallengeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a68c30a96fe7a40e, []int{0}
+}
+
+// StorageChallenge asks the provider of a hosting contract to prove that it
+// still holds the sampled chunks of the contract's file.
+type StorageChallenge struct {
+	Id            uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContractId    uint64          `protobuf:"varint,2,opt,name=contractId,proto3" json:"contractId,omitempty"`
+	Provider      string          `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	FileEntryCid  string          `protobuf:"bytes,4,opt,name=fileEntryCid,proto3" json:"fileEntryCid,omitempty"`
+	ChunkIndices  []uint64        `protobuf:"varint,5,rep,packed,name=chunkIndices,proto3" json:"chunkIndices,omitempty"`
+	IssuedBlock   uint64          `protobuf:"varint,6,opt,name=issuedBlock,proto3" json:"issuedBlock,omitempty"`
+	DeadlineBlock uint64          `protobuf:"varint,7,opt,name=deadlineBlock,proto3" json:"deadlineBlock,omitempty"`
+	Status        ChallengeStatus `protobuf:"varint,8,opt,name=status,proto3,enum=filespacechain.filespacechain.ChallengeStatus" json:"status,omitempty"`
+	// File entry the proofs are checked against
+	FileEntryId uint64 `protobuf:"varint,9,opt,name=fileEntryId,proto3" json:"fileEntryId,omitempty"`
+}
+
+func (m *StorageChallenge) Reset()         { *m = StorageChallenge{} }
+func (m *StorageChallenge) String() string { return proto.CompactTextString(m) }
+func (*StorageChallenge) ProtoMessage()    {}
+func (*StorageChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68c30a96fe7a40e, []int{0}
+}
+func (m *StorageChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageChallenge.Merge(m, src)
+}
+func (m *StorageChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageChallenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageChallenge proto.InternalMessageInfo
+
+func (m *StorageChallenge) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *StorageChallenge) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
+}
+
+func (m *StorageChallenge) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *StorageChallenge) GetFileEntryCid() string {
+	if m != nil {
+		return m.FileEntryCid
+	}
+	return ""
+}
+
+func (m *StorageChallenge) GetChunkIndices() []uint64 {
+	if m != nil {
+		return m.ChunkIndices
+	}
+	return nil
+}
+
+func (m *StorageChallenge) GetIssuedBlock() uint64 {
+	if m != nil {
+		return m.IssuedBlock
+	}
+	return 0
+}
+
+func (m *StorageChallenge) GetDeadlineBlock() uint64 {
+	if m != nil {
+		return m.DeadlineBlock
+	}
+	return 0
+}
+
+func (m *StorageChallenge) GetStatus() ChallengeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CHALLENGE_STATUS_PENDING
+}
+
+func (m *StorageChallenge) GetFileEntryId() uint64 {
+	if m != nil {
+		return m.FileEntryId
+	}
+	return 0
+}
+
+// ChunkProof carries a challenged chunk together with the Merkle siblings
+// needed to recompute the file entry's merkleRoot, ordered from leaf to root.
+type ChunkProof struct {
+	ChunkIndex uint64   `protobuf:"varint,1,opt,name=chunkIndex,proto3" json:"chunkIndex,omitempty"`
+	Chunk      []byte   `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Siblings   [][]byte `protobuf:"bytes,3,rep,name=siblings,proto3" json:"siblings,omitempty"`
+}
+
+func (m *ChunkProof) Reset()         { *m = ChunkProof{} }
+func (m *ChunkProof) String() string { return proto.CompactTextString(m) }
+func (*ChunkProof) ProtoMessage()    {}
+func (*ChunkProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68c30a96fe7a40e, []int{1}
+}
+func (m *ChunkProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChunkProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChunkProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChunkProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChunkProof.Merge(m, src)
+}
+func (m *ChunkProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChunkProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChunkProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChunkProof proto.InternalMessageInfo
+
+func (m *ChunkProof) GetChunkIndex() uint64 {
+	if m != nil {
+		return m.ChunkIndex
+	}
+	return 0
+}
+
+func (m *ChunkProof) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+func (m *ChunkProof) GetSiblings() [][]byte {
+	if m != nil {
+		return m.Siblings
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("filespacechain.filespacechain.ChallengeStatus", ChallengeStatus_name, ChallengeStatus_value)
+	proto.RegisterType((*StorageChallenge)(nil), "filespacechain.filespacechain.StorageChallenge")
+	proto.RegisterType((*ChunkProof)(nil), "filespacechain.filespacechain.ChunkProof")
+}
+
+func init() {
+	proto.RegisterFile("filespacechain/filespacechain/storage_proof.proto", fileDescriptor_a68c30a96fe7a40e)
+}
+
+var fileDescriptor_a68c30a96fe7a40e = []byte{
+	// 455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x8d, 0x93, 0xae, 0x6c, 0xa6, 0x8c, 0xca, 0x9a, 0x84, 0x55, 0x20, 0x8a, 0x2a, 0x0e, 0x11,
+	0x12, 0xa9, 0x80, 0x0b, 0xd7, 0xae, 0xcd, 0x46, 0xa4, 0x52, 0x4d, 0xc9, 0xb8, 0x70, 0xa0, 0x4a,
+	0x63, 0x2f, 0xb1, 0x16, 0xec, 0x10, 0xbb, 0x68, 0xfb, 0x07, 0x1c, 0x38, 0xf0, 0x1f, 0xf8, 0x33,
+	0x1c, 0x77, 0xdc, 0x11, 0xb5, 0x7f, 0x04, 0xc5, 0xa9, 0x4a, 0x53, 0xa6, 0xdd, 0xf2, 0xde, 0xf7,
+	0xbe, 0xe8, 0x7d, 0xcf, 0x0f, 0xbe, 0xbe, 0x60, 0x39, 0x95, 0x45, 0x9c, 0xd0, 0x24, 0x8b, 0x19,
+	0x1f, 0xec, 0x40, 0xa9, 0x44, 0x19, 0xa7, 0x74, 0x56, 0x94, 0x42, 0x5c, 0x78, 0x45, 0x29, 0x94,
+	0x40, 0xcf, 0x9b, 0x1a, 0xaf, 0x09, 0x7b, 0x47, 0xa9, 0x48, 0x85, 0x56, 0x0e, 0xaa, 0xaf, 0x7a,
+	0xa9, 0x7f, 0x6b, 0xc2, 0x6e, 0x54, 0xff, 0x6c, 0x94, 0xc5, 0x79, 0x4e, 0x79, 0x4a, 0xd1, 0x21,
+	0x34, 0x19, 0xc1, 0xc0, 0x01, 0x6e, 0x2b, 0x34, 0x19, 0x41, 0x36, 0x84, 0x89, 0xe0, 0xaa, 0x8c,
+	0x13, 0x15, 0x10, 0x6c, 0x6a, 0x7e, 0x8b, 0x41, 0x3d, 0xb8, 0x5f, 0x94, 0xe2, 0x1b, 0x23, 0xb4,
+	0xc4, 0x96, 0x03, 0xdc, 0x83, 0x70, 0x83, 0x51, 0x1f, 0x76, 0x2a, 0x23, 0x3e, 0x57, 0xe5, 0xf5,
+	0x88, 0x11, 0xdc, 0xd2, 0xf3, 0x06, 0x57, 0x69, 0x92, 0x6c, 0xc1, 0x2f, 0x03, 0x4e, 0x58, 0x42,
+	0x25, 0xde, 0x73, 0x2c, 0xb7, 0x15, 0x36, 0x38, 0xe4, 0xc0, 0x87, 0x4c, 0xca, 0x05, 0x25, 0xc7,
+	0xb9, 0x48, 0x2e, 0x71, 0x5b, 0x9b, 0xd8, 0xa6, 0xd0, 0x0b, 0xf8, 0x88, 0xd0, 0x98, 0xe4, 0x8c,
+	0xd3, 0x5a, 0xf3, 0x40, 0x6b, 0x9a, 0x24, 0x3a, 0x81, 0x6d, 0xa9, 0x62, 0xb5, 0x90, 0x78, 0xdf,
+	0x01, 0xee, 0xe1, 0x1b, 0xcf, 0xbb, 0x37, 0x36, 0x6f, 0x93, 0x4a, 0xa4, 0xb7, 0xc2, 0xf5, 0x76,
+	0xe5, 0x67, 0x73, 0x43, 0x40, 0xf0, 0x41, 0xed, 0x67, 0x8b, 0xea, 0x7f, 0x86, 0x70, 0x54, 0x5d,
+	0x70, 0x56, 0xbd, 0x91, 0xce, 0x70, 0x7d, 0x0f, 0xbd, 0x5a, 0x67, 0xbb, 0xc5, 0xa0, 0x23, 0xb8,
+	0xa7, 0x91, 0x8e, 0xb7, 0x13, 0xd6, 0xa0, 0x4a, 0x56, 0xb2, 0x79, 0xce, 0x78, 0x2a, 0xb1, 0xe5,
+	0x58, 0x6e, 0x27, 0xdc, 0xe0, 0x97, 0x3f, 0x00, 0x7c, 0xbc, 0xe3, 0x0e, 0x3d, 0x83, 0x78, 0xf4,
+	0x7e, 0x38, 0x99, 0xf8, 0xd3, 0x53, 0x7f, 0x16, 0x9d, 0x0f, 0xcf, 0x3f, 0x46, 0xb3, 0x33, 0x7f,
+	0x3a, 0x0e, 0xa6, 0xa7, 0x5d, 0x03, 0x3d, 0x85, 0x4f, 0xfe, 0x9f, 0x0e, 0xa3, 0xc8, 0x1f, 0x77,
+	0xc1, 0x9d, 0xc3, 0x93, 0x61, 0x30, 0xf1, 0xc7, 0x5d, 0xf3, 0xce, 0xe1, 0x87, 0x40, 0x6f, 0x5a,
+	0xbd, 0xd6, 0xf7, 0x5f, 0xb6, 0x71, 0x1c, 0xfe, 0x5e, 0xda, 0xe0, 0x66, 0x69, 0x83, 0x3f, 0x4b,
+	0x1b, 0xfc, 0x5c, 0xd9, 0xc6, 0xcd, 0xca, 0x36, 0x6e, 0x57, 0xb6, 0xf1, 0xe9, 0x5d, 0xca, 0x54,
+	0xb6, 0x98, 0x7b, 0x89, 0xf8, 0x32, 0xc8, 0x62, 0x2e, 0xb3, 0xaf, 0xff, 0xea, 0xfc, 0xaa, 0xee,
+	0xf3, 0xd5, 0x6e, 0xc1, 0xd5, 0x75, 0x41, 0xe5, 0xbc, 0xad, 0x4b, 0xfa, 0xf6, 0xef, 0x00, 0x6e,
+	0x87, 0xa6, 0x32, 0x0e, 0x03, 0x00, 0x00,
+}
+
+func (m *StorageChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FileEntryId != 0 {
+		i = encodeVarintStorageProof(dAtA, i, uint64(m.FileEntryId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Status != 0 {
+		i = encodeVarintStorageProof(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DeadlineBlock != 0 {
+		i = encodeVarintStorageProof(dAtA, i, uint64(m.DeadlineBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.IssuedBlock != 0 {
+		i = encodeVarintStorageProof(dAtA, i, uint64(m.IssuedBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ChunkIndices) > 0 {
+		dAtA2 := make([]byte, len(m.ChunkIndices)*10)
+		var j1 int
+		for _, num := range m.ChunkIndices {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintStorageProof(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FileEntryCid) > 0 {
+		i -= len(m.FileEntryCid)
+		copy(dAtA[i:], m.FileEntryCid)
+		i = encodeVarintStorageProof(dAtA, i, uint64(len(m.FileEntryCid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintStorageProof(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintStorageProof(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintStorageProof(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChunkProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChunkProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Siblings) > 0 {
+		for iNdEx := len(m.Siblings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Siblings[iNdEx])
+			copy(dAtA[i:], m.Siblings[iNdEx])
+			i = encodeVarintStorageProof(dAtA, i, uint64(len(m.Siblings[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Chunk) > 0 {
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarintStorageProof(dAtA, i, uint64(len(m.Chunk)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChunkIndex != 0 {
+		i = encodeVarintStorageProof(dAtA, i, uint64(m.ChunkIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStorageProof(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStorageProof(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StorageChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStorageProof(uint64(m.Id))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovStorageProof(uint64(m.ContractId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovStorageProof(uint64(l))
+	}
+	l = len(m.FileEntryCid)
+	if l > 0 {
+		n += 1 + l + sovStorageProof(uint64(l))
+	}
+	if len(m.ChunkIndices) > 0 {
+		l = 0
+		for _, e := range m.ChunkIndices {
+			l += sovStorageProof(uint64(e))
+		}
+		n += 1 + sovStorageProof(uint64(l)) + l
+	}
+	if m.IssuedBlock != 0 {
+		n += 1 + sovStorageProof(uint64(m.IssuedBlock))
+	}
+	if m.DeadlineBlock != 0 {
+		n += 1 + sovStorageProof(uint64(m.DeadlineBlock))
+	}
+	if m.Status != 0 {
+		n += 1 + sovStorageProof(uint64(m.Status))
+	}
+	if m.FileEntryId != 0 {
+		n += 1 + sovStorageProof(uint64(m.FileEntryId))
+	}
+	return n
+}
+
+func (m *ChunkProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChunkIndex != 0 {
+		n += 1 + sovStorageProof(uint64(m.ChunkIndex))
+	}
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovStorageProof(uint64(l))
+	}
+	if len(m.Siblings) > 0 {
+		for _, b := range m.Siblings {
+			l = len(b)
+			n += 1 + l + sovStorageProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStorageProof(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStorageProof(x uint64) (n int) {
+	return sovStorageProof(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StorageChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorageProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorageProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorageProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileEntryCid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorageProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorageProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileEntryCid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStorageProof
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ChunkIndices = append(m.ChunkIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStorageProof
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStorageProof
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStorageProof
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ChunkIndices) == 0 {
+					m.ChunkIndices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStorageProof
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ChunkIndices = append(m.ChunkIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkIndices", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedBlock", wireType)
+			}
+			m.IssuedBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuedBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineBlock", wireType)
+			}
+			m.DeadlineBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ChallengeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileEntryId", wireType)
+			}
+			m.FileEntryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileEntryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorageProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStorageProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChunkProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorageProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChunkProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChunkProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkIndex", wireType)
+			}
+			m.ChunkIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStorageProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorageProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Siblings", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStorageProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorageProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Siblings = append(m.Siblings, make([]byte, postIndex-iNdEx))
+			copy(m.Siblings[len(m.Siblings)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorageProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStorageProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStorageProof(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStorageProof
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStorageProof
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStorageProof
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStorageProof
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStorageProof
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStorageProof        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStorageProof          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStorageProof = fmt.Errorf("proto: unexpected end of group")
+)
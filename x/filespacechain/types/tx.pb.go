@@ -132,6 +132,11 @@ type MsgCreateFileEntry struct {
 	ParentCid string `protobuf:"bytes,4,opt,name=parentCid,proto3" json:"parentCid,omitempty"`
 	MetaData  string `protobuf:"bytes,5,opt,name=metaData,proto3" json:"metaData,omitempty"`
 	FileSize  uint64 `protobuf:"varint,6,opt,name=fileSize,proto3" json:"fileSize,omitempty"`
+	// Optional content commitment: the Merkle root over fixed-size chunks of
+	// the file. Either all three fields are set or none of them.
+	MerkleRoot []byte `protobuf:"bytes,7,opt,name=merkleRoot,proto3" json:"merkleRoot,omitempty"`
+	ChunkCount uint64 `protobuf:"varint,8,opt,name=chunkCount,proto3" json:"chunkCount,omitempty"`
+	ChunkSize  uint64 `protobuf:"varint,9,opt,name=chunkSize,proto3" json:"chunkSize,omitempty"`
 }
 
 func (m *MsgCreateFileEntry) Reset()         { *m = MsgCreateFileEntry{} }
@@ -209,6 +214,27 @@ func (m *MsgCreateFileEntry) GetFileSize() uint64 {
 	return 0
 }
 
+func (m *MsgCreateFileEntry) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+func (m *MsgCreateFileEntry) GetChunkCount() uint64 {
+	if m != nil {
+		return m.ChunkCount
+	}
+	return 0
+}
+
+func (m *MsgCreateFileEntry) GetChunkSize() uint64 {
+	if m != nil {
+		return m.ChunkSize
+	}
+	return 0
+}
+
 type MsgCreateFileEntryResponse struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
@@ -476,6 +502,12 @@ type MsgCreateHostingInquiry struct {
 	EscrowAmount     types.Coin `protobuf:"bytes,4,opt,name=escrowAmount,proto3" json:"escrowAmount"`
 	EndTime          uint64     `protobuf:"varint,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
 	MaxPricePerBlock types.Coin `protobuf:"bytes,6,opt,name=maxPricePerBlock,proto3" json:"maxPricePerBlock"`
+	AllowedRegions   []string   `protobuf:"bytes,7,rep,name=allowedRegions,proto3" json:"allowedRegions,omitempty"`
+	DeniedRegions    []string   `protobuf:"bytes,8,rep,name=deniedRegions,proto3" json:"deniedRegions,omitempty"`
+	// Blocks providers have to commit and then reveal sealed bids; when set,
+	// the inquiry is auctioned instead of matched to the cheapest offers
+	AuctionCommitBlocks uint64 `protobuf:"varint,9,opt,name=auctionCommitBlocks,proto3" json:"auctionCommitBlocks,omitempty"`
+	AuctionRevealBlocks uint64 `protobuf:"varint,10,opt,name=auctionRevealBlocks,proto3" json:"auctionRevealBlocks,omitempty"`
 }
 
 func (m *MsgCreateHostingInquiry) Reset()         { *m = MsgCreateHostingInquiry{} }
@@ -553,6 +585,34 @@ func (m *MsgCreateHostingInquiry) GetMaxPricePerBlock() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgCreateHostingInquiry) GetAllowedRegions() []string {
+	if m != nil {
+		return m.AllowedRegions
+	}
+	return nil
+}
+
+func (m *MsgCreateHostingInquiry) GetDeniedRegions() []string {
+	if m != nil {
+		return m.DeniedRegions
+	}
+	return nil
+}
+
+func (m *MsgCreateHostingInquiry) GetAuctionCommitBlocks() uint64 {
+	if m != nil {
+		return m.AuctionCommitBlocks
+	}
+	return 0
+}
+
+func (m *MsgCreateHostingInquiry) GetAuctionRevealBlocks() uint64 {
+	if m != nil {
+		return m.AuctionRevealBlocks
+	}
+	return 0
+}
+
 type MsgCreateHostingInquiryResponse struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
@@ -597,6 +657,10 @@ func (m *MsgCreateHostingInquiryResponse) GetId() uint64 {
 	return 0
 }
 
+// MsgUpdateHostingInquiry changes the replication rate of an inquiry: added
+// replicas are escrowed at the share every replica holds and matched, removed
+// ones must be unfilled and their escrow is refunded. All other fields are
+// fixed once the inquiry is funded; set, they must match the inquiry.
 type MsgUpdateHostingInquiry struct {
 	Creator          string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Id               uint64     `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -813,6 +877,8 @@ func (m *MsgDeleteHostingInquiryResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteHostingInquiryResponse proto.InternalMessageInfo
 
+// MsgCreateHostingContract lets the provider of an offer take an open replica
+// of an inquiry; the contract runs from now until the inquiry's end time.
 type MsgCreateHostingContract struct {
 	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	InquiryId uint64 `protobuf:"varint,2,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
@@ -917,6 +983,10 @@ func (m *MsgCreateHostingContractResponse) GetId() uint64 {
 	return 0
 }
 
+// MsgUpdateHostingContract proposes handing an ACTIVE contract over to the
+// provider of another offer, who takes it over with
+// MsgAcceptHostingContractTransfer. An offer id of zero or of the contract's
+// own offer withdraws the proposal. The inquiry a contract hosts is fixed.
 type MsgUpdateHostingContract struct {
 	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Id        uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -1546,6 +1616,7 @@ func (m *MsgUnstakeFromHosting) GetAmount() types.Coin {
 }
 
 type MsgUnstakeFromHostingResponse struct {
+	CompletionHeight uint64 `protobuf:"varint,1,opt,name=completionHeight,proto3" json:"completionHeight,omitempty"`
 }
 
 func (m *MsgUnstakeFromHostingResponse) Reset()         { *m = MsgUnstakeFromHostingResponse{} }
@@ -1581,2271 +1652,7450 @@ func (m *MsgUnstakeFromHostingResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnstakeFromHostingResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "filespacechain.filespacechain.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "filespacechain.filespacechain.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateFileEntry)(nil), "filespacechain.filespacechain.MsgCreateFileEntry")
-	proto.RegisterType((*MsgCreateFileEntryResponse)(nil), "filespacechain.filespacechain.MsgCreateFileEntryResponse")
-	proto.RegisterType((*MsgUpdateFileEntry)(nil), "filespacechain.filespacechain.MsgUpdateFileEntry")
-	proto.RegisterType((*MsgUpdateFileEntryResponse)(nil), "filespacechain.filespacechain.MsgUpdateFileEntryResponse")
-	proto.RegisterType((*MsgDeleteFileEntry)(nil), "filespacechain.filespacechain.MsgDeleteFileEntry")
-	proto.RegisterType((*MsgDeleteFileEntryResponse)(nil), "filespacechain.filespacechain.MsgDeleteFileEntryResponse")
-	proto.RegisterType((*MsgCreateHostingInquiry)(nil), "filespacechain.filespacechain.MsgCreateHostingInquiry")
-	proto.RegisterType((*MsgCreateHostingInquiryResponse)(nil), "filespacechain.filespacechain.MsgCreateHostingInquiryResponse")
-	proto.RegisterType((*MsgUpdateHostingInquiry)(nil), "filespacechain.filespacechain.MsgUpdateHostingInquiry")
-	proto.RegisterType((*MsgUpdateHostingInquiryResponse)(nil), "filespacechain.filespacechain.MsgUpdateHostingInquiryResponse")
-	proto.RegisterType((*MsgDeleteHostingInquiry)(nil), "filespacechain.filespacechain.MsgDeleteHostingInquiry")
-	proto.RegisterType((*MsgDeleteHostingInquiryResponse)(nil), "filespacechain.filespacechain.MsgDeleteHostingInquiryResponse")
-	proto.RegisterType((*MsgCreateHostingContract)(nil), "filespacechain.filespacechain.MsgCreateHostingContract")
-	proto.RegisterType((*MsgCreateHostingContractResponse)(nil), "filespacechain.filespacechain.MsgCreateHostingContractResponse")
-	proto.RegisterType((*MsgUpdateHostingContract)(nil), "filespacechain.filespacechain.MsgUpdateHostingContract")
-	proto.RegisterType((*MsgUpdateHostingContractResponse)(nil), "filespacechain.filespacechain.MsgUpdateHostingContractResponse")
-	proto.RegisterType((*MsgDeleteHostingContract)(nil), "filespacechain.filespacechain.MsgDeleteHostingContract")
-	proto.RegisterType((*MsgDeleteHostingContractResponse)(nil), "filespacechain.filespacechain.MsgDeleteHostingContractResponse")
-	proto.RegisterType((*MsgCreateHostingOffer)(nil), "filespacechain.filespacechain.MsgCreateHostingOffer")
-	proto.RegisterType((*MsgCreateHostingOfferResponse)(nil), "filespacechain.filespacechain.MsgCreateHostingOfferResponse")
-	proto.RegisterType((*MsgUpdateHostingOffer)(nil), "filespacechain.filespacechain.MsgUpdateHostingOffer")
-	proto.RegisterType((*MsgUpdateHostingOfferResponse)(nil), "filespacechain.filespacechain.MsgUpdateHostingOfferResponse")
-	proto.RegisterType((*MsgDeleteHostingOffer)(nil), "filespacechain.filespacechain.MsgDeleteHostingOffer")
-	proto.RegisterType((*MsgDeleteHostingOfferResponse)(nil), "filespacechain.filespacechain.MsgDeleteHostingOfferResponse")
-	proto.RegisterType((*MsgStakeForHosting)(nil), "filespacechain.filespacechain.MsgStakeForHosting")
-	proto.RegisterType((*MsgStakeForHostingResponse)(nil), "filespacechain.filespacechain.MsgStakeForHostingResponse")
-	proto.RegisterType((*MsgUnstakeFromHosting)(nil), "filespacechain.filespacechain.MsgUnstakeFromHosting")
-	proto.RegisterType((*MsgUnstakeFromHostingResponse)(nil), "filespacechain.filespacechain.MsgUnstakeFromHostingResponse")
+func (m *MsgUnstakeFromHostingResponse) GetCompletionHeight() uint64 {
+	if m != nil {
+		return m.CompletionHeight
+	}
+	return 0
 }
 
-func init() {
-	proto.RegisterFile("filespacechain/filespacechain/tx.proto", fileDescriptor_a668097e3ff363a4)
+type MsgSubmitStorageProof struct {
+	Creator     string       `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChallengeId uint64       `protobuf:"varint,2,opt,name=challengeId,proto3" json:"challengeId,omitempty"`
+	Proofs      []ChunkProof `protobuf:"bytes,3,rep,name=proofs,proto3" json:"proofs"`
 }
 
-var fileDescriptor_a668097e3ff363a4 = []byte{
-	// 1217 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xda, 0x8e, 0x83, 0xa7, 0x81, 0x84, 0x55, 0x4a, 0x1d, 0x2b, 0x75, 0x82, 0x25, 0x50,
-	0x14, 0x51, 0x5b, 0x4e, 0xa3, 0x04, 0x2a, 0x04, 0xaa, 0xdd, 0x56, 0x8d, 0x20, 0x6a, 0xd8, 0xc0,
-	0x85, 0x4b, 0x34, 0x59, 0x4f, 0xd6, 0xa3, 0x66, 0x77, 0xdc, 0xdd, 0x49, 0x48, 0x7a, 0x01, 0x71,
-	0x42, 0x3d, 0x20, 0xf8, 0x03, 0x5c, 0xb8, 0x70, 0x42, 0x39, 0x70, 0x43, 0xe2, 0x5c, 0x09, 0x09,
-	0x55, 0x5c, 0xe0, 0x84, 0x50, 0x72, 0xc8, 0xdf, 0x40, 0x3b, 0x33, 0xbb, 0xee, 0x8e, 0x67, 0x37,
-	0xb3, 0xa6, 0xea, 0xa5, 0xcd, 0x9b, 0x99, 0x37, 0xef, 0xfb, 0xde, 0x37, 0x33, 0xfb, 0xc9, 0xe0,
-	0xed, 0x7d, 0x7c, 0x80, 0x82, 0x01, 0xb4, 0x91, 0xdd, 0x87, 0xd8, 0x6b, 0x49, 0x21, 0x3d, 0x6e,
-	0x0e, 0x7c, 0x42, 0x89, 0x79, 0x3d, 0x39, 0xd1, 0x4c, 0x86, 0xb5, 0xd7, 0xa1, 0x8b, 0x3d, 0xd2,
-	0x62, 0xff, 0xf2, 0x8c, 0xda, 0x35, 0x9b, 0x04, 0x2e, 0x09, 0x5a, 0x6e, 0xe0, 0xb4, 0x8e, 0xda,
-	0xe1, 0x7f, 0x62, 0x62, 0x9e, 0x4f, 0xec, 0xb2, 0xa8, 0xc5, 0x03, 0x31, 0x35, 0xe7, 0x10, 0x87,
-	0xf0, 0xf1, 0xf0, 0x2f, 0x31, 0xba, 0x92, 0x8d, 0x71, 0x00, 0x7d, 0xe8, 0x46, 0x3b, 0x34, 0xb3,
-	0xd7, 0x86, 0xe1, 0x2e, 0xf2, 0xa8, 0x7f, 0x22, 0xd6, 0xdf, 0xcc, 0x5e, 0xdf, 0x27, 0x01, 0xc5,
-	0x9e, 0xb3, 0x8b, 0xbd, 0x47, 0x87, 0x38, 0x4e, 0xaa, 0x0b, 0x6a, 0x7b, 0x30, 0x40, 0xad, 0xa3,
-	0xf6, 0x1e, 0xa2, 0xb0, 0xdd, 0xb2, 0x09, 0xf6, 0xc4, 0xfc, 0x9a, 0xde, 0xa6, 0x36, 0xf1, 0xa8,
-	0x0f, 0x6d, 0x2a, 0xb2, 0xda, 0x7a, 0x59, 0x64, 0x7f, 0x1f, 0xf9, 0x3c, 0xa5, 0xf1, 0x97, 0x01,
-	0x66, 0xb6, 0x02, 0xe7, 0xb3, 0x41, 0x0f, 0x52, 0xb4, 0xcd, 0xfa, 0x60, 0xae, 0x83, 0x0a, 0x3c,
-	0xa4, 0x7d, 0xe2, 0x63, 0x7a, 0x52, 0x35, 0x96, 0x8c, 0xe5, 0x4a, 0xa7, 0xfa, 0xe7, 0x2f, 0x37,
-	0xe6, 0x44, 0xa3, 0x6f, 0xf7, 0x7a, 0x3e, 0x0a, 0x82, 0x1d, 0xea, 0x63, 0xcf, 0xb1, 0x86, 0x4b,
-	0xcd, 0xfb, 0xa0, 0xcc, 0x3b, 0x59, 0x2d, 0x2c, 0x19, 0xcb, 0x57, 0x56, 0xdf, 0x6a, 0x66, 0x4a,
-	0xde, 0xe4, 0xe5, 0x3a, 0x95, 0xa7, 0xff, 0x2c, 0x4e, 0xfc, 0x74, 0x71, 0xba, 0x62, 0x58, 0x22,
-	0xff, 0x56, 0xe7, 0xeb, 0x8b, 0xd3, 0x95, 0xe1, 0xce, 0x4f, 0x2e, 0x4e, 0x57, 0x64, 0x32, 0xc7,
-	0xf2, 0x80, 0xc4, 0xa2, 0x31, 0x0f, 0xae, 0x49, 0x43, 0x16, 0x0a, 0x06, 0xc4, 0x0b, 0x50, 0xe3,
-	0x37, 0x03, 0x98, 0x5b, 0x81, 0xd3, 0xf5, 0x11, 0xa4, 0xe8, 0x1e, 0x3e, 0x40, 0x77, 0x43, 0x3d,
-	0xcd, 0x2a, 0x98, 0xb2, 0xc3, 0x21, 0xe2, 0x73, 0xd6, 0x56, 0x14, 0x9a, 0xb3, 0xa0, 0x68, 0xe3,
-	0x1e, 0xa3, 0x55, 0xb1, 0xc2, 0x3f, 0xc3, 0xb5, 0x3e, 0x21, 0xb4, 0x8b, 0x7b, 0xd5, 0x22, 0x5f,
-	0x2b, 0x42, 0x73, 0x01, 0x54, 0x06, 0xd0, 0x47, 0x1e, 0x9b, 0x2b, 0xb1, 0xb9, 0xe1, 0x80, 0x59,
-	0x03, 0xaf, 0xb8, 0x88, 0xc2, 0x3b, 0x90, 0xc2, 0xea, 0x24, 0x9b, 0x8c, 0xe3, 0x70, 0x2e, 0xe4,
-	0xb4, 0x83, 0x1f, 0xa3, 0x6a, 0x79, 0xc9, 0x58, 0x2e, 0x59, 0x71, 0x7c, 0x6b, 0x3a, 0xec, 0x48,
-	0x84, 0xa7, 0xf1, 0x0e, 0xa8, 0x8d, 0xe2, 0x8f, 0xe8, 0x99, 0xaf, 0x81, 0x02, 0xee, 0x31, 0x0a,
-	0x25, 0xab, 0x80, 0x7b, 0x8d, 0x3f, 0x38, 0x5d, 0xde, 0x0a, 0x1d, 0xba, 0x7c, 0x83, 0x42, 0xb4,
-	0x41, 0x44, 0xbf, 0xa8, 0xa4, 0x5f, 0xca, 0xa0, 0x3f, 0x99, 0x45, 0xbf, 0x9c, 0x41, 0x7f, 0x2a,
-	0x93, 0xfe, 0x02, 0xa3, 0x2f, 0xf1, 0x89, 0xd5, 0xfd, 0x98, 0xb1, 0xbd, 0x83, 0x0e, 0xd0, 0x58,
-	0x6c, 0x95, 0xb5, 0xa4, 0xdd, 0xe2, 0x5a, 0xbf, 0x16, 0xd8, 0x29, 0xe3, 0x4a, 0xdc, 0xe7, 0xf7,
-	0x6b, 0x93, 0xdf, 0xf4, 0x8c, 0x8a, 0x0d, 0x30, 0xbd, 0x1f, 0x6d, 0xd5, 0x8d, 0xcf, 0x55, 0x62,
-	0xcc, 0x5c, 0x06, 0x33, 0x3e, 0x1a, 0x1c, 0x60, 0x1b, 0x52, 0x4c, 0x3c, 0x0b, 0x52, 0xc4, 0xfa,
-	0x5f, 0xb2, 0xe4, 0x61, 0xb3, 0x0b, 0xa6, 0x51, 0x60, 0xfb, 0xe4, 0x8b, 0xdb, 0x2e, 0x39, 0xf4,
-	0x28, 0x13, 0xe4, 0xca, 0xea, 0x7c, 0x53, 0x5c, 0xd7, 0xf0, 0x89, 0x69, 0x8a, 0x27, 0xa6, 0xd9,
-	0x25, 0xd8, 0xeb, 0x94, 0xc2, 0x0b, 0x67, 0x25, 0x92, 0x42, 0xb0, 0xc8, 0xeb, 0x7d, 0x8a, 0x5d,
-	0xc4, 0x44, 0x2b, 0x59, 0x51, 0x68, 0x7e, 0x04, 0x66, 0x5d, 0x78, 0xbc, 0xed, 0x63, 0x1b, 0x6d,
-	0x23, 0xbf, 0x73, 0x40, 0xec, 0x87, 0x4c, 0x3a, 0x8d, 0x12, 0x23, 0x89, 0x52, 0x6f, 0xdb, 0x60,
-	0x31, 0xa5, 0x79, 0xa9, 0x67, 0xf9, 0xf7, 0xc2, 0x73, 0xd7, 0x5a, 0xbb, 0xe1, 0xf2, 0x81, 0x96,
-	0x05, 0x28, 0xea, 0x09, 0x50, 0xd2, 0x13, 0x60, 0xf2, 0x7f, 0x0a, 0x50, 0xbe, 0x5c, 0x80, 0xa9,
-	0x17, 0x23, 0xc0, 0x9b, 0x4c, 0x00, 0x55, 0x33, 0xe3, 0x13, 0xfe, 0x09, 0xeb, 0x37, 0x3f, 0xff,
-	0xe3, 0xf6, 0x5b, 0x59, 0x55, 0xb5, 0x65, 0x5c, 0xf5, 0x31, 0xa8, 0xca, 0x27, 0xa3, 0x2b, 0xbe,
-	0x75, 0x19, 0x65, 0x17, 0x40, 0x45, 0x7c, 0x66, 0x37, 0xa3, 0xea, 0xc3, 0x81, 0x30, 0x8f, 0x7d,
-	0xf9, 0x36, 0x7b, 0xe2, 0x26, 0x45, 0xa1, 0x04, 0x6f, 0x15, 0x2c, 0xa5, 0xd5, 0x4e, 0x3d, 0x96,
-	0x4f, 0x0c, 0x06, 0x38, 0xd1, 0x49, 0x0d, 0xc0, 0xf2, 0xb9, 0x4c, 0x10, 0x28, 0x66, 0x10, 0x28,
-	0x65, 0x11, 0x68, 0x30, 0x02, 0x4a, 0x2c, 0x71, 0x83, 0x2d, 0x86, 0x37, 0xa1, 0x41, 0x7e, 0xbc,
-	0xca, 0xba, 0xca, 0x3d, 0xe3, 0xba, 0x3f, 0x18, 0xe0, 0xaa, 0xdc, 0xdd, 0x07, 0x21, 0x8b, 0x8c,
-	0xaa, 0x6f, 0x80, 0xb2, 0x8f, 0x1c, 0x4c, 0x3c, 0xf1, 0x50, 0x8a, 0xc8, 0xbc, 0x0b, 0x5e, 0x1d,
-	0x24, 0x6e, 0x45, 0x51, 0xef, 0x56, 0x24, 0xb3, 0x24, 0x12, 0x2d, 0x70, 0x5d, 0x89, 0x2f, 0x55,
-	0xfa, 0x9f, 0x39, 0xa3, 0x44, 0xbb, 0x2f, 0x63, 0x24, 0xeb, 0x3e, 0x64, 0x58, 0xcc, 0x66, 0x58,
-	0x7a, 0x01, 0x0c, 0x17, 0x19, 0xc3, 0x51, 0xbc, 0xb1, 0x46, 0x0f, 0x18, 0xa1, 0x84, 0x8e, 0x39,
-	0x09, 0x29, 0x2b, 0x8e, 0x6e, 0x18, 0x57, 0x3c, 0x64, 0x9f, 0xec, 0x1d, 0x0a, 0x1f, 0xa2, 0x7b,
-	0xc4, 0x17, 0x4b, 0x32, 0xca, 0x6d, 0x80, 0x32, 0xe4, 0x6f, 0x6d, 0x41, 0xaf, 0x21, 0x62, 0xb9,
-	0xf2, 0xdb, 0x2e, 0x95, 0x8d, 0x41, 0x1d, 0x73, 0x5d, 0xbd, 0x80, 0xcd, 0xfb, 0xc4, 0x7d, 0x69,
-	0xb8, 0x84, 0x42, 0x23, 0x95, 0x23, 0x68, 0xab, 0x3f, 0xce, 0x82, 0xe2, 0x56, 0xe0, 0x98, 0x47,
-	0x60, 0x3a, 0xe1, 0xdc, 0x9b, 0x97, 0x38, 0x6e, 0xc9, 0x10, 0xd7, 0xd6, 0xf3, 0xad, 0x8f, 0xef,
-	0xc0, 0x97, 0x60, 0x46, 0x36, 0xcf, 0xed, 0xcb, 0xb7, 0x92, 0x52, 0x6a, 0xef, 0xe5, 0x4e, 0x79,
-	0x1e, 0x80, 0x6c, 0x67, 0xdb, 0xba, 0x5c, 0x72, 0x01, 0x48, 0x31, 0x99, 0x21, 0x00, 0xd9, 0x61,
-	0x6a, 0x00, 0x90, 0x52, 0x74, 0x00, 0xa4, 0x38, 0x4f, 0xf3, 0x5b, 0x03, 0xcc, 0x29, 0x6d, 0xe7,
-	0xba, 0x6e, 0x57, 0x93, 0x79, 0xb5, 0x0f, 0xc6, 0xcb, 0x4b, 0x00, 0x52, 0xda, 0x32, 0xed, 0x43,
-	0x96, 0x1f, 0x50, 0x96, 0x73, 0x61, 0x80, 0x94, 0xbe, 0x65, 0x5d, 0xb7, 0xeb, 0xf9, 0x01, 0x65,
-	0x99, 0x1a, 0xf3, 0x7b, 0x03, 0x5c, 0x55, 0x5b, 0x9a, 0x8d, 0x9c, 0xbd, 0x8f, 0x12, 0x6b, 0x1f,
-	0x8e, 0x99, 0x98, 0xc0, 0xa4, 0x76, 0x2d, 0x1b, 0x39, 0xdb, 0x9f, 0x07, 0x53, 0xa6, 0x37, 0x61,
-	0x98, 0xd4, 0xce, 0x64, 0x23, 0xa7, 0x02, 0x79, 0x30, 0x65, 0xfa, 0x16, 0xf3, 0x1b, 0x03, 0x98,
-	0x0a, 0xd3, 0xb2, 0x96, 0xb3, 0xff, 0x2c, 0xab, 0xf6, 0xfe, 0x38, 0x59, 0x09, 0x28, 0x0a, 0xb7,
-	0xb1, 0x96, 0xb3, 0xed, 0xda, 0x50, 0xd2, 0x9d, 0x02, 0x83, 0xa2, 0xf0, 0x09, 0x6b, 0x39, 0xbb,
-	0xad, 0x0d, 0x25, 0xdd, 0x42, 0x84, 0x0f, 0xb2, 0xec, 0x1f, 0x34, 0x1e, 0x64, 0x29, 0x45, 0xe7,
-	0x41, 0x4e, 0xb1, 0x0b, 0x5c, 0x96, 0x51, 0xb3, 0xa0, 0x23, 0xcb, 0x48, 0x96, 0x96, 0x2c, 0xa9,
-	0xf6, 0xa0, 0x36, 0xf9, 0xd5, 0xc5, 0xe9, 0x8a, 0xd1, 0xb1, 0x9e, 0x9e, 0xd5, 0x8d, 0x67, 0x67,
-	0x75, 0xe3, 0xdf, 0xb3, 0xba, 0xf1, 0xdd, 0x79, 0x7d, 0xe2, 0xd9, 0x79, 0x7d, 0xe2, 0xef, 0xf3,
-	0xfa, 0xc4, 0xe7, 0xef, 0x3a, 0x98, 0xf6, 0x0f, 0xf7, 0x9a, 0x36, 0x71, 0x5b, 0x7d, 0xe8, 0x05,
-	0xfd, 0x47, 0xc3, 0x5f, 0xd3, 0x6e, 0xa4, 0xfc, 0xbe, 0x46, 0x4f, 0x06, 0x28, 0xd8, 0x2b, 0xb3,
-	0x9f, 0x0d, 0x6f, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x2e, 0xfa, 0xbe, 0xf6, 0x15, 0x00,
-	0x00,
+func (m *MsgSubmitStorageProof) Reset()         { *m = MsgSubmitStorageProof{} }
+func (m *MsgSubmitStorageProof) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitStorageProof) ProtoMessage()    {}
+func (*MsgSubmitStorageProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a668097e3ff363a4, []int{30}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	CreateFileEntry(ctx context.Context, in *MsgCreateFileEntry, opts ...grpc.CallOption) (*MsgCreateFileEntryResponse, error)
-	UpdateFileEntry(ctx context.Context, in *MsgUpdateFileEntry, opts ...grpc.CallOption) (*MsgUpdateFileEntryResponse, error)
-	DeleteFileEntry(ctx context.Context, in *MsgDeleteFileEntry, opts ...grpc.CallOption) (*MsgDeleteFileEntryResponse, error)
-	CreateHostingInquiry(ctx context.Context, in *MsgCreateHostingInquiry, opts ...grpc.CallOption) (*MsgCreateHostingInquiryResponse, error)
-	UpdateHostingInquiry(ctx context.Context, in *MsgUpdateHostingInquiry, opts ...grpc.CallOption) (*MsgUpdateHostingInquiryResponse, error)
-	DeleteHostingInquiry(ctx context.Context, in *MsgDeleteHostingInquiry, opts ...grpc.CallOption) (*MsgDeleteHostingInquiryResponse, error)
-	CreateHostingContract(ctx context.Context, in *MsgCreateHostingContract, opts ...grpc.CallOption) (*MsgCreateHostingContractResponse, error)
-	UpdateHostingContract(ctx context.Context, in *MsgUpdateHostingContract, opts ...grpc.CallOption) (*MsgUpdateHostingContractResponse, error)
-	DeleteHostingContract(ctx context.Context, in *MsgDeleteHostingContract, opts ...grpc.CallOption) (*MsgDeleteHostingContractResponse, error)
-	CreateHostingOffer(ctx context.Context, in *MsgCreateHostingOffer, opts ...grpc.CallOption) (*MsgCreateHostingOfferResponse, error)
-	UpdateHostingOffer(ctx context.Context, in *MsgUpdateHostingOffer, opts ...grpc.CallOption) (*MsgUpdateHostingOfferResponse, error)
-	DeleteHostingOffer(ctx context.Context, in *MsgDeleteHostingOffer, opts ...grpc.CallOption) (*MsgDeleteHostingOfferResponse, error)
-	StakeForHosting(ctx context.Context, in *MsgStakeForHosting, opts ...grpc.CallOption) (*MsgStakeForHostingResponse, error)
-	UnstakeFromHosting(ctx context.Context, in *MsgUnstakeFromHosting, opts ...grpc.CallOption) (*MsgUnstakeFromHostingResponse, error)
+func (m *MsgSubmitStorageProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSubmitStorageProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitStorageProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSubmitStorageProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitStorageProof.Merge(m, src)
+}
+func (m *MsgSubmitStorageProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitStorageProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitStorageProof.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitStorageProof proto.InternalMessageInfo
+
+func (m *MsgSubmitStorageProof) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateFileEntry(ctx context.Context, in *MsgCreateFileEntry, opts ...grpc.CallOption) (*MsgCreateFileEntryResponse, error) {
-	out := new(MsgCreateFileEntryResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/CreateFileEntry", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitStorageProof) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateFileEntry(ctx context.Context, in *MsgUpdateFileEntry, opts ...grpc.CallOption) (*MsgUpdateFileEntryResponse, error) {
-	out := new(MsgUpdateFileEntryResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/UpdateFileEntry", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitStorageProof) GetProofs() []ChunkProof {
+	if m != nil {
+		return m.Proofs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) DeleteFileEntry(ctx context.Context, in *MsgDeleteFileEntry, opts ...grpc.CallOption) (*MsgDeleteFileEntryResponse, error) {
-	out := new(MsgDeleteFileEntryResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/DeleteFileEntry", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgSubmitStorageProofResponse struct {
+	Verified bool `protobuf:"varint,1,opt,name=verified,proto3" json:"verified,omitempty"`
 }
 
-func (c *msgClient) CreateHostingInquiry(ctx context.Context, in *MsgCreateHostingInquiry, opts ...grpc.CallOption) (*MsgCreateHostingInquiryResponse, error) {
-	out := new(MsgCreateHostingInquiryResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/CreateHostingInquiry", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitStorageProofResponse) Reset()         { *m = MsgSubmitStorageProofResponse{} }
+func (m *MsgSubmitStorageProofResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitStorageProofResponse) ProtoMessage()    {}
+func (*MsgSubmitStorageProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a668097e3ff363a4, []int{31}
+}
+func (m *MsgSubmitStorageProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitStorageProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitStorageProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSubmitStorageProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitStorageProofResponse.Merge(m, src)
+}
+func (m *MsgSubmitStorageProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitStorageProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitStorageProofResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateHostingInquiry(ctx context.Context, in *MsgUpdateHostingInquiry, opts ...grpc.CallOption) (*MsgUpdateHostingInquiryResponse, error) {
-	out := new(MsgUpdateHostingInquiryResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/UpdateHostingInquiry", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitStorageProofResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitStorageProofResponse) GetVerified() bool {
+	if m != nil {
+		return m.Verified
 	}
-	return out, nil
+	return false
 }
 
-func (c *msgClient) DeleteHostingInquiry(ctx context.Context, in *MsgDeleteHostingInquiry, opts ...grpc.CallOption) (*MsgDeleteHostingInquiryResponse, error) {
-	out := new(MsgDeleteHostingInquiryResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/DeleteHostingInquiry", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgAcceptHostingContract is sent by the provider of a PENDING contract once
+// it has fetched the file. The attestation is the Merkle root the provider
+// computed over its copy and must match the file entry's commitment, and the
+// proof shows the provider holds the contract's acceptance chunk under it.
+type MsgAcceptHostingContract struct {
+	Creator     string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId  uint64     `protobuf:"varint,2,opt,name=contractId,proto3" json:"contractId,omitempty"`
+	Attestation []byte     `protobuf:"bytes,3,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	Proof       ChunkProof `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof"`
 }
 
-func (c *msgClient) CreateHostingContract(ctx context.Context, in *MsgCreateHostingContract, opts ...grpc.CallOption) (*MsgCreateHostingContractResponse, error) {
-	out := new(MsgCreateHostingContractResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/CreateHostingContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptHostingContract) Reset()         { *m = MsgAcceptHostingContract{} }
+func (m *MsgAcceptHostingContract) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptHostingContract) ProtoMessage()    {}
+func (*MsgAcceptHostingContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a668097e3ff363a4, []int{32}
+}
+func (m *MsgAcceptHostingContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptHostingContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptHostingContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAcceptHostingContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptHostingContract.Merge(m, src)
+}
+func (m *MsgAcceptHostingContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptHostingContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptHostingContract.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateHostingContract(ctx context.Context, in *MsgUpdateHostingContract, opts ...grpc.CallOption) (*MsgUpdateHostingContractResponse, error) {
-	out := new(MsgUpdateHostingContractResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/UpdateHostingContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAcceptHostingContract proto.InternalMessageInfo
+
+func (m *MsgAcceptHostingContract) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DeleteHostingContract(ctx context.Context, in *MsgDeleteHostingContract, opts ...grpc.CallOption) (*MsgDeleteHostingContractResponse, error) {
-	out := new(MsgDeleteHostingContractResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/DeleteHostingContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptHostingContract) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) CreateHostingOffer(ctx context.Context, in *MsgCreateHostingOffer, opts ...grpc.CallOption) (*MsgCreateHostingOfferResponse, error) {
-	out := new(MsgCreateHostingOfferResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/CreateHostingOffer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptHostingContract) GetAttestation() []byte {
+	if m != nil {
+		return m.Attestation
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) UpdateHostingOffer(ctx context.Context, in *MsgUpdateHostingOffer, opts ...grpc.CallOption) (*MsgUpdateHostingOfferResponse, error) {
-	out := new(MsgUpdateHostingOfferResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/UpdateHostingOffer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptHostingContract) GetProof() ChunkProof {
+	if m != nil {
+		return m.Proof
 	}
-	return out, nil
+	return ChunkProof{}
 }
 
-func (c *msgClient) DeleteHostingOffer(ctx context.Context, in *MsgDeleteHostingOffer, opts ...grpc.CallOption) (*MsgDeleteHostingOfferResponse, error) {
-	out := new(MsgDeleteHostingOfferResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/DeleteHostingOffer", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgAcceptHostingContractResponse struct {
 }
 
-func (c *msgClient) StakeForHosting(ctx context.Context, in *MsgStakeForHosting, opts ...grpc.CallOption) (*MsgStakeForHostingResponse, error) {
-	out := new(MsgStakeForHostingResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/StakeForHosting", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptHostingContractResponse) Reset()         { *m = MsgAcceptHostingContractResponse{} }
+func (m *MsgAcceptHostingContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptHostingContractResponse) ProtoMessage()    {}
+func (*MsgAcceptHostingContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a668097e3ff363a4, []int{33}
+}
+func (m *MsgAcceptHostingContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptHostingContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptHostingContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAcceptHostingContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptHostingContractResponse.Merge(m, src)
+}
+func (m *MsgAcceptHostingContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptHostingContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptHostingContractResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UnstakeFromHosting(ctx context.Context, in *MsgUnstakeFromHosting, opts ...grpc.CallOption) (*MsgUnstakeFromHostingResponse, error) {
-	out := new(MsgUnstakeFromHostingResponse)
-	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/UnstakeFromHosting", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAcceptHostingContractResponse proto.InternalMessageInfo
+
+type MsgRejectHostingContract struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contractId,proto3" json:"contractId,omitempty"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgRejectHostingContract) Reset()         { *m = MsgRejectHostingContract{} }
+func (m *MsgRejectHostingContract) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectHostingContract) ProtoMessage()    {}
+func (*MsgRejectHostingContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a668097e3ff363a4, []int{34}
+}
+func (m *MsgRejectHostingContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectHostingContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectHostingContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRejectHostingContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectHostingContract.Merge(m, src)
+}
+func (m *MsgRejectHostingContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectHostingContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectHostingContract.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	CreateFileEntry(context.Context, *MsgCreateFileEntry) (*MsgCreateFileEntryResponse, error)
-	UpdateFileEntry(context.Context, *MsgUpdateFileEntry) (*MsgUpdateFileEntryResponse, error)
-	DeleteFileEntry(context.Context, *MsgDeleteFileEntry) (*MsgDeleteFileEntryResponse, error)
-	CreateHostingInquiry(context.Context, *MsgCreateHostingInquiry) (*MsgCreateHostingInquiryResponse, error)
-	UpdateHostingInquiry(context.Context, *MsgUpdateHostingInquiry) (*MsgUpdateHostingInquiryResponse, error)
-	DeleteHostingInquiry(context.Context, *MsgDeleteHostingInquiry) (*MsgDeleteHostingInquiryResponse, error)
-	CreateHostingContract(context.Context, *MsgCreateHostingContract) (*MsgCreateHostingContractResponse, error)
-	UpdateHostingContract(context.Context, *MsgUpdateHostingContract) (*MsgUpdateHostingContractResponse, error)
-	DeleteHostingContract(context.Context, *MsgDeleteHostingContract) (*MsgDeleteHostingContractResponse, error)
-	CreateHostingOffer(context.Context, *MsgCreateHostingOffer) (*MsgCreateHostingOfferResponse, error)
-	UpdateHostingOffer(context.Context, *MsgUpdateHostingOffer) (*MsgUpdateHostingOfferResponse, error)
-	DeleteHostingOffer(context.Context, *MsgDeleteHostingOffer) (*MsgDeleteHostingOfferResponse, error)
-	StakeForHosting(context.Context, *MsgStakeForHosting) (*MsgStakeForHostingResponse, error)
-	UnstakeFromHosting(context.Context, *MsgUnstakeFromHosting) (*MsgUnstakeFromHostingResponse, error)
+var xxx_messageInfo_MsgRejectHostingContract proto.InternalMessageInfo
+
+func (m *MsgRejectHostingContract) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgRejectHostingContract) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgRejectHostingContract) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) CreateFileEntry(ctx context.Context, req *MsgCreateFileEntry) (*MsgCreateFileEntryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateFileEntry not implemented")
+
+type MsgRejectHostingContractResponse struct {
 }
-func (*UnimplementedMsgServer) UpdateFileEntry(ctx context.Context, req *MsgUpdateFileEntry) (*MsgUpdateFileEntryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateFileEntry not implemented")
+
+func (m *MsgRejectHostingContractResponse) Reset()         { *m = MsgRejectHostingContractResponse{} }
+func (m *MsgRejectHostingContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectHostingContractResponse) ProtoMessage()    {}
+func (*MsgRejectHostingContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a668097e3ff363a4, []int{35}
 }
-func (*UnimplementedMsgServer) DeleteFileEntry(ctx context.Context, req *MsgDeleteFileEntry) (*MsgDeleteFileEntryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteFileEntry not implemented")
+func (m *MsgRejectHostingContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateHostingInquiry(ctx context.Context, req *MsgCreateHostingInquiry) (*MsgCreateHostingInquiryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateHostingInquiry not implemented")
+func (m *MsgRejectHostingContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectHostingContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateHostingInquiry(ctx context.Context, req *MsgUpdateHostingInquiry) (*MsgUpdateHostingInquiryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateHostingInquiry not implemented")
+func (m *MsgRejectHostingContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectHostingContractResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) DeleteHostingInquiry(ctx context.Context, req *MsgDeleteHostingInquiry) (*MsgDeleteHostingInquiryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteHostingInquiry not implemented")
+func (m *MsgRejectHostingContractResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) CreateHostingContract(ctx context.Context, req *MsgCreateHostingContract) (*MsgCreateHostingContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateHostingContract not implemented")
+func (m *MsgRejectHostingContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectHostingContractResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) UpdateHostingContract(ctx context.Context, req *MsgUpdateHostingContract) (*MsgUpdateHostingContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateHostingContract not implemented")
+
+var xxx_messageInfo_MsgRejectHostingContractResponse proto.InternalMessageInfo
+
+// MsgCommitBid bids one of the provider's offers in the auction of an
+// inquiry. The commitment is types.BidCommitment of the price the provider
+// will reveal; committing again replaces the bid.
+type MsgCommitBid struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	InquiryId  uint64 `protobuf:"varint,2,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	OfferId    uint64 `protobuf:"varint,3,opt,name=offerId,proto3" json:"offerId,omitempty"`
+	Commitment []byte `protobuf:"bytes,4,opt,name=commitment,proto3" json:"commitment,omitempty"`
 }
-func (*UnimplementedMsgServer) DeleteHostingContract(ctx context.Context, req *MsgDeleteHostingContract) (*MsgDeleteHostingContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteHostingContract not implemented")
+
+func (m *MsgCommitBid) Reset()         { *m = MsgCommitBid{} }
+func (m *MsgCommitBid) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitBid) ProtoMessage()    {}
+func (*MsgCommitBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a668097e3ff363a4, []int{36}
 }
-func (*UnimplementedMsgServer) CreateHostingOffer(ctx context.Context, req *MsgCreateHostingOffer) (*MsgCreateHostingOfferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateHostingOffer not implemented")
+func (m *MsgCommitBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateHostingOffer(ctx context.Context, req *MsgUpdateHostingOffer) (*MsgUpdateHostingOfferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateHostingOffer not implemented")
+func (m *MsgCommitBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) DeleteHostingOffer(ctx context.Context, req *MsgDeleteHostingOffer) (*MsgDeleteHostingOfferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteHostingOffer not implemented")
+func (m *MsgCommitBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitBid.Merge(m, src)
 }
-func (*UnimplementedMsgServer) StakeForHosting(ctx context.Context, req *MsgStakeForHosting) (*MsgStakeForHostingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StakeForHosting not implemented")
+func (m *MsgCommitBid) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UnstakeFromHosting(ctx context.Context, req *MsgUnstakeFromHosting) (*MsgUnstakeFromHostingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnstakeFromHosting not implemented")
+func (m *MsgCommitBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitBid.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgCommitBid proto.InternalMessageInfo
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgCommitBid) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateFileEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateFileEntry)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateFileEntry(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Msg/CreateFileEntry",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateFileEntry(ctx, req.(*MsgCreateFileEntry))
+func (m *MsgCommitBid) GetInquiryId() uint64 {
+	if m != nil {
+		return m.InquiryId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateFileEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateFileEntry)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCommitBid) GetOfferId() uint64 {
+	if m != nil {
+		return m.OfferId
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateFileEntry(ctx, in)
+	return 0
+}
+
+func (m *MsgCommitBid) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Msg/UpdateFileEntry",
+	return nil
+}
+
+type MsgCommitBidResponse struct {
+}
+
+func (m *MsgCommitBidResponse) Reset()         { *m = MsgCommitBidResponse{} }
+func (m *MsgCommitBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitBidResponse) ProtoMessage()    {}
+func (*MsgCommitBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a668097e3ff363a4, []int{37}
+}
+func (m *MsgCommitBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateFileEntry(ctx, req.(*MsgUpdateFileEntry))
+}
+func (m *MsgCommitBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitBidResponse.Merge(m, src)
+}
+func (m *MsgCommitBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitBidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitBidResponse proto.InternalMessageInfo
+
+// MsgRevealBid reveals the price of a committed bid once bidding closed.
+type MsgRevealBid struct {
+	Creator   string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	InquiryId uint64     `protobuf:"varint,2,opt,name=inquiryId,proto3" json:"inquiryId,omitempty"`
+	Price     types.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price"`
+	Salt      []byte     `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty"`
+}
+
+func (m *MsgRevealBid) Reset()         { *m = MsgRevealBid{} }
+func (m *MsgRevealBid) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealBid) ProtoMessage()    {}
+func (*MsgRevealBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a668097e3ff363a4, []int{38}
+}
+func (m *MsgRevealBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRevealBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealBid.Merge(m, src)
+}
+func (m *MsgRevealBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealBid.DiscardUnknown(m)
 }
 
-func _Msg_DeleteFileEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteFileEntry)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRevealBid proto.InternalMessageInfo
+
+func (m *MsgRevealBid) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteFileEntry(ctx, in)
+	return ""
+}
+
+func (m *MsgRevealBid) GetInquiryId() uint64 {
+	if m != nil {
+		return m.InquiryId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Msg/DeleteFileEntry",
+	return 0
+}
+
+func (m *MsgRevealBid) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteFileEntry(ctx, req.(*MsgDeleteFileEntry))
+	return types.Coin{}
+}
+
+func (m *MsgRevealBid) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_CreateHostingInquiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateHostingInquiry)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateHostingInquiry(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Msg/CreateHostingInquiry",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateHostingInquiry(ctx, req.(*MsgCreateHostingInquiry))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgRevealBidResponse struct {
 }
 
-func _Msg_UpdateHostingInquiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateHostingInquiry)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateHostingInquiry(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Msg/UpdateHostingInquiry",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateHostingInquiry(ctx, req.(*MsgUpdateHostingInquiry))
+func (m *MsgRevealBidResponse) Reset()         { *m = MsgRevealBidResponse{} }
+func (m *MsgRevealBidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealBidResponse) ProtoMessage()    {}
+func (*MsgRevealBidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a668097e3ff363a4, []int{39}
+}
+func (m *MsgRevealBidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealBidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealBidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRevealBidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealBidResponse.Merge(m, src)
+}
+func (m *MsgRevealBidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealBidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealBidResponse.DiscardUnknown(m)
 }
 
-func _Msg_DeleteHostingInquiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteHostingInquiry)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteHostingInquiry(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Msg/DeleteHostingInquiry",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteHostingInquiry(ctx, req.(*MsgDeleteHostingInquiry))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgRevealBidResponse proto.InternalMessageInfo
+
+// MsgRegisterProviderProfile declares the provider's capacity, regions and
+// endpoints. Contracts the provider already holds count as used capacity.
+type MsgRegisterProviderProfile struct {
+	Creator       string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Moniker       string   `protobuf:"bytes,2,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	TotalCapacity uint64   `protobuf:"varint,3,opt,name=totalCapacity,proto3" json:"totalCapacity,omitempty"`
+	Regions       []string `protobuf:"bytes,4,rep,name=regions,proto3" json:"regions,omitempty"`
+	Endpoints     []string `protobuf:"bytes,5,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
 }
 
-func _Msg_CreateHostingContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateHostingContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateHostingContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Msg/CreateHostingContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateHostingContract(ctx, req.(*MsgCreateHostingContract))
+func (m *MsgRegisterProviderProfile) Reset()         { *m = MsgRegisterProviderProfile{} }
+func (m *MsgRegisterProviderProfile) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterProviderProfile) ProtoMessage()    {}
+func (*MsgRegisterProviderProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a668097e3ff363a4, []int{40}
+}
+func (m *MsgRegisterProviderProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterProviderProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterProviderProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRegisterProviderProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterProviderProfile.Merge(m, src)
+}
+func (m *MsgRegisterProviderProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterProviderProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterProviderProfile.DiscardUnknown(m)
 }
 
-func _Msg_UpdateHostingContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateHostingContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateHostingContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Msg/UpdateHostingContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateHostingContract(ctx, req.(*MsgUpdateHostingContract))
+var xxx_messageInfo_MsgRegisterProviderProfile proto.InternalMessageInfo
+
+func (m *MsgRegisterProviderProfile) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DeleteHostingContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteHostingContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteHostingContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Msg/DeleteHostingContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteHostingContract(ctx, req.(*MsgDeleteHostingContract))
+func (m *MsgRegisterProviderProfile) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateHostingOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateHostingOffer)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateHostingOffer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Msg/CreateHostingOffer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateHostingOffer(ctx, req.(*MsgCreateHostingOffer))
+func (m *MsgRegisterProviderProfile) GetTotalCapacity() uint64 {
+	if m != nil {
+		return m.TotalCapacity
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateHostingOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateHostingOffer)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateHostingOffer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Msg/UpdateHostingOffer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateHostingOffer(ctx, req.(*MsgUpdateHostingOffer))
+func (m *MsgRegisterProviderProfile) GetRegions() []string {
+	if m != nil {
+		return m.Regions
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_DeleteHostingOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteHostingOffer)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteHostingOffer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Msg/DeleteHostingOffer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteHostingOffer(ctx, req.(*MsgDeleteHostingOffer))
+func (m *MsgRegisterProviderProfile) GetEndpoints() []string {
+	if m != nil {
+		return m.Endpoints
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_StakeForHosting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStakeForHosting)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).StakeForHosting(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Msg/StakeForHosting",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).StakeForHosting(ctx, req.(*MsgStakeForHosting))
+type MsgRegisterProviderProfileResponse struct {
+}
+
+func (m *MsgRegisterProviderProfileResponse) Reset()         { *m = MsgRegisterProviderProfileResponse{} }
+func (m *MsgRegisterProviderProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterProviderProfileResponse) ProtoMessage()    {}
+func (*MsgRegisterProviderProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a668097e3ff363a4, []int{41}
+}
+func (m *MsgRegisterProviderProfileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterProviderProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterProviderProfileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRegisterProviderProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterProviderProfileResponse.Merge(m, src)
+}
+func (m *MsgRegisterProviderProfileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterProviderProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterProviderProfileResponse.DiscardUnknown(m)
 }
 
-func _Msg_UnstakeFromHosting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnstakeFromHosting)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRegisterProviderProfileResponse proto.InternalMessageInfo
+
+// MsgUpdateProviderProfile replaces the declared fields of the provider's
+// profile; its used capacity is kept.
+type MsgUpdateProviderProfile struct {
+	Creator       string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Moniker       string   `protobuf:"bytes,2,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	TotalCapacity uint64   `protobuf:"varint,3,opt,name=totalCapacity,proto3" json:"totalCapacity,omitempty"`
+	Regions       []string `protobuf:"bytes,4,rep,name=regions,proto3" json:"regions,omitempty"`
+	Endpoints     []string `protobuf:"bytes,5,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+}
+
+func (m *MsgUpdateProviderProfile) Reset()         { *m = MsgUpdateProviderProfile{} }
+func (m *MsgUpdateProviderProfile) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProviderProfile) ProtoMessage()    {}
+func (*MsgUpdateProviderProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a668097e3ff363a4, []int{42}
+}
+func (m *MsgUpdateProviderProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateProviderProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateProviderProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UnstakeFromHosting(ctx, in)
+}
+func (m *MsgUpdateProviderProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProviderProfile.Merge(m, src)
+}
+func (m *MsgUpdateProviderProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateProviderProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProviderProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateProviderProfile proto.InternalMessageInfo
+
+func (m *MsgUpdateProviderProfile) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/filespacechain.filespacechain.Msg/UnstakeFromHosting",
+	return ""
+}
+
+func (m *MsgUpdateProviderProfile) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UnstakeFromHosting(ctx, req.(*MsgUnstakeFromHosting))
+	return ""
+}
+
+func (m *MsgUpdateProviderProfile) GetTotalCapacity() uint64 {
+	if m != nil {
+		return m.TotalCapacity
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "filespacechain.filespacechain.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateFileEntry",
-			Handler:    _Msg_CreateFileEntry_Handler,
-		},
-		{
-			MethodName: "UpdateFileEntry",
-			Handler:    _Msg_UpdateFileEntry_Handler,
-		},
-		{
-			MethodName: "DeleteFileEntry",
-			Handler:    _Msg_DeleteFileEntry_Handler,
-		},
-		{
-			MethodName: "CreateHostingInquiry",
-			Handler:    _Msg_CreateHostingInquiry_Handler,
-		},
-		{
-			MethodName: "UpdateHostingInquiry",
-			Handler:    _Msg_UpdateHostingInquiry_Handler,
-		},
-		{
-			MethodName: "DeleteHostingInquiry",
-			Handler:    _Msg_DeleteHostingInquiry_Handler,
-		},
-		{
-			MethodName: "CreateHostingContract",
-			Handler:    _Msg_CreateHostingContract_Handler,
-		},
-		{
-			MethodName: "UpdateHostingContract",
-			Handler:    _Msg_UpdateHostingContract_Handler,
-		},
-		{
-			MethodName: "DeleteHostingContract",
-			Handler:    _Msg_DeleteHostingContract_Handler,
-		},
-		{
-			MethodName: "CreateHostingOffer",
-			Handler:    _Msg_CreateHostingOffer_Handler,
-		},
-		{
-			MethodName: "UpdateHostingOffer",
-			Handler:    _Msg_UpdateHostingOffer_Handler,
-		},
-		{
-			MethodName: "DeleteHostingOffer",
-			Handler:    _Msg_DeleteHostingOffer_Handler,
-		},
-		{
-			MethodName: "StakeForHosting",
-			Handler:    _Msg_StakeForHosting_Handler,
-		},
-		{
-			MethodName: "UnstakeFromHosting",
-			Handler:    _Msg_UnstakeFromHosting_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "filespacechain/filespacechain/tx.proto",
+func (m *MsgUpdateProviderProfile) GetRegions() []string {
+	if m != nil {
+		return m.Regions
+	}
+	return nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateProviderProfile) GetEndpoints() []string {
+	if m != nil {
+		return m.Endpoints
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgUpdateProviderProfileResponse struct {
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateProviderProfileResponse) Reset()         { *m = MsgUpdateProviderProfileResponse{} }
+func (m *MsgUpdateProviderProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProviderProfileResponse) ProtoMessage()    {}
+func (*MsgUpdateProviderProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a668097e3ff363a4, []int{43}
+}
+func (m *MsgUpdateProviderProfileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateProviderProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateProviderProfileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateProviderProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProviderProfileResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateProviderProfileResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateProviderProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProviderProfileResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateFileEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgUpdateProviderProfileResponse proto.InternalMessageInfo
 
-func (m *MsgCreateFileEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgExtendHostingInquiry tops up the escrow of a running inquiry and pushes
+// out its end time together with the end blocks of its open contracts. The
+// additional escrow is in the inquiry's escrow denom and must cover the
+// extension.
+type MsgExtendHostingInquiry struct {
+	Creator          string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id               uint64     `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	EndTime          uint64     `protobuf:"varint,3,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	AdditionalEscrow types.Coin `protobuf:"bytes,4,opt,name=additionalEscrow,proto3" json:"additionalEscrow"`
 }
 
-func (m *MsgCreateFileEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FileSize != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FileSize))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.MetaData) > 0 {
-		i -= len(m.MetaData)
-		copy(dAtA[i:], m.MetaData)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MetaData)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ParentCid) > 0 {
-		i -= len(m.ParentCid)
-		copy(dAtA[i:], m.ParentCid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ParentCid)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.RootCid) > 0 {
-		i -= len(m.RootCid)
-		copy(dAtA[i:], m.RootCid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RootCid)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgExtendHostingInquiry) Reset()         { *m = MsgExtendHostingInquiry{} }
+func (m *MsgExtendHostingInquiry) String() string { return proto.CompactTextString(m) }
+func (*MsgExtendHostingInquiry) ProtoMessage()    {}
+func (*MsgExtendHostingInquiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a668097e3ff363a4, []int{44}
+}
+func (m *MsgExtendHostingInquiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExtendHostingInquiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExtendHostingInquiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgExtendHostingInquiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExtendHostingInquiry.Merge(m, src)
+}
+func (m *MsgExtendHostingInquiry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExtendHostingInquiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExtendHostingInquiry.DiscardUnknown(m)
 }
 
-func (m *MsgCreateFileEntryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgExtendHostingInquiry proto.InternalMessageInfo
+
+func (m *MsgExtendHostingInquiry) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateFileEntryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgExtendHostingInquiry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgCreateFileEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgExtendHostingInquiry) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgUpdateFileEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgExtendHostingInquiry) GetAdditionalEscrow() types.Coin {
+	if m != nil {
+		return m.AdditionalEscrow
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgUpdateFileEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgExtendHostingInquiryResponse struct {
+	EscrowAmount types.Coin `protobuf:"bytes,1,opt,name=escrowAmount,proto3" json:"escrowAmount"`
 }
 
-func (m *MsgUpdateFileEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FileSize != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FileSize))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.MetaData) > 0 {
-		i -= len(m.MetaData)
-		copy(dAtA[i:], m.MetaData)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MetaData)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ParentCid) > 0 {
-		i -= len(m.ParentCid)
-		copy(dAtA[i:], m.ParentCid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ParentCid)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.RootCid) > 0 {
-		i -= len(m.RootCid)
-		copy(dAtA[i:], m.RootCid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RootCid)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgExtendHostingInquiryResponse) Reset()         { *m = MsgExtendHostingInquiryResponse{} }
+func (m *MsgExtendHostingInquiryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExtendHostingInquiryResponse) ProtoMessage()    {}
+func (*MsgExtendHostingInquiryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a668097e3ff363a4, []int{45}
 }
-
-func (m *MsgUpdateFileEntryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgExtendHostingInquiryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExtendHostingInquiryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExtendHostingInquiryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateFileEntryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgExtendHostingInquiryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExtendHostingInquiryResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateFileEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgExtendHostingInquiryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExtendHostingInquiryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExtendHostingInquiryResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteFileEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgExtendHostingInquiryResponse proto.InternalMessageInfo
+
+func (m *MsgExtendHostingInquiryResponse) GetEscrowAmount() types.Coin {
+	if m != nil {
+		return m.EscrowAmount
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgDeleteFileEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgAcceptHostingContractTransfer is sent by the provider of the offer a
+// contract was proposed to be handed over to. Like on acceptance, it proves
+// it holds the file by the contract's acceptance chunk.
+type MsgAcceptHostingContractTransfer struct {
+	Creator     string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId  uint64     `protobuf:"varint,2,opt,name=contractId,proto3" json:"contractId,omitempty"`
+	Attestation []byte     `protobuf:"bytes,3,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	Proof       ChunkProof `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof"`
 }
 
-func (m *MsgDeleteFileEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgAcceptHostingContractTransfer) Reset()         { *m = MsgAcceptHostingContractTransfer{} }
+func (m *MsgAcceptHostingContractTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptHostingContractTransfer) ProtoMessage()    {}
+func (*MsgAcceptHostingContractTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a668097e3ff363a4, []int{46}
+}
+func (m *MsgAcceptHostingContractTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptHostingContractTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptHostingContractTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAcceptHostingContractTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptHostingContractTransfer.Merge(m, src)
+}
+func (m *MsgAcceptHostingContractTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptHostingContractTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptHostingContractTransfer.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteFileEntryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAcceptHostingContractTransfer proto.InternalMessageInfo
+
+func (m *MsgAcceptHostingContractTransfer) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeleteFileEntryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAcceptHostingContractTransfer) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
 }
 
-func (m *MsgDeleteFileEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgAcceptHostingContractTransfer) GetAttestation() []byte {
+	if m != nil {
+		return m.Attestation
+	}
+	return nil
 }
 
-func (m *MsgCreateHostingInquiry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptHostingContractTransfer) GetProof() ChunkProof {
+	if m != nil {
+		return m.Proof
 	}
-	return dAtA[:n], nil
+	return ChunkProof{}
 }
 
-func (m *MsgCreateHostingInquiry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgAcceptHostingContractTransferResponse struct {
 }
 
-func (m *MsgCreateHostingInquiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MaxPricePerBlock.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgAcceptHostingContractTransferResponse) Reset() {
+	*m = MsgAcceptHostingContractTransferResponse{}
+}
+func (m *MsgAcceptHostingContractTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptHostingContractTransferResponse) ProtoMessage()    {}
+func (*MsgAcceptHostingContractTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a668097e3ff363a4, []int{47}
+}
+func (m *MsgAcceptHostingContractTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptHostingContractTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptHostingContractTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if m.EndTime != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EndTime))
-		i--
-		dAtA[i] = 0x28
+		return b[:n], nil
 	}
-	{
-		size, err := m.EscrowAmount.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgAcceptHostingContractTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptHostingContractTransferResponse.Merge(m, src)
+}
+func (m *MsgAcceptHostingContractTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptHostingContractTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptHostingContractTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptHostingContractTransferResponse proto.InternalMessageInfo
+
+// MsgReassignHostingContract is the Msg/ReassignHostingContract request type.
+type MsgReassignHostingContract struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contractId,proto3" json:"contractId,omitempty"`
+	OfferId    uint64 `protobuf:"varint,3,opt,name=offerId,proto3" json:"offerId,omitempty"`
+	Reason     string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgReassignHostingContract) Reset()         { *m = MsgReassignHostingContract{} }
+func (m *MsgReassignHostingContract) String() string { return proto.CompactTextString(m) }
+func (*MsgReassignHostingContract) ProtoMessage()    {}
+func (*MsgReassignHostingContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a668097e3ff363a4, []int{48}
+}
+func (m *MsgReassignHostingContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReassignHostingContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReassignHostingContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.ReplicationRate != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ReplicationRate))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.FileEntryCid) > 0 {
-		i -= len(m.FileEntryCid)
-		copy(dAtA[i:], m.FileEntryCid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FileEntryCid)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgReassignHostingContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReassignHostingContract.Merge(m, src)
+}
+func (m *MsgReassignHostingContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReassignHostingContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReassignHostingContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReassignHostingContract proto.InternalMessageInfo
+
+func (m *MsgReassignHostingContract) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateHostingInquiryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReassignHostingContract) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateHostingInquiryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReassignHostingContract) GetOfferId() uint64 {
+	if m != nil {
+		return m.OfferId
+	}
+	return 0
 }
 
-func (m *MsgCreateHostingInquiryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgReassignHostingContract) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateHostingInquiry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type MsgReassignHostingContractResponse struct {
 }
 
-func (m *MsgUpdateHostingInquiry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReassignHostingContractResponse) Reset()         { *m = MsgReassignHostingContractResponse{} }
+func (m *MsgReassignHostingContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReassignHostingContractResponse) ProtoMessage()    {}
+func (*MsgReassignHostingContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a668097e3ff363a4, []int{49}
 }
-
-func (m *MsgUpdateHostingInquiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MaxPricePerBlock.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	if m.EndTime != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EndTime))
-		i--
-		dAtA[i] = 0x30
-	}
-	{
-		size, err := m.EscrowAmount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgReassignHostingContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReassignHostingContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReassignHostingContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.ReplicationRate != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ReplicationRate))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.FileEntryCid) > 0 {
-		i -= len(m.FileEntryCid)
-		copy(dAtA[i:], m.FileEntryCid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FileEntryCid)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateHostingInquiryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgReassignHostingContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReassignHostingContractResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateHostingInquiryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReassignHostingContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReassignHostingContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReassignHostingContractResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateHostingInquiryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgReassignHostingContractResponse proto.InternalMessageInfo
+
+// MsgTerminateHostingContract ends an active contract before its end block.
+// It is sent by the contract's provider or by the creator of its inquiry; the
+// contract is settled pro rata and the party walking away pays a penalty.
+type MsgTerminateHostingContract struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contractId,proto3" json:"contractId,omitempty"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *MsgDeleteHostingInquiry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTerminateHostingContract) Reset()         { *m = MsgTerminateHostingContract{} }
+func (m *MsgTerminateHostingContract) String() string { return proto.CompactTextString(m) }
+func (*MsgTerminateHostingContract) ProtoMessage()    {}
+func (*MsgTerminateHostingContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a668097e3ff363a4, []int{50}
+}
+func (m *MsgTerminateHostingContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTerminateHostingContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTerminateHostingContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgDeleteHostingInquiry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTerminateHostingContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTerminateHostingContract.Merge(m, src)
+}
+func (m *MsgTerminateHostingContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTerminateHostingContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTerminateHostingContract.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteHostingInquiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgTerminateHostingContract proto.InternalMessageInfo
+
+func (m *MsgTerminateHostingContract) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDeleteHostingInquiryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTerminateHostingContract) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgDeleteHostingInquiryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTerminateHostingContract) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *MsgDeleteHostingInquiryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgTerminateHostingContractResponse struct {
+	Settled  types.Coin `protobuf:"bytes,1,opt,name=settled,proto3" json:"settled"`
+	Penalty  types.Coin `protobuf:"bytes,2,opt,name=penalty,proto3" json:"penalty"`
+	Refunded types.Coin `protobuf:"bytes,3,opt,name=refunded,proto3" json:"refunded"`
 }
 
-func (m *MsgCreateHostingContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgTerminateHostingContractResponse) Reset()         { *m = MsgTerminateHostingContractResponse{} }
+func (m *MsgTerminateHostingContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTerminateHostingContractResponse) ProtoMessage()    {}
+func (*MsgTerminateHostingContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a668097e3ff363a4, []int{51}
 }
-
-func (m *MsgCreateHostingContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTerminateHostingContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgCreateHostingContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OfferId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OfferId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.InquiryId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.InquiryId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgTerminateHostingContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTerminateHostingContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateHostingContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgTerminateHostingContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTerminateHostingContractResponse.Merge(m, src)
+}
+func (m *MsgTerminateHostingContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTerminateHostingContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTerminateHostingContractResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateHostingContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgTerminateHostingContractResponse proto.InternalMessageInfo
+
+func (m *MsgTerminateHostingContractResponse) GetSettled() types.Coin {
+	if m != nil {
+		return m.Settled
+	}
+	return types.Coin{}
 }
 
-func (m *MsgCreateHostingContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgTerminateHostingContractResponse) GetPenalty() types.Coin {
+	if m != nil {
+		return m.Penalty
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *MsgUpdateHostingContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTerminateHostingContractResponse) GetRefunded() types.Coin {
+	if m != nil {
+		return m.Refunded
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgUpdateHostingContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "filespacechain.filespacechain.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "filespacechain.filespacechain.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateFileEntry)(nil), "filespacechain.filespacechain.MsgCreateFileEntry")
+	proto.RegisterType((*MsgCreateFileEntryResponse)(nil), "filespacechain.filespacechain.MsgCreateFileEntryResponse")
+	proto.RegisterType((*MsgUpdateFileEntry)(nil), "filespacechain.filespacechain.MsgUpdateFileEntry")
+	proto.RegisterType((*MsgUpdateFileEntryResponse)(nil), "filespacechain.filespacechain.MsgUpdateFileEntryResponse")
+	proto.RegisterType((*MsgDeleteFileEntry)(nil), "filespacechain.filespacechain.MsgDeleteFileEntry")
+	proto.RegisterType((*MsgDeleteFileEntryResponse)(nil), "filespacechain.filespacechain.MsgDeleteFileEntryResponse")
+	proto.RegisterType((*MsgCreateHostingInquiry)(nil), "filespacechain.filespacechain.MsgCreateHostingInquiry")
+	proto.RegisterType((*MsgCreateHostingInquiryResponse)(nil), "filespacechain.filespacechain.MsgCreateHostingInquiryResponse")
+	proto.RegisterType((*MsgUpdateHostingInquiry)(nil), "filespacechain.filespacechain.MsgUpdateHostingInquiry")
+	proto.RegisterType((*MsgUpdateHostingInquiryResponse)(nil), "filespacechain.filespacechain.MsgUpdateHostingInquiryResponse")
+	proto.RegisterType((*MsgDeleteHostingInquiry)(nil), "filespacechain.filespacechain.MsgDeleteHostingInquiry")
+	proto.RegisterType((*MsgDeleteHostingInquiryResponse)(nil), "filespacechain.filespacechain.MsgDeleteHostingInquiryResponse")
+	proto.RegisterType((*MsgCreateHostingContract)(nil), "filespacechain.filespacechain.MsgCreateHostingContract")
+	proto.RegisterType((*MsgCreateHostingContractResponse)(nil), "filespacechain.filespacechain.MsgCreateHostingContractResponse")
+	proto.RegisterType((*MsgUpdateHostingContract)(nil), "filespacechain.filespacechain.MsgUpdateHostingContract")
+	proto.RegisterType((*MsgUpdateHostingContractResponse)(nil), "filespacechain.filespacechain.MsgUpdateHostingContractResponse")
+	proto.RegisterType((*MsgDeleteHostingContract)(nil), "filespacechain.filespacechain.MsgDeleteHostingContract")
+	proto.RegisterType((*MsgDeleteHostingContractResponse)(nil), "filespacechain.filespacechain.MsgDeleteHostingContractResponse")
+	proto.RegisterType((*MsgCreateHostingOffer)(nil), "filespacechain.filespacechain.MsgCreateHostingOffer")
+	proto.RegisterType((*MsgCreateHostingOfferResponse)(nil), "filespacechain.filespacechain.MsgCreateHostingOfferResponse")
+	proto.RegisterType((*MsgUpdateHostingOffer)(nil), "filespacechain.filespacechain.MsgUpdateHostingOffer")
+	proto.RegisterType((*MsgUpdateHostingOfferResponse)(nil), "filespacechain.filespacechain.MsgUpdateHostingOfferResponse")
+	proto.RegisterType((*MsgDeleteHostingOffer)(nil), "filespacechain.filespacechain.MsgDeleteHostingOffer")
+	proto.RegisterType((*MsgDeleteHostingOfferResponse)(nil), "filespacechain.filespacechain.MsgDeleteHostingOfferResponse")
+	proto.RegisterType((*MsgStakeForHosting)(nil), "filespacechain.filespacechain.MsgStakeForHosting")
+	proto.RegisterType((*MsgStakeForHostingResponse)(nil), "filespacechain.filespacechain.MsgStakeForHostingResponse")
+	proto.RegisterType((*MsgUnstakeFromHosting)(nil), "filespacechain.filespacechain.MsgUnstakeFromHosting")
+	proto.RegisterType((*MsgUnstakeFromHostingResponse)(nil), "filespacechain.filespacechain.MsgUnstakeFromHostingResponse")
+	proto.RegisterType((*MsgSubmitStorageProof)(nil), "filespacechain.filespacechain.MsgSubmitStorageProof")
+	proto.RegisterType((*MsgSubmitStorageProofResponse)(nil), "filespacechain.filespacechain.MsgSubmitStorageProofResponse")
+	proto.RegisterType((*MsgAcceptHostingContract)(nil), "filespacechain.filespacechain.MsgAcceptHostingContract")
+	proto.RegisterType((*MsgAcceptHostingContractResponse)(nil), "filespacechain.filespacechain.MsgAcceptHostingContractResponse")
+	proto.RegisterType((*MsgRejectHostingContract)(nil), "filespacechain.filespacechain.MsgRejectHostingContract")
+	proto.RegisterType((*MsgRejectHostingContractResponse)(nil), "filespacechain.filespacechain.MsgRejectHostingContractResponse")
+	proto.RegisterType((*MsgCommitBid)(nil), "filespacechain.filespacechain.MsgCommitBid")
+	proto.RegisterType((*MsgCommitBidResponse)(nil), "filespacechain.filespacechain.MsgCommitBidResponse")
+	proto.RegisterType((*MsgRevealBid)(nil), "filespacechain.filespacechain.MsgRevealBid")
+	proto.RegisterType((*MsgRevealBidResponse)(nil), "filespacechain.filespacechain.MsgRevealBidResponse")
+	proto.RegisterType((*MsgRegisterProviderProfile)(nil), "filespacechain.filespacechain.MsgRegisterProviderProfile")
+	proto.RegisterType((*MsgRegisterProviderProfileResponse)(nil), "filespacechain.filespacechain.MsgRegisterProviderProfileResponse")
+	proto.RegisterType((*MsgUpdateProviderProfile)(nil), "filespacechain.filespacechain.MsgUpdateProviderProfile")
+	proto.RegisterType((*MsgUpdateProviderProfileResponse)(nil), "filespacechain.filespacechain.MsgUpdateProviderProfileResponse")
+	proto.RegisterType((*MsgExtendHostingInquiry)(nil), "filespacechain.filespacechain.MsgExtendHostingInquiry")
+	proto.RegisterType((*MsgExtendHostingInquiryResponse)(nil), "filespacechain.filespacechain.MsgExtendHostingInquiryResponse")
+	proto.RegisterType((*MsgAcceptHostingContractTransfer)(nil), "filespacechain.filespacechain.MsgAcceptHostingContractTransfer")
+	proto.RegisterType((*MsgAcceptHostingContractTransferResponse)(nil), "filespacechain.filespacechain.MsgAcceptHostingContractTransferResponse")
+	proto.RegisterType((*MsgReassignHostingContract)(nil), "filespacechain.filespacechain.MsgReassignHostingContract")
+	proto.RegisterType((*MsgReassignHostingContractResponse)(nil), "filespacechain.filespacechain.MsgReassignHostingContractResponse")
+	proto.RegisterType((*MsgTerminateHostingContract)(nil), "filespacechain.filespacechain.MsgTerminateHostingContract")
+	proto.RegisterType((*MsgTerminateHostingContractResponse)(nil), "filespacechain.filespacechain.MsgTerminateHostingContractResponse")
 }
 
-func (m *MsgUpdateHostingContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OfferId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OfferId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.InquiryId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.InquiryId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("filespacechain/filespacechain/tx.proto", fileDescriptor_a668097e3ff363a4)
 }
 
-func (m *MsgUpdateHostingContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var fileDescriptor_a668097e3ff363a4 = []byte{
+	// 2052 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x6f, 0x24, 0x47,
+	0x15, 0xdf, 0xf6, 0x8c, 0xc7, 0x9e, 0xda, 0x49, 0x76, 0x69, 0x36, 0xd9, 0xd9, 0x61, 0x77, 0x76,
+	0x18, 0x42, 0x34, 0x38, 0x64, 0x06, 0xef, 0x2e, 0x36, 0xeb, 0x20, 0x22, 0x7b, 0xe2, 0x64, 0x57,
+	0xc1, 0x8a, 0x69, 0x2f, 0x17, 0x2e, 0xab, 0x72, 0x77, 0xcd, 0x4c, 0xe1, 0xee, 0xae, 0x49, 0x77,
+	0x8d, 0x63, 0x47, 0x42, 0x20, 0x4e, 0x28, 0x87, 0x08, 0x4e, 0x48, 0x08, 0xc1, 0x15, 0x38, 0x20,
+	0x1f, 0x38, 0x20, 0xc4, 0x1f, 0x10, 0x29, 0x12, 0x8a, 0x40, 0x82, 0x9c, 0x10, 0xda, 0x3d, 0xf8,
+	0xc4, 0x91, 0x3b, 0xaa, 0xea, 0x8f, 0xe9, 0xae, 0xa9, 0xee, 0xa9, 0x9e, 0xb5, 0x10, 0xb9, 0xac,
+	0xa7, 0x3e, 0x5e, 0xbd, 0xdf, 0xef, 0xd5, 0x7b, 0xaf, 0x5f, 0x3d, 0x2d, 0x78, 0x79, 0x80, 0x6d,
+	0xe4, 0x8f, 0xa1, 0x89, 0xcc, 0x11, 0xc4, 0x6e, 0x4f, 0x18, 0xd2, 0x93, 0xee, 0xd8, 0x23, 0x94,
+	0xe8, 0xb7, 0xd2, 0x0b, 0xdd, 0xf4, 0xb0, 0xf1, 0x39, 0xe8, 0x60, 0x97, 0xf4, 0xf8, 0xbf, 0x81,
+	0x44, 0xe3, 0xba, 0x49, 0x7c, 0x87, 0xf8, 0x3d, 0xc7, 0x1f, 0xf6, 0x8e, 0xd7, 0xd9, 0x9f, 0x70,
+	0xe1, 0x46, 0xb0, 0xf0, 0x98, 0x8f, 0x7a, 0xc1, 0x20, 0x5c, 0xba, 0x36, 0x24, 0x43, 0x12, 0xcc,
+	0xb3, 0x5f, 0xe1, 0xec, 0x5a, 0x3e, 0xc6, 0x31, 0xf4, 0xa0, 0x13, 0x9d, 0xd0, 0xcd, 0xdf, 0xcb,
+	0x86, 0x8f, 0x91, 0x4b, 0xbd, 0xd3, 0x70, 0xff, 0xdd, 0xfc, 0xfd, 0x23, 0xe2, 0x53, 0xec, 0x0e,
+	0x1f, 0x63, 0xf7, 0xdd, 0x09, 0x8e, 0x85, 0x9a, 0x21, 0xb5, 0x43, 0xe8, 0xa3, 0xde, 0xf1, 0xfa,
+	0x21, 0xa2, 0x70, 0xbd, 0x67, 0x12, 0xec, 0x86, 0xeb, 0xf7, 0xd4, 0x0e, 0x35, 0x89, 0x4b, 0x3d,
+	0x68, 0xd2, 0x50, 0x6a, 0x5d, 0x4d, 0x8a, 0x0c, 0x06, 0xc8, 0x53, 0x13, 0xf1, 0x29, 0xf1, 0xe0,
+	0x10, 0x31, 0x4b, 0x93, 0x41, 0x20, 0xd2, 0xfe, 0x87, 0x06, 0xae, 0xec, 0xf9, 0xc3, 0xef, 0x8e,
+	0x2d, 0x48, 0xd1, 0x3e, 0x37, 0x9d, 0xbe, 0x01, 0xaa, 0x70, 0x42, 0x47, 0xc4, 0xc3, 0xf4, 0xb4,
+	0xae, 0xb5, 0xb4, 0x4e, 0x75, 0xa7, 0xfe, 0xd7, 0x3f, 0xbc, 0x7a, 0x2d, 0xbc, 0x9b, 0x6d, 0xcb,
+	0xf2, 0x90, 0xef, 0x1f, 0x50, 0x0f, 0xbb, 0x43, 0x63, 0xba, 0x55, 0x7f, 0x00, 0x2a, 0x81, 0xf1,
+	0xeb, 0x4b, 0x2d, 0xad, 0x73, 0xf9, 0xce, 0x97, 0xbb, 0xb9, 0x5e, 0xd2, 0x0d, 0xd4, 0xed, 0x54,
+	0x3f, 0xfa, 0xe7, 0xed, 0x4b, 0xbf, 0x39, 0x3f, 0x5b, 0xd3, 0x8c, 0x50, 0x7e, 0x6b, 0xe7, 0xc7,
+	0xe7, 0x67, 0x6b, 0xd3, 0x93, 0x3f, 0x38, 0x3f, 0x5b, 0x13, 0xc9, 0x9c, 0x88, 0x13, 0x02, 0x8b,
+	0xf6, 0x0d, 0x70, 0x5d, 0x98, 0x32, 0x90, 0x3f, 0x26, 0xae, 0x8f, 0xda, 0xbf, 0x5c, 0x02, 0xfa,
+	0x9e, 0x3f, 0xec, 0x7b, 0x08, 0x52, 0xf4, 0x26, 0xb6, 0xd1, 0x2e, 0x73, 0x01, 0xbd, 0x0e, 0x56,
+	0x4c, 0x36, 0x45, 0xbc, 0x80, 0xb5, 0x11, 0x0d, 0xf5, 0xab, 0xa0, 0x64, 0x62, 0x8b, 0xd3, 0xaa,
+	0x1a, 0xec, 0x27, 0xdb, 0xeb, 0x11, 0x42, 0xfb, 0xd8, 0xaa, 0x97, 0x82, 0xbd, 0xe1, 0x50, 0xbf,
+	0x09, 0xaa, 0x63, 0xe8, 0x21, 0x97, 0xaf, 0x95, 0xf9, 0xda, 0x74, 0x42, 0x6f, 0x80, 0x55, 0x07,
+	0x51, 0xf8, 0x06, 0xa4, 0xb0, 0xbe, 0xcc, 0x17, 0xe3, 0x31, 0x5b, 0x63, 0x9c, 0x0e, 0xf0, 0xfb,
+	0xa8, 0x5e, 0x69, 0x69, 0x9d, 0xb2, 0x11, 0x8f, 0xf5, 0x26, 0x00, 0x0e, 0xf2, 0x8e, 0x6c, 0x64,
+	0x10, 0x42, 0xeb, 0x2b, 0x2d, 0xad, 0x53, 0x33, 0x12, 0x33, 0x6c, 0xdd, 0x1c, 0x4d, 0xdc, 0xa3,
+	0x3e, 0x99, 0xb8, 0xb4, 0xbe, 0xca, 0xa5, 0x13, 0x33, 0x0c, 0x15, 0x1f, 0xf1, 0xc3, 0xab, 0x7c,
+	0x79, 0x3a, 0xb1, 0x55, 0x63, 0xf6, 0x8e, 0xd8, 0xb6, 0xbf, 0x0a, 0x1a, 0xb3, 0xd6, 0x89, 0x8c,
+	0xa7, 0x3f, 0x0f, 0x96, 0xb0, 0xc5, 0x0d, 0x54, 0x36, 0x96, 0xb0, 0xd5, 0xfe, 0x8b, 0xc6, 0x8d,
+	0x19, 0x18, 0x5a, 0xc5, 0x98, 0xc1, 0x01, 0x4b, 0xd1, 0x01, 0x91, 0x71, 0x4b, 0x52, 0xe3, 0x96,
+	0x73, 0x8c, 0xbb, 0x9c, 0x67, 0xdc, 0x4a, 0x8e, 0x71, 0x57, 0xd2, 0xc6, 0x15, 0xe8, 0xdf, 0xe4,
+	0xf4, 0x05, 0x3e, 0xb1, 0xef, 0x7c, 0x9b, 0xb3, 0x7d, 0x03, 0xd9, 0x68, 0x21, 0xb6, 0x52, 0x5d,
+	0xc2, 0x69, 0xb1, 0xae, 0x7f, 0x97, 0xb8, 0x0f, 0x07, 0x37, 0xf1, 0x20, 0x08, 0xf8, 0x87, 0x41,
+	0xea, 0xc9, 0xd1, 0xd8, 0x06, 0xb5, 0x41, 0x74, 0x54, 0x3f, 0xf6, 0xda, 0xd4, 0x9c, 0xde, 0x01,
+	0x57, 0x3c, 0x34, 0xb6, 0xb1, 0x09, 0x29, 0x26, 0xae, 0x01, 0x29, 0xe2, 0xf6, 0x2f, 0x1b, 0xe2,
+	0xb4, 0xde, 0x07, 0x35, 0xe4, 0x9b, 0x1e, 0x79, 0x6f, 0xdb, 0xe1, 0xae, 0x55, 0xe6, 0xa1, 0x7d,
+	0xa3, 0x1b, 0x26, 0x03, 0x96, 0xf3, 0xba, 0x61, 0xce, 0xeb, 0xf6, 0x09, 0x76, 0x77, 0xca, 0x2c,
+	0x9c, 0x8d, 0x94, 0x10, 0x03, 0x8b, 0x5c, 0xeb, 0x11, 0x76, 0x10, 0xbf, 0xb4, 0xb2, 0x11, 0x0d,
+	0xf5, 0xb7, 0xc1, 0x55, 0x07, 0x9e, 0xec, 0x7b, 0xd8, 0x44, 0xfb, 0xc8, 0xdb, 0xb1, 0x89, 0x79,
+	0xc4, 0xaf, 0x4e, 0x41, 0xc5, 0x8c, 0xa0, 0xfe, 0x32, 0x78, 0x1e, 0xda, 0x36, 0x79, 0x0f, 0x59,
+	0x06, 0x1a, 0x62, 0xe2, 0xfa, 0xf5, 0x95, 0x56, 0xa9, 0x53, 0x35, 0x84, 0x59, 0xfd, 0x25, 0xf0,
+	0x9c, 0x85, 0x5c, 0x3c, 0xdd, 0xb6, 0xca, 0xb7, 0xa5, 0x27, 0xf5, 0xaf, 0x81, 0xcf, 0xc3, 0x89,
+	0xc9, 0x0c, 0xd1, 0x27, 0x8e, 0x83, 0x29, 0xd7, 0xe1, 0x87, 0xc1, 0x23, 0x5b, 0x4a, 0x48, 0x18,
+	0xe8, 0x18, 0x41, 0x3b, 0x94, 0x00, 0x29, 0x89, 0xe4, 0x92, 0xe0, 0x0d, 0xeb, 0xe0, 0x76, 0xc6,
+	0x75, 0x67, 0x46, 0xdf, 0xc7, 0x4b, 0x89, 0x34, 0xa7, 0xec, 0x22, 0x62, 0x08, 0x8a, 0x2e, 0x53,
+	0x52, 0x73, 0x99, 0xb2, 0x9a, 0xcb, 0x2c, 0x3f, 0xa3, 0xcb, 0x54, 0xe6, 0xbb, 0xcc, 0xca, 0x82,
+	0x2e, 0x23, 0x5c, 0xc0, 0x17, 0xf9, 0x05, 0xc8, 0x8c, 0x19, 0xc7, 0xe4, 0x77, 0xb8, 0xbd, 0x83,
+	0x88, 0x5d, 0xd4, 0xde, 0x52, 0xad, 0xb2, 0x23, 0x63, 0xad, 0xef, 0x83, 0xba, 0xe8, 0x19, 0xfd,
+	0xb0, 0x5c, 0xc8, 0x51, 0x7b, 0x13, 0x54, 0xc3, 0x4a, 0xe5, 0x61, 0xa4, 0x7d, 0x3a, 0xc1, 0xe4,
+	0x78, 0xf1, 0xf0, 0xd0, 0x0a, 0x63, 0x3f, 0x1a, 0x0a, 0xf0, 0xee, 0x80, 0x56, 0x96, 0xee, 0x4c,
+	0xb7, 0xfc, 0x40, 0xe3, 0x80, 0x53, 0x96, 0x54, 0x00, 0x2c, 0xfa, 0x65, 0x8a, 0x40, 0x29, 0x87,
+	0x40, 0x39, 0x8f, 0x40, 0x9b, 0x13, 0x90, 0x62, 0x89, 0x0d, 0x6c, 0x70, 0xbc, 0xa9, 0x3b, 0x28,
+	0x8e, 0x57, 0xaa, 0x57, 0x7a, 0x66, 0xac, 0xf7, 0x57, 0x1a, 0x78, 0x41, 0xb4, 0xee, 0x3b, 0x8c,
+	0x45, 0x8e, 0xd6, 0x17, 0x41, 0xc5, 0xe3, 0x39, 0x2a, 0x4c, 0xed, 0xe1, 0x48, 0xdf, 0x05, 0xcf,
+	0x8d, 0x53, 0x51, 0x51, 0x52, 0x8b, 0x8a, 0xb4, 0x94, 0x40, 0xa2, 0x07, 0x6e, 0x49, 0xf1, 0x65,
+	0x5e, 0xfd, 0xef, 0x03, 0x46, 0x29, 0x73, 0xcf, 0x63, 0x24, 0xde, 0xfb, 0x94, 0x61, 0x29, 0x9f,
+	0x61, 0xf9, 0x02, 0x18, 0xde, 0xe6, 0x0c, 0x67, 0xf1, 0xc6, 0x77, 0xf4, 0x0e, 0x27, 0x94, 0xba,
+	0xc7, 0x82, 0x84, 0xa4, 0x1a, 0x67, 0x0f, 0x8c, 0x35, 0x4e, 0x78, 0x91, 0x71, 0x40, 0xe1, 0x11,
+	0x7a, 0x93, 0x78, 0xe1, 0x96, 0x1c, 0x75, 0x9b, 0xa0, 0x02, 0x83, 0x5c, 0xbb, 0xa4, 0x66, 0x90,
+	0x70, 0xbb, 0xb4, 0x1a, 0x11, 0xd4, 0xc6, 0xa0, 0x4e, 0x82, 0x7b, 0x75, 0x7d, 0xbe, 0xee, 0x11,
+	0xe7, 0x7f, 0x86, 0xeb, 0xed, 0xe0, 0x86, 0x66, 0x34, 0xc7, 0x3e, 0xb8, 0x06, 0xae, 0x9a, 0xc4,
+	0x19, 0xdb, 0x88, 0x7d, 0x83, 0x1e, 0x20, 0x3c, 0x1c, 0xd1, 0xd0, 0x23, 0x67, 0xe6, 0xdb, 0xbf,
+	0x0b, 0xfc, 0xf3, 0x60, 0x72, 0xe8, 0x60, 0x7a, 0x10, 0x3c, 0x89, 0xf6, 0xd9, 0x8b, 0x28, 0x87,
+	0x47, 0x0b, 0x5c, 0x36, 0x47, 0xd0, 0xb6, 0x91, 0x3b, 0x44, 0x71, 0x2a, 0x4d, 0x4e, 0xe9, 0x6f,
+	0x81, 0x0a, 0x7f, 0x56, 0xf9, 0xf5, 0x52, 0xab, 0xd4, 0xb9, 0x7c, 0xe7, 0x2b, 0x73, 0xde, 0x3e,
+	0x7d, 0x56, 0x7b, 0x73, 0xb5, 0x11, 0xf3, 0x40, 0x5c, 0x60, 0xfe, 0x1a, 0x67, 0x3e, 0x8b, 0x35,
+	0x66, 0xde, 0x00, 0xab, 0xc7, 0xc8, 0xc3, 0x03, 0x8c, 0x82, 0x18, 0x5c, 0x35, 0xe2, 0x71, 0xfb,
+	0xe3, 0x20, 0x09, 0x6f, 0x9b, 0x26, 0x1a, 0x53, 0xf5, 0xa4, 0xc6, 0x9e, 0x12, 0xe1, 0xae, 0x98,
+	0x6b, 0x62, 0x86, 0x19, 0x03, 0x52, 0x8a, 0x7c, 0xca, 0xbf, 0xf8, 0x3c, 0x42, 0x6b, 0x46, 0x72,
+	0x4a, 0xdf, 0x05, 0xcb, 0x9c, 0x4d, 0x18, 0x9e, 0x85, 0x6d, 0x11, 0x48, 0x4b, 0xb3, 0xa9, 0x94,
+	0x8c, 0xf0, 0x99, 0x34, 0xd0, 0xf7, 0x91, 0x79, 0x81, 0x84, 0x79, 0x36, 0x82, 0x7e, 0x32, 0x1b,
+	0xb1, 0x91, 0x14, 0x9f, 0x54, 0x77, 0x8c, 0xef, 0x43, 0x0d, 0xd4, 0x58, 0x36, 0x0d, 0x8a, 0xc6,
+	0xe0, 0xa5, 0x73, 0xb1, 0xdf, 0xee, 0x80, 0x0c, 0x3b, 0xde, 0x41, 0x61, 0xb5, 0x5e, 0x33, 0x12,
+	0x33, 0x02, 0xe8, 0x17, 0xc1, 0xb5, 0x24, 0x9e, 0x18, 0xe8, 0xaf, 0x03, 0xa0, 0x61, 0xad, 0xfa,
+	0x0c, 0x40, 0xbf, 0xce, 0x5c, 0x01, 0x9b, 0x48, 0xf5, 0x5b, 0x14, 0xec, 0xd6, 0x75, 0x50, 0xf6,
+	0xa1, 0x1d, 0xe1, 0xe7, 0xbf, 0xa5, 0xc8, 0x63, 0x80, 0x31, 0xf2, 0x3f, 0x6b, 0x3c, 0x89, 0xb1,
+	0x22, 0xde, 0xa7, 0xc8, 0xdb, 0xf7, 0xc8, 0x31, 0xb6, 0xf8, 0x5f, 0xe6, 0x75, 0x39, 0x3c, 0xea,
+	0x60, 0xc5, 0x21, 0x2e, 0x3e, 0x42, 0x5e, 0xf8, 0x59, 0x8d, 0x86, 0xec, 0xb9, 0x40, 0x09, 0x85,
+	0x76, 0x1f, 0x8e, 0xa1, 0x89, 0xe9, 0x69, 0x68, 0xf2, 0xf4, 0x24, 0x7f, 0xb4, 0x86, 0xcf, 0x89,
+	0x32, 0x7f, 0x4e, 0x44, 0x43, 0x66, 0x21, 0xe4, 0x5a, 0x63, 0x82, 0x5d, 0xea, 0xd7, 0x97, 0xf9,
+	0xda, 0x74, 0x42, 0xa0, 0xf5, 0x12, 0x68, 0x67, 0xa3, 0x8f, 0x49, 0xfe, 0x29, 0x59, 0x5e, 0x7d,
+	0xd6, 0x28, 0x26, 0xcb, 0xb1, 0x2c, 0x82, 0x7f, 0xd4, 0x78, 0x99, 0xbd, 0x7b, 0x42, 0x91, 0x6b,
+	0x2d, 0xfc, 0xac, 0x49, 0xbc, 0x21, 0x4a, 0x33, 0x6f, 0x08, 0x68, 0x59, 0x98, 0x65, 0x2b, 0x68,
+	0xef, 0xf2, 0x77, 0x87, 0x6a, 0x2d, 0x31, 0x23, 0x28, 0xd0, 0x1b, 0xf0, 0x6a, 0x5e, 0x86, 0x3c,
+	0x4e, 0xda, 0xe2, 0x03, 0x49, 0x5b, 0xe0, 0x81, 0xd4, 0xfe, 0x9b, 0x96, 0x9d, 0x10, 0x1f, 0x79,
+	0xd0, 0xf5, 0xf3, 0x2b, 0x94, 0xff, 0xd3, 0x2c, 0xbf, 0x06, 0x3a, 0xf3, 0x48, 0xc5, 0x4e, 0xf2,
+	0x9f, 0x28, 0xd4, 0xa1, 0xef, 0xe3, 0xa1, 0x2b, 0x26, 0xfc, 0x45, 0xdb, 0x98, 0xf3, 0x2c, 0x93,
+	0x9d, 0x7b, 0xa7, 0x1f, 0x8a, 0x72, 0xea, 0x43, 0xb1, 0x37, 0xdb, 0xce, 0xdc, 0x52, 0x68, 0x67,
+	0x66, 0x10, 0x8b, 0x73, 0x84, 0x74, 0x35, 0xb6, 0xce, 0x0f, 0xc0, 0x17, 0xf6, 0xfc, 0xe1, 0x23,
+	0xe4, 0x39, 0xd8, 0x2d, 0xf4, 0x08, 0xbb, 0x98, 0xcf, 0xe1, 0xa7, 0x1a, 0xf8, 0x52, 0x8e, 0xfe,
+	0x38, 0x16, 0xee, 0x83, 0x15, 0x1f, 0x51, 0x6a, 0x87, 0xf5, 0x8b, 0x42, 0x18, 0x44, 0xfb, 0x99,
+	0xe8, 0x18, 0xb9, 0xd0, 0xa6, 0xa7, 0xaa, 0xe5, 0x65, 0xb4, 0x5f, 0x7f, 0x0d, 0xac, 0x7a, 0x68,
+	0x30, 0x71, 0x2d, 0x64, 0xa9, 0x7e, 0x99, 0x62, 0x81, 0x3b, 0x7f, 0xbf, 0x09, 0x4a, 0x7b, 0xfe,
+	0x50, 0x3f, 0x06, 0xb5, 0x54, 0xdf, 0xbc, 0x3b, 0x27, 0x02, 0x84, 0x76, 0x74, 0x63, 0xa3, 0xd8,
+	0xfe, 0xd8, 0x64, 0x3f, 0x04, 0x57, 0xc4, 0xd6, 0xf5, 0xfa, 0xfc, 0xa3, 0x04, 0x91, 0xc6, 0xfd,
+	0xc2, 0x22, 0x49, 0x00, 0x62, 0xbb, 0x77, 0x5d, 0x95, 0x4b, 0x21, 0x00, 0x19, 0x4d, 0x58, 0x06,
+	0x40, 0xec, 0xc0, 0x2a, 0x00, 0x10, 0x44, 0x54, 0x00, 0x64, 0x74, 0x66, 0xf5, 0x0f, 0x35, 0x70,
+	0x4d, 0xda, 0x96, 0xdd, 0x50, 0xb5, 0x6a, 0x5a, 0xae, 0xf1, 0xad, 0xc5, 0xe4, 0x52, 0x80, 0xa4,
+	0x4d, 0x40, 0x65, 0x27, 0x2b, 0x0e, 0x28, 0xaf, 0x4f, 0xc6, 0x01, 0x49, 0xbb, 0x64, 0x1b, 0xaa,
+	0x56, 0x2f, 0x0e, 0x28, 0xaf, 0x85, 0xa6, 0xff, 0x4c, 0x03, 0x2f, 0xc8, 0x1b, 0x68, 0x9b, 0x05,
+	0x6d, 0x1f, 0x09, 0x36, 0x5e, 0x5f, 0x50, 0x30, 0x85, 0x49, 0xde, 0x23, 0xdb, 0x2c, 0x68, 0xfe,
+	0x22, 0x98, 0x72, 0x3b, 0x61, 0x1c, 0x93, 0xbc, 0x0f, 0xb6, 0x59, 0xf0, 0x06, 0x8a, 0x60, 0xca,
+	0xed, 0x92, 0xe9, 0x3f, 0xd1, 0x80, 0x2e, 0x69, 0x91, 0xdd, 0x2b, 0x68, 0x7f, 0x2e, 0xd5, 0xf8,
+	0xe6, 0x22, 0x52, 0x29, 0x28, 0x92, 0xde, 0xd6, 0xbd, 0x82, 0x66, 0x57, 0x86, 0x92, 0xdd, 0x97,
+	0xe2, 0x50, 0x24, 0x5d, 0xa9, 0x7b, 0x05, 0xad, 0xad, 0x0c, 0x25, 0xbb, 0x61, 0xc5, 0x12, 0xb2,
+	0xd8, 0xad, 0x52, 0x48, 0xc8, 0x82, 0x88, 0x4a, 0x42, 0xce, 0x68, 0x4e, 0x05, 0xd7, 0x32, 0xdb,
+	0x9a, 0x52, 0xb9, 0x96, 0x19, 0x29, 0xa5, 0x6b, 0xc9, 0x6e, 0x46, 0x31, 0x28, 0x92, 0xee, 0x92,
+	0x02, 0x94, 0x59, 0x29, 0x15, 0x28, 0x39, 0xdd, 0x21, 0x16, 0xcb, 0xf2, 0xf6, 0x8f, 0x42, 0x2c,
+	0x4b, 0x05, 0x55, 0x62, 0x39, 0xb7, 0x47, 0xc3, 0x31, 0xc9, 0x3b, 0x34, 0x0a, 0x98, 0xa4, 0x82,
+	0x2a, 0x98, 0x72, 0xfb, 0x32, 0xba, 0x03, 0xaa, 0xd3, 0x9e, 0xcc, 0x2b, 0x0a, 0xf9, 0x21, 0xda,
+	0xdc, 0xb8, 0x5b, 0x60, 0x73, 0x52, 0xdd, 0xb4, 0xb3, 0xf2, 0x8a, 0x0a, 0xf8, 0x70, 0xb3, 0x8a,
+	0xba, 0x99, 0x96, 0x88, 0xfe, 0x73, 0x0d, 0x5c, 0xcf, 0xea, 0x87, 0xdc, 0x57, 0x39, 0x50, 0x2a,
+	0xda, 0xd8, 0x5e, 0x58, 0x54, 0xf2, 0xfd, 0x13, 0x71, 0x29, 0x7f, 0xff, 0x44, 0x54, 0xaf, 0x2f,
+	0x28, 0x98, 0x2a, 0x5c, 0xa4, 0x7d, 0x07, 0x85, 0xc2, 0x45, 0x26, 0xa7, 0x52, 0xb8, 0xe4, 0x76,
+	0x0b, 0x7e, 0xab, 0x81, 0x5b, 0xf9, 0xaf, 0xfc, 0x45, 0x63, 0x32, 0x3a, 0xa0, 0xf1, 0xd6, 0x33,
+	0x1e, 0x10, 0x63, 0xfd, 0x85, 0x06, 0xea, 0x99, 0x4f, 0xce, 0xad, 0xf9, 0x5a, 0xb2, 0x64, 0x1b,
+	0x3b, 0x8b, 0xcb, 0x0a, 0x71, 0x20, 0x6f, 0x16, 0x28, 0xc5, 0x81, 0x54, 0x54, 0x2d, 0x0e, 0x72,
+	0xdf, 0xea, 0x8d, 0xe5, 0x1f, 0x9d, 0x9f, 0xad, 0x69, 0x3b, 0xc6, 0x47, 0x4f, 0x9a, 0xda, 0x27,
+	0x4f, 0x9a, 0xda, 0xbf, 0x9e, 0x34, 0xb5, 0x9f, 0x3e, 0x6d, 0x5e, 0xfa, 0xe4, 0x69, 0xf3, 0xd2,
+	0xa7, 0x4f, 0x9b, 0x97, 0xbe, 0xf7, 0x8d, 0x21, 0xa6, 0xa3, 0xc9, 0x61, 0xd7, 0x24, 0x4e, 0x6f,
+	0x04, 0x5d, 0x7f, 0xf4, 0xee, 0xb4, 0x5f, 0xf0, 0x6a, 0x46, 0x07, 0x81, 0x9e, 0x8e, 0x91, 0x7f,
+	0x58, 0xe1, 0xff, 0xcf, 0xeb, 0xee, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xd1, 0xb5, 0xa1, 0xaf,
+	0xda, 0x27, 0x00, 0x00,
 }
 
-func (m *MsgUpdateHostingContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	CreateFileEntry(ctx context.Context, in *MsgCreateFileEntry, opts ...grpc.CallOption) (*MsgCreateFileEntryResponse, error)
+	UpdateFileEntry(ctx context.Context, in *MsgUpdateFileEntry, opts ...grpc.CallOption) (*MsgUpdateFileEntryResponse, error)
+	DeleteFileEntry(ctx context.Context, in *MsgDeleteFileEntry, opts ...grpc.CallOption) (*MsgDeleteFileEntryResponse, error)
+	CreateHostingInquiry(ctx context.Context, in *MsgCreateHostingInquiry, opts ...grpc.CallOption) (*MsgCreateHostingInquiryResponse, error)
+	UpdateHostingInquiry(ctx context.Context, in *MsgUpdateHostingInquiry, opts ...grpc.CallOption) (*MsgUpdateHostingInquiryResponse, error)
+	DeleteHostingInquiry(ctx context.Context, in *MsgDeleteHostingInquiry, opts ...grpc.CallOption) (*MsgDeleteHostingInquiryResponse, error)
+	CreateHostingContract(ctx context.Context, in *MsgCreateHostingContract, opts ...grpc.CallOption) (*MsgCreateHostingContractResponse, error)
+	UpdateHostingContract(ctx context.Context, in *MsgUpdateHostingContract, opts ...grpc.CallOption) (*MsgUpdateHostingContractResponse, error)
+	DeleteHostingContract(ctx context.Context, in *MsgDeleteHostingContract, opts ...grpc.CallOption) (*MsgDeleteHostingContractResponse, error)
+	CreateHostingOffer(ctx context.Context, in *MsgCreateHostingOffer, opts ...grpc.CallOption) (*MsgCreateHostingOfferResponse, error)
+	UpdateHostingOffer(ctx context.Context, in *MsgUpdateHostingOffer, opts ...grpc.CallOption) (*MsgUpdateHostingOfferResponse, error)
+	DeleteHostingOffer(ctx context.Context, in *MsgDeleteHostingOffer, opts ...grpc.CallOption) (*MsgDeleteHostingOfferResponse, error)
+	StakeForHosting(ctx context.Context, in *MsgStakeForHosting, opts ...grpc.CallOption) (*MsgStakeForHostingResponse, error)
+	UnstakeFromHosting(ctx context.Context, in *MsgUnstakeFromHosting, opts ...grpc.CallOption) (*MsgUnstakeFromHostingResponse, error)
+	SubmitStorageProof(ctx context.Context, in *MsgSubmitStorageProof, opts ...grpc.CallOption) (*MsgSubmitStorageProofResponse, error)
+	AcceptHostingContract(ctx context.Context, in *MsgAcceptHostingContract, opts ...grpc.CallOption) (*MsgAcceptHostingContractResponse, error)
+	RejectHostingContract(ctx context.Context, in *MsgRejectHostingContract, opts ...grpc.CallOption) (*MsgRejectHostingContractResponse, error)
+	CommitBid(ctx context.Context, in *MsgCommitBid, opts ...grpc.CallOption) (*MsgCommitBidResponse, error)
+	RevealBid(ctx context.Context, in *MsgRevealBid, opts ...grpc.CallOption) (*MsgRevealBidResponse, error)
+	RegisterProviderProfile(ctx context.Context, in *MsgRegisterProviderProfile, opts ...grpc.CallOption) (*MsgRegisterProviderProfileResponse, error)
+	UpdateProviderProfile(ctx context.Context, in *MsgUpdateProviderProfile, opts ...grpc.CallOption) (*MsgUpdateProviderProfileResponse, error)
+	ExtendHostingInquiry(ctx context.Context, in *MsgExtendHostingInquiry, opts ...grpc.CallOption) (*MsgExtendHostingInquiryResponse, error)
+	AcceptHostingContractTransfer(ctx context.Context, in *MsgAcceptHostingContractTransfer, opts ...grpc.CallOption) (*MsgAcceptHostingContractTransferResponse, error)
+	TerminateHostingContract(ctx context.Context, in *MsgTerminateHostingContract, opts ...grpc.CallOption) (*MsgTerminateHostingContractResponse, error)
+	// ReassignHostingContract defines a (governance) operation for handing a
+	// hosting contract over to another provider without its consent.
+	ReassignHostingContract(ctx context.Context, in *MsgReassignHostingContract, opts ...grpc.CallOption) (*MsgReassignHostingContractResponse, error)
 }
 
-func (m *MsgUpdateHostingContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgDeleteHostingContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteHostingContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateFileEntry(ctx context.Context, in *MsgCreateFileEntry, opts ...grpc.CallOption) (*MsgCreateFileEntryResponse, error) {
+	out := new(MsgCreateFileEntryResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/CreateFileEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteHostingContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UpdateFileEntry(ctx context.Context, in *MsgUpdateFileEntry, opts ...grpc.CallOption) (*MsgUpdateFileEntryResponse, error) {
+	out := new(MsgUpdateFileEntryResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/UpdateFileEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteHostingContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteFileEntry(ctx context.Context, in *MsgDeleteFileEntry, opts ...grpc.CallOption) (*MsgDeleteFileEntryResponse, error) {
+	out := new(MsgDeleteFileEntryResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/DeleteFileEntry", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteHostingContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateHostingInquiry(ctx context.Context, in *MsgCreateHostingInquiry, opts ...grpc.CallOption) (*MsgCreateHostingInquiryResponse, error) {
+	out := new(MsgCreateHostingInquiryResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/CreateHostingInquiry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteHostingContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) UpdateHostingInquiry(ctx context.Context, in *MsgUpdateHostingInquiry, opts ...grpc.CallOption) (*MsgUpdateHostingInquiryResponse, error) {
+	out := new(MsgUpdateHostingInquiryResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/UpdateHostingInquiry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateHostingOffer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteHostingInquiry(ctx context.Context, in *MsgDeleteHostingInquiry, opts ...grpc.CallOption) (*MsgDeleteHostingInquiryResponse, error) {
+	out := new(MsgDeleteHostingInquiryResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/DeleteHostingInquiry", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateHostingOffer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateHostingContract(ctx context.Context, in *MsgCreateHostingContract, opts ...grpc.CallOption) (*MsgCreateHostingContractResponse, error) {
+	out := new(MsgCreateHostingContractResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/CreateHostingContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateHostingOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PricePerBlock.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Region) > 0 {
-		i -= len(m.Region)
-		copy(dAtA[i:], m.Region)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Region)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UpdateHostingContract(ctx context.Context, in *MsgUpdateHostingContract, opts ...grpc.CallOption) (*MsgUpdateHostingContractResponse, error) {
+	out := new(MsgUpdateHostingContractResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/UpdateHostingContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCreateHostingOfferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteHostingContract(ctx context.Context, in *MsgDeleteHostingContract, opts ...grpc.CallOption) (*MsgDeleteHostingContractResponse, error) {
+	out := new(MsgDeleteHostingContractResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/DeleteHostingContract", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateHostingOfferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCreateHostingOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) CreateHostingOffer(ctx context.Context, in *MsgCreateHostingOffer, opts ...grpc.CallOption) (*MsgCreateHostingOfferResponse, error) {
+	out := new(MsgCreateHostingOfferResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/CreateHostingOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateHostingOffer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateHostingOffer(ctx context.Context, in *MsgUpdateHostingOffer, opts ...grpc.CallOption) (*MsgUpdateHostingOfferResponse, error) {
+	out := new(MsgUpdateHostingOfferResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/UpdateHostingOffer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateHostingOffer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgUpdateHostingOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PricePerBlock.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Region) > 0 {
-		i -= len(m.Region)
-		copy(dAtA[i:], m.Region)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Region)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) DeleteHostingOffer(ctx context.Context, in *MsgDeleteHostingOffer, opts ...grpc.CallOption) (*MsgDeleteHostingOfferResponse, error) {
+	out := new(MsgDeleteHostingOfferResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/DeleteHostingOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateHostingOfferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) StakeForHosting(ctx context.Context, in *MsgStakeForHosting, opts ...grpc.CallOption) (*MsgStakeForHostingResponse, error) {
+	out := new(MsgStakeForHostingResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/StakeForHosting", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateHostingOfferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateHostingOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteHostingOffer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UnstakeFromHosting(ctx context.Context, in *MsgUnstakeFromHosting, opts ...grpc.CallOption) (*MsgUnstakeFromHostingResponse, error) {
+	out := new(MsgUnstakeFromHostingResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/UnstakeFromHosting", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeleteHostingOffer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgDeleteHostingOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SubmitStorageProof(ctx context.Context, in *MsgSubmitStorageProof, opts ...grpc.CallOption) (*MsgSubmitStorageProofResponse, error) {
+	out := new(MsgSubmitStorageProofResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/SubmitStorageProof", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteHostingOfferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AcceptHostingContract(ctx context.Context, in *MsgAcceptHostingContract, opts ...grpc.CallOption) (*MsgAcceptHostingContractResponse, error) {
+	out := new(MsgAcceptHostingContractResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/AcceptHostingContract", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDeleteHostingOfferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDeleteHostingOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgStakeForHosting) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RejectHostingContract(ctx context.Context, in *MsgRejectHostingContract, opts ...grpc.CallOption) (*MsgRejectHostingContractResponse, error) {
+	out := new(MsgRejectHostingContractResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/RejectHostingContract", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgStakeForHosting) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgStakeForHosting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CommitBid(ctx context.Context, in *MsgCommitBid, opts ...grpc.CallOption) (*MsgCommitBidResponse, error) {
+	out := new(MsgCommitBidResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/CommitBid", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgStakeForHostingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RevealBid(ctx context.Context, in *MsgRevealBid, opts ...grpc.CallOption) (*MsgRevealBidResponse, error) {
+	out := new(MsgRevealBidResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/RevealBid", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgStakeForHostingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgStakeForHostingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) RegisterProviderProfile(ctx context.Context, in *MsgRegisterProviderProfile, opts ...grpc.CallOption) (*MsgRegisterProviderProfileResponse, error) {
+	out := new(MsgRegisterProviderProfileResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/RegisterProviderProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUnstakeFromHosting) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateProviderProfile(ctx context.Context, in *MsgUpdateProviderProfile, opts ...grpc.CallOption) (*MsgUpdateProviderProfileResponse, error) {
+	out := new(MsgUpdateProviderProfileResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/UpdateProviderProfile", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUnstakeFromHosting) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ExtendHostingInquiry(ctx context.Context, in *MsgExtendHostingInquiry, opts ...grpc.CallOption) (*MsgExtendHostingInquiryResponse, error) {
+	out := new(MsgExtendHostingInquiryResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/ExtendHostingInquiry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUnstakeFromHosting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) AcceptHostingContractTransfer(ctx context.Context, in *MsgAcceptHostingContractTransfer, opts ...grpc.CallOption) (*MsgAcceptHostingContractTransferResponse, error) {
+	out := new(MsgAcceptHostingContractTransferResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/AcceptHostingContractTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUnstakeFromHostingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TerminateHostingContract(ctx context.Context, in *MsgTerminateHostingContract, opts ...grpc.CallOption) (*MsgTerminateHostingContractResponse, error) {
+	out := new(MsgTerminateHostingContractResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/TerminateHostingContract", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUnstakeFromHostingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ReassignHostingContract(ctx context.Context, in *MsgReassignHostingContract, opts ...grpc.CallOption) (*MsgReassignHostingContractResponse, error) {
+	out := new(MsgReassignHostingContractResponse)
+	err := c.cc.Invoke(ctx, "/filespacechain.filespacechain.Msg/ReassignHostingContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUnstakeFromHostingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	CreateFileEntry(context.Context, *MsgCreateFileEntry) (*MsgCreateFileEntryResponse, error)
+	UpdateFileEntry(context.Context, *MsgUpdateFileEntry) (*MsgUpdateFileEntryResponse, error)
+	DeleteFileEntry(context.Context, *MsgDeleteFileEntry) (*MsgDeleteFileEntryResponse, error)
+	CreateHostingInquiry(context.Context, *MsgCreateHostingInquiry) (*MsgCreateHostingInquiryResponse, error)
+	UpdateHostingInquiry(context.Context, *MsgUpdateHostingInquiry) (*MsgUpdateHostingInquiryResponse, error)
+	DeleteHostingInquiry(context.Context, *MsgDeleteHostingInquiry) (*MsgDeleteHostingInquiryResponse, error)
+	CreateHostingContract(context.Context, *MsgCreateHostingContract) (*MsgCreateHostingContractResponse, error)
+	UpdateHostingContract(context.Context, *MsgUpdateHostingContract) (*MsgUpdateHostingContractResponse, error)
+	DeleteHostingContract(context.Context, *MsgDeleteHostingContract) (*MsgDeleteHostingContractResponse, error)
+	CreateHostingOffer(context.Context, *MsgCreateHostingOffer) (*MsgCreateHostingOfferResponse, error)
+	UpdateHostingOffer(context.Context, *MsgUpdateHostingOffer) (*MsgUpdateHostingOfferResponse, error)
+	DeleteHostingOffer(context.Context, *MsgDeleteHostingOffer) (*MsgDeleteHostingOfferResponse, error)
+	StakeForHosting(context.Context, *MsgStakeForHosting) (*MsgStakeForHostingResponse, error)
+	UnstakeFromHosting(context.Context, *MsgUnstakeFromHosting) (*MsgUnstakeFromHostingResponse, error)
+	SubmitStorageProof(context.Context, *MsgSubmitStorageProof) (*MsgSubmitStorageProofResponse, error)
+	AcceptHostingContract(context.Context, *MsgAcceptHostingContract) (*MsgAcceptHostingContractResponse, error)
+	RejectHostingContract(context.Context, *MsgRejectHostingContract) (*MsgRejectHostingContractResponse, error)
+	CommitBid(context.Context, *MsgCommitBid) (*MsgCommitBidResponse, error)
+	RevealBid(context.Context, *MsgRevealBid) (*MsgRevealBidResponse, error)
+	RegisterProviderProfile(context.Context, *MsgRegisterProviderProfile) (*MsgRegisterProviderProfileResponse, error)
+	UpdateProviderProfile(context.Context, *MsgUpdateProviderProfile) (*MsgUpdateProviderProfileResponse, error)
+	ExtendHostingInquiry(context.Context, *MsgExtendHostingInquiry) (*MsgExtendHostingInquiryResponse, error)
+	AcceptHostingContractTransfer(context.Context, *MsgAcceptHostingContractTransfer) (*MsgAcceptHostingContractTransferResponse, error)
+	TerminateHostingContract(context.Context, *MsgTerminateHostingContract) (*MsgTerminateHostingContractResponse, error)
+	// ReassignHostingContract defines a (governance) operation for handing a
+	// hosting contract over to another provider without its consent.
+	ReassignHostingContract(context.Context, *MsgReassignHostingContract) (*MsgReassignHostingContractResponse, error)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreateFileEntry(ctx context.Context, req *MsgCreateFileEntry) (*MsgCreateFileEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFileEntry not implemented")
+}
+func (*UnimplementedMsgServer) UpdateFileEntry(ctx context.Context, req *MsgUpdateFileEntry) (*MsgUpdateFileEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFileEntry not implemented")
+}
+func (*UnimplementedMsgServer) DeleteFileEntry(ctx context.Context, req *MsgDeleteFileEntry) (*MsgDeleteFileEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFileEntry not implemented")
+}
+func (*UnimplementedMsgServer) CreateHostingInquiry(ctx context.Context, req *MsgCreateHostingInquiry) (*MsgCreateHostingInquiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHostingInquiry not implemented")
+}
+func (*UnimplementedMsgServer) UpdateHostingInquiry(ctx context.Context, req *MsgUpdateHostingInquiry) (*MsgUpdateHostingInquiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHostingInquiry not implemented")
+}
+func (*UnimplementedMsgServer) DeleteHostingInquiry(ctx context.Context, req *MsgDeleteHostingInquiry) (*MsgDeleteHostingInquiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHostingInquiry not implemented")
+}
+func (*UnimplementedMsgServer) CreateHostingContract(ctx context.Context, req *MsgCreateHostingContract) (*MsgCreateHostingContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHostingContract not implemented")
+}
+func (*UnimplementedMsgServer) UpdateHostingContract(ctx context.Context, req *MsgUpdateHostingContract) (*MsgUpdateHostingContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHostingContract not implemented")
+}
+func (*UnimplementedMsgServer) DeleteHostingContract(ctx context.Context, req *MsgDeleteHostingContract) (*MsgDeleteHostingContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHostingContract not implemented")
+}
+func (*UnimplementedMsgServer) CreateHostingOffer(ctx context.Context, req *MsgCreateHostingOffer) (*MsgCreateHostingOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHostingOffer not implemented")
+}
+func (*UnimplementedMsgServer) UpdateHostingOffer(ctx context.Context, req *MsgUpdateHostingOffer) (*MsgUpdateHostingOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHostingOffer not implemented")
+}
+func (*UnimplementedMsgServer) DeleteHostingOffer(ctx context.Context, req *MsgDeleteHostingOffer) (*MsgDeleteHostingOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHostingOffer not implemented")
+}
+func (*UnimplementedMsgServer) StakeForHosting(ctx context.Context, req *MsgStakeForHosting) (*MsgStakeForHostingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakeForHosting not implemented")
+}
+func (*UnimplementedMsgServer) UnstakeFromHosting(ctx context.Context, req *MsgUnstakeFromHosting) (*MsgUnstakeFromHostingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnstakeFromHosting not implemented")
+}
+func (*UnimplementedMsgServer) SubmitStorageProof(ctx context.Context, req *MsgSubmitStorageProof) (*MsgSubmitStorageProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitStorageProof not implemented")
+}
+func (*UnimplementedMsgServer) AcceptHostingContract(ctx context.Context, req *MsgAcceptHostingContract) (*MsgAcceptHostingContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptHostingContract not implemented")
+}
+func (*UnimplementedMsgServer) RejectHostingContract(ctx context.Context, req *MsgRejectHostingContract) (*MsgRejectHostingContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectHostingContract not implemented")
+}
+func (*UnimplementedMsgServer) CommitBid(ctx context.Context, req *MsgCommitBid) (*MsgCommitBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitBid not implemented")
+}
+func (*UnimplementedMsgServer) RevealBid(ctx context.Context, req *MsgRevealBid) (*MsgRevealBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealBid not implemented")
+}
+func (*UnimplementedMsgServer) RegisterProviderProfile(ctx context.Context, req *MsgRegisterProviderProfile) (*MsgRegisterProviderProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterProviderProfile not implemented")
+}
+func (*UnimplementedMsgServer) UpdateProviderProfile(ctx context.Context, req *MsgUpdateProviderProfile) (*MsgUpdateProviderProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProviderProfile not implemented")
+}
+func (*UnimplementedMsgServer) ExtendHostingInquiry(ctx context.Context, req *MsgExtendHostingInquiry) (*MsgExtendHostingInquiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendHostingInquiry not implemented")
+}
+func (*UnimplementedMsgServer) AcceptHostingContractTransfer(ctx context.Context, req *MsgAcceptHostingContractTransfer) (*MsgAcceptHostingContractTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptHostingContractTransfer not implemented")
+}
+func (*UnimplementedMsgServer) TerminateHostingContract(ctx context.Context, req *MsgTerminateHostingContract) (*MsgTerminateHostingContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateHostingContract not implemented")
+}
+func (*UnimplementedMsgServer) ReassignHostingContract(ctx context.Context, req *MsgReassignHostingContract) (*MsgReassignHostingContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignHostingContract not implemented")
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgCreateFileEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/UpdateParams",
 	}
-	l = len(m.RootCid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	l = len(m.ParentCid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateFileEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateFileEntry)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.MetaData)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateFileEntry(ctx, in)
 	}
-	if m.FileSize != 0 {
-		n += 1 + sovTx(uint64(m.FileSize))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/CreateFileEntry",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateFileEntry(ctx, req.(*MsgCreateFileEntry))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateFileEntryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateFileEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFileEntry)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFileEntry(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/UpdateFileEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFileEntry(ctx, req.(*MsgUpdateFileEntry))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateFileEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DeleteFileEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteFileEntry)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteFileEntry(ctx, in)
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/DeleteFileEntry",
 	}
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteFileEntry(ctx, req.(*MsgDeleteFileEntry))
 	}
-	l = len(m.RootCid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateHostingInquiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateHostingInquiry)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ParentCid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateHostingInquiry(ctx, in)
 	}
-	l = len(m.MetaData)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/CreateHostingInquiry",
 	}
-	if m.FileSize != 0 {
-		n += 1 + sovTx(uint64(m.FileSize))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateHostingInquiry(ctx, req.(*MsgCreateHostingInquiry))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateFileEntryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateHostingInquiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateHostingInquiry)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgDeleteFileEntry) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateHostingInquiry(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/UpdateHostingInquiry",
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateHostingInquiry(ctx, req.(*MsgUpdateHostingInquiry))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteFileEntryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DeleteHostingInquiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteHostingInquiry)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteHostingInquiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/DeleteHostingInquiry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteHostingInquiry(ctx, req.(*MsgDeleteHostingInquiry))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateHostingInquiry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_CreateHostingContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateHostingContract)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.FileEntryCid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateHostingContract(ctx, in)
 	}
-	if m.ReplicationRate != 0 {
-		n += 1 + sovTx(uint64(m.ReplicationRate))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/CreateHostingContract",
 	}
-	l = m.EscrowAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.EndTime != 0 {
-		n += 1 + sovTx(uint64(m.EndTime))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateHostingContract(ctx, req.(*MsgCreateHostingContract))
 	}
-	l = m.MaxPricePerBlock.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateHostingInquiryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateHostingContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateHostingContract)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateHostingContract(ctx, in)
 	}
-	return n
-}
-
-func (m *MsgUpdateHostingInquiry) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/UpdateHostingContract",
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateHostingContract(ctx, req.(*MsgUpdateHostingContract))
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteHostingContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteHostingContract)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.FileEntryCid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteHostingContract(ctx, in)
 	}
-	if m.ReplicationRate != 0 {
-		n += 1 + sovTx(uint64(m.ReplicationRate))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/DeleteHostingContract",
 	}
-	l = m.EscrowAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.EndTime != 0 {
-		n += 1 + sovTx(uint64(m.EndTime))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteHostingContract(ctx, req.(*MsgDeleteHostingContract))
 	}
-	l = m.MaxPricePerBlock.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateHostingInquiryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateHostingOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateHostingOffer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgDeleteHostingInquiry) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).CreateHostingOffer(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/CreateHostingOffer",
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateHostingOffer(ctx, req.(*MsgCreateHostingOffer))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteHostingInquiryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateHostingOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateHostingOffer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateHostingOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/UpdateHostingOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateHostingOffer(ctx, req.(*MsgUpdateHostingOffer))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateHostingContract) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DeleteHostingOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteHostingOffer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteHostingOffer(ctx, in)
 	}
-	if m.InquiryId != 0 {
-		n += 1 + sovTx(uint64(m.InquiryId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/DeleteHostingOffer",
 	}
-	if m.OfferId != 0 {
-		n += 1 + sovTx(uint64(m.OfferId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteHostingOffer(ctx, req.(*MsgDeleteHostingOffer))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateHostingContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_StakeForHosting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStakeForHosting)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(MsgServer).StakeForHosting(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/StakeForHosting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StakeForHosting(ctx, req.(*MsgStakeForHosting))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateHostingContract) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_UnstakeFromHosting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnstakeFromHosting)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(MsgServer).UnstakeFromHosting(ctx, in)
 	}
-	if m.InquiryId != 0 {
-		n += 1 + sovTx(uint64(m.InquiryId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/UnstakeFromHosting",
 	}
-	if m.OfferId != 0 {
-		n += 1 + sovTx(uint64(m.OfferId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnstakeFromHosting(ctx, req.(*MsgUnstakeFromHosting))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateHostingContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func _Msg_SubmitStorageProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitStorageProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitStorageProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/SubmitStorageProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitStorageProof(ctx, req.(*MsgSubmitStorageProof))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteHostingContract) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AcceptHostingContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptHostingContract)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptHostingContract(ctx, in)
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/AcceptHostingContract",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptHostingContract(ctx, req.(*MsgAcceptHostingContract))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteHostingContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RejectHostingContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectHostingContract)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RejectHostingContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/RejectHostingContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectHostingContract(ctx, req.(*MsgRejectHostingContract))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateHostingOffer) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CommitBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitBid)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CommitBid(ctx, in)
 	}
-	l = len(m.Region)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/CommitBid",
 	}
-	l = m.PricePerBlock.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitBid(ctx, req.(*MsgCommitBid))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateHostingOfferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RevealBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealBid)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(MsgServer).RevealBid(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/RevealBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealBid(ctx, req.(*MsgRevealBid))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateHostingOffer) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RegisterProviderProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterProviderProfile)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterProviderProfile(ctx, in)
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/RegisterProviderProfile",
 	}
-	l = len(m.Region)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterProviderProfile(ctx, req.(*MsgRegisterProviderProfile))
 	}
-	l = m.PricePerBlock.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateHostingOfferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateProviderProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProviderProfile)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProviderProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/UpdateProviderProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProviderProfile(ctx, req.(*MsgUpdateProviderProfile))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteHostingOffer) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ExtendHostingInquiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendHostingInquiry)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendHostingInquiry(ctx, in)
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/ExtendHostingInquiry",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendHostingInquiry(ctx, req.(*MsgExtendHostingInquiry))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteHostingOfferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AcceptHostingContractTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptHostingContractTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptHostingContractTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/AcceptHostingContractTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptHostingContractTransfer(ctx, req.(*MsgAcceptHostingContractTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgStakeForHosting) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_TerminateHostingContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTerminateHostingContract)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).TerminateHostingContract(ctx, in)
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/TerminateHostingContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TerminateHostingContract(ctx, req.(*MsgTerminateHostingContract))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgStakeForHostingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ReassignHostingContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReassignHostingContract)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ReassignHostingContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filespacechain.filespacechain.Msg/ReassignHostingContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReassignHostingContract(ctx, req.(*MsgReassignHostingContract))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUnstakeFromHosting) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "filespacechain.filespacechain.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateFileEntry",
+			Handler:    _Msg_CreateFileEntry_Handler,
+		},
+		{
+			MethodName: "UpdateFileEntry",
+			Handler:    _Msg_UpdateFileEntry_Handler,
+		},
+		{
+			MethodName: "DeleteFileEntry",
+			Handler:    _Msg_DeleteFileEntry_Handler,
+		},
+		{
+			MethodName: "CreateHostingInquiry",
+			Handler:    _Msg_CreateHostingInquiry_Handler,
+		},
+		{
+			MethodName: "UpdateHostingInquiry",
+			Handler:    _Msg_UpdateHostingInquiry_Handler,
+		},
+		{
+			MethodName: "DeleteHostingInquiry",
+			Handler:    _Msg_DeleteHostingInquiry_Handler,
+		},
+		{
+			MethodName: "CreateHostingContract",
+			Handler:    _Msg_CreateHostingContract_Handler,
+		},
+		{
+			MethodName: "UpdateHostingContract",
+			Handler:    _Msg_UpdateHostingContract_Handler,
+		},
+		{
+			MethodName: "DeleteHostingContract",
+			Handler:    _Msg_DeleteHostingContract_Handler,
+		},
+		{
+			MethodName: "CreateHostingOffer",
+			Handler:    _Msg_CreateHostingOffer_Handler,
+		},
+		{
+			MethodName: "UpdateHostingOffer",
+			Handler:    _Msg_UpdateHostingOffer_Handler,
+		},
+		{
+			MethodName: "DeleteHostingOffer",
+			Handler:    _Msg_DeleteHostingOffer_Handler,
+		},
+		{
+			MethodName: "StakeForHosting",
+			Handler:    _Msg_StakeForHosting_Handler,
+		},
+		{
+			MethodName: "UnstakeFromHosting",
+			Handler:    _Msg_UnstakeFromHosting_Handler,
+		},
+		{
+			MethodName: "SubmitStorageProof",
+			Handler:    _Msg_SubmitStorageProof_Handler,
+		},
+		{
+			MethodName: "AcceptHostingContract",
+			Handler:    _Msg_AcceptHostingContract_Handler,
+		},
+		{
+			MethodName: "RejectHostingContract",
+			Handler:    _Msg_RejectHostingContract_Handler,
+		},
+		{
+			MethodName: "CommitBid",
+			Handler:    _Msg_CommitBid_Handler,
+		},
+		{
+			MethodName: "RevealBid",
+			Handler:    _Msg_RevealBid_Handler,
+		},
+		{
+			MethodName: "RegisterProviderProfile",
+			Handler:    _Msg_RegisterProviderProfile_Handler,
+		},
+		{
+			MethodName: "UpdateProviderProfile",
+			Handler:    _Msg_UpdateProviderProfile_Handler,
+		},
+		{
+			MethodName: "ExtendHostingInquiry",
+			Handler:    _Msg_ExtendHostingInquiry_Handler,
+		},
+		{
+			MethodName: "AcceptHostingContractTransfer",
+			Handler:    _Msg_AcceptHostingContractTransfer_Handler,
+		},
+		{
+			MethodName: "TerminateHostingContract",
+			Handler:    _Msg_TerminateHostingContract_Handler,
+		},
+		{
+			MethodName: "ReassignHostingContract",
+			Handler:    _Msg_ReassignHostingContract_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "filespacechain/filespacechain/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateFileEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFileEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFileEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChunkSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChunkSize))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ChunkCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChunkCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.FileSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FileSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MetaData) > 0 {
+		i -= len(m.MetaData)
+		copy(dAtA[i:], m.MetaData)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MetaData)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ParentCid) > 0 {
+		i -= len(m.ParentCid)
+		copy(dAtA[i:], m.ParentCid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ParentCid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RootCid) > 0 {
+		i -= len(m.RootCid)
+		copy(dAtA[i:], m.RootCid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RootCid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateFileEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFileEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFileEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFileEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFileEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFileEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FileSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FileSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MetaData) > 0 {
+		i -= len(m.MetaData)
+		copy(dAtA[i:], m.MetaData)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MetaData)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ParentCid) > 0 {
+		i -= len(m.ParentCid)
+		copy(dAtA[i:], m.ParentCid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ParentCid)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RootCid) > 0 {
+		i -= len(m.RootCid)
+		copy(dAtA[i:], m.RootCid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RootCid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFileEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFileEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFileEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteFileEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteFileEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteFileEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteFileEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteFileEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteFileEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateHostingInquiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateHostingInquiry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateHostingInquiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionRevealBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionRevealBlocks))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.AuctionCommitBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionCommitBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.DeniedRegions) > 0 {
+		for iNdEx := len(m.DeniedRegions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedRegions[iNdEx])
+			copy(dAtA[i:], m.DeniedRegions[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DeniedRegions[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AllowedRegions) > 0 {
+		for iNdEx := len(m.AllowedRegions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRegions[iNdEx])
+			copy(dAtA[i:], m.AllowedRegions[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedRegions[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.MaxPricePerBlock.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.EndTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.EscrowAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ReplicationRate != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReplicationRate))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FileEntryCid) > 0 {
+		i -= len(m.FileEntryCid)
+		copy(dAtA[i:], m.FileEntryCid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FileEntryCid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateHostingInquiryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateHostingInquiryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateHostingInquiryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateHostingInquiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateHostingInquiry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateHostingInquiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MaxPricePerBlock.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.EndTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.EscrowAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.ReplicationRate != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReplicationRate))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FileEntryCid) > 0 {
+		i -= len(m.FileEntryCid)
+		copy(dAtA[i:], m.FileEntryCid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FileEntryCid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateHostingInquiryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateHostingInquiryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateHostingInquiryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteHostingInquiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteHostingInquiry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteHostingInquiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteHostingInquiryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteHostingInquiryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteHostingInquiryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateHostingContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateHostingContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateHostingContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OfferId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OfferId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InquiryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.InquiryId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateHostingContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateHostingContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateHostingContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateHostingContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateHostingContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateHostingContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OfferId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OfferId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InquiryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.InquiryId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateHostingContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateHostingContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateHostingContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteHostingContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteHostingContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteHostingContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteHostingContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteHostingContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteHostingContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateHostingOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateHostingOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateHostingOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PricePerBlock.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Region)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateHostingOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateHostingOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateHostingOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateHostingOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateHostingOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateHostingOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PricePerBlock.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Region)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateHostingOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateHostingOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateHostingOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteHostingOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteHostingOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteHostingOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteHostingOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteHostingOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteHostingOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStakeForHosting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStakeForHosting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStakeForHosting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStakeForHostingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStakeForHostingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStakeForHostingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnstakeFromHosting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstakeFromHosting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstakeFromHosting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnstakeFromHostingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstakeFromHostingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstakeFromHostingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompletionHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitStorageProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitStorageProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitStorageProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitStorageProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitStorageProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitStorageProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptHostingContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptHostingContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptHostingContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Attestation) > 0 {
+		i -= len(m.Attestation)
+		copy(dAtA[i:], m.Attestation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Attestation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptHostingContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptHostingContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptHostingContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectHostingContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectHostingContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectHostingContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectHostingContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectHostingContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectHostingContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OfferId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OfferId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InquiryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.InquiryId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.InquiryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.InquiryId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealBidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealBidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterProviderProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterProviderProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterProviderProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Endpoints[iNdEx])
+			copy(dAtA[i:], m.Endpoints[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Endpoints[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Regions) > 0 {
+		for iNdEx := len(m.Regions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Regions[iNdEx])
+			copy(dAtA[i:], m.Regions[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Regions[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TotalCapacity != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalCapacity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterProviderProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterProviderProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterProviderProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProviderProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProviderProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProviderProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Endpoints[iNdEx])
+			copy(dAtA[i:], m.Endpoints[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Endpoints[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Regions) > 0 {
+		for iNdEx := len(m.Regions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Regions[iNdEx])
+			copy(dAtA[i:], m.Regions[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Regions[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TotalCapacity != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalCapacity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProviderProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProviderProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProviderProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendHostingInquiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendHostingInquiry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendHostingInquiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AdditionalEscrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.EndTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendHostingInquiryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendHostingInquiryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendHostingInquiryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EscrowAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptHostingContractTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptHostingContractTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptHostingContractTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Attestation) > 0 {
+		i -= len(m.Attestation)
+		copy(dAtA[i:], m.Attestation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Attestation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptHostingContractTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptHostingContractTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptHostingContractTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReassignHostingContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReassignHostingContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReassignHostingContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OfferId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OfferId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReassignHostingContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReassignHostingContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReassignHostingContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTerminateHostingContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTerminateHostingContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTerminateHostingContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTerminateHostingContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTerminateHostingContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTerminateHostingContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Refunded.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Penalty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Settled.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateFileEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RootCid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ParentCid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MetaData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FileSize != 0 {
+		n += 1 + sovTx(uint64(m.FileSize))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChunkCount != 0 {
+		n += 1 + sovTx(uint64(m.ChunkCount))
+	}
+	if m.ChunkSize != 0 {
+		n += 1 + sovTx(uint64(m.ChunkSize))
+	}
+	return n
+}
+
+func (m *MsgCreateFileEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgUpdateFileEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RootCid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ParentCid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MetaData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FileSize != 0 {
+		n += 1 + sovTx(uint64(m.FileSize))
+	}
+	return n
+}
+
+func (m *MsgUpdateFileEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteFileEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgDeleteFileEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateHostingInquiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FileEntryCid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReplicationRate != 0 {
+		n += 1 + sovTx(uint64(m.ReplicationRate))
+	}
+	l = m.EscrowAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.EndTime != 0 {
+		n += 1 + sovTx(uint64(m.EndTime))
+	}
+	l = m.MaxPricePerBlock.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.AllowedRegions) > 0 {
+		for _, s := range m.AllowedRegions {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.DeniedRegions) > 0 {
+		for _, s := range m.DeniedRegions {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.AuctionCommitBlocks != 0 {
+		n += 1 + sovTx(uint64(m.AuctionCommitBlocks))
+	}
+	if m.AuctionRevealBlocks != 0 {
+		n += 1 + sovTx(uint64(m.AuctionRevealBlocks))
+	}
+	return n
+}
+
+func (m *MsgCreateHostingInquiryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgUpdateHostingInquiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.FileEntryCid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReplicationRate != 0 {
+		n += 1 + sovTx(uint64(m.ReplicationRate))
+	}
+	l = m.EscrowAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.EndTime != 0 {
+		n += 1 + sovTx(uint64(m.EndTime))
+	}
+	l = m.MaxPricePerBlock.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateHostingInquiryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteHostingInquiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgDeleteHostingInquiryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateHostingContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.InquiryId != 0 {
+		n += 1 + sovTx(uint64(m.InquiryId))
+	}
+	if m.OfferId != 0 {
+		n += 1 + sovTx(uint64(m.OfferId))
+	}
+	return n
+}
+
+func (m *MsgCreateHostingContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgUpdateHostingContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.InquiryId != 0 {
+		n += 1 + sovTx(uint64(m.InquiryId))
+	}
+	if m.OfferId != 0 {
+		n += 1 + sovTx(uint64(m.OfferId))
+	}
+	return n
+}
+
+func (m *MsgUpdateHostingContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteHostingContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgDeleteHostingContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateHostingOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PricePerBlock.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateHostingOfferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgUpdateHostingOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PricePerBlock.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateHostingOfferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteHostingOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgDeleteHostingOfferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStakeForHosting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStakeForHostingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnstakeFromHosting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUnstakeFromHostingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompletionHeight != 0 {
+		n += 1 + sovTx(uint64(m.CompletionHeight))
+	}
+	return n
+}
+
+func (m *MsgSubmitStorageProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChallengeId != 0 {
+		n += 1 + sovTx(uint64(m.ChallengeId))
+	}
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitStorageProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Verified {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgAcceptHostingContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	l = len(m.Attestation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Proof.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAcceptHostingContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRejectHostingContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRejectHostingContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCommitBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.InquiryId != 0 {
+		n += 1 + sovTx(uint64(m.InquiryId))
+	}
+	if m.OfferId != 0 {
+		n += 1 + sovTx(uint64(m.OfferId))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCommitBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevealBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.InquiryId != 0 {
+		n += 1 + sovTx(uint64(m.InquiryId))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevealBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterProviderProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TotalCapacity != 0 {
+		n += 1 + sovTx(uint64(m.TotalCapacity))
+	}
+	if len(m.Regions) > 0 {
+		for _, s := range m.Regions {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Endpoints) > 0 {
+		for _, s := range m.Endpoints {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterProviderProfileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateProviderProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TotalCapacity != 0 {
+		n += 1 + sovTx(uint64(m.TotalCapacity))
+	}
+	if len(m.Regions) > 0 {
+		for _, s := range m.Regions {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Endpoints) > 0 {
+		for _, s := range m.Endpoints {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateProviderProfileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExtendHostingInquiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovTx(uint64(m.EndTime))
+	}
+	l = m.AdditionalEscrow.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgExtendHostingInquiryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EscrowAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAcceptHostingContractTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	l = len(m.Attestation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Proof.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAcceptHostingContractTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReassignHostingContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	if m.OfferId != 0 {
+		n += 1 + sovTx(uint64(m.OfferId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReassignHostingContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTerminateHostingContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTerminateHostingContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Settled.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Penalty.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Refunded.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFileEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFileEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFileEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootCid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootCid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentCid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetaData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
+			}
+			m.FileSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkCount", wireType)
+			}
+			m.ChunkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSize", wireType)
+			}
+			m.ChunkSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFileEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFileEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFileEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFileEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFileEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFileEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootCid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootCid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentCid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetaData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
+			}
+			m.FileSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFileEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFileEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFileEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteFileEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteFileEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteFileEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteFileEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteFileEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteFileEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateHostingInquiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateHostingInquiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateHostingInquiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileEntryCid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileEntryCid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationRate", wireType)
+			}
+			m.ReplicationRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicationRate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPricePerBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPricePerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRegions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRegions = append(m.AllowedRegions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedRegions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedRegions = append(m.DeniedRegions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionCommitBlocks", wireType)
+			}
+			m.AuctionCommitBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionCommitBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionRevealBlocks", wireType)
+			}
+			m.AuctionRevealBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionRevealBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateHostingInquiryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateHostingInquiryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateHostingInquiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateHostingInquiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateHostingInquiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateHostingInquiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileEntryCid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileEntryCid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationRate", wireType)
+			}
+			m.ReplicationRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicationRate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPricePerBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPricePerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateHostingInquiryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateHostingInquiryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateHostingInquiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteHostingInquiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteHostingInquiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteHostingInquiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteHostingInquiryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteHostingInquiryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteHostingInquiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateHostingContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateHostingContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateHostingContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+			}
+			m.InquiryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InquiryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			}
+			m.OfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateHostingContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateHostingContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateHostingContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateHostingContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateHostingContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateHostingContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+			}
+			m.InquiryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InquiryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			}
+			m.OfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateHostingContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateHostingContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateHostingContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUnstakeFromHostingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteHostingContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3868,15 +9118,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteHostingContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteHostingContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3904,13 +9154,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3920,25 +9170,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3960,7 +9196,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteHostingContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3983,10 +9219,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteHostingContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteHostingContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4010,7 +9246,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateFileEntry) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateHostingOffer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4033,10 +9269,10 @@ func (m *MsgCreateFileEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFileEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateHostingOffer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFileEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateHostingOffer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4073,7 +9309,7 @@ func (m *MsgCreateFileEntry) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4101,11 +9337,163 @@ func (m *MsgCreateFileEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cid = string(dAtA[iNdEx:postIndex])
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RootCid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PricePerBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PricePerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateHostingOfferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateHostingOfferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateHostingOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateHostingOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateHostingOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateHostingOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4133,13 +9521,13 @@ func (m *MsgCreateFileEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RootCid = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCid", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4149,27 +9537,14 @@ func (m *MsgCreateFileEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ParentCid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4197,13 +9572,13 @@ func (m *MsgCreateFileEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetaData = string(dAtA[iNdEx:postIndex])
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricePerBlock", wireType)
 			}
-			m.FileSize = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4213,11 +9588,25 @@ func (m *MsgCreateFileEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FileSize |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PricePerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4239,7 +9628,7 @@ func (m *MsgCreateFileEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateFileEntryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateHostingOfferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4262,31 +9651,12 @@ func (m *MsgCreateFileEntryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFileEntryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateHostingOfferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFileEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateHostingOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4308,7 +9678,7 @@ func (m *MsgCreateFileEntryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateFileEntry) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteHostingOffer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4331,10 +9701,10 @@ func (m *MsgUpdateFileEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateFileEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteHostingOffer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateFileEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteHostingOffer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4388,105 +9758,109 @@ func (m *MsgUpdateFileEntry) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RootCid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteHostingOfferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RootCid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCid", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteHostingOfferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteHostingOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeForHosting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParentCid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeForHosting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeForHosting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4514,13 +9888,13 @@ func (m *MsgUpdateFileEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetaData = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.FileSize = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4530,11 +9904,25 @@ func (m *MsgUpdateFileEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FileSize |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4556,7 +9944,7 @@ func (m *MsgUpdateFileEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateFileEntryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgStakeForHostingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4579,10 +9967,10 @@ func (m *MsgUpdateFileEntryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateFileEntryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgStakeForHostingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateFileEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgStakeForHostingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4606,7 +9994,7 @@ func (m *MsgUpdateFileEntryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteFileEntry) Unmarshal(dAtA []byte) error {
+func (m *MsgUnstakeFromHosting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4629,10 +10017,10 @@ func (m *MsgDeleteFileEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteFileEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnstakeFromHosting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteFileEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnstakeFromHosting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4668,10 +10056,10 @@ func (m *MsgDeleteFileEntry) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4681,11 +10069,25 @@ func (m *MsgDeleteFileEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4707,7 +10109,7 @@ func (m *MsgDeleteFileEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteFileEntryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnstakeFromHostingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4730,12 +10132,31 @@ func (m *MsgDeleteFileEntryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteFileEntryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnstakeFromHostingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteFileEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnstakeFromHostingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionHeight", wireType)
+			}
+			m.CompletionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4757,7 +10178,7 @@ func (m *MsgDeleteFileEntryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateHostingInquiry) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitStorageProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4780,10 +10201,10 @@ func (m *MsgCreateHostingInquiry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateHostingInquiry: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitStorageProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateHostingInquiry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitStorageProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4819,10 +10240,29 @@ func (m *MsgCreateHostingInquiry) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileEntryCid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4832,29 +10272,81 @@ func (m *MsgCreateHostingInquiry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileEntryCid = string(dAtA[iNdEx:postIndex])
+			m.Proofs = append(m.Proofs, ChunkProof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitStorageProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitStorageProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitStorageProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
 			}
-			m.ReplicationRate = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4864,16 +10356,67 @@ func (m *MsgCreateHostingInquiry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReplicationRate |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			m.Verified = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptHostingContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptHostingContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptHostingContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4883,30 +10426,29 @@ func (m *MsgCreateHostingInquiry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EscrowAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.EndTime = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4916,14 +10458,48 @@ func (m *MsgCreateHostingInquiry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndTime |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestation = append(m.Attestation[:0], dAtA[iNdEx:postIndex]...)
+			if m.Attestation == nil {
+				m.Attestation = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPricePerBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4950,7 +10526,7 @@ func (m *MsgCreateHostingInquiry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxPricePerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4975,7 +10551,7 @@ func (m *MsgCreateHostingInquiry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateHostingInquiryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptHostingContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4998,31 +10574,12 @@ func (m *MsgCreateHostingInquiryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateHostingInquiryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptHostingContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateHostingInquiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptHostingContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5044,7 +10601,7 @@ func (m *MsgCreateHostingInquiryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateHostingInquiry) Unmarshal(dAtA []byte) error {
+func (m *MsgRejectHostingContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5067,10 +10624,10 @@ func (m *MsgUpdateHostingInquiry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateHostingInquiry: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejectHostingContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateHostingInquiry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejectHostingContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5107,9 +10664,9 @@ func (m *MsgUpdateHostingInquiry) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.Id = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5119,14 +10676,14 @@ func (m *MsgUpdateHostingInquiry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileEntryCid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5154,111 +10711,7 @@ func (m *MsgUpdateHostingInquiry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileEntryCid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationRate", wireType)
-			}
-			m.ReplicationRate = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReplicationRate |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAmount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.EscrowAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
-			}
-			m.EndTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndTime |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPricePerBlock", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MaxPricePerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5281,7 +10734,7 @@ func (m *MsgUpdateHostingInquiry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateHostingInquiryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRejectHostingContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5304,10 +10757,10 @@ func (m *MsgUpdateHostingInquiryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateHostingInquiryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejectHostingContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateHostingInquiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejectHostingContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5331,7 +10784,7 @@ func (m *MsgUpdateHostingInquiryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteHostingInquiry) Unmarshal(dAtA []byte) error {
+func (m *MsgCommitBid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5354,10 +10807,10 @@ func (m *MsgDeleteHostingInquiry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteHostingInquiry: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommitBid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteHostingInquiry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommitBid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5394,9 +10847,9 @@ func (m *MsgDeleteHostingInquiry) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
 			}
-			m.Id = 0
+			m.InquiryId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5406,11 +10859,64 @@ func (m *MsgDeleteHostingInquiry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.InquiryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			}
+			m.OfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5432,7 +10938,7 @@ func (m *MsgDeleteHostingInquiry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteHostingInquiryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCommitBidResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5455,10 +10961,10 @@ func (m *MsgDeleteHostingInquiryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteHostingInquiryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommitBidResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteHostingInquiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommitBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5482,7 +10988,7 @@ func (m *MsgDeleteHostingInquiryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateHostingContract) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealBid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5505,10 +11011,10 @@ func (m *MsgCreateHostingContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateHostingContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealBid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateHostingContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealBid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5563,10 +11069,10 @@ func (m *MsgCreateHostingContract) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			m.OfferId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5576,11 +11082,59 @@ func (m *MsgCreateHostingContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OfferId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5602,7 +11156,7 @@ func (m *MsgCreateHostingContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateHostingContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealBidResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5625,31 +11179,12 @@ func (m *MsgCreateHostingContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateHostingContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealBidResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateHostingContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5671,7 +11206,7 @@ func (m *MsgCreateHostingContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateHostingContract) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterProviderProfile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5694,10 +11229,10 @@ func (m *MsgUpdateHostingContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateHostingContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterProviderProfile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateHostingContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterProviderProfile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5733,10 +11268,10 @@ func (m *MsgUpdateHostingContract) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5746,16 +11281,29 @@ func (m *MsgUpdateHostingContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InquiryId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCapacity", wireType)
 			}
-			m.InquiryId = 0
+			m.TotalCapacity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5765,16 +11313,16 @@ func (m *MsgUpdateHostingContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InquiryId |= uint64(b&0x7F) << shift
+				m.TotalCapacity |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
 			}
-			m.OfferId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5784,11 +11332,56 @@ func (m *MsgUpdateHostingContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OfferId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regions = append(m.Regions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5810,7 +11403,7 @@ func (m *MsgUpdateHostingContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateHostingContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterProviderProfileResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5833,10 +11426,10 @@ func (m *MsgUpdateHostingContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateHostingContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterProviderProfileResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateHostingContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterProviderProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5860,7 +11453,7 @@ func (m *MsgUpdateHostingContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteHostingContract) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateProviderProfile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5883,10 +11476,10 @@ func (m *MsgDeleteHostingContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteHostingContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateProviderProfile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteHostingContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateProviderProfile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5922,10 +11515,42 @@ func (m *MsgDeleteHostingContract) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCapacity", wireType)
 			}
-			m.Id = 0
+			m.TotalCapacity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5935,11 +11560,75 @@ func (m *MsgDeleteHostingContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.TotalCapacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regions = append(m.Regions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5961,7 +11650,7 @@ func (m *MsgDeleteHostingContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteHostingContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateProviderProfileResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5984,10 +11673,10 @@ func (m *MsgDeleteHostingContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteHostingContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateProviderProfileResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteHostingContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateProviderProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6011,7 +11700,7 @@ func (m *MsgDeleteHostingContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateHostingOffer) Unmarshal(dAtA []byte) error {
+func (m *MsgExtendHostingInquiry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6034,10 +11723,10 @@ func (m *MsgCreateHostingOffer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateHostingOffer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExtendHostingInquiry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateHostingOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExtendHostingInquiry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6073,10 +11762,10 @@ func (m *MsgCreateHostingOffer) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6086,27 +11775,33 @@ func (m *MsgCreateHostingOffer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PricePerBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalEscrow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6133,7 +11828,7 @@ func (m *MsgCreateHostingOffer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PricePerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AdditionalEscrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6158,7 +11853,7 @@ func (m *MsgCreateHostingOffer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateHostingOfferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExtendHostingInquiryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6181,17 +11876,17 @@ func (m *MsgCreateHostingOfferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateHostingOfferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExtendHostingInquiryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateHostingOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExtendHostingInquiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAmount", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6201,11 +11896,25 @@ func (m *MsgCreateHostingOfferResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6227,7 +11936,7 @@ func (m *MsgCreateHostingOfferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateHostingOffer) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptHostingContractTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6250,10 +11959,10 @@ func (m *MsgUpdateHostingOffer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateHostingOffer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptHostingContractTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateHostingOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptHostingContractTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6290,9 +11999,9 @@ func (m *MsgUpdateHostingOffer) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.Id = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6302,16 +12011,16 @@ func (m *MsgUpdateHostingOffer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6321,27 +12030,29 @@ func (m *MsgUpdateHostingOffer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			m.Attestation = append(m.Attestation[:0], dAtA[iNdEx:postIndex]...)
+			if m.Attestation == nil {
+				m.Attestation = []byte{}
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PricePerBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6368,7 +12079,7 @@ func (m *MsgUpdateHostingOffer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PricePerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6393,7 +12104,7 @@ func (m *MsgUpdateHostingOffer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateHostingOfferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptHostingContractTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6416,10 +12127,10 @@ func (m *MsgUpdateHostingOfferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateHostingOfferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptHostingContractTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateHostingOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptHostingContractTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6443,7 +12154,7 @@ func (m *MsgUpdateHostingOfferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteHostingOffer) Unmarshal(dAtA []byte) error {
+func (m *MsgReassignHostingContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6466,15 +12177,15 @@ func (m *MsgDeleteHostingOffer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteHostingOffer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReassignHostingContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteHostingOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReassignHostingContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6502,13 +12213,13 @@ func (m *MsgDeleteHostingOffer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.Id = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6518,11 +12229,62 @@ func (m *MsgDeleteHostingOffer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			}
+			m.OfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6544,7 +12306,7 @@ func (m *MsgDeleteHostingOffer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteHostingOfferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReassignHostingContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6567,10 +12329,10 @@ func (m *MsgDeleteHostingOfferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteHostingOfferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReassignHostingContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteHostingOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReassignHostingContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6594,7 +12356,7 @@ func (m *MsgDeleteHostingOfferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakeForHosting) Unmarshal(dAtA []byte) error {
+func (m *MsgTerminateHostingContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6617,10 +12379,10 @@ func (m *MsgStakeForHosting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeForHosting: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTerminateHostingContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeForHosting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTerminateHostingContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6656,10 +12418,29 @@ func (m *MsgStakeForHosting) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6669,24 +12450,23 @@ func (m *MsgStakeForHosting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6709,57 +12489,7 @@ func (m *MsgStakeForHosting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakeForHostingResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeForHostingResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeForHostingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUnstakeFromHosting) Unmarshal(dAtA []byte) error {
+func (m *MsgTerminateHostingContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6782,17 +12512,17 @@ func (m *MsgUnstakeFromHosting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstakeFromHosting: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTerminateHostingContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstakeFromHosting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTerminateHostingContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Settled", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6802,27 +12532,28 @@ func (m *MsgUnstakeFromHosting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if err := m.Settled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6849,60 +12580,43 @@ func (m *MsgUnstakeFromHosting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Penalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUnstakeFromHostingResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Refunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstakeFromHostingResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstakeFromHostingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])